@@ -4,29 +4,131 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"slices"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/tonkeeper/opentonapi/pkg/blockchain/indexer"
 	"github.com/tonkeeper/opentonapi/pkg/spam"
 	"github.com/tonkeeper/tongo"
+	liteconfig "github.com/tonkeeper/tongo/config"
 	"github.com/tonkeeper/tongo/liteapi"
 	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 
 	"github.com/tonkeeper/opentonapi/pkg/addressbook"
+	"github.com/tonkeeper/opentonapi/pkg/analytics"
+	"github.com/tonkeeper/opentonapi/pkg/annotations"
 	"github.com/tonkeeper/opentonapi/pkg/api"
 	"github.com/tonkeeper/opentonapi/pkg/app"
+	"github.com/tonkeeper/opentonapi/pkg/auctions"
 	"github.com/tonkeeper/opentonapi/pkg/blockchain"
+	"github.com/tonkeeper/opentonapi/pkg/chainstate"
 	"github.com/tonkeeper/opentonapi/pkg/config"
+	"github.com/tonkeeper/opentonapi/pkg/deposits"
+	"github.com/tonkeeper/opentonapi/pkg/labels"
+	"github.com/tonkeeper/opentonapi/pkg/literetry"
 	"github.com/tonkeeper/opentonapi/pkg/litestorage"
+	"github.com/tonkeeper/opentonapi/pkg/profiling"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/webhooks"
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+	"github.com/tonkeeper/opentonapi/pkg/scheduler"
+	"github.com/tonkeeper/opentonapi/pkg/selftest"
+	"github.com/tonkeeper/opentonapi/pkg/shardroute"
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+	"github.com/tonkeeper/opentonapi/pkg/usage"
 )
 
 func main() {
 
 	cfg := config.Load()
 	log := app.Logger(cfg.App.LogLevel)
-	book := addressbook.NewAddressBook(log, config.AddressPath, config.JettonPath, config.CollectionPath)
+	degradedGroups := runSelfTest(log, cfg.App.SelfTest)
+	// jobs runs background jobs of all components, they start as soon as they are added.
+	jobs := scheduler.New(log, scheduler.WithIntervals(cfg.Scheduler.Intervals))
+	go jobs.Run(context.TODO())
+	var bookSources []addressbook.Source
+	for _, path := range cfg.AddressBook.Files {
+		bookSources = append(bookSources, addressbook.NewFileSource(path))
+	}
+	book := addressbook.NewAddressBook(log, config.AddressPath, config.JettonPath, config.CollectionPath,
+		addressbook.WithSources(bookSources...),
+		addressbook.WithRefreshInterval(cfg.AddressBook.RefreshInterval),
+		addressbook.WithScheduler(jobs))
+
+	encryptionKey := cfg.App.RepositoryEncryptionKey
+	if cfg.App.RepositoryEncryptionKeyFile != "" {
+		content, err := os.ReadFile(cfg.App.RepositoryEncryptionKeyFile)
+		if err != nil {
+			log.Fatal("failed to read repository encryption key", zap.Error(err))
+		}
+		encryptionKey = string(content)
+	}
+	if encryptionKey != "" && cfg.App.Repository == "" {
+		// file-backed stores are never encrypted, so they would silently keep secrets in plain text.
+		log.Fatal("repository encryption key is set, but REPOSITORY is not")
+	}
+	if cfg.App.RepositoryEncryptionStrict && encryptionKey == "" {
+		log.Fatal("REPOSITORY_ENCRYPTION_STRICT requires a repository encryption key")
+	}
+	var repo repository.Repository
+	var encrypted *repository.Encrypted
+	if cfg.App.Repository != "" {
+		var err error
+		if repo, err = repository.Open(context.TODO(), cfg.App.Repository); err != nil {
+			log.Fatal("failed to open repository", zap.Error(err))
+		}
+		defer repo.Close()
+		if encryptionKey != "" {
+			keys, err := repository.ParseKeys(encryptionKey)
+			if err != nil {
+				log.Fatal("failed to parse repository encryption key", zap.Error(err))
+			}
+			encrypted, err = repository.NewEncrypted(repo, keys...)
+			if err != nil {
+				log.Fatal("failed to enable repository encryption", zap.Error(err))
+			}
+			if cfg.App.RepositoryEncryptionStrict {
+				encrypted.RejectPlaintext()
+			}
+			repo = encrypted
+			// re-saves records with the first key after a rotation, it is triggered at /admin/jobs/ only.
+			jobs.Add(scheduler.Job{
+				Name: "repository_reencrypt",
+				Run: func(ctx context.Context) error {
+					saved, err := encrypted.Reencrypt(ctx, tenant.Collection, labels.Collection, deposits.Collection, annotations.Collection, webhooks.Collection)
+					log.Info("repository records re-encrypted", zap.Int("records", saved))
+					return err
+				},
+			})
+		}
+	}
+
+	var tenants *tenant.Registry
+	accounts := cfg.App.Accounts
+	switch {
+	case cfg.App.TenantsFile != "":
+		var err error
+		if tenants, err = tenant.LoadFile(cfg.App.TenantsFile); err != nil {
+			log.Fatal("failed to load tenants", zap.Error(err))
+		}
+	case repo != nil:
+		registry, err := tenant.LoadRepository(context.TODO(), repo)
+		if err != nil {
+			log.Fatal("failed to load tenants", zap.Error(err))
+		}
+		// without tenants in the repository, the instance is not multi-tenant.
+		if registry.Len() > 0 {
+			tenants = registry
+		}
+	}
+	if tenants != nil {
+		accounts = append(accounts, tenants.Accounts()...)
+	}
 
 	storageBlockCh := make(chan indexer.IDandBlock)
 
@@ -41,68 +143,277 @@ func main() {
 	if err != nil {
 		log.Fatal("failed to create liteapi client", zap.Error(err))
 	}
+	retryPolicy := literetry.DefaultPolicy
+	retryPolicy.Attempts = cfg.App.RetryAttempts
+	retryPolicy.Delay = cfg.App.RetryDelay
+	var shardRouter *shardroute.Router
+	if cfg.App.ShardRouting || cfg.App.HedgeDelay > 0 || cfg.App.VerifyFraction > 0 {
+		shardRouter, err = newShardRouter(cfg.App.LiteServers,
+			shardroute.WithHedging(cfg.App.HedgeDelay),
+			shardroute.WithVerification(cfg.App.VerifyFraction, log))
+		if err != nil {
+			log.Fatal("failed to create shard router", zap.Error(err))
+		}
+	}
 
-	storage, err := litestorage.NewLiteStorage(
-		log,
-		client,
+	storageOptions := []litestorage.Option{
 		// Subscriibe to all accounts in the address book
-		litestorage.WithPreloadAccounts(cfg.App.Accounts),
+		litestorage.WithPreloadAccounts(accounts),
 		litestorage.WithTFPools(book.TFPools()),
 		litestorage.WithKnownJettons(maps.Keys(book.GetKnownJettons())),
 		litestorage.WithBlockChannel(storageBlockCh),
-	)
+		litestorage.WithShardRouter(shardRouter),
+		litestorage.WithScheduler(jobs),
+		litestorage.WithRetryPolicy(retryPolicy),
+		litestorage.WithRetention(litestorage.Retention{
+			Transactions: litestorage.RetentionPolicy{
+				MaxAge:   cfg.Retention.TransactionsMaxAge,
+				MaxBytes: cfg.Retention.TransactionsMaxSizeMB << 20,
+			},
+			Blocks:   litestorage.RetentionPolicy{MaxAge: cfg.Retention.BlocksMaxAge},
+			Interval: cfg.Retention.PruneInterval,
+		}),
+	}
+	if encrypted != nil {
+		storageOptions = append(storageOptions, litestorage.WithSnapshotCipher(encrypted))
+	}
+	storage, err := litestorage.NewLiteStorage(log, client, storageOptions...)
 	// The executor is used to resolve DNS records.
 	tongo.SetDefaultExecutor(storage)
 
 	if err != nil {
 		log.Fatal("storage init", zap.Error(err))
 	}
+	if cfg.App.RestoreSnapshot != "" {
+		if err := restoreSnapshot(storage, cfg.App.RestoreSnapshot); err != nil {
+			log.Fatal("failed to restore snapshot", zap.Error(err))
+		}
+	}
+	for _, account := range cfg.App.BackfillAccounts {
+		storage.StartBackfill(account, litestorage.BackfillOptions{})
+	}
 	// mempool receives a copy of any payload that goes through our API method /v2/blockchain/message
 	mempool := sources.NewMemPool(log)
 	mempoolCh := mempool.Run(context.TODO())
 
 	msgSender, err := blockchain.NewMsgSender(log, cfg.App.LiteServers, map[string]chan<- blockchain.ExtInMsgCopy{
 		"mempool": mempoolCh,
-	})
+	}, blockchain.WithScheduler(jobs))
 	if err != nil {
 		log.Fatal("failed to create msg sender", zap.Error(err))
 	}
+	source := sources.NewBlockchainSource(log, client,
+		sources.WithPriorityAccounts(cfg.App.PriorityAccounts...),
+		sources.WithTransactionHistory(storage))
 	spamFilter := spam.NewSpamFilter()
-	h, err := api.NewHandler(log,
+	usageMeter := usage.NewMeter()
+	handlerOptions := []api.Option{
 		api.WithStorage(storage),
 		api.WithAddressBook(book),
 		api.WithExecutor(storage),
 		api.WithMessageSender(msgSender),
 		api.WithSpamFilter(spamFilter),
 		api.WithTonConnectSecret(cfg.TonConnect.Secret),
-	)
+		api.WithFinalityDepth(cfg.API.FinalityDepth),
+		api.WithChainState(chainstate.NewChainState(storage, chainstate.WithScheduler(jobs))),
+		api.WithUsage(usageMeter),
+	}
+	if cfg.AddressBook.PrivateLabelsFile != "" || repo != nil {
+		var privateLabels *labels.Store
+		if repo != nil {
+			privateLabels, err = labels.NewRepositoryStore(context.TODO(), repo)
+			jobs.Add(reloadJob("private_labels_reload", privateLabels.Reload, cfg.App.RepositoryRefreshInterval))
+		} else {
+			privateLabels, err = labels.NewStore(cfg.AddressBook.PrivateLabelsFile)
+		}
+		if err != nil {
+			log.Fatal("failed to load private labels", zap.Error(err))
+		}
+		handlerOptions = append(handlerOptions, api.WithPrivateLabels(privateLabels))
+	}
+	var depositMatcher *deposits.Matcher
+	if cfg.App.DepositsFile != "" || repo != nil {
+		var registry *deposits.Registry
+		if repo != nil {
+			registry, err = deposits.NewRepositoryRegistry(context.TODO(), repo)
+			jobs.Add(reloadJob("deposits_reload", registry.Reload, cfg.App.RepositoryRefreshInterval))
+		} else {
+			registry, err = deposits.NewRegistry(cfg.App.DepositsFile)
+		}
+		if err != nil {
+			log.Fatal("failed to load expected deposits", zap.Error(err))
+		}
+		handlerOptions = append(handlerOptions, api.WithExpectedDeposits(registry))
+		depositMatcher = deposits.NewMatcher(log, registry, storage, source)
+		go depositMatcher.Run(context.TODO())
+	}
+	if cfg.App.AnnotationsFile != "" || repo != nil {
+		var store *annotations.Store
+		if repo != nil {
+			store, err = annotations.NewRepositoryStore(context.TODO(), repo)
+			jobs.Add(reloadJob("annotations_reload", store.Reload, cfg.App.RepositoryRefreshInterval))
+		} else {
+			store, err = annotations.NewStore(cfg.App.AnnotationsFile)
+		}
+		if err != nil {
+			log.Fatal("failed to load event annotations", zap.Error(err))
+		}
+		handlerOptions = append(handlerOptions, api.WithEventAnnotations(store))
+	}
+	lagMonitor := indexer.NewLagMonitor(cfg.App.IndexerLagThreshold)
+	tracer := sources.NewTracer(log, storage, source, sources.WithCatchUpIndicator(lagMonitor))
+	if cfg.App.WebhooksFile != "" || repo != nil {
+		var registry *webhooks.Registry
+		if repo != nil {
+			registry, err = webhooks.NewRepositoryRegistry(context.TODO(), repo)
+			jobs.Add(reloadJob("webhooks_reload", registry.Reload, cfg.App.RepositoryRefreshInterval))
+		} else {
+			registry, err = webhooks.NewRegistry(cfg.App.WebhooksFile)
+		}
+		if err != nil {
+			log.Fatal("failed to load webhooks", zap.Error(err))
+		}
+		handlerOptions = append(handlerOptions, api.WithWebhooks(registry))
+		go webhooks.NewDeliverer(log, registry, source, tracer).Run(context.TODO())
+	}
+	var bidTracker *auctions.Tracker
+	if cfg.App.AuctionBids {
+		bidTracker = auctions.NewTracker(log, storage, tracer)
+		go bidTracker.Run(context.TODO())
+		handlerOptions = append(handlerOptions, api.WithAuctionBids(bidTracker))
+	}
+	var nftTransfers *sources.NftTransfers
+	if cfg.App.NftTransfers {
+		nftTransfers = sources.NewNftTransfers(log, storage, tracer)
+		go nftTransfers.Run(context.TODO())
+	}
+	if cfg.App.SimulationEnabled {
+		if !cfg.App.IsTestnet {
+			log.Fatal("transaction simulation is available in the testnet only")
+		}
+		handlerOptions = append(handlerOptions, api.WithTransactionSimulator(source))
+	}
+	h, err := api.NewHandler(log, handlerOptions...)
 	if err != nil {
 		log.Fatal("failed to create api handler", zap.Error(err))
 	}
-	source := sources.NewBlockchainSource(log, client)
 	pusherBlockCh := source.Run(context.TODO())
 
-	tracer := sources.NewTracer(log, storage, source)
 	go tracer.Run(context.TODO())
+	if cfg.App.AnalyticsSink != "" {
+		sink, err := analytics.NewSink(log, cfg.App.AnalyticsSink)
+		if err != nil {
+			log.Fatal("failed to create analytics sink", zap.Error(err))
+		}
+		emitter, err := analytics.NewEmitter(log, storage, tracer, sink,
+			analytics.WithSampleRate(cfg.App.AnalyticsSampleRate),
+			analytics.WithInterval(cfg.App.AnalyticsInterval))
+		if err != nil {
+			log.Fatal("failed to create analytics emitter", zap.Error(err))
+		}
+		go emitter.Run(context.TODO())
+	}
 
-	idx := indexer.New(log, client)
+	indexerOptions := []indexer.Option{indexer.WithLagMonitor(lagMonitor), indexer.WithRetryPolicy(retryPolicy)}
+	if cfg.App.ChainResetPurge {
+		indexerOptions = append(indexerOptions, indexer.WithChainResetHandler(storage.PurgeIndex))
+	}
+	idx := indexer.New(log, client, indexerOptions...)
 	go idx.Run(context.TODO(), []chan indexer.IDandBlock{
 		pusherBlockCh,
 		storageBlockCh,
 	})
 
-	server, err := api.NewServer(log, h,
+	serverOptions := []api.ServerOption{
 		api.WithTransactionSource(source),
 		api.WithBlockHeadersSource(source),
+		api.WithConfigChangesSource(source),
 		api.WithTraceSource(tracer),
-		api.WithMemPool(mempool))
+		api.WithMemPool(mempool),
+		api.WithReadinessProbe(lagMonitor.Ready),
+		api.WithLiteServerAccess(api.LiteServerAccess{
+			Tokens:            cfg.API.LiteServerTokens,
+			RequestsPerSecond: cfg.API.LiteServerRPS,
+		}),
+		api.WithQueryBudgets(api.QueryBudgets{
+			Trace:         cfg.API.TraceQueryBudget,
+			AccountEvents: cfg.API.AccountEventsQueryBudget,
+		}),
+		api.WithUsageMeter(usageMeter),
+	}
+	if tenants != nil {
+		serverOptions = append(serverOptions, api.WithTenants(tenants))
+	}
+	if depositMatcher != nil {
+		serverOptions = append(serverOptions, api.WithDepositSource(depositMatcher))
+	}
+	if bidTracker != nil {
+		serverOptions = append(serverOptions, api.WithBidSource(bidTracker))
+	}
+	if nftTransfers != nil {
+		serverOptions = append(serverOptions, api.WithNftTransferSource(nftTransfers))
+	}
+	maintenance := api.NewMaintenance(cfg.API.MaintenanceRetryAfter, cfg.API.MaintenanceFailoverDelay)
+	serverOptions = append(serverOptions, api.WithMaintenance(maintenance))
+	if len(cfg.API.AdminTokens) > 0 {
+		serverOptions = append(serverOptions, api.WithAdminTokens(cfg.API.AdminTokens))
+	}
+	slowLog := slowlog.NewLog(cfg.API.SlowLogSize)
+	if cfg.API.CacheImmutableMaxAge > 0 || cfg.API.CacheVolatileMaxAge > 0 {
+		serverOptions = append(serverOptions, api.WithCachePolicy(api.CachePolicy{
+			Immutable: cfg.API.CacheImmutableMaxAge,
+			Volatile:  cfg.API.CacheVolatileMaxAge,
+		}))
+	}
+	serverOptions = append(serverOptions, api.WithStreamingLimits(utils.Limits{
+		MaxAccountsPerSubscription:    cfg.API.StreamingMaxAccountsPerSubscription,
+		MaxSubscriptionsPerConnection: cfg.API.StreamingMaxSubscriptionsPerConnection,
+	}))
+	serverOptions = append(serverOptions, api.WithSSEResume(cfg.API.SSEResumeBufferSize, cfg.API.SSEResumeRetention))
+	serverOptions = append(serverOptions, api.WithOpenAPI(api.OpenAPIOptions{
+		PublicURL: cfg.API.PublicURL,
+		Docs:      cfg.API.OpenAPIDocs,
+	}))
+	if cfg.API.ShardRouteHeaders {
+		serverOptions = append(serverOptions, api.WithShardRouteHeaders())
+	}
+	if cfg.API.SlowRequestThreshold > 0 {
+		serverOptions = append(serverOptions, api.WithSlowLog(slowLog, cfg.API.SlowRequestThreshold))
+	}
+	for _, group := range cfg.API.DisabledEndpointGroups {
+		serverOptions = append(serverOptions, api.WithDisabledEndpointGroups(api.EndpointGroup(group)))
+	}
+	serverOptions = append(serverOptions, api.WithDisabledEndpointGroups(degradedGroups...))
+	server, err := api.NewServer(log, h, serverOptions...)
 	if err != nil {
 		log.Fatal("failed to create api handler", zap.Error(err))
 	}
 
+	// the metrics port is internal, so it also serves admin endpoints, all of them require one of the admin tokens.
+	metricMux := http.NewServeMux()
+	metricMux.Handle("/", promhttp.Handler())
+	metricMux.Handle("/admin/addressbook/", api.AdminOnly(cfg.API.AdminTokens, book.AdminHandler("/admin/addressbook/")))
+	metricMux.Handle("/admin/backfill/", api.AdminOnly(cfg.API.AdminTokens, storage.BackfillHandler("/admin/backfill/")))
+	metricMux.Handle("/admin/snapshot", api.AdminOnly(cfg.API.AdminTokens, storage.SnapshotHandler()))
+	metricMux.Handle("/admin/maintenance", api.AdminOnly(cfg.API.AdminTokens, maintenance.AdminHandler()))
+	metricMux.Handle("/admin/jobs/", api.AdminOnly(cfg.API.AdminTokens, jobs.AdminHandler("/admin/jobs/")))
+	metricMux.Handle("/debug/slowlog", api.AdminOnly(cfg.API.AdminTokens, slowLog.Handler()))
+	if len(cfg.API.AdminTokens) > 0 {
+		metricMux.Handle("/admin/export/", api.AdminOnly(cfg.API.AdminTokens, h.ExportHandler("/admin/export/")))
+		metricMux.Handle("/debug/pprof/", api.AdminOnly(cfg.API.AdminTokens, profiling.Handler()))
+		if cfg.App.PprofCaptureInterval > 0 {
+			capturer := profiling.NewCapturer(log, profiling.Options{
+				Interval:    cfg.App.PprofCaptureInterval,
+				CPUDuration: cfg.App.PprofCPUDuration,
+				Keep:        cfg.App.PprofCaptureKeep,
+			})
+			go capturer.Run(context.TODO())
+			metricMux.Handle("/admin/profiles/", api.AdminOnly(cfg.API.AdminTokens, capturer.Handler("/admin/profiles/")))
+		}
+	}
 	metricServer := http.Server{
 		Addr:    fmt.Sprintf(":%v", cfg.App.MetricsPort),
-		Handler: promhttp.Handler(),
+		Handler: metricMux,
 	}
 	go func() {
 		if err := metricServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -113,3 +424,96 @@ func main() {
 	log.Warn("start server", zap.Int("port", cfg.API.Port))
 	server.Run(fmt.Sprintf(":%d", cfg.API.Port), cfg.API.UnixSockets)
 }
+
+func restoreSnapshot(storage *litestorage.LiteStorage, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	snapshot, err := storage.OpenSnapshot(file)
+	if err != nil {
+		return err
+	}
+	_, err = storage.RestoreSnapshot(snapshot)
+	return err
+}
+
+// newShardRouter creates a client per lite server, so the router can pick a particular one for an account query.
+func newShardRouter(servers []liteconfig.LiteServer, opts ...shardroute.Option) (*shardroute.Router, error) {
+	if len(servers) < 2 {
+		return nil, fmt.Errorf("shard routing requires several lite servers")
+	}
+	var routed []shardroute.Server
+	for _, server := range servers {
+		client, err := liteapi.NewClient(liteapi.WithLiteServers([]liteconfig.LiteServer{server}))
+		if err != nil {
+			return nil, fmt.Errorf("lite server %v: %w", server.Host, err)
+		}
+		routed = append(routed, shardroute.Server{Name: server.Host, Client: client})
+	}
+	return shardroute.NewRouter(routed, opts...), nil
+}
+
+// selfTestDegradedGroups are endpoint groups disabled when a component fails the self-test in the "degrade" mode.
+var selfTestDegradedGroups = map[selftest.Component][]api.EndpointGroup{
+	selftest.ComponentDecoding:   {api.EndpointGroupEvents, api.EndpointGroupEmulation},
+	selftest.ComponentInterfaces: {api.EndpointGroupNFT, api.EndpointGroupJettons, api.EndpointGroupEmulation},
+	selftest.ComponentActions:    {api.EndpointGroupEvents, api.EndpointGroupEmulation},
+}
+
+// runSelfTest runs the self-test and returns endpoint groups to disable.
+func runSelfTest(log *zap.Logger, mode string) []api.EndpointGroup {
+	switch mode {
+	case "off":
+		return nil
+	case "strict", "degrade":
+	default:
+		log.Fatal("unknown self-test mode", zap.String("mode", mode))
+	}
+	report := selftest.Run(context.TODO())
+	for _, check := range report.Failed() {
+		log.Error("self-test check failed",
+			zap.String("component", string(check.Component)),
+			zap.String("check", check.Name),
+			zap.String("error", check.Error))
+	}
+	log.Info("self-test finished",
+		zap.Int("checks", len(report.Checks)),
+		zap.Int("failed", len(report.Failed())),
+		zap.Duration("duration", report.Duration))
+	failed := report.FailedComponents()
+	if len(failed) == 0 {
+		return nil
+	}
+	if mode == "strict" {
+		log.Fatal("self-test failed", zap.Any("components", failed))
+	}
+	var groups []api.EndpointGroup
+	for _, component := range failed {
+		for _, group := range selfTestDegradedGroups[component] {
+			if !slices.Contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+	}
+	log.Warn("endpoint groups are disabled after the self-test", zap.Any("groups", groups))
+	return groups
+}
+
+// reloadTimeout limits a single reload of the repository.
+// It doesn't depend on the interval of the job, which is 0 for a job triggered manually only.
+const reloadTimeout = 30 * time.Second
+
+// reloadJob picks up changes made to the repository by other replicas.
+func reloadJob(name string, reload func(ctx context.Context) error, interval time.Duration) scheduler.Job {
+	return scheduler.Job{
+		Name:     name,
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, reloadTimeout)
+			defer cancel()
+			return reload(ctx)
+		},
+	}
+}
@@ -0,0 +1,69 @@
+// loadgen replays a recorded mix of requests against a running opentonapi instance and prints a throughput report,
+// so the same mix can be replayed against two releases to compare their performance:
+//
+//	go run ./cmd/loadgen -target http://localhost:8081 -mix mix.jsonl -concurrency 16 -duration 1m
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/tonkeeper/opentonapi/pkg/loadgen"
+)
+
+func main() {
+	target := flag.String("target", "http://localhost:8081", "base URL of the instance")
+	mixPath := flag.String("mix", "", "path to a recorded mix of requests, one JSON-encoded request per line")
+	token := flag.String("token", "", "bearer token sent with every request")
+	concurrency := flag.Int("concurrency", 8, "number of requests sent in parallel")
+	duration := flag.Duration("duration", time.Minute, "how long the load is applied")
+	rate := flag.Float64("rate", 0, "limit of requests per second, 0 means no limit")
+	seed := flag.Int64("seed", 1, "seed of the order of requests")
+	timeout := flag.Duration("timeout", 30*time.Second, "timeout of a single request")
+	flag.Parse()
+
+	if *mixPath == "" {
+		log.Fatal("-mix is required")
+	}
+	file, err := os.Open(*mixPath)
+	if err != nil {
+		log.Fatalf("failed to open mix: %v", err)
+	}
+	mix, err := loadgen.ReadMix(file)
+	file.Close()
+	if err != nil {
+		log.Fatalf("failed to read mix: %v", err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client := &http.Client{
+		Timeout: *timeout,
+		Transport: &http.Transport{
+			MaxIdleConns:        *concurrency,
+			MaxIdleConnsPerHost: *concurrency,
+		},
+	}
+	report, err := loadgen.Run(ctx, client, mix, loadgen.Options{
+		Target:      *target,
+		Token:       *token,
+		Concurrency: *concurrency,
+		Duration:    *duration,
+		Rate:        *rate,
+		Seed:        *seed,
+	})
+	if err != nil {
+		log.Fatalf("load test failed: %v", err)
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal(err)
+	}
+}
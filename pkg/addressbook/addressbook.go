@@ -2,10 +2,8 @@ package addressbook
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"sort"
 	"sync"
 	"time"
@@ -20,6 +18,7 @@ import (
 	"github.com/tonkeeper/opentonapi/pkg/cache"
 	"github.com/tonkeeper/opentonapi/pkg/oas"
 	"github.com/tonkeeper/opentonapi/pkg/references"
+	"github.com/tonkeeper/opentonapi/pkg/scheduler"
 )
 
 // KnownAddress represents additional manually crafted information about a particular account in the blockchain.
@@ -29,6 +28,8 @@ type KnownAddress struct {
 	Name        string `json:"name"`
 	Address     string `json:"address"`
 	Image       string `json:"image,omitempty"`
+	// LeaderboardOptOut hides the account from top accounts leaderboards.
+	LeaderboardOptOut bool `json:"leaderboard_opt_out,omitempty"`
 }
 
 type AttachedAccountType string
@@ -91,7 +92,10 @@ type KnownCollection struct {
 type Option func(o *Options)
 
 type Options struct {
-	addressers []addresser
+	addressers      []addresser
+	sources         []Source
+	refreshInterval time.Duration
+	scheduler       *scheduler.Scheduler
 }
 
 type addresser interface {
@@ -106,12 +110,40 @@ func WithAdditionalAddressesSource(a addresser) Option {
 	}
 }
 
+// WithSources adds label sources merged on top of the ton-assets lists.
+func WithSources(sources ...Source) Option {
+	return func(o *Options) {
+		o.sources = append(o.sources, sources...)
+	}
+}
+
+// WithRefreshInterval configures how often the address book polls its sources.
+func WithRefreshInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.refreshInterval = interval
+	}
+}
+
+// WithScheduler runs the refresh of the address book as the "addressbook_sync" job of the scheduler.
+func WithScheduler(s *scheduler.Scheduler) Option {
+	return func(o *Options) {
+		o.scheduler = s
+	}
+}
+
 // Book holds information about known accounts, jettons, NFT collections manually crafted by the tonkeeper team and the community.
 type Book struct {
 	addressers []addresser
-
-	mu              sync.RWMutex
-	addresses       map[tongo.AccountID]KnownAddress
+	sources    []Source
+
+	mu        sync.RWMutex
+	addresses map[tongo.AccountID]KnownAddress
+	// addressOrigins maps an account to a name of the source its label comes from.
+	addressOrigins map[tongo.AccountID]string
+	// overrides are labels set at runtime by an operator, they take precedence over all sources.
+	overrides map[tongo.AccountID]KnownAddress
+	// snapshots contains the latest snapshot of each source.
+	snapshots       []*Snapshot
 	collections     map[tongo.AccountID]KnownCollection
 	jettons         map[tongo.AccountID]KnownJetton
 	tfPools         map[tongo.AccountID]TFPoolInfo
@@ -128,6 +160,9 @@ func (b *Book) GetAddressInfoByAddress(a tongo.AccountID) (KnownAddress, bool) {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
+	if a1, ok := b.overrides[a]; ok {
+		return a1, ok
+	}
 	if a1, ok := b.addresses[a]; ok {
 		return a1, ok
 	}
@@ -218,31 +253,44 @@ func (b *Book) IsWallet(addr tongo.AccountID) (bool, error) {
 	return false, fmt.Errorf("failed to figure out if %v is a wallet", addr)
 }
 
+// NewAddressBook creates an address book populated from the given ton-assets lists and additional sources.
 func NewAddressBook(logger *zap.Logger, addressPath, jettonPath, collectionPath string, opts ...Option) *Book {
 	var options Options
 	for _, opt := range opts {
 		opt(&options)
 	}
-	addresses := make(map[tongo.AccountID]KnownAddress)
-	collections := make(map[tongo.AccountID]KnownCollection)
-	jettons := make(map[tongo.AccountID]KnownJetton)
-	tfPools := make(map[tongo.AccountID]TFPoolInfo)
+	if options.refreshInterval == 0 {
+		options.refreshInterval = time.Minute * 10
+	}
+	sources := append([]Source{NewTonAssetsSource(addressPath, jettonPath, collectionPath)}, options.sources...)
 
 	book := &Book{
-		addresses:       addresses,
-		collections:     collections,
-		jettons:         jettons,
-		tfPools:         tfPools,
+		addresses:       make(map[tongo.AccountID]KnownAddress),
+		addressOrigins:  make(map[tongo.AccountID]string),
+		overrides:       make(map[tongo.AccountID]KnownAddress),
+		collections:     make(map[tongo.AccountID]KnownCollection),
+		jettons:         make(map[tongo.AccountID]KnownJetton),
+		tfPools:         make(map[tongo.AccountID]TFPoolInfo),
 		addressers:      options.addressers,
+		sources:         sources,
+		snapshots:       make([]*Snapshot, len(sources)),
 		walletsResolved: cache.NewLRUCache[tongo.AccountID, bool](200_000, "is_wallet"),
 	}
 
-	go func() {
-		for {
-			book.refresh(logger, addressPath, jettonPath, collectionPath)
-			time.Sleep(time.Minute * 10)
-		}
-	}()
+	job := scheduler.Job{
+		Name:      "addressbook_sync",
+		Interval:  options.refreshInterval,
+		Immediate: true,
+		Run: func(ctx context.Context) error {
+			book.refresh(ctx, logger)
+			return nil
+		},
+	}
+	if options.scheduler != nil {
+		options.scheduler.Add(job)
+	} else {
+		go scheduler.RunJob(context.Background(), logger, job)
+	}
 
 	go book.getGGWhitelist(logger)
 	go book.getTonDiamondsWhitelist()
@@ -250,21 +298,44 @@ func NewAddressBook(logger *zap.Logger, addressPath, jettonPath, collectionPath
 	return book
 }
 
-func (b *Book) refresh(logger *zap.Logger, addressPath, jettonPath, collectionPath string) {
-	go b.refreshAddresses(logger, addressPath)
-	go b.refreshJettons(logger, jettonPath)
-	go b.refreshCollections(logger, collectionPath)
+func (b *Book) refresh(ctx context.Context, logger *zap.Logger) {
 	go b.refreshTfPools(logger)
-}
 
-func (b *Book) refreshAddresses(logger *zap.Logger, addressPath string) {
-	addresses, err := downloadJson[KnownAddress](addressPath)
-	if err != nil {
-		logger.Warn("failed to load accounts.json")
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	modified := false
+	for i, source := range b.sources {
+		snapshot, err := source.Load(ctx)
+		if errors.Is(err, ErrNotModified) {
+			continue
+		}
+		if err != nil {
+			logger.Warn("failed to load address book source", zap.String("source", source.Name()), zap.Error(err))
+			continue
+		}
+		b.mu.Lock()
+		b.snapshots[i] = &snapshot
+		b.mu.Unlock()
+		modified = true
+	}
+	if !modified {
 		return
 	}
 	b.mu.Lock()
 	defer b.mu.Unlock()
+	// snapshots are applied in order, so later sources override earlier ones.
+	for i, snapshot := range b.snapshots {
+		if snapshot == nil {
+			continue
+		}
+		b.applyJettons(b.sources[i].Name(), snapshot.Jettons)
+		b.applyAddresses(b.sources[i].Name(), snapshot.Addresses)
+		b.applyCollections(snapshot.Collections)
+	}
+}
+
+func (b *Book) applyAddresses(origin string, addresses []KnownAddress) {
 	for _, item := range addresses {
 		account, err := tongo.ParseAddress(item.Address)
 		if err != nil {
@@ -272,17 +343,11 @@ func (b *Book) refreshAddresses(logger *zap.Logger, addressPath string) {
 		}
 		item.Address = account.ID.ToRaw()
 		b.addresses[account.ID] = item
+		b.addressOrigins[account.ID] = origin
 	}
 }
 
-func (b *Book) refreshJettons(logger *zap.Logger, jettonPath string) {
-	jettons, err := downloadJson[KnownJetton](jettonPath)
-	if err != nil {
-		logger.Warn("failed to load jettons.json")
-		return
-	}
-	b.mu.Lock()
-	defer b.mu.Unlock()
+func (b *Book) applyJettons(origin string, jettons []KnownJetton) {
 	for _, item := range jettons {
 		account, err := tongo.ParseAddress(item.Address)
 		if err != nil {
@@ -293,6 +358,7 @@ func (b *Book) refreshJettons(logger *zap.Logger, jettonPath string) {
 			Name:    item.Symbol + " master",
 			Address: account.ID.ToRaw(),
 		}
+		b.addressOrigins[account.ID] = origin
 		b.jettons[account.ID] = item
 	}
 }
@@ -304,14 +370,7 @@ func unique(approvers []oas.NftApprovedByItem) []oas.NftApprovedByItem {
 	return slices.Compact(approvers)
 }
 
-func (b *Book) refreshCollections(logger *zap.Logger, collectionPath string) {
-	collections, err := downloadJson[KnownCollection](collectionPath)
-	if err != nil {
-		logger.Warn("fail to load collections.json")
-		return
-	}
-	b.mu.Lock()
-	defer b.mu.Unlock()
+func (b *Book) applyCollections(collections []KnownCollection) {
 	for _, item := range collections {
 		// TODO: remove items that were previously added but aren't present in the current list.
 		account, err := tongo.ParseAddress(item.Address)
@@ -327,6 +386,7 @@ func (b *Book) refreshCollections(logger *zap.Logger, collectionPath string) {
 			continue
 		}
 		// this is an existing item, so we merge approvers and remove duplicates adding tonkeeper.
+		item.Address = account.ID.ToRaw()
 		item.Approvers = unique(append(append(currentCollection.Approvers, item.Approvers...), oas.NftApprovedByItemTonkeeper))
 		b.collections[account.ID] = item
 	}
@@ -347,26 +407,6 @@ func (b *Book) refreshTfPools(logger *zap.Logger) {
 	}
 }
 
-func downloadJson[T any](url string) ([]T, error) {
-	response, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-	if response.StatusCode >= 300 {
-		return nil, fmt.Errorf("invalid status code %v", response.StatusCode)
-	}
-	content, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-	var data []T
-	if err = json.Unmarshal(content, &data); err != nil {
-		return nil, err
-	}
-	return data, nil
-}
-
 func (b *Book) getGGWhitelist(logger *zap.Logger) {
 	for {
 		if len(b.GetKnownCollections()) == 0 {
@@ -0,0 +1,130 @@
+package addressbook
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/tonkeeper/tongo"
+)
+
+// OverrideSourceName is reported as an origin of labels set with SetAddressOverride.
+const OverrideSourceName = "override"
+
+// AddressLabel describes where a label of a particular account comes from.
+type AddressLabel struct {
+	// Effective is the label returned by GetAddressInfoByAddress.
+	Effective *KnownAddress `json:"effective,omitempty"`
+	// Origin is a name of the source the effective label comes from.
+	Origin string `json:"origin,omitempty"`
+	// Override is the label set at runtime, if any.
+	Override *KnownAddress `json:"override,omitempty"`
+}
+
+// SetAddressOverride sets a label of the given account at runtime.
+// The label takes precedence over all sources until it is removed with RemoveAddressOverride.
+func (b *Book) SetAddressOverride(account tongo.AccountID, label KnownAddress) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	label.Address = account.ToRaw()
+	b.overrides[account] = label
+}
+
+// RemoveAddressOverride removes a label set with SetAddressOverride and reports whether it existed.
+func (b *Book) RemoveAddressOverride(account tongo.AccountID) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.overrides[account]
+	delete(b.overrides, account)
+	return ok
+}
+
+// AddressOverrides returns all labels set at runtime.
+func (b *Book) AddressOverrides() map[tongo.AccountID]KnownAddress {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	overrides := make(map[tongo.AccountID]KnownAddress, len(b.overrides))
+	for account, label := range b.overrides {
+		overrides[account] = label
+	}
+	return overrides
+}
+
+// InspectAddress returns the effective label of the given account along with its origin.
+func (b *Book) InspectAddress(account tongo.AccountID) AddressLabel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var result AddressLabel
+	if override, ok := b.overrides[account]; ok {
+		result.Override = &override
+		result.Effective = &override
+		result.Origin = OverrideSourceName
+		return result
+	}
+	if label, ok := b.addresses[account]; ok {
+		result.Effective = &label
+		result.Origin = b.addressOrigins[account]
+	}
+	return result
+}
+
+// AdminHandler returns an http.Handler to inspect and override labels at runtime.
+// It is supposed to be exposed on an internal port only:
+//
+//	GET    <prefix>            lists all overrides
+//	GET    <prefix><account>   returns AddressLabel of the account
+//	PUT    <prefix><account>   sets an override, the body is a KnownAddress in JSON
+//	DELETE <prefix><account>   removes an override
+func (b *Book) AdminHandler(prefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		accountStr := strings.TrimPrefix(r.URL.Path, prefix)
+		if accountStr == "" {
+			if r.Method != http.MethodGet {
+				writeAdminError(w, http.StatusMethodNotAllowed, "method not allowed")
+				return
+			}
+			overrides := b.AddressOverrides()
+			labels := make([]KnownAddress, 0, len(overrides))
+			for _, label := range overrides {
+				labels = append(labels, label)
+			}
+			json.NewEncoder(w).Encode(labels)
+			return
+		}
+		account, err := tongo.ParseAddress(accountStr)
+		if err != nil {
+			writeAdminError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(b.InspectAddress(account.ID))
+		case http.MethodPut:
+			var label KnownAddress
+			if err := json.NewDecoder(r.Body).Decode(&label); err != nil {
+				writeAdminError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if label.Name == "" {
+				writeAdminError(w, http.StatusBadRequest, "name is required")
+				return
+			}
+			b.SetAddressOverride(account.ID, label)
+			json.NewEncoder(w).Encode(b.InspectAddress(account.ID))
+		case http.MethodDelete:
+			if !b.RemoveAddressOverride(account.ID) {
+				writeAdminError(w, http.StatusNotFound, "override not found")
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeAdminError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	})
+}
+
+func writeAdminError(w http.ResponseWriter, code int, msg string) {
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
@@ -0,0 +1,209 @@
+package addressbook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNotModified is returned by Source.Load when nothing has changed since the previous call.
+var ErrNotModified = errors.New("address book source is not modified")
+
+// Snapshot contains everything a Source knows about accounts, jettons and NFT collections.
+type Snapshot struct {
+	Addresses   []KnownAddress    `json:"accounts"`
+	Jettons     []KnownJetton     `json:"jettons"`
+	Collections []KnownCollection `json:"collections"`
+}
+
+// Source provides labels for the address book.
+//
+// Book merges snapshots of all its sources in order,
+// so if two sources describe the same account, the later one wins.
+// Runtime overrides set with Book.SetAddressOverride take precedence over all sources.
+type Source interface {
+	// Name identifies the source in logs and in the admin endpoint.
+	Name() string
+	// Load returns the current snapshot or ErrNotModified if it hasn't changed since the previous call.
+	Load(ctx context.Context) (Snapshot, error)
+}
+
+// RemoteSource downloads JSON lists in the ton-assets format
+// and uses ETags to avoid downloading unchanged lists.
+type RemoteSource struct {
+	name           string
+	client         *http.Client
+	addressPath    string
+	jettonPath     string
+	collectionPath string
+
+	// mu protects lists.
+	mu    sync.Mutex
+	lists map[string]remoteList
+}
+
+// remoteList is the latest downloaded content of a list and its ETag.
+type remoteList struct {
+	etag    string
+	content []byte
+}
+
+var _ Source = (*RemoteSource)(nil)
+
+// NewRemoteSource returns a source downloading accounts, jettons and collections from the given URLs.
+// Any of the URLs can be empty.
+func NewRemoteSource(name, addressPath, jettonPath, collectionPath string) *RemoteSource {
+	return &RemoteSource{
+		name:           name,
+		client:         &http.Client{Timeout: time.Minute},
+		addressPath:    addressPath,
+		jettonPath:     jettonPath,
+		collectionPath: collectionPath,
+		lists:          map[string]remoteList{},
+	}
+}
+
+// NewTonAssetsSource returns a source for the given ton-assets files.
+func NewTonAssetsSource(addressPath, jettonPath, collectionPath string) *RemoteSource {
+	return NewRemoteSource("ton-assets", addressPath, jettonPath, collectionPath)
+}
+
+func (s *RemoteSource) Name() string {
+	return s.name
+}
+
+func (s *RemoteSource) Load(ctx context.Context) (Snapshot, error) {
+	var snapshot Snapshot
+	addressesModified, err := s.download(ctx, s.addressPath, &snapshot.Addresses)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	jettonsModified, err := s.download(ctx, s.jettonPath, &snapshot.Jettons)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	collectionsModified, err := s.download(ctx, s.collectionPath, &snapshot.Collections)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if !addressesModified && !jettonsModified && !collectionsModified {
+		return Snapshot{}, ErrNotModified
+	}
+	return snapshot, nil
+}
+
+// download decodes a list from the given url into dest and reports whether the list has been modified.
+// If the server responds with 304 Not Modified, the previously downloaded content is used.
+func (s *RemoteSource) download(ctx context.Context, url string, dest any) (bool, error) {
+	if url == "" {
+		return false, nil
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	previous, ok := s.lists[url]
+	s.mu.Unlock()
+	if ok && previous.etag != "" {
+		request.Header.Set("If-None-Match", previous.etag)
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotModified && ok {
+		return false, json.Unmarshal(previous.content, dest)
+	}
+	if response.StatusCode >= 300 {
+		return false, fmt.Errorf("invalid status code %v for %v", response.StatusCode, url)
+	}
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return false, err
+	}
+	if err = json.Unmarshal(content, dest); err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	s.lists[url] = remoteList{etag: response.Header.Get("ETag"), content: content}
+	s.mu.Unlock()
+	return !ok || !bytes.Equal(previous.content, content), nil
+}
+
+// FileSource reads a local JSON file containing a Snapshot:
+//
+//	{"accounts": [...], "jettons": [...], "collections": [...]}
+//
+// The file is parsed again only if its modification time or size has changed.
+type FileSource struct {
+	path string
+
+	// mu protects lastModTime and lastSize.
+	mu          sync.Mutex
+	lastModTime time.Time
+	lastSize    int64
+}
+
+var _ Source = (*FileSource)(nil)
+
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+func (s *FileSource) Name() string {
+	return "file:" + s.path
+}
+
+func (s *FileSource) Load(ctx context.Context) (Snapshot, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if info.ModTime().Equal(s.lastModTime) && info.Size() == s.lastSize {
+		return Snapshot{}, ErrNotModified
+	}
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(content, &snapshot); err != nil {
+		return Snapshot{}, fmt.Errorf("failed to decode %v: %w", s.path, err)
+	}
+	s.lastModTime = info.ModTime()
+	s.lastSize = info.Size()
+	return snapshot, nil
+}
+
+// FuncSource adapts a function to the Source interface.
+// It is handy to plug in a database or any other storage:
+// the function is called on every refresh and its result is always considered modified.
+type FuncSource struct {
+	name string
+	fn   func(ctx context.Context) (Snapshot, error)
+}
+
+var _ Source = (*FuncSource)(nil)
+
+func NewFuncSource(name string, fn func(ctx context.Context) (Snapshot, error)) *FuncSource {
+	return &FuncSource{name: name, fn: fn}
+}
+
+func (s *FuncSource) Name() string {
+	return s.name
+}
+
+func (s *FuncSource) Load(ctx context.Context) (Snapshot, error) {
+	return s.fn(ctx)
+}
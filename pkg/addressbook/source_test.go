@@ -0,0 +1,128 @@
+package addressbook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+)
+
+func TestRemoteSource_Load(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests += 1
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`[{"address": "0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351", "name": "Elector"}]`))
+	}))
+	defer server.Close()
+
+	source := NewRemoteSource("test", server.URL, "", "")
+	snapshot, err := source.Load(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 1, len(snapshot.Addresses))
+	require.Equal(t, "Elector", snapshot.Addresses[0].Name)
+
+	_, err = source.Load(context.Background())
+	require.ErrorIs(t, err, ErrNotModified)
+	require.Equal(t, 2, requests)
+}
+
+func TestFileSource_Load(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "labels.json")
+	content := `{"accounts": [{"address": "0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351", "name": "Elector"}]}`
+	require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+
+	source := NewFileSource(path)
+	snapshot, err := source.Load(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 1, len(snapshot.Addresses))
+
+	_, err = source.Load(context.Background())
+	require.ErrorIs(t, err, ErrNotModified)
+}
+
+func newTestBook(sources ...Source) *Book {
+	return &Book{
+		addresses:      map[tongo.AccountID]KnownAddress{},
+		addressOrigins: map[tongo.AccountID]string{},
+		overrides:      map[tongo.AccountID]KnownAddress{},
+		collections:    map[tongo.AccountID]KnownCollection{},
+		jettons:        map[tongo.AccountID]KnownJetton{},
+		tfPools:        map[tongo.AccountID]TFPoolInfo{},
+		sources:        sources,
+		snapshots:      make([]*Snapshot, len(sources)),
+	}
+}
+
+func TestBook_refreshMergesSources(t *testing.T) {
+	account := tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+	snapshot := func(name string) func(ctx context.Context) (Snapshot, error) {
+		return func(ctx context.Context) (Snapshot, error) {
+			return Snapshot{Addresses: []KnownAddress{{Address: account.ToRaw(), Name: name}}}, nil
+		}
+	}
+	book := newTestBook(NewFuncSource("first", snapshot("first")), NewFuncSource("second", snapshot("second")))
+	book.refresh(context.Background(), zap.L())
+
+	label := book.InspectAddress(account)
+	require.Equal(t, "second", label.Origin)
+	require.Equal(t, "second", label.Effective.Name)
+
+	book.SetAddressOverride(account, KnownAddress{Name: "operator"})
+	info, ok := book.GetAddressInfoByAddress(account)
+	require.True(t, ok)
+	require.Equal(t, "operator", info.Name)
+	require.Equal(t, OverrideSourceName, book.InspectAddress(account).Origin)
+
+	require.True(t, book.RemoveAddressOverride(account))
+	info, ok = book.GetAddressInfoByAddress(account)
+	require.True(t, ok)
+	require.Equal(t, "second", info.Name)
+}
+
+func TestBook_AdminHandler(t *testing.T) {
+	book := newTestBook()
+	handler := book.AdminHandler("/admin/addressbook/")
+	const account = "0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351"
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		wantCode int
+	}{
+		{name: "invalid account", method: http.MethodGet, path: "not-an-account", wantCode: http.StatusBadRequest},
+		{name: "no name", method: http.MethodPut, path: account, body: `{}`, wantCode: http.StatusBadRequest},
+		{name: "set override", method: http.MethodPut, path: account, body: `{"name": "operator"}`, wantCode: http.StatusOK},
+		{name: "list overrides", method: http.MethodGet, path: "", wantCode: http.StatusOK},
+		{name: "remove override", method: http.MethodDelete, path: account, wantCode: http.StatusNoContent},
+		{name: "remove missing override", method: http.MethodDelete, path: account, wantCode: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/admin/addressbook/"+tt.path, strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.name == "list overrides" {
+				var labels []KnownAddress
+				require.Nil(t, json.Unmarshal(w.Body.Bytes(), &labels))
+				require.Equal(t, 1, len(labels))
+				require.Equal(t, "operator", labels[0].Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Package analytics samples processed traces and emits anonymized aggregate statistics to a sink.
+//
+// A report contains only counts of action types and of TON values falling into coarse buckets,
+// it never carries accounts, hashes, comments or anything else identifying a user,
+// so operators can build product analytics without exporting user data.
+package analytics
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/bath"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+)
+
+// Report aggregates actions of traces sampled during a period.
+type Report struct {
+	Start      int64   `json:"start"`
+	End        int64   `json:"end"`
+	SampleRate float64 `json:"sample_rate"`
+	// Traces is a number of sampled traces.
+	Traces int `json:"traces"`
+	// Actions counts actions by type.
+	Actions map[bath.ActionType]int `json:"actions"`
+	// FailedActions counts failed actions by type.
+	FailedActions map[bath.ActionType]int `json:"failed_actions"`
+	// TonValues counts actions moving TON by a bucket of the amount, see valueBuckets.
+	TonValues map[string]int `json:"ton_values"`
+}
+
+// Sink receives a report at the end of every period.
+type Sink interface {
+	Emit(ctx context.Context, report Report) error
+}
+
+// valueBuckets are upper bounds of TON amounts, in nanotons, with labels used in Report.TonValues.
+var valueBuckets = []struct {
+	label string
+	below int64
+}{
+	{label: "<1", below: 1_000_000_000},
+	{label: "1-10", below: 10_000_000_000},
+	{label: "10-100", below: 100_000_000_000},
+	{label: "100-1000", below: 1_000_000_000_000},
+	{label: ">=1000", below: math.MaxInt64},
+}
+
+type storage interface {
+	core.InformationSource
+	GetTrace(ctx context.Context, hash tongo.Bits256) (*core.Trace, error)
+}
+
+// Emitter subscribes to traces, samples them and emits a report to its sink every interval.
+type Emitter struct {
+	logger     *zap.Logger
+	storage    storage
+	source     sources.TraceSource
+	sink       Sink
+	sampleRate float64
+	interval   time.Duration
+
+	// mu protects report.
+	mu     sync.Mutex
+	report Report
+}
+
+type Option func(e *Emitter)
+
+// WithSampleRate sets a share of traces to be analyzed, from 0 exclusive to 1 inclusive. The default is 0.01.
+func WithSampleRate(rate float64) Option {
+	return func(e *Emitter) {
+		e.sampleRate = rate
+	}
+}
+
+// WithInterval sets a period covered by a single report. The default is a minute.
+func WithInterval(interval time.Duration) Option {
+	return func(e *Emitter) {
+		e.interval = interval
+	}
+}
+
+func NewEmitter(logger *zap.Logger, storage storage, source sources.TraceSource, sink Sink, opts ...Option) (*Emitter, error) {
+	e := &Emitter{
+		logger:     logger,
+		storage:    storage,
+		source:     source,
+		sink:       sink,
+		sampleRate: 0.01,
+		interval:   time.Minute,
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	if e.sampleRate <= 0 || e.sampleRate > 1 {
+		return nil, fmt.Errorf("sample rate must be in (0, 1], got %v", e.sampleRate)
+	}
+	if e.interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive")
+	}
+	e.report = e.newReport(time.Now())
+	return e, nil
+}
+
+func (e *Emitter) Run(ctx context.Context) {
+	hashCh := make(chan tongo.Bits256, 100)
+	cancelFn := e.source.SubscribeToTraces(ctx, func(eventData []byte) {
+		var event sources.TraceEventData
+		if err := json.Unmarshal(eventData, &event); err != nil {
+			e.logger.Error("json.Unmarshal() failed", zap.Error(err))
+			return
+		}
+		if event.Simulated {
+			return
+		}
+		var hash tongo.Bits256
+		if err := hash.FromHex(event.Hash); err != nil {
+			e.logger.Error("hash.FromHex() failed", zap.Error(err))
+			return
+		}
+		if !sampled(hash, e.sampleRate) {
+			return
+		}
+		select {
+		case hashCh <- hash:
+		default:
+			// analytics is best effort, it must never slow down dispatching of traces.
+		}
+	}, sources.SubscribeToTraceOptions{AllAccounts: true})
+	defer cancelFn()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case hash := <-hashCh:
+			e.analyze(ctx, hash)
+		case now := <-ticker.C:
+			e.flush(ctx, now)
+		}
+	}
+}
+
+// sampled decides by the trace hash, so all replicas of an instance sample the same traces.
+func sampled(hash tongo.Bits256, rate float64) bool {
+	return float64(binary.BigEndian.Uint64(hash[:8]))/float64(math.MaxUint64) < rate
+}
+
+func (e *Emitter) analyze(ctx context.Context, hash tongo.Bits256) {
+	trace, err := e.storage.GetTrace(ctx, hash)
+	if err != nil {
+		e.logger.Debug("failed to get trace", zap.Error(err))
+		return
+	}
+	if trace.InProgress() {
+		return
+	}
+	result, err := bath.FindActions(ctx, trace, bath.WithInformationSource(e.storage))
+	if err != nil {
+		e.logger.Debug("failed to find actions", zap.Error(err))
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.report.add(result.Actions)
+}
+
+func (e *Emitter) flush(ctx context.Context, now time.Time) {
+	e.mu.Lock()
+	report := e.report
+	e.report = e.newReport(now)
+	e.mu.Unlock()
+
+	report.End = now.Unix()
+	ctx, cancel := context.WithTimeout(ctx, e.interval)
+	defer cancel()
+	if err := e.sink.Emit(ctx, report); err != nil {
+		e.logger.Warn("failed to emit analytics report", zap.Error(err))
+	}
+}
+
+func (e *Emitter) newReport(start time.Time) Report {
+	return Report{
+		Start:         start.Unix(),
+		SampleRate:    e.sampleRate,
+		Actions:       map[bath.ActionType]int{},
+		FailedActions: map[bath.ActionType]int{},
+		TonValues:     map[string]int{},
+	}
+}
+
+func (r *Report) add(actions []bath.Action) {
+	r.Traces++
+	for _, action := range actions {
+		r.Actions[action.Type]++
+		if !action.Success {
+			r.FailedActions[action.Type]++
+		}
+		if amount, ok := tonValue(action); ok {
+			r.TonValues[valueBucket(amount)]++
+		}
+	}
+}
+
+// tonValue returns the amount of TON moved by the action, if the action moves TON at all.
+func tonValue(action bath.Action) (int64, bool) {
+	switch {
+	case action.TonTransfer != nil:
+		return action.TonTransfer.Amount, true
+	case action.SmartContractExec != nil:
+		return action.SmartContractExec.TonAttached, true
+	case action.DepositStake != nil:
+		return action.DepositStake.Amount, true
+	case action.WithdrawStake != nil:
+		return action.WithdrawStake.Amount, true
+	case action.ElectionsDepositStake != nil:
+		return action.ElectionsDepositStake.Amount, true
+	case action.ElectionsRecoverStake != nil:
+		return action.ElectionsRecoverStake.Amount, true
+	}
+	return 0, false
+}
+
+func valueBucket(amount int64) string {
+	for _, bucket := range valueBuckets {
+		if amount < bucket.below {
+			return bucket.label
+		}
+	}
+	return valueBuckets[len(valueBuckets)-1].label
+}
@@ -0,0 +1,79 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/bath"
+)
+
+func TestReport_add(t *testing.T) {
+	report := Report{
+		Actions:       map[bath.ActionType]int{},
+		FailedActions: map[bath.ActionType]int{},
+		TonValues:     map[string]int{},
+	}
+	report.add([]bath.Action{
+		{Type: bath.TonTransfer, Success: true, TonTransfer: &bath.TonTransferAction{Amount: 500_000_000}},
+		{Type: bath.TonTransfer, Success: true, TonTransfer: &bath.TonTransferAction{Amount: 5_000_000_000}},
+		{Type: bath.JettonTransfer, Success: false, JettonTransfer: &bath.JettonTransferAction{}},
+	})
+	report.add([]bath.Action{
+		{Type: bath.TonTransfer, Success: true, TonTransfer: &bath.TonTransferAction{Amount: 2_000_000_000_000}},
+	})
+	require.Equal(t, 2, report.Traces)
+	require.Equal(t, map[bath.ActionType]int{bath.TonTransfer: 3, bath.JettonTransfer: 1}, report.Actions)
+	require.Equal(t, map[bath.ActionType]int{bath.JettonTransfer: 1}, report.FailedActions)
+	require.Equal(t, map[string]int{"<1": 1, "1-10": 1, ">=1000": 1}, report.TonValues)
+}
+
+func Test_valueBucket(t *testing.T) {
+	tests := []struct {
+		amount int64
+		want   string
+	}{
+		{amount: 0, want: "<1"},
+		{amount: 999_999_999, want: "<1"},
+		{amount: 1_000_000_000, want: "1-10"},
+		{amount: 99_000_000_000, want: "10-100"},
+		{amount: 100_000_000_000, want: "100-1000"},
+		{amount: 1_000_000_000_000, want: ">=1000"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, valueBucket(tt.amount))
+	}
+}
+
+func Test_sampled(t *testing.T) {
+	var low, high tongo.Bits256
+	low[0] = 0x01
+	high[0] = 0xff
+	require.True(t, sampled(low, 0.01))
+	require.False(t, sampled(high, 0.01))
+	require.True(t, sampled(high, 1))
+}
+
+func TestHTTPSink(t *testing.T) {
+	var got Report
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(zap.L(), server.URL)
+	require.Nil(t, err)
+	report := Report{Start: 1, End: 61, SampleRate: 0.5, Traces: 1, Actions: map[bath.ActionType]int{bath.TonTransfer: 1}}
+	require.Nil(t, sink.Emit(context.Background(), report))
+	require.Equal(t, report, got)
+
+	_, err = NewSink(zap.L(), "kafka://localhost")
+	require.NotNil(t, err)
+}
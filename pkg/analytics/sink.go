@@ -0,0 +1,61 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// NewSink returns a sink described by the given string:
+// "log" writes reports to the log, an http(s) URL receives reports as JSON in POST requests.
+func NewSink(logger *zap.Logger, sink string) (Sink, error) {
+	switch {
+	case sink == "log":
+		return &LogSink{logger: logger}, nil
+	case strings.HasPrefix(sink, "http://"), strings.HasPrefix(sink, "https://"):
+		return &HTTPSink{url: sink, client: http.DefaultClient}, nil
+	}
+	return nil, fmt.Errorf("unknown analytics sink %q", sink)
+}
+
+// LogSink writes reports to the log.
+type LogSink struct {
+	logger *zap.Logger
+}
+
+func (s *LogSink) Emit(ctx context.Context, report Report) error {
+	s.logger.Info("analytics report", zap.Any("report", report))
+	return nil
+}
+
+// HTTPSink posts reports as JSON to a URL.
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *HTTPSink) Emit(ctx context.Context, report Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("analytics sink responded with %v", response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+// Package annotations keeps private annotations of events.
+//
+// Treasury tooling classifies transactions with tags, a category and a note,
+// annotations are stored per tenant and are returned along with events to the tenant that attached them only.
+// Annotations are kept in a local JSON file or in a repository, so they survive restarts.
+package annotations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+const (
+	maxTags           = 16
+	maxTagLength      = 64
+	maxCategoryLength = 64
+	maxNoteLength     = 4096
+
+	// Collection is a name of the repository collection with annotations.
+	Collection = "annotations"
+	// repositoryTimeout limits a single change written to a repository.
+	repositoryTimeout = 5 * time.Second
+)
+
+// ErrInvalidAnnotation is returned by Store.Set when an annotation can't be stored as is.
+var ErrInvalidAnnotation = errors.New("invalid annotation")
+
+// Annotation is a private classification of an event.
+type Annotation struct {
+	// Tenant is a name of a tenant owning the annotation, empty for annotations attached by an operator.
+	Tenant    string        `json:"tenant,omitempty"`
+	EventID   tongo.Bits256 `json:"event_id"`
+	Tags      []string      `json:"tags,omitempty"`
+	Category  string        `json:"category,omitempty"`
+	Note      string        `json:"note,omitempty"`
+	UpdatedAt int64         `json:"updated_at"`
+}
+
+type key struct {
+	tenant  string
+	eventID tongo.Bits256
+}
+
+func (k key) String() string {
+	return k.tenant + "/" + k.eventID.Hex()
+}
+
+// Store keeps annotations in memory and persists every change to a file or to a repository.
+type Store struct {
+	// path is a file with annotations, an empty path keeps annotations in memory only.
+	path string
+	// repo, if set, is used instead of the file.
+	repo repository.Repository
+
+	// mu protects annotations and writes to the file.
+	mu          sync.RWMutex
+	annotations map[key]Annotation
+	// generation is incremented by every change, so Reload can tell its records are outdated.
+	generation uint64
+}
+
+// NewStore returns a store backed by the given file, the file is created with the first change.
+func NewStore(path string) (*Store, error) {
+	s := &Store{path: path, annotations: map[key]Annotation{}}
+	if path == "" {
+		return s, nil
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var annotations []Annotation
+	if err := json.Unmarshal(content, &annotations); err != nil {
+		return nil, fmt.Errorf("failed to decode %v: %w", path, err)
+	}
+	for _, a := range annotations {
+		s.annotations[key{tenant: a.Tenant, eventID: a.EventID}] = a
+	}
+	return s, nil
+}
+
+// NewRepositoryStore returns a store backed by the repository.
+func NewRepositoryStore(ctx context.Context, repo repository.Repository) (*Store, error) {
+	s := &Store{repo: repo, annotations: map[key]Annotation{}}
+	if err := s.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload replaces annotations with the ones from the repository, so changes made by other replicas become visible.
+// It does nothing for a store backed by a file.
+// If the store is changed while the repository is being read, the records are discarded
+// as they might miss the change, the next reload picks it up.
+func (s *Store) Reload(ctx context.Context) error {
+	if s.repo == nil {
+		return nil
+	}
+	s.mu.RLock()
+	generation := s.generation
+	s.mu.RUnlock()
+	records, err := s.repo.List(ctx, Collection)
+	if err != nil {
+		return err
+	}
+	annotations := make(map[key]Annotation, len(records))
+	for _, record := range records {
+		var a Annotation
+		if err := json.Unmarshal(record.Value, &a); err != nil {
+			return fmt.Errorf("failed to decode annotation %v: %w", record.Key, err)
+		}
+		annotations[key{tenant: a.Tenant, eventID: a.EventID}] = a
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation != generation {
+		return nil
+	}
+	s.annotations = annotations
+	return nil
+}
+
+// Get returns an annotation the tenant has attached to the event.
+func (s *Store) Get(tenant string, eventID tongo.Bits256) (Annotation, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	a, ok := s.annotations[key{tenant: tenant, eventID: eventID}]
+	return a, ok
+}
+
+// Set attaches an annotation of the tenant to the event replacing the previous one.
+// Tags are trimmed, deduplicated and sorted.
+func (s *Store) Set(tenant string, eventID tongo.Bits256, tags []string, category, note string) (Annotation, error) {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return Annotation{}, err
+	}
+	category = strings.TrimSpace(category)
+	if len(category) > maxCategoryLength {
+		return Annotation{}, fmt.Errorf("%w: category is longer than %v bytes", ErrInvalidAnnotation, maxCategoryLength)
+	}
+	if len(note) > maxNoteLength {
+		return Annotation{}, fmt.Errorf("%w: note is longer than %v bytes", ErrInvalidAnnotation, maxNoteLength)
+	}
+	if len(tags) == 0 && category == "" && note == "" {
+		return Annotation{}, fmt.Errorf("%w: tags, category or note is required", ErrInvalidAnnotation)
+	}
+	a := Annotation{
+		Tenant:    tenant,
+		EventID:   eventID,
+		Tags:      tags,
+		Category:  category,
+		Note:      note,
+		UpdatedAt: time.Now().Unix(),
+	}
+	k := key{tenant: tenant, eventID: eventID}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation++
+	previous, existed := s.annotations[k]
+	s.annotations[k] = a
+	if err := s.persist(k, &a); err != nil {
+		if existed {
+			s.annotations[k] = previous
+		} else {
+			delete(s.annotations, k)
+		}
+		return Annotation{}, err
+	}
+	return a, nil
+}
+
+// Delete removes an annotation the tenant has attached to the event and reports whether it existed.
+func (s *Store) Delete(tenant string, eventID tongo.Bits256) (bool, error) {
+	k := key{tenant: tenant, eventID: eventID}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.annotations[k]
+	if !ok {
+		return false, nil
+	}
+	s.generation++
+	delete(s.annotations, k)
+	if err := s.persist(k, nil); err != nil {
+		s.annotations[k] = a
+		return false, err
+	}
+	return true, nil
+}
+
+func normalizeTags(tags []string) ([]string, error) {
+	if len(tags) > maxTags {
+		return nil, fmt.Errorf("%w: more than %v tags", ErrInvalidAnnotation, maxTags)
+	}
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if len(tag) > maxTagLength {
+			return nil, fmt.Errorf("%w: tag is longer than %v bytes", ErrInvalidAnnotation, maxTagLength)
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (s *Store) list() []Annotation {
+	annotations := make([]Annotation, 0, len(s.annotations))
+	for _, a := range s.annotations {
+		annotations = append(annotations, a)
+	}
+	sort.Slice(annotations, func(i, j int) bool {
+		if annotations[i].Tenant != annotations[j].Tenant {
+			return annotations[i].Tenant < annotations[j].Tenant
+		}
+		return annotations[i].EventID.Hex() < annotations[j].EventID.Hex()
+	})
+	return annotations
+}
+
+// persist stores a change of the annotation, a nil annotation means it has been deleted.
+func (s *Store) persist(k key, a *Annotation) error {
+	if s.repo == nil {
+		return s.save()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), repositoryTimeout)
+	defer cancel()
+	if a == nil {
+		return s.repo.Delete(ctx, Collection, k.String())
+	}
+	value, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	return s.repo.Put(ctx, Collection, repository.Record{Key: k.String(), Value: value})
+}
+
+// save writes annotations to a temporary file and renames it, so the file is never left half-written.
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(s.list(), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
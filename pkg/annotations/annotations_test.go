@@ -0,0 +1,130 @@
+package annotations
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+func TestStore(t *testing.T) {
+	event := tongo.MustParseHash("55e8809519cd3c49098c9ee45afdafcea7a894a74d0f628d94a115a50e045122")
+	path := filepath.Join(t.TempDir(), "annotations.json")
+
+	store, err := NewStore(path)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		tags     []string
+		category string
+		note     string
+	}{
+		{name: "empty", tags: []string{" "}},
+		{name: "too many tags", tags: strings.Split(strings.Repeat("x,", maxTags+1), ",")},
+		{name: "long tag", tags: []string{strings.Repeat("x", maxTagLength+1)}},
+		{name: "long category", category: strings.Repeat("x", maxCategoryLength+1)},
+		{name: "long note", note: strings.Repeat("x", maxNoteLength+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := store.Set("acme", event, tt.tags, tt.category, tt.note)
+			require.ErrorIs(t, err, ErrInvalidAnnotation)
+		})
+	}
+
+	a, err := store.Set("acme", event, []string{"payroll", " ops ", "payroll"}, "expense", "March salaries")
+	require.Nil(t, err)
+	require.Equal(t, []string{"ops", "payroll"}, a.Tags)
+	_, err = store.Set("", event, nil, "", "operator's note")
+	require.Nil(t, err)
+
+	// annotations survive a restart and are isolated by tenants.
+	store, err = NewStore(path)
+	require.Nil(t, err)
+	a, ok := store.Get("acme", event)
+	require.True(t, ok)
+	require.Equal(t, "expense", a.Category)
+	a, ok = store.Get("", event)
+	require.True(t, ok)
+	require.Equal(t, "operator's note", a.Note)
+	_, ok = store.Get("other", event)
+	require.False(t, ok)
+
+	deleted, err := store.Delete("acme", event)
+	require.Nil(t, err)
+	require.True(t, deleted)
+	deleted, err = store.Delete("acme", event)
+	require.Nil(t, err)
+	require.False(t, deleted)
+
+	store, err = NewStore(path)
+	require.Nil(t, err)
+	_, ok = store.Get("acme", event)
+	require.False(t, ok)
+	_, ok = store.Get("", event)
+	require.True(t, ok)
+}
+
+func TestRepositoryStore(t *testing.T) {
+	event := tongo.MustParseHash("55e8809519cd3c49098c9ee45afdafcea7a894a74d0f628d94a115a50e045122")
+	repo := repository.NewMemory()
+
+	store, err := NewRepositoryStore(context.Background(), repo)
+	require.Nil(t, err)
+	_, err = store.Set("acme", event, []string{"payroll"}, "", "")
+	require.Nil(t, err)
+
+	// another replica sees the annotation after a reload.
+	replica, err := NewRepositoryStore(context.Background(), repo)
+	require.Nil(t, err)
+	a, ok := replica.Get("acme", event)
+	require.True(t, ok)
+	require.Equal(t, []string{"payroll"}, a.Tags)
+
+	deleted, err := store.Delete("acme", event)
+	require.Nil(t, err)
+	require.True(t, deleted)
+	require.Nil(t, replica.Reload(context.Background()))
+	_, ok = replica.Get("acme", event)
+	require.False(t, ok)
+}
+
+// changingRepository runs onList after reading records, like a change made while a reload is in flight.
+type changingRepository struct {
+	repository.Repository
+	onList func()
+}
+
+func (r *changingRepository) List(ctx context.Context, collection string) ([]repository.Record, error) {
+	records, err := r.Repository.List(ctx, Collection)
+	if r.onList != nil {
+		r.onList()
+	}
+	return records, err
+}
+
+func TestStore_Reload_concurrentChange(t *testing.T) {
+	event := tongo.MustParseHash("55e8809519cd3c49098c9ee45afdafcea7a894a74d0f628d94a115a50e045122")
+	repo := &changingRepository{Repository: repository.NewMemory()}
+	store, err := NewRepositoryStore(context.Background(), repo)
+	require.Nil(t, err)
+
+	repo.onList = func() {
+		_, err := store.Set("acme", event, []string{"payroll"}, "", "")
+		require.Nil(t, err)
+	}
+	require.Nil(t, store.Reload(context.Background()))
+	_, ok := store.Get("acme", event)
+	require.True(t, ok)
+
+	repo.onList = nil
+	require.Nil(t, store.Reload(context.Background()))
+	_, ok = store.Get("acme", event)
+	require.True(t, ok)
+}
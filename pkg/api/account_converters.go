@@ -83,6 +83,15 @@ func convertToAccount(account *core.Account, ab *addressbook.KnownAddress, state
 	for i, iface := range account.Interfaces {
 		acc.Interfaces[i] = iface.String()
 	}
+	if len(account.ExtraBalances) > 0 {
+		acc.ExtraBalance = make([]oas.ExtraCurrency, 0, len(account.ExtraBalances))
+		for id, value := range account.ExtraBalances {
+			acc.ExtraBalance = append(acc.ExtraBalance, oas.ExtraCurrency{ID: int64(id), Value: value.String()})
+		}
+		sort.Slice(acc.ExtraBalance, func(i, j int) bool {
+			return acc.ExtraBalance[i].ID < acc.ExtraBalance[j].ID
+		})
+	}
 	if state.CheckIsSuspended(account.AccountAddress) {
 		acc.IsSuspended.SetTo(true)
 	}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/bath"
+	"github.com/tonkeeper/opentonapi/pkg/cache"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// exportPageSize is a number of transactions loaded from the storage at once while exporting an account.
+const exportPageSize = 100
+
+// exportSnapshotTTL defines how long an export snapshot lives after its creation.
+const exportSnapshotTTL = time.Hour
+
+// exportSnapshot pins an export of an account to the state of the account at a masterchain block,
+// so a long export yields a consistent set of transactions even if new ones arrive in the meantime.
+type exportSnapshot struct {
+	ID               string `json:"snapshot"`
+	Account          string `json:"account"`
+	MasterchainSeqno uint32 `json:"masterchain_seqno"`
+	// Lt is the lt of the last transaction of the account included in the snapshot.
+	Lt        uint64    `json:"lt"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// exportRecord is a single line of an account export.
+// A trace and its event are attached to the earliest transaction of the account in the trace,
+// so every trace is exported once even if the export is resumed in the middle of it.
+type exportRecord struct {
+	// Cursor resumes the export right after this record.
+	Cursor      string          `json:"cursor"`
+	Transaction json.RawMessage `json:"transaction"`
+	Trace       json.RawMessage `json:"trace,omitempty"`
+	Event       json.RawMessage `json:"event,omitempty"`
+}
+
+// ExportHandler returns an http.Handler streaming all indexed data of an account as newline-delimited JSON.
+// It is supposed to be exposed on an internal port only and behind AdminOnly:
+//
+//	GET   <prefix><account>?cursor=<cursor>&snapshot=<snapshot>
+//	POST  <prefix><account> creates a snapshot of the account
+//
+// Every line is an exportRecord, the earliest transactions go first.
+// Pass the cursor of the last received line to resume an interrupted export.
+// The export stops before a transaction whose trace is still in progress, so it can be resumed later.
+// An export with a snapshot stops at the last transaction of the account at the moment the snapshot was created,
+// a snapshot expires in an hour.
+func (h *Handler) ExportHandler(prefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodPost {
+			errcode.Write(w, http.StatusMethodNotAllowed, errcode.BadRequest, "method not allowed")
+			return
+		}
+		account, err := tongo.ParseAddress(strings.TrimPrefix(r.URL.Path, prefix))
+		if err != nil {
+			errcode.Write(w, http.StatusBadRequest, errcode.BadRequest, err.Error())
+			return
+		}
+		if r.Method == http.MethodPost {
+			h.createExportSnapshot(r.Context(), w, account.ID)
+			return
+		}
+		var snapshot *exportSnapshot
+		if id := r.URL.Query().Get("snapshot"); id != "" {
+			pinned, ok := h.exportSnapshots.Get(id)
+			if !ok || pinned.Account != account.ID.ToRaw() {
+				errcode.Write(w, http.StatusNotFound, errcode.EntityNotFound, "snapshot not found or expired")
+				return
+			}
+			snapshot = &pinned
+		}
+		var cursor uint64
+		if value := r.URL.Query().Get("cursor"); value != "" {
+			if cursor, err = strconv.ParseUint(value, 10, 64); err != nil {
+				errcode.Write(w, http.StatusBadRequest, errcode.BadRequest, "invalid cursor")
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		if err := h.exportAccount(r.Context(), w, account.ID, cursor, snapshot); err != nil {
+			// the status has been sent already, the client resumes from the cursor of the last line.
+			h.logger.Warn("account export failed", zap.String("account", account.ID.ToRaw()), zap.Error(err))
+		}
+	})
+}
+
+func (h *Handler) createExportSnapshot(ctx context.Context, w http.ResponseWriter, account tongo.AccountID) {
+	// the account state goes first, so the snapshot doesn't include transactions after the masterchain block.
+	state, err := h.storage.GetRawAccount(ctx, account)
+	if err != nil {
+		errcode.Write(w, http.StatusInternalServerError, errcode.Of(http.StatusInternalServerError, err), err.Error())
+		return
+	}
+	header, err := h.storage.LastMasterchainBlockHeader(ctx)
+	if err != nil {
+		errcode.Write(w, http.StatusInternalServerError, errcode.Of(http.StatusInternalServerError, err), err.Error())
+		return
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		errcode.Write(w, http.StatusInternalServerError, errcode.Internal, err.Error())
+		return
+	}
+	snapshot := exportSnapshot{
+		ID:               hex.EncodeToString(id),
+		Account:          account.ToRaw(),
+		MasterchainSeqno: header.Seqno,
+		Lt:               state.LastTransactionLt,
+		ExpiresAt:        time.Now().Add(exportSnapshotTTL),
+	}
+	h.exportSnapshots.Set(snapshot.ID, snapshot, cache.WithExpiration(exportSnapshotTTL))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(snapshot)
+}
+
+// exportAccount writes transactions of the account after the cursor up to the end of the snapshot, if any.
+func (h *Handler) exportAccount(ctx context.Context, w http.ResponseWriter, account tongo.AccountID, cursor uint64, snapshot *exportSnapshot) error {
+	encoder := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+	for {
+		txs, err := h.storage.IndexedAccountTransactions(ctx, account, cursor, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, tx := range txs {
+			if snapshot != nil && tx.Lt > snapshot.Lt {
+				return nil
+			}
+			record, complete, err := h.exportTransaction(ctx, account, tx)
+			if err != nil {
+				return err
+			}
+			if !complete {
+				return nil
+			}
+			if err := encoder.Encode(record); err != nil {
+				return err
+			}
+			cursor = tx.Lt
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if len(txs) < exportPageSize {
+			return nil
+		}
+	}
+}
+
+// exportTransaction converts the transaction to an exportRecord,
+// it reports false if the trace of the transaction is still in progress.
+func (h *Handler) exportTransaction(ctx context.Context, account tongo.AccountID, tx *core.Transaction) (exportRecord, bool, error) {
+	transaction := convertTransaction(*tx, nil, h.addressBook)
+	h.linkBounces(ctx, &transaction, tx)
+	record := exportRecord{Cursor: strconv.FormatUint(tx.Lt, 10)}
+	var err error
+	if record.Transaction, err = transaction.MarshalJSON(); err != nil {
+		return exportRecord{}, false, err
+	}
+	trace, err := h.storage.GetTrace(ctx, tx.Hash)
+	if errors.Is(err, core.ErrTraceIsTooLong) {
+		return record, true, nil
+	}
+	if err != nil {
+		return exportRecord{}, false, err
+	}
+	if trace.InProgress() {
+		return exportRecord{}, false, nil
+	}
+	if earliestAccountLt(trace, account) != tx.Lt {
+		return record, true, nil
+	}
+	convertedTrace := convertTrace(trace, h.addressBook)
+	if record.Trace, err = convertedTrace.MarshalJSON(); err != nil {
+		return exportRecord{}, false, err
+	}
+	result, err := bath.FindActions(ctx, trace, bath.ForAccount(account), bath.WithInformationSource(h.storage))
+	if err != nil {
+		return exportRecord{}, false, err
+	}
+	event, err := h.toAccountEvent(ctx, account, trace, result, oas.OptString{}, false)
+	if err != nil {
+		return exportRecord{}, false, err
+	}
+	if record.Event, err = event.MarshalJSON(); err != nil {
+		return exportRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// earliestAccountLt returns lt of the earliest transaction of the account in the trace.
+func earliestAccountLt(trace *core.Trace, account tongo.AccountID) uint64 {
+	var lt uint64
+	for _, node := range core.FindByAccount(trace, account) {
+		if lt == 0 || node.Lt < lt {
+			lt = node.Lt
+		}
+	}
+	return lt
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/cache"
+	pkgTesting "github.com/tonkeeper/opentonapi/pkg/testing"
+)
+
+func Test_earliestAccountLt(t *testing.T) {
+	wallet := tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	contract := tongo.MustParseAccountID("0:da6b1b6663a0e4d18cc8574ccd9db5296e367dd9324706f3bbd9eb1cd2caf0bf")
+	trace := pkgTesting.NewTrace(wallet, pkgTesting.WithLt(10), pkgTesting.WithChildren(
+		pkgTesting.NewTrace(contract, pkgTesting.WithLt(12), pkgTesting.WithChildren(
+			pkgTesting.NewTrace(wallet, pkgTesting.WithLt(14)),
+		)),
+	))
+	require.Equal(t, uint64(10), earliestAccountLt(trace, wallet))
+	require.Equal(t, uint64(12), earliestAccountLt(trace, contract))
+	require.Equal(t, uint64(0), earliestAccountLt(trace, tongo.AccountID{}))
+}
+
+func TestHandler_ExportHandler_badRequests(t *testing.T) {
+	handler := (&Handler{}).ExportHandler("/admin/export/")
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+	}{
+		{name: "method", method: http.MethodPut, path: "/admin/export/0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb", want: http.StatusMethodNotAllowed},
+		{name: "account", method: http.MethodGet, path: "/admin/export/abc", want: http.StatusBadRequest},
+		{name: "cursor", method: http.MethodGet, path: "/admin/export/0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb?cursor=x", want: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			require.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestHandler_ExportHandler_snapshots(t *testing.T) {
+	h := &Handler{exportSnapshots: cache.NewLRUCache[string, exportSnapshot](10, "test_export_snapshots")}
+	h.exportSnapshots.Set("pinned", exportSnapshot{
+		ID:      "pinned",
+		Account: "0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb",
+		Lt:      10,
+	})
+	handler := h.ExportHandler("/admin/export/")
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "unknown snapshot", path: "/admin/export/0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb?snapshot=expired"},
+		{name: "snapshot of another account", path: "/admin/export/0:da6b1b6663a0e4d18cc8574ccd9db5296e367dd9324706f3bbd9eb1cd2caf0bf?snapshot=pinned"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			require.Equal(t, http.StatusNotFound, rec.Code)
+			require.JSONEq(t, `{"error":"snapshot not found or expired","error_code":"entity_not_found"}`, rec.Body.String())
+		})
+	}
+}
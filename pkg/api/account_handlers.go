@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"strings"
@@ -19,6 +20,9 @@ import (
 	"github.com/tonkeeper/opentonapi/pkg/addressbook"
 	"github.com/tonkeeper/opentonapi/pkg/core"
 	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/abi"
 	"github.com/tonkeeper/tongo/boc"
@@ -57,8 +61,9 @@ func (h *Handler) GetAccount(ctx context.Context, params oas.GetAccountParams) (
 	rawAccount, err := h.storage.GetRawAccount(ctx, account.ID)
 	if errors.Is(err, core.ErrEntityNotFound) {
 		return &oas.Account{
-			Address: account.ID.ToRaw(),
-			Status:  oas.AccountStatusNonexist,
+			Address:      account.ID.ToRaw(),
+			Status:       oas.AccountStatusNonexist,
+			PrivateLabel: h.privateLabel(ctx, account.ID),
 		}, nil
 	}
 	if err != nil {
@@ -71,6 +76,7 @@ func (h *Handler) GetAccount(ctx context.Context, params oas.GetAccountParams) (
 	} else {
 		res = convertToAccount(rawAccount, nil, h.state)
 	}
+	res.PrivateLabel = h.privateLabel(ctx, account.ID)
 	return &res, nil
 }
 
@@ -99,9 +105,31 @@ func (h *Handler) GetAccounts(ctx context.Context, request oas.OptGetAccountsReq
 		ids = append(ids, account.ID)
 		allAccountIDs[account.ID] = struct{}{}
 	}
-	accounts, err := h.storage.GetRawAccounts(ctx, ids)
-	if err != nil {
-		return nil, toError(http.StatusInternalServerError, err)
+	resp := &oas.Accounts{}
+	var accounts []*core.Account
+	if params.Partial.Value == oas.GetAccountsPartialAllow {
+		fetched := make([]*core.Account, len(ids))
+		errs := fetchSections(ctx, len(ids), h.limits.sectionTimeout(), func(ctx context.Context, i int) error {
+			account, err := h.storage.GetRawAccount(ctx, ids[i])
+			fetched[i] = account
+			return err
+		})
+		for i, err := range errs {
+			if err != nil {
+				// the account is neither returned nor reported as nonexistent.
+				delete(allAccountIDs, ids[i])
+				resp.Errors = append(resp.Errors, toPartialError(ids[i].ToRaw(), err))
+				continue
+			}
+			accounts = append(accounts, fetched[i])
+		}
+		resp.Partial.SetTo(len(resp.Errors) > 0)
+	} else {
+		var err error
+		accounts, err = h.storage.GetRawAccounts(ctx, ids)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
 	}
 	results := make(map[ton.AccountID]oas.Account, len(accounts))
 	for _, account := range accounts {
@@ -113,20 +141,24 @@ func (h *Handler) GetAccounts(ctx context.Context, request oas.OptGetAccountsReq
 		} else {
 			res = convertToAccount(account, nil, h.state)
 		}
+		res.PrivateLabel = h.privateLabel(ctx, account.AccountAddress)
 		results[account.AccountAddress] = res
 	}
 	// if we don't find an account, we return it with "nonexist" status
 	for accountID := range allAccountIDs {
 		account := oas.Account{
-			Address:  accountID.ToRaw(),
-			Status:   oas.AccountStatusNonexist,
-			IsWallet: true,
+			Address:      accountID.ToRaw(),
+			Status:       oas.AccountStatusNonexist,
+			IsWallet:     true,
+			PrivateLabel: h.privateLabel(ctx, accountID),
 		}
 		results[accountID] = account
 	}
-	resp := &oas.Accounts{}
 	for _, i := range ids {
-		account := results[i]
+		account, ok := results[i]
+		if !ok {
+			continue
+		}
 		if currencyPrice != 0 {
 			convertedAmount := float64(account.Balance/int64(ton.OneTON)) / currencyPrice
 			currenciesBalance := map[string]jx.Raw{currency: jx.Raw(fmt.Sprintf("%f", convertedAmount))}
@@ -166,10 +198,91 @@ func (h *Handler) GetBlockchainAccountTransactions(ctx context.Context, params o
 	}
 	for i, tx := range txs {
 		result.Transactions[i] = convertTransaction(*tx, accountObject.Interfaces, h.addressBook)
+		h.linkBounces(ctx, &result.Transactions[i], tx)
 	}
 	return &result, nil
 }
 
+// maxLogsScannedTransactions limits a number of transactions scanned by a single GetBlockchainAccountLogs request,
+// so a request to an account emitting logs rarely doesn't walk through its whole history.
+const maxLogsScannedTransactions = 1000
+
+// logsPageSize is a number of transactions GetBlockchainAccountLogs reads from the index at once.
+const logsPageSize = 100
+
+func (h *Handler) GetBlockchainAccountLogs(ctx context.Context, params oas.GetBlockchainAccountLogsParams) (*oas.AccountLogs, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	var operation string
+	if params.Operation.IsSet() {
+		operation, err = sources.ParseOperation(params.Operation.Value)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+	}
+	if t, ok := tenant.FromContext(ctx); ok && !t.Watches(account.ID) {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("account is not tracked"))
+	}
+	limit := int(params.Limit.Or(100))
+	beforeLt := uint64(params.BeforeLt.Value)
+	if beforeLt == 0 {
+		beforeLt = math.MaxUint64
+	}
+	result := oas.AccountLogs{Logs: []oas.AccountLog{}}
+	// logs of a transaction aren't split between pages, so a page can contain slightly more logs than the limit.
+	for scanned := 0; scanned < maxLogsScannedTransactions && len(result.Logs) < limit; {
+		txs, err := h.storage.IndexedAccountTransactionsBefore(ctx, account.ID, beforeLt, logsPageSize)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		for i, tx := range txs {
+			for _, msg := range tx.OutMsgs {
+				if msg.MsgType != core.ExtOutMsg || !matchOperation(msg, operation) {
+					continue
+				}
+				entry := oas.AccountLog{
+					TxHash:  tx.Hash.Hex(),
+					Lt:      int64(tx.Lt),
+					Utime:   tx.Utime,
+					Message: convertMessage(msg, h.addressBook),
+				}
+				if msg.DestinationExtern != nil {
+					entry.Destination = oas.NewOptString(msg.DestinationExtern.ToFiftHex())
+				}
+				result.Logs = append(result.Logs, entry)
+			}
+			beforeLt = tx.Lt
+			scanned++
+			if i == len(txs)-1 && len(txs) < logsPageSize {
+				// the history is over.
+				return &result, nil
+			}
+			if len(result.Logs) >= limit || scanned >= maxLogsScannedTransactions {
+				break
+			}
+		}
+		if len(txs) == 0 {
+			return &result, nil
+		}
+	}
+	result.NextFrom = int64(beforeLt)
+	return &result, nil
+}
+
+// matchOperation checks if the message carries the operation returned by sources.ParseOperation,
+// an empty operation matches any message.
+func matchOperation(msg core.Message, operation string) bool {
+	if operation == "" {
+		return true
+	}
+	if msg.DecodedBody != nil && msg.DecodedBody.Operation == operation {
+		return true
+	}
+	return msg.OpCode != nil && fmt.Sprintf("0x%08x", *msg.OpCode) == operation
+}
+
 func getMethodCacheKey(accountID ton.AccountID, methodName string, lt uint64, args []string) (string, error) {
 	d := xxhash.New()
 	var x [8]byte
@@ -204,8 +317,11 @@ func (h *Handler) ExecGetMethodForBlockchainAccount(ctx context.Context, params
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
 	}
-	if result, ok := h.getMethodsCache.Get(key); ok {
-		return result, nil
+	started := time.Now()
+	cached, ok := h.getMethodsCache.Get(key)
+	slowlog.RecordKind(ctx, slowlog.KindCache, "get_methods_cache", time.Since(started))
+	if ok {
+		return cached, nil
 	}
 	stack := make([]tlb.VmStackValue, 0, len(params.Args))
 	for _, p := range params.Args {
@@ -440,6 +556,149 @@ func (h *Handler) GetAccountDiff(ctx context.Context, params oas.GetAccountDiffP
 	return &oas.GetAccountDiffOK{BalanceChange: balanceChange}, nil
 }
 
+func (h *Handler) GetAccountStats(ctx context.Context, params oas.GetAccountStatsParams) (*oas.AccountStats, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	window := params.Window.Or(oas.GetAccountStatsWindowAll)
+	var since int64
+	switch window {
+	case oas.GetAccountStatsWindow1d:
+		since = time.Now().Add(-24 * time.Hour).Unix()
+	case oas.GetAccountStatsWindow7d:
+		since = time.Now().Add(-7 * 24 * time.Hour).Unix()
+	case oas.GetAccountStatsWindow30d:
+		since = time.Now().Add(-30 * 24 * time.Hour).Unix()
+	}
+	if t, ok := tenant.FromContext(ctx); ok && !t.Watches(account.ID) {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("account is not tracked"))
+	}
+	stats, err := h.storage.GetAccountStats(ctx, account.ID, since)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := oas.AccountStats{
+		Window:            string(window),
+		TransactionsCount: stats.TransactionsCount,
+		Received:          stats.Received,
+		Sent:              stats.Sent,
+		Fees:              stats.Fees,
+	}
+	if stats.TransactionsCount > 0 {
+		result.FirstActivity = oas.NewOptInt64(stats.FirstActivity)
+		result.LastActivity = oas.NewOptInt64(stats.LastActivity)
+	}
+	return &result, nil
+}
+
+// maxHeatmapBuckets limits a range of an activity heatmap: a month of hourly buckets or a year of daily ones.
+var maxHeatmapBuckets = map[oas.GetAccountActivityHeatmapInterval]int64{
+	oas.GetAccountActivityHeatmapIntervalHour: 31 * 24,
+	oas.GetAccountActivityHeatmapIntervalDay:  366,
+}
+
+func (h *Handler) GetAccountActivityHeatmap(ctx context.Context, params oas.GetAccountActivityHeatmapParams) (*oas.AccountActivityHeatmap, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	interval := params.Interval.Or(oas.GetAccountActivityHeatmapIntervalDay)
+	size, defaultRange := core.DailyBuckets, int64(365)
+	if interval == oas.GetAccountActivityHeatmapIntervalHour {
+		size, defaultRange = core.HourlyBuckets, 7*24
+	}
+	to := params.EndDate.Or(time.Now().Unix())
+	from := params.StartDate.Or(to - defaultRange*size)
+	if from >= to {
+		return nil, toError(http.StatusBadRequest, fmt.Errorf("start_date must be before end_date"))
+	}
+	// there is no activity before the epoch, and buckets start at from aligned down to the size.
+	from = max(from, 0)
+	from -= from % size
+	if buckets := (to - from + size - 1) / size; buckets > maxHeatmapBuckets[interval] {
+		return nil, toError(http.StatusBadRequest, fmt.Errorf("the range is limited to %v %v buckets, got %v", maxHeatmapBuckets[interval], interval, buckets))
+	}
+	if t, ok := tenant.FromContext(ctx); ok && !t.Watches(account.ID) {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("account is not tracked"))
+	}
+	buckets, err := h.storage.GetAccountActivityHeatmap(ctx, account.ID, from, to, size)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := oas.AccountActivityHeatmap{
+		Interval: string(interval),
+		Buckets:  make([]oas.AccountActivityHeatmapBucketsItem, 0, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		result.Buckets = append(result.Buckets, oas.AccountActivityHeatmapBucketsItem{
+			Time:         bucket.Start,
+			Transactions: bucket.Transactions,
+		})
+	}
+	return &result, nil
+}
+
+func (h *Handler) GetTopAccounts(ctx context.Context, params oas.GetTopAccountsParams) (*oas.GetTopAccountsOK, error) {
+	leaderboard, err := h.storage.GetLeaderboard(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	entries := leaderboard.Entries
+	if t, ok := tenant.FromContext(ctx); ok {
+		// a tenant sees only its own accounts.
+		entries = make([]core.LeaderboardEntry, 0, len(leaderboard.Entries))
+		for _, entry := range leaderboard.Entries {
+			if t.Watches(entry.Account) {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	entries = rankLeaderboard(entries, params.Order.Or(oas.GetTopAccountsOrderBalance), params.ExcludeLabeled.Or(false), h.addressBook)
+	result := oas.GetTopAccountsOK{
+		UpdatedAt: leaderboard.UpdatedAt,
+		Total:     len(entries),
+		Accounts:  []oas.GetTopAccountsOKAccountsItem{},
+	}
+	offset := int(params.Offset.Or(0))
+	limit := int(params.Limit.Or(100))
+	if offset >= len(entries) {
+		return &result, nil
+	}
+	entries = entries[offset:]
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	for _, entry := range entries {
+		result.Accounts = append(result.Accounts, oas.GetTopAccountsOKAccountsItem{
+			Account:        convertAccountAddress(entry.Account, h.addressBook),
+			Balance:        entry.Balance,
+			Transactions7d: entry.Transactions7d,
+		})
+	}
+	return &result, nil
+}
+
+// rankLeaderboard removes accounts that opted out of leaderboards and sorts the rest in the given order.
+func rankLeaderboard(entries []core.LeaderboardEntry, order oas.GetTopAccountsOrder, excludeLabeled bool, book addressBook) []core.LeaderboardEntry {
+	ranked := make([]core.LeaderboardEntry, 0, len(entries))
+	for _, entry := range entries {
+		if info, ok := book.GetAddressInfoByAddress(entry.Account); ok {
+			if excludeLabeled || info.LeaderboardOptOut {
+				continue
+			}
+		}
+		ranked = append(ranked, entry)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if order == oas.GetTopAccountsOrderActivity {
+			return ranked[i].Transactions7d > ranked[j].Transactions7d
+		}
+		return ranked[i].Balance > ranked[j].Balance
+	})
+	return ranked
+}
+
 func (h *Handler) GetAccountNftHistory(ctx context.Context, params oas.GetAccountNftHistoryParams) (*oas.AccountEvents, error) {
 	account, err := tongo.ParseAddress(params.AccountID)
 	if err != nil {
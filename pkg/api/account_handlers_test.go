@@ -14,6 +14,7 @@ import (
 
 	"github.com/tonkeeper/opentonapi/pkg/addressbook"
 	"github.com/tonkeeper/opentonapi/pkg/config"
+	"github.com/tonkeeper/opentonapi/pkg/core"
 	"github.com/tonkeeper/opentonapi/pkg/litestorage"
 	"github.com/tonkeeper/opentonapi/pkg/oas"
 )
@@ -230,3 +231,150 @@ func TestHandler_GetTransactions(t *testing.T) {
 		})
 	}
 }
+
+func Test_rankLeaderboard(t *testing.T) {
+	whale := tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+	exchange := tongo.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+	hidden := tongo.MustParseAccountID("0:2f956143c461769579baef2e32cc2d7bc18283f40d20bb03e432cd603ac33ffc")
+	entries := []core.LeaderboardEntry{
+		{Account: whale, Balance: 300, Transactions7d: 1},
+		{Account: exchange, Balance: 200, Transactions7d: 100},
+		{Account: hidden, Balance: 1000, Transactions7d: 1000},
+	}
+	book := &mockAddressBook{
+		OnGetAddressInfoByAddress: func(a tongo.AccountID) (addressbook.KnownAddress, bool) {
+			switch a {
+			case exchange:
+				return addressbook.KnownAddress{Name: "Exchange"}, true
+			case hidden:
+				return addressbook.KnownAddress{Name: "Hidden", LeaderboardOptOut: true}, true
+			}
+			return addressbook.KnownAddress{}, false
+		},
+	}
+	accounts := func(entries []core.LeaderboardEntry) []tongo.AccountID {
+		var result []tongo.AccountID
+		for _, entry := range entries {
+			result = append(result, entry.Account)
+		}
+		return result
+	}
+	require.Equal(t, []tongo.AccountID{whale, exchange}, accounts(rankLeaderboard(entries, oas.GetTopAccountsOrderBalance, false, book)))
+	require.Equal(t, []tongo.AccountID{exchange, whale}, accounts(rankLeaderboard(entries, oas.GetTopAccountsOrderActivity, false, book)))
+	require.Equal(t, []tongo.AccountID{whale}, accounts(rankLeaderboard(entries, oas.GetTopAccountsOrderBalance, true, book)))
+}
+
+func Test_matchOperation(t *testing.T) {
+	opCode := uint32(0x7362d09c)
+	decoded := core.Message{OpCode: &opCode, DecodedBody: &core.DecodedMessageBody{Operation: "JettonNotify"}}
+	tests := []struct {
+		name      string
+		msg       core.Message
+		operation string
+		want      bool
+	}{
+		{name: "any operation", msg: core.Message{}, want: true},
+		{name: "by name", msg: decoded, operation: "JettonNotify", want: true},
+		{name: "by opcode", msg: decoded, operation: "0x7362d09c", want: true},
+		{name: "other operation", msg: decoded, operation: "0x0f8a7ea5"},
+		{name: "empty body", msg: core.Message{}, operation: "0x7362d09c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, matchOperation(tt.msg, tt.operation))
+		})
+	}
+}
+
+// mockLogsStorage keeps transactions of a single account sorted by lt.
+type mockLogsStorage struct {
+	storage
+	txs []*core.Transaction
+}
+
+func (m *mockLogsStorage) IndexedAccountTransactionsBefore(ctx context.Context, account tongo.AccountID, beforeLt uint64, limit int) ([]*core.Transaction, error) {
+	var result []*core.Transaction
+	for i := len(m.txs) - 1; i >= 0 && len(result) < limit; i-- {
+		if m.txs[i].Lt < beforeLt {
+			result = append(result, m.txs[i])
+		}
+	}
+	return result, nil
+}
+
+func TestHandler_GetBlockchainAccountLogs(t *testing.T) {
+	account := tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	storage := &mockLogsStorage{}
+	for lt := uint64(1); lt <= 250; lt++ {
+		storage.txs = append(storage.txs, &core.Transaction{
+			TransactionID: core.TransactionID{Account: account, Lt: lt, Hash: tongo.Bits256{byte(lt)}},
+			OutMsgs:       []core.Message{{MsgType: core.ExtOutMsg}},
+		})
+	}
+	h := &Handler{storage: storage, addressBook: mockAddressBook{}}
+
+	seen := map[int64]struct{}{}
+	params := oas.GetBlockchainAccountLogsParams{AccountID: account.ToRaw(), Limit: oas.NewOptInt32(100)}
+	var nextFroms []int64
+	for {
+		logs, err := h.GetBlockchainAccountLogs(context.Background(), params)
+		require.Nil(t, err)
+		for _, log := range logs.Logs {
+			_, ok := seen[log.Lt]
+			require.False(t, ok, "duplicated log of lt %v", log.Lt)
+			seen[log.Lt] = struct{}{}
+		}
+		if logs.NextFrom == 0 {
+			break
+		}
+		if len(nextFroms) > 0 {
+			require.Less(t, logs.NextFrom, nextFroms[len(nextFroms)-1])
+		}
+		nextFroms = append(nextFroms, logs.NextFrom)
+		params.BeforeLt = oas.NewOptInt64(logs.NextFrom)
+	}
+	require.Len(t, seen, 250)
+	require.Equal(t, []int64{151, 51}, nextFroms)
+}
+
+type mockHeatmapStorage struct {
+	storage
+}
+
+func (m *mockHeatmapStorage) GetAccountActivityHeatmap(ctx context.Context, account tongo.AccountID, from, to, size int64) ([]core.ActivityBucket, error) {
+	return core.NewAccountActivity().Heatmap(from, to, size), nil
+}
+
+func TestHandler_GetAccountActivityHeatmap(t *testing.T) {
+	h := &Handler{storage: &mockHeatmapStorage{}}
+	account := "0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb"
+	hour := int64(core.HourlyBuckets)
+	tests := []struct {
+		name        string
+		from, to    int64
+		wantBuckets int
+		wantErr     bool
+	}{
+		{name: "aligned range", from: 100 * hour, to: 100*hour + 744*hour, wantBuckets: 744},
+		// the range takes 745 buckets once from is aligned down.
+		{name: "unaligned range", from: 100*hour + 1, to: 100*hour + 744*hour + 1, wantErr: true},
+		{name: "before the epoch", from: -hour - 1, to: hour, wantBuckets: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := h.GetAccountActivityHeatmap(context.Background(), oas.GetAccountActivityHeatmapParams{
+				AccountID: account,
+				Interval:  oas.NewOptGetAccountActivityHeatmapInterval(oas.GetAccountActivityHeatmapIntervalHour),
+				StartDate: oas.NewOptInt64(tt.from),
+				EndDate:   oas.NewOptInt64(tt.to),
+			})
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Len(t, result.Buckets, tt.wantBuckets)
+			require.Zero(t, result.Buckets[0].Time%hour)
+		})
+	}
+}
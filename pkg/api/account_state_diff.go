@@ -0,0 +1,84 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// maxStateDiffTransactions limits the number of transactions walked to count the ones between two blocks.
+const maxStateDiffTransactions = 1000
+
+func (h *Handler) GetAccountStateDiff(ctx context.Context, params oas.GetAccountStateDiffParams) (*oas.AccountStateDiff, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if params.FromSeqno < 0 || params.ToSeqno <= params.FromSeqno {
+		return nil, toError(http.StatusBadRequest, fmt.Errorf("to_seqno must be greater than from_seqno"))
+	}
+	before, err := h.accountAtMasterchainSeqno(ctx, account.ID, params.FromSeqno)
+	if err != nil {
+		return nil, err
+	}
+	after, err := h.accountAtMasterchainSeqno(ctx, account.ID, params.ToSeqno)
+	if err != nil {
+		return nil, err
+	}
+	diff := convertAccountStateDiff(before, after)
+	diff.FromSeqno = params.FromSeqno
+	diff.ToSeqno = params.ToSeqno
+	diff.TransactionsCountExact = true
+	if after.LastTransactionLt > before.LastTransactionLt {
+		count, exact, err := h.storage.CountAccountTransactions(ctx, account.ID, before.LastTransactionLt, after.LastTransactionLt, after.LastTransactionHash, maxStateDiffTransactions)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		diff.TransactionsCount = int32(count)
+		diff.TransactionsCountExact = exact
+	}
+	return &diff, nil
+}
+
+// accountAtMasterchainSeqno returns the account's state as of the given masterchain block.
+func (h *Handler) accountAtMasterchainSeqno(ctx context.Context, account tongo.AccountID, seqno int32) (*core.Account, error) {
+	header, err := h.storage.GetBlockHeader(ctx, ton.BlockID{Workchain: -1, Shard: 0x8000000000000000, Seqno: uint32(seqno)})
+	if errors.Is(err, core.ErrEntityNotFound) {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("masterchain block %v not found", seqno))
+	}
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	state, err := h.storage.GetAccountStateAtBlock(ctx, account, header.BlockIDExt)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result, err := core.ConvertToAccount(account, state)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	return result, nil
+}
+
+func convertAccountStateDiff(before, after *core.Account) oas.AccountStateDiff {
+	return oas.AccountStateDiff{
+		Account:                 before.AccountAddress.ToRaw(),
+		BalanceBefore:           before.TonBalance,
+		BalanceAfter:            after.TonBalance,
+		BalanceDelta:            after.TonBalance - before.TonBalance,
+		StatusBefore:            oas.AccountStatus(before.Status),
+		StatusAfter:             oas.AccountStatus(after.Status),
+		CodeChanged:             !bytes.Equal(before.Code, after.Code),
+		DataChanged:             !bytes.Equal(before.Data, after.Data),
+		LastTransactionLtBefore: int64(before.LastTransactionLt),
+		LastTransactionLtAfter:  int64(after.LastTransactionLt),
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_convertAccountStateDiff(t *testing.T) {
+	account := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	tests := []struct {
+		name   string
+		before core.Account
+		after  core.Account
+		want   oas.AccountStateDiff
+	}{
+		{
+			name:   "deployed",
+			before: core.Account{AccountAddress: account, Status: tlb.AccountUninit, TonBalance: 1_000_000_000, Code: []byte{}, LastTransactionLt: 100},
+			after:  core.Account{AccountAddress: account, Status: tlb.AccountActive, TonBalance: 990_000_000, Code: []byte{1, 2}, Data: []byte{3}, LastTransactionLt: 200},
+			want: oas.AccountStateDiff{
+				Account:                 account.ToRaw(),
+				BalanceBefore:           1_000_000_000,
+				BalanceAfter:            990_000_000,
+				BalanceDelta:            -10_000_000,
+				StatusBefore:            oas.AccountStatusUninit,
+				StatusAfter:             oas.AccountStatusActive,
+				CodeChanged:             true,
+				DataChanged:             true,
+				LastTransactionLtBefore: 100,
+				LastTransactionLtAfter:  200,
+			},
+		},
+		{
+			name:   "only data changed",
+			before: core.Account{AccountAddress: account, Status: tlb.AccountActive, TonBalance: 5, Code: []byte{1, 2}, Data: []byte{3}, LastTransactionLt: 100},
+			after:  core.Account{AccountAddress: account, Status: tlb.AccountActive, TonBalance: 7, Code: []byte{1, 2}, Data: []byte{4}, LastTransactionLt: 300},
+			want: oas.AccountStateDiff{
+				Account:                 account.ToRaw(),
+				BalanceBefore:           5,
+				BalanceAfter:            7,
+				BalanceDelta:            2,
+				StatusBefore:            oas.AccountStatusActive,
+				StatusAfter:             oas.AccountStatusActive,
+				DataChanged:             true,
+				LastTransactionLtBefore: 100,
+				LastTransactionLtAfter:  300,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertAccountStateDiff(&tt.before, &tt.after)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	"net/http"
+)
+
+// AdminOnly restricts the given handler to requests carrying one of the admin tokens.
+// Endpoints of the metrics port are unauthenticated otherwise,
+// so it guards the ones exposing internals of the process, like pprof.
+func AdminOnly(tokens []string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !tokenGranted(tokens, bearerToken(r)) {
+			http.Error(w, "token with admin scope is required", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
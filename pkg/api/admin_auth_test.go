@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminOnly(t *testing.T) {
+	tests := []struct {
+		name     string
+		tokens   []string
+		token    string
+		wantCode int
+	}{
+		{name: "no token", tokens: []string{"secret"}, wantCode: http.StatusForbidden},
+		{name: "wrong token", tokens: []string{"secret"}, token: "guess", wantCode: http.StatusForbidden},
+		{name: "no tokens configured", token: "secret", wantCode: http.StatusForbidden},
+		{name: "granted", tokens: []string{"a", "secret"}, token: "secret", wantCode: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			r := httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+			if tt.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			AdminOnly(tt.tokens, next).ServeHTTP(w, r)
+			require.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+}
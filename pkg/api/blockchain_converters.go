@@ -55,6 +55,18 @@ func convertValueFlow(collection core.CurrencyCollection) oas.BlockCurrencyColle
 	})
 	return res
 }
+
+func convertExtraCurrencies(currencies []core.Currency) []oas.ExtraCurrency {
+	if len(currencies) == 0 {
+		return nil
+	}
+	res := make([]oas.ExtraCurrency, 0, len(currencies))
+	for _, c := range currencies {
+		res = append(res, oas.ExtraCurrency{ID: c.ID, Value: c.Value})
+	}
+	return res
+}
+
 func convertBlockHeader(b core.BlockHeader) oas.BlockchainBlock {
 	res := oas.BlockchainBlock{
 		WorkchainID:       b.Workchain,
@@ -130,6 +142,18 @@ func convertReducedBlock(block core.ReducedBlock) oas.ReducedBlock {
 	return converted
 }
 
+func convertFeeBreakdown(fees core.FeeBreakdown) oas.FeeBreakdown {
+	return oas.FeeBreakdown{
+		Storage: fees.Storage,
+		Gas:     fees.Gas,
+		Action:  fees.Action,
+		Forward: fees.Forward,
+		Import:  fees.Import,
+		Total:   fees.Total,
+		Paid:    fees.Paid,
+	}
+}
+
 func convertTransaction(t core.Transaction, accountInterfaces []abi.ContractInterface, book addressBook) oas.Transaction {
 	tx := oas.Transaction{
 		Hash:            t.Hash.Hex(),
@@ -140,6 +164,7 @@ func convertTransaction(t core.Transaction, accountInterfaces []abi.ContractInte
 		OrigStatus:      oas.AccountStatus(t.OrigStatus),
 		EndStatus:       oas.AccountStatus(t.EndStatus),
 		TotalFees:       t.TotalFee,
+		Fees:            convertFeeBreakdown(t.FeeBreakdown()),
 		EndBalance:      t.EndBalance,
 		TransactionType: oas.TransactionType(t.Type),
 		StateUpdateOld:  t.StateHashUpdate.OldHash.Hex(),
@@ -149,6 +174,13 @@ func convertTransaction(t core.Transaction, accountInterfaces []abi.ContractInte
 		Destroyed:       t.Destroyed,
 		Raw:             hex.EncodeToString(t.Raw),
 	}
+	if t.Type == core.TickTockTx {
+		tickTock := oas.TransactionTickTockTick
+		if t.IsTock {
+			tickTock = oas.TransactionTickTockTock
+		}
+		tx.TickTock = oas.NewOptTransactionTickTock(tickTock)
+	}
 	if t.PrevTransLt != 0 {
 		tx.PrevTransLt.Value = int64(t.PrevTransLt)
 		tx.PrevTransLt.Set = true
@@ -234,6 +266,7 @@ func convertMessage(m core.Message, book addressBook) oas.Message {
 		Bounce:        m.Bounce,
 		Bounced:       m.Bounced,
 		Value:         m.Value,
+		ValueExtra:    convertExtraCurrencies(m.ValueExtra),
 		FwdFee:        m.FwdFee,
 		IhrFee:        m.IhrFee,
 		Destination:   convertOptAccountAddress(m.Destination, book),
@@ -5,10 +5,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/boc"
 	"github.com/tonkeeper/tongo/tlb"
 	"go.uber.org/zap"
 
+	"github.com/tonkeeper/opentonapi/pkg/addressbook"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
 	pkgTesting "github.com/tonkeeper/opentonapi/pkg/testing"
 )
 
@@ -40,3 +44,49 @@ func Test_convertConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_filterTransactionsByType(t *testing.T) {
+	transactions := []*core.Transaction{
+		{Type: core.OrdinaryTx},
+		{Type: core.TickTockTx},
+		{Type: core.TickTockTx, IsTock: true},
+		{Type: core.StorageTx},
+	}
+	tests := []struct {
+		name  string
+		types []oas.TransactionType
+		want  []*core.Transaction
+	}{
+		{
+			name: "all transactions",
+			want: transactions,
+		},
+		{
+			name:  "special transactions",
+			types: []oas.TransactionType{oas.TransactionTypeTransTickTock, oas.TransactionTypeTransStorage},
+			want:  transactions[1:],
+		},
+		{
+			name:  "no transactions of the kind",
+			types: []oas.TransactionType{oas.TransactionTypeTransMergeInstall},
+			want:  []*core.Transaction{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, filterTransactionsByType(transactions, tt.types))
+		})
+	}
+}
+
+func Test_convertTransaction_tickTock(t *testing.T) {
+	book := &mockAddressBook{OnGetAddressInfoByAddress: func(a tongo.AccountID) (addressbook.KnownAddress, bool) {
+		return addressbook.KnownAddress{}, false
+	}}
+	tick := convertTransaction(core.Transaction{Type: core.TickTockTx}, nil, book)
+	require.Equal(t, oas.NewOptTransactionTickTock(oas.TransactionTickTockTick), tick.TickTock)
+	tock := convertTransaction(core.Transaction{Type: core.TickTockTx, IsTock: true}, nil, book)
+	require.Equal(t, oas.NewOptTransactionTickTock(oas.TransactionTickTockTock), tock.TickTock)
+	ordinary := convertTransaction(core.Transaction{Type: core.OrdinaryTx}, nil, book)
+	require.False(t, ordinary.TickTock.IsSet())
+}
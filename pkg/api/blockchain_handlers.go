@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"golang.org/x/exp/maps"
 
@@ -37,6 +38,34 @@ func (h *Handler) Status(ctx context.Context) (*oas.ServiceStatus, error) {
 	}, nil
 }
 
+func (h *Handler) GetNetworkStats(ctx context.Context) (*oas.NetworkStats, error) {
+	stats, err := h.storage.GetNetworkStats(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := oas.NetworkStats{
+		ObservedSince: stats.ObservedSince,
+		Tps: oas.NetworkStatsTps{
+			LastMinute:           stats.TPS.LastMinute,
+			Last5Minutes:         stats.TPS.Last5Minutes,
+			LastHour:             stats.TPS.LastHour,
+			TransactionsLastHour: stats.TPS.TransactionsLastHour,
+		},
+		MasterchainBlockTime: oas.NetworkStatsMasterchainBlockTime{
+			P50: stats.MasterchainBlockTime.P50,
+			P90: stats.MasterchainBlockTime.P90,
+			P99: stats.MasterchainBlockTime.P99,
+		},
+		FeesCollected: stats.FeesCollected,
+		FeesBurned:    stats.FeesBurned,
+		NewAccounts:   make([]oas.NetworkStatsNewAccountsItem, 0, len(stats.NewAccounts)),
+	}
+	for _, day := range stats.NewAccounts {
+		result.NewAccounts = append(result.NewAccounts, oas.NetworkStatsNewAccountsItem{Date: day.Date, Count: day.Count})
+	}
+	return &result, nil
+}
+
 func (h *Handler) GetReducedBlockchainBlocks(ctx context.Context, params oas.GetReducedBlockchainBlocksParams) (*oas.ReducedBlocks, error) {
 	if params.From > params.To {
 		return nil, toError(http.StatusBadRequest, fmt.Errorf("from must be less (or equal) than to"))
@@ -191,8 +220,10 @@ func (h *Handler) GetBlockchainMasterchainTransactions(ctx context.Context, para
 		if err != nil {
 			return nil, toError(http.StatusInternalServerError, err)
 		}
-		for _, tx := range txs {
-			result.Transactions = append(result.Transactions, convertTransaction(*tx, nil, h.addressBook))
+		for _, tx := range filterTransactionsByType(txs, params.Type) {
+			transaction := convertTransaction(*tx, nil, h.addressBook)
+			h.linkBounces(ctx, &transaction, tx)
+			result.Transactions = append(result.Transactions, transaction)
 		}
 	}
 	return &result, nil
@@ -210,15 +241,32 @@ func (h *Handler) GetBlockchainBlockTransactions(ctx context.Context, params oas
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
 	}
+	transactions = filterTransactionsByType(transactions, params.Type)
 	res := oas.Transactions{
 		Transactions: make([]oas.Transaction, 0, len(transactions)),
 	}
 	for _, tx := range transactions {
-		res.Transactions = append(res.Transactions, convertTransaction(*tx, nil, h.addressBook))
+		transaction := convertTransaction(*tx, nil, h.addressBook)
+		h.linkBounces(ctx, &transaction, tx)
+		res.Transactions = append(res.Transactions, transaction)
 	}
 	return &res, nil
 }
 
+// filterTransactionsByType leaves transactions of the given kinds, all transactions are kept if no kind is given.
+func filterTransactionsByType(transactions []*core.Transaction, types []oas.TransactionType) []*core.Transaction {
+	if len(types) == 0 {
+		return transactions
+	}
+	filtered := make([]*core.Transaction, 0, len(transactions))
+	for _, tx := range transactions {
+		if slices.Contains(types, oas.TransactionType(tx.Type)) {
+			filtered = append(filtered, tx)
+		}
+	}
+	return filtered
+}
+
 func (h *Handler) GetBlockchainTransaction(ctx context.Context, params oas.GetBlockchainTransactionParams) (*oas.Transaction, error) {
 	hash, err := tongo.ParseHash(params.TransactionID)
 	if err != nil {
@@ -243,6 +291,8 @@ func (h *Handler) GetBlockchainTransaction(ctx context.Context, params oas.GetBl
 		return nil, toError(http.StatusInternalServerError, err)
 	}
 	transaction := convertTransaction(*txs, nil, h.addressBook)
+	transaction.Finality = h.optFinality(ctx, []tongo.BlockID{txs.BlockID})
+	h.linkBounces(ctx, &transaction, txs)
 	return &transaction, nil
 }
 
@@ -264,9 +314,37 @@ func (h *Handler) GetBlockchainTransactionByMessageHash(ctx context.Context, par
 		return nil, toError(http.StatusInternalServerError, err)
 	}
 	transaction := convertTransaction(*txs, nil, h.addressBook)
+	transaction.Finality = h.optFinality(ctx, []tongo.BlockID{txs.BlockID})
+	h.linkBounces(ctx, &transaction, txs)
 	return &transaction, nil
 }
 
+func (h *Handler) ResolveMessageHash(ctx context.Context, params oas.ResolveMessageHashParams) (*oas.MessageHashResolution, error) {
+	hash, err := tongo.ParseHash(params.MsgID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	match, err := h.storage.ResolveMessageHash(ctx, hash)
+	if errors.Is(err, core.ErrEntityNotFound) {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("message not found"))
+	}
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	res := oas.MessageHashResolution{
+		MatchedAs:       oas.MessageHashResolutionMatchedAs(match.Kind),
+		TransactionHash: match.TransactionHash.Hex(),
+	}
+	if match.NormalizedHash != nil {
+		res.NormalizedHash = oas.NewOptString(match.NormalizedHash.Hex())
+	}
+	// the trace might be unavailable if some of its transactions are not indexed yet.
+	if trace, err := h.storage.GetTrace(ctx, match.TransactionHash); err == nil {
+		res.TraceID = oas.NewOptString(trace.Hash.Hex())
+	}
+	return &res, nil
+}
+
 func (h *Handler) GetBlockchainMasterchainHead(ctx context.Context) (*oas.BlockchainBlock, error) {
 	header, err := h.storage.LastMasterchainBlockHeader(ctx)
 	if err != nil {
@@ -0,0 +1,297 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/code"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/tvm"
+	"github.com/tonkeeper/tongo/wallet"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// maxBocTreeLines limits the printable tree returned by InspectBoc,
+// because shared subtrees are printed every time they are referenced.
+const maxBocTreeLines = 4096
+
+var bocCellTypes = map[boc.CellType]oas.BocCellType{
+	boc.OrdinaryCell:     oas.BocCellTypeOrdinary,
+	boc.PrunedBranchCell: oas.BocCellTypePrunedBranch,
+	boc.LibraryCell:      oas.BocCellTypeLibrary,
+	boc.MerkleProofCell:  oas.BocCellTypeMerkleProof,
+	boc.MerkleUpdateCell: oas.BocCellTypeMerkleUpdate,
+}
+
+func (h *Handler) GetBocHash(ctx context.Context, req *oas.GetBocHashReq) (*oas.BocHashes, error) {
+	cells, err := deserializeBoc(req.Boc)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	hashes := make([]string, 0, len(cells))
+	for _, cell := range cells {
+		hash, err := cell.HashString()
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		hashes = append(hashes, hash)
+	}
+	return &oas.BocHashes{Hashes: hashes}, nil
+}
+
+func (h *Handler) InspectBoc(ctx context.Context, req *oas.InspectBocReq) (*oas.BocInspection, error) {
+	cells, err := deserializeBoc(req.Boc)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	inspection, err := inspectCells(cells)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	return inspection, nil
+}
+
+func (h *Handler) ParseStateInit(ctx context.Context, req *oas.ParseStateInitReq, params oas.ParseStateInitParams) (*oas.StateInitComponents, error) {
+	cell, err := deserializeSingleBoc(req.Boc)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	var stateInit tlb.StateInit
+	if err := tlb.Unmarshal(cell, &stateInit); err != nil {
+		return nil, toError(http.StatusBadRequest, fmt.Errorf("not a state init: %w", err))
+	}
+	hash, err := cell.Hash256()
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	account := tongo.AccountID{Workchain: params.Workchain.Or(0), Address: hash}
+	result := oas.StateInitComponents{
+		Address:   account.ToRaw(),
+		Hash:      tlb.Bits256(hash).Hex(),
+		Libraries: []oas.StateInitComponentsLibrariesItem{},
+	}
+	if stateInit.Code.Exists {
+		code := stateInit.Code.Value.Value
+		if result.Code, result.CodeHash, err = convertCellToBoc(&code); err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+	}
+	if stateInit.Data.Exists {
+		data := stateInit.Data.Value.Value
+		if result.Data, result.DataHash, err = convertCellToBoc(&data); err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+	}
+	if stateInit.SplitDepth.Exists {
+		result.SplitDepth = oas.NewOptInt32(int32(stateInit.SplitDepth.Value))
+	}
+	if stateInit.Special.Exists {
+		result.Special = oas.NewOptStateInitComponentsSpecial(oas.StateInitComponentsSpecial{
+			Tick: stateInit.Special.Value.Tick,
+			Tock: stateInit.Special.Value.Tock,
+		})
+	}
+	for _, item := range stateInit.Library.Items() {
+		result.Libraries = append(result.Libraries, oas.StateInitComponentsLibrariesItem{
+			Hash:   item.Key.Hex(),
+			Public: item.Value.Public,
+		})
+	}
+	return &result, nil
+}
+
+func (h *Handler) InspectContractCode(ctx context.Context, req *oas.InspectContractCodeReq) (*oas.ContractCodeInspection, error) {
+	contractCode, data, account, err := contractToInspect(req)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	codeBoc, err := contractCode.ToBoc()
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	codeHash, err := contractCode.Hash256()
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	result := oas.ContractCodeInspection{
+		CodeHash:   tlb.Bits256(codeHash).Hex(),
+		Interfaces: []string{},
+		Methods:    []oas.ContractCodeInspectionMethodsItem{},
+	}
+	if version, ok := wallet.GetVerByCodeHash(codeHash); ok {
+		result.WalletVersion = oas.NewOptString(version.ToString())
+	}
+	// a code stored in a library cell has no method dictionary to parse,
+	// its get methods are still executed by the inspector below.
+	if methods, err := code.ParseContractMethods(codeBoc); err == nil {
+		for _, methodID := range methods {
+			if method, ok := code.Methods[methodID]; ok {
+				result.Methods = append(result.Methods, oas.ContractCodeInspectionMethodsItem{
+					ID:     methodID,
+					Method: string(method),
+				})
+			}
+		}
+	}
+	options := []tvm.Option{tvm.WithLibraryResolver(h.storage)}
+	// get methods rarely depend on the blockchain config, so the inspection goes on without it.
+	if configObject, ok := h.configPool.Get().(*tvm.Config); ok && configObject != nil {
+		defer h.configPool.Put(configObject)
+		options = append(options, tvm.WithConfig(configObject))
+	}
+	emulator, err := tvm.NewEmulator(contractCode, data, nil, options...)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if err := emulator.SetGasLimit(10_000_000); err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	description, err := abi.NewContractInspector(abi.InspectWithLibraryResolver(h.storage)).InspectContract(ctx, codeBoc, emulator, account)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	for _, iface := range description.ContractInterfaces {
+		result.Interfaces = append(result.Interfaces, iface.String())
+	}
+	return &result, nil
+}
+
+// contractToInspect returns code and data of a contract and the address it is going to be deployed to.
+// If only the code is given, the data is an empty cell and the address is the zero one.
+func contractToInspect(req *oas.InspectContractCodeReq) (*boc.Cell, *boc.Cell, tongo.AccountID, error) {
+	switch {
+	case req.StateInit.IsSet():
+		cell, err := deserializeSingleBoc(req.StateInit.Value)
+		if err != nil {
+			return nil, nil, tongo.AccountID{}, err
+		}
+		var stateInit tlb.StateInit
+		if err := tlb.Unmarshal(cell, &stateInit); err != nil {
+			return nil, nil, tongo.AccountID{}, fmt.Errorf("not a state init: %w", err)
+		}
+		if !stateInit.Code.Exists {
+			return nil, nil, tongo.AccountID{}, fmt.Errorf("state init has no code")
+		}
+		hash, err := cell.Hash256()
+		if err != nil {
+			return nil, nil, tongo.AccountID{}, err
+		}
+		contractCode, data := stateInit.Code.Value.Value, boc.NewCell()
+		if stateInit.Data.Exists {
+			*data = stateInit.Data.Value.Value
+		}
+		return &contractCode, data, tongo.AccountID{Address: hash}, nil
+	case req.Code.IsSet():
+		contractCode, err := deserializeSingleBoc(req.Code.Value)
+		if err != nil {
+			return nil, nil, tongo.AccountID{}, err
+		}
+		return contractCode, boc.NewCell(), tongo.AccountID{}, nil
+	default:
+		return nil, nil, tongo.AccountID{}, fmt.Errorf("either code or state_init is required")
+	}
+}
+
+// convertCellToBoc returns a hex-encoded bag-of-cells and the hash of the given cell.
+func convertCellToBoc(cell *boc.Cell) (oas.OptString, oas.OptString, error) {
+	bocStr, err := cell.ToBocString()
+	if err != nil {
+		return oas.OptString{}, oas.OptString{}, err
+	}
+	hash, err := cell.HashString()
+	if err != nil {
+		return oas.OptString{}, oas.OptString{}, err
+	}
+	return oas.NewOptString(bocStr), oas.NewOptString(hash), nil
+}
+
+// cellInspector assigns indexes to unique cells in the order they are visited,
+// so shared subtrees are walked only once.
+type cellInspector struct {
+	indexes map[string]int32
+	cells   []oas.BocCell
+}
+
+func inspectCells(roots []*boc.Cell) (*oas.BocInspection, error) {
+	inspector := cellInspector{
+		indexes: map[string]int32{},
+	}
+	inspection := oas.BocInspection{
+		Roots: make([]int32, 0, len(roots)),
+	}
+	for _, root := range roots {
+		index, err := inspector.visit(root)
+		if err != nil {
+			return nil, err
+		}
+		inspection.Roots = append(inspection.Roots, index)
+	}
+	inspection.Cells = inspector.cells
+	var tree strings.Builder
+	lines := 0
+	for _, index := range inspection.Roots {
+		inspector.print(&tree, index, "", &lines)
+	}
+	inspection.Tree = tree.String()
+	return &inspection, nil
+}
+
+func (i *cellInspector) visit(cell *boc.Cell) (int32, error) {
+	hash, err := cell.HashString()
+	if err != nil {
+		return 0, err
+	}
+	if index, ok := i.indexes[hash]; ok {
+		return index, nil
+	}
+	bits := cell.RawBitString()
+	index := int32(len(i.cells))
+	i.indexes[hash] = index
+	i.cells = append(i.cells, oas.BocCell{
+		Hash:  hash,
+		Type:  bocCellTypes[cell.CellType()],
+		Level: int32(cell.Level()),
+		Bits:  int32(cell.BitSize()),
+		Data:  bits.ToFiftHex(),
+		Refs:  []int32{},
+	})
+	var depth int32
+	for _, ref := range cell.Refs() {
+		refIndex, err := i.visit(ref)
+		if err != nil {
+			return 0, err
+		}
+		i.cells[index].Refs = append(i.cells[index].Refs, refIndex)
+		if refDepth := i.cells[refIndex].Depth + 1; refDepth > depth {
+			depth = refDepth
+		}
+	}
+	i.cells[index].Depth = depth
+	return index, nil
+}
+
+func (i *cellInspector) print(b *strings.Builder, index int32, indent string, lines *int) {
+	if *lines >= maxBocTreeLines {
+		if *lines == maxBocTreeLines {
+			b.WriteString("...\n")
+			*lines++
+		}
+		return
+	}
+	*lines++
+	cell := i.cells[index]
+	prefix := "x"
+	if cell.Type != oas.BocCellTypeOrdinary {
+		prefix = "!x"
+	}
+	fmt.Fprintf(b, "%s%s{%s} depth:%d refs:%d\n", indent, prefix, cell.Data, cell.Depth, len(cell.Refs))
+	for _, ref := range cell.Refs {
+		i.print(b, ref, indent+" ", lines)
+	}
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"crypto/ed25519"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/wallet"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func TestHandler_InspectBoc(t *testing.T) {
+	leaf := boc.NewCell()
+	require.Nil(t, leaf.WriteUint(0xAB, 8))
+	middle := boc.NewCell()
+	require.Nil(t, middle.WriteUint(0xCD, 8))
+	require.Nil(t, middle.AddRef(leaf))
+	root := boc.NewCell()
+	require.Nil(t, root.WriteBit(true))
+	require.Nil(t, root.AddRef(middle))
+	require.Nil(t, root.AddRef(leaf))
+	bocStr, err := root.ToBocBase64()
+	require.Nil(t, err)
+
+	h := &Handler{}
+	inspection, err := h.InspectBoc(context.Background(), &oas.InspectBocReq{Boc: bocStr})
+	require.Nil(t, err)
+	require.Equal(t, []int32{0}, inspection.Roots)
+	require.Len(t, inspection.Cells, 3)
+	require.Equal(t, int32(2), inspection.Cells[0].Depth)
+	require.Equal(t, []int32{1, 2}, inspection.Cells[0].Refs)
+	require.Equal(t, "C_", inspection.Cells[0].Data)
+	require.Equal(t, int32(1), inspection.Cells[1].Depth)
+	require.Equal(t, int32(0), inspection.Cells[2].Depth)
+	require.Equal(t, "x{C_} depth:2 refs:2\n x{CD} depth:1 refs:1\n  x{AB} depth:0 refs:0\n x{AB} depth:0 refs:0\n", inspection.Tree)
+
+	hashes, err := h.GetBocHash(context.Background(), &oas.GetBocHashReq{Boc: bocStr})
+	require.Nil(t, err)
+	rootHash, err := root.HashString()
+	require.Nil(t, err)
+	require.Equal(t, []string{rootHash}, hashes.Hashes)
+	require.Equal(t, rootHash, inspection.Cells[0].Hash)
+
+	_, err = h.InspectBoc(context.Background(), &oas.InspectBocReq{Boc: "not a boc"})
+	require.NotNil(t, err)
+}
+
+func TestHandler_ParseStateInit(t *testing.T) {
+	code := boc.NewCell()
+	require.Nil(t, code.WriteUint(0xFF00, 16))
+	data := boc.NewCell()
+	require.Nil(t, data.WriteUint(7, 32))
+	stateInit := tlb.StateInit{
+		Code: tlb.Maybe[tlb.Ref[boc.Cell]]{Exists: true, Value: tlb.Ref[boc.Cell]{Value: *code}},
+		Data: tlb.Maybe[tlb.Ref[boc.Cell]]{Exists: true, Value: tlb.Ref[boc.Cell]{Value: *data}},
+	}
+	cell := boc.NewCell()
+	require.Nil(t, tlb.Marshal(cell, stateInit))
+	bocStr, err := cell.ToBocBase64()
+	require.Nil(t, err)
+	hash, err := cell.HashString()
+	require.Nil(t, err)
+
+	h := &Handler{}
+	components, err := h.ParseStateInit(context.Background(), &oas.ParseStateInitReq{Boc: bocStr}, oas.ParseStateInitParams{Workchain: oas.NewOptInt32(-1)})
+	require.Nil(t, err)
+	require.Equal(t, hash, components.Hash)
+	require.Equal(t, "-1:"+hash, components.Address)
+	codeHash, err := code.HashString()
+	require.Nil(t, err)
+	require.Equal(t, codeHash, components.CodeHash.Value)
+	dataHash, err := data.HashString()
+	require.Nil(t, err)
+	require.Equal(t, dataHash, components.DataHash.Value)
+	require.False(t, components.SplitDepth.IsSet())
+	require.False(t, components.Special.IsSet())
+	require.Empty(t, components.Libraries)
+}
+
+func TestHandler_InspectContractCode(t *testing.T) {
+	stateInit, err := wallet.GenerateStateInit(make(ed25519.PublicKey, ed25519.PublicKeySize), wallet.V4R2, nil, 0, nil)
+	require.Nil(t, err)
+	cell := boc.NewCell()
+	require.Nil(t, tlb.Marshal(cell, stateInit))
+	stateInitBoc, err := cell.ToBocBase64()
+	require.Nil(t, err)
+	codeBoc, err := wallet.GetCodeByVer(wallet.V4R2).ToBocBase64()
+	require.Nil(t, err)
+	codeHash := wallet.GetCodeHashByVer(wallet.V4R2).Hex()
+
+	h := &Handler{configPool: &sync.Pool{New: func() any { return nil }}}
+	tests := []struct {
+		name           string
+		req            oas.InspectContractCodeReq
+		wantInterfaces []string
+		wantErr        bool
+	}{
+		{
+			name:           "state init",
+			req:            oas.InspectContractCodeReq{StateInit: oas.NewOptString(stateInitBoc)},
+			wantInterfaces: []string{"wallet_v4r2"},
+		},
+		{
+			name:           "code only",
+			req:            oas.InspectContractCodeReq{Code: oas.NewOptString(codeBoc)},
+			wantInterfaces: []string{"wallet_v4r2"},
+		},
+		{
+			name:    "neither code nor state init",
+			req:     oas.InspectContractCodeReq{},
+			wantErr: true,
+		},
+		{
+			name:    "code is not a state init",
+			req:     oas.InspectContractCodeReq{StateInit: oas.NewOptString(codeBoc)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inspection, err := h.InspectContractCode(context.Background(), &tt.req)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, codeHash, inspection.CodeHash)
+			require.Equal(t, "v4R2", inspection.WalletVersion.Value)
+			require.Subset(t, inspection.Interfaces, tt.wantInterfaces)
+			var methods []string
+			for _, m := range inspection.Methods {
+				methods = append(methods, m.Method)
+			}
+			require.Contains(t, methods, "get_plugin_list")
+		})
+	}
+}
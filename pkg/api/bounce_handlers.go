@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/txemulator"
+	tongoWallet "github.com/tonkeeper/tongo/wallet"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// CheckAccountBounce predicts whether a transfer to the account will bounce.
+// Inactive accounts can't run code, so their status is enough,
+// otherwise we emulate a single transaction of the account receiving the transfer.
+func (h *Handler) CheckAccountBounce(ctx context.Context, req *oas.CheckAccountBounceReq, params oas.CheckAccountBounceParams) (*oas.BouncePrediction, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if req.Amount < 0 {
+		return nil, toError(http.StatusBadRequest, fmt.Errorf("amount must be greater than 0"))
+	}
+	bounceable := req.Bounceable.Or(account.Bounce)
+	var body *boc.Cell
+	if req.Payload.IsSet() {
+		if body, err = deserializeSingleBoc(req.Payload.Value); err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+	}
+	// without a sender we use the zero address, it works for contracts accepting transfers from anyone.
+	var sender ton.AccountID
+	if req.Sender.IsSet() {
+		address, err := tongo.ParseAddress(req.Sender.Value)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		sender = address.ID
+	}
+	state, err := h.storage.GetAccountState(ctx, account.ID)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	status := state.Account.Status()
+	if status != tlb.AccountActive {
+		prediction := predictBounce(bounceable, status, nil)
+		return &prediction, nil
+	}
+
+	msg, _, err := tongoWallet.Message{
+		Amount:  tlb.Grams(req.Amount),
+		Address: account.ID,
+		Body:    body,
+		Bounce:  bounceable,
+	}.ToInternal()
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	msg.Info.IntMsgInfo.Src = sender.ToMsgAddress()
+	configBase64, err := h.storage.TrimmedConfigBase64()
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	emulator, err := txemulator.NewTraceBuilder(
+		txemulator.WithAccountsSource(h.storage),
+		txemulator.WithAccounts(state),
+		txemulator.WithConfigBase64(configBase64),
+		// only the transaction of the account matters, messages sent by it are not emulated.
+		txemulator.WithSoftLimit(1),
+	)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	tree, err := runEmulation(ctx, emulator, msg)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	tx, err := core.ConvertTransaction(account.ID.Workchain, tongo.Transaction{
+		Transaction: tree.TX,
+		BlockID:     tongo.BlockIDExt{BlockID: tongo.BlockID{Workchain: account.ID.Workchain}},
+	})
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	prediction := predictBounce(bounceable, status, tx)
+	return &prediction, nil
+}
+
+// predictBounce makes a prediction based on the status of the destination account
+// and, for an active account, on the emulated transaction processing the transfer.
+func predictBounce(bounceable bool, status tlb.AccountStatus, tx *core.Transaction) oas.BouncePrediction {
+	prediction := oas.BouncePrediction{
+		Bounceable:    bounceable,
+		AccountStatus: oas.AccountStatus(status),
+		Reason:        oas.BouncePredictionReasonNone,
+	}
+	switch status {
+	case tlb.AccountNone:
+		prediction.Reason = oas.BouncePredictionReasonNonexist
+	case tlb.AccountUninit:
+		prediction.Reason = oas.BouncePredictionReasonUninit
+	case tlb.AccountFrozen:
+		prediction.Reason = oas.BouncePredictionReasonFrozen
+	}
+	if tx == nil {
+		prediction.WillBounce = bounceable && prediction.Reason != oas.BouncePredictionReasonNone
+		return prediction
+	}
+	switch {
+	case tx.ComputePhase == nil:
+	case tx.ComputePhase.Skipped:
+		switch tx.ComputePhase.SkipReason {
+		case tlb.ComputeSkipReasonNoGas:
+			prediction.Reason = oas.BouncePredictionReasonNoGas
+		case tlb.ComputeSkipReasonNoState:
+			prediction.Reason = oas.BouncePredictionReasonUninit
+		default:
+			prediction.Reason = oas.BouncePredictionReasonFrozen
+		}
+	case !tx.ComputePhase.Success:
+		prediction.Reason = oas.BouncePredictionReasonComputeFailed
+		prediction.ExitCode.SetTo(tx.ComputePhase.ExitCode)
+	case tx.ActionPhase != nil && !tx.ActionPhase.Success:
+		prediction.Reason = oas.BouncePredictionReasonActionFailed
+		prediction.ExitCode.SetTo(tx.ActionPhase.ResultCode)
+	}
+	prediction.WillBounce = tx.BouncePhase != nil
+	return prediction
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_predictBounce(t *testing.T) {
+	tests := []struct {
+		name       string
+		bounceable bool
+		status     tlb.AccountStatus
+		tx         *core.Transaction
+		want       oas.BouncePrediction
+	}{
+		{
+			name:       "bounceable to uninit",
+			bounceable: true,
+			status:     tlb.AccountUninit,
+			want:       oas.BouncePrediction{WillBounce: true, Bounceable: true, AccountStatus: oas.AccountStatusUninit, Reason: oas.BouncePredictionReasonUninit},
+		},
+		{
+			name:   "non-bounceable to nonexist",
+			status: tlb.AccountNone,
+			want:   oas.BouncePrediction{AccountStatus: oas.AccountStatusNonexist, Reason: oas.BouncePredictionReasonNonexist},
+		},
+		{
+			name:       "bounceable to frozen",
+			bounceable: true,
+			status:     tlb.AccountFrozen,
+			want:       oas.BouncePrediction{WillBounce: true, Bounceable: true, AccountStatus: oas.AccountStatusFrozen, Reason: oas.BouncePredictionReasonFrozen},
+		},
+		{
+			name:       "successful receive",
+			bounceable: true,
+			status:     tlb.AccountActive,
+			tx: &core.Transaction{
+				ComputePhase: &core.TxComputePhase{Success: true},
+				ActionPhase:  &core.TxActionPhase{Success: true},
+			},
+			want: oas.BouncePrediction{Bounceable: true, AccountStatus: oas.AccountStatusActive, Reason: oas.BouncePredictionReasonNone},
+		},
+		{
+			name:       "failing receive",
+			bounceable: true,
+			status:     tlb.AccountActive,
+			tx: &core.Transaction{
+				ComputePhase: &core.TxComputePhase{ExitCode: 65535},
+				BouncePhase:  &core.TxBouncePhase{Type: core.BounceOk},
+			},
+			want: oas.BouncePrediction{
+				WillBounce:    true,
+				Bounceable:    true,
+				AccountStatus: oas.AccountStatusActive,
+				Reason:        oas.BouncePredictionReasonComputeFailed,
+				ExitCode:      oas.NewOptInt32(65535),
+			},
+		},
+		{
+			name:   "non-bounceable failing receive",
+			status: tlb.AccountActive,
+			tx: &core.Transaction{
+				ComputePhase: &core.TxComputePhase{ExitCode: 65535},
+			},
+			want: oas.BouncePrediction{
+				AccountStatus: oas.AccountStatusActive,
+				Reason:        oas.BouncePredictionReasonComputeFailed,
+				ExitCode:      oas.NewOptInt32(65535),
+			},
+		},
+		{
+			name:       "not enough gas",
+			bounceable: true,
+			status:     tlb.AccountActive,
+			tx: &core.Transaction{
+				ComputePhase: &core.TxComputePhase{Skipped: true, SkipReason: tlb.ComputeSkipReasonNoGas},
+				BouncePhase:  &core.TxBouncePhase{Type: core.BounceNoFunds},
+			},
+			want: oas.BouncePrediction{WillBounce: true, Bounceable: true, AccountStatus: oas.AccountStatusActive, Reason: oas.BouncePredictionReasonNoGas},
+		},
+		{
+			name:       "failing action",
+			bounceable: true,
+			status:     tlb.AccountActive,
+			tx: &core.Transaction{
+				ComputePhase: &core.TxComputePhase{Success: true},
+				ActionPhase:  &core.TxActionPhase{ResultCode: 37},
+				BouncePhase:  &core.TxBouncePhase{Type: core.BounceOk},
+			},
+			want: oas.BouncePrediction{
+				WillBounce:    true,
+				Bounceable:    true,
+				AccountStatus: oas.AccountStatusActive,
+				Reason:        oas.BouncePredictionReasonActionFailed,
+				ExitCode:      oas.NewOptInt32(37),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := predictBounce(tt.bounceable, tt.status, tt.tx)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
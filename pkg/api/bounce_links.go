@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func convertMessageRef(msgHash, txHash tongo.Bits256) oas.OptMessageRef {
+	return oas.NewOptMessageRef(oas.MessageRef{
+		MessageHash:     msgHash.Hex(),
+		TransactionHash: txHash.Hex(),
+	})
+}
+
+// linkBounces links a transaction processing a bounce to the bounced message,
+// and outgoing messages of the transaction to their bounces.
+// Links are resolved with the indexer, a link that can't be resolved is omitted.
+func (h *Handler) linkBounces(ctx context.Context, tx *oas.Transaction, t *core.Transaction) {
+	if t.InMsg != nil && t.InMsg.Bounced {
+		if originHash, err := h.storage.FindBounceOrigin(ctx, t.InMsg.Hash); err == nil {
+			if origin, err := h.storage.GetTransaction(ctx, originHash); err == nil && origin.InMsg != nil {
+				tx.BounceOrigin = convertMessageRef(origin.InMsg.Hash, origin.Hash)
+			}
+		}
+	}
+	for _, msg := range t.OutMsgs {
+		if !msg.Bounce || msg.Destination == nil {
+			continue
+		}
+		if bounce, bounceTx, ok := h.findBounce(ctx, msg); ok {
+			setBouncedBy(tx, msg.Hash, convertMessageRef(bounce, bounceTx))
+		}
+	}
+}
+
+// findBounce returns a hash of the bounce sent in response to the given message and a hash of the transaction processing it.
+func (h *Handler) findBounce(ctx context.Context, msg core.Message) (tongo.Bits256, tongo.Bits256, bool) {
+	destTxHash, err := h.storage.SearchTransactionByMessageHash(ctx, msg.Hash)
+	if err != nil {
+		return tongo.Bits256{}, tongo.Bits256{}, false
+	}
+	destTx, err := h.storage.GetTransaction(ctx, *destTxHash)
+	if err != nil {
+		return tongo.Bits256{}, tongo.Bits256{}, false
+	}
+	for _, out := range destTx.OutMsgs {
+		if !out.Bounced {
+			continue
+		}
+		bounceTxHash, err := h.storage.SearchTransactionByMessageHash(ctx, out.Hash)
+		if err != nil {
+			return tongo.Bits256{}, tongo.Bits256{}, false
+		}
+		return out.Hash, *bounceTxHash, true
+	}
+	return tongo.Bits256{}, tongo.Bits256{}, false
+}
+
+func setBouncedBy(tx *oas.Transaction, msgHash tongo.Bits256, ref oas.OptMessageRef) {
+	hash := msgHash.Hex()
+	for i := range tx.OutMsgs {
+		if tx.OutMsgs[i].Hash == hash {
+			tx.OutMsgs[i].BouncedBy = ref
+			return
+		}
+	}
+}
+
+// linkTraceBounces links bounces within a trace, they don't need the indexer:
+// a bounce is sent by a child transaction that has failed to process a message of its parent.
+func linkTraceBounces(t *core.Trace, trace *oas.Trace) {
+	// convertTrace has sorted children of t, so they are in the same order as children of trace.
+	for i, child := range t.Children {
+		if child.InMsg != nil && child.InMsg.Bounced && t.InMsg != nil {
+			trace.Children[i].Transaction.BounceOrigin = convertMessageRef(t.InMsg.Hash, t.Hash)
+		}
+		if child.InMsg == nil {
+			continue
+		}
+		for _, grandChild := range child.Children {
+			if grandChild.InMsg != nil && grandChild.InMsg.Bounced {
+				setBouncedBy(&trace.Transaction, child.InMsg.Hash, convertMessageRef(grandChild.InMsg.Hash, grandChild.Hash))
+			}
+		}
+	}
+}
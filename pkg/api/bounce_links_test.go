@@ -0,0 +1,56 @@
+package api
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/addressbook"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	pkgTesting "github.com/tonkeeper/opentonapi/pkg/testing"
+)
+
+func Test_linkTraceBounces(t *testing.T) {
+	wallet := tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	contract := tongo.MustParseAccountID("0:da6b1b6663a0e4d18cc8574ccd9db5296e367dd9324706f3bbd9eb1cd2caf0bf")
+	transfer := tongo.Bits256(sha256.Sum256([]byte("transfer")))
+	bounce := tongo.Bits256(sha256.Sum256([]byte("bounce")))
+
+	bounceTx := pkgTesting.NewTrace(wallet, pkgTesting.WithInMsg(core.Message{
+		MessageID: core.MessageID{Source: &contract},
+		MsgType:   core.IntMsg,
+		Hash:      bounce,
+		Bounced:   true,
+	}))
+	failedTx := pkgTesting.NewTrace(contract,
+		pkgTesting.WithInMsg(core.Message{
+			MessageID: core.MessageID{Source: &wallet},
+			MsgType:   core.IntMsg,
+			Hash:      transfer,
+			Bounce:    true,
+		}),
+		pkgTesting.WithFailure(9),
+		pkgTesting.WithChildren(bounceTx))
+	failedTx.OutMsgs = []core.Message{*bounceTx.InMsg}
+	root := pkgTesting.NewTrace(wallet, pkgTesting.WithExternalInMsg(), pkgTesting.WithChildren(failedTx))
+	root.OutMsgs = []core.Message{*failedTx.InMsg}
+
+	trace := convertTrace(root, &mockAddressBook{OnGetAddressInfoByAddress: func(a tongo.AccountID) (addressbook.KnownAddress, bool) {
+		return addressbook.KnownAddress{}, false
+	}})
+	require.Equal(t, oas.NewOptMessageRef(oas.MessageRef{
+		MessageHash:     bounce.Hex(),
+		TransactionHash: bounceTx.Hash.Hex(),
+	}), trace.Transaction.OutMsgs[0].BouncedBy)
+	require.False(t, trace.Transaction.BounceOrigin.IsSet())
+
+	failed := trace.Children[0]
+	require.False(t, failed.Transaction.BounceOrigin.IsSet())
+	require.Equal(t, oas.NewOptMessageRef(oas.MessageRef{
+		MessageHash:     transfer.Hex(),
+		TransactionHash: failedTx.Hash.Hex(),
+	}), failed.Children[0].Transaction.BounceOrigin)
+}
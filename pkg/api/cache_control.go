@@ -0,0 +1,133 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// cacheClass is a class of endpoints sharing the same caching policy.
+type cacheClass int
+
+const (
+	// cacheClassNone is for endpoints whose responses must not be cached by a CDN.
+	cacheClassNone cacheClass = iota
+	// cacheClassImmutable is for endpoints returning data addressed by a block or a hash,
+	// such data never changes once it has been found.
+	cacheClassImmutable
+	// cacheClassVolatile is for endpoints returning the current state of an account or the blockchain,
+	// it can be cached for a few seconds to absorb bursts of identical requests.
+	cacheClassVolatile
+)
+
+// cacheClasses maps operation IDs from api/openapi.yml to their cache classes,
+// operations missing here don't get any caching hints.
+var cacheClasses = map[string]cacheClass{
+	"getBlockchainBlock":                    cacheClassImmutable,
+	"getBlockchainBlockTransactions":        cacheClassImmutable,
+	"getBlockchainMasterchainShards":        cacheClassImmutable,
+	"getBlockchainMasterchainBlocks":        cacheClassImmutable,
+	"getBlockchainMasterchainTransactions":  cacheClassImmutable,
+	"getBlockchainConfigFromBlock":          cacheClassImmutable,
+	"getRawBlockchainConfigFromBlock":       cacheClassImmutable,
+	"getBlockchainTransaction":              cacheClassImmutable,
+	"getBlockchainTransactionByMessageHash": cacheClassImmutable,
+	"getRawBlockchainBlock":                 cacheClassImmutable,
+	"getRawBlockchainBlockState":            cacheClassImmutable,
+	"getRawBlockchainBlockHeader":           cacheClassImmutable,
+	"getRawListBlockTransactions":           cacheClassImmutable,
+	"getRawShardBlockProof":                 cacheClassImmutable,
+	"getAccount":                            cacheClassVolatile,
+	"getAccountSeqno":                       cacheClassVolatile,
+	"getAccountJettonsBalances":             cacheClassVolatile,
+	"getAccountJettonBalance":               cacheClassVolatile,
+	"getAccountNftItems":                    cacheClassVolatile,
+	"getBlockchainRawAccount":               cacheClassVolatile,
+	"getRawAccountState":                    cacheClassVolatile,
+	"getBlockchainMasterchainHead":          cacheClassVolatile,
+	"getRawMasterchainInfo":                 cacheClassVolatile,
+	"getBlockchainFees":                     cacheClassVolatile,
+}
+
+// CachePolicy configures Cache-Control headers of successful GET responses,
+// so a CDN in front of opentonapi can serve repeated reads.
+// A zero duration disables caching hints for the class.
+type CachePolicy struct {
+	// Immutable is a lifetime of responses with data addressed by a block or a hash, like old blocks and transactions.
+	Immutable time.Duration
+	// Volatile is a lifetime of responses with the current state, like account balances.
+	// Only shared caches are allowed to keep them, so clients always get fresh data from the CDN.
+	Volatile time.Duration
+}
+
+// WithCachePolicy adds Cache-Control headers to responses according to the given policy.
+func WithCachePolicy(policy CachePolicy) ServerOption {
+	return func(options *ServerOptions) {
+		options.cachePolicy = policy
+	}
+}
+
+func (p CachePolicy) enabled() bool {
+	return p.Immutable > 0 || p.Volatile > 0
+}
+
+// header returns a value of the Cache-Control header for the class, an empty string means no header.
+func (p CachePolicy) header(class cacheClass) string {
+	switch {
+	case class == cacheClassImmutable && p.Immutable > 0:
+		seconds := int(p.Immutable.Seconds())
+		return fmt.Sprintf("public, max-age=%d, s-maxage=%d, immutable", seconds, seconds)
+	case class == cacheClassVolatile && p.Volatile > 0:
+		return fmt.Sprintf("public, max-age=0, s-maxage=%d", int(p.Volatile.Seconds()))
+	}
+	return ""
+}
+
+// cacheControlResponseWriter sets the Cache-Control header of a successful response right before it is sent.
+type cacheControlResponseWriter struct {
+	http.ResponseWriter
+	value       string
+	wroteHeader bool
+}
+
+func (w *cacheControlResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		// errors like 404 Not Found might go away once the data is indexed, so they are never cached.
+		if status == http.StatusOK && w.Header().Get("Cache-Control") == "" {
+			w.Header().Set("Cache-Control", w.value)
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *cacheControlResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// cacheControlMiddleware never adds caching hints to requests with an admin token,
+// their responses carry private labels and must not be shared via a CDN.
+func cacheControlMiddleware(server *oas.Server, policy CachePolicy, adminTokens []string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || tokenGranted(adminTokens, bearerToken(r)) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		route, ok := server.FindPath(r.Method, r.URL)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		value := policy.header(cacheClasses[route.OperationID()])
+		if value == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(&cacheControlResponseWriter{ResponseWriter: w, value: value}, r)
+	})
+}
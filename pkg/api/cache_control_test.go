@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_cacheControlMiddleware(t *testing.T) {
+	server, err := oas.NewServer(&Handler{})
+	require.Nil(t, err)
+	policy := CachePolicy{Immutable: 24 * time.Hour, Volatile: 5 * time.Second}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		status int
+		token  string
+		want   string
+	}{
+		{
+			name:   "old block",
+			method: http.MethodGet,
+			path:   "/v2/blockchain/blocks/(-1,8000000000000000,4234234)",
+			status: http.StatusOK,
+			want:   "public, max-age=86400, s-maxage=86400, immutable",
+		},
+		{
+			name:   "transaction not found yet",
+			method: http.MethodGet,
+			path:   "/v2/blockchain/transactions/97264395BD65A255A429B11326C84128B7D70FFED7949ABAE3036D506BA38621",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "balance",
+			method: http.MethodGet,
+			path:   "/v2/accounts/0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb",
+			status: http.StatusOK,
+			want:   "public, max-age=0, s-maxage=5",
+		},
+		{
+			name:   "balance with private labels",
+			method: http.MethodGet,
+			path:   "/v2/accounts/0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb",
+			status: http.StatusOK,
+			token:  "admin-token",
+		},
+		{
+			name:   "events",
+			method: http.MethodGet,
+			path:   "/v2/accounts/0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb/events",
+			status: http.StatusOK,
+		},
+		{
+			name:   "not a GET request",
+			method: http.MethodPost,
+			path:   "/v2/blockchain/message",
+			status: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := cacheControlMiddleware(server, policy, []string{"admin-token"}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tt.want, rec.Header().Get("Cache-Control"))
+		})
+	}
+}
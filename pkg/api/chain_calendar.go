@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// defaultCalendarPeriod is how far ahead the chain calendar looks by default, in seconds.
+const defaultCalendarPeriod = 7 * 24 * 60 * 60
+
+// calendarValidationEvents lists events of the validation cycle along with their types in the calendar.
+var calendarValidationEvents = []struct {
+	event     oas.GetMasterchainEtaEvent
+	eventType oas.ChainCalendarEventType
+}{
+	{oas.GetMasterchainEtaEventElectionsStart, oas.ChainCalendarEventTypeElectionsStart},
+	{oas.GetMasterchainEtaEventElectionsEnd, oas.ChainCalendarEventTypeElectionsEnd},
+	{oas.GetMasterchainEtaEventValidationRoundEnd, oas.ChainCalendarEventTypeValidationRoundEnd},
+	{oas.GetMasterchainEtaEventStakeUnfreeze, oas.ChainCalendarEventTypeStakeUnfreeze},
+}
+
+func (h *Handler) GetChainCalendar(ctx context.Context, params oas.GetChainCalendarParams) (*oas.ChainCalendar, error) {
+	config, err := h.storage.GetLastConfig(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	schedules, err := h.storage.GetLockupSchedules(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	now := time.Now().Unix()
+	until := now + params.Period.Or(defaultCalendarPeriod)
+	events, err := validationCalendar(config, now, until)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	for _, schedule := range schedules {
+		for _, unlock := range schedule.Unlocks(now, until) {
+			events = append(events, h.convertLockupUnlock(unlock))
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Utime < events[j].Utime
+	})
+	return &oas.ChainCalendar{Events: events}, nil
+}
+
+// validationCalendar returns events of the validation cycle happening in (now, until].
+// Validation rounds last the same time, so every event repeats each round.
+func validationCalendar(config ton.BlockchainConfig, now, until int64) ([]oas.ChainCalendarEvent, error) {
+	events := []oas.ChainCalendarEvent{}
+	for _, e := range calendarValidationEvents {
+		deadline, err := validationDeadline(config, e.event, now)
+		if err != nil {
+			return nil, err
+		}
+		for ; deadline <= until; deadline += int64(config.ConfigParam15.ValidatorsElectedFor) {
+			events = append(events, oas.ChainCalendarEvent{Type: e.eventType, Utime: deadline})
+			if config.ConfigParam15.ValidatorsElectedFor == 0 {
+				break
+			}
+		}
+	}
+	return events, nil
+}
+
+func (h *Handler) convertLockupUnlock(unlock core.LockupUnlock) oas.ChainCalendarEvent {
+	return oas.ChainCalendarEvent{
+		Type:    oas.ChainCalendarEventTypeVestingUnlock,
+		Utime:   unlock.Utime,
+		Account: oas.NewOptAccountAddress(convertAccountAddress(unlock.Account, h.addressBook)),
+		Amount:  oas.NewOptInt64(unlock.Amount),
+	}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_validationCalendar(t *testing.T) {
+	validatorSet := func(since, until uint32) tlb.ValidatorSet {
+		set := tlb.ValidatorSet{SumType: "ValidatorsExt"}
+		set.ValidatorsExt.UtimeSince = since
+		set.ValidatorsExt.UtimeUntil = until
+		return set
+	}
+	config := ton.BlockchainConfig{
+		ConfigParam15: &tlb.ConfigParam15{
+			ValidatorsElectedFor: 65536,
+			ElectionsStartBefore: 32768,
+			ElectionsEndBefore:   8192,
+			StakeHeldFor:         32768,
+		},
+		ConfigParam32: &tlb.ConfigParam32{PrevValidators: validatorSet(1_000_000-65536, 1_000_000)},
+		ConfigParam34: &tlb.ConfigParam34{CurValidators: validatorSet(1_000_000, 1_000_000+65536)},
+	}
+	tests := []struct {
+		name  string
+		now   int64
+		until int64
+		want  []oas.ChainCalendarEvent
+	}{
+		{
+			name:  "single round",
+			now:   1_010_000,
+			until: 1_070_000,
+			want: []oas.ChainCalendarEvent{
+				{Type: oas.ChainCalendarEventTypeElectionsStart, Utime: 1_000_000 + 65536 - 32768},
+				{Type: oas.ChainCalendarEventTypeElectionsEnd, Utime: 1_000_000 + 65536 - 8192},
+				{Type: oas.ChainCalendarEventTypeValidationRoundEnd, Utime: 1_000_000 + 65536},
+				{Type: oas.ChainCalendarEventTypeStakeUnfreeze, Utime: 1_000_000 + 32768},
+			},
+		},
+		{
+			name:  "events repeat every round",
+			now:   1_040_000,
+			until: 1_040_000 + 2*65536,
+			want: []oas.ChainCalendarEvent{
+				{Type: oas.ChainCalendarEventTypeElectionsStart, Utime: 1_000_000 + 2*65536 - 32768},
+				{Type: oas.ChainCalendarEventTypeElectionsStart, Utime: 1_000_000 + 3*65536 - 32768},
+				{Type: oas.ChainCalendarEventTypeElectionsEnd, Utime: 1_000_000 + 65536 - 8192},
+				{Type: oas.ChainCalendarEventTypeElectionsEnd, Utime: 1_000_000 + 2*65536 - 8192},
+				{Type: oas.ChainCalendarEventTypeValidationRoundEnd, Utime: 1_000_000 + 65536},
+				{Type: oas.ChainCalendarEventTypeValidationRoundEnd, Utime: 1_000_000 + 2*65536},
+				{Type: oas.ChainCalendarEventTypeStakeUnfreeze, Utime: 1_000_000 + 65536 + 32768},
+				{Type: oas.ChainCalendarEventTypeStakeUnfreeze, Utime: 1_000_000 + 2*65536 + 32768},
+			},
+		},
+		{
+			name:  "nothing happens",
+			now:   1_010_000,
+			until: 1_020_000,
+			want:  []oas.ChainCalendarEvent{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := validationCalendar(config, tt.now, tt.until)
+			require.Nil(t, err)
+			require.Equal(t, tt.want, events)
+		})
+	}
+}
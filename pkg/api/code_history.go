@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tvm"
+
+	"github.com/tonkeeper/opentonapi/pkg/cache"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+func (h *Handler) GetAccountCodeHistory(ctx context.Context, params oas.GetAccountCodeHistoryParams) (*oas.AccountCodeHistory, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if t, ok := tenant.FromContext(ctx); ok && !t.Watches(account.ID) {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("account is not tracked"))
+	}
+	versions, err := h.storage.GetAccountCodeHistory(ctx, account.ID)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := oas.AccountCodeHistory{
+		Upgrades: make([]oas.AccountCodeUpgrade, 0, len(versions)),
+	}
+	var prevInterfaces []string
+	for i, version := range versions {
+		interfaces, err := h.codeInterfaces(ctx, account.ID, version)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		upgrade := oas.AccountCodeUpgrade{
+			Block:             version.BlockID.BlockID.String(),
+			Lt:                int64(version.Lt),
+			TxHash:            version.Hash.Hex(),
+			Utime:             version.Utime,
+			CodeHash:          version.CodeHash.Hex(),
+			Interfaces:        interfaces,
+			AddedInterfaces:   subtractInterfaces(interfaces, prevInterfaces),
+			RemovedInterfaces: subtractInterfaces(prevInterfaces, interfaces),
+		}
+		if i > 0 {
+			upgrade.PreviousCodeHash = oas.NewOptString(versions[i-1].CodeHash.Hex())
+		}
+		result.Upgrades = append(result.Upgrades, upgrade)
+		prevInterfaces = interfaces
+	}
+	return &result, nil
+}
+
+// codeInterfaces detects interfaces implemented by the given version of the account's code,
+// get methods are executed against the account's data as of the upgrade.
+// Interfaces are cached by the code hash, so popular code isn't run in the emulator on every request.
+func (h *Handler) codeInterfaces(ctx context.Context, account tongo.AccountID, version core.CodeVersion) ([]string, error) {
+	if interfaces, ok := h.codeInterfacesCache.Get(version.CodeHash); ok {
+		return interfaces, nil
+	}
+	code, err := singleRootCell(version.Code)
+	if err != nil {
+		return nil, err
+	}
+	data := boc.NewCell()
+	if len(version.Data) > 0 {
+		if data, err = singleRootCell(version.Data); err != nil {
+			return nil, err
+		}
+	}
+	options := []tvm.Option{tvm.WithLibraryResolver(h.storage)}
+	if configObject, ok := h.configPool.Get().(*tvm.Config); ok && configObject != nil {
+		defer h.configPool.Put(configObject)
+		options = append(options, tvm.WithConfig(configObject))
+	}
+	emulator, err := tvm.NewEmulator(code, data, nil, options...)
+	if err != nil {
+		return nil, err
+	}
+	if err := emulator.SetGasLimit(10_000_000); err != nil {
+		return nil, err
+	}
+	description, err := abi.NewContractInspector(abi.InspectWithLibraryResolver(h.storage)).InspectContract(ctx, version.Code, emulator, account)
+	if err != nil {
+		return nil, err
+	}
+	interfaces := make([]string, 0, len(description.ContractInterfaces))
+	for _, iface := range description.ContractInterfaces {
+		interfaces = append(interfaces, iface.String())
+	}
+	h.codeInterfacesCache.Set(version.CodeHash, interfaces, cache.WithExpiration(time.Hour))
+	return interfaces, nil
+}
+
+func singleRootCell(b []byte) (*boc.Cell, error) {
+	cells, err := boc.DeserializeBoc(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(cells) != 1 {
+		return nil, boc.ErrNotSingleRoot
+	}
+	return cells[0], nil
+}
+
+// subtractInterfaces returns interfaces of a missing in b.
+func subtractInterfaces(a, b []string) []string {
+	result := []string{}
+	for _, iface := range a {
+		if !slices.Contains(b, iface) {
+			result = append(result, iface)
+		}
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/cache"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+func TestHandler_codeInterfaces_cached(t *testing.T) {
+	h := &Handler{codeInterfacesCache: cache.NewLRUCache[tongo.Bits256, []string](10, "test_code_interfaces")}
+	codeHash := tongo.MustParseHash("c5ca880c8e667af78d193ac5d2f1437c2bcec08d16436f6579f3493e556b4f48")
+	h.codeInterfacesCache.Set(codeHash, []string{"wallet_v4r2"})
+
+	// the code isn't even a valid boc, cached interfaces are returned without running it.
+	version := core.CodeVersion{CodeHash: codeHash, Code: []byte("not a boc")}
+	interfaces, err := h.codeInterfaces(context.Background(), tongo.AccountID{}, version)
+	require.Nil(t, err)
+	require.Equal(t, []string{"wallet_v4r2"}, interfaces)
+
+	_, err = h.codeInterfaces(context.Background(), tongo.AccountID{}, core.CodeVersion{Code: []byte("not a boc")})
+	require.NotNil(t, err)
+}
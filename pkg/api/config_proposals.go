@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func (h *Handler) GetConfigProposals(ctx context.Context) (*oas.ConfigProposals, error) {
+	rawConfig, err := h.storage.GetConfigRaw(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	params, err := ton.DecodeConfigParams(rawConfig)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	// the config contract identifies a validator set by a hash of the cell of config param 34,
+	// so the hash has to be taken before the param is decoded.
+	vset, ok := params.Config.Get(34)
+	if !ok {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("there is no current validators set in blockchain config"))
+	}
+	vsetID, err := vset.Value.Hash256()
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	config, _, err := ton.ConvertBlockchainConfig(params, true)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	if config.ConfigParam11 == nil || config.ConfigParam34 == nil {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("blockchain config doesn't contain voting setup"))
+	}
+	configAddr, ok := config.ConfigAddr()
+	if !ok {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("can't get config contract address"))
+	}
+	proposals, err := h.listConfigProposals(ctx, configAddr)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	weights, totalWeight := validatorWeights(config.ConfigParam34.CurValidators)
+	result := oas.ConfigProposals{
+		Proposals: make([]oas.ConfigProposalVoting, 0, len(proposals)),
+	}
+	for _, p := range proposals {
+		proposal, err := convertConfigProposal(p)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		setup := config.ConfigParam11.ConfigVotingSetup.NormalParams
+		if p.Critical {
+			setup = config.ConfigParam11.ConfigVotingSetup.CriticalParams
+		}
+		result.Proposals = append(result.Proposals, oas.ConfigProposalVoting{
+			Proposal:    proposal,
+			VotedWeight: int64(p.VotedWeight(tongo.Bits256(vsetID), weights)),
+			TotalWeight: int64(totalWeight),
+			MinWins:     int32(setup.MinWins),
+			MaxLosses:   int32(setup.MaxLosses),
+		})
+	}
+	return &result, nil
+}
+
+// validatorWeights returns weights of validators of the set by their indexes and the total weight of the set.
+func validatorWeights(set tlb.ValidatorSet) (map[int32]uint64, uint64) {
+	var items []tlb.HashmapItem[tlb.Uint16, tlb.ValidatorDescr]
+	switch set.SumType {
+	case "Validators":
+		items = set.Validators.List.Items()
+	case "ValidatorsExt":
+		items = set.ValidatorsExt.List.Items()
+	}
+	weights := make(map[int32]uint64, len(items))
+	var total uint64
+	for _, item := range items {
+		var weight uint64
+		switch item.Value.SumType {
+		case "Validator":
+			weight = item.Value.Validator.Weight
+		case "ValidatorAddr":
+			weight = item.Value.ValidatorAddr.Weight
+		}
+		weights[int32(item.Key)] = weight
+		total += weight
+	}
+	return weights, total
+}
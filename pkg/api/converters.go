@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
 	"reflect"
 	"strconv"
 	"strings"
@@ -17,20 +18,29 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
 	imgGenerator "github.com/tonkeeper/opentonapi/pkg/image"
 	"github.com/tonkeeper/opentonapi/pkg/oas"
 	walletPkg "github.com/tonkeeper/opentonapi/pkg/wallet"
 )
 
+// toError converts an error to a response with the given status.
+// Known domain errors responded with 500 Internal Server Error get their own status, see errcode.Status.
 func toError(code int, err error) *oas.ErrorStatusCode {
+	if code == http.StatusInternalServerError {
+		if status, ok := errcode.Status(err); ok {
+			code = status
+		}
+	}
+	errorCode := string(errcode.Of(code, err))
 	if strings.HasPrefix(err.Error(), "failed to connect to") || strings.Contains(err.Error(), "host=") {
-		return &oas.ErrorStatusCode{StatusCode: code, Response: oas.Error{Error: "unknown error"}}
+		return &oas.ErrorStatusCode{StatusCode: code, Response: oas.Error{Error: "unknown error", ErrorCode: errorCode}}
 	}
 	if s, ok := status.FromError(err); ok {
-		return &oas.ErrorStatusCode{StatusCode: code, Response: oas.Error{Error: s.Message()}}
+		return &oas.ErrorStatusCode{StatusCode: code, Response: oas.Error{Error: s.Message(), ErrorCode: errorCode}}
 	}
 	msg := err.Error()
-	return &oas.ErrorStatusCode{StatusCode: code, Response: oas.Error{Error: msg}}
+	return &oas.ErrorStatusCode{StatusCode: code, Response: oas.Error{Error: msg, ErrorCode: errorCode}}
 }
 
 func anyToJSONRawMap(a any) map[string]jx.Raw { //todo: переписать этот ужас
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/deposits"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	pusherErrors "github.com/tonkeeper/opentonapi/pkg/pusher/errors"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/events"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sse"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+// depositSource provides classifications of incoming transfers, see deposits.Matcher.
+type depositSource interface {
+	SubscribeToDeposits(ctx context.Context, deliveryFn sources.DeliveryFn, opts deposits.SubscribeOptions) sources.CancelFn
+}
+
+// WithDepositSource exposes classifications of incoming transfers at /v2/sse/deposits.
+func WithDepositSource(src depositSource) ServerOption {
+	return func(options *ServerOptions) {
+		options.depositSource = src
+	}
+}
+
+// subscribeToDeposits streams classifications of incoming transfers to the accounts of the "accounts" query parameter.
+func subscribeToDeposits(sseHandler *sse.Handler, source depositSource) sse.HandlerFunc {
+	return func(session sse.Session, request *http.Request) error {
+		var opts deposits.SubscribeOptions
+		accounts := request.URL.Query().Get("accounts")
+		if strings.ToUpper(accounts) == "ALL" {
+			opts.AllAccounts = true
+		} else {
+			for _, str := range strings.Split(accounts, ",") {
+				account, err := tongo.ParseAddress(str)
+				if err != nil {
+					return pusherErrors.BadRequest(fmt.Sprintf("failed to parse query parameters: %v", err))
+				}
+				opts.Accounts = append(opts.Accounts, account.ID)
+			}
+		}
+		if err := utils.LimitsFromContext(request.Context()).CheckAccounts(len(opts.Accounts)); err != nil {
+			return pusherErrors.SubscriptionLimitExceeded(err.Error())
+		}
+		var err error
+		opts.Accounts, opts.AllAccounts, err = utils.ScopeAccounts(request.Context(), opts.Accounts, opts.AllAccounts)
+		if err != nil {
+			return pusherErrors.Forbidden(err.Error())
+		}
+		cancelFn := source.SubscribeToDeposits(request.Context(), sseHandler.Deliver(session, events.DepositEvent), opts)
+		session.SetCancelFn(cancelFn)
+		return nil
+	}
+}
+
+// depositsScope returns a tenant the expected deposits of the request are limited to,
+// nil means the request has the admin scope and manages expectations of all tenants.
+func (h *Handler) depositsScope(ctx context.Context) (*tenant.Tenant, error) {
+	if h.deposits == nil {
+		return nil, toError(http.StatusNotImplemented, fmt.Errorf("expected deposits are not configured"))
+	}
+	if t, ok := tenant.FromContext(ctx); ok {
+		return t, nil
+	}
+	if !hasAdminScope(ctx) {
+		return nil, toError(http.StatusForbidden, fmt.Errorf("tenant token or token with admin scope is required"))
+	}
+	return nil, nil
+}
+
+func convertExpectedDeposit(e deposits.Expectation) oas.ExpectedDeposit {
+	result := oas.ExpectedDeposit{
+		ID:        e.ID,
+		Account:   e.Account.ToRaw(),
+		MinAmount: e.MinAmount,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.Comment != "" {
+		result.Comment = oas.NewOptString(e.Comment)
+	}
+	return result
+}
+
+func (h *Handler) GetExpectedDeposits(ctx context.Context, params oas.GetExpectedDepositsParams) (*oas.ExpectedDeposits, error) {
+	t, err := h.depositsScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var account *tongo.AccountID
+	if params.AccountID.Value != "" {
+		a, err := tongo.ParseAddress(params.AccountID.Value)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		account = &a.ID
+	}
+	list := h.deposits.List(func(e deposits.Expectation) bool {
+		if t != nil && e.Tenant != t.Name() {
+			return false
+		}
+		return account == nil || e.Account == *account
+	})
+	result := oas.ExpectedDeposits{Deposits: make([]oas.ExpectedDeposit, 0, len(list))}
+	for _, e := range list {
+		result.Deposits = append(result.Deposits, convertExpectedDeposit(e))
+	}
+	return &result, nil
+}
+
+func (h *Handler) AddExpectedDeposit(ctx context.Context, request *oas.AddExpectedDepositReq) (*oas.ExpectedDeposit, error) {
+	t, err := h.depositsScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := tongo.ParseAddress(request.Account)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	var tenantName string
+	if t != nil {
+		if !t.Watches(account.ID) {
+			return nil, toError(http.StatusForbidden, fmt.Errorf("account is not tracked"))
+		}
+		tenantName = t.Name()
+	}
+	e, err := h.deposits.Add(account.ID, request.Comment.Value, request.MinAmount.Value, tenantName)
+	if errors.Is(err, deposits.ErrInvalidExpectation) {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := convertExpectedDeposit(e)
+	return &result, nil
+}
+
+func (h *Handler) DeleteExpectedDeposit(ctx context.Context, params oas.DeleteExpectedDepositParams) error {
+	t, err := h.depositsScope(ctx)
+	if err != nil {
+		return err
+	}
+	e, ok := h.deposits.Get(params.ID)
+	if !ok || (t != nil && e.Tenant != t.Name()) {
+		return toError(http.StatusNotFound, fmt.Errorf("expected deposit not found"))
+	}
+	deleted, err := h.deposits.Delete(params.ID)
+	if err != nil {
+		return toError(http.StatusInternalServerError, err)
+	}
+	if !deleted {
+		return toError(http.StatusNotFound, fmt.Errorf("expected deposit not found"))
+	}
+	return nil
+}
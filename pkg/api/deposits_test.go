@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/deposits"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+func TestHandler_ExpectedDeposits(t *testing.T) {
+	exchange := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	other := tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+	registry, err := deposits.NewRegistry("")
+	require.Nil(t, err)
+	h := &Handler{deposits: registry}
+
+	tenants, err := tenant.NewRegistry(tenant.Config{Name: "exchange", Tokens: []string{"token"}, Accounts: []tongo.AccountID{exchange}})
+	require.Nil(t, err)
+	exchangeTenant, ok := tenants.Authenticate("token")
+	require.True(t, ok)
+	tenantCtx := tenant.NewContext(context.Background(), exchangeTenant)
+	admin := context.WithValue(context.Background(), adminScopeKey{}, true)
+
+	requireStatus := func(t *testing.T, err error, status int) {
+		var statusErr *oas.ErrorStatusCode
+		require.True(t, errors.As(err, &statusErr))
+		require.Equal(t, status, statusErr.StatusCode)
+	}
+
+	_, err = h.GetExpectedDeposits(context.Background(), oas.GetExpectedDepositsParams{})
+	requireStatus(t, err, http.StatusForbidden)
+	_, err = (&Handler{}).GetExpectedDeposits(admin, oas.GetExpectedDepositsParams{})
+	requireStatus(t, err, http.StatusNotImplemented)
+
+	// a tenant registers deposits to its own accounts only.
+	_, err = h.AddExpectedDeposit(tenantCtx, &oas.AddExpectedDepositReq{Account: other.ToRaw()})
+	requireStatus(t, err, http.StatusForbidden)
+	_, err = h.AddExpectedDeposit(tenantCtx, &oas.AddExpectedDepositReq{Account: exchange.ToRaw(), MinAmount: oas.NewOptInt64(-1)})
+	requireStatus(t, err, http.StatusBadRequest)
+	expected, err := h.AddExpectedDeposit(tenantCtx, &oas.AddExpectedDepositReq{Account: exchange.ToRaw(), Comment: oas.NewOptString("user-1")})
+	require.Nil(t, err)
+	require.Equal(t, "user-1", expected.Comment.Value)
+	_, err = h.AddExpectedDeposit(admin, &oas.AddExpectedDepositReq{Account: other.ToRaw()})
+	require.Nil(t, err)
+
+	list, err := h.GetExpectedDeposits(tenantCtx, oas.GetExpectedDepositsParams{})
+	require.Nil(t, err)
+	require.Len(t, list.Deposits, 1)
+	list, err = h.GetExpectedDeposits(admin, oas.GetExpectedDepositsParams{AccountID: oas.NewOptString(other.ToRaw())})
+	require.Nil(t, err)
+	require.Len(t, list.Deposits, 1)
+	require.NotEqual(t, expected.ID, list.Deposits[0].ID)
+
+	// expectations of the operator are invisible to tenants.
+	err = h.DeleteExpectedDeposit(tenantCtx, oas.DeleteExpectedDepositParams{ID: list.Deposits[0].ID})
+	requireStatus(t, err, http.StatusNotFound)
+	require.Nil(t, h.DeleteExpectedDeposit(tenantCtx, oas.DeleteExpectedDepositParams{ID: expected.ID}))
+	err = h.DeleteExpectedDeposit(admin, oas.DeleteExpectedDepositParams{ID: expected.ID})
+	requireStatus(t, err, http.StatusNotFound)
+}
@@ -100,6 +100,11 @@ func (h *Handler) DnsResolve(ctx context.Context, params oas.DnsResolveParams) (
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
 	}
+	result := convertDnsRecords(records, h.addressBook)
+	return &result, nil
+}
+
+func convertDnsRecords(records []tlb.DNSRecord, book addressBook) oas.DnsRecord {
 	result := oas.DnsRecord{}
 	for _, r := range records {
 		switch r.SumType {
@@ -110,7 +115,7 @@ func (h *Handler) DnsResolve(ctx context.Context, params oas.DnsResolveParams) (
 				Address: convertMsgAddress(r.DNSSmcAddress.Address),
 				Names:   r.DNSSmcAddress.SmcCapability.Name,
 			}
-			w.Account = convertAccountAddress(ton.MustParseAccountID(w.Address), h.addressBook)
+			w.Account = convertAccountAddress(ton.MustParseAccountID(w.Address), book)
 			for _, c := range r.DNSSmcAddress.SmcCapability.Interfaces {
 				switch c {
 				case "seqno":
@@ -131,7 +136,7 @@ func (h *Handler) DnsResolve(ctx context.Context, params oas.DnsResolveParams) (
 			result.Storage.SetTo(r.DNSStorageAddress.Hex())
 		}
 	}
-	return &result, nil
+	return result
 }
 
 func (h *Handler) GetDnsInfo(ctx context.Context, params oas.GetDnsInfoParams) (*oas.DomainInfo, error) {
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/ogen-go/ogen/middleware"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// EndpointGroup is a group of endpoints an operator can disable as a whole,
+// e.g. to run a minimal read-only surface.
+type EndpointGroup string
+
+const (
+	EndpointGroupNFT       EndpointGroup = "nft"
+	EndpointGroupJettons   EndpointGroup = "jettons"
+	EndpointGroupStaking   EndpointGroup = "staking"
+	EndpointGroupEmulation EndpointGroup = "emulation"
+	// EndpointGroupEvents covers endpoints returning actions found in traces.
+	EndpointGroupEvents EndpointGroup = "events"
+	// EndpointGroupSend covers all endpoints broadcasting messages to the blockchain.
+	EndpointGroupSend EndpointGroup = "send"
+)
+
+// endpointGroupTags maps groups to tags from api/openapi.yml, an operation with any of the tags belongs to the group.
+var endpointGroupTags = map[EndpointGroup][]string{
+	EndpointGroupNFT:       {"NFT"},
+	EndpointGroupJettons:   {"Jettons"},
+	EndpointGroupStaking:   {"Staking"},
+	EndpointGroupEmulation: {"Emulation"},
+	EndpointGroupEvents:    {"Events", "Traces"},
+}
+
+// endpointGroupOperations lists operations of groups that don't match a tag.
+var endpointGroupOperations = map[EndpointGroup][]string{
+	EndpointGroupSend: {"sendBlockchainMessage", "sendRawMessage", "gaslessSend"},
+	// inspectContractCode runs get methods of a contract in the emulator.
+	EndpointGroupEmulation: {"inspectContractCode"},
+	EndpointGroupEvents: {"getAccountEvents", "getAccountEventsDelta", "getAccountEvent", "getAccountTraces",
+		"getAccountJettonsHistory", "getAccountJettonHistoryByID", "getAccountNftHistory", "getNftHistoryByID", "getJettonsEvents"},
+}
+
+// operationParameter is a query parameter of an operation.
+type operationParameter struct {
+	OperationID string
+	Name        string
+}
+
+// endpointGroupParameters lists boolean parameters making operations outside a group use the group,
+// they are rejected when the group is disabled.
+var endpointGroupParameters = map[EndpointGroup][]operationParameter{
+	EndpointGroupEmulation: {{OperationID: "getTrace", Name: "emulate_pending"}},
+}
+
+// WithDisabledEndpointGroups makes endpoints of the given groups respond with 404 Not Found
+// and removes them from the specification served at /v2/openapi.json,
+// parameters of other endpoints depending on the groups are rejected and removed as well.
+func WithDisabledEndpointGroups(groups ...EndpointGroup) ServerOption {
+	return func(options *ServerOptions) {
+		options.disabledGroups = append(options.disabledGroups, groups...)
+	}
+}
+
+func (o *ServerOptions) groupDisabled(group EndpointGroup) bool {
+	return slices.Contains(o.disabledGroups, group)
+}
+
+// groupOperations returns IDs of operations of the given groups found in the specification.
+func groupOperations(spec []byte, groups []EndpointGroup) ([]string, error) {
+	tags := map[string]struct{}{}
+	var operations []string
+	for _, group := range groups {
+		groupTags, hasTags := endpointGroupTags[group]
+		groupOperations, hasOperations := endpointGroupOperations[group]
+		if !hasTags && !hasOperations {
+			return nil, fmt.Errorf("unknown endpoint group %q", group)
+		}
+		for _, tag := range groupTags {
+			tags[tag] = struct{}{}
+		}
+		operations = append(operations, groupOperations...)
+	}
+	if len(tags) == 0 {
+		return operations, nil
+	}
+	var doc struct {
+		Paths map[string]map[string]struct {
+			OperationID string   `json:"operationId"`
+			Tags        []string `json:"tags"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+	for _, item := range doc.Paths {
+		for _, operation := range item {
+			for _, tag := range operation.Tags {
+				if _, ok := tags[tag]; ok && !slices.Contains(operations, operation.OperationID) {
+					operations = append(operations, operation.OperationID)
+				}
+			}
+		}
+	}
+	slices.Sort(operations)
+	return operations, nil
+}
+
+// groupParameters returns parameters depending on the given groups.
+func groupParameters(groups []EndpointGroup) []operationParameter {
+	var parameters []operationParameter
+	for _, group := range groups {
+		parameters = append(parameters, endpointGroupParameters[group]...)
+	}
+	return parameters
+}
+
+// disabledParametersMiddleware responds with 403 Forbidden to requests enabling a parameter of a disabled group.
+func disabledParametersMiddleware(parameters []operationParameter) middleware.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		for _, parameter := range parameters {
+			if parameter.OperationID != req.OperationID {
+				continue
+			}
+			value, ok := req.Params[middleware.ParameterKey{Name: parameter.Name, In: "query"}].(oas.OptBool)
+			if ok && value.Value {
+				return middleware.Response{}, toError(http.StatusForbidden, fmt.Errorf("%v is disabled on this instance", parameter.Name))
+			}
+		}
+		return next(req)
+	}
+}
+
+// disabledOperationsMiddleware responds to disabled operations as if they didn't exist.
+func disabledOperationsMiddleware(operations []string) middleware.Middleware {
+	disabled := make(map[string]struct{}, len(operations))
+	for _, operationID := range operations {
+		disabled[operationID] = struct{}{}
+	}
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		if _, ok := disabled[req.OperationID]; ok {
+			return middleware.Response{}, toError(http.StatusNotFound, fmt.Errorf("endpoint is disabled on this instance"))
+		}
+		return next(req)
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_groupOperations(t *testing.T) {
+	tests := []struct {
+		name       string
+		groups     []EndpointGroup
+		wantOps    []string
+		wantNotOps []string
+		wantErr    bool
+	}{
+		{
+			name:       "nft",
+			groups:     []EndpointGroup{EndpointGroupNFT},
+			wantOps:    []string{"getNftItemByAddress", "getNftCollections"},
+			wantNotOps: []string{"getJettons", "getAccount"},
+		},
+		{
+			name:       "send",
+			groups:     []EndpointGroup{EndpointGroupSend},
+			wantOps:    []string{"sendBlockchainMessage", "gaslessSend"},
+			wantNotOps: []string{"getNftItemByAddress"},
+		},
+		{
+			name:       "events",
+			groups:     []EndpointGroup{EndpointGroupEvents},
+			wantOps:    []string{"getAccountEvents", "getTrace"},
+			wantNotOps: []string{"getAccount"},
+		},
+		{
+			name:    "jettons and emulation",
+			groups:  []EndpointGroup{EndpointGroupJettons, EndpointGroupEmulation},
+			wantOps: []string{"getJettons", "emulateMessageToWallet", "emulateMessageToEvent", "inspectContractCode"},
+		},
+		{
+			name:       "nothing disabled",
+			wantNotOps: []string{"getAccount"},
+		},
+		{
+			name:    "unknown group",
+			groups:  []EndpointGroup{"accounts"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operations, err := groupOperations(opentonapi.OpenAPISpec, tt.groups)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			for _, op := range tt.wantOps {
+				require.Contains(t, operations, op)
+			}
+			for _, op := range tt.wantNotOps {
+				require.NotContains(t, operations, op)
+			}
+			doc, err := filterOperations(opentonapi.OpenAPISpec, operations)
+			require.Nil(t, err)
+			filtered, err := json.Marshal(doc)
+			require.Nil(t, err)
+			for _, op := range tt.wantOps {
+				require.NotContains(t, string(filtered), `"operationId":"`+op+`"`)
+			}
+		})
+	}
+}
+
+func Test_disabledOperationsMiddleware(t *testing.T) {
+	mw := disabledOperationsMiddleware([]string{"getNftItemByAddress"})
+	next := func(req middleware.Request) (middleware.Response, error) {
+		return middleware.Response{}, nil
+	}
+	_, err := mw(middleware.Request{Context: context.Background(), OperationID: "getAccount"}, next)
+	require.Nil(t, err)
+
+	_, err = mw(middleware.Request{Context: context.Background(), OperationID: "getNftItemByAddress"}, next)
+	var errResp *oas.ErrorStatusCode
+	require.True(t, errors.As(err, &errResp))
+	require.Equal(t, http.StatusNotFound, errResp.StatusCode)
+}
+
+func Test_disabledParametersMiddleware(t *testing.T) {
+	mw := disabledParametersMiddleware(groupParameters([]EndpointGroup{EndpointGroupEmulation}))
+	next := func(req middleware.Request) (middleware.Response, error) {
+		return middleware.Response{}, nil
+	}
+	key := middleware.ParameterKey{Name: "emulate_pending", In: "query"}
+	_, err := mw(middleware.Request{Context: context.Background(), OperationID: "getTrace",
+		Params: middleware.Parameters{key: oas.NewOptBool(false)}}, next)
+	require.Nil(t, err)
+
+	_, err = mw(middleware.Request{Context: context.Background(), OperationID: "getTrace",
+		Params: middleware.Parameters{key: oas.NewOptBool(true)}}, next)
+	var errResp *oas.ErrorStatusCode
+	require.True(t, errors.As(err, &errResp))
+	require.Equal(t, http.StatusForbidden, errResp.StatusCode)
+}
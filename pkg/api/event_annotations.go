@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/annotations"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+// annotationsScope returns a name of the tenant the annotations of the request belong to,
+// an empty name means the request has the admin scope and manages the operator's annotations.
+func (h *Handler) annotationsScope(ctx context.Context) (string, error) {
+	if h.annotations == nil {
+		return "", toError(http.StatusNotImplemented, fmt.Errorf("event annotations are not configured"))
+	}
+	if t, ok := tenant.FromContext(ctx); ok {
+		return t.Name(), nil
+	}
+	if !hasAdminScope(ctx) {
+		return "", toError(http.StatusForbidden, fmt.Errorf("tenant token or token with admin scope is required"))
+	}
+	return "", nil
+}
+
+// annotatedEventID returns an ID of the event containing a transaction with the given hash,
+// so an annotation attached by a hash of any transaction is returned along with the event.
+func (h *Handler) annotatedEventID(ctx context.Context, id string) (tongo.Bits256, error) {
+	hash, err := tongo.ParseHash(id)
+	if err != nil {
+		return tongo.Bits256{}, toError(http.StatusBadRequest, err)
+	}
+	trace, err := h.storage.GetTrace(ctx, hash)
+	if errors.Is(err, core.ErrEntityNotFound) {
+		return tongo.Bits256{}, toError(http.StatusNotFound, fmt.Errorf("event not found"))
+	}
+	if err != nil {
+		return tongo.Bits256{}, toError(http.StatusInternalServerError, err)
+	}
+	return trace.Hash, nil
+}
+
+// eventAnnotation returns an annotation of the event visible to the request.
+func (h *Handler) eventAnnotation(ctx context.Context, eventID tongo.Bits256) oas.OptEventAnnotation {
+	if h.annotations == nil {
+		return oas.OptEventAnnotation{}
+	}
+	var tenantName string
+	if t, ok := tenant.FromContext(ctx); ok {
+		tenantName = t.Name()
+	} else if !hasAdminScope(ctx) {
+		return oas.OptEventAnnotation{}
+	}
+	a, ok := h.annotations.Get(tenantName, eventID)
+	if !ok {
+		return oas.OptEventAnnotation{}
+	}
+	return oas.NewOptEventAnnotation(convertEventAnnotation(a))
+}
+
+func convertEventAnnotation(a annotations.Annotation) oas.EventAnnotation {
+	result := oas.EventAnnotation{
+		Tags:      a.Tags,
+		UpdatedAt: a.UpdatedAt,
+	}
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+	if a.Category != "" {
+		result.Category = oas.NewOptString(a.Category)
+	}
+	if a.Note != "" {
+		result.Note = oas.NewOptString(a.Note)
+	}
+	return result
+}
+
+func (h *Handler) GetEventAnnotation(ctx context.Context, params oas.GetEventAnnotationParams) (*oas.EventAnnotation, error) {
+	tenantName, err := h.annotationsScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := h.annotatedEventID(ctx, params.EventID)
+	if err != nil {
+		return nil, err
+	}
+	a, ok := h.annotations.Get(tenantName, eventID)
+	if !ok {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("event annotation not found"))
+	}
+	result := convertEventAnnotation(a)
+	return &result, nil
+}
+
+func (h *Handler) SetEventAnnotation(ctx context.Context, request *oas.SetEventAnnotationReq, params oas.SetEventAnnotationParams) (*oas.EventAnnotation, error) {
+	tenantName, err := h.annotationsScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := h.annotatedEventID(ctx, params.EventID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := h.annotations.Set(tenantName, eventID, request.Tags, request.Category.Value, request.Note.Value)
+	if errors.Is(err, annotations.ErrInvalidAnnotation) {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := convertEventAnnotation(a)
+	return &result, nil
+}
+
+func (h *Handler) DeleteEventAnnotation(ctx context.Context, params oas.DeleteEventAnnotationParams) error {
+	tenantName, err := h.annotationsScope(ctx)
+	if err != nil {
+		return err
+	}
+	eventID, err := h.annotatedEventID(ctx, params.EventID)
+	if err != nil {
+		return err
+	}
+	deleted, err := h.annotations.Delete(tenantName, eventID)
+	if err != nil {
+		return toError(http.StatusInternalServerError, err)
+	}
+	if !deleted {
+		return toError(http.StatusNotFound, fmt.Errorf("event annotation not found"))
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/annotations"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+func TestHandler_eventAnnotation(t *testing.T) {
+	event := tongo.MustParseHash("55e8809519cd3c49098c9ee45afdafcea7a894a74d0f628d94a115a50e045122")
+	tenants, err := tenant.NewRegistry(
+		tenant.Config{Name: "treasury", Tokens: []string{"treasury-token"}},
+		tenant.Config{Name: "other", Tokens: []string{"other-token"}},
+	)
+	require.Nil(t, err)
+	treasury, _ := tenants.Authenticate("treasury-token")
+	other, _ := tenants.Authenticate("other-token")
+
+	store, err := annotations.NewStore("")
+	require.Nil(t, err)
+	_, err = store.Set("treasury", event, []string{"payroll"}, "expense", "")
+	require.Nil(t, err)
+	_, err = store.Set("", event, nil, "", "operator's note")
+	require.Nil(t, err)
+	h := &Handler{annotations: store}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantSet  bool
+		wantTags []string
+		wantNote string
+	}{
+		{
+			name:     "tenant gets its own annotation",
+			ctx:      tenant.NewContext(context.Background(), treasury),
+			wantSet:  true,
+			wantTags: []string{"payroll"},
+		},
+		{
+			name: "other tenant gets nothing",
+			ctx:  tenant.NewContext(context.Background(), other),
+		},
+		{
+			name:     "admin gets the operator's annotation",
+			ctx:      context.WithValue(context.Background(), adminScopeKey{}, true),
+			wantSet:  true,
+			wantTags: []string{},
+			wantNote: "operator's note",
+		},
+		{
+			name: "anonymous request gets nothing",
+			ctx:  context.Background(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotation := h.eventAnnotation(tt.ctx, event)
+			require.Equal(t, tt.wantSet, annotation.IsSet())
+			if !tt.wantSet {
+				return
+			}
+			require.Equal(t, tt.wantTags, annotation.Value.Tags)
+			require.Equal(t, tt.wantNote, annotation.Value.Note.Value)
+		})
+	}
+
+	_, err = h.annotationsScope(context.Background())
+	require.Error(t, err)
+	_, err = (&Handler{}).annotationsScope(tenant.NewContext(context.Background(), treasury))
+	require.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
 
 	"github.com/tonkeeper/opentonapi/pkg/references"
@@ -56,9 +57,43 @@ func convertTrace(t *core.Trace, book addressBook) oas.Trace {
 	for _, c := range t.Children {
 		trace.Children = append(trace.Children, convertTrace(c, book))
 	}
+	linkTraceBounces(t, &trace)
 	return trace
 }
 
+// setGasProfiles attaches gas profiles to nodes of an already converted trace and returns a profile of the whole trace.
+func setGasProfiles(t *core.Trace, trace *oas.Trace) core.GasProfile {
+	profile := t.Transaction.GasProfile()
+	// convertTrace has sorted children of t, so they are in the same order as children of trace.
+	for i, child := range t.Children {
+		profile.AddDescendant(setGasProfiles(child, &trace.Children[i]))
+	}
+	trace.GasProfile = oas.NewOptGasProfile(convertGasProfile(profile))
+	return profile
+}
+
+func convertGasProfile(profile core.GasProfile) oas.GasProfile {
+	result := oas.GasProfile{
+		GasUsed:            profile.GasUsed,
+		GasLimit:           profile.GasLimit,
+		VMSteps:            profile.VmSteps,
+		TotalGasUsed:       profile.TotalGasUsed,
+		TotalVMSteps:       profile.TotalVmSteps,
+		FailedTransactions: int32(profile.Failed),
+		ExitCodes:          make([]oas.GasProfileExitCodesItem, 0, len(profile.ExitCodes)),
+	}
+	if profile.ExitCode != nil {
+		result.ExitCode = oas.NewOptInt32(*profile.ExitCode)
+	}
+	for code, count := range profile.ExitCodes {
+		result.ExitCodes = append(result.ExitCodes, oas.GasProfileExitCodesItem{ExitCode: code, Count: int32(count)})
+	}
+	sort.Slice(result.ExitCodes, func(i, j int) bool {
+		return result.ExitCodes[i].ExitCode < result.ExitCodes[j].ExitCode
+	})
+	return result
+}
+
 func (h *Handler) convertRisk(ctx context.Context, risk wallet.Risk, walletAddress tongo.AccountID) (oas.Risk, error) {
 	oasRisk := oas.Risk{
 		TransferAllRemainingBalance: risk.TransferAllRemainingBalance,
@@ -66,6 +101,17 @@ func (h *Handler) convertRisk(ctx context.Context, risk wallet.Risk, walletAddre
 		Ton:     int64(risk.Ton),
 		Jettons: nil,
 		Nfts:    nil,
+		// installing an extension or disabling signatures is elevated as it doesn't move any assets by itself.
+		AccountTakeover: risk.AccountTakeover(),
+	}
+	for _, extension := range risk.AddedExtensions {
+		oasRisk.AddedExtensions = append(oasRisk.AddedExtensions, convertAccountAddress(extension, h.addressBook))
+	}
+	for _, extension := range risk.RemovedExtensions {
+		oasRisk.RemovedExtensions = append(oasRisk.RemovedExtensions, convertAccountAddress(extension, h.addressBook))
+	}
+	if risk.SignatureAllowed != nil {
+		oasRisk.SignatureAllowed = oas.NewOptBool(*risk.SignatureAllowed)
 	}
 	if len(risk.Jettons) > 0 {
 		wallets, err := h.storage.GetJettonWalletsByOwnerAddress(ctx, walletAddress, nil, true)
@@ -156,6 +202,38 @@ func (h *Handler) convertActionTonTransfer(t *bath.TonTransferAction, acceptLang
 	return action, simplePreview
 }
 
+func (h *Handler) convertActionExtraCurrencyTransfer(t *bath.ExtraCurrencyTransferAction, acceptLanguage string, viewer *tongo.AccountID) (oas.OptExtraCurrencyTransferAction, oas.ActionSimplePreview) {
+	var action oas.OptExtraCurrencyTransferAction
+	action.SetTo(oas.ExtraCurrencyTransferAction{
+		Currencies:       convertExtraCurrencies(t.Currencies),
+		TonAttached:      t.TonAttached,
+		Comment:          g.Opt(t.Comment),
+		Recipient:        convertAccountAddress(t.Recipient, h.addressBook),
+		Sender:           convertAccountAddress(t.Sender, h.addressBook),
+		EncryptedComment: convertEncryptedComment(t.EncryptedComment),
+	})
+	values := make([]string, 0, len(t.Currencies))
+	for _, c := range t.Currencies {
+		values = append(values, fmt.Sprintf("%v of currency #%v", c.Value, c.ID))
+	}
+	value := strings.Join(values, ", ")
+	simplePreview := oas.ActionSimplePreview{
+		Name: "Extra Currency Transfer",
+		Description: i18n.T(acceptLanguage, i18n.C{
+			DefaultMessage: &i18n.M{
+				ID:    "extraCurrencyTransferAction",
+				Other: "Transferring {{.Value}}",
+			},
+			TemplateData: i18n.Template{
+				"Value": value,
+			},
+		}),
+		Accounts: distinctAccounts(viewer, h.addressBook, &t.Sender, &t.Recipient),
+		Value:    oas.NewOptString(value),
+	}
+	return action, simplePreview
+}
+
 func (h *Handler) convertActionNftTransfer(t *bath.NftTransferAction, acceptLanguage string, viewer *tongo.AccountID) (oas.OptNftItemTransferAction, oas.ActionSimplePreview) {
 	var action oas.OptNftItemTransferAction
 	action.SetTo(oas.NftItemTransferAction{
@@ -185,6 +263,7 @@ func (h *Handler) convertActionJettonTransfer(ctx context.Context, t *bath.Jetto
 	var action oas.OptJettonTransferAction
 	action.SetTo(oas.JettonTransferAction{
 		Amount:           g.Pointer(big.Int(t.Amount)).String(),
+		NormalizedAmount: oas.NewOptString(Scale(t.Amount, meta.Decimals).String()),
 		Recipient:        convertOptAccountAddress(t.Recipient, h.addressBook),
 		Sender:           convertOptAccountAddress(t.Sender, h.addressBook),
 		Jetton:           preview,
@@ -223,6 +302,7 @@ func (h *Handler) convertActionJettonMint(ctx context.Context, m *bath.JettonMin
 	var action oas.OptJettonMintAction
 	action.SetTo(oas.JettonMintAction{
 		Amount:           g.Pointer(big.Int(m.Amount)).String(),
+		NormalizedAmount: oas.NewOptString(Scale(m.Amount, meta.Decimals).String()),
 		Recipient:        convertAccountAddress(m.Recipient, h.addressBook),
 		Jetton:           preview,
 		RecipientsWallet: m.RecipientsWallet.ToRaw(),
@@ -412,6 +492,67 @@ func (h *Handler) convertDomainRenew(ctx context.Context, d *bath.DnsRenewAction
 	return action, simplePreview
 }
 
+func (h *Handler) convertDomainPurchase(d *bath.DomainPurchaseAction, acceptLanguage string, viewer *tongo.AccountID) (oas.OptDomainPurchaseAction, oas.ActionSimplePreview) {
+	var action oas.OptDomainPurchaseAction
+	action.SetTo(oas.DomainPurchaseAction{
+		Domain:          d.Domain,
+		ContractAddress: d.Item.String(),
+		Buyer:           convertAccountAddress(d.Buyer, h.addressBook),
+		Amount: oas.Price{
+			Value:     fmt.Sprintf("%v", d.Amount),
+			TokenName: "TON",
+		},
+	})
+	simplePreview := oas.ActionSimplePreview{
+		Name: "Domain Purchase",
+		Description: i18n.T(acceptLanguage, i18n.C{
+			DefaultMessage: &i18n.M{
+				ID:    "domainPurchaseAction",
+				Other: "Purchase of {{.Value}}",
+			},
+			TemplateData: i18n.Template{"Value": d.Domain},
+		}),
+		Accounts: distinctAccounts(viewer, h.addressBook, &d.Buyer, &d.Item),
+		Value:    oas.NewOptString(d.Domain),
+	}
+	return action, simplePreview
+}
+
+func (h *Handler) convertDnsRecordChange(ctx context.Context, d *bath.DnsRecordChangeAction, acceptLanguage string, viewer *tongo.AccountID) (oas.OptDnsRecordChangeAction, oas.ActionSimplePreview) {
+	var action oas.OptDnsRecordChangeAction
+	var domain = "unknown"
+	nfts, err := h.storage.GetNFTs(ctx, []ton.AccountID{d.Item})
+	if err == nil && len(nfts) == 1 && nfts[0].DNS != nil {
+		domain = *nfts[0].DNS
+	}
+	change := oas.DnsRecordChangeAction{
+		Domain:          domain,
+		ContractAddress: d.Item.String(),
+		Changer:         convertAccountAddress(d.Changer, h.addressBook),
+		Record:          d.Record(),
+	}
+	if d.OldValue != nil {
+		change.OldValue.SetTo(convertDnsRecords([]tlb.DNSRecord{*d.OldValue}, h.addressBook))
+	}
+	if d.NewValue != nil {
+		change.NewValue.SetTo(convertDnsRecords([]tlb.DNSRecord{*d.NewValue}, h.addressBook))
+	}
+	action.SetTo(change)
+	simplePreview := oas.ActionSimplePreview{
+		Name: "DNS Record Change",
+		Description: i18n.T(acceptLanguage, i18n.C{
+			DefaultMessage: &i18n.M{
+				ID:    "dnsRecordChangeAction",
+				Other: "Change {{.Record}} record of {{.Value}}",
+			},
+			TemplateData: i18n.Template{"Value": domain, "Record": change.Record},
+		}),
+		Accounts: distinctAccounts(viewer, h.addressBook, &d.Changer, &d.Item),
+		Value:    oas.NewOptString(domain),
+	}
+	return action, simplePreview
+}
+
 func (h *Handler) convertAction(ctx context.Context, viewer *tongo.AccountID, a bath.Action, acceptLanguage oas.OptString) (oas.Action, error) {
 	action := oas.Action{
 		Type:             oas.ActionType(a.Type),
@@ -433,6 +574,8 @@ func (h *Handler) convertAction(ctx context.Context, viewer *tongo.AccountID, a
 	switch a.Type {
 	case bath.TonTransfer:
 		action.TonTransfer, action.SimplePreview = h.convertActionTonTransfer(a.TonTransfer, acceptLanguage.Value, viewer)
+	case bath.ExtraCurrencyTransfer:
+		action.ExtraCurrencyTransfer, action.SimplePreview = h.convertActionExtraCurrencyTransfer(a.ExtraCurrencyTransfer, acceptLanguage.Value, viewer)
 	case bath.NftItemTransfer:
 		action.NftItemTransfer, action.SimplePreview = h.convertActionNftTransfer(a.NftItemTransfer, acceptLanguage.Value, viewer)
 	case bath.JettonTransfer:
@@ -443,10 +586,11 @@ func (h *Handler) convertAction(ctx context.Context, viewer *tongo.AccountID, a
 		meta := h.GetJettonNormalizedMetadata(ctx, a.JettonBurn.Jetton)
 		preview := jettonPreview(a.JettonBurn.Jetton, meta)
 		action.JettonBurn.SetTo(oas.JettonBurnAction{
-			Amount:        g.Pointer(big.Int(a.JettonBurn.Amount)).String(),
-			Sender:        convertAccountAddress(a.JettonBurn.Sender, h.addressBook),
-			Jetton:        preview,
-			SendersWallet: a.JettonBurn.SendersWallet.ToRaw(),
+			Amount:           g.Pointer(big.Int(a.JettonBurn.Amount)).String(),
+			NormalizedAmount: oas.NewOptString(Scale(a.JettonBurn.Amount, meta.Decimals).String()),
+			Sender:           convertAccountAddress(a.JettonBurn.Sender, h.addressBook),
+			Jetton:           preview,
+			SendersWallet:    a.JettonBurn.SendersWallet.ToRaw(),
 		})
 		if len(preview.Image) > 0 {
 			action.SimplePreview.ValueImage = oas.NewOptString(preview.Image)
@@ -607,6 +751,7 @@ func (h *Handler) convertAction(ctx context.Context, viewer *tongo.AccountID, a
 		swapAction := oas.JettonSwapAction{
 			UserWallet: convertAccountAddress(a.JettonSwap.UserWallet, h.addressBook),
 			Router:     convertAccountAddress(a.JettonSwap.Router, h.addressBook),
+			Sandwich:   convertSandwich(a.JettonSwap.Sandwich, h.addressBook),
 		}
 		simplePreviewData := i18n.Template{}
 		if a.JettonSwap.In.IsTon {
@@ -618,8 +763,10 @@ func (h *Handler) convertAction(ctx context.Context, viewer *tongo.AccountID, a
 			jettonInMeta := h.GetJettonNormalizedMetadata(ctx, a.JettonSwap.In.JettonMaster)
 			preview := jettonPreview(a.JettonSwap.In.JettonMaster, jettonInMeta)
 			swapAction.JettonMasterIn.SetTo(preview)
+			amountIn := ScaleJettons(a.JettonSwap.In.Amount, jettonInMeta.Decimals).String()
+			swapAction.NormalizedAmountIn = oas.NewOptString(amountIn)
 			simplePreviewData["JettonIn"] = preview.GetSymbol()
-			simplePreviewData["AmountIn"] = ScaleJettons(a.JettonSwap.In.Amount, jettonInMeta.Decimals).String()
+			simplePreviewData["AmountIn"] = amountIn
 		}
 		if a.JettonSwap.Out.IsTon {
 			swapAction.TonOut = oas.NewOptInt64(a.JettonSwap.Out.Amount.Int64())
@@ -630,8 +777,10 @@ func (h *Handler) convertAction(ctx context.Context, viewer *tongo.AccountID, a
 			jettonOutMeta := h.GetJettonNormalizedMetadata(ctx, a.JettonSwap.Out.JettonMaster)
 			preview := jettonPreview(a.JettonSwap.Out.JettonMaster, jettonOutMeta)
 			swapAction.JettonMasterOut.SetTo(preview)
+			amountOut := ScaleJettons(a.JettonSwap.Out.Amount, jettonOutMeta.Decimals).String()
+			swapAction.NormalizedAmountOut = oas.NewOptString(amountOut)
 			simplePreviewData["JettonOut"] = preview.GetSymbol()
-			simplePreviewData["AmountOut"] = ScaleJettons(a.JettonSwap.Out.Amount, jettonOutMeta.Decimals).String()
+			simplePreviewData["AmountOut"] = amountOut
 		}
 
 		switch a.JettonSwap.Dex {
@@ -736,6 +885,10 @@ func (h *Handler) convertAction(ctx context.Context, viewer *tongo.AccountID, a
 		action.WithdrawStake, action.SimplePreview = h.convertWithdrawStake(a.WithdrawStake, acceptLanguage.Value, viewer)
 	case bath.DomainRenew:
 		action.DomainRenew, action.SimplePreview = h.convertDomainRenew(ctx, a.DnsRenew, acceptLanguage.Value, viewer)
+	case bath.DomainPurchase:
+		action.DomainPurchase, action.SimplePreview = h.convertDomainPurchase(a.DomainPurchase, acceptLanguage.Value, viewer)
+	case bath.DnsRecordChange:
+		action.DnsRecordChange, action.SimplePreview = h.convertDnsRecordChange(ctx, a.DnsRecordChange, acceptLanguage.Value, viewer)
 
 	}
 	return action, nil
@@ -766,7 +919,9 @@ func (h *Handler) toEvent(ctx context.Context, trace *core.Trace, result *bath.A
 		IsScam:     false,
 		Lt:         int64(trace.Lt),
 		InProgress: trace.InProgress(),
+		Annotation: h.eventAnnotation(ctx, trace.Hash),
 	}
+	h.annotateSandwiches(ctx, trace, result)
 	for i, a := range result.Actions {
 		convertedAction, err := h.convertAction(ctx, nil, a, lang)
 		if err != nil {
@@ -785,8 +940,13 @@ func (h *Handler) toEvent(ctx context.Context, trace *core.Trace, result *bath.A
 			previews[jettonMaster] = jettonPreview(jettonMaster, meta)
 		}
 	}
+	fees := core.ComputeFeeBreakdown(trace)
 	for accountID, flow := range result.ValueFlow.Accounts {
-		event.ValueFlow = append(event.ValueFlow, convertAccountValueFlow(accountID, flow, h.addressBook, previews))
+		valueFlow := convertAccountValueFlow(accountID, flow, h.addressBook, previews)
+		if breakdown, ok := fees[accountID]; ok {
+			valueFlow.FeeBreakdown = oas.NewOptFeeBreakdown(convertFeeBreakdown(breakdown))
+		}
+		event.ValueFlow = append(event.ValueFlow, valueFlow)
 	}
 	return event, nil
 }
@@ -842,7 +1002,13 @@ func (h *Handler) toAccountEvent(ctx context.Context, account tongo.AccountID, t
 		Lt:         int64(trace.Lt),
 		InProgress: trace.InProgress(),
 		Extra:      result.Extra(account),
+		Annotation: h.eventAnnotation(ctx, trace.Hash),
 	}
+	e.StatusChange = convertStatusChange(account, trace)
+	if fees, ok := core.ComputeFeeBreakdown(trace)[account]; ok {
+		e.Fees = oas.NewOptFeeBreakdown(convertFeeBreakdown(fees))
+	}
+	h.annotateSandwiches(ctx, trace, result)
 	for _, a := range result.Actions {
 		if subjectOnly && !a.IsSubject(account) {
 			continue
@@ -864,6 +1030,29 @@ func (h *Handler) toAccountEvent(ctx context.Context, account tongo.AccountID, t
 	return e, nil
 }
 
+// convertStatusChange returns a transition of the account's status made by its transactions in the trace.
+func convertStatusChange(account tongo.AccountID, trace *core.Trace) oas.OptAccountStatusChange {
+	var first, last *core.Transaction
+	core.Visit(trace, func(node *core.Trace) {
+		if node.Account != account {
+			return
+		}
+		if first == nil || node.Lt < first.Lt {
+			first = &node.Transaction
+		}
+		if last == nil || node.Lt > last.Lt {
+			last = &node.Transaction
+		}
+	})
+	if first == nil || first.OrigStatus == "" || last.EndStatus == "" || first.OrigStatus == last.EndStatus {
+		return oas.OptAccountStatusChange{}
+	}
+	return oas.NewOptAccountStatusChange(oas.AccountStatusChange{
+		From: oas.AccountStatus(first.OrigStatus),
+		To:   oas.AccountStatus(last.EndStatus),
+	})
+}
+
 func convertEncryptedComment(comment *bath.EncryptedComment) oas.OptEncryptedComment {
 	c := oas.OptEncryptedComment{}
 	if comment != nil {
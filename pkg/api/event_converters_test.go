@@ -0,0 +1,124 @@
+package api
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_convertStatusChange(t *testing.T) {
+	account := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	other := tongo.MustParseAddress("0:408da3b28b6c065a593e10391269baaa9c5f8caebc0c69d9f0aabbab2a99256b").ID
+	tx := func(account tongo.AccountID, lt uint64, orig, end tlb.AccountStatus) core.Transaction {
+		return core.Transaction{
+			TransactionID: core.TransactionID{Account: account, Lt: lt},
+			OrigStatus:    orig,
+			EndStatus:     end,
+		}
+	}
+	tests := []struct {
+		name  string
+		trace *core.Trace
+		want  oas.OptAccountStatusChange
+	}{
+		{
+			name: "deployment",
+			trace: &core.Trace{
+				Transaction: tx(other, 1, tlb.AccountActive, tlb.AccountActive),
+				Children: []*core.Trace{
+					{Transaction: tx(account, 2, tlb.AccountNone, tlb.AccountActive)},
+				},
+			},
+			want: oas.NewOptAccountStatusChange(oas.AccountStatusChange{From: oas.AccountStatusNonexist, To: oas.AccountStatusActive}),
+		},
+		{
+			name: "deployment and deletion in the same trace",
+			trace: &core.Trace{
+				Transaction: tx(account, 1, tlb.AccountNone, tlb.AccountActive),
+				Children: []*core.Trace{
+					{Transaction: tx(account, 3, tlb.AccountActive, tlb.AccountNone)},
+				},
+			},
+		},
+		{
+			name: "freezing",
+			trace: &core.Trace{
+				Transaction: tx(account, 1, tlb.AccountActive, tlb.AccountFrozen),
+			},
+			want: oas.NewOptAccountStatusChange(oas.AccountStatusChange{From: oas.AccountStatusActive, To: oas.AccountStatusFrozen}),
+		},
+		{
+			name: "another account changes its status",
+			trace: &core.Trace{
+				Transaction: tx(account, 1, tlb.AccountActive, tlb.AccountActive),
+				Children: []*core.Trace{
+					{Transaction: tx(other, 2, tlb.AccountUninit, tlb.AccountActive)},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, convertStatusChange(account, tt.trace))
+		})
+	}
+}
+
+func Test_setGasProfiles(t *testing.T) {
+	computed := func(success bool, exitCode int32, gasUsed int64) core.Transaction {
+		return core.Transaction{ComputePhase: &core.TxComputePhase{
+			Success:  success,
+			ExitCode: exitCode,
+			GasUsed:  *big.NewInt(gasUsed),
+			GasLimit: *big.NewInt(10_000),
+			VmSteps:  uint32(gasUsed / 50),
+		}}
+	}
+	trace := &core.Trace{
+		Transaction: computed(true, 0, 3000),
+		Children: []*core.Trace{
+			{Transaction: computed(false, 709, 1500)},
+			{Transaction: core.Transaction{ComputePhase: &core.TxComputePhase{Skipped: true}}},
+		},
+	}
+	converted := oas.Trace{Children: []oas.Trace{{}, {}}}
+
+	setGasProfiles(trace, &converted)
+
+	require.Equal(t, oas.GasProfile{
+		GasUsed:            3000,
+		GasLimit:           10_000,
+		VMSteps:            60,
+		ExitCode:           oas.NewOptInt32(0),
+		TotalGasUsed:       4500,
+		TotalVMSteps:       90,
+		FailedTransactions: 1,
+		ExitCodes: []oas.GasProfileExitCodesItem{
+			{ExitCode: 0, Count: 1},
+			{ExitCode: 709, Count: 1},
+		},
+	}, converted.GasProfile.Value)
+	require.Equal(t, int64(1500), converted.Children[0].GasProfile.Value.TotalGasUsed)
+	skipped := converted.Children[1].GasProfile.Value
+	require.False(t, skipped.ExitCode.IsSet())
+	require.Empty(t, skipped.ExitCodes)
+}
+
+func Test_convertDnsRecords(t *testing.T) {
+	resolver := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	records := []tlb.DNSRecord{
+		{SumType: "DNSNextResolver", DNSNextResolver: resolver.ToMsgAddress()},
+		{SumType: "DNSStorageAddress", DNSStorageAddress: tlb.Bits256{0xab}},
+	}
+	got := convertDnsRecords(records, &mockAddressBook{})
+	require.Equal(t, oas.NewOptString(resolver.ToRaw()), got.NextResolver)
+	require.Equal(t, oas.NewOptString(tlb.Bits256{0xab}.Hex()), got.Storage)
+	require.False(t, got.Wallet.IsSet())
+	require.Empty(t, got.Sites)
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// maxCursorEvents caps a number of pending and in-progress events tracked by a cursor,
+// so the cursor stays short enough to be passed in a query string.
+const maxCursorEvents = 50
+
+// eventsCursor is a state of a client polling GetAccountEventsDelta.
+// The state travels with the client as an opaque string, so we don't keep anything per client.
+type eventsCursor struct {
+	// Lt is a logical time of the newest on-chain event returned so far.
+	Lt uint64 `json:"lt"`
+	// Pending are hashes of messages returned as pending events.
+	Pending []tongo.Bits256 `json:"p,omitempty"`
+	// InProgress are hashes of traces returned as in-progress events.
+	InProgress []tongo.Bits256 `json:"i,omitempty"`
+}
+
+func (c eventsCursor) encode() (string, error) {
+	if len(c.Pending) > maxCursorEvents {
+		c.Pending = c.Pending[len(c.Pending)-maxCursorEvents:]
+	}
+	if len(c.InProgress) > maxCursorEvents {
+		c.InProgress = c.InProgress[len(c.InProgress)-maxCursorEvents:]
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeEventsCursor(s string) (eventsCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return eventsCursor{}, fmt.Errorf("invalid cursor")
+	}
+	var c eventsCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return eventsCursor{}, fmt.Errorf("invalid cursor")
+	}
+	return c, nil
+}
+
+// GetAccountEventsDelta returns status changes of events returned before first,
+// then new on-chain events from the oldest to the newest one and finally new pending events.
+func (h *Handler) GetAccountEventsDelta(ctx context.Context, params oas.GetAccountEventsDeltaParams) (*oas.AccountEventsDelta, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	var cursor eventsCursor
+	if params.SinceCursor.IsSet() {
+		if cursor, err = decodeEventsCursor(params.SinceCursor.Value); err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+	}
+	lang, subjectOnly := params.AcceptLanguage, params.SubjectOnly.Value
+	next := eventsCursor{Lt: cursor.Lt}
+	var changes []oas.AccountEventChange
+	// reported contains traces that already have a change in this response.
+	reported := map[tongo.Bits256]struct{}{}
+
+	for _, hash := range cursor.Pending {
+		txHash, _ := h.storage.SearchTransactionByMessageHash(ctx, hash)
+		if txHash == nil {
+			if _, ok := h.mempoolEmulate.traces.Get(hash); ok {
+				next.Pending = append(next.Pending, hash)
+				continue
+			}
+			changes = append(changes, oas.AccountEventChange{
+				EventID:        hash.Hex(),
+				Status:         oas.AccountEventStatusDropped,
+				PreviousStatus: oas.NewOptAccountEventStatus(oas.AccountEventStatusPending),
+			})
+			continue
+		}
+		trace, err := h.storage.GetTrace(ctx, *txHash)
+		if err != nil {
+			// the trace is not indexed yet, so we check it again next time.
+			next.Pending = append(next.Pending, hash)
+			continue
+		}
+		change := h.toEventChange(ctx, account.ID, trace, lang, subjectOnly, &next)
+		change.EventID = hash.Hex()
+		change.PreviousStatus.SetTo(oas.AccountEventStatusPending)
+		change.ConfirmedEventID.SetTo(trace.Hash.Hex())
+		changes = append(changes, change)
+		reported[trace.Hash] = struct{}{}
+	}
+	for _, hash := range cursor.InProgress {
+		if _, ok := reported[hash]; ok {
+			continue
+		}
+		trace, err := h.storage.GetTrace(ctx, hash)
+		if err != nil || trace.InProgress() {
+			next.InProgress = append(next.InProgress, hash)
+			continue
+		}
+		change := h.toEventChange(ctx, account.ID, trace, lang, subjectOnly, &next)
+		change.PreviousStatus.SetTo(oas.AccountEventStatusInProgress)
+		changes = append(changes, change)
+		reported[hash] = struct{}{}
+	}
+
+	limit := params.Limit.Or(100)
+	traceIDs, err := h.storage.SearchTraces(ctx, account.ID, limit, nil, nil, nil, false)
+	if err != nil && !errors.Is(err, core.ErrEntityNotFound) {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	var newIDs []core.TraceID
+	for _, traceID := range traceIDs {
+		if traceID.Lt > cursor.Lt {
+			newIDs = append(newIDs, traceID)
+		}
+	}
+	// the page has no events known to the client, so there might be a gap between the page and the cursor.
+	truncated := params.SinceCursor.IsSet() && len(traceIDs) == limit && len(newIDs) == limit
+	for i := len(newIDs) - 1; i >= 0; i-- {
+		traceID := newIDs[i]
+		if traceID.Lt > next.Lt {
+			next.Lt = traceID.Lt
+		}
+		if _, ok := reported[traceID.Hash]; ok {
+			continue
+		}
+		trace, err := h.storage.GetTrace(ctx, traceID.Hash)
+		switch {
+		case errors.Is(err, core.ErrTraceIsTooLong):
+			changes = append(changes, confirmedEventChange(h.toAccountEventForLongTrace(account.ID, traceID)))
+		case err != nil:
+			changes = append(changes, confirmedEventChange(h.toUnknownAccountEvent(account.ID, traceID)))
+		default:
+			changes = append(changes, h.toEventChange(ctx, account.ID, trace, lang, subjectOnly, &next))
+		}
+	}
+
+	known := make(map[tongo.Bits256]struct{}, len(cursor.Pending))
+	for _, hash := range cursor.Pending {
+		known[hash] = struct{}{}
+	}
+	memTraces, _ := h.mempoolEmulate.accountsTraces.Get(account.ID)
+	for _, hash := range memTraces {
+		if _, ok := known[hash]; ok {
+			continue
+		}
+		if tx, _ := h.storage.SearchTransactionByMessageHash(ctx, hash); tx != nil {
+			continue
+		}
+		trace, ok := h.mempoolEmulate.traces.Get(hash)
+		if !ok {
+			continue
+		}
+		event := h.toActivityEvent(ctx, account.ID, trace, core.TraceID{Hash: hash, Lt: trace.Lt, UTime: trace.Utime}, lang, subjectOnly)
+		event.InProgress = true
+		event.EventID = hash.Hex()
+		changes = append(changes, oas.AccountEventChange{
+			EventID: hash.Hex(),
+			Status:  oas.AccountEventStatusPending,
+			Event:   oas.NewOptAccountEvent(event),
+		})
+		next.Pending = append(next.Pending, hash)
+	}
+
+	nextCursor, err := next.encode()
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	if changes == nil {
+		changes = []oas.AccountEventChange{}
+	}
+	return &oas.AccountEventsDelta{Changes: changes, NextCursor: nextCursor, Truncated: truncated}, nil
+}
+
+// toEventChange converts an on-chain trace to a change,
+// an in-progress trace is remembered in the cursor to report its confirmation later.
+func (h *Handler) toEventChange(ctx context.Context, account tongo.AccountID, trace *core.Trace, lang oas.OptString, subjectOnly bool, next *eventsCursor) oas.AccountEventChange {
+	traceID := core.TraceID{Hash: trace.Hash, Lt: trace.Lt, UTime: trace.Utime}
+	event := h.toActivityEvent(ctx, account, trace, traceID, lang, subjectOnly)
+	if !trace.InProgress() {
+		return confirmedEventChange(event)
+	}
+	next.InProgress = append(next.InProgress, trace.Hash)
+	return oas.AccountEventChange{
+		EventID: event.EventID,
+		Status:  oas.AccountEventStatusInProgress,
+		Event:   oas.NewOptAccountEvent(event),
+	}
+}
+
+func confirmedEventChange(event oas.AccountEvent) oas.AccountEventChange {
+	return oas.AccountEventChange{
+		EventID: event.EventID,
+		Status:  oas.AccountEventStatusConfirmed,
+		Event:   oas.NewOptAccountEvent(event),
+	}
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+)
+
+func Test_eventsCursor(t *testing.T) {
+	cursor := eventsCursor{
+		Lt:         25713146000001,
+		Pending:    []tongo.Bits256{{1}, {2}},
+		InProgress: []tongo.Bits256{{3}},
+	}
+	encoded, err := cursor.encode()
+	require.Nil(t, err)
+	decoded, err := decodeEventsCursor(encoded)
+	require.Nil(t, err)
+	require.Equal(t, cursor, decoded)
+
+	// a cursor keeps only the latest events.
+	var long eventsCursor
+	for i := 0; i < maxCursorEvents+10; i++ {
+		long.Pending = append(long.Pending, tongo.Bits256{byte(i)})
+	}
+	encoded, err = long.encode()
+	require.Nil(t, err)
+	decoded, err = decodeEventsCursor(encoded)
+	require.Nil(t, err)
+	require.Len(t, decoded.Pending, maxCursorEvents)
+	require.Equal(t, tongo.Bits256{byte(maxCursorEvents + 9)}, decoded.Pending[maxCursorEvents-1])
+
+	for _, invalid := range []string{"not base64!", "bm90IGpzb24"} {
+		_, err = decodeEventsCursor(invalid)
+		require.EqualError(t, err, "invalid cursor")
+	}
+}
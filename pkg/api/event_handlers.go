@@ -1,6 +1,7 @@
 package api
 
 import (
+	"cmp"
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
@@ -16,16 +17,19 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tonkeeper/opentonapi/pkg/blockchain"
 	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
 	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
 	"github.com/tonkeeper/tongo/tontest"
 	"github.com/tonkeeper/tongo/txemulator"
+	walletTongo "github.com/tonkeeper/tongo/wallet"
 	"golang.org/x/exp/slices"
 
 	"github.com/tonkeeper/opentonapi/pkg/bath"
 	"github.com/tonkeeper/opentonapi/pkg/cache"
 	"github.com/tonkeeper/opentonapi/pkg/core"
 	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/querybudget"
 	"github.com/tonkeeper/opentonapi/pkg/sentry"
 	"github.com/tonkeeper/opentonapi/pkg/wallet"
 )
@@ -83,6 +87,12 @@ func (h *Handler) SendBlockchainMessage(ctx context.Context, request *oas.SendBl
 		if _, prs := h.blacklistedBocCache.Get(checksum); prs {
 			return toError(http.StatusBadRequest, fmt.Errorf("duplicate message"))
 		}
+		queryKey, queryTTL, isHighloadV3 := highloadV3QueryKey(m.payload)
+		if isHighloadV3 {
+			if _, prs := h.highloadV3Queries.Get(queryKey); prs {
+				return toError(http.StatusBadRequest, fmt.Errorf("duplicate message: query id has already been sent"))
+			}
+		}
 		msgCopy := blockchain.ExtInMsgCopy{
 			MsgBoc:  m.base64,
 			Payload: m.payload,
@@ -98,6 +108,9 @@ func (h *Handler) SendBlockchainMessage(ctx context.Context, request *oas.SendBl
 			return toError(http.StatusInternalServerError, err)
 		}
 		h.blacklistedBocCache.Set(checksum, struct{}{}, cache.WithExpiration(time.Minute))
+		if isHighloadV3 {
+			h.highloadV3Queries.Set(queryKey, struct{}{}, cache.WithExpiration(queryTTL))
+		}
 		return nil
 	}
 	var (
@@ -111,6 +124,13 @@ func (h *Handler) SendBlockchainMessage(ctx context.Context, request *oas.SendBl
 		if err != nil {
 			return err
 		}
+		if queryKey, queryTTL, ok := highloadV3QueryKey(m.payload); ok {
+			// the wallet executes only one of the copies anyway.
+			if _, prs := h.highloadV3Queries.Get(queryKey); prs {
+				continue
+			}
+			h.highloadV3Queries.Set(queryKey, struct{}{}, cache.WithExpiration(queryTTL))
+		}
 		msgCopy := blockchain.ExtInMsgCopy{
 			MsgBoc:  m.base64,
 			Payload: m.payload,
@@ -174,7 +194,7 @@ func (h *Handler) getTraceByHash(ctx context.Context, hash tongo.Bits256) (*core
 	return nil, false, core.ErrEntityNotFound
 }
 
-func (h *Handler) GetTrace(ctx context.Context, params oas.GetTraceParams) (*oas.Trace, error) {
+func (h *Handler) GetTrace(ctx context.Context, params oas.GetTraceParams) (oas.GetTraceRes, error) {
 	hash, err := tongo.ParseHash(params.TraceID)
 	if err != nil {
 		return nil, toError(http.StatusBadRequest, err)
@@ -189,13 +209,55 @@ func (h *Handler) GetTrace(ctx context.Context, params oas.GetTraceParams) (*oas
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
 	}
+	var predicted map[tongo.Bits256]struct{}
+	if params.EmulatePending.Value && !emulated && trace.InProgress() {
+		trace, predicted, err = h.emulatePendingBranches(ctx, trace)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+	}
+	if format := params.Format.Or(oas.GetTraceFormatJSON); format != oas.GetTraceFormatJSON {
+		rendered, err := renderTrace(trace, h.addressBook, format)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		return &oas.GetTraceOKTextPlain{Data: strings.NewReader(rendered)}, nil
+	}
 	convertedTrace := convertTrace(trace, h.addressBook)
 	if emulated {
 		convertedTrace.Emulated.SetTo(true)
 	}
+	if querybudget.Exceeded(ctx) {
+		convertedTrace.Partial.SetTo(true)
+	}
+	markPredicted(&convertedTrace, predicted)
+	if params.GasProfile.Value {
+		setGasProfiles(trace, &convertedTrace)
+	}
+	if txHash, path, ok := h.matchTraceNode(ctx, trace, hash); ok {
+		convertedTrace.MatchedTransaction = oas.NewOptString(txHash.Hex())
+		convertedTrace.MatchedPath = make([]int32, 0, len(path))
+		for _, i := range path {
+			convertedTrace.MatchedPath = append(convertedTrace.MatchedPath, int32(i))
+		}
+	}
 	return &convertedTrace, nil
 }
 
+// matchTraceNode finds a node of the trace requested by the given hash,
+// which is either a hash of a transaction or any form of a hash of its inbound message.
+func (h *Handler) matchTraceNode(ctx context.Context, trace *core.Trace, hash tongo.Bits256) (tongo.Bits256, []int, bool) {
+	if path, ok := core.PathToTransaction(trace, hash); ok {
+		return hash, path, true
+	}
+	match, err := h.storage.ResolveMessageHash(ctx, hash)
+	if err != nil {
+		return tongo.Bits256{}, nil, false
+	}
+	path, ok := core.PathToTransaction(trace, match.TransactionHash)
+	return match.TransactionHash, path, ok
+}
+
 func (h *Handler) GetEvent(ctx context.Context, params oas.GetEventParams) (*oas.Event, error) {
 	traceID, err := tongo.ParseHash(params.EventID)
 	if err != nil {
@@ -221,6 +283,11 @@ func (h *Handler) GetEvent(ctx context.Context, params oas.GetEventParams) (*oas
 	}
 	if emulated {
 		event.InProgress = true
+	} else {
+		event.Finality = h.traceFinality(ctx, trace)
+	}
+	if querybudget.Exceeded(ctx) {
+		event.Partial.SetTo(true)
 	}
 	return &event, nil
 }
@@ -259,7 +326,10 @@ func (h *Handler) GetAccountEvents(ctx context.Context, params oas.GetAccountEve
 			}
 			continue
 		}
-		if trace.InProgress() {
+		// once the budget of the request is exhausted, traces are cut short and look like in-progress ones,
+		// they are returned as partial events instead.
+		partial := querybudget.Exceeded(ctx)
+		if trace.InProgress() && !partial {
 			skippedInProgress = append(skippedInProgress, traceID.Hash)
 			continue
 		}
@@ -275,6 +345,9 @@ func (h *Handler) GetAccountEvents(ctx context.Context, params oas.GetAccountEve
 			continue
 			//return nil, toError(http.StatusInternalServerError, err)
 		}
+		if partial {
+			e.Partial.SetTo(true)
+		}
 		events = append(events, e)
 	}
 	if !(params.BeforeLt.IsSet() || params.StartDate.IsSet() || params.EndDate.IsSet()) { //if we look into history we don't need to mix mempool
@@ -322,6 +395,105 @@ func (h *Handler) GetAccountEvents(ctx context.Context, params oas.GetAccountEve
 	return &oas.AccountEvents{Events: events, NextFrom: int64(lastLT)}, nil
 }
 
+func (h *Handler) GetAccountActivity(ctx context.Context, params oas.GetAccountActivityParams) (*oas.AccountActivity, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	traceIDs, err := h.storage.SearchTraces(ctx, account.ID, params.Limit, optIntToPointer(params.BeforeLt), nil, nil, false)
+	if err != nil && !errors.Is(err, core.ErrEntityNotFound) {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	onChain := make([]activityItem, 0, len(traceIDs))
+	for _, traceID := range traceIDs {
+		item := activityItem{Lt: traceID.Lt, Status: oas.AccountActivityItemStatusConfirmed}
+		trace, err := h.storage.GetTrace(ctx, traceID.Hash)
+		switch {
+		case errors.Is(err, core.ErrTraceIsTooLong):
+			item.Event = h.toAccountEventForLongTrace(account.ID, traceID)
+		case err != nil:
+			item.Event = h.toUnknownAccountEvent(account.ID, traceID)
+		default:
+			if trace.InProgress() {
+				item.Status = oas.AccountActivityItemStatusInProgress
+			}
+			item.Event = h.toActivityEvent(ctx, account.ID, trace, traceID, params.AcceptLanguage, params.SubjectOnly.Value)
+		}
+		onChain = append(onChain, item)
+	}
+	var pending []activityItem
+	if !params.BeforeLt.IsSet() {
+		memTraces, _ := h.mempoolEmulate.accountsTraces.Get(account.ID)
+		for _, hash := range memTraces {
+			if tx, _ := h.storage.SearchTransactionByMessageHash(ctx, hash); tx != nil {
+				// the message has reached the blockchain, so its trace is among on-chain items.
+				continue
+			}
+			trace, ok := h.mempoolEmulate.traces.Get(hash)
+			if !ok {
+				continue
+			}
+			event := h.toActivityEvent(ctx, account.ID, trace, core.TraceID{Hash: hash, Lt: trace.Lt, UTime: trace.Utime}, params.AcceptLanguage, params.SubjectOnly.Value)
+			event.InProgress = true
+			event.EventID = hash.Hex()
+			pending = append(pending, activityItem{Status: oas.AccountActivityItemStatusPending, Event: event})
+		}
+	}
+	items, nextFrom := mergeActivity(pending, onChain, params.Limit)
+	return &oas.AccountActivity{Items: items, NextFrom: int64(nextFrom)}, nil
+}
+
+// activityItem is an item of an account's activity feed,
+// Lt is a logical time of the trace's root transaction and is zero for pending items.
+type activityItem struct {
+	Lt     uint64
+	Status oas.AccountActivityItemStatus
+	Event  oas.AccountEvent
+}
+
+// toActivityEvent converts a trace to an account event,
+// a trace we fail to parse is still shown in the feed as an unknown event.
+func (h *Handler) toActivityEvent(ctx context.Context, account tongo.AccountID, trace *core.Trace, traceID core.TraceID, lang oas.OptString, subjectOnly bool) oas.AccountEvent {
+	result, err := bath.FindActions(ctx, trace, bath.ForAccount(account), bath.WithInformationSource(h.storage))
+	if err != nil {
+		return h.toUnknownAccountEvent(account, traceID)
+	}
+	event, err := h.toAccountEvent(ctx, account, trace, result, lang, subjectOnly)
+	if err != nil {
+		return h.toUnknownAccountEvent(account, traceID)
+	}
+	return event
+}
+
+// mergeActivity puts pending items, the newest first, in front of on-chain items
+// which are expected to be ordered by lt descending.
+// Pending items never take the whole page, so a client can always continue with next_from.
+// next_from is zero once there is no more on-chain history.
+func mergeActivity(pending, onChain []activityItem, limit int) ([]oas.AccountActivityItem, uint64) {
+	slices.SortStableFunc(pending, func(a, b activityItem) int {
+		return cmp.Compare(b.Event.Timestamp, a.Event.Timestamp)
+	})
+	maxPending := limit
+	if len(onChain) > 0 {
+		maxPending = limit - 1
+	}
+	if len(pending) > maxPending {
+		pending = pending[:maxPending]
+	}
+	var nextFrom uint64
+	if len(pending)+len(onChain) > limit {
+		onChain = onChain[:limit-len(pending)]
+		nextFrom = onChain[len(onChain)-1].Lt
+	} else if len(onChain) == limit {
+		nextFrom = onChain[len(onChain)-1].Lt
+	}
+	items := make([]oas.AccountActivityItem, 0, len(pending)+len(onChain))
+	for _, item := range append(pending, onChain...) {
+		items = append(items, oas.AccountActivityItem{Status: item.Status, Event: item.Event})
+	}
+	return items, nextFrom
+}
+
 func (h *Handler) GetAccountEvent(ctx context.Context, params oas.GetAccountEventParams) (*oas.AccountEvent, error) {
 	account, err := tongo.ParseAddress(params.AccountID)
 	if err != nil {
@@ -354,6 +526,11 @@ func (h *Handler) GetAccountEvent(ctx context.Context, params oas.GetAccountEven
 	}
 	if emulated {
 		event.InProgress = true
+	} else {
+		event.Finality = h.traceFinality(ctx, trace)
+	}
+	if querybudget.Exceeded(ctx) {
+		event.Partial.SetTo(true)
 	}
 	return &event, nil
 }
@@ -400,7 +577,7 @@ func (h *Handler) EmulateMessageToAccountEvent(ctx context.Context, request *oas
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
 	}
-	tree, err := emulator.Run(ctx, m)
+	tree, err := runEmulation(ctx, emulator, m)
 	if err != nil {
 		return nil, toProperEmulationError(err)
 	}
@@ -450,7 +627,7 @@ func (h *Handler) EmulateMessageToEvent(ctx context.Context, request *oas.Emulat
 		if err != nil {
 			return nil, toError(http.StatusInternalServerError, err)
 		}
-		tree, err := emulator.Run(ctx, m)
+		tree, err := runEmulation(ctx, emulator, m)
 		if err != nil {
 			return nil, toProperEmulationError(err)
 		}
@@ -502,7 +679,7 @@ func (h *Handler) EmulateMessageToTrace(ctx context.Context, request *oas.Emulat
 		if err != nil {
 			return nil, toError(http.StatusInternalServerError, err)
 		}
-		tree, err := emulator.Run(ctx, m)
+		tree, err := runEmulation(ctx, emulator, m)
 		if err != nil {
 			return nil, toProperEmulationError(err)
 		}
@@ -515,6 +692,31 @@ func (h *Handler) EmulateMessageToTrace(ctx context.Context, request *oas.Emulat
 	return &t, nil
 }
 
+// highloadV3QueryKey identifies an external message to highload wallet v3 by its destination and query ID.
+// The wallet executes only one message with a query ID, so copies re-signed with another created_at are duplicates
+// even though their BoCs differ. The key expires with the message: if it hasn't been executed by then,
+// the query ID is still free and can be used again.
+func highloadV3QueryKey(payload []byte) (string, time.Duration, bool) {
+	cells, err := boc.DeserializeBoc(payload)
+	if err != nil || len(cells) != 1 {
+		return "", 0, false
+	}
+	var message tlb.Message
+	if err := tlb.Unmarshal(cells[0], &message); err != nil {
+		return "", 0, false
+	}
+	destination, err := extractDestinationWallet(message)
+	if err != nil {
+		return "", 0, false
+	}
+	body := boc.Cell(message.Body.Value)
+	msg, err := wallet.DecodeHighloadV3Message(&body)
+	if err != nil {
+		return "", 0, false
+	}
+	return fmt.Sprintf("%v/%d", destination.ToRaw(), msg.QueryID), time.Duration(msg.Timeout) * time.Second, true
+}
+
 func extractDestinationWallet(message tlb.Message) (*ton.AccountID, error) {
 	if message.Info.SumType != "ExtInMsgInfo" {
 		return nil, fmt.Errorf("unsupported message type: %v", message.Info.SumType)
@@ -626,7 +828,7 @@ func (h *Handler) EmulateMessageToWallet(ctx context.Context, request *oas.Emula
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
 	}
-	tree, err := emulator.Run(ctx, m)
+	tree, err := runEmulation(ctx, emulator, m)
 	if err != nil {
 		return nil, toProperEmulationError(err)
 	}
@@ -634,6 +836,17 @@ func (h *Handler) EmulateMessageToWallet(ctx context.Context, request *oas.Emula
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
 	}
+	if walletVersion == walletTongo.V5R1 {
+		if finalState, ok := emulator.FinalStates()[*walletAddress]; ok {
+			originalState, err := h.storage.GetAccountState(ctx, *walletAddress)
+			if err != nil {
+				return nil, toError(http.StatusInternalServerError, err)
+			}
+			if err := risk.AddW5AuthChanges(*walletAddress, originalState, finalState); err != nil {
+				return nil, toError(http.StatusInternalServerError, err)
+			}
+		}
+	}
 	t := convertTrace(trace, h.addressBook)
 	result, err := bath.FindActions(ctx, trace, bath.ForAccount(*walletAddress), bath.WithInformationSource(h.storage))
 	if err != nil {
@@ -177,3 +177,71 @@ func Test_decodeMessage(t *testing.T) {
 		})
 	}
 }
+
+func Test_mergeActivity(t *testing.T) {
+	pendingItem := func(id string, timestamp int64) activityItem {
+		return activityItem{Status: oas.AccountActivityItemStatusPending, Event: oas.AccountEvent{EventID: id, Timestamp: timestamp}}
+	}
+	onChainItem := func(id string, lt uint64, status oas.AccountActivityItemStatus) activityItem {
+		return activityItem{Lt: lt, Status: status, Event: oas.AccountEvent{EventID: id, Lt: int64(lt)}}
+	}
+	tests := []struct {
+		name         string
+		pending      []activityItem
+		onChain      []activityItem
+		limit        int
+		wantIDs      []string
+		wantNextFrom uint64
+	}{
+		{
+			name:    "pending go first, the newest first",
+			pending: []activityItem{pendingItem("p1", 100), pendingItem("p2", 200)},
+			onChain: []activityItem{
+				onChainItem("c1", 30, oas.AccountActivityItemStatusInProgress),
+				onChainItem("c2", 20, oas.AccountActivityItemStatusConfirmed),
+			},
+			limit:   10,
+			wantIDs: []string{"p2", "p1", "c1", "c2"},
+		},
+		{
+			name:    "full page of on-chain items",
+			onChain: []activityItem{onChainItem("c1", 30, oas.AccountActivityItemStatusConfirmed), onChainItem("c2", 20, oas.AccountActivityItemStatusConfirmed)},
+			limit:   2,
+			wantIDs: []string{"c1", "c2"},
+			// there might be more history.
+			wantNextFrom: 20,
+		},
+		{
+			name:         "pending items push on-chain ones to the next page",
+			pending:      []activityItem{pendingItem("p1", 100)},
+			onChain:      []activityItem{onChainItem("c1", 30, oas.AccountActivityItemStatusConfirmed), onChainItem("c2", 20, oas.AccountActivityItemStatusConfirmed)},
+			limit:        2,
+			wantIDs:      []string{"p1", "c1"},
+			wantNextFrom: 30,
+		},
+		{
+			name:    "pending items never take the whole page",
+			pending: []activityItem{pendingItem("p1", 100), pendingItem("p2", 200), pendingItem("p3", 300)},
+			onChain: []activityItem{onChainItem("c1", 30, oas.AccountActivityItemStatusConfirmed)},
+			limit:   2,
+			wantIDs: []string{"p3", "c1"},
+		},
+		{
+			name:    "only pending items",
+			pending: []activityItem{pendingItem("p1", 100), pendingItem("p2", 200), pendingItem("p3", 300)},
+			limit:   2,
+			wantIDs: []string{"p3", "p2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, nextFrom := mergeActivity(tt.pending, tt.onChain, tt.limit)
+			var ids []string
+			for _, item := range items {
+				ids = append(ids, item.Event.EventID)
+			}
+			require.Equal(t, tt.wantIDs, ids)
+			require.Equal(t, tt.wantNextFrom, nextFrom)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tonkeeper/opentonapi/internal/g"
+)
+
+// Event model versions supported by the API.
+//
+// v2 is the default one described in api/openapi.yml.
+// v3 is requested with "Accept: application/vnd.opentonapi.v3+json" and differs in the action shape:
+//   - "type" is in snake case,
+//   - a type specific object is placed under the "payload" key
+//     instead of a separate nullable key per action type,
+//   - "simple_preview" is renamed to "preview",
+//   - amounts are objects with the raw value, decimals and a normalized decimal string.
+const (
+	EventModelV2 = 2
+	EventModelV3 = 3
+
+	mediaTypeEventModelV3 = "application/vnd.opentonapi.v3+json"
+)
+
+// eventModelVersion returns a version of the event model requested by a client with the Accept header.
+func eventModelVersion(r *http.Request) int {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, part := range strings.Split(accept, ",") {
+			mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err != nil {
+				continue
+			}
+			if mediaType == mediaTypeEventModelV3 {
+				return EventModelV3
+			}
+		}
+	}
+	return EventModelV2
+}
+
+// bufferedResponseWriter keeps a response in memory, so it can be rewritten before sending to a client.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+// eventVersioningMiddleware converts successful JSON responses containing actions to the event model
+// negotiated with the Accept header.
+// Handlers always produce the v2 model, so clients pinned to v2 are not affected.
+func eventVersioningMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if eventModelVersion(r) != EventModelV3 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		buffered := &bufferedResponseWriter{header: w.Header(), status: http.StatusOK}
+		next.ServeHTTP(buffered, r)
+		body := buffered.body.Bytes()
+		if buffered.status == http.StatusOK && strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+			if converted, err := convertToEventModelV3(body); err == nil {
+				body = converted
+				w.Header().Set("Content-Type", mediaTypeEventModelV3)
+			}
+		}
+		w.Header().Set("Vary", "Accept")
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(buffered.status)
+		_, _ = w.Write(body)
+	})
+}
+
+// convertToEventModelV3 walks through a JSON document and converts every "actions" list to the v3 model.
+func convertToEventModelV3(body []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(walkEventModelV3(doc))
+}
+
+func walkEventModelV3(node any) any {
+	switch value := node.(type) {
+	case map[string]any:
+		for key, child := range value {
+			if key == "actions" {
+				if actions, ok := child.([]any); ok {
+					value[key] = convertActionsToV3(actions)
+					continue
+				}
+			}
+			value[key] = walkEventModelV3(child)
+		}
+		return value
+	case []any:
+		for i := range value {
+			value[i] = walkEventModelV3(value[i])
+		}
+		return value
+	}
+	return node
+}
+
+func convertActionsToV3(actions []any) []any {
+	result := make([]any, 0, len(actions))
+	for _, item := range actions {
+		action, ok := item.(map[string]any)
+		if !ok {
+			result = append(result, item)
+			continue
+		}
+		result = append(result, convertActionToV3(action))
+	}
+	return result
+}
+
+func convertActionToV3(action map[string]any) map[string]any {
+	actionType, _ := action["type"].(string)
+	v3 := map[string]any{
+		"type":              g.CamelToSnake(actionType),
+		"status":            action["status"],
+		"preview":           action["simple_preview"],
+		"base_transactions": action["base_transactions"],
+	}
+	payload, ok := action[actionType].(map[string]any)
+	if !ok {
+		v3["payload"] = nil
+		return v3
+	}
+	switch actionType {
+	case "TonTransfer", "DepositStake", "WithdrawStake", "WithdrawStakeRequest",
+		"ElectionsDepositStake", "ElectionsRecoverStake", "AuctionBid":
+		normalizeAmountField(payload, "amount", 9)
+	case "JettonTransfer", "JettonBurn", "JettonMint":
+		normalizeAmountField(payload, "amount", jettonDecimals(payload["jetton"]))
+	case "JettonSwap":
+		normalizeAmountField(payload, "ton_in", 9)
+		normalizeAmountField(payload, "ton_out", 9)
+		normalizeAmountField(payload, "amount_in", jettonDecimals(payload["jetton_master_in"]))
+		normalizeAmountField(payload, "amount_out", jettonDecimals(payload["jetton_master_out"]))
+	case "SmartContractExec":
+		normalizeAmountField(payload, "ton_attached", 9)
+	}
+	v3["payload"] = payload
+	return v3
+}
+
+func jettonDecimals(jetton any) int {
+	preview, ok := jetton.(map[string]any)
+	if !ok {
+		return 0
+	}
+	number, ok := preview["decimals"].(json.Number)
+	if !ok {
+		return 0
+	}
+	decimals, err := number.Int64()
+	if err != nil {
+		return 0
+	}
+	return int(decimals)
+}
+
+// normalizeAmountField replaces an amount, encoded either as a JSON number or a string,
+// with an object containing the raw value, decimals and a normalized value.
+func normalizeAmountField(payload map[string]any, key string, decimals int) {
+	var raw string
+	switch value := payload[key].(type) {
+	case json.Number:
+		raw = value.String()
+	case string:
+		raw = value
+	default:
+		return
+	}
+	amount, ok := new(big.Int).SetString(raw, 10)
+	if !ok {
+		return
+	}
+	payload[key] = map[string]any{
+		"value":      raw,
+		"decimals":   decimals,
+		"normalized": ScaleJettons(*amount, decimals).String(),
+	}
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_eventModelVersion(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept string
+		want   int
+	}{
+		{name: "no header", want: EventModelV2},
+		{name: "plain json", accept: "application/json", want: EventModelV2},
+		{name: "v3", accept: "application/vnd.opentonapi.v3+json", want: EventModelV3},
+		{name: "v3 among others", accept: "text/html, application/vnd.opentonapi.v3+json;q=0.9", want: EventModelV3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/v2/events/1", nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			require.Equal(t, tt.want, eventModelVersion(r))
+		})
+	}
+}
+
+func Test_eventVersioningMiddleware(t *testing.T) {
+	const v2Event = `{"event_id":"1","actions":[` +
+		`{"type":"TonTransfer","status":"ok","TonTransfer":{"amount":1500000000},"simple_preview":{"name":"Ton Transfer"},"base_transactions":["a"]},` +
+		`{"type":"JettonTransfer","status":"ok","JettonTransfer":{"amount":"1234500","jetton":{"decimals":6}},"simple_preview":{"name":"Jetton Transfer"},"base_transactions":["b"]}]}`
+	handler := eventVersioningMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(v2Event))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/events/1", nil))
+	require.JSONEq(t, v2Event, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/v2/events/1", nil)
+	r.Header.Set("Accept", "application/vnd.opentonapi.v3+json")
+	handler.ServeHTTP(rec, r)
+	require.Equal(t, "application/vnd.opentonapi.v3+json", rec.Header().Get("Content-Type"))
+
+	var event map[string]any
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &event))
+	expected := `{"event_id":"1","actions":[` +
+		`{"type":"ton_transfer","status":"ok","payload":{"amount":{"value":"1500000000","decimals":9,"normalized":"1.5"}},"preview":{"name":"Ton Transfer"},"base_transactions":["a"]},` +
+		`{"type":"jetton_transfer","status":"ok","payload":{"amount":{"value":"1234500","decimals":6,"normalized":"1.2345"},"jetton":{"decimals":6}},"preview":{"name":"Jetton Transfer"},"base_transactions":["b"]}]}`
+	require.JSONEq(t, expected, rec.Body.String())
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// feeStep is a transaction of an operation along with the message starting it.
+type feeStep struct {
+	// Gas is consumed by the compute phase of the transaction.
+	Gas uint64
+	// Cells and Bits are a size of the message starting the transaction, its root cell isn't counted.
+	Cells uint64
+	Bits  uint64
+	// External is set if the transaction is started by an external message, which pays an import fee instead of a forward fee.
+	External bool
+}
+
+// typicalOperations are chains of transactions of common operations.
+// Gas and sizes of messages are approximate and taken from transactions of wallet v4,
+// TEP-74 jetton wallets and TEP-62 NFT items, so estimates follow the config but not every contract.
+var typicalOperations = []struct {
+	operation oas.FeeEstimateOperation
+	steps     []feeStep
+}{
+	{
+		operation: oas.FeeEstimateOperationTonTransfer,
+		steps: []feeStep{
+			{Gas: 3308, Cells: 1, Bits: 700, External: true},
+			{Gas: 309},
+		},
+	},
+	{
+		operation: oas.FeeEstimateOperationJettonTransfer,
+		steps: []feeStep{
+			{Gas: 3308, Cells: 2, Bits: 1400, External: true},
+			{Gas: 10065, Cells: 1, Bits: 700},
+			{Gas: 11460, Cells: 5, Bits: 2600},
+			{Gas: 309, Cells: 1, Bits: 100},
+		},
+	},
+	{
+		operation: oas.FeeEstimateOperationNftTransfer,
+		steps: []feeStep{
+			{Gas: 3308, Cells: 2, Bits: 1300, External: true},
+			{Gas: 6582, Cells: 1, Bits: 600},
+			{Gas: 309, Cells: 1, Bits: 100},
+		},
+	},
+}
+
+func (h *Handler) GetBlockchainFees(ctx context.Context) (*oas.BlockchainFees, error) {
+	config, err := h.storage.GetLastConfig(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	fees, err := blockchainFees(config, uint32(time.Now().Unix()))
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	return fees, nil
+}
+
+// blockchainFees converts prices of the config and estimates typical operations with them,
+// so the result changes as soon as the config does.
+func blockchainFees(config ton.BlockchainConfig, now uint32) (*oas.BlockchainFees, error) {
+	if config.ConfigParam20 == nil || config.ConfigParam21 == nil || config.ConfigParam24 == nil || config.ConfigParam25 == nil {
+		return nil, fmt.Errorf("gas or forward prices are not found in the blockchain config")
+	}
+	storage, ok := currentStoragePrices(config, now)
+	if !ok {
+		return nil, fmt.Errorf("storage prices are not found in the blockchain config")
+	}
+	masterchain, ok := workchainFees(config.ConfigParam20.GasLimitsPrices, config.ConfigParam24.MsgForwardPrices)
+	if !ok {
+		return nil, fmt.Errorf("unsupported gas prices format")
+	}
+	masterchain.StorageBitPrice = int64(storage.McBitPricePs)
+	masterchain.StorageCellPrice = int64(storage.McCellPricePs)
+	basechain, ok := workchainFees(config.ConfigParam21.GasLimitsPrices, config.ConfigParam25.MsgForwardPrices)
+	if !ok {
+		return nil, fmt.Errorf("unsupported gas prices format")
+	}
+	basechain.StorageBitPrice = int64(storage.BitPricePs)
+	basechain.StorageCellPrice = int64(storage.CellPricePs)
+
+	fees := oas.BlockchainFees{
+		Basechain:   basechain,
+		Masterchain: masterchain,
+		Estimates:   make([]oas.FeeEstimate, 0, len(typicalOperations)),
+	}
+	for _, op := range typicalOperations {
+		estimate := oas.FeeEstimate{Operation: op.operation}
+		for _, step := range op.steps {
+			estimate.GasFee += gasFee(basechain, step.Gas)
+			estimate.FwdFee += fwdFee(basechain, step.Cells, step.Bits)
+		}
+		estimate.Total = estimate.GasFee + estimate.FwdFee
+		fees.Estimates = append(fees.Estimates, estimate)
+	}
+	return &fees, nil
+}
+
+// workchainFees converts gas and forward prices, the gas price is converted from 2^-16 nanotons to nanotons.
+func workchainFees(gas tlb.GasLimitsPrices, fwd tlb.MsgForwardPrices) (oas.WorkchainFees, bool) {
+	fees := oas.WorkchainFees{
+		LumpPrice: int64(fwd.LumpPrice),
+		BitPrice:  int64(fwd.BitPrice),
+		CellPrice: int64(fwd.CellPrice),
+	}
+	if gas.SumType == "GasFlatPfx" {
+		fees.FlatGasLimit = int64(gas.GasFlatPfx.FlatGasLimit)
+		fees.FlatGasPrice = int64(gas.GasFlatPfx.FlatGasPrice)
+		if gas.GasFlatPfx.Other == nil {
+			return oas.WorkchainFees{}, false
+		}
+		gas = *gas.GasFlatPfx.Other
+	}
+	switch gas.SumType {
+	case "GasPrices":
+		fees.GasPrice = int64(gas.GasPrices.GasPrice >> 16)
+		fees.GasLimit = int64(gas.GasPrices.GasLimit)
+	case "GasPricesExt":
+		fees.GasPrice = int64(gas.GasPricesExt.GasPrice >> 16)
+		fees.GasLimit = int64(gas.GasPricesExt.GasLimit)
+	default:
+		return oas.WorkchainFees{}, false
+	}
+	return fees, true
+}
+
+// gasFee returns nanotons paid for gas the same way the compute phase does,
+// the first FlatGasLimit units cost FlatGasPrice.
+func gasFee(fees oas.WorkchainFees, gas uint64) int64 {
+	if int64(gas) <= fees.FlatGasLimit {
+		return fees.FlatGasPrice
+	}
+	return fees.FlatGasPrice + (int64(gas)-fees.FlatGasLimit)*fees.GasPrice
+}
+
+// fwdFee returns nanotons paid for forwarding a message of the given size rounded up like the action phase does.
+func fwdFee(fees oas.WorkchainFees, cells, bits uint64) int64 {
+	price := fees.BitPrice*int64(bits) + fees.CellPrice*int64(cells)
+	return fees.LumpPrice + (price+1<<16-1)>>16
+}
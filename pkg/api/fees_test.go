@@ -0,0 +1,64 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_workchainFees(t *testing.T) {
+	fwd := tlb.MsgForwardPrices{LumpPrice: 400_000, BitPrice: 26_214_400, CellPrice: 2_621_440_000}
+	basechain := tlb.GasLimitsPrices{SumType: "GasPricesExt"}
+	basechain.GasPricesExt.GasPrice = 26_214_400
+	basechain.GasPricesExt.GasLimit = 1_000_000
+	flat := tlb.GasLimitsPrices{SumType: "GasFlatPfx"}
+	flat.GasFlatPfx.FlatGasLimit = 100
+	flat.GasFlatPfx.FlatGasPrice = 40_000
+	flat.GasFlatPfx.Other = &basechain
+	tests := []struct {
+		name   string
+		gas    tlb.GasLimitsPrices
+		want   oas.WorkchainFees
+		wantOk bool
+	}{
+		{
+			name:   "gas prices",
+			gas:    basechain,
+			want:   oas.WorkchainFees{GasPrice: 400, GasLimit: 1_000_000, LumpPrice: 400_000, BitPrice: 26_214_400, CellPrice: 2_621_440_000},
+			wantOk: true,
+		},
+		{
+			name:   "flat gas prices",
+			gas:    flat,
+			want:   oas.WorkchainFees{GasPrice: 400, GasLimit: 1_000_000, FlatGasLimit: 100, FlatGasPrice: 40_000, LumpPrice: 400_000, BitPrice: 26_214_400, CellPrice: 2_621_440_000},
+			wantOk: true,
+		},
+		{
+			name: "flat gas prices without other prices",
+			gas:  tlb.GasLimitsPrices{SumType: "GasFlatPfx"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fees, ok := workchainFees(tt.gas, fwd)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, fees)
+		})
+	}
+}
+
+func Test_gasFee_fwdFee(t *testing.T) {
+	fees := oas.WorkchainFees{GasPrice: 400, FlatGasLimit: 100, FlatGasPrice: 40_000, LumpPrice: 400_000, BitPrice: 26_214_400, CellPrice: 2_621_440_000}
+
+	require.Equal(t, int64(40_000), gasFee(fees, 50))
+	require.Equal(t, int64(40_000), gasFee(fees, 100))
+	require.Equal(t, int64(1_323_200), gasFee(fees, 3308))
+
+	require.Equal(t, int64(400_000), fwdFee(fees, 0, 0))
+	require.Equal(t, int64(720_000), fwdFee(fees, 1, 700))
+	// a fraction of a nanoton is rounded up.
+	require.Equal(t, int64(400_001), fwdFee(oas.WorkchainFees{LumpPrice: 400_000, BitPrice: 1}, 0, 1))
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/ton"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// maxCommitSearchDepth limits the number of masterchain blocks checked to find the one committing a shard block.
+const maxCommitSearchDepth = 16
+
+// optFinality returns the finality of the given blocks, it is unset if the finality can't be determined.
+func (h *Handler) optFinality(ctx context.Context, blocks []tongo.BlockID) oas.OptFinality {
+	finality, err := h.finality(ctx, blocks)
+	if err != nil {
+		h.logger.Debug("failed to get finality", zap.Error(err))
+		return oas.OptFinality{}
+	}
+	return oas.NewOptFinality(finality)
+}
+
+// traceFinality returns the finality of the least final transaction of the trace.
+func (h *Handler) traceFinality(ctx context.Context, trace *core.Trace) oas.OptFinality {
+	seen := map[tongo.BlockID]struct{}{}
+	var blocks []tongo.BlockID
+	core.Visit(trace, func(t *core.Trace) {
+		if _, ok := seen[t.BlockID]; ok {
+			return
+		}
+		seen[t.BlockID] = struct{}{}
+		blocks = append(blocks, t.BlockID)
+	})
+	finality := h.optFinality(ctx, blocks)
+	if finality.Set && trace.InProgress() {
+		// transactions to come are not committed yet.
+		finality.Value.Final = false
+	}
+	return finality
+}
+
+// finality returns the finality of the least final block among the given ones.
+func (h *Handler) finality(ctx context.Context, blocks []tongo.BlockID) (oas.Finality, error) {
+	last, err := h.storage.LastMasterchainBlockHeader(ctx)
+	if err != nil {
+		return oas.Finality{}, err
+	}
+	var commitSeqno uint32
+	for _, block := range blocks {
+		seqno, committed, err := h.committingMasterchainSeqno(ctx, block, last.Seqno)
+		if err != nil {
+			return oas.Finality{}, err
+		}
+		if !committed {
+			return oas.Finality{}, nil
+		}
+		if seqno > commitSeqno {
+			commitSeqno = seqno
+		}
+	}
+	return convertFinality(commitSeqno, last.Seqno, h.finalityDepth), nil
+}
+
+func convertFinality(commitSeqno, lastSeqno uint32, depth int) oas.Finality {
+	var confirmations int32
+	if lastSeqno >= commitSeqno {
+		confirmations = int32(lastSeqno-commitSeqno) + 1
+	}
+	return oas.Finality{
+		Committed:        true,
+		MasterchainSeqno: oas.NewOptInt32(int32(commitSeqno)),
+		Confirmations:    confirmations,
+		Final:            int(confirmations) >= depth,
+	}
+}
+
+// committingMasterchainSeqno finds the first masterchain block referencing the given block or one of its descendants.
+func (h *Handler) committingMasterchainSeqno(ctx context.Context, block tongo.BlockID, lastSeqno uint32) (uint32, bool, error) {
+	if block.Workchain == -1 {
+		return block.Seqno, true, nil
+	}
+	header, err := h.storage.GetBlockHeader(ctx, block)
+	if err != nil {
+		return 0, false, err
+	}
+	if header.MasterRef == nil {
+		return 0, false, nil
+	}
+	// master_ref of a shard block is the last masterchain block known to its validators,
+	// the shard block can only be committed by a later one.
+	for seqno := header.MasterRef.Seqno + 1; seqno <= lastSeqno && seqno <= header.MasterRef.Seqno+maxCommitSearchDepth; seqno++ {
+		shards, err := h.storage.GetBlockShards(ctx, ton.BlockID{Workchain: -1, Shard: 0x8000000000000000, Seqno: seqno})
+		if err != nil {
+			return 0, false, err
+		}
+		for _, shard := range shards {
+			if shard.Workchain == block.Workchain && shardsIntersect(shard.Shard, block.Shard) && shard.Seqno >= block.Seqno {
+				return seqno, true, nil
+			}
+		}
+	}
+	return 0, false, nil
+}
+
+// shardsIntersect reports whether one shard is the other one or its ancestor.
+func shardsIntersect(a, b uint64) bool {
+	// the lowest set bit of a shard ID marks the end of its prefix.
+	if a&-a < b&-b {
+		a, b = b, a
+	}
+	mask := ^((a&-a)<<1 - 1)
+	return a&mask == b&mask
+}
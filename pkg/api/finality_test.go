@@ -0,0 +1,67 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_shardsIntersect(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b uint64
+		want bool
+	}{
+		{name: "same shard", a: 0x8000000000000000, b: 0x8000000000000000, want: true},
+		{name: "full shard is an ancestor of all", a: 0x8000000000000000, b: 0x6000000000000000, want: true},
+		{name: "parent and child", a: 0x4000000000000000, b: 0x6000000000000000, want: true},
+		{name: "child and parent", a: 0x2000000000000000, b: 0x4000000000000000, want: true},
+		{name: "siblings", a: 0x4000000000000000, b: 0xc000000000000000, want: false},
+		{name: "cousins", a: 0x2000000000000000, b: 0xa000000000000000, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, shardsIntersect(tt.a, tt.b))
+			require.Equal(t, tt.want, shardsIntersect(tt.b, tt.a))
+		})
+	}
+}
+
+func Test_convertFinality(t *testing.T) {
+	tests := []struct {
+		name        string
+		commitSeqno uint32
+		lastSeqno   uint32
+		depth       int
+		want        oas.Finality
+	}{
+		{
+			name:        "just committed",
+			commitSeqno: 100,
+			lastSeqno:   100,
+			depth:       1,
+			want:        oas.Finality{Committed: true, MasterchainSeqno: oas.NewOptInt32(100), Confirmations: 1, Final: true},
+		},
+		{
+			name:        "not deep enough",
+			commitSeqno: 100,
+			lastSeqno:   101,
+			depth:       3,
+			want:        oas.Finality{Committed: true, MasterchainSeqno: oas.NewOptInt32(100), Confirmations: 2, Final: false},
+		},
+		{
+			name:        "deep enough",
+			commitSeqno: 100,
+			lastSeqno:   102,
+			depth:       3,
+			want:        oas.Finality{Committed: true, MasterchainSeqno: oas.NewOptInt32(100), Confirmations: 3, Final: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, convertFinality(tt.commitSeqno, tt.lastSeqno, tt.depth))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/go-faster/errors"
@@ -18,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/tonkeeper/opentonapi/pkg/addressbook"
+	"github.com/tonkeeper/opentonapi/pkg/bath"
 	"github.com/tonkeeper/opentonapi/pkg/cache"
 	"github.com/tonkeeper/opentonapi/pkg/config"
 	"github.com/tonkeeper/opentonapi/pkg/oas"
@@ -38,6 +40,16 @@ type Handler struct {
 	msgSender   messageSender
 	executor    executor
 	gasless     Gasless
+	simulator   transactionSimulator
+	// privateLabels are merged into responses for tokens with the admin scope only.
+	privateLabels privateLabels
+	deposits      expectedDeposits
+	webhooks      webhookRegistry
+	usage         usageMeter
+	annotations   eventAnnotations
+	auctionBids   auctionBids
+	// finalityDepth is a number of masterchain confirmations after which a transaction is reported as final.
+	finalityDepth int
 
 	limits      Limits
 	spamFilter  SpamFilter
@@ -55,9 +67,17 @@ type Handler struct {
 
 	// need to blacklist BoCs for avoiding spamming
 	blacklistedBocCache cache.Cache[[32]byte, struct{}]
+	// highloadV3Queries contains query IDs of messages to highload wallets v3 sent recently.
+	highloadV3Queries cache.Cache[string, struct{}]
 
 	// getMethodsCache contains results of methods.
 	getMethodsCache cache.Cache[string, *oas.MethodExecutionResult]
+	// exportSnapshots contains snapshots of accounts pinned for consistent exports, see ExportHandler.
+	exportSnapshots cache.Cache[string, exportSnapshot]
+	// blockSwapLegs contains swaps executed by DEX pools in a block, see annotateSandwiches.
+	blockSwapLegs cache.Cache[tongo.BlockID, []bath.SwapLeg]
+	// codeInterfacesCache contains interfaces detected by code hashes, see codeInterfaces.
+	codeInterfacesCache cache.Cache[tongo.Bits256, []string]
 
 	// mu protects "dns".
 	mu         sync.Mutex
@@ -66,7 +86,7 @@ type Handler struct {
 }
 
 func (h *Handler) NewError(ctx context.Context, err error) *oas.ErrorStatusCode {
-	return new(oas.ErrorStatusCode)
+	return toError(http.StatusInternalServerError, err)
 }
 
 // Options configures behavior of a Handler instance.
@@ -82,6 +102,14 @@ type Options struct {
 	tonConnectSecret string
 	ctxToDetails     ctxToDetails
 	gasless          Gasless
+	simulator        transactionSimulator
+	privateLabels    privateLabels
+	deposits         expectedDeposits
+	webhooks         webhookRegistry
+	usage            usageMeter
+	annotations      eventAnnotations
+	auctionBids      auctionBids
+	finalityDepth    int
 }
 
 type Option func(o *Options)
@@ -151,8 +179,69 @@ func WithGasless(gasless Gasless) Option {
 	}
 }
 
+// WithTransactionSimulator enables simulation of incoming transactions for testing deposit processing.
+// It is supposed to be used in the testnet only.
+func WithTransactionSimulator(simulator transactionSimulator) Option {
+	return func(o *Options) {
+		o.simulator = simulator
+	}
+}
+
+// WithPrivateLabels enables the private address book of the operator,
+// it is available to tokens with the admin scope only, see WithAdminTokens.
+func WithPrivateLabels(store privateLabels) Option {
+	return func(o *Options) {
+		o.privateLabels = store
+	}
+}
+
+// WithExpectedDeposits enables registration of expected deposits,
+// their incoming transfers are classified by deposits.Matcher.
+func WithExpectedDeposits(registry expectedDeposits) Option {
+	return func(o *Options) {
+		o.deposits = registry
+	}
+}
+
+// WithWebhooks enables registration of webhooks, notifications are delivered to them by webhooks.Deliverer.
+func WithWebhooks(registry webhookRegistry) Option {
+	return func(o *Options) {
+		o.webhooks = registry
+	}
+}
+
+// WithUsage exposes usage of upstream resources by API tokens at /v2/usage,
+// the same meter must be passed to the server with WithUsageMeter.
+func WithUsage(meter usageMeter) Option {
+	return func(o *Options) {
+		o.usage = meter
+	}
+}
+
+// WithEventAnnotations enables private annotations of events attached by tenants and operators.
+func WithEventAnnotations(store eventAnnotations) Option {
+	return func(o *Options) {
+		o.annotations = store
+	}
+}
+
+// WithAuctionBids enables the history of bids placed on NFT auctions.
+func WithAuctionBids(bids auctionBids) Option {
+	return func(o *Options) {
+		o.auctionBids = bids
+	}
+}
+
+// WithFinalityDepth sets a number of masterchain confirmations after which a transaction is reported as final.
+// The default is 1, a transaction is final as soon as its block is committed to the masterchain.
+func WithFinalityDepth(depth int) Option {
+	return func(o *Options) {
+		o.finalityDepth = depth
+	}
+}
+
 func NewHandler(logger *zap.Logger, opts ...Option) (*Handler, error) {
-	options := &Options{}
+	options := &Options{finalityDepth: 1}
 	for _, o := range opts {
 		o(options)
 	}
@@ -197,17 +286,25 @@ func NewHandler(logger *zap.Logger, opts ...Option) (*Handler, error) {
 		return nil, fmt.Errorf("failed to init tonconnect")
 	}
 	return &Handler{
-		logger:       logger,
-		storage:      options.storage,
-		state:        options.chainState,
-		addressBook:  options.addressBook,
-		msgSender:    options.msgSender,
-		executor:     options.executor,
-		limits:       options.limits,
-		spamFilter:   options.spamFilter,
-		ctxToDetails: options.ctxToDetails,
-		gasless:      options.gasless,
-		ratesSource:  rates.InitCalculator(options.ratesSource),
+		logger:        logger,
+		storage:       options.storage,
+		state:         options.chainState,
+		addressBook:   options.addressBook,
+		msgSender:     options.msgSender,
+		executor:      options.executor,
+		limits:        options.limits,
+		spamFilter:    options.spamFilter,
+		ctxToDetails:  options.ctxToDetails,
+		gasless:       options.gasless,
+		simulator:     options.simulator,
+		privateLabels: options.privateLabels,
+		deposits:      options.deposits,
+		webhooks:      options.webhooks,
+		usage:         options.usage,
+		annotations:   options.annotations,
+		auctionBids:   options.auctionBids,
+		finalityDepth: options.finalityDepth,
+		ratesSource:   rates.InitCalculator(options.ratesSource),
 		metaCache: metadataCache{
 			collectionsCache: cache.NewLRUCache[tongo.AccountID, tep64.Metadata](10000, "nft_metadata_cache"),
 			jettonsCache:     cache.NewLRUCache[tongo.AccountID, tep64.Metadata](10000, "jetton_metadata_cache"),
@@ -221,7 +318,11 @@ func NewHandler(logger *zap.Logger, opts ...Option) (*Handler, error) {
 			tongo.MustParseAddress("0:0000000000000000000000000000000000000000000000000000000000000000").ID: {},
 		},
 		blacklistedBocCache: cache.NewLRUCache[[32]byte, struct{}](100000, "blacklisted_boc_cache"),
+		highloadV3Queries:   cache.NewLRUCache[string, struct{}](100000, "highload_v3_queries_cache"),
 		getMethodsCache:     cache.NewLRUCache[string, *oas.MethodExecutionResult](100000, "get_methods_cache"),
+		exportSnapshots:     cache.NewLRUCache[string, exportSnapshot](10000, "export_snapshots_cache"),
+		blockSwapLegs:       cache.NewLRUCache[tongo.BlockID, []bath.SwapLeg](1000, "block_swap_legs_cache"),
+		codeInterfacesCache: cache.NewLRUCache[tongo.Bits256, []string](10000, "code_interfaces_cache"),
 		tonConnect:          tonConnect,
 		configPool:          configPool,
 	}, nil
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"crypto/ed25519"
+	"time"
 
 	"github.com/tonkeeper/opentonapi/pkg/gasless"
 	"github.com/tonkeeper/opentonapi/pkg/oas"
@@ -15,10 +16,16 @@ import (
 	"github.com/tonkeeper/tongo/ton"
 
 	"github.com/tonkeeper/opentonapi/pkg/addressbook"
+	"github.com/tonkeeper/opentonapi/pkg/annotations"
 	"github.com/tonkeeper/opentonapi/pkg/blockchain"
 	"github.com/tonkeeper/opentonapi/pkg/cache"
 	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/deposits"
+	"github.com/tonkeeper/opentonapi/pkg/labels"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/webhooks"
 	"github.com/tonkeeper/opentonapi/pkg/rates"
+	"github.com/tonkeeper/opentonapi/pkg/usage"
 )
 
 type storage interface {
@@ -37,13 +44,38 @@ type storage interface {
 	LastMasterchainBlockHeader(ctx context.Context) (*core.BlockHeader, error)
 	GetTransaction(ctx context.Context, hash tongo.Bits256) (*core.Transaction, error)
 	SearchTransactionByMessageHash(ctx context.Context, hash tongo.Bits256) (*tongo.Bits256, error)
+	// ResolveMessageHash finds a transaction created by a message with the given hash of any core.MessageHashKind.
+	ResolveMessageHash(ctx context.Context, hash tongo.Bits256) (core.MessageHashMatch, error)
+	// FindBounceOrigin returns a hash of the transaction that has sent the given bounce message,
+	// its inbound message is the bounced one.
+	FindBounceOrigin(ctx context.Context, msgHash tongo.Bits256) (tongo.Bits256, error)
 	// GetBlockTransactions returns low-level information about transactions in a particular block.
 	GetBlockTransactions(ctx context.Context, id tongo.BlockID) ([]*core.Transaction, error)
 	GetAccountTransactions(ctx context.Context, id tongo.AccountID, limit int, beforeLt, afterLt uint64, descendingOrder bool) ([]*core.Transaction, error)
 	GetDnsExpiring(ctx context.Context, id tongo.AccountID, period *int) ([]core.DnsExpiring, error)
 	GetLogs(ctx context.Context, account tongo.AccountID, destination *tlb.MsgAddress, limit int, beforeLT uint64) ([]core.Message, error)
 	GetAccountDiff(ctx context.Context, account tongo.AccountID, startTime int64, endTime int64) (int64, error)
+	// GetLeaderboard returns the latest snapshot of tracked accounts with their balances and recent activity.
+	GetLeaderboard(ctx context.Context) (core.Leaderboard, error)
+	// GetLockupSchedules returns vesting schedules of tracked lockup wallets.
+	GetLockupSchedules(ctx context.Context) ([]core.LockupSchedule, error)
+	// GetAccountStats returns activity stats of an account for transactions with utime >= since.
+	GetAccountStats(ctx context.Context, account tongo.AccountID, since int64) (core.AccountStats, error)
+	// GetAccountActivityHeatmap returns numbers of transactions of an account in buckets of the given size covering [from, to).
+	GetAccountActivityHeatmap(ctx context.Context, account tongo.AccountID, from, to, size int64) ([]core.ActivityBucket, error)
+	// GetAccountCodeHistory returns codes installed in the account ordered by lt.
+	GetAccountCodeHistory(ctx context.Context, account tongo.AccountID) ([]core.CodeVersion, error)
+	// SearchTransactionsByPayload looks for indexed transactions whose messages match the search, the latest go first.
+	SearchTransactionsByPayload(ctx context.Context, search core.PayloadSearch) ([]*core.Transaction, error)
+	// IndexedAccountTransactions returns indexed transactions of the account with lt greater than afterLt,
+	// the earliest ones go first.
+	IndexedAccountTransactions(ctx context.Context, account tongo.AccountID, afterLt uint64, limit int) ([]*core.Transaction, error)
+	// IndexedAccountTransactionsBefore returns indexed transactions of the account with lt less than beforeLt,
+	// the latest ones go first.
+	IndexedAccountTransactionsBefore(ctx context.Context, account tongo.AccountID, beforeLt uint64, limit int) ([]*core.Transaction, error)
 	GetLatencyAndLastMasterchainSeqno(ctx context.Context) (int64, uint32, error)
+	// GetNetworkStats returns chain-wide aggregates collected from blocks observed by the indexer.
+	GetNetworkStats(ctx context.Context) (core.NetworkStats, error)
 	GetTrace(ctx context.Context, hash tongo.Bits256) (*core.Trace, error)
 	SearchTraces(ctx context.Context, a tongo.AccountID, limit int, beforeLT, startTime, endTime *int64, initiator bool) ([]core.TraceID, error)
 
@@ -77,6 +109,10 @@ type storage interface {
 	GetAccountJettonsHistory(ctx context.Context, address tongo.AccountID, limit int, beforeLT, startTime, endTime *int64) ([]tongo.Bits256, error)
 	GetAccountJettonHistoryByID(ctx context.Context, address, jettonMaster tongo.AccountID, limit int, beforeLT, startTime, endTime *int64) ([]tongo.Bits256, error)
 	GetJettonTransferPayload(ctx context.Context, accountID, jettonMaster ton.AccountID) (*core.JettonTransferPayload, error)
+	// GetJettonVolumes returns rolling transfer stats of the given jetton masters observed by the indexer.
+	GetJettonVolumes(ctx context.Context, masters []tongo.AccountID) (map[tongo.AccountID]core.JettonVolume, error)
+	// GetTopJettonsByVolume returns jettons with the highest number of transfers during the given period.
+	GetTopJettonsByVolume(ctx context.Context, period time.Duration, limit int) ([]core.JettonVolume, error)
 
 	GetAllAuctions(ctx context.Context) ([]core.Auction, error)
 	GetDomainBids(ctx context.Context, domain string) ([]core.DomainBid, error)
@@ -93,6 +129,11 @@ type storage interface {
 	GetSeqno(ctx context.Context, account tongo.AccountID) (uint32, error)
 
 	GetAccountState(ctx context.Context, a tongo.AccountID) (tlb.ShardAccount, error)
+	// GetAccountStateAtBlock returns the account's state as of the given masterchain block.
+	GetAccountStateAtBlock(ctx context.Context, a tongo.AccountID, block tongo.BlockIDExt) (tlb.ShardAccount, error)
+	// CountAccountTransactions counts the account's transactions with lt in (afterLt, lastLt],
+	// it stops after limit transactions and reports whether the count is exact.
+	CountAccountTransactions(ctx context.Context, a tongo.AccountID, afterLt uint64, lastLt uint64, lastHash tongo.Bits256, limit int) (int, bool, error)
 	GetLibraries(ctx context.Context, libraries []tongo.Bits256) (map[tongo.Bits256]*boc.Cell, error)
 
 	SearchAccountsByPubKey(pubKey ed25519.PublicKey) ([]tongo.AccountID, error)
@@ -170,6 +211,11 @@ type Gasless interface {
 	Send(ctx context.Context, walletPublicKey ed25519.PublicKey, payload []byte) error
 }
 
+// transactionSimulator injects synthetic transactions into streaming subscriptions.
+type transactionSimulator interface {
+	SimulateTransaction(event sources.TransactionEvent) error
+}
+
 type ratesSource interface {
 	GetRates(date int64) (map[string]float64, error)
 	GetRatesChart(token string, currency string, pointsCount int, startDate *int64, endDate *int64) ([][]any, error)
@@ -195,3 +241,39 @@ type mempoolEmulate struct {
 	traces         cache.Cache[ton.Bits256, *core.Trace]
 	accountsTraces cache.Cache[tongo.AccountID, []ton.Bits256]
 }
+
+// privateLabels is a private address book of the operator, see labels.Store.
+type privateLabels interface {
+	Get(account tongo.AccountID) (labels.Label, bool)
+	List() []labels.Label
+	Set(account tongo.AccountID, name, memo string) (labels.Label, error)
+	Delete(account tongo.AccountID) (bool, error)
+}
+
+// expectedDeposits is a registry of deposits exchanges expect to receive, see deposits.Registry.
+type expectedDeposits interface {
+	Add(account tongo.AccountID, comment string, minAmount int64, tenant string) (deposits.Expectation, error)
+	Get(id string) (deposits.Expectation, bool)
+	List(filter func(e deposits.Expectation) bool) []deposits.Expectation
+	Delete(id string) (bool, error)
+}
+
+type webhookRegistry interface {
+	Add(callbackURL string, accounts []tongo.AccountID, events []webhooks.EventType, tenant string) (webhooks.Webhook, error)
+	Get(id string) (webhooks.Webhook, bool)
+	List(filter func(w webhooks.Webhook) bool) []webhooks.Webhook
+	Delete(id string) (bool, error)
+	RotateSecret(id string, gracePeriod time.Duration) (webhooks.Webhook, error)
+	Status(id string) webhooks.Status
+}
+
+type usageMeter interface {
+	Since() time.Time
+	Usage(filter func(tokenName string) bool) []usage.Usage
+}
+
+type eventAnnotations interface {
+	Get(tenant string, eventID tongo.Bits256) (annotations.Annotation, bool)
+	Set(tenant string, eventID tongo.Bits256, tags []string, category, note string) (annotations.Annotation, error)
+	Delete(tenant string, eventID tongo.Bits256) (bool, error)
+}
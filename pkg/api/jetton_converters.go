@@ -27,6 +27,23 @@ func jettonPreview(master ton.AccountID, meta NormalizedMetadata) oas.JettonPrev
 	return preview
 }
 
+func convertJettonVolume(volume core.JettonVolume) oas.JettonVolume {
+	return oas.JettonVolume{
+		Transfers24h: volume.Transfers24h,
+		Volume24h:    volume.Volume24h.String(),
+		Transfers7d:  volume.Transfers7d,
+		Volume7d:     volume.Volume7d.String(),
+	}
+}
+
+func convertJettonWalletStatus(status core.JettonWalletStatus) oas.JettonWalletStatus {
+	return oas.JettonWalletStatus{
+		Code:           int32(status),
+		OutgoingLocked: status.OutgoingLocked(),
+		IncomingLocked: status.IncomingLocked(),
+	}
+}
+
 func jettonMetadata(account ton.AccountID, meta NormalizedMetadata) oas.JettonMetadata {
 	metadata := oas.JettonMetadata{
 		Address:  account.ToRaw(),
@@ -125,6 +142,12 @@ func (h *Handler) convertJettonBalance(ctx context.Context, wallet core.JettonWa
 			Till:   wallet.Lock.UnlockTime,
 		})
 	}
+	if wallet.Status != nil {
+		jettonBalance.Status = oas.NewOptJettonWalletStatus(convertJettonWalletStatus(*wallet.Status))
+	}
+	if wallet.CodeMismatch {
+		jettonBalance.CodeMismatch = oas.NewOptBool(true)
+	}
 	var err error
 	rates := make(map[string]oas.TokenRates)
 	for _, currency := range currencies {
@@ -161,6 +184,7 @@ func (h *Handler) convertJettonBalance(ctx context.Context, wallet core.JettonWa
 		normalizedMetadata = NormalizeMetadata(meta, nil, trust)
 	}
 	jettonBalance.Jetton = jettonPreview(wallet.JettonAddress, normalizedMetadata)
+	jettonBalance.NormalizedBalance = oas.NewOptString(wallet.Balance.Shift(int32(-normalizedMetadata.Decimals)).String())
 
 	return jettonBalance, nil
 }
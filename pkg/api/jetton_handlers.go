@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/tonkeeper/opentonapi/pkg/bath"
 	"github.com/tonkeeper/opentonapi/pkg/core"
@@ -84,14 +85,44 @@ func (h *Handler) GetJettonInfo(ctx context.Context, params oas.GetJettonInfoPar
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
 	}
-	return &oas.JettonInfo{
+	info := oas.JettonInfo{
 		Mintable:     data.Mintable,
 		TotalSupply:  data.TotalSupply.String(),
 		Metadata:     metadata,
 		Verification: oas.JettonVerificationType(meta.Verification),
 		HoldersCount: holdersCount[account.ID],
 		Admin:        convertOptAccountAddress(data.Admin, h.addressBook),
-	}, nil
+	}
+	volumes, err := h.storage.GetJettonVolumes(ctx, []tongo.AccountID{account.ID})
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	if volume, ok := volumes[account.ID]; ok && volume.Transfers7d > 0 {
+		info.Volume.SetTo(convertJettonVolume(volume))
+	}
+	return &info, nil
+}
+
+func (h *Handler) GetTopJettonsByVolume(ctx context.Context, params oas.GetTopJettonsByVolumeParams) (*oas.GetTopJettonsByVolumeOK, error) {
+	period := 24 * time.Hour
+	if params.Period.Or(oas.GetTopJettonsByVolumePeriod24h) == oas.GetTopJettonsByVolumePeriod7d {
+		period = 7 * 24 * time.Hour
+	}
+	volumes, err := h.storage.GetTopJettonsByVolume(ctx, period, int(params.Limit.Or(20)))
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := oas.GetTopJettonsByVolumeOK{
+		Jettons: make([]oas.GetTopJettonsByVolumeOKJettonsItem, 0, len(volumes)),
+	}
+	for _, volume := range volumes {
+		meta := h.GetJettonNormalizedMetadata(ctx, volume.Master)
+		result.Jettons = append(result.Jettons, oas.GetTopJettonsByVolumeOKJettonsItem{
+			Jetton: jettonPreview(volume.Master, meta),
+			Volume: convertJettonVolume(volume),
+		})
+	}
+	return &result, nil
 }
 
 func (h *Handler) GetAccountJettonsHistory(ctx context.Context, params oas.GetAccountJettonsHistoryParams) (*oas.AccountEvents, error) {
@@ -1,8 +1,15 @@
 package api
 
+import "time"
+
+// defaultSectionTimeout is used when Limits.SectionTimeout is not set.
+const defaultSectionTimeout = 5 * time.Second
+
 type Limits struct {
 	// BulkLimits stands for a number of entities a user is allowed to request at once with a bulk query.
 	BulkLimits int
+	// SectionTimeout bounds fetching of a single item of a bulk query when partial results are allowed.
+	SectionTimeout time.Duration
 }
 
 func (lim *Limits) isBulkQuantityAllowed(quantity int) bool {
@@ -11,3 +18,10 @@ func (lim *Limits) isBulkQuantityAllowed(quantity int) bool {
 	}
 	return quantity <= lim.BulkLimits
 }
+
+func (lim *Limits) sectionTimeout() time.Duration {
+	if lim.SectionTimeout <= 0 {
+		return defaultSectionTimeout
+	}
+	return lim.SectionTimeout
+}
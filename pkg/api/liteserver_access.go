@@ -0,0 +1,116 @@
+package api
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Narasimha1997/ratelimiter"
+	"github.com/ogen-go/ogen/middleware"
+)
+
+// liteServerPathPrefix is a common prefix of raw lite server endpoints.
+// These endpoints return raw BoCs with proofs, so advanced clients can verify responses on their own.
+const liteServerPathPrefix = "/v2/liteserver/"
+
+// LiteServerAccess restricts access to raw lite server endpoints.
+type LiteServerAccess struct {
+	// Tokens are bearer tokens granted the "liteserver" scope.
+	// If empty, raw lite server endpoints are available to everyone.
+	Tokens []string
+	// RequestsPerSecond limits the number of raw lite server requests per token, 0 means no limit.
+	RequestsPerSecond int
+}
+
+// WithLiteServerAccess configures a scope-limited permission and a rate limit for raw lite server endpoints.
+func WithLiteServerAccess(access LiteServerAccess) ServerOption {
+	return func(options *ServerOptions) {
+		options.ogenMiddlewares = append(options.ogenMiddlewares, liteServerAccessMiddleware(access))
+	}
+}
+
+// idleLimiterTTL is how long a rate limiter of a token is kept after the token's last request,
+// so limiters of tokens that are used once don't pile up along with their goroutines.
+const idleLimiterTTL = time.Minute
+
+// tokenLimiters keeps a rate limiter per token and evicts limiters of idle tokens.
+type tokenLimiters struct {
+	rps int
+
+	// mu protects all fields below.
+	mu        sync.Mutex
+	limiters  map[string]*tokenLimiter
+	lastSweep time.Time
+}
+
+type tokenLimiter struct {
+	limiter  *ratelimiter.DefaultLimiter
+	lastUsed time.Time
+}
+
+func newTokenLimiters(rps int) *tokenLimiters {
+	return &tokenLimiters{rps: rps, limiters: map[string]*tokenLimiter{}}
+}
+
+func (t *tokenLimiters) get(token string, now time.Time) *ratelimiter.DefaultLimiter {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if now.Sub(t.lastSweep) >= idleLimiterTTL {
+		for key, l := range t.limiters {
+			if now.Sub(l.lastUsed) >= idleLimiterTTL {
+				l.limiter.Kill()
+				delete(t.limiters, key)
+			}
+		}
+		t.lastSweep = now
+	}
+	l, ok := t.limiters[token]
+	if !ok {
+		l = &tokenLimiter{limiter: ratelimiter.NewDefaultLimiter(uint64(t.rps), time.Second)}
+		t.limiters[token] = l
+	}
+	l.lastUsed = now
+	return l.limiter
+}
+
+func liteServerAccessMiddleware(access LiteServerAccess) middleware.Middleware {
+	limiters := newTokenLimiters(access.RequestsPerSecond)
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		if !strings.HasPrefix(req.Raw.URL.Path, liteServerPathPrefix) {
+			return next(req)
+		}
+		token := bearerToken(req.Raw)
+		if len(access.Tokens) > 0 && !tokenGranted(access.Tokens, token) {
+			return middleware.Response{}, toError(http.StatusForbidden, fmt.Errorf("token with liteserver scope is required"))
+		}
+		if access.RequestsPerSecond > 0 {
+			if allow, _ := limiters.get(token, time.Now()).ShouldAllow(1); !allow {
+				return middleware.Response{}, toError(http.StatusTooManyRequests, ErrRateLimit)
+			}
+		}
+		return next(req)
+	}
+}
+
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if token, ok := strings.CutPrefix(header, "Bearer "); ok {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+func tokenGranted(tokens []string, token string) bool {
+	if token == "" {
+		return false
+	}
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
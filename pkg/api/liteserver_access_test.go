@@ -0,0 +1,70 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_liteServerAccessMiddleware(t *testing.T) {
+	next := func(req middleware.Request) (middleware.Response, error) {
+		return middleware.Response{}, nil
+	}
+	tests := []struct {
+		name     string
+		access   LiteServerAccess
+		path     string
+		token    string
+		requests int
+		wantCode int
+	}{
+		{name: "open by default", path: "/v2/liteserver/get_masterchain_info", requests: 3},
+		{name: "other endpoints are not restricted", access: LiteServerAccess{Tokens: []string{"secret"}}, path: "/v2/accounts/x", requests: 1},
+		{name: "token is required", access: LiteServerAccess{Tokens: []string{"secret"}}, path: "/v2/liteserver/get_masterchain_info", requests: 1, wantCode: http.StatusForbidden},
+		{name: "wrong token", access: LiteServerAccess{Tokens: []string{"secret"}}, path: "/v2/liteserver/get_masterchain_info", token: "guess", requests: 1, wantCode: http.StatusForbidden},
+		{name: "granted", access: LiteServerAccess{Tokens: []string{"a", "secret"}}, path: "/v2/liteserver/get_masterchain_info", token: "secret", requests: 2},
+		{name: "rate limited", access: LiteServerAccess{Tokens: []string{"secret"}, RequestsPerSecond: 2}, path: "/v2/liteserver/get_masterchain_info", token: "secret", requests: 3, wantCode: http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw := liteServerAccessMiddleware(tt.access)
+			var err error
+			for i := 0; i < tt.requests; i++ {
+				r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+				if tt.token != "" {
+					r.Header.Set("Authorization", "Bearer "+tt.token)
+				}
+				_, err = mw(middleware.Request{Raw: r}, next)
+			}
+			if tt.wantCode == 0 {
+				require.Nil(t, err)
+				return
+			}
+			var statusErr *oas.ErrorStatusCode
+			require.True(t, errors.As(err, &statusErr))
+			require.Equal(t, tt.wantCode, statusErr.StatusCode)
+		})
+	}
+}
+
+func Test_tokenLimiters(t *testing.T) {
+	limiters := newTokenLimiters(1)
+	now := time.Now()
+	first := limiters.get("first", now)
+	require.Same(t, first, limiters.get("first", now.Add(idleLimiterTTL/4)))
+	second := limiters.get("second", now.Add(idleLimiterTTL*3/4))
+	require.Len(t, limiters.limiters, 2)
+
+	// "first" has been idle for a while, "second" is still in use.
+	later := now.Add(idleLimiterTTL * 3 / 2)
+	require.Same(t, second, limiters.get("second", later))
+	require.Len(t, limiters.limiters, 1)
+	require.NotSame(t, first, limiters.get("first", later))
+}
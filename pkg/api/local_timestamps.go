@@ -0,0 +1,133 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	// time zones are resolved even if the host doesn't have the tz database, e.g. in a scratch image.
+	_ "time/tzdata"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+)
+
+// timestampKeys are keys of unix timestamps in responses described in api/openapi.yml.
+var timestampKeys = map[string]struct{}{
+	"utime":         {},
+	"timestamp":     {},
+	"created_at":    {},
+	"gen_utime":     {},
+	"last_activity": {},
+	"expiring_at":   {},
+	"valid_until":   {},
+	"last_paid":     {},
+	"frozen_at":     {},
+	"deleted_at":    {},
+	"time":          {},
+	"now":           {},
+	"start_time":    {},
+}
+
+// timeFormats are layouts of local timestamps requested with the "time_format" query parameter.
+var timeFormats = map[string]string{
+	"iso8601":  time.RFC3339,
+	"datetime": time.DateTime,
+	"date":     time.DateOnly,
+}
+
+// localTimestamps returns a time zone and a layout requested with "timezone" and "time_format" query parameters,
+// ok is false if the request doesn't ask for local timestamps.
+func localTimestamps(r *http.Request) (loc *time.Location, layout string, ok bool, err error) {
+	query := r.URL.Query()
+	timezone, format := query.Get("timezone"), query.Get("time_format")
+	if timezone == "" && format == "" {
+		return nil, "", false, nil
+	}
+	loc = time.UTC
+	if timezone != "" {
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, "", false, fmt.Errorf("unknown timezone %q", timezone)
+		}
+	}
+	layout = time.RFC3339
+	if format != "" {
+		if layout, ok = timeFormats[format]; !ok {
+			return nil, "", false, fmt.Errorf("unknown time_format %q, supported formats are iso8601, datetime and date", format)
+		}
+	}
+	return loc, layout, true, nil
+}
+
+// localTimestampsMiddleware adds a "<key>_local" field with a formatted local time next to every unix timestamp
+// of a successful JSON response, so CSV exports and dashboards can show times without post-processing.
+// Unix timestamps are kept as is.
+func localTimestampsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		loc, layout, ok, err := localTimestamps(r)
+		if err != nil {
+			errcode.Write(w, http.StatusBadRequest, errcode.BadRequest, err.Error())
+			return
+		}
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		buffered := &bufferedResponseWriter{header: w.Header(), status: http.StatusOK}
+		next.ServeHTTP(buffered, r)
+		body := buffered.body.Bytes()
+		if buffered.status == http.StatusOK && isJSONContentType(w.Header().Get("Content-Type")) {
+			if converted, err := addLocalTimestamps(body, loc, layout); err == nil {
+				body = converted
+			}
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(buffered.status)
+		_, _ = w.Write(body)
+	})
+}
+
+func isJSONContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/json") || strings.HasPrefix(contentType, mediaTypeEventModelV3)
+}
+
+func addLocalTimestamps(body []byte, loc *time.Location, layout string) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(walkLocalTimestamps(doc, loc, layout))
+}
+
+func walkLocalTimestamps(node any, loc *time.Location, layout string) any {
+	switch value := node.(type) {
+	case map[string]any:
+		local := map[string]any{}
+		for key, child := range value {
+			if _, ok := timestampKeys[key]; ok {
+				if number, ok := child.(json.Number); ok {
+					// zero means the moment is unknown.
+					if seconds, err := number.Int64(); err == nil && seconds > 0 {
+						local[key+"_local"] = time.Unix(seconds, 0).In(loc).Format(layout)
+					}
+					continue
+				}
+			}
+			value[key] = walkLocalTimestamps(child, loc, layout)
+		}
+		for key, formatted := range local {
+			value[key] = formatted
+		}
+		return value
+	case []any:
+		for i := range value {
+			value[i] = walkLocalTimestamps(value[i], loc, layout)
+		}
+		return value
+	}
+	return node
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_localTimestampsMiddleware(t *testing.T) {
+	const response = `{"seqno":1,"utime":1700000000,"transactions":[{"lt":100,"utime":1700000060}],"last_activity":0}`
+	handler := localTimestampsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(response))
+	}))
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		want       string
+	}{
+		{
+			name:       "no parameters",
+			wantStatus: http.StatusOK,
+			want:       response,
+		},
+		{
+			name:       "default format in utc",
+			query:      "?time_format=iso8601",
+			wantStatus: http.StatusOK,
+			want:       `{"seqno":1,"utime":1700000000,"utime_local":"2023-11-14T22:13:20Z","transactions":[{"lt":100,"utime":1700000060,"utime_local":"2023-11-14T22:14:20Z"}],"last_activity":0}`,
+		},
+		{
+			name:       "datetime in a timezone",
+			query:      "?timezone=Europe/Berlin&time_format=datetime",
+			wantStatus: http.StatusOK,
+			want:       `{"seqno":1,"utime":1700000000,"utime_local":"2023-11-14 23:13:20","transactions":[{"lt":100,"utime":1700000060,"utime_local":"2023-11-14 23:14:20"}],"last_activity":0}`,
+		},
+		{
+			name:       "iso8601 with an offset",
+			query:      "?timezone=Asia/Tokyo",
+			wantStatus: http.StatusOK,
+			want:       `{"seqno":1,"utime":1700000000,"utime_local":"2023-11-15T07:13:20+09:00","transactions":[{"lt":100,"utime":1700000060,"utime_local":"2023-11-15T07:14:20+09:00"}],"last_activity":0}`,
+		},
+		{
+			name:       "unknown timezone",
+			query:      "?timezone=Mars/Olympus",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown format",
+			query:      "?time_format=rfc822",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/blockchain/masterchain-head"+tt.query, nil))
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.want != "" {
+				require.JSONEq(t, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
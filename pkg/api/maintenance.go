@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+)
+
+var maintenanceModeMetric = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "maintenance_mode",
+	Help: "1 if the instance is in the maintenance mode and drains its connections, 0 otherwise",
+})
+
+// Maintenance is a toggle of the maintenance mode used during rolling deploys.
+// In the maintenance mode, new requests are rejected with 503 Service Unavailable and a Retry-After header,
+// while existing streaming connections are kept and receive a server_shutting_down event,
+// so clients can reconnect to another instance in advance.
+type Maintenance struct {
+	retryAfter    time.Duration
+	failoverDelay time.Duration
+
+	// mu protects "enabled" and "draining".
+	mu       sync.Mutex
+	enabled  bool
+	draining chan struct{}
+}
+
+var _ utils.Drain = (*Maintenance)(nil)
+
+// NewMaintenance returns a Maintenance toggle, it is off initially.
+// retryAfter is reported to clients of rejected requests,
+// failoverDelay is suggested to streaming clients before they reconnect to another instance.
+func NewMaintenance(retryAfter, failoverDelay time.Duration) *Maintenance {
+	return &Maintenance{
+		retryAfter:    retryAfter,
+		failoverDelay: failoverDelay,
+		draining:      make(chan struct{}),
+	}
+}
+
+// WithMaintenance enables the maintenance mode toggle, see Maintenance.
+func WithMaintenance(m *Maintenance) ServerOption {
+	return func(options *ServerOptions) {
+		options.maintenance = m
+	}
+}
+
+// Enable switches the instance to the maintenance mode.
+func (m *Maintenance) Enable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.enabled {
+		return
+	}
+	m.enabled = true
+	close(m.draining)
+	maintenanceModeMetric.Set(1)
+}
+
+// Disable brings the instance back from the maintenance mode.
+func (m *Maintenance) Disable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.enabled {
+		return
+	}
+	m.enabled = false
+	m.draining = make(chan struct{})
+	maintenanceModeMetric.Set(0)
+}
+
+func (m *Maintenance) Enabled() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.enabled
+}
+
+func (m *Maintenance) Draining() <-chan struct{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.draining
+}
+
+func (m *Maintenance) FailoverDelay() time.Duration {
+	return m.failoverDelay
+}
+
+type maintenanceStatus struct {
+	Enabled         bool  `json:"enabled"`
+	RetryAfterMs    int64 `json:"retry_after_ms"`
+	FailoverDelayMs int64 `json:"failover_delay_ms"`
+}
+
+// AdminHandler returns an http.Handler to toggle the maintenance mode.
+// It is supposed to be exposed on an internal port only:
+//
+//	GET     returns the current status
+//	POST    enables the maintenance mode
+//	DELETE  disables the maintenance mode
+func (m *Maintenance) AdminHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			m.Enable()
+		case http.MethodDelete:
+			m.Disable()
+		default:
+			errcode.Write(w, http.StatusMethodNotAllowed, errcode.BadRequest, "method not allowed")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(maintenanceStatus{
+			Enabled:         m.Enabled(),
+			RetryAfterMs:    m.retryAfter.Milliseconds(),
+			FailoverDelayMs: m.failoverDelay.Milliseconds(),
+		})
+	})
+}
+
+// maintenanceMiddleware rejects new requests while the maintenance mode is on.
+func maintenanceMiddleware(m *Maintenance, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		seconds := int64(math.Ceil(m.retryAfter.Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+		w.Header().Set("Retry-After", fmt.Sprintf("%d", seconds))
+		errcode.Write(w, http.StatusServiceUnavailable, errcode.Unavailable, "the instance is in maintenance mode")
+	})
+}
+
+// drainMiddleware attaches the maintenance toggle to the request context,
+// so streaming connections get notified once the instance starts draining.
+func drainMiddleware(m *Maintenance) AsyncMiddleware {
+	return func(handler AsyncHandler) AsyncHandler {
+		return func(w http.ResponseWriter, r *http.Request, connectionType int, allowTokenInQuery bool) error {
+			ctx := context.WithValue(r.Context(), utils.DrainKey, utils.Drain(m))
+			return handler(w, r.WithContext(ctx), connectionType, allowTokenInQuery)
+		}
+	}
+}
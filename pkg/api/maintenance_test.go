@@ -0,0 +1,50 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenance_middleware(t *testing.T) {
+	m := NewMaintenance(1500*time.Millisecond, time.Second)
+	handler := maintenanceMiddleware(m, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	admin := m.AdminHandler()
+
+	serve := func(h http.Handler, method string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(method, "/v2/status", nil))
+		return rec
+	}
+	require.Equal(t, http.StatusOK, serve(handler, http.MethodGet).Code)
+	draining := m.Draining()
+
+	rec := serve(admin, http.MethodPost)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"enabled":true,"retry_after_ms":1500,"failover_delay_ms":1000}`, rec.Body.String())
+	select {
+	case <-draining:
+	default:
+		t.Fatal("draining channel must be closed")
+	}
+	rec = serve(handler, http.MethodGet)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "2", rec.Header().Get("Retry-After"))
+	require.JSONEq(t, `{"error":"the instance is in maintenance mode","error_code":"unavailable"}`, rec.Body.String())
+
+	rec = serve(admin, http.MethodDelete)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, m.Enabled())
+	require.Equal(t, http.StatusOK, serve(handler, http.MethodGet).Code)
+	select {
+	case <-m.Draining():
+		t.Fatal("a new draining channel must be open")
+	default:
+	}
+	require.Equal(t, http.StatusMethodNotAllowed, serve(admin, http.MethodPut).Code)
+}
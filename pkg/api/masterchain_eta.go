@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// defaultMasterchainBlockTime is used until the indexer observes enough masterchain blocks, in seconds.
+const defaultMasterchainBlockTime = 5.0
+
+func (h *Handler) GetMasterchainEta(ctx context.Context, params oas.GetMasterchainEtaParams) (*oas.MasterchainEta, error) {
+	if params.Seqno.IsSet() == params.Event.IsSet() {
+		return nil, toError(http.StatusBadRequest, fmt.Errorf("either seqno or event is required"))
+	}
+	header, err := h.storage.LastMasterchainBlockHeader(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	stats, err := h.storage.GetNetworkStats(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	blockTime := stats.AvgMasterchainBlockTime
+	if blockTime <= 0 {
+		blockTime = defaultMasterchainBlockTime
+	}
+	current := masterchainPoint{seqno: header.Seqno, utime: int64(header.GenUtime)}
+	var eta oas.MasterchainEta
+	if params.Seqno.IsSet() {
+		if params.Seqno.Value <= int32(current.seqno) {
+			return nil, toError(http.StatusBadRequest, fmt.Errorf("masterchain block %v is already generated", params.Seqno.Value))
+		}
+		eta = estimateMasterchainUtime(current, uint32(params.Seqno.Value), blockTime)
+	} else {
+		config, err := h.storage.GetLastConfig(ctx)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		deadline, err := validationDeadline(config, params.Event.Value, time.Now().Unix())
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		eta = estimateMasterchainSeqno(current, deadline, blockTime)
+	}
+	eta.SecondsLeft = max(eta.TargetUtime-time.Now().Unix(), 0)
+	return &eta, nil
+}
+
+// masterchainPoint is a masterchain block estimations start from.
+type masterchainPoint struct {
+	seqno uint32
+	utime int64
+}
+
+// estimateMasterchainUtime returns when a masterchain block with the given seqno is expected to be generated.
+func estimateMasterchainUtime(current masterchainPoint, seqno uint32, blockTime float64) oas.MasterchainEta {
+	return oas.MasterchainEta{
+		CurrentSeqno: int32(current.seqno),
+		CurrentUtime: current.utime,
+		TargetSeqno:  int32(seqno),
+		TargetUtime:  current.utime + int64(math.Round(float64(seqno-current.seqno)*blockTime)),
+		BlockTime:    blockTime,
+	}
+}
+
+// estimateMasterchainSeqno returns the seqno of the first masterchain block expected to be generated at or after the deadline.
+func estimateMasterchainSeqno(current masterchainPoint, deadline int64, blockTime float64) oas.MasterchainEta {
+	seqno := current.seqno
+	if deadline > current.utime {
+		seqno += uint32(math.Ceil(float64(deadline-current.utime) / blockTime))
+	}
+	return oas.MasterchainEta{
+		CurrentSeqno: int32(current.seqno),
+		CurrentUtime: current.utime,
+		TargetSeqno:  int32(seqno),
+		TargetUtime:  deadline,
+		BlockTime:    blockTime,
+	}
+}
+
+func validatorSetBounds(set tlb.ValidatorSet) (since uint32, until uint32) {
+	switch set.SumType {
+	case "Validators":
+		return set.Validators.UtimeSince, set.Validators.UtimeUntil
+	case "ValidatorsExt":
+		return set.ValidatorsExt.UtimeSince, set.ValidatorsExt.UtimeUntil
+	}
+	return 0, 0
+}
+
+// validationDeadline returns the next moment after now when the given event of the validation cycle happens.
+func validationDeadline(config ton.BlockchainConfig, event oas.GetMasterchainEtaEvent, now int64) (int64, error) {
+	if config.ConfigParam15 == nil || config.ConfigParam34 == nil {
+		return 0, fmt.Errorf("blockchain config doesn't contain validation timings")
+	}
+	timings := config.ConfigParam15
+	_, until := validatorSetBounds(config.ConfigParam34.CurValidators)
+	if until == 0 {
+		return 0, fmt.Errorf("unknown validator set")
+	}
+	roundEnd := int64(until)
+	var deadline int64
+	switch event {
+	case oas.GetMasterchainEtaEventElectionsStart:
+		deadline = roundEnd - int64(timings.ElectionsStartBefore)
+	case oas.GetMasterchainEtaEventElectionsEnd:
+		deadline = roundEnd - int64(timings.ElectionsEndBefore)
+	case oas.GetMasterchainEtaEventValidationRoundEnd:
+		deadline = roundEnd
+	case oas.GetMasterchainEtaEventStakeUnfreeze:
+		// stakes of the previous round are still frozen for a while after the round has ended.
+		deadline = roundEnd + int64(timings.StakeHeldFor)
+		if config.ConfigParam32 != nil {
+			if _, prevUntil := validatorSetBounds(config.ConfigParam32.PrevValidators); prevUntil != 0 && int64(prevUntil)+int64(timings.StakeHeldFor) > now {
+				deadline = int64(prevUntil) + int64(timings.StakeHeldFor)
+			}
+		}
+	default:
+		return 0, fmt.Errorf("unknown event %v", event)
+	}
+	// the deadline of the current round has passed, so the next one comes a round later.
+	for deadline <= now && timings.ValidatorsElectedFor > 0 {
+		deadline += int64(timings.ValidatorsElectedFor)
+	}
+	return deadline, nil
+}
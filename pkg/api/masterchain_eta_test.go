@@ -0,0 +1,65 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_estimateMasterchainUtime(t *testing.T) {
+	eta := estimateMasterchainUtime(masterchainPoint{seqno: 1000, utime: 1_700_000_000}, 1010, 5.5)
+	require.Equal(t, int32(1010), eta.TargetSeqno)
+	require.Equal(t, int64(1_700_000_055), eta.TargetUtime)
+}
+
+func Test_estimateMasterchainSeqno(t *testing.T) {
+	current := masterchainPoint{seqno: 1000, utime: 1_700_000_000}
+	require.Equal(t, int32(1011), estimateMasterchainSeqno(current, 1_700_000_052, 5).TargetSeqno)
+	require.Equal(t, int32(1010), estimateMasterchainSeqno(current, 1_700_000_050, 5).TargetSeqno)
+	require.Equal(t, int32(1000), estimateMasterchainSeqno(current, 1_699_999_990, 5).TargetSeqno)
+}
+
+func Test_validationDeadline(t *testing.T) {
+	validatorSet := func(since, until uint32) tlb.ValidatorSet {
+		set := tlb.ValidatorSet{SumType: "ValidatorsExt"}
+		set.ValidatorsExt.UtimeSince = since
+		set.ValidatorsExt.UtimeUntil = until
+		return set
+	}
+	config := ton.BlockchainConfig{
+		ConfigParam15: &tlb.ConfigParam15{
+			ValidatorsElectedFor: 65536,
+			ElectionsStartBefore: 32768,
+			ElectionsEndBefore:   8192,
+			StakeHeldFor:         32768,
+		},
+		ConfigParam32: &tlb.ConfigParam32{PrevValidators: validatorSet(1_000_000-65536, 1_000_000)},
+		ConfigParam34: &tlb.ConfigParam34{CurValidators: validatorSet(1_000_000, 1_000_000+65536)},
+	}
+	tests := []struct {
+		name  string
+		event oas.GetMasterchainEtaEvent
+		now   int64
+		want  int64
+	}{
+		{name: "elections start", event: oas.GetMasterchainEtaEventElectionsStart, now: 1_010_000, want: 1_000_000 + 65536 - 32768},
+		{name: "elections end", event: oas.GetMasterchainEtaEventElectionsEnd, now: 1_010_000, want: 1_000_000 + 65536 - 8192},
+		{name: "next round elections", event: oas.GetMasterchainEtaEventElectionsStart, now: 1_040_000, want: 1_000_000 + 2*65536 - 32768},
+		{name: "round end", event: oas.GetMasterchainEtaEventValidationRoundEnd, now: 1_010_000, want: 1_000_000 + 65536},
+		{name: "previous stakes unfreeze", event: oas.GetMasterchainEtaEventStakeUnfreeze, now: 1_010_000, want: 1_000_000 + 32768},
+		{name: "current stakes unfreeze", event: oas.GetMasterchainEtaEventStakeUnfreeze, now: 1_040_000, want: 1_000_000 + 65536 + 32768},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validationDeadline(config, tt.event, tt.now)
+			require.Nil(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+	_, err := validationDeadline(ton.BlockchainConfig{}, oas.GetMasterchainEtaEventElectionsStart, 0)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+// SearchMessages finds transactions of tracked accounts by a text comment or a payload hash of their messages,
+// so support teams can find a payment by its memo.
+func (h *Handler) SearchMessages(ctx context.Context, params oas.SearchMessagesParams) (*oas.Transactions, error) {
+	search := core.PayloadSearch{
+		Comment:   params.Comment.Value,
+		StartTime: params.StartDate.Value,
+		EndTime:   params.EndDate.Value,
+		BeforeLt:  uint64(params.BeforeLt.Value),
+		Limit:     int(params.Limit.Or(100)),
+	}
+	if params.PayloadHash.Value != "" {
+		hash, err := tongo.ParseHash(params.PayloadHash.Value)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		search.Hash = &hash
+	}
+	if search.Comment == "" && search.Hash == nil {
+		return nil, toError(http.StatusBadRequest, fmt.Errorf("either comment or payload_hash is required"))
+	}
+	if params.AccountID.Value != "" {
+		account, err := tongo.ParseAddress(params.AccountID.Value)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		search.Accounts = []tongo.AccountID{account.ID}
+	}
+	if t, ok := tenant.FromContext(ctx); ok {
+		// a tenant searches only its own accounts.
+		if len(search.Accounts) == 0 {
+			search.Accounts = t.WatchedAccounts()
+		} else if !t.Watches(search.Accounts[0]) {
+			return nil, toError(http.StatusNotFound, fmt.Errorf("account is not tracked"))
+		}
+	}
+	txs, err := h.storage.SearchTransactionsByPayload(ctx, search)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := oas.Transactions{Transactions: make([]oas.Transaction, 0, len(txs))}
+	for _, tx := range txs {
+		transaction := convertTransaction(*tx, nil, h.addressBook)
+		h.linkBounces(ctx, &transaction, tx)
+		result.Transactions = append(result.Transactions, transaction)
+	}
+	return &result, nil
+}
@@ -3,20 +3,32 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
 	"time"
 
 	"github.com/tonkeeper/opentonapi/pkg/cache"
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/literetry"
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+	"github.com/tonkeeper/opentonapi/pkg/unresolved"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/tep64"
 )
 
 func (mc *metadataCache) getCollectionMeta(ctx context.Context, a tongo.AccountID) (tep64.Metadata, bool) {
+	started := time.Now()
 	m, ok := mc.collectionsCache.Get(a)
+	slowlog.RecordKind(ctx, slowlog.KindCache, "collections_metadata_cache", time.Since(started))
 	if ok {
 		return m, ok
 	}
 	collection, err := mc.storage.GetNftCollectionByCollectionAddress(ctx, a)
 	if err != nil {
+		if isTransientMetadataError(err) {
+			unresolved.Record(ctx, unresolved.KindCollectionMetadata, a.ToRaw(), err)
+		}
 		return tep64.Metadata{}, false
 	}
 	m = metaMapToStruct(collection.Metadata)
@@ -32,14 +44,34 @@ func metaMapToStruct(m map[string]interface{}) tep64.Metadata { //todo: rewrite
 }
 
 func (mc *metadataCache) getJettonMeta(ctx context.Context, a tongo.AccountID) (tep64.Metadata, bool) {
+	started := time.Now()
 	m, ok := mc.jettonsCache.Get(a)
+	slowlog.RecordKind(ctx, slowlog.KindCache, "jettons_metadata_cache", time.Since(started))
 	if ok {
 		return m, true
 	}
 	m, err := mc.storage.GetJettonMasterMetadata(ctx, a)
 	if err != nil {
+		if isTransientMetadataError(err) {
+			unresolved.Record(ctx, unresolved.KindJettonMetadata, a.ToRaw(), err)
+		}
 		return m, false
 	}
 	mc.jettonsCache.Set(a, m, cache.WithExpiration(time.Minute*10))
 	return m, true
 }
+
+// isTransientMetadataError reports whether metadata has failed to load for a reason that may go away,
+// e.g. a lite server or an IPFS gateway timeout.
+// A jetton without metadata or with broken metadata stays an unknown token, so retrying it is pointless.
+func isTransientMetadataError(err error) bool {
+	if literetry.IsTransient(err) {
+		return true
+	}
+	switch errcode.Of(http.StatusInternalServerError, err) {
+	case errcode.LiteServerTimeout, errcode.LiteServerError, errcode.UpstreamUnavailable:
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -2,16 +2,19 @@ package api
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
+	ht "github.com/ogen-go/ogen/http"
 	"github.com/ogen-go/ogen/middleware"
 	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"github.com/tonkeeper/opentonapi/pkg/oas"
 	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
 )
 
 func ogenLoggingMiddleware(logger *zap.Logger) middleware.Middleware {
@@ -65,10 +68,40 @@ var httpResponseTimeMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
 	Buckets:     []float64{0.001, 0.01, 0.05, 0.1, 0.5, 1, 10},
 }, []string{"operation"})
 
+// operationDurationMetric and operationRequestsMetric are meant for SLOs,
+// so they are labeled with stable operation IDs from api/openapi.yml and have finer buckets.
+var operationDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Subsystem: "http",
+	Name:      "operation_duration_seconds",
+	Help:      "Duration of REST requests by operation ID",
+	Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+}, []string{"operation_id"})
+
+var operationRequestsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "http",
+	Name:      "operation_requests_total",
+	Help:      "Number of REST requests by operation ID and error code, successful requests have the \"ok\" code",
+}, []string{"operation_id", "code"})
+
 func ogenMetricsMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
 	t := prometheus.NewTimer(httpResponseTimeMetric.WithLabelValues(req.OperationName))
 	defer t.ObserveDuration()
-	return next(req)
+	started := time.Now()
+	resp, err := next(req)
+	operationDurationMetric.WithLabelValues(req.OperationID).Observe(time.Since(started).Seconds())
+	operationRequestsMetric.WithLabelValues(req.OperationID, responseCode(err)).Inc()
+	return resp, err
+}
+
+// responseCode returns an error code of a response, see errcode package.
+func responseCode(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	if oasError, ok := err.(*oas.ErrorStatusCode); ok {
+		return oasError.Response.ErrorCode
+	}
+	return string(errcode.Of(http.StatusInternalServerError, err))
 }
 
 func asyncMetricsMiddleware(next AsyncHandler) AsyncHandler {
@@ -81,21 +114,19 @@ func asyncMetricsMiddleware(next AsyncHandler) AsyncHandler {
 
 var ErrRateLimit = errors.New("rate limit")
 
-type errorJSON struct {
-	Error string
-}
-
 func ogenErrorsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
-	w.Header().Set("content-type", "application/json")
+	status := http.StatusInternalServerError
 	switch err.(type) {
 	case *ogenerrors.DecodeParamsError, *ogenerrors.DecodeBodyError, *ogenerrors.DecodeRequestError, *ogenerrors.DecodeParamError:
-		w.WriteHeader(http.StatusBadRequest)
+		status = http.StatusBadRequest
 	default:
 		if errors.Is(err, ErrRateLimit) {
-			w.WriteHeader(http.StatusTooManyRequests)
-		} else {
-			w.WriteHeader(http.StatusInternalServerError)
+			status = http.StatusTooManyRequests
+		} else if errors.Is(err, ht.ErrNotImplemented) {
+			status = http.StatusNotImplemented
+		} else if s, ok := errcode.Status(err); ok {
+			status = s
 		}
 	}
-	json.NewEncoder(w).Encode(&errorJSON{Error: err.Error()})
+	errcode.Write(w, status, errcode.Of(status, err), err.Error())
 }
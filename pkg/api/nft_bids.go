@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/auctions"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	pusherErrors "github.com/tonkeeper/opentonapi/pkg/pusher/errors"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/events"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sse"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+)
+
+// auctionBids keeps a history of bids placed on NFT auctions, see auctions.Tracker.
+type auctionBids interface {
+	Bids(item tongo.AccountID) []auctions.Bid
+}
+
+// bidSource provides new bids placed on NFT auctions, see auctions.Tracker.
+type bidSource interface {
+	SubscribeToBids(ctx context.Context, deliveryFn sources.DeliveryFn, opts auctions.SubscribeOptions) sources.CancelFn
+}
+
+// WithBidSource exposes new bids placed on NFT auctions at /v2/sse/nfts/bids.
+func WithBidSource(src bidSource) ServerOption {
+	return func(options *ServerOptions) {
+		options.bidSource = src
+	}
+}
+
+// subscribeToBids streams bids on the collections, items or auctions of the "accounts" query parameter.
+func subscribeToBids(sseHandler *sse.Handler, source bidSource) sse.HandlerFunc {
+	return func(session sse.Session, request *http.Request) error {
+		var opts auctions.SubscribeOptions
+		accounts := request.URL.Query().Get("accounts")
+		if strings.ToUpper(accounts) == "ALL" {
+			opts.AllAccounts = true
+		} else {
+			for _, str := range strings.Split(accounts, ",") {
+				account, err := tongo.ParseAddress(str)
+				if err != nil {
+					return pusherErrors.BadRequest(fmt.Sprintf("failed to parse query parameters: %v", err))
+				}
+				opts.Accounts = append(opts.Accounts, account.ID)
+			}
+		}
+		if err := utils.LimitsFromContext(request.Context()).CheckAccounts(len(opts.Accounts)); err != nil {
+			return pusherErrors.SubscriptionLimitExceeded(err.Error())
+		}
+		var err error
+		opts.Accounts, opts.AllAccounts, err = utils.ScopeAccounts(request.Context(), opts.Accounts, opts.AllAccounts)
+		if err != nil {
+			return pusherErrors.Forbidden(err.Error())
+		}
+		cancelFn := source.SubscribeToBids(request.Context(), sseHandler.Deliver(session, events.NftBidEvent), opts)
+		session.SetCancelFn(cancelFn)
+		return nil
+	}
+}
+
+func convertNftBid(bid auctions.Bid, book addressBook) oas.NftBid {
+	result := oas.NftBid{
+		Auction:     convertAccountAddress(bid.Auction, book),
+		AuctionType: oas.NftBidAuctionType(bid.Type),
+		Bidder:      convertAccountAddress(bid.Bidder, book),
+		Amount: oas.Price{
+			Value:     fmt.Sprintf("%v", bid.Amount),
+			TokenName: "TON",
+		},
+		TraceID: bid.TraceID,
+		Utime:   bid.Utime,
+	}
+	if bid.Nft != nil {
+		result.Nft = oas.NewOptString(bid.Nft.ToRaw())
+	}
+	if bid.Collection != nil {
+		result.Collection = oas.NewOptString(bid.Collection.ToRaw())
+	}
+	return result
+}
+
+func (h *Handler) GetNftItemBids(ctx context.Context, params oas.GetNftItemBidsParams) (*oas.NftBids, error) {
+	if h.auctionBids == nil {
+		return nil, toError(http.StatusNotImplemented, fmt.Errorf("auction bids are not tracked"))
+	}
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	bids := h.auctionBids.Bids(account.ID)
+	result := oas.NftBids{Bids: make([]oas.NftBid, 0, len(bids))}
+	for _, bid := range bids {
+		result.Bids = append(result.Bids, convertNftBid(bid, h.addressBook))
+	}
+	return &result, nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/addressbook"
+	"github.com/tonkeeper/opentonapi/pkg/auctions"
+	"github.com/tonkeeper/opentonapi/pkg/bath"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_convertNftBid(t *testing.T) {
+	auction := tongo.MustParseAddress("0:9c3c2ab7ef8efc6a8bd1bbd11bd5ad16d40f2fd0a4dc6e2e3e24be1ea52ae1cc").ID
+	nft := tongo.MustParseAddress("0:b1f2d7d3a1d2cd7f8b64ef3bb0cd42cbbd03d1ae2beb6f9f0f1bf4e2bd3a3a2d").ID
+	bidder := tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+
+	bid := convertNftBid(auctions.Bid{
+		Auction: auction,
+		Type:    bath.GetGemsAuction,
+		Nft:     &nft,
+		Bidder:  bidder,
+		Amount:  1_500_000_000,
+		TraceID: "076a457ace46c6bcea6ef0644d65a4b866d25a5fd52349f08a6ccfbf7cb99ddb",
+		Utime:   1682407879,
+	}, &mockAddressBook{
+		OnGetAddressInfoByAddress: func(a tongo.AccountID) (addressbook.KnownAddress, bool) {
+			return addressbook.KnownAddress{}, false
+		},
+	})
+	require.Equal(t, oas.NftBidAuctionTypeGetgems, bid.AuctionType)
+	require.Equal(t, auction.ToRaw(), bid.Auction.Address)
+	require.Equal(t, bidder.ToRaw(), bid.Bidder.Address)
+	require.Equal(t, oas.Price{Value: "1500000000", TokenName: "TON"}, bid.Amount)
+	require.Equal(t, oas.NewOptString(nft.ToRaw()), bid.Nft)
+	require.False(t, bid.Collection.IsSet())
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/tonkeeper/tongo"
 )
 
-func (h *Handler) GetNftItemsByAddresses(ctx context.Context, request oas.OptGetNftItemsByAddressesReq) (*oas.NftItems, error) {
+func (h *Handler) GetNftItemsByAddresses(ctx context.Context, request oas.OptGetNftItemsByAddressesReq, params oas.GetNftItemsByAddressesParams) (*oas.NftItems, error) {
 	if len(request.Value.AccountIds) == 0 {
 		return nil, toError(http.StatusBadRequest, fmt.Errorf("empty list of ids"))
 	}
@@ -29,14 +29,32 @@ func (h *Handler) GetNftItemsByAddresses(ctx context.Context, request oas.OptGet
 		}
 		accounts[i] = account.ID
 	}
-	items, err := h.storage.GetNFTs(ctx, accounts)
-	if errors.Is(err, core.ErrEntityNotFound) {
-		return nil, toError(http.StatusNotFound, err)
-	}
-	if err != nil {
-		return nil, toError(http.StatusInternalServerError, err)
-	}
 	var result oas.NftItems
+	var items []core.NftItem
+	if params.Partial.Value == oas.GetNftItemsByAddressesPartialAllow {
+		fetched := make([][]core.NftItem, len(accounts))
+		errs := fetchSections(ctx, len(accounts), h.limits.sectionTimeout(), func(ctx context.Context, i int) error {
+			var err error
+			fetched[i], err = h.storage.GetNFTs(ctx, []tongo.AccountID{accounts[i]})
+			return err
+		})
+		for i, err := range errs {
+			if err != nil {
+				result.Errors = append(result.Errors, toPartialError(accounts[i].ToRaw(), err))
+				continue
+			}
+			items = append(items, fetched[i]...)
+		}
+		result.Partial.SetTo(len(result.Errors) > 0)
+	} else {
+		items, err = h.storage.GetNFTs(ctx, accounts)
+		if errors.Is(err, core.ErrEntityNotFound) {
+			return nil, toError(http.StatusNotFound, err)
+		}
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+	}
 	for _, i := range items {
 		result.NftItems = append(result.NftItems, h.convertNFT(ctx, i, h.addressBook, h.metaCache))
 	}
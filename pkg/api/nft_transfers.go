@@ -0,0 +1,53 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tonkeeper/tongo"
+
+	pusherErrors "github.com/tonkeeper/opentonapi/pkg/pusher/errors"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/events"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sse"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+)
+
+// WithNftTransferSource exposes ownership changes of NFT items at /v2/sse/accounts/nft_transfers.
+func WithNftTransferSource(src sources.NftTransferSource) ServerOption {
+	return func(options *ServerOptions) {
+		options.nftTransferSource = src
+	}
+}
+
+// subscribeToNftTransfers streams transfers of NFT items of the "accounts" query parameter:
+// owners sending or receiving items, the items themselves or their collections.
+func subscribeToNftTransfers(sseHandler *sse.Handler, source sources.NftTransferSource) sse.HandlerFunc {
+	return func(session sse.Session, request *http.Request) error {
+		var opts sources.SubscribeToNftTransfersOptions
+		accounts := request.URL.Query().Get("accounts")
+		if strings.ToUpper(accounts) == "ALL" {
+			opts.AllAccounts = true
+		} else {
+			for _, str := range strings.Split(accounts, ",") {
+				account, err := tongo.ParseAddress(str)
+				if err != nil {
+					return pusherErrors.BadRequest(fmt.Sprintf("failed to parse query parameters: %v", err))
+				}
+				opts.Accounts = append(opts.Accounts, account.ID)
+			}
+		}
+		if err := utils.LimitsFromContext(request.Context()).CheckAccounts(len(opts.Accounts)); err != nil {
+			return pusherErrors.SubscriptionLimitExceeded(err.Error())
+		}
+		var err error
+		opts.Accounts, opts.AllAccounts, err = utils.ScopeAccounts(request.Context(), opts.Accounts, opts.AllAccounts)
+		if err != nil {
+			return pusherErrors.Forbidden(err.Error())
+		}
+		cancelFn := source.SubscribeToNftTransfers(request.Context(), sseHandler.Deliver(session, events.NftTransferEvent), opts)
+		session.SetCancelFn(cancelFn)
+		return nil
+	}
+}
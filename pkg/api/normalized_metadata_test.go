@@ -39,6 +39,12 @@ func TestScale(t *testing.T) {
 			decimals: 3,
 			want:     "0.1",
 		},
+		{
+			name:     "usdt",
+			amount:   big.NewInt(1_234_500_000),
+			decimals: 6,
+			want:     "1234.5",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
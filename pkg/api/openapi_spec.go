@@ -0,0 +1,184 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/tonkeeper/opentonapi"
+)
+
+// OpenAPIOptions configures serving of the OpenAPI specification of the instance.
+type OpenAPIOptions struct {
+	// PublicURL replaces the list of servers in the specification.
+	// If empty, the server is derived from the Host and X-Forwarded-Proto headers of a request.
+	PublicURL string
+	// Docs enables an interactive documentation page at /v2/docs.
+	Docs bool
+}
+
+// WithOpenAPI configures the specification served at /v2/openapi.json.
+func WithOpenAPI(opts OpenAPIOptions) ServerOption {
+	return func(options *ServerOptions) {
+		options.openAPI = opts
+	}
+}
+
+// DisabledOperations returns IDs of operations responding with an error on this instance
+// because the features they depend on aren't configured.
+func (h *Handler) DisabledOperations() []string {
+	var operations []string
+	if h.msgSender == nil {
+		operations = append(operations, "sendBlockchainMessage")
+	}
+	if h.privateLabels == nil {
+		operations = append(operations, "getPrivateLabels", "getPrivateLabel", "setPrivateLabel", "deletePrivateLabel")
+	}
+	if h.deposits == nil {
+		operations = append(operations, "getExpectedDeposits", "addExpectedDeposit", "deleteExpectedDeposit")
+	}
+	if h.auctionBids == nil {
+		operations = append(operations, "getNftItemBids")
+	}
+	if h.gasless == nil {
+		operations = append(operations, "gaslessConfig", "gaslessEstimate", "gaslessSend")
+	}
+	if h.simulator == nil {
+		operations = append(operations, "simulateAccountTransaction")
+	}
+	if h.webhooks == nil {
+		operations = append(operations, "getWebhooks", "addWebhook", "deleteWebhook", "rotateWebhookSecret")
+	}
+	return operations
+}
+
+// filterOperations removes the given operations from the specification,
+// a path left without operations is removed as well.
+func filterOperations(spec []byte, disabled []string) (map[string]any, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+	paths, ok := doc["paths"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("specification has no paths")
+	}
+	skip := make(map[string]struct{}, len(disabled))
+	for _, operationID := range disabled {
+		skip[operationID] = struct{}{}
+	}
+	for path, item := range paths {
+		methods, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		operations := 0
+		for method, value := range methods {
+			operation, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			operationID, ok := operation["operationId"].(string)
+			if !ok {
+				continue
+			}
+			if _, ok := skip[operationID]; ok {
+				delete(methods, method)
+				continue
+			}
+			operations++
+		}
+		if operations == 0 {
+			delete(paths, path)
+		}
+	}
+	return doc, nil
+}
+
+// filterParameters removes the given parameters from operations of the specification.
+func filterParameters(doc map[string]any, disabled []operationParameter) {
+	if len(disabled) == 0 {
+		return
+	}
+	paths, _ := doc["paths"].(map[string]any)
+	for _, item := range paths {
+		methods, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, value := range methods {
+			operation, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			parameters, ok := operation["parameters"].([]any)
+			if !ok {
+				continue
+			}
+			kept := parameters[:0]
+			for _, p := range parameters {
+				parameter, _ := p.(map[string]any)
+				if !slices.Contains(disabled, operationParameter{OperationID: fmt.Sprint(operation["operationId"]), Name: fmt.Sprint(parameter["name"])}) {
+					kept = append(kept, p)
+				}
+			}
+			operation["parameters"] = kept
+		}
+	}
+}
+
+// requestServerURL returns a URL of the instance as it is seen by a client.
+func requestServerURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme, _, _ = strings.Cut(proto, ",")
+	}
+	return scheme + "://" + r.Host
+}
+
+// openAPIHandler serves the specification of the operations enabled on the instance.
+func openAPIHandler(disabled []string, disabledParameters []operationParameter, opts OpenAPIOptions) (http.Handler, error) {
+	doc, err := filterOperations(opentonapi.OpenAPISpec, disabled)
+	if err != nil {
+		return nil, err
+	}
+	filterParameters(doc, disabledParameters)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverURL := opts.PublicURL
+		if serverURL == "" {
+			serverURL = requestServerURL(r)
+		}
+		// doc is shared by concurrent requests, so servers are replaced in a shallow copy.
+		response := make(map[string]any, len(doc))
+		for k, v := range doc {
+			response[k] = v
+		}
+		response["servers"] = []map[string]string{{"url": serverURL}}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}), nil
+}
+
+const docsPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>opentonapi</title>
+  <meta charset="utf-8"/>
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+</head>
+<body>
+  <redoc spec-url="/v2/openapi.json"></redoc>
+  <script src="https://cdn.redoc.ly/redoc/v2.1.5/bundles/redoc.standalone.js"></script>
+</body>
+</html>
+`
+
+func docsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(docsPage))
+}
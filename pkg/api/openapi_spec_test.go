@@ -0,0 +1,88 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_openAPIHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		disabled    []string
+		parameters  []operationParameter
+		opts        OpenAPIOptions
+		headers     map[string]string
+		wantServer  string
+		wantPaths   []string
+		wantMissing []string
+		wantNoParam string
+	}{
+		{
+			name:       "all enabled",
+			headers:    map[string]string{"X-Forwarded-Proto": "https"},
+			wantServer: "https://example.com",
+			wantPaths:  []string{"/v2/labels", "/v2/gasless/config"},
+		},
+		{
+			name:        "disabled operations are removed",
+			disabled:    (&Handler{}).DisabledOperations(),
+			opts:        OpenAPIOptions{PublicURL: "https://api.example.org"},
+			wantServer:  "https://api.example.org",
+			wantPaths:   []string{"/v2/accounts/{account_id}"},
+			wantMissing: []string{"/v2/labels", "/v2/labels/{account_id}", "/v2/gasless/config", "/v2/blockchain/message", "/v2/webhooks"},
+		},
+		{
+			name:        "disabled parameters are removed",
+			parameters:  groupParameters([]EndpointGroup{EndpointGroupEmulation}),
+			wantServer:  "http://example.com",
+			wantPaths:   []string{"/v2/traces/{trace_id}"},
+			wantNoParam: "emulate_pending",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, err := openAPIHandler(tt.disabled, tt.parameters, tt.opts)
+			require.Nil(t, err)
+
+			request := httptest.NewRequest("GET", "http://example.com/v2/openapi.json", nil)
+			for k, v := range tt.headers {
+				request.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, request)
+			require.Equal(t, 200, rec.Code)
+			require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var doc struct {
+				Servers []struct {
+					URL string `json:"url"`
+				} `json:"servers"`
+				Paths map[string]map[string]struct {
+					Parameters []struct {
+						Name string `json:"name"`
+					} `json:"parameters"`
+				} `json:"paths"`
+			}
+			require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+			require.Len(t, doc.Servers, 1)
+			require.Equal(t, tt.wantServer, doc.Servers[0].URL)
+			for _, path := range tt.wantPaths {
+				require.Contains(t, doc.Paths, path)
+			}
+			for _, path := range tt.wantMissing {
+				require.NotContains(t, doc.Paths, path)
+			}
+			var params []string
+			for _, p := range doc.Paths["/v2/traces/{trace_id}"]["get"].Parameters {
+				params = append(params, p.Name)
+			}
+			require.Contains(t, params, "format")
+			if tt.wantNoParam != "" {
+				require.NotContains(t, params, tt.wantNoParam)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sourcegraph/conc/iter"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// fetchSections calls fetch for every section of an aggregate response concurrently
+// and returns an error of every section, nil for successfully fetched ones.
+// Each call is bounded by the timeout and by the deadline of the request itself,
+// so a slow section fails on its own instead of failing the whole request.
+func fetchSections(ctx context.Context, count int, timeout time.Duration, fetch func(ctx context.Context, i int) error) []error {
+	errs := make([]error, count)
+	iter.ForEachIdx(errs, func(i int, err *error) {
+		sectionCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		*err = fetch(sectionCtx, i)
+		if *err != nil && errors.Is(sectionCtx.Err(), context.DeadlineExceeded) {
+			// upstream errors are often wrapped by retries, so we report a timeout explicitly.
+			*err = errcode.Wrap(errcode.LiteServerTimeout, fmt.Errorf("request timeout: %w", sectionCtx.Err()))
+		}
+	})
+	return errs
+}
+
+// toPartialError describes a failed section of a partial response.
+func toPartialError(section string, err error) oas.PartialError {
+	return oas.PartialError{
+		Section:   section,
+		Error:     err.Error(),
+		ErrorCode: string(errcode.Of(http.StatusInternalServerError, err)),
+	}
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+)
+
+func Test_fetchSections(t *testing.T) {
+	fetched := make([]int, 3)
+	errs := fetchSections(context.Background(), 3, 50*time.Millisecond, func(ctx context.Context, i int) error {
+		switch i {
+		case 1:
+			return fmt.Errorf("lite server is down")
+		case 2:
+			// a slow section waits for the deadline like a lite client does.
+			<-ctx.Done()
+			return fmt.Errorf("All attempts fail:\n#1: %v", ctx.Err())
+		}
+		fetched[i] = i + 100
+		return nil
+	})
+	require.Equal(t, []int{100, 0, 0}, fetched)
+	require.Nil(t, errs[0])
+	require.EqualError(t, errs[1], "lite server is down")
+	require.Equal(t, errcode.LiteServerTimeout, errcode.Of(500, errs[2]))
+
+	partialErr := toPartialError("0:abc", errs[2])
+	require.Equal(t, "0:abc", partialErr.Section)
+	require.Equal(t, "request timeout: context deadline exceeded", partialErr.Error)
+	require.Equal(t, string(errcode.LiteServerTimeout), partialErr.ErrorCode)
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/labels"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// WithAdminTokens grants the admin scope to the given bearer tokens.
+// Tokens with the admin scope manage private labels and get them merged into account responses.
+func WithAdminTokens(tokens []string) ServerOption {
+	return func(options *ServerOptions) {
+		options.adminTokens = tokens
+		options.ogenMiddlewares = append(options.ogenMiddlewares, adminScopeMiddleware(tokens))
+	}
+}
+
+type adminScopeKey struct{}
+
+func adminScopeMiddleware(tokens []string) middleware.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		if tokenGranted(tokens, bearerToken(req.Raw)) {
+			req.Context = context.WithValue(req.Context, adminScopeKey{}, true)
+		}
+		return next(req)
+	}
+}
+
+func hasAdminScope(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminScopeKey{}).(bool)
+	return admin
+}
+
+// checkPrivateLabelsAccess returns an error if the request can't manage private labels.
+func (h *Handler) checkPrivateLabelsAccess(ctx context.Context) error {
+	if h.privateLabels == nil {
+		return toError(http.StatusNotImplemented, fmt.Errorf("private labels are not configured"))
+	}
+	if !hasAdminScope(ctx) {
+		return toError(http.StatusForbidden, fmt.Errorf("token with admin scope is required"))
+	}
+	return nil
+}
+
+// privateLabel returns a private label of the account if the request has the admin scope.
+func (h *Handler) privateLabel(ctx context.Context, account tongo.AccountID) oas.OptPrivateLabel {
+	if h.privateLabels == nil || !hasAdminScope(ctx) {
+		return oas.OptPrivateLabel{}
+	}
+	label, ok := h.privateLabels.Get(account)
+	if !ok {
+		return oas.OptPrivateLabel{}
+	}
+	return oas.NewOptPrivateLabel(convertPrivateLabel(label))
+}
+
+func convertPrivateLabel(label labels.Label) oas.PrivateLabel {
+	result := oas.PrivateLabel{
+		Account:   label.Account.ToRaw(),
+		Name:      label.Name,
+		UpdatedAt: label.UpdatedAt,
+	}
+	if label.Memo != "" {
+		result.Memo = oas.NewOptString(label.Memo)
+	}
+	return result
+}
+
+func (h *Handler) GetPrivateLabels(ctx context.Context) (*oas.PrivateLabels, error) {
+	if err := h.checkPrivateLabelsAccess(ctx); err != nil {
+		return nil, err
+	}
+	list := h.privateLabels.List()
+	result := oas.PrivateLabels{Labels: make([]oas.PrivateLabel, 0, len(list))}
+	for _, label := range list {
+		result.Labels = append(result.Labels, convertPrivateLabel(label))
+	}
+	return &result, nil
+}
+
+func (h *Handler) GetPrivateLabel(ctx context.Context, params oas.GetPrivateLabelParams) (*oas.PrivateLabel, error) {
+	if err := h.checkPrivateLabelsAccess(ctx); err != nil {
+		return nil, err
+	}
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	label, ok := h.privateLabels.Get(account.ID)
+	if !ok {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("private label not found"))
+	}
+	result := convertPrivateLabel(label)
+	return &result, nil
+}
+
+func (h *Handler) SetPrivateLabel(ctx context.Context, request *oas.SetPrivateLabelReq, params oas.SetPrivateLabelParams) (*oas.PrivateLabel, error) {
+	if err := h.checkPrivateLabelsAccess(ctx); err != nil {
+		return nil, err
+	}
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	label, err := h.privateLabels.Set(account.ID, request.Name, request.Memo.Value)
+	if errors.Is(err, labels.ErrInvalidLabel) {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := convertPrivateLabel(label)
+	return &result, nil
+}
+
+func (h *Handler) DeletePrivateLabel(ctx context.Context, params oas.DeletePrivateLabelParams) error {
+	if err := h.checkPrivateLabelsAccess(ctx); err != nil {
+		return err
+	}
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return toError(http.StatusBadRequest, err)
+	}
+	deleted, err := h.privateLabels.Delete(account.ID)
+	if err != nil {
+		return toError(http.StatusInternalServerError, err)
+	}
+	if !deleted {
+		return toError(http.StatusNotFound, fmt.Errorf("private label not found"))
+	}
+	return nil
+}
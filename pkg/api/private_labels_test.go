@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/labels"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_adminScopeMiddleware(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{name: "no token"},
+		{name: "wrong token", token: "guess"},
+		{name: "admin token", token: "secret", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/v2/labels", nil)
+			if tt.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			var admin bool
+			_, err := adminScopeMiddleware([]string{"secret"})(middleware.Request{Raw: r, Context: r.Context()}, func(req middleware.Request) (middleware.Response, error) {
+				admin = hasAdminScope(req.Context)
+				return middleware.Response{}, nil
+			})
+			require.Nil(t, err)
+			require.Equal(t, tt.want, admin)
+		})
+	}
+}
+
+func TestHandler_PrivateLabels(t *testing.T) {
+	account := tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+	store, err := labels.NewStore("")
+	require.Nil(t, err)
+	h := &Handler{privateLabels: store}
+	admin := context.WithValue(context.Background(), adminScopeKey{}, true)
+
+	requireStatus := func(t *testing.T, err error, status int) {
+		var statusErr *oas.ErrorStatusCode
+		require.True(t, errors.As(err, &statusErr))
+		require.Equal(t, status, statusErr.StatusCode)
+	}
+
+	_, err = h.GetPrivateLabels(context.Background())
+	requireStatus(t, err, http.StatusForbidden)
+	_, err = (&Handler{}).GetPrivateLabels(admin)
+	requireStatus(t, err, http.StatusNotImplemented)
+
+	params := oas.SetPrivateLabelParams{AccountID: account.ToRaw()}
+	_, err = h.SetPrivateLabel(admin, &oas.SetPrivateLabelReq{}, params)
+	requireStatus(t, err, http.StatusBadRequest)
+	label, err := h.SetPrivateLabel(admin, &oas.SetPrivateLabelReq{Name: "Exchange", Memo: oas.NewOptString("INV-1234")}, params)
+	require.Nil(t, err)
+	require.Equal(t, account.ToRaw(), label.Account)
+
+	list, err := h.GetPrivateLabels(admin)
+	require.Nil(t, err)
+	require.Len(t, list.Labels, 1)
+
+	// labels are merged into responses for the admin scope only.
+	require.False(t, h.privateLabel(context.Background(), account).IsSet())
+	merged := h.privateLabel(admin, account)
+	require.True(t, merged.IsSet())
+	require.Equal(t, "INV-1234", merged.Value.Memo.Value)
+
+	require.Nil(t, h.DeletePrivateLabel(admin, oas.DeletePrivateLabelParams{AccountID: account.ToRaw()}))
+	_, err = h.GetPrivateLabel(admin, oas.GetPrivateLabelParams{AccountID: account.ToRaw()})
+	requireStatus(t, err, http.StatusNotFound)
+	err = h.DeletePrivateLabel(admin, oas.DeletePrivateLabelParams{AccountID: account.ToRaw()})
+	requireStatus(t, err, http.StatusNotFound)
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"github.com/ogen-go/ogen/middleware"
+
+	"github.com/tonkeeper/opentonapi/pkg/querybudget"
+)
+
+// queryBudgetClass is a class of endpoints sharing the same budget of lite server queries.
+type queryBudgetClass int
+
+const (
+	// queryBudgetClassTrace is for endpoints assembling a single trace.
+	queryBudgetClassTrace queryBudgetClass = iota + 1
+	// queryBudgetClassAccountEvents is for endpoints assembling a trace per event of an account's history.
+	queryBudgetClassAccountEvents
+)
+
+// queryBudgetClasses maps operation IDs from api/openapi.yml to their budget classes,
+// operations missing here are not limited.
+var queryBudgetClasses = map[string]queryBudgetClass{
+	"getTrace":         queryBudgetClassTrace,
+	"getEvent":         queryBudgetClassTrace,
+	"getAccountEvent":  queryBudgetClassTrace,
+	"getAccountEvents": queryBudgetClassAccountEvents,
+}
+
+// QueryBudgets limits how many lite server round trips a single request may trigger, per class of endpoints.
+// A request exceeding its budget gets a partial result marked with "partial" instead of an error.
+// A zero value means no limit for the class.
+type QueryBudgets struct {
+	// Trace limits requests of a single trace or event.
+	Trace int
+	// AccountEvents limits requests of an account's history, the budget is shared by all events of a page.
+	AccountEvents int
+}
+
+// WithQueryBudgets limits lite server queries of expensive endpoints according to the given budgets.
+func WithQueryBudgets(budgets QueryBudgets) ServerOption {
+	return func(options *ServerOptions) {
+		options.ogenMiddlewares = append(options.ogenMiddlewares, queryBudgetMiddleware(budgets))
+	}
+}
+
+// limit returns a budget of the class, 0 means no limit.
+func (b QueryBudgets) limit(class queryBudgetClass) int {
+	switch class {
+	case queryBudgetClassTrace:
+		return b.Trace
+	case queryBudgetClassAccountEvents:
+		return b.AccountEvents
+	}
+	return 0
+}
+
+func queryBudgetMiddleware(budgets QueryBudgets) middleware.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		limit := budgets.limit(queryBudgetClasses[req.OperationID])
+		if limit <= 0 {
+			return next(req)
+		}
+		req.Context, _ = querybudget.NewContext(req.Context, limit)
+		return next(req)
+	}
+}
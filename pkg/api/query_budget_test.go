@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi/pkg/querybudget"
+)
+
+func Test_queryBudgetMiddleware(t *testing.T) {
+	budgets := QueryBudgets{Trace: 2, AccountEvents: 10}
+	tests := []struct {
+		name        string
+		operationID string
+		wantLimited bool
+		wantQueries int
+	}{
+		{name: "trace", operationID: "getTrace", wantLimited: true, wantQueries: 2},
+		{name: "event", operationID: "getEvent", wantLimited: true, wantQueries: 2},
+		{name: "account events", operationID: "getAccountEvents", wantLimited: true, wantQueries: 10},
+		{name: "other endpoints are not limited", operationID: "getAccount"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries int
+			next := func(req middleware.Request) (middleware.Response, error) {
+				_, ok := querybudget.FromContext(req.Context)
+				require.Equal(t, tt.wantLimited, ok)
+				for i := 0; i < 20; i++ {
+					if querybudget.Spend(req.Context) == nil {
+						queries++
+					}
+				}
+				return middleware.Response{}, nil
+			}
+			mw := queryBudgetMiddleware(budgets)
+			_, err := mw(middleware.Request{Context: context.Background(), OperationID: tt.operationID}, next)
+			require.Nil(t, err)
+			if tt.wantLimited {
+				require.Equal(t, tt.wantQueries, queries)
+			} else {
+				require.Equal(t, 20, queries)
+			}
+		})
+	}
+}
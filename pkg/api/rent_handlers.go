@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// storagePriceShift is a number of fractional bits of storage prices,
+// they are set in 2^-16 nanotons per bit or cell per second.
+const storagePriceShift = 16
+
+// dueLimits are thresholds of a storage fee debt, the account is frozen or deleted once its debt exceeds them.
+type dueLimits struct {
+	Freeze uint64
+	Delete uint64
+}
+
+func (h *Handler) GetAccountStorageRent(ctx context.Context, params oas.GetAccountStorageRentParams) (*oas.AccountStorageRent, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	state, err := h.storage.GetAccountState(ctx, account.ID)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	status := state.Account.Status()
+	if status == tlb.AccountNone {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("account doesn't exist"))
+	}
+	config, err := h.storage.GetLastConfig(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	now := uint32(time.Now().Unix())
+	prices, ok := currentStoragePrices(config, now)
+	if !ok {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("storage prices are not found in the blockchain config"))
+	}
+	var gasPrices *tlb.GasLimitsPrices
+	if account.ID.Workchain == -1 && config.ConfigParam20 != nil {
+		gasPrices = &config.ConfigParam20.GasLimitsPrices
+	} else if account.ID.Workchain != -1 && config.ConfigParam21 != nil {
+		gasPrices = &config.ConfigParam21.GasLimitsPrices
+	}
+	if gasPrices == nil {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("gas prices are not found in the blockchain config"))
+	}
+	limits, ok := storageDueLimits(*gasPrices)
+	if !ok {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("unsupported gas prices format"))
+	}
+	rent := projectStorageRent(state.Account.Account, status, prices, limits, account.ID.Workchain == -1, now)
+	return &rent, nil
+}
+
+// currentStoragePrices returns storage prices in effect at the given moment.
+func currentStoragePrices(config ton.BlockchainConfig, now uint32) (tlb.StoragePrices, bool) {
+	if config.ConfigParam18 == nil {
+		return tlb.StoragePrices{}, false
+	}
+	var current tlb.StoragePrices
+	found := false
+	for _, prices := range config.ConfigParam18.Value.Values() {
+		if prices.UtimeSince <= now && (!found || prices.UtimeSince > current.UtimeSince) {
+			current, found = prices, true
+		}
+	}
+	return current, found
+}
+
+func storageDueLimits(prices tlb.GasLimitsPrices) (dueLimits, bool) {
+	switch prices.SumType {
+	case "GasPrices":
+		return dueLimits{Freeze: prices.GasPrices.FreezeDueLimit, Delete: prices.GasPrices.DeleteDueLimit}, true
+	case "GasPricesExt":
+		return dueLimits{Freeze: prices.GasPricesExt.FreezeDueLimit, Delete: prices.GasPricesExt.DeleteDueLimit}, true
+	case "GasFlatPfx":
+		if prices.GasFlatPfx.Other != nil {
+			return storageDueLimits(*prices.GasFlatPfx.Other)
+		}
+	}
+	return dueLimits{}, false
+}
+
+// projectStorageRent calculates storage fees of the account the same way the storage phase does
+// and projects when its debt exceeds the freeze and delete limits if the balance isn't topped up.
+func projectStorageRent(account tlb.ExistedAccount, status tlb.AccountStatus, prices tlb.StoragePrices, limits dueLimits, masterchain bool, now uint32) oas.AccountStorageRent {
+	cells := big.Int(account.StorageStat.Used.Cells)
+	bits := big.Int(account.StorageStat.Used.Bits)
+	cellPrice, bitPrice := prices.CellPricePs, prices.BitPricePs
+	if masterchain {
+		cellPrice, bitPrice = prices.McCellPricePs, prices.McBitPricePs
+	}
+	// rate is a storage fee per second in 2^-16 nanotons.
+	rate := new(big.Int).Mul(&cells, new(big.Int).SetUint64(cellPrice))
+	rate.Add(rate, new(big.Int).Mul(&bits, new(big.Int).SetUint64(bitPrice)))
+
+	var due uint64
+	if account.StorageStat.DuePayment.Exists {
+		due = uint64(account.StorageStat.DuePayment.Value)
+	}
+	balance := int64(account.Storage.Balance.Grams)
+	lastPaid := account.StorageStat.LastPaid
+	rent := oas.AccountStorageRent{
+		Status:     oas.AccountStatus(status),
+		Balance:    balance,
+		UsedCells:  cells.Int64(),
+		UsedBits:   bits.Int64(),
+		LastPaid:   int64(lastPaid),
+		DuePayment: int64(due),
+		RentPerDay: storageFee(rate, 24*60*60),
+	}
+	// special accounts don't pay storage fees, their last_paid is never set.
+	if lastPaid == 0 || rate.Sign() == 0 {
+		return rent
+	}
+	if now > lastPaid {
+		rent.AccruedFee = storageFee(rate, uint64(now-lastPaid))
+	}
+	if status == tlb.AccountActive {
+		rent.FrozenAt.SetTo(debtExceedsAt(rate, lastPaid, balance, due, limits.Freeze, now))
+	}
+	rent.DeletedAt.SetTo(debtExceedsAt(rate, lastPaid, balance, due, limits.Delete, now))
+	return rent
+}
+
+// storageFee returns storage fees in nanotons for the given period rounded up like the storage phase does.
+func storageFee(rate *big.Int, seconds uint64) int64 {
+	fee := new(big.Int).Mul(rate, new(big.Int).SetUint64(seconds))
+	fee.Add(fee, big.NewInt(1<<storagePriceShift-1))
+	return fee.Rsh(fee, storagePriceShift).Int64()
+}
+
+// debtExceedsAt returns a moment when the storage fee debt exceeds the limit,
+// the balance is spent on storage fees first.
+func debtExceedsAt(rate *big.Int, lastPaid uint32, balance int64, due uint64, limit uint64, now uint32) int64 {
+	allowance := new(big.Int).SetInt64(balance)
+	allowance.Add(allowance, new(big.Int).SetUint64(limit))
+	allowance.Sub(allowance, new(big.Int).SetUint64(due))
+	if allowance.Sign() <= 0 {
+		return int64(now)
+	}
+	seconds := allowance.Lsh(allowance, storagePriceShift)
+	seconds.Div(seconds, rate)
+	at := seconds.Add(seconds, big.NewInt(int64(lastPaid)))
+	if !at.IsInt64() {
+		return math.MaxInt64
+	}
+	if at.Int64() < int64(now) {
+		return int64(now)
+	}
+	return at.Int64()
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_projectStorageRent(t *testing.T) {
+	account := func(balance, due uint64, lastPaid uint32) tlb.ExistedAccount {
+		var a tlb.ExistedAccount
+		a.StorageStat.Used.Cells = tlb.VarUInteger7(*big.NewInt(10))
+		a.StorageStat.Used.Bits = tlb.VarUInteger7(*big.NewInt(1000))
+		a.StorageStat.LastPaid = lastPaid
+		if due > 0 {
+			a.StorageStat.DuePayment = tlb.Maybe[tlb.Grams]{Exists: true, Value: tlb.Grams(due)}
+		}
+		a.Storage.Balance.Grams = tlb.Grams(balance)
+		return a
+	}
+	prices := tlb.StoragePrices{BitPricePs: 1, CellPricePs: 500, McBitPricePs: 1000, McCellPricePs: 500000}
+	limits := dueLimits{Freeze: 100_000_000, Delete: 1_000_000_000}
+	tests := []struct {
+		name        string
+		account     tlb.ExistedAccount
+		status      tlb.AccountStatus
+		masterchain bool
+		want        oas.AccountStorageRent
+	}{
+		{
+			name:    "active account",
+			account: account(1_000_000_000, 0, 1_700_000_000),
+			status:  tlb.AccountActive,
+			want: oas.AccountStorageRent{
+				Status:     oas.AccountStatusActive,
+				Balance:    1_000_000_000,
+				UsedCells:  10,
+				UsedBits:   1000,
+				LastPaid:   1_700_000_000,
+				AccruedFee: 92,
+				RentPerDay: 7911,
+				FrozenAt:   oas.NewOptInt64(13_714_933_333),
+				DeletedAt:  oas.NewOptInt64(23_545_333_333),
+			},
+		},
+		{
+			name:        "masterchain account",
+			account:     account(1_000_000_000, 0, 1_700_000_000),
+			status:      tlb.AccountActive,
+			masterchain: true,
+			want: oas.AccountStorageRent{
+				Status:     oas.AccountStatusActive,
+				Balance:    1_000_000_000,
+				UsedCells:  10,
+				UsedBits:   1000,
+				LastPaid:   1_700_000_000,
+				AccruedFee: 91_553,
+				RentPerDay: 7_910_157,
+				FrozenAt:   oas.NewOptInt64(1_712_014_933),
+				DeletedAt:  oas.NewOptInt64(1_721_845_333),
+			},
+		},
+		{
+			name:    "debt over the freeze limit",
+			account: account(0, 200_000_000, 1_700_000_000),
+			status:  tlb.AccountActive,
+			want: oas.AccountStorageRent{
+				Status:     oas.AccountStatusActive,
+				UsedCells:  10,
+				UsedBits:   1000,
+				LastPaid:   1_700_000_000,
+				DuePayment: 200_000_000,
+				AccruedFee: 92,
+				RentPerDay: 7911,
+				FrozenAt:   oas.NewOptInt64(1_700_001_000),
+				DeletedAt:  oas.NewOptInt64(10_438_133_333),
+			},
+		},
+		{
+			name:    "frozen account",
+			account: account(0, 200_000_000, 1_700_000_000),
+			status:  tlb.AccountFrozen,
+			want: oas.AccountStorageRent{
+				Status:     oas.AccountStatusFrozen,
+				UsedCells:  10,
+				UsedBits:   1000,
+				LastPaid:   1_700_000_000,
+				DuePayment: 200_000_000,
+				AccruedFee: 92,
+				RentPerDay: 7911,
+				DeletedAt:  oas.NewOptInt64(10_438_133_333),
+			},
+		},
+		{
+			name:    "special account",
+			account: account(1_000_000_000, 0, 0),
+			status:  tlb.AccountActive,
+			want: oas.AccountStorageRent{
+				Status:     oas.AccountStatusActive,
+				Balance:    1_000_000_000,
+				UsedCells:  10,
+				UsedBits:   1000,
+				RentPerDay: 7911,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := projectStorageRent(tt.account, tt.status, prices, limits, tt.masterchain, 1_700_001_000)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/bath"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// annotateSandwiches flags swaps of the trace surrounded by swaps of another actor on the same pool in the same block.
+// The detection is best-effort: a swap is left as is if its block can't be loaded.
+func (h *Handler) annotateSandwiches(ctx context.Context, trace *core.Trace, result *bath.ActionsList) {
+	var legs map[tongo.Bits256]bath.SwapLeg
+	for _, action := range result.Actions {
+		if action.JettonSwap == nil {
+			continue
+		}
+		if legs == nil {
+			legs = bath.TraceSwapLegs(trace)
+		}
+		for _, hash := range action.BaseTransactions {
+			leg, ok := legs[hash]
+			// emulated transactions don't belong to any block.
+			if !ok || leg.Block.Seqno == 0 {
+				continue
+			}
+			blockLegs, err := h.swapLegsOfBlock(ctx, leg.Block)
+			if err != nil {
+				h.logger.Debug("failed to load swaps of block", zap.String("block", leg.Block.String()), zap.Error(err))
+				continue
+			}
+			if sandwich := bath.FindSandwich(leg, blockLegs); sandwich != nil {
+				action.JettonSwap.Sandwich = sandwich
+				break
+			}
+		}
+	}
+}
+
+func (h *Handler) swapLegsOfBlock(ctx context.Context, id tongo.BlockID) ([]bath.SwapLeg, error) {
+	if legs, ok := h.blockSwapLegs.Get(id); ok {
+		return legs, nil
+	}
+	txs, err := h.storage.GetBlockTransactions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var legs []bath.SwapLeg
+	for _, tx := range txs {
+		if leg, ok := bath.ExtractSwapLeg(tx); ok {
+			legs = append(legs, leg)
+		}
+	}
+	h.blockSwapLegs.Set(id, legs)
+	return legs, nil
+}
+
+func convertSandwich(sandwich *bath.Sandwich, book addressBook) oas.OptSwapSandwich {
+	if sandwich == nil {
+		return oas.OptSwapSandwich{}
+	}
+	return oas.NewOptSwapSandwich(oas.SwapSandwich{
+		Attacker:            convertAccountAddress(sandwich.Attacker, book),
+		FrontRunTransaction: sandwich.FrontRun.Hex(),
+		BackRunTransaction:  sandwich.BackRun.Hex(),
+	})
+}
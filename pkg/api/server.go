@@ -1,20 +1,28 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
 	"net"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/tonkeeper/opentonapi"
 	"github.com/tonkeeper/tongo/config"
 	"go.uber.org/zap"
 
 	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/longpoll"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/sse"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/websocket"
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+	"github.com/tonkeeper/opentonapi/pkg/usage"
 )
 
 // Server opens a port and exposes REST-ish API.
@@ -31,6 +39,7 @@ type Server struct {
 	httpServer       *http.Server
 	mux              *http.ServeMux
 	asyncMiddlewares []AsyncMiddleware
+	sseHandler       *sse.Handler
 }
 
 // For authentication purposes we need to distinguish between regular and long-lived connections.
@@ -50,7 +59,25 @@ type ServerOptions struct {
 	blockSource        sources.BlockSource
 	traceSource        sources.TraceSource
 	memPool            sources.MemPoolSource
+	configSource       sources.ConfigChangesSource
+	depositSource      depositSource
+	bidSource          bidSource
+	nftTransferSource  sources.NftTransferSource
+	usageMeter         *usage.Meter
 	liteServers        []config.LiteServer
+	readinessProbe     func() error
+	slowLog            *slowlog.Log
+	shardRouteHeaders  bool
+	cachePolicy        CachePolicy
+	streamingLimits    utils.Limits
+	sseResumer         *sse.Resumer
+	adminTokens        []string
+	tenants            *tenant.Registry
+	maintenance        *Maintenance
+	openAPI            OpenAPIOptions
+	disabledGroups     []EndpointGroup
+	// slowRequestThreshold is a duration after which a request is kept in slowLog.
+	slowRequestThreshold time.Duration
 }
 
 type ServerOption func(options *ServerOptions)
@@ -85,6 +112,12 @@ func WithTransactionSource(txSource sources.TransactionSource) ServerOption {
 	}
 }
 
+func WithConfigChangesSource(src sources.ConfigChangesSource) ServerOption {
+	return func(options *ServerOptions) {
+		options.configSource = src
+	}
+}
+
 func WithTraceSource(src sources.TraceSource) ServerOption {
 	return func(options *ServerOptions) {
 		options.traceSource = src
@@ -97,13 +130,40 @@ func WithMemPool(memPool sources.MemPoolSource) ServerOption {
 	}
 }
 
+// WithReadinessProbe exposes GET /readyz responding with 503 Service Unavailable while the probe returns an error.
+func WithReadinessProbe(probe func() error) ServerOption {
+	return func(options *ServerOptions) {
+		options.readinessProbe = probe
+	}
+}
+
 func NewServer(log *zap.Logger, handler *Handler, opts ...ServerOption) (*Server, error) {
 	options := &ServerOptions{}
 	for _, o := range opts {
 		o(options)
 	}
-	ogenMiddlewares := []oas.Middleware{ogenLoggingMiddleware(log), ogenMetricsMiddleware}
+	ogenMiddlewares := []oas.Middleware{ogenLoggingMiddleware(log), ogenMetricsMiddleware, ogenUpstreamTimingMiddleware}
+	if options.slowLog != nil {
+		ogenMiddlewares = append(ogenMiddlewares, ogenSlowLogMiddleware(log, options.slowLog, options.slowRequestThreshold))
+	}
+	disabledOperations, err := groupOperations(opentonapi.OpenAPISpec, options.disabledGroups)
+	if err != nil {
+		return nil, err
+	}
+	if len(disabledOperations) > 0 {
+		ogenMiddlewares = append(ogenMiddlewares, disabledOperationsMiddleware(disabledOperations))
+	}
+	disabledParameters := groupParameters(options.disabledGroups)
+	if len(disabledParameters) > 0 {
+		ogenMiddlewares = append(ogenMiddlewares, disabledParametersMiddleware(disabledParameters))
+	}
+	if options.tenants != nil {
+		ogenMiddlewares = append(ogenMiddlewares, tenantOgenMiddleware(options.tenants, options.adminTokens))
+	}
 	ogenMiddlewares = append(ogenMiddlewares, options.ogenMiddlewares...)
+	if options.usageMeter != nil {
+		ogenMiddlewares = append(ogenMiddlewares, ogenUsageMiddleware(options.usageMeter))
+	}
 
 	ogenServer, err := oas.NewServer(handler,
 		oas.WithMiddleware(ogenMiddlewares...),
@@ -113,40 +173,110 @@ func NewServer(log *zap.Logger, handler *Handler, opts ...ServerOption) (*Server
 	}
 	mux := http.NewServeMux()
 	asyncMiddlewares := []AsyncMiddleware{asyncLoggingMiddleware(log), asyncMetricsMiddleware}
+	if options.usageMeter != nil {
+		// the last middleware runs first, so the usage middleware goes before the tenant one.
+		asyncMiddlewares = append(asyncMiddlewares, usageAsyncMiddleware(options.usageMeter))
+	}
 	asyncMiddlewares = append(asyncMiddlewares, options.asyncMiddlewares...)
+	if options.streamingLimits != (utils.Limits{}) {
+		asyncMiddlewares = append(asyncMiddlewares, streamingLimitsMiddleware(options.streamingLimits))
+	}
+	if options.sseResumer != nil {
+		asyncMiddlewares = append(asyncMiddlewares, sseResumeMiddleware(options.sseResumer))
+	}
+	if options.maintenance != nil {
+		asyncMiddlewares = append(asyncMiddlewares, drainMiddleware(options.maintenance))
+	}
 
-	sseHandler := sse.NewHandler(options.blockSource, options.blockHeadersSource, options.txSource, options.traceSource, options.memPool)
+	sseHandler := sse.NewHandler(options.blockSource, options.blockHeadersSource, options.txSource, options.traceSource, options.memPool, options.configSource)
 	if options.blockSource != nil {
 		mux.Handle("/v2/sse/blockchain/full", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToBlocks), asyncMiddlewares...)))
 	}
 	if options.blockHeadersSource != nil {
 		mux.Handle("/v2/sse/blocks", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToBlockHeaders), asyncMiddlewares...)))
 	}
+	if options.configSource != nil {
+		mux.Handle("/v2/sse/blockchain/config", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToConfigChanges), asyncMiddlewares...)))
+		mux.Handle("/v2/sse/blockchain/config/proposals", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToConfigProposals), asyncMiddlewares...)))
+	}
 	if options.txSource != nil {
 		mux.Handle("/v2/sse/accounts/transactions", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToTransactions), asyncMiddlewares...)))
+		mux.Handle("/v2/sse/accounts/status", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToAccountStatuses), asyncMiddlewares...)))
+		mux.Handle("/v2/sse/accounts/logs", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToLogs), asyncMiddlewares...)))
+		if !options.groupDisabled(EndpointGroupJettons) {
+			mux.Handle("/v2/sse/jettons/status", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToJettonStatuses), asyncMiddlewares...)))
+			mux.Handle("/v2/sse/accounts/jetton_transfers", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToJettonTransfers), asyncMiddlewares...)))
+		}
+		poller := longpoll.NewPoller(context.Background(), options.txSource)
+		mux.Handle("/v2/poll/accounts/transactions", wrapAsync(LongLivedConnection, true, chainMiddlewares(poller.Handler, asyncMiddlewares...)))
 	}
 	if options.traceSource != nil {
 		mux.Handle("/v2/sse/accounts/traces", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToTraces), asyncMiddlewares...)))
 	}
+	if options.depositSource != nil {
+		mux.Handle("/v2/sse/deposits", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, subscribeToDeposits(sseHandler, options.depositSource)), asyncMiddlewares...)))
+	}
+	if options.bidSource != nil && !options.groupDisabled(EndpointGroupNFT) {
+		mux.Handle("/v2/sse/nfts/bids", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, subscribeToBids(sseHandler, options.bidSource)), asyncMiddlewares...)))
+	}
+	if options.nftTransferSource != nil && !options.groupDisabled(EndpointGroupNFT) {
+		mux.Handle("/v2/sse/accounts/nft_transfers", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, subscribeToNftTransfers(sseHandler, options.nftTransferSource)), asyncMiddlewares...)))
+	}
 	if options.memPool != nil {
 		mux.Handle("/v2/sse/mempool", wrapAsync(LongLivedConnection, true, chainMiddlewares(sse.Stream(log, sseHandler.SubscribeToMessages), asyncMiddlewares...)))
 	}
 
 	websocketHandler := websocket.Handler(log, options.txSource, options.traceSource, options.memPool, options.blockHeadersSource)
 	mux.Handle("/v2/websocket", wrapAsync(LongLivedConnection, true, chainMiddlewares(websocketHandler, asyncMiddlewares...)))
-	mux.Handle("/", ogenServer)
+	specHandler, err := openAPIHandler(append(handler.DisabledOperations(), disabledOperations...), disabledParameters, options.openAPI)
+	if err != nil {
+		return nil, err
+	}
+	mux.Handle("/v2/openapi.json", specHandler)
+	if options.openAPI.Docs {
+		mux.HandleFunc("/v2/docs", docsHandler)
+	}
+	if options.readinessProbe != nil {
+		mux.Handle("/readyz", readinessHandler(options.readinessProbe))
+	}
+	rootHandler := localTimestampsMiddleware(eventVersioningMiddleware(ogenServer))
+	if options.cachePolicy.enabled() {
+		rootHandler = cacheControlMiddleware(ogenServer, options.cachePolicy, options.adminTokens, rootHandler)
+	}
+	// it goes after the cache control middleware to prevent caching of degraded responses.
+	rootHandler = unresolvedMiddleware(rootHandler)
+	if options.shardRouteHeaders {
+		rootHandler = shardRouteHeadersMiddleware(rootHandler)
+	}
+	rootHandler = upstreamTimingMiddleware(options.adminTokens, rootHandler)
+	mux.Handle("/", rootHandler)
 
+	var serverHandler http.Handler = mux
+	if options.maintenance != nil {
+		serverHandler = maintenanceMiddleware(options.maintenance, mux)
+	}
 	serv := Server{
 		logger:           log,
 		mux:              mux,
 		asyncMiddlewares: asyncMiddlewares,
+		sseHandler:       sseHandler,
 		httpServer: &http.Server{
-			Handler: mux,
+			Handler: serverHandler,
 		},
 	}
 	return &serv, nil
 }
 
+func readinessHandler(probe func() error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := probe(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
 func wrapAsync(connectionType int, allowTokenInQuery bool, handler AsyncHandler) http.Handler {
 	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 		_ = handler(writer, request, connectionType, allowTokenInQuery)
@@ -164,6 +294,20 @@ func (s *Server) RegisterAsyncHandler(pattern string, handler AsyncHandler, conn
 	s.mux.Handle(pattern, wrapAsync(connectionType, allowTokenInQuery, chainMiddlewares(handler, s.asyncMiddlewares...)))
 }
 
+// SSEHandler returns the handler serving built-in SSE endpoints.
+// Custom streaming endpoints can use SSEHandler().Deliver to share the event ID sequence with the built-in ones.
+func (s *Server) SSEHandler() *sse.Handler {
+	return s.sseHandler
+}
+
+// RegisterSSEHandler exposes a custom SSE endpoint.
+// The endpoint gets the same behavior as the built-in ones:
+// it is a long-lived connection going through all async middlewares (auth, logging, metrics),
+// and events are queued per connection and dropped if a client can't keep up.
+func (s *Server) RegisterSSEHandler(pattern string, handler sse.HandlerFunc) {
+	s.RegisterAsyncHandler(pattern, sse.Stream(s.logger, handler), LongLivedConnection, true)
+}
+
 func (s *Server) Run(address string, unixSockets []string) {
 	go func() {
 		tcpListener, err := net.Listen("tcp", address)
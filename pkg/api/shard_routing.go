@@ -0,0 +1,49 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/tonkeeper/opentonapi/pkg/shardroute"
+)
+
+// ShardRouteHeader lists lite servers that have served account queries of a request along with their shards.
+const ShardRouteHeader = "X-Shard-Route"
+
+// WithShardRouteHeaders exposes routing decisions of account queries in the X-Shard-Route response header,
+// it is meant for debugging the shard routing of lite servers.
+func WithShardRouteHeaders() ServerOption {
+	return func(options *ServerOptions) {
+		options.shardRouteHeaders = true
+	}
+}
+
+// shardRouteResponseWriter adds routing decisions to headers right before they are sent to a client.
+type shardRouteResponseWriter struct {
+	http.ResponseWriter
+	trace       *shardroute.Trace
+	wroteHeader bool
+}
+
+func (w *shardRouteResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		for _, decision := range w.trace.Decisions() {
+			w.Header().Add(ShardRouteHeader, decision.String())
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *shardRouteResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func shardRouteHeadersMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, trace := shardroute.NewContext(r.Context())
+		next.ServeHTTP(&shardRouteResponseWriter{ResponseWriter: w, trace: trace}, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/liteapi"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/shardroute"
+)
+
+func Test_shardRouteHeadersMiddleware(t *testing.T) {
+	router := shardroute.NewRouter([]shardroute.Server{{Name: "ls-1"}})
+	account := ton.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	handler := shardRouteHeadersMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := shardroute.Query(r.Context(), router, account, func(ctx context.Context, client *liteapi.Client) (struct{}, error) {
+			return struct{}{}, nil
+		})
+		require.Nil(t, err)
+		w.Write([]byte("{}"))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/accounts/"+account.ToRaw(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, []string{"shard=0:8000000000000000; server=ls-1; reason=fallback"}, rec.Header().Values(ShardRouteHeader))
+}
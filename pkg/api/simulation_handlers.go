@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+func (h *Handler) SimulateAccountTransaction(ctx context.Context, req oas.OptSimulateAccountTransactionReq, params oas.SimulateAccountTransactionParams) (*oas.SimulatedTransaction, error) {
+	if h.simulator == nil {
+		return nil, toError(http.StatusNotImplemented, fmt.Errorf("simulation is available in the testnet only"))
+	}
+	// synthetic transactions reach every subscriber of the account, so only operators may inject them.
+	if !hasAdminScope(ctx) {
+		return nil, toError(http.StatusForbidden, fmt.Errorf("token with admin scope is required"))
+	}
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if t, ok := tenant.FromContext(ctx); ok && !t.Watches(account.ID) {
+		return nil, toError(http.StatusForbidden, fmt.Errorf("account is not watched"))
+	}
+	event := sources.TransactionEvent{
+		AccountID: account.ID,
+		// a synthetic lt grows with time, so it looks like a real one to a consumer ordering transactions by lt.
+		Lt: uint64(time.Now().UnixMicro()),
+	}
+	var hash tongo.Bits256
+	if _, err := rand.Read(hash[:]); err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	event.TxHash = hash.Hex()
+	if operation, ok := req.Value.Operation.Get(); req.Set && ok {
+		if event.MsgOpName, event.MsgOpCode, err = parseOperation(operation); err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+	}
+	if err := h.simulator.SimulateTransaction(event); err != nil {
+		if errors.Is(err, sources.ErrSimulationQueueFull) {
+			return nil, toError(http.StatusTooManyRequests, err)
+		}
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	return &oas.SimulatedTransaction{
+		AccountID: account.ID.ToRaw(),
+		Lt:        int64(event.Lt),
+		TxHash:    event.TxHash,
+	}, nil
+}
+
+// parseOperation converts an operation to the form used by subscriptions filtering by operations:
+// a hex string like "0x7362d09c" is an opcode, anything else is an operation name.
+func parseOperation(operation string) (*abi.MsgOpName, *uint32, error) {
+	if hex, ok := strings.CutPrefix(operation, "0x"); ok {
+		opCode, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid opcode %v", operation)
+		}
+		code := uint32(opCode)
+		return nil, &code, nil
+	}
+	if operation == "" {
+		return nil, nil, fmt.Errorf("empty operation")
+	}
+	return &operation, nil, nil
+}
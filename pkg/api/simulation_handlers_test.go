@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/abi"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+)
+
+type mockSimulator struct {
+	events []sources.TransactionEvent
+}
+
+func (m *mockSimulator) SimulateTransaction(event sources.TransactionEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func TestHandler_SimulateAccountTransaction_adminScope(t *testing.T) {
+	simulator := &mockSimulator{}
+	h := &Handler{simulator: simulator}
+	params := oas.SimulateAccountTransactionParams{AccountID: "0:5555555555555555555555555555555555555555555555555555555555555555"}
+
+	_, err := h.SimulateAccountTransaction(context.Background(), oas.OptSimulateAccountTransactionReq{}, params)
+	var errResp *oas.ErrorStatusCode
+	require.True(t, errors.As(err, &errResp))
+	require.Equal(t, http.StatusForbidden, errResp.StatusCode)
+	require.Empty(t, simulator.events)
+
+	admin := context.WithValue(context.Background(), adminScopeKey{}, true)
+	_, err = h.SimulateAccountTransaction(admin, oas.OptSimulateAccountTransactionReq{}, params)
+	require.Nil(t, err)
+	require.Len(t, simulator.events, 1)
+}
+
+func Test_parseOperation(t *testing.T) {
+	name := abi.MsgOpName("JettonNotify")
+	code := uint32(0x7362d09c)
+	tests := []struct {
+		name      string
+		operation string
+		wantName  *abi.MsgOpName
+		wantCode  *uint32
+		wantErr   bool
+	}{
+		{name: "name", operation: "JettonNotify", wantName: &name},
+		{name: "opcode", operation: "0x7362d09c", wantCode: &code},
+		{name: "invalid opcode", operation: "0xZZ", wantErr: true},
+		{name: "too long opcode", operation: "0x7362d09c00", wantErr: true},
+		{name: "empty", operation: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opName, opCode, err := parseOperation(tt.operation)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.wantName, opName)
+			require.Equal(t, tt.wantCode, opCode)
+		})
+	}
+}
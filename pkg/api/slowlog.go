@@ -0,0 +1,67 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ogen-go/ogen/middleware"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+)
+
+// WithSlowLog keeps REST requests taking longer than the threshold in the given log
+// along with their parameters and a breakdown of time spent in storage calls.
+// Slow requests are logged as well.
+func WithSlowLog(log *slowlog.Log, threshold time.Duration) ServerOption {
+	return func(options *ServerOptions) {
+		options.slowLog = log
+		options.slowRequestThreshold = threshold
+	}
+}
+
+func ogenSlowLogMiddleware(logger *zap.Logger, log *slowlog.Log, threshold time.Duration) middleware.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		ctx, breakdown := slowlog.NewContext(req.Context)
+		req.Context = ctx
+		started := time.Now()
+		resp, err := next(req)
+		duration := time.Since(started)
+		if duration < threshold {
+			return resp, err
+		}
+		entry := slowlog.Entry{
+			Time:      started,
+			Operation: req.OperationID,
+			Path:      req.Raw.URL.Path,
+			Params:    make(map[string]string, len(req.Params)),
+			Duration:  duration,
+			Spans:     breakdown.Spans(),
+		}
+		for key, value := range req.Params {
+			entry.Params[fmt.Sprintf("%v:%v", key.In, key.Name)] = formatParam(value)
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		log.Add(entry)
+		logger.Warn("slow request",
+			zap.String("operation", entry.Operation),
+			zap.String("path", entry.Path),
+			zap.Any("params", entry.Params),
+			zap.Duration("duration", duration),
+			zap.Any("spans", entry.Spans))
+		return resp, err
+	}
+}
+
+// formatParam converts an ogen parameter to a string, unset optional parameters look like "<unset>".
+func formatParam(value any) string {
+	if opt, ok := value.(interface{ IsSet() bool }); ok && !opt.IsSet() {
+		return "<unset>"
+	}
+	if opt, ok := value.(fmt.Stringer); ok {
+		return opt.String()
+	}
+	return fmt.Sprintf("%+v", value)
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sse"
+)
+
+// WithSSEResume keeps up to bufferSize latest events of every SSE stream and subscriptions of disconnected clients
+// for the retention period, so a client reconnecting with the Last-Event-ID header gets events it has missed.
+func WithSSEResume(bufferSize int, retention time.Duration) ServerOption {
+	return func(options *ServerOptions) {
+		if bufferSize > 0 && retention > 0 {
+			options.sseResumer = sse.NewResumer(bufferSize, retention)
+		}
+	}
+}
+
+// sseResumeMiddleware attaches the resumer to the request context, so SSE streams can be resumed.
+func sseResumeMiddleware(resumer *sse.Resumer) AsyncMiddleware {
+	return func(handler AsyncHandler) AsyncHandler {
+		return func(w http.ResponseWriter, r *http.Request, connectionType int, allowTokenInQuery bool) error {
+			ctx := context.WithValue(r.Context(), sse.ResumerKey, resumer)
+			return handler(w, r.WithContext(ctx), connectionType, allowTokenInQuery)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+)
+
+// WithStreamingLimits limits a number of accounts per subscription and subscriptions per connection
+// of the Streaming API, subscriptions exceeding the limits are rejected with a structured error.
+func WithStreamingLimits(limits utils.Limits) ServerOption {
+	return func(options *ServerOptions) {
+		options.streamingLimits = limits
+	}
+}
+
+// streamingLimitsMiddleware attaches limits to the request context, so pusher handlers can enforce them.
+func streamingLimitsMiddleware(limits utils.Limits) AsyncMiddleware {
+	return func(handler AsyncHandler) AsyncHandler {
+		return func(w http.ResponseWriter, r *http.Request, connectionType int, allowTokenInQuery bool) error {
+			ctx := context.WithValue(r.Context(), utils.LimitsKey, limits)
+			return handler(w, r.WithContext(ctx), connectionType, allowTokenInQuery)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/contract/elector"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/utils"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func (h *Handler) GetBlockchainElector(ctx context.Context) (*oas.ElectorState, error) {
+	config, err := h.storage.GetLastConfig(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	electorAddr, ok := config.ElectorAddr()
+	if !ok {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("can't get elector address"))
+	}
+	account, err := h.storage.GetRawAccount(ctx, electorAddr)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	var activeElection struct {
+		ID int64
+	}
+	if err := h.runGetMethod(ctx, electorAddr, "active_election_id", &activeElection); err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	state := oas.ElectorState{
+		Address:          electorAddr.ToRaw(),
+		Balance:          account.TonBalance,
+		ActiveElectionID: activeElection.ID,
+		Participants:     []oas.Validator{},
+	}
+	if activeElection.ID == 0 {
+		return &state, nil
+	}
+	list, err := elector.GetParticipantListExtended(ctx, electorAddr, h.executor)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	state.ElectAt = list.ElectAt
+	state.ElectClose = list.ElectClose
+	state.MinStake = list.MinStake
+	state.TotalStake = list.TotalStake
+	for _, v := range list.Validators {
+		state.Participants = append(state.Participants, oas.Validator{
+			Address:     v.Address.ToRaw(),
+			AdnlAddress: v.AdnlAddr,
+			Stake:       v.Stake,
+			MaxFactor:   v.MaxFactor,
+		})
+	}
+	return &state, nil
+}
+
+func (h *Handler) GetBlockchainConfigContract(ctx context.Context) (*oas.ConfigContractState, error) {
+	config, err := h.storage.GetLastConfig(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	configAddr, ok := config.ConfigAddr()
+	if !ok {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("can't get config contract address"))
+	}
+	account, err := h.storage.GetRawAccount(ctx, configAddr)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	var seqno struct {
+		Seqno int64
+	}
+	if err := h.runGetMethod(ctx, configAddr, "seqno", &seqno); err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	var publicKey struct {
+		Key tlb.Bits256
+	}
+	if err := h.runGetMethod(ctx, configAddr, "get_public_key", &publicKey); err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	proposals, err := h.listConfigProposals(ctx, configAddr)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	state := oas.ConfigContractState{
+		Address:   configAddr.ToRaw(),
+		Balance:   account.TonBalance,
+		Seqno:     seqno.Seqno,
+		PublicKey: publicKey.Key.Hex(),
+		Proposals: make([]oas.ConfigProposal, 0, len(proposals)),
+	}
+	for _, p := range proposals {
+		proposal, err := convertConfigProposal(p)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		state.Proposals = append(state.Proposals, proposal)
+	}
+	return &state, nil
+}
+
+func (h *Handler) GetBlockchainMinter(ctx context.Context) (*oas.MinterState, error) {
+	config, err := h.storage.GetLastConfig(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	minterAddr, ok := config.MinterAddr()
+	if !ok {
+		return nil, toError(http.StatusInternalServerError, fmt.Errorf("can't get minter address"))
+	}
+	rawConfig, err := h.storage.GetConfigRaw(ctx)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	stateExtra, err := decodeMcStateExtra(rawConfig)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	account, err := h.storage.GetRawAccount(ctx, minterAddr)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	return &oas.MinterState{
+		Address:     minterAddr.ToRaw(),
+		Balance:     account.TonBalance,
+		TotalSupply: int64(stateExtra.GlobalBalance.Grams),
+	}, nil
+}
+
+// runGetMethod runs a get method without arguments and decodes its result into dest.
+func (h *Handler) runGetMethod(ctx context.Context, account ton.AccountID, method string, dest any) error {
+	exitCode, stack, err := h.executor.RunSmcMethodByID(ctx, account, utils.MethodIdFromName(method), tlb.VmStack{})
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 && exitCode != 1 {
+		return fmt.Errorf("%s failed with exit code %d", method, exitCode)
+	}
+	return stack.Unmarshal(dest)
+}
+
+// listConfigProposals returns active proposals of the config contract.
+func (h *Handler) listConfigProposals(ctx context.Context, configAddr ton.AccountID) ([]core.ConfigProposal, error) {
+	exitCode, stack, err := h.executor.RunSmcMethodByID(ctx, configAddr, utils.MethodIdFromName("list_proposals"), tlb.VmStack{})
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 && exitCode != 1 {
+		return nil, fmt.Errorf("list_proposals failed with exit code %d", exitCode)
+	}
+	return core.DecodeConfigProposals(stack)
+}
+
+// decodeMcStateExtra decodes the masterchain state proven by a config proof of a lite server,
+// the proof contains the whole McStateExtra cell, so the global balance along with the config.
+func decodeMcStateExtra(configProof []byte) (*tlb.McStateExtra, error) {
+	cells, err := boc.DeserializeBoc(configProof)
+	if err != nil {
+		return nil, err
+	}
+	if len(cells) != 1 {
+		return nil, boc.ErrNotSingleRoot
+	}
+	var proof struct {
+		Proof tlb.MerkleProof[tlb.ShardStateUnsplit]
+	}
+	if err := tlb.Unmarshal(cells[0], &proof); err != nil {
+		return nil, err
+	}
+	custom := proof.Proof.VirtualRoot.ShardStateUnsplit.Custom
+	if !custom.Exists {
+		return nil, fmt.Errorf("config proof has no masterchain state")
+	}
+	return &custom.Value.Value, nil
+}
+
+func convertConfigProposal(p core.ConfigProposal) (oas.ConfigProposal, error) {
+	proposal := oas.ConfigProposal{
+		Hash:            p.Hash.Hex(),
+		Expires:         p.Expires,
+		Critical:        p.Critical,
+		ParamID:         p.ParamID,
+		VsetID:          p.VsetID.Hex(),
+		Voters:          p.Voters,
+		WeightRemaining: p.WeightRemaining,
+		RoundsRemaining: p.RoundsRemaining,
+		Wins:            p.Wins,
+		Losses:          p.Losses,
+	}
+	if p.ParamValue != nil {
+		value, err := p.ParamValue.ToBocString()
+		if err != nil {
+			return oas.ConfigProposal{}, err
+		}
+		proposal.ParamValue = oas.NewOptString(value)
+	}
+	if p.ParamHash != nil {
+		proposal.ParamHash = oas.NewOptString(p.ParamHash.Hex())
+	}
+	return proposal, nil
+}
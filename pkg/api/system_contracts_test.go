@@ -0,0 +1,121 @@
+package api
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func stackInt(v int64) tlb.VmStackValue {
+	return tlb.VmStackValue{SumType: "VmStkTinyInt", VmStkTinyInt: v}
+}
+
+func stackBigInt(v *big.Int) tlb.VmStackValue {
+	return tlb.VmStackValue{SumType: "VmStkInt", VmStkInt: tlb.Int257(*v)}
+}
+
+func stackNull() tlb.VmStackValue {
+	return tlb.VmStackValue{SumType: "VmStkNull"}
+}
+
+func stackTuple(values ...tlb.VmStackValue) tlb.VmStackValue {
+	var build func(values []tlb.VmStackValue) *tlb.VmTuple
+	build = func(values []tlb.VmStackValue) *tlb.VmTuple {
+		t := &tlb.VmTuple{Tail: values[len(values)-1]}
+		if len(values) == 2 {
+			t.Head.Entry = &values[0]
+		} else {
+			t.Head.Ref = build(values[:len(values)-1])
+		}
+		return t
+	}
+	return tlb.VmStackValue{SumType: "VmStkTuple", VmStkTuple: tlb.VmStkTuple{Len: uint16(len(values)), Data: build(values)}}
+}
+
+// stackList builds a lisp-style list of nested pairs ending with null.
+func stackList(values ...tlb.VmStackValue) tlb.VmStackValue {
+	list := stackNull()
+	for i := len(values) - 1; i >= 0; i-- {
+		list = stackTuple(values[i], list)
+	}
+	return list
+}
+
+func Test_decodeConfigProposals(t *testing.T) {
+	hash, _ := new(big.Int).SetString("55e8809519cd3c49098c9ee45afdafcea7a894a74d0f628d94a115a50e045122", 16)
+	vsetID, _ := new(big.Int).SetString("10c1073837b93fdaad594284ce8b8eff7b9cf25427440eb2fc682762e1471365", 16)
+	value := boc.NewCell()
+	require.Nil(t, value.WriteUint(42, 32))
+	proposal := []tlb.VmStackValue{
+		stackInt(1700000000),
+		stackInt(-1),
+		stackTuple(stackInt(17), tlb.VmStackValue{SumType: "VmStkCell", VmStkCell: tlb.Ref[boc.Cell]{Value: *value}}, stackInt(-1)),
+		stackBigInt(vsetID),
+		stackList(stackInt(3), stackInt(0)),
+		stackInt(1000),
+		stackInt(3),
+		stackInt(0),
+		stackInt(1),
+	}
+	tests := []struct {
+		name  string
+		stack tlb.VmStack
+		want  []oas.ConfigProposal
+	}{
+		{
+			name:  "no proposals",
+			stack: tlb.VmStack{stackNull()},
+		},
+		{
+			name:  "flat tuple",
+			stack: tlb.VmStack{stackList(stackTuple(append([]tlb.VmStackValue{stackBigInt(hash)}, proposal...)...))},
+			want: []oas.ConfigProposal{{
+				Hash:            "55e8809519cd3c49098c9ee45afdafcea7a894a74d0f628d94a115a50e045122",
+				Expires:         1700000000,
+				Critical:        true,
+				ParamID:         17,
+				ParamValue:      oas.NewOptString("b5ee9c720101010100060000080000002a"),
+				VsetID:          "10c1073837b93fdaad594284ce8b8eff7b9cf25427440eb2fc682762e1471365",
+				Voters:          []int32{3, 0},
+				WeightRemaining: 1000,
+				RoundsRemaining: 3,
+				Wins:            1,
+			}},
+		},
+		{
+			name:  "nested tuple",
+			stack: tlb.VmStack{stackList(stackTuple(stackBigInt(hash), stackTuple(proposal...)))},
+			want: []oas.ConfigProposal{{
+				Hash:            "55e8809519cd3c49098c9ee45afdafcea7a894a74d0f628d94a115a50e045122",
+				Expires:         1700000000,
+				Critical:        true,
+				ParamID:         17,
+				ParamValue:      oas.NewOptString("b5ee9c720101010100060000080000002a"),
+				VsetID:          "10c1073837b93fdaad594284ce8b8eff7b9cf25427440eb2fc682762e1471365",
+				Voters:          []int32{3, 0},
+				WeightRemaining: 1000,
+				RoundsRemaining: 3,
+				Wins:            1,
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proposals, err := core.DecodeConfigProposals(tt.stack)
+			require.Nil(t, err)
+			var got []oas.ConfigProposal
+			for _, p := range proposals {
+				proposal, err := convertConfigProposal(p)
+				require.Nil(t, err)
+				got = append(got, proposal)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
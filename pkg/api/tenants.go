@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ogen-go/ogen/middleware"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+// WithTenants requires every request to carry a token of one of the tenants
+// and isolates tenants from each other:
+// quotas are enforced per tenant, streaming subscriptions are limited to the tenant's watched accounts.
+// REST requests with an admin token, see WithAdminTokens, don't belong to any tenant.
+func WithTenants(registry *tenant.Registry) ServerOption {
+	return func(options *ServerOptions) {
+		options.tenants = registry
+		options.asyncMiddlewares = append(options.asyncMiddlewares, tenantAsyncMiddleware(registry))
+	}
+}
+
+func tenantOgenMiddleware(registry *tenant.Registry, adminTokens []string) middleware.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		token := bearerToken(req.Raw)
+		if tokenGranted(adminTokens, token) {
+			return next(req)
+		}
+		t, ok := registry.Authenticate(token)
+		if !ok {
+			return middleware.Response{}, toError(http.StatusUnauthorized, fmt.Errorf("invalid token"))
+		}
+		if !t.AllowRequest() {
+			return middleware.Response{}, toError(http.StatusTooManyRequests, ErrRateLimit)
+		}
+		req.Context = tenantContext(req.Context, t)
+		return next(req)
+	}
+}
+
+func tenantAsyncMiddleware(registry *tenant.Registry) AsyncMiddleware {
+	return func(handler AsyncHandler) AsyncHandler {
+		return func(w http.ResponseWriter, r *http.Request, connectionType int, allowTokenInQuery bool) error {
+			token := bearerToken(r)
+			if token == "" && allowTokenInQuery {
+				token = r.URL.Query().Get("token")
+			}
+			t, ok := registry.Authenticate(token)
+			if !ok {
+				errcode.Write(w, http.StatusUnauthorized, errcode.Unauthorized, "invalid token")
+				return fmt.Errorf("invalid token")
+			}
+			if connectionType == LongLivedConnection {
+				if !t.OpenConnection() {
+					errcode.Write(w, http.StatusTooManyRequests, errcode.RateLimited, "too many connections")
+					return fmt.Errorf("tenant %v has reached the connections limit", t.Name())
+				}
+				defer t.CloseConnection()
+			}
+			return handler(w, r.WithContext(tenantContext(r.Context(), t)), connectionType, allowTokenInQuery)
+		}
+	}
+}
+
+// tenantContext attaches the tenant to the request context,
+// so pusher metrics are labeled with the tenant's name and subscriptions are limited to its accounts.
+func tenantContext(ctx context.Context, t *tenant.Tenant) context.Context {
+	ctx = tenant.NewContext(ctx, t)
+	ctx = context.WithValue(ctx, utils.TokenNameKey, t.Name())
+	return context.WithValue(ctx, utils.AccountScopeKey, utils.AccountScope(t))
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+func Test_tenantOgenMiddleware(t *testing.T) {
+	registry, err := tenant.NewRegistry(tenant.Config{Name: "wallet", Tokens: []string{"wallet-token"}})
+	require.Nil(t, err)
+	mw := tenantOgenMiddleware(registry, []string{"admin-token"})
+
+	tests := []struct {
+		name       string
+		token      string
+		wantTenant string
+		wantErr    bool
+	}{
+		{name: "tenant", token: "wallet-token", wantTenant: "wallet"},
+		{name: "admin", token: "admin-token"},
+		{name: "unknown token", token: "guess", wantErr: true},
+		{name: "no token", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/v2/status", nil)
+			if tt.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			var gotTenant string
+			_, err := mw(middleware.Request{Context: context.Background(), Raw: r}, func(req middleware.Request) (middleware.Response, error) {
+				if t, ok := tenant.FromContext(req.Context); ok {
+					gotTenant = t.Name()
+				}
+				return middleware.Response{}, nil
+			})
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.wantTenant, gotTenant)
+		})
+	}
+}
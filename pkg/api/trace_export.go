@@ -0,0 +1,172 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+// traceEdge is a message of a trace leading to a transaction.
+type traceEdge struct {
+	// parent is an index of a node sending the message, -1 means an external message.
+	parent int
+	child  int
+	msg    *core.Message
+}
+
+// traceNode is a transaction of a trace.
+type traceNode struct {
+	trace *core.Trace
+	label string
+}
+
+// traceGraph is a trace flattened in the depth-first order, the root is the first node.
+type traceGraph struct {
+	nodes []traceNode
+	edges []traceEdge
+}
+
+func newTraceGraph(trace *core.Trace, book addressBook) traceGraph {
+	var graph traceGraph
+	var walk func(t *core.Trace, parent int)
+	walk = func(t *core.Trace, parent int) {
+		idx := len(graph.nodes)
+		graph.nodes = append(graph.nodes, traceNode{trace: t, label: traceNodeLabel(t, book)})
+		if t.InMsg != nil && (parent != -1 || t.InMsg.MsgType == core.ExtInMsg) {
+			graph.edges = append(graph.edges, traceEdge{parent: parent, child: idx, msg: t.InMsg})
+		}
+		for _, child := range t.Children {
+			walk(child, idx)
+		}
+	}
+	walk(trace, -1)
+	return graph
+}
+
+func traceNodeLabel(t *core.Trace, book addressBook) string {
+	address := shortAddress(t.Account)
+	if info, ok := book.GetAddressInfoByAddress(t.Account); ok && info.Name != "" {
+		address = info.Name + "\n" + address
+	}
+	if !t.Success {
+		address += "\n(failed)"
+	}
+	return address
+}
+
+// shortAddress keeps the beginning and the end of a raw address, it is enough to tell accounts of a trace apart.
+func shortAddress(account tongo.AccountID) string {
+	raw := account.ToRaw()
+	return raw[:6] + "…" + raw[len(raw)-4:]
+}
+
+// messageLabel describes a message by its operation and value.
+func messageLabel(msg *core.Message) string {
+	var parts []string
+	switch {
+	case msg.DecodedBody != nil:
+		parts = append(parts, msg.DecodedBody.Operation)
+	case msg.OpCode != nil:
+		parts = append(parts, fmt.Sprintf("0x%08x", *msg.OpCode))
+	}
+	if msg.Value > 0 {
+		parts = append(parts, formatTON(msg.Value))
+	}
+	if msg.Bounced {
+		parts = append(parts, "bounced")
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatTON formats nanotons as TON without trailing zeros.
+func formatTON(nanotons int64) string {
+	value := fmt.Sprintf("%d.%09d", nanotons/1_000_000_000, nanotons%1_000_000_000)
+	return strings.TrimRight(strings.TrimRight(value, "0"), ".") + " TON"
+}
+
+func (g traceGraph) dot() string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	var b strings.Builder
+	b.WriteString("digraph trace {\n  node [shape=box];\n")
+	if len(g.edges) > 0 && g.edges[0].parent == -1 {
+		b.WriteString("  ext [label=\"external\", shape=ellipse];\n")
+	}
+	for i, node := range g.nodes {
+		fmt.Fprintf(&b, "  tx%d [label=\"%s\"];\n", i, escape.Replace(node.label))
+	}
+	for _, edge := range g.edges {
+		fmt.Fprintf(&b, "  %s -> tx%d [label=\"%s\"];\n", dotNodeID(edge.parent), edge.child, escape.Replace(messageLabel(edge.msg)))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotNodeID(idx int) string {
+	if idx == -1 {
+		return "ext"
+	}
+	return fmt.Sprintf("tx%d", idx)
+}
+
+func (g traceGraph) mermaid() string {
+	escape := strings.NewReplacer(`"`, "#quot;", "\n", "<br/>")
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+	if len(g.edges) > 0 && g.edges[0].parent == -1 {
+		b.WriteString("  ext((\"external\"))\n")
+	}
+	for i, node := range g.nodes {
+		fmt.Fprintf(&b, "  tx%d[\"%s\"]\n", i, escape.Replace(node.label))
+	}
+	for _, edge := range g.edges {
+		if label := messageLabel(edge.msg); label != "" {
+			fmt.Fprintf(&b, "  %s -->|\"%s\"| tx%d\n", dotNodeID(edge.parent), escape.Replace(label), edge.child)
+		} else {
+			fmt.Fprintf(&b, "  %s --> tx%d\n", dotNodeID(edge.parent), edge.child)
+		}
+	}
+	return b.String()
+}
+
+// flat returns a tab-separated list of edges, every row describes a message and the transaction it has caused.
+func (g traceGraph) flat() string {
+	var b strings.Builder
+	b.WriteString("parent_tx\ttx\tsource\tdestination\tvalue\top\tsuccess\n")
+	for _, edge := range g.edges {
+		parent, source := "", "external"
+		if edge.parent != -1 {
+			parent = g.nodes[edge.parent].trace.Hash.Hex()
+		}
+		if edge.msg.Source != nil {
+			source = edge.msg.Source.ToRaw()
+		}
+		op := ""
+		switch {
+		case edge.msg.DecodedBody != nil:
+			op = edge.msg.DecodedBody.Operation
+		case edge.msg.OpCode != nil:
+			op = fmt.Sprintf("0x%08x", *edge.msg.OpCode)
+		}
+		child := g.nodes[edge.child].trace
+		fmt.Fprintf(&b, "%s\t%s\t%s\t%s\t%d\t%s\t%t\n", parent, child.Hash.Hex(), source, child.Account.ToRaw(), edge.msg.Value, op, child.Success)
+	}
+	return b.String()
+}
+
+// renderTrace renders the trace in one of text formats of the getTrace endpoint.
+func renderTrace(trace *core.Trace, book addressBook, format oas.GetTraceFormat) (string, error) {
+	graph := newTraceGraph(trace, book)
+	switch format {
+	case oas.GetTraceFormatDot:
+		return graph.dot(), nil
+	case oas.GetTraceFormatMermaid:
+		return graph.mermaid(), nil
+	case oas.GetTraceFormatFlat:
+		return graph.flat(), nil
+	}
+	return "", fmt.Errorf("unsupported format %v", format)
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/internal/g"
+	"github.com/tonkeeper/opentonapi/pkg/addressbook"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func Test_renderTrace(t *testing.T) {
+	wallet := tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+	jettonWallet := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	trace := &core.Trace{
+		Transaction: core.Transaction{
+			TransactionID: core.TransactionID{Hash: tongo.Bits256{1}, Account: wallet},
+			Success:       true,
+			InMsg:         &core.Message{MsgType: core.ExtInMsg, MessageID: core.MessageID{Destination: &wallet}},
+		},
+		Children: []*core.Trace{
+			{
+				Transaction: core.Transaction{
+					TransactionID: core.TransactionID{Hash: tongo.Bits256{2}, Account: jettonWallet},
+					InMsg: &core.Message{
+						MsgType:     core.IntMsg,
+						MessageID:   core.MessageID{Source: &wallet, Destination: &jettonWallet},
+						Value:       1_500_000_000,
+						OpCode:      g.Pointer(uint32(0x0f8a7ea5)),
+						DecodedBody: &core.DecodedMessageBody{Operation: "JettonTransfer"},
+					},
+				},
+			},
+		},
+	}
+	book := &mockAddressBook{
+		OnGetAddressInfoByAddress: func(a tongo.AccountID) (addressbook.KnownAddress, bool) {
+			if a == wallet {
+				return addressbook.KnownAddress{Name: `My "hot" wallet`}, true
+			}
+			return addressbook.KnownAddress{}, false
+		},
+	}
+
+	tests := []struct {
+		format oas.GetTraceFormat
+		want   string
+	}{
+		{
+			format: oas.GetTraceFormatDot,
+			want: `digraph trace {
+  node [shape=box];
+  ext [label="external", shape=ellipse];
+  tx0 [label="My \"hot\" wallet\n0:2cf3…5acb"];
+  tx1 [label="0:6dcb…ac6c\n(failed)"];
+  ext -> tx0 [label=""];
+  tx0 -> tx1 [label="JettonTransfer 1.5 TON"];
+}
+`,
+		},
+		{
+			format: oas.GetTraceFormatMermaid,
+			want: `flowchart TD
+  ext(("external"))
+  tx0["My #quot;hot#quot; wallet<br/>0:2cf3…5acb"]
+  tx1["0:6dcb…ac6c<br/>(failed)"]
+  ext --> tx0
+  tx0 -->|"JettonTransfer 1.5 TON"| tx1
+`,
+		},
+		{
+			format: oas.GetTraceFormatFlat,
+			want: "parent_tx\ttx\tsource\tdestination\tvalue\top\tsuccess\n" +
+				"\t" + tongo.Bits256{1}.Hex() + "\texternal\t" + wallet.ToRaw() + "\t0\t\ttrue\n" +
+				tongo.Bits256{1}.Hex() + "\t" + tongo.Bits256{2}.Hex() + "\t" + wallet.ToRaw() + "\t" + jettonWallet.ToRaw() + "\t1500000000\tJettonTransfer\tfalse\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			got, err := renderTrace(trace, book, tt.format)
+			require.Nil(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
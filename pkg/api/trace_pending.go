@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/txemulator"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+type pendingMessage struct {
+	sender *core.Trace
+	index  int
+}
+
+// emulatePendingBranches returns a copy of an in-progress trace with messages which haven't been delivered yet
+// replaced by emulated subtrees, along with hashes of the emulated transactions.
+// A message failed to be emulated stays pending.
+func (h *Handler) emulatePendingBranches(ctx context.Context, trace *core.Trace) (*core.Trace, map[tongo.Bits256]struct{}, error) {
+	// the trace can be shared through a cache, so we modify a copy of it.
+	merged := copyTrace(trace)
+	var pending []pendingMessage
+	core.Visit(merged, func(node *core.Trace) {
+		for i, msg := range node.OutMsgs {
+			if msg.Destination != nil {
+				pending = append(pending, pendingMessage{sender: node, index: i})
+			}
+		}
+	})
+	predicted := map[tongo.Bits256]struct{}{}
+	if len(pending) == 0 {
+		return merged, predicted, nil
+	}
+	// messages are delivered roughly in the order they have been created.
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].sender.OutMsgs[pending[i].index].CreatedLt < pending[j].sender.OutMsgs[pending[j].index].CreatedLt
+	})
+	configBase64, err := h.storage.TrimmedConfigBase64()
+	if err != nil {
+		return nil, nil, err
+	}
+	// a single emulator makes later branches see accounts changed by earlier ones.
+	emulator, err := txemulator.NewTraceBuilder(
+		txemulator.WithAccountsSource(h.storage),
+		txemulator.WithConfigBase64(configBase64),
+		txemulator.WithLimit(1100),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	delivered := map[*core.Trace]map[int]struct{}{}
+	for _, p := range pending {
+		msg, err := core.ConvertToInternalMessage(p.sender.OutMsgs[p.index])
+		if err != nil {
+			h.logger.Debug("failed to restore pending message", zap.Error(err))
+			continue
+		}
+		tree, err := runEmulation(ctx, emulator, msg)
+		if err != nil {
+			h.logger.Debug("failed to emulate pending message", zap.Error(err))
+			continue
+		}
+		child, err := emulatedTreeToTrace(ctx, h.executor, h.storage, tree, emulator.FinalStates(), nil, h.configPool)
+		if err != nil {
+			h.logger.Debug("failed to convert emulated pending message", zap.Error(err))
+			continue
+		}
+		core.Visit(child, func(node *core.Trace) {
+			predicted[node.Hash] = struct{}{}
+		})
+		p.sender.Children = append(p.sender.Children, child)
+		if delivered[p.sender] == nil {
+			delivered[p.sender] = map[int]struct{}{}
+		}
+		delivered[p.sender][p.index] = struct{}{}
+	}
+	// OutMsgs keep only messages without a child node.
+	for sender, indexes := range delivered {
+		outMsgs := make([]core.Message, 0, len(sender.OutMsgs)-len(indexes))
+		for i, msg := range sender.OutMsgs {
+			if _, ok := indexes[i]; !ok {
+				outMsgs = append(outMsgs, msg)
+			}
+		}
+		sender.OutMsgs = outMsgs
+	}
+	return merged, predicted, nil
+}
+
+func copyTrace(t *core.Trace) *core.Trace {
+	c := &core.Trace{
+		Transaction:       t.Transaction,
+		AccountInterfaces: t.AccountInterfaces,
+		Children:          make([]*core.Trace, 0, len(t.Children)),
+	}
+	c.SetAdditionalInfo(t.AdditionalInfo())
+	for _, child := range t.Children {
+		c.Children = append(c.Children, copyTrace(child))
+	}
+	return c
+}
+
+// markPredicted sets the emulated flag of nodes of a converted trace holding predicted transactions.
+func markPredicted(trace *oas.Trace, predicted map[tongo.Bits256]struct{}) {
+	if len(predicted) == 0 {
+		return
+	}
+	hash, err := tongo.ParseHash(trace.Transaction.Hash)
+	if err == nil {
+		if _, ok := predicted[hash]; ok {
+			trace.Emulated.SetTo(true)
+		}
+	}
+	for i := range trace.Children {
+		markPredicted(&trace.Children[i], predicted)
+	}
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+)
+
+func TestHandler_emulatePendingBranches_completedTrace(t *testing.T) {
+	child := &core.Trace{Transaction: core.Transaction{TransactionID: core.TransactionID{Hash: tongo.Bits256{2}}}}
+	trace := &core.Trace{
+		Transaction: core.Transaction{TransactionID: core.TransactionID{Hash: tongo.Bits256{1}}},
+		Children:    []*core.Trace{child},
+	}
+	h := &Handler{}
+	merged, predicted, err := h.emulatePendingBranches(context.Background(), trace)
+	require.NoError(t, err)
+	require.Empty(t, predicted)
+	require.Equal(t, trace.Hash, merged.Hash)
+	require.Len(t, merged.Children, 1)
+	// the original trace is never modified.
+	require.NotSame(t, child, merged.Children[0])
+}
+
+func TestMarkPredicted(t *testing.T) {
+	trace := oas.Trace{
+		Transaction: oas.Transaction{Hash: tongo.Bits256{1}.Hex()},
+		Children: []oas.Trace{
+			{Transaction: oas.Transaction{Hash: tongo.Bits256{2}.Hex()}},
+			{
+				Transaction: oas.Transaction{Hash: tongo.Bits256{3}.Hex()},
+				Children:    []oas.Trace{{Transaction: oas.Transaction{Hash: tongo.Bits256{4}.Hex()}}},
+			},
+		},
+	}
+	markPredicted(&trace, map[tongo.Bits256]struct{}{{3}: {}, {4}: {}})
+	require.False(t, trace.Emulated.Value)
+	require.False(t, trace.Children[0].Emulated.Value)
+	require.True(t, trace.Children[1].Emulated.Value)
+	require.True(t, trace.Children[1].Children[0].Emulated.Value)
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/unresolved"
+)
+
+// unresolvedRetryAfter is suggested to a client in the Retry-After header of a degraded response.
+// Failures of enrichment aren't cached, so the next attempt is likely to get a complete response.
+const unresolvedRetryAfter = 10 * time.Second
+
+// unresolvedField is an element of the "unresolved" list of a degraded response.
+type unresolvedField struct {
+	Kind      string `json:"kind"`
+	Subject   string `json:"subject"`
+	Error     string `json:"error"`
+	ErrorCode string `json:"error_code"`
+}
+
+// unresolvedMiddleware adds an "unresolved" list to a successful JSON response
+// that has been returned without some of its parts, e.g. metadata of a jetton behind an IPFS timeout,
+// along with a Retry-After header.
+// A degraded response must not end up in caches, so it gets "Cache-Control: no-store".
+// Complete responses are passed through as is.
+func unresolvedMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, collector := unresolved.NewContext(r.Context())
+		writer := &unresolvedResponseWriter{ResponseWriter: w, collector: collector}
+		next.ServeHTTP(writer, r.WithContext(ctx))
+		if writer.buffer == nil {
+			return
+		}
+		body := writer.buffer.Bytes()
+		if converted, err := addUnresolvedFields(body, collector.Fields()); err == nil {
+			body = converted
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(int(unresolvedRetryAfter.Seconds())))
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(writer.status)
+		_, _ = w.Write(body)
+	})
+}
+
+// unresolvedResponseWriter starts buffering a response once it turns out to be degraded.
+// A handler resolves everything before it writes headers, so the collector is complete by then.
+type unresolvedResponseWriter struct {
+	http.ResponseWriter
+	collector   *unresolved.Collector
+	wroteHeader bool
+	status      int
+	// buffer is set if the response is degraded.
+	buffer *bytes.Buffer
+}
+
+func (w *unresolvedResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+	if status == http.StatusOK && isJSONContentType(w.Header().Get("Content-Type")) && len(w.collector.Fields()) > 0 {
+		w.buffer = &bytes.Buffer{}
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *unresolvedResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buffer != nil {
+		return w.buffer.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func addUnresolvedFields(body []byte, fields []unresolved.Field) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var doc map[string]any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	list := make([]unresolvedField, 0, len(fields))
+	for _, field := range fields {
+		list = append(list, unresolvedField{
+			Kind:      field.Kind,
+			Subject:   field.Subject,
+			Error:     field.Err.Error(),
+			ErrorCode: string(errcode.Of(http.StatusInternalServerError, field.Err)),
+		})
+	}
+	doc["unresolved"] = list
+	return json.Marshal(doc)
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/liteclient"
+
+	"github.com/tonkeeper/opentonapi/pkg/unresolved"
+)
+
+func Test_unresolvedMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		unresolved  bool
+		want        string
+		wantHeaders map[string]string
+	}{
+		{
+			name:        "complete response",
+			status:      http.StatusOK,
+			want:        `{"balance":"100"}`,
+			wantHeaders: map[string]string{"Retry-After": "", "Cache-Control": "max-age=5"},
+		},
+		{
+			name:        "degraded response",
+			status:      http.StatusOK,
+			unresolved:  true,
+			want:        `{"balance":"100","unresolved":[{"kind":"jetton_metadata","subject":"0:abc","error":"ipfs timeout: context deadline exceeded","error_code":"liteserver_timeout"}]}`,
+			wantHeaders: map[string]string{"Retry-After": "10", "Cache-Control": "no-store"},
+		},
+		{
+			name:        "error response",
+			status:      http.StatusInternalServerError,
+			unresolved:  true,
+			want:        `{"balance":"100"}`,
+			wantHeaders: map[string]string{"Retry-After": "", "Cache-Control": "max-age=5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := unresolvedMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.unresolved {
+					err := fmt.Errorf("ipfs timeout: %w", context.DeadlineExceeded)
+					unresolved.Record(r.Context(), unresolved.KindJettonMetadata, "0:abc", err)
+					unresolved.Record(r.Context(), unresolved.KindJettonMetadata, "0:abc", err)
+				}
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.Header().Set("Cache-Control", "max-age=5")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"balance":"100"}`))
+			}))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/accounts/0:abc/jettons", nil))
+			require.Equal(t, tt.status, rec.Code)
+			require.JSONEq(t, tt.want, rec.Body.String())
+			for key, value := range tt.wantHeaders {
+				require.Equal(t, value, rec.Header().Get(key))
+			}
+		})
+	}
+}
+
+func Test_isTransientMetadataError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "ipfs timeout", err: fmt.Errorf("ipfs: %w", context.DeadlineExceeded), want: true},
+		{name: "lite server timeout", err: fmt.Errorf("request timeout: %w", context.DeadlineExceeded), want: true},
+		{name: "lite server error", err: liteclient.LiteServerErrorC{Code: 651, Message: "not ready"}, want: true},
+		{name: "no metadata", err: fmt.Errorf("no content"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isTransientMetadataError(tt.err))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/txemulator"
+
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+)
+
+// UpstreamTimingHeader breaks down time a request has spent in lite servers, caches, emulation and serialization.
+// It is sent if the request has the DebugTimingHeader and a token with the admin scope.
+const UpstreamTimingHeader = "X-Upstream-Timing"
+
+// DebugTimingHeader asks for the UpstreamTimingHeader in the response, e.g. "X-Debug-Timing: 1".
+const DebugTimingHeader = "X-Debug-Timing"
+
+type upstreamTimingKey struct{}
+
+// upstreamTimingRequested requires the admin scope, as measuring serialization encodes a response twice.
+func upstreamTimingRequested(r *http.Request, adminTokens []string) bool {
+	if requested, err := strconv.ParseBool(r.Header.Get(DebugTimingHeader)); err != nil || !requested {
+		return false
+	}
+	return tokenGranted(adminTokens, bearerToken(r))
+}
+
+func upstreamTimingMiddleware(adminTokens []string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !upstreamTimingRequested(r, adminTokens) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx, breakdown := slowlog.NewContext(r.Context())
+		ctx = context.WithValue(ctx, upstreamTimingKey{}, true)
+		next.ServeHTTP(&upstreamTimingResponseWriter{
+			ResponseWriter: w,
+			breakdown:      breakdown,
+			started:        time.Now(),
+		}, r.WithContext(ctx))
+	})
+}
+
+// ogenUpstreamTimingMiddleware measures serialization of a response if the request asks for the upstream timing.
+// ogen encodes a response after the handler returns and the headers are sent right before the body,
+// so the response is encoded one more time here to measure it.
+func ogenUpstreamTimingMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	resp, err := next(req)
+	if requested, _ := req.Context.Value(upstreamTimingKey{}).(bool); !requested || err != nil {
+		return resp, err
+	}
+	if marshaler, ok := resp.Type.(json.Marshaler); ok {
+		started := time.Now()
+		_, _ = marshaler.MarshalJSON()
+		slowlog.RecordKind(req.Context, slowlog.KindSerialization, "encode_response", time.Since(started))
+	}
+	return resp, err
+}
+
+// upstreamTimingResponseWriter adds the timing breakdown to headers right before they are sent to a client.
+type upstreamTimingResponseWriter struct {
+	http.ResponseWriter
+	breakdown   *slowlog.Breakdown
+	started     time.Time
+	wroteHeader bool
+}
+
+func (w *upstreamTimingResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.Header().Set(UpstreamTimingHeader, formatUpstreamTiming(w.breakdown.Totals(), time.Since(w.started)))
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *upstreamTimingResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// formatUpstreamTiming formats totals in the Server-Timing syntax with durations in milliseconds:
+//
+//	liteserver;dur=12.500;count=3, cache;dur=0.010;count=1, emulation;dur=0.000;count=0, serialization;dur=0.300;count=1, total;dur=14.100
+//
+// Spans might overlap, e.g. emulation includes lite server calls to load accounts.
+func formatUpstreamTiming(totals []slowlog.Total, total time.Duration) string {
+	milliseconds := func(d time.Duration) string {
+		return strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', 3, 64)
+	}
+	parts := make([]string, 0, len(totals)+1)
+	for _, t := range totals {
+		parts = append(parts, fmt.Sprintf("%s;dur=%s;count=%d", t.Kind, milliseconds(t.Duration), t.Count))
+	}
+	parts = append(parts, "total;dur="+milliseconds(total))
+	return strings.Join(parts, ", ")
+}
+
+// runEmulation runs the emulator reporting time spent in it to the timing breakdown of the request.
+func runEmulation(ctx context.Context, emulator *txemulator.Tracer, msg tlb.Message) (*txemulator.TxTree, error) {
+	started := time.Now()
+	defer func() {
+		slowlog.RecordKind(ctx, slowlog.KindEmulation, "emulate_trace", time.Since(started))
+	}()
+	return emulator.Run(ctx, msg)
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+)
+
+func Test_formatUpstreamTiming(t *testing.T) {
+	totals := []slowlog.Total{
+		{Kind: slowlog.KindLiteServer, Duration: 12500 * time.Microsecond, Count: 3},
+		{Kind: slowlog.KindCache, Duration: 10 * time.Microsecond, Count: 1},
+		{Kind: slowlog.KindEmulation},
+		{Kind: slowlog.KindSerialization, Duration: 300 * time.Microsecond, Count: 1},
+	}
+	require.Equal(t,
+		"liteserver;dur=12.500;count=3, cache;dur=0.010;count=1, emulation;dur=0.000;count=0, serialization;dur=0.300;count=1, total;dur=14.100",
+		formatUpstreamTiming(totals, 14100*time.Microsecond))
+}
+
+func Test_upstreamTimingMiddleware(t *testing.T) {
+	handler := upstreamTimingMiddleware([]string{"admin-token"}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slowlog.Record(r.Context(), "get_account", time.Millisecond)
+		w.Write([]byte("{}"))
+	}))
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    bool
+	}{
+		{name: "no debug flag"},
+		{name: "debug flag with admin scope", headers: map[string]string{DebugTimingHeader: "1", "Authorization": "Bearer admin-token"}, want: true},
+		{name: "debug flag is off", headers: map[string]string{DebugTimingHeader: "false", "Authorization": "Bearer admin-token"}},
+		{name: "debug flag without a token", headers: map[string]string{DebugTimingHeader: "1"}},
+		{name: "debug flag with another token", headers: map[string]string{DebugTimingHeader: "1", "Authorization": "Bearer token"}},
+		{name: "admin scope without debug flag", headers: map[string]string{"Authorization": "Bearer admin-token"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v2/accounts/x", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			header := rec.Header().Get(UpstreamTimingHeader)
+			if !tt.want {
+				require.Empty(t, header)
+				return
+			}
+			require.True(t, strings.HasPrefix(header, "liteserver;dur=1.000;count=1, cache;dur=0.000;count=0"), header)
+		})
+	}
+}
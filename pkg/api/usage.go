@@ -0,0 +1,137 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/ogen-go/ogen/middleware"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+	"github.com/tonkeeper/opentonapi/pkg/usage"
+)
+
+// WithUsageMeter attributes lite server calls and emulation of REST requests
+// and bytes sent to streaming clients to names of their API tokens.
+func WithUsageMeter(meter *usage.Meter) ServerOption {
+	return func(options *ServerOptions) {
+		options.usageMeter = meter
+	}
+}
+
+// ogenUsageMiddleware must run after the tenant middleware, so the request context has the token name.
+// Calls are taken from totals of the breakdown, they are not capped like its spans.
+func ogenUsageMiddleware(meter *usage.Meter) middleware.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		ctx, breakdown := slowlog.NewContext(req.Context)
+		req.Context = ctx
+		resp, err := next(req)
+		var consumed usage.Request
+		for _, total := range breakdown.Totals() {
+			switch total.Kind {
+			case slowlog.KindLiteServer:
+				consumed.LiteServerCalls = total.Count
+			case slowlog.KindEmulation:
+				consumed.Emulation = total.Duration
+			}
+		}
+		meter.AddRequest(utils.TokenNameFromContext(ctx), consumed)
+		return resp, err
+	}
+}
+
+// usageAsyncMiddleware counts bytes sent to a streaming client as they are written,
+// so usage of long-lived connections is up-to-date.
+// It must run after the tenant middleware, so the request context has the token name.
+func usageAsyncMiddleware(meter *usage.Meter) AsyncMiddleware {
+	return func(next AsyncHandler) AsyncHandler {
+		return func(w http.ResponseWriter, r *http.Request, connectionType int, allowTokenInQuery bool) error {
+			counting := &usageResponseWriter{
+				ResponseWriter: w,
+				count: func(bytes int) {
+					meter.AddStreamed(utils.TokenNameFromContext(r.Context()), bytes)
+				},
+			}
+			return next(counting, r, connectionType, allowTokenInQuery)
+		}
+	}
+}
+
+// usageResponseWriter counts bytes of SSE and long polling responses,
+// a websocket connection is hijacked, so its bytes are counted by usageConn.
+type usageResponseWriter struct {
+	http.ResponseWriter
+	count func(bytes int)
+}
+
+func (w *usageResponseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.count(n)
+	return n, err
+}
+
+func (w *usageResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *usageResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer doesn't support hijacking")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &usageConn{Conn: conn, count: w.count}, rw, nil
+}
+
+type usageConn struct {
+	net.Conn
+	count func(bytes int)
+}
+
+func (c *usageConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.count(n)
+	return n, err
+}
+
+func (h *Handler) GetUsage(ctx context.Context) (*oas.Usage, error) {
+	if h.usage == nil {
+		return nil, toError(http.StatusNotImplemented, fmt.Errorf("usage is not metered"))
+	}
+	// the admin scope goes first: an operator charging teams back sees all of them even with a tenant token.
+	var filter func(tokenName string) bool
+	if !hasAdminScope(ctx) {
+		t, ok := tenant.FromContext(ctx)
+		if !ok {
+			return nil, toError(http.StatusForbidden, fmt.Errorf("tenant token or token with admin scope is required"))
+		}
+		filter = func(tokenName string) bool {
+			return tokenName == t.Name()
+		}
+	}
+	records := h.usage.Usage(filter)
+	result := oas.Usage{
+		Since:  h.usage.Since().Unix(),
+		Tokens: make([]oas.TokenUsage, 0, len(records)),
+	}
+	for _, u := range records {
+		result.Tokens = append(result.Tokens, oas.TokenUsage{
+			TokenName:       u.TokenName,
+			Requests:        u.Requests,
+			LiteserverCalls: u.LiteServerCalls,
+			EmulationMs:     u.Emulation.Milliseconds(),
+			StreamedBytes:   u.StreamedBytes,
+		})
+	}
+	return &result, nil
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+	"github.com/tonkeeper/opentonapi/pkg/usage"
+)
+
+func TestUsageMiddlewares(t *testing.T) {
+	meter := usage.NewMeter()
+	ctx := context.WithValue(context.Background(), utils.TokenNameKey, "team-a")
+
+	_, err := ogenUsageMiddleware(meter)(middleware.Request{Context: ctx}, func(req middleware.Request) (middleware.Response, error) {
+		slowlog.Record(req.Context, "get_account_state", time.Millisecond)
+		slowlog.Record(req.Context, "get_account_state", time.Millisecond)
+		slowlog.RecordKind(req.Context, slowlog.KindCache, "jettons_metadata_cache", time.Millisecond)
+		slowlog.RecordKind(req.Context, slowlog.KindEmulation, "emulate_trace", 30*time.Millisecond)
+		return middleware.Response{}, nil
+	})
+	require.Nil(t, err)
+
+	handler := usageAsyncMiddleware(meter)(func(w http.ResponseWriter, r *http.Request, connectionType int, allowTokenInQuery bool) error {
+		_, err := w.Write([]byte("event: heartbeat\n\n"))
+		w.(http.Flusher).Flush()
+		return err
+	})
+	request := httptest.NewRequest(http.MethodGet, "/v2/sse/accounts/transactions", nil).WithContext(ctx)
+	require.Nil(t, handler(httptest.NewRecorder(), request, LongLivedConnection, true))
+
+	require.Equal(t, []usage.Usage{
+		{TokenName: "team-a", Requests: 1, LiteServerCalls: 2, Emulation: 30 * time.Millisecond, StreamedBytes: 18},
+	}, meter.Usage(nil))
+}
+
+func TestHandler_GetUsage(t *testing.T) {
+	meter := usage.NewMeter()
+	meter.AddRequest("exchange", usage.Request{LiteServerCalls: 5, Emulation: 1500 * time.Millisecond})
+	meter.AddStreamed("wallet", 1024)
+	h := &Handler{usage: meter}
+
+	tenants, err := tenant.NewRegistry(tenant.Config{Name: "exchange", Tokens: []string{"token"}})
+	require.Nil(t, err)
+	exchangeTenant, ok := tenants.Authenticate("token")
+	require.True(t, ok)
+	tenantCtx := tenant.NewContext(context.Background(), exchangeTenant)
+	admin := context.WithValue(tenantCtx, adminScopeKey{}, true)
+
+	requireStatus := func(t *testing.T, err error, status int) {
+		var statusErr *oas.ErrorStatusCode
+		require.True(t, errors.As(err, &statusErr))
+		require.Equal(t, status, statusErr.StatusCode)
+	}
+	_, err = h.GetUsage(context.Background())
+	requireStatus(t, err, http.StatusForbidden)
+	_, err = (&Handler{}).GetUsage(admin)
+	requireStatus(t, err, http.StatusNotImplemented)
+
+	// a tenant sees its own usage only.
+	result, err := h.GetUsage(tenantCtx)
+	require.Nil(t, err)
+	require.Equal(t, []oas.TokenUsage{
+		{TokenName: "exchange", Requests: 1, LiteserverCalls: 5, EmulationMs: 1500},
+	}, result.Tokens)
+
+	// an operator sees all tokens even with a token of a tenant.
+	result, err = h.GetUsage(admin)
+	require.Nil(t, err)
+	require.Equal(t, meter.Since().Unix(), result.Since)
+	require.Equal(t, []oas.TokenUsage{
+		{TokenName: "exchange", Requests: 1, LiteserverCalls: 5, EmulationMs: 1500},
+		{TokenName: "wallet", StreamedBytes: 1024},
+	}, result.Tokens)
+}
@@ -4,13 +4,19 @@ import (
 	"fmt"
 
 	"github.com/tonkeeper/tongo/boc"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
 )
 
 // deserializeBoc tries to deserialize boc string in base64 or hex format.
 func deserializeBoc(bocStr string) ([]*boc.Cell, error) {
 	cells, err := boc.DeserializeBocBase64(bocStr)
+	if err == nil {
+		return cells, nil
+	}
+	cells, err = boc.DeserializeBocHex(bocStr)
 	if err != nil {
-		return boc.DeserializeBocHex(bocStr)
+		return nil, errcode.Wrap(errcode.InvalidBoc, err)
 	}
 	return cells, nil
 }
@@ -21,7 +27,7 @@ func deserializeSingleBoc(bocStr string) (*boc.Cell, error) {
 		return nil, err
 	}
 	if len(cells) != 1 {
-		return nil, fmt.Errorf("invalid boc roots number %v", len(cells))
+		return nil, errcode.Wrap(errcode.InvalidBoc, fmt.Errorf("invalid boc roots number %v", len(cells)))
 	}
 	return cells[0], nil
 }
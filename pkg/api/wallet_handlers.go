@@ -153,6 +153,57 @@ func (h *Handler) GetWalletsByPublicKey(ctx context.Context, params oas.GetWalle
 	return &oas.Accounts{Accounts: results}, nil
 }
 
+func (h *Handler) RecoverWallets(ctx context.Context, req oas.OptRecoverWalletsReq, params oas.RecoverWalletsParams) (*oas.WalletRecovery, error) {
+	publicKey, err := hex.DecodeString(params.PublicKey)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	var versions []tongoWallet.Version
+	for _, name := range req.Value.Versions {
+		version, err := wallet.ParseRecoveryVersion(name)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		versions = append(versions, version)
+	}
+	candidates, err := wallet.RecoveryCandidates(publicKey, versions, req.Value.SubwalletIds)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	addresses := make([]tongo.AccountID, 0, len(candidates))
+	for _, candidate := range candidates {
+		addresses = append(addresses, candidate.Address)
+	}
+	accounts, err := h.storage.GetRawAccounts(ctx, addresses)
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	accountsByAddress := make(map[tongo.AccountID]*core.Account, len(accounts))
+	for _, account := range accounts {
+		accountsByAddress[account.AccountAddress] = account
+	}
+	result := oas.WalletRecovery{Wallets: []oas.RecoveredWallet{}}
+	for _, candidate := range candidates {
+		account, ok := accountsByAddress[candidate.Address]
+		if !ok || account.Status == tlb.AccountNone {
+			continue
+		}
+		recovered := oas.RecoveredWallet{
+			Address:      candidate.Address.ToRaw(),
+			Version:      candidate.Version.ToString(),
+			Status:       oas.AccountStatus(account.Status),
+			Balance:      account.TonBalance,
+			LastActivity: account.LastActivityTime,
+		}
+		if candidate.SubWalletID != nil {
+			recovered.SubwalletID = oas.NewOptUint32(*candidate.SubWalletID)
+		}
+		result.Wallets = append(result.Wallets, recovered)
+		result.TotalBalance += account.TonBalance
+	}
+	return &result, nil
+}
+
 func (h *Handler) GetAccountSeqno(ctx context.Context, params oas.GetAccountSeqnoParams) (*oas.Seqno, error) {
 	account, err := tongo.ParseAddress(params.AccountID)
 	if err != nil {
@@ -173,6 +224,13 @@ func (h *Handler) GetAccountSeqno(ctx context.Context, params oas.GetAccountSeqn
 	if len(rawAccount.Code) == 0 {
 		return &oas.Seqno{Seqno: int32(seqno)}, nil
 	}
+	if wallet.IsHighloadV3(rawAccount.Code) {
+		state, err := wallet.ParseHighloadV3State(rawAccount.Data, time.Now().Unix())
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		return &oas.Seqno{HighloadV3: oas.NewOptHighloadWalletV3State(convertHighloadV3State(state))}, nil
+	}
 	walletVersion, err := wallet.GetVersionByCode(rawAccount.Code)
 	if err != nil {
 		return nil, toError(http.StatusInternalServerError, err)
@@ -202,3 +260,13 @@ func (h *Handler) GetAccountSeqno(ctx context.Context, params oas.GetAccountSeqn
 	}
 	return &oas.Seqno{Seqno: int32(seqno)}, nil
 }
+
+func convertHighloadV3State(state wallet.HighloadV3State) oas.HighloadWalletV3State {
+	return oas.HighloadWalletV3State{
+		SubwalletID:       int64(state.SubwalletID),
+		Timeout:           int64(state.Timeout),
+		LastCleanTime:     int64(state.LastCleanTime),
+		UsedQueryIds:      int64(state.UsedQueryIDs),
+		RemainingQueryIds: int64(state.RemainingQueryIDs),
+	}
+}
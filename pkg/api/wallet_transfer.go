@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
+	tongoWallet "github.com/tonkeeper/tongo/wallet"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/wallet"
+)
+
+const (
+	defaultTransferLifetime     = 5 * time.Minute
+	defaultTransferAttachedTon  = 50_000_000
+	defaultTransferForwardedTon = 1
+)
+
+func (h *Handler) BuildWalletTransfer(ctx context.Context, request *oas.BuildWalletTransferReq, params oas.BuildWalletTransferParams) (*oas.UnsignedWalletTransfer, error) {
+	account, err := tongo.ParseAddress(params.AccountID)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	version, err := wallet.ParseTransferVersion(request.Version)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	transferParams := wallet.TransferParams{
+		Version:    version,
+		ValidUntil: time.Now().Add(defaultTransferLifetime),
+	}
+	transferParams.WalletID, err = wallet.DefaultWalletID(version, account.ID.Workchain)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	rawAccount, err := h.storage.GetRawAccount(ctx, account.ID)
+	if err != nil && !errors.Is(err, core.ErrEntityNotFound) {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	if err == nil && rawAccount.Status == tlb.AccountActive {
+		actual, err := wallet.GetVersionByCode(rawAccount.Code)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		if actual != version {
+			return nil, toError(http.StatusBadRequest, fmt.Errorf("wallet is %v, not %v", actual.ToString(), version.ToString()))
+		}
+		transferParams.Seqno, transferParams.WalletID, err = wallet.ParseWalletData(version, rawAccount.Data)
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+	}
+	if request.Seqno.IsSet() {
+		transferParams.Seqno = uint32(request.Seqno.Value)
+	}
+	if request.WalletID.IsSet() {
+		transferParams.WalletID = uint32(request.WalletID.Value)
+	}
+	if request.ValidUntil.IsSet() {
+		transferParams.ValidUntil = time.Unix(request.ValidUntil.Value, 0)
+	}
+	messages := make([]tongoWallet.Sendable, 0, len(request.Transfers))
+	for _, transfer := range request.Transfers {
+		msg, err := h.convertWalletTransfer(ctx, account.ID, transfer)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	unsigned, err := wallet.BuildUnsignedBody(transferParams, messages...)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	body, err := unsigned.Body.ToBocString()
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	hash, err := unsigned.Body.HashString()
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	return &oas.UnsignedWalletTransfer{
+		Body:              body,
+		Hash:              hash,
+		SignaturePosition: oas.UnsignedWalletTransferSignaturePosition(unsigned.SignaturePosition),
+		Seqno:             int64(transferParams.Seqno),
+		WalletID:          int64(transferParams.WalletID),
+		ValidUntil:        transferParams.ValidUntil.Unix(),
+	}, nil
+}
+
+func (h *Handler) convertWalletTransfer(ctx context.Context, sender tongo.AccountID, transfer oas.WalletTransferItem) (tongoWallet.Sendable, error) {
+	destination, err := tongo.ParseAddress(transfer.Destination)
+	if err != nil {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	amount, ok := new(big.Int).SetString(transfer.Amount.Value, 10)
+	if transfer.Amount.IsSet() && (!ok || amount.Sign() < 0) {
+		return nil, toError(http.StatusBadRequest, fmt.Errorf("invalid amount %q", transfer.Amount.Value))
+	}
+	if !ok {
+		amount = big.NewInt(0)
+	}
+	attached := tlb.Grams(transfer.AttachedAmount.Or(defaultTransferAttachedTon))
+	forwarded := tlb.Grams(transfer.ForwardAmount.Or(defaultTransferForwardedTon))
+	queryID := uint64(time.Now().UnixNano())
+	switch transfer.Type {
+	case oas.WalletTransferItemTypeTon:
+		if !amount.IsUint64() {
+			return nil, toError(http.StatusBadRequest, fmt.Errorf("invalid amount %q", transfer.Amount.Value))
+		}
+		return tongoWallet.SimpleTransfer{
+			Amount:     tlb.Grams(amount.Uint64()),
+			Address:    destination.ID,
+			Comment:    transfer.Comment.Value,
+			Bounceable: transfer.Bounce.Value,
+		}, nil
+	case oas.WalletTransferItemTypeJetton:
+		master, err := tongo.ParseAddress(transfer.Jetton.Value)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, fmt.Errorf("invalid jetton: %w", err))
+		}
+		_, value, err := abi.GetWalletAddress(ctx, h.executor, master.ID, sender.ToMsgAddress())
+		if err != nil {
+			return nil, toError(http.StatusInternalServerError, err)
+		}
+		result, ok := value.(abi.GetWalletAddressResult)
+		if !ok {
+			return nil, toError(http.StatusBadRequest, fmt.Errorf("%v is not a jetton master", transfer.Jetton.Value))
+		}
+		jettonWallet, err := tongo.AccountIDFromTlb(result.JettonWalletAddress)
+		if err != nil || jettonWallet == nil {
+			return nil, toError(http.StatusInternalServerError, fmt.Errorf("failed to get a jetton wallet"))
+		}
+		return wallet.JettonTransfer{
+			QueryID:             queryID,
+			JettonWallet:        *jettonWallet,
+			Amount:              *amount,
+			Destination:         destination.ID,
+			ResponseDestination: sender,
+			AttachedTon:         attached,
+			ForwardTonAmount:    forwarded,
+			Comment:             transfer.Comment.Value,
+		}, nil
+	case oas.WalletTransferItemTypeNft:
+		item, err := tongo.ParseAddress(transfer.Nft.Value)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, fmt.Errorf("invalid nft: %w", err))
+		}
+		return wallet.NftTransfer{
+			QueryID:             queryID,
+			Item:                item.ID,
+			NewOwner:            destination.ID,
+			ResponseDestination: sender,
+			AttachedTon:         attached,
+			ForwardTonAmount:    forwarded,
+			Comment:             transfer.Comment.Value,
+		}, nil
+	}
+	return nil, toError(http.StatusBadRequest, fmt.Errorf("unknown transfer type %v", transfer.Type))
+}
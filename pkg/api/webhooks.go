@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/webhooks"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+// defaultSecretGracePeriod is how long a previous secret of a webhook keeps signing notifications after rotation.
+const defaultSecretGracePeriod = 24 * time.Hour
+
+// webhooksScope returns a tenant the webhooks of the request are limited to,
+// nil means the request has the admin scope and manages webhooks of all tenants.
+func (h *Handler) webhooksScope(ctx context.Context) (*tenant.Tenant, error) {
+	if h.webhooks == nil {
+		return nil, toError(http.StatusNotImplemented, fmt.Errorf("webhooks are not configured"))
+	}
+	if t, ok := tenant.FromContext(ctx); ok {
+		return t, nil
+	}
+	if !hasAdminScope(ctx) {
+		return nil, toError(http.StatusForbidden, fmt.Errorf("tenant token or token with admin scope is required"))
+	}
+	return nil, nil
+}
+
+func (h *Handler) convertWebhook(w webhooks.Webhook) oas.Webhook {
+	result := oas.Webhook{
+		ID:        w.ID,
+		URL:       w.URL,
+		Accounts:  make([]string, 0, len(w.Accounts)),
+		Events:    make([]string, 0, len(w.Events)),
+		CreatedAt: w.CreatedAt,
+	}
+	for _, account := range w.Accounts {
+		result.Accounts = append(result.Accounts, account.ToRaw())
+	}
+	for _, event := range w.Events {
+		result.Events = append(result.Events, string(event))
+	}
+	status := h.webhooks.Status(w.ID)
+	result.Status = oas.WebhookStatus{
+		Delivered: status.Delivered,
+		Failed:    status.Failed,
+		Pending:   status.Pending,
+	}
+	if status.LastDeliveryAt > 0 {
+		result.Status.LastDeliveryAt = oas.NewOptInt64(status.LastDeliveryAt)
+	}
+	if status.LastFailureAt > 0 {
+		result.Status.LastFailureAt = oas.NewOptInt64(status.LastFailureAt)
+	}
+	if status.LastError != "" {
+		result.Status.LastError = oas.NewOptString(status.LastError)
+	}
+	return result
+}
+
+func (h *Handler) GetWebhooks(ctx context.Context) (*oas.Webhooks, error) {
+	t, err := h.webhooksScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := h.webhooks.List(func(w webhooks.Webhook) bool {
+		return t == nil || w.Tenant == t.Name()
+	})
+	result := oas.Webhooks{Webhooks: make([]oas.Webhook, 0, len(list))}
+	for _, w := range list {
+		result.Webhooks = append(result.Webhooks, h.convertWebhook(w))
+	}
+	return &result, nil
+}
+
+func (h *Handler) AddWebhook(ctx context.Context, request *oas.AddWebhookReq) (*oas.Webhook, error) {
+	t, err := h.webhooksScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]tongo.AccountID, 0, len(request.Accounts))
+	for _, str := range request.Accounts {
+		account, err := tongo.ParseAddress(str)
+		if err != nil {
+			return nil, toError(http.StatusBadRequest, err)
+		}
+		if t != nil && !t.Watches(account.ID) {
+			return nil, toError(http.StatusForbidden, fmt.Errorf("account %v is not tracked", str))
+		}
+		accounts = append(accounts, account.ID)
+	}
+	events := make([]webhooks.EventType, 0, len(request.Events))
+	for _, event := range request.Events {
+		events = append(events, webhooks.EventType(event))
+	}
+	var tenantName string
+	if t != nil {
+		tenantName = t.Name()
+	}
+	w, err := h.webhooks.Add(request.URL, accounts, events, tenantName)
+	if errors.Is(err, webhooks.ErrInvalidWebhook) {
+		return nil, toError(http.StatusBadRequest, err)
+	}
+	if errors.Is(err, webhooks.ErrLimitReached) {
+		return nil, toError(http.StatusTooManyRequests, err)
+	}
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := h.convertWebhook(w)
+	// the secret is never returned again.
+	result.Secret = oas.NewOptString(w.Secret)
+	return &result, nil
+}
+
+func (h *Handler) RotateWebhookSecret(ctx context.Context, params oas.RotateWebhookSecretParams) (*oas.Webhook, error) {
+	t, err := h.webhooksScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	w, ok := h.webhooks.Get(params.ID)
+	if !ok || (t != nil && w.Tenant != t.Name()) {
+		return nil, toError(http.StatusNotFound, fmt.Errorf("webhook not found"))
+	}
+	gracePeriod := defaultSecretGracePeriod
+	if params.GracePeriod.IsSet() {
+		gracePeriod = time.Duration(params.GracePeriod.Value) * time.Second
+	}
+	w, err = h.webhooks.RotateSecret(params.ID, gracePeriod)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return nil, toError(http.StatusNotFound, err)
+	}
+	if err != nil {
+		return nil, toError(http.StatusInternalServerError, err)
+	}
+	result := h.convertWebhook(w)
+	result.Secret = oas.NewOptString(w.Secret)
+	return &result, nil
+}
+
+func (h *Handler) DeleteWebhook(ctx context.Context, params oas.DeleteWebhookParams) error {
+	t, err := h.webhooksScope(ctx)
+	if err != nil {
+		return err
+	}
+	w, ok := h.webhooks.Get(params.ID)
+	if !ok || (t != nil && w.Tenant != t.Name()) {
+		return toError(http.StatusNotFound, fmt.Errorf("webhook not found"))
+	}
+	deleted, err := h.webhooks.Delete(params.ID)
+	if err != nil {
+		return toError(http.StatusInternalServerError, err)
+	}
+	if !deleted {
+		return toError(http.StatusNotFound, fmt.Errorf("webhook not found"))
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/oas"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/webhooks"
+	"github.com/tonkeeper/opentonapi/pkg/tenant"
+)
+
+func TestHandler_Webhooks(t *testing.T) {
+	exchange := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	other := tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+	registry, err := webhooks.NewRegistry("")
+	require.Nil(t, err)
+	h := &Handler{webhooks: registry}
+
+	tenants, err := tenant.NewRegistry(tenant.Config{Name: "exchange", Tokens: []string{"token"}, Accounts: []tongo.AccountID{exchange}})
+	require.Nil(t, err)
+	exchangeTenant, ok := tenants.Authenticate("token")
+	require.True(t, ok)
+	tenantCtx := tenant.NewContext(context.Background(), exchangeTenant)
+	admin := context.WithValue(context.Background(), adminScopeKey{}, true)
+
+	requireStatus := func(t *testing.T, err error, status int) {
+		var statusErr *oas.ErrorStatusCode
+		require.True(t, errors.As(err, &statusErr))
+		require.Equal(t, status, statusErr.StatusCode)
+	}
+
+	_, err = h.GetWebhooks(context.Background())
+	requireStatus(t, err, http.StatusForbidden)
+	_, err = (&Handler{}).GetWebhooks(admin)
+	requireStatus(t, err, http.StatusNotImplemented)
+
+	// a tenant registers webhooks for its own accounts only.
+	_, err = h.AddWebhook(tenantCtx, &oas.AddWebhookReq{URL: "https://example.com/callback", Accounts: []string{exchange.ToRaw(), other.ToRaw()}})
+	requireStatus(t, err, http.StatusForbidden)
+	_, err = h.AddWebhook(tenantCtx, &oas.AddWebhookReq{URL: "example.com", Accounts: []string{exchange.ToRaw()}})
+	requireStatus(t, err, http.StatusBadRequest)
+	webhook, err := h.AddWebhook(tenantCtx, &oas.AddWebhookReq{
+		URL:      "https://example.com/callback",
+		Accounts: []string{exchange.ToRaw()},
+		Events:   []oas.AddWebhookReqEventsItem{oas.AddWebhookReqEventsItemTrace},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"trace"}, webhook.Events)
+	require.NotEmpty(t, webhook.Secret.Value)
+	operatorWebhook, err := h.AddWebhook(admin, &oas.AddWebhookReq{URL: "https://example.com/admin", Accounts: []string{other.ToRaw()}})
+	require.Nil(t, err)
+
+	list, err := h.GetWebhooks(tenantCtx)
+	require.Nil(t, err)
+	require.Len(t, list.Webhooks, 1)
+	list, err = h.GetWebhooks(admin)
+	require.Nil(t, err)
+	require.Len(t, list.Webhooks, 2)
+	for _, w := range list.Webhooks {
+		require.False(t, w.Secret.IsSet())
+	}
+
+	_, err = h.RotateWebhookSecret(tenantCtx, oas.RotateWebhookSecretParams{ID: operatorWebhook.ID})
+	requireStatus(t, err, http.StatusNotFound)
+	rotated, err := h.RotateWebhookSecret(tenantCtx, oas.RotateWebhookSecretParams{ID: webhook.ID})
+	require.Nil(t, err)
+	require.NotEmpty(t, rotated.Secret.Value)
+	require.NotEqual(t, webhook.Secret.Value, rotated.Secret.Value)
+	stored, ok := registry.Get(webhook.ID)
+	require.True(t, ok)
+	require.Equal(t, []string{rotated.Secret.Value, webhook.Secret.Value}, stored.Secrets(time.Now()))
+
+	// webhooks of the operator are invisible to tenants.
+	err = h.DeleteWebhook(tenantCtx, oas.DeleteWebhookParams{ID: operatorWebhook.ID})
+	requireStatus(t, err, http.StatusNotFound)
+	require.Nil(t, h.DeleteWebhook(tenantCtx, oas.DeleteWebhookParams{ID: webhook.ID}))
+	err = h.DeleteWebhook(admin, oas.DeleteWebhookParams{ID: webhook.ID})
+	requireStatus(t, err, http.StatusNotFound)
+}
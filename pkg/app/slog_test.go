@@ -0,0 +1,39 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := SlogLogger(handler).With(zap.String("component", "pusher"))
+
+	logger.Debug("skipped")
+	logger.Warn("failed to load trace", zap.Int("attempt", 3), zap.Error(errors.New("not found")))
+
+	var record map[string]any
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, map[string]any{
+		"level":     "WARN",
+		"msg":       "failed to load trace",
+		"component": "pusher",
+		"attempt":   float64(3),
+		"error":     "not found",
+	}, record)
+}
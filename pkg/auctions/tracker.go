@@ -0,0 +1,230 @@
+// Package auctions keeps a history of bids placed on recognized NFT auction contracts
+// and streams new bids to subscribers.
+package auctions
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/bath"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+)
+
+var bidNumber = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "auction_bids_tracked",
+	Help: "Number of tracked auction bids",
+}, []string{"type"})
+
+const (
+	// maxBidsPerItem limits the history of a single item, older bids are dropped first.
+	maxBidsPerItem = 100
+	// bidRetention is how long the history of an item is kept after its last bid.
+	bidRetention = 7 * 24 * time.Hour
+)
+
+// straws recognize bids on auctions of getgems and fragment.
+var straws = []bath.Merger{
+	bath.StrawFindAuctionBidFragmentSimple,
+	bath.TgAuctionV1InitialBidStraw,
+	bath.StrawAuctionBigGetgems,
+}
+
+// Bid is a successful bid placed on an auction contract.
+type Bid struct {
+	Auction tongo.AccountID     `json:"auction"`
+	Type    bath.NftAuctionType `json:"auction_type"`
+	// Nft and Collection are unknown if the auction contract doesn't expose the item.
+	Nft        *tongo.AccountID `json:"nft,omitempty"`
+	Collection *tongo.AccountID `json:"collection,omitempty"`
+	Bidder     tongo.AccountID  `json:"bidder"`
+	Amount     int64            `json:"amount"`
+	TraceID    string           `json:"trace_id"`
+	Utime      int64            `json:"utime"`
+}
+
+// Item returns the key of the bid's history: the NFT item or, if it is unknown, the auction contract.
+func (b Bid) Item() tongo.AccountID {
+	if b.Nft != nil {
+		return *b.Nft
+	}
+	return b.Auction
+}
+
+// SubscribeOptions configures a subscription to new bids.
+type SubscribeOptions struct {
+	AllAccounts bool
+	// Accounts are NFT collections, items or auction contracts.
+	Accounts []tongo.AccountID
+}
+
+type storage interface {
+	core.InformationSource
+	GetTrace(ctx context.Context, hash tongo.Bits256) (*core.Trace, error)
+	GetNFTs(ctx context.Context, accounts []tongo.AccountID) ([]core.NftItem, error)
+}
+
+// Tracker finds bids in traces delivered by a trace source, keeps their history and delivers them to subscribers.
+type Tracker struct {
+	logger     *zap.Logger
+	storage    storage
+	source     sources.TraceSource
+	dispatcher *sources.TraceDispatcher
+
+	// mu protects bids.
+	mu   sync.RWMutex
+	bids map[tongo.AccountID][]Bid
+}
+
+func NewTracker(logger *zap.Logger, storage storage, source sources.TraceSource) *Tracker {
+	return &Tracker{
+		logger:  logger,
+		storage: storage,
+		source:  source,
+		// fan-out of bids by accounts is the same as of traces.
+		dispatcher: sources.NewTraceDispatcher(logger),
+		bids:       map[tongo.AccountID][]Bid{},
+	}
+}
+
+// SubscribeToBids delivers new bids on items of the given collections, on the given items or auctions.
+func (t *Tracker) SubscribeToBids(ctx context.Context, deliveryFn sources.DeliveryFn, opts SubscribeOptions) sources.CancelFn {
+	return t.dispatcher.RegisterSubscriber(deliveryFn, sources.SubscribeToTraceOptions{
+		AllAccounts: opts.AllAccounts,
+		Accounts:    opts.Accounts,
+	})
+}
+
+// Bids returns the history of bids on the NFT item or on the auction contract, the latest go first.
+func (t *Tracker) Bids(item tongo.AccountID) []Bid {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	history := t.bids[item]
+	result := make([]Bid, len(history))
+	for i, bid := range history {
+		result[len(history)-1-i] = bid
+	}
+	return result
+}
+
+func (t *Tracker) Run(ctx context.Context) {
+	hashCh := make(chan tongo.Bits256, 1000)
+	cancelFn := t.source.SubscribeToTraces(ctx, func(eventData []byte) {
+		var event sources.TraceEventData
+		if err := json.Unmarshal(eventData, &event); err != nil {
+			t.logger.Error("json.Unmarshal() failed", zap.Error(err))
+			return
+		}
+		if event.Simulated {
+			return
+		}
+		var hash tongo.Bits256
+		if err := hash.FromHex(event.Hash); err != nil {
+			t.logger.Error("hash.FromHex() failed", zap.Error(err))
+			return
+		}
+		select {
+		case hashCh <- hash:
+		default:
+			bidNumber.With(map[string]string{"type": "dropped-trace"}).Inc()
+		}
+	}, sources.SubscribeToTraceOptions{AllAccounts: true})
+	defer cancelFn()
+
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case hash := <-hashCh:
+			t.process(ctx, hash)
+		case now := <-ticker.C:
+			t.prune(now)
+		}
+	}
+}
+
+func (t *Tracker) process(ctx context.Context, hash tongo.Bits256) {
+	trace, err := t.storage.GetTrace(ctx, hash)
+	if err != nil {
+		t.logger.Debug("failed to get trace", zap.Error(err))
+		return
+	}
+	result, err := bath.FindActions(ctx, trace, bath.WithInformationSource(t.storage), bath.WithStraws(straws))
+	if err != nil {
+		t.logger.Debug("failed to find actions", zap.Error(err))
+		return
+	}
+	for _, action := range result.Actions {
+		if action.AuctionBid == nil || !action.Success {
+			continue
+		}
+		bid := Bid{
+			Auction: action.AuctionBid.Auction,
+			Type:    action.AuctionBid.Type,
+			Nft:     action.AuctionBid.NftAddress,
+			Bidder:  action.AuctionBid.Bidder,
+			Amount:  action.AuctionBid.Amount,
+			TraceID: trace.Hash.Hex(),
+			Utime:   trace.Utime,
+		}
+		if bid.Nft != nil {
+			bid.Collection = t.collection(ctx, *bid.Nft)
+		}
+		t.add(bid)
+	}
+}
+
+func (t *Tracker) collection(ctx context.Context, nft tongo.AccountID) *tongo.AccountID {
+	items, err := t.storage.GetNFTs(ctx, []tongo.AccountID{nft})
+	if err != nil || len(items) == 0 {
+		return nil
+	}
+	return items[0].CollectionAddress
+}
+
+func (t *Tracker) add(bid Bid) {
+	bidNumber.With(map[string]string{"type": string(bid.Type)}).Inc()
+	t.mu.Lock()
+	item := bid.Item()
+	history := append(t.bids[item], bid)
+	if len(history) > maxBidsPerItem {
+		history = history[len(history)-maxBidsPerItem:]
+	}
+	t.bids[item] = history
+	t.mu.Unlock()
+
+	eventJSON, err := json.Marshal(bid)
+	if err != nil {
+		t.logger.Error("json.Marshal() failed", zap.Error(err))
+		return
+	}
+	accounts := []tongo.AccountID{bid.Auction}
+	if bid.Nft != nil {
+		accounts = append(accounts, *bid.Nft)
+	}
+	if bid.Collection != nil {
+		accounts = append(accounts, *bid.Collection)
+	}
+	t.dispatcher.Dispatch(accounts, eventJSON)
+}
+
+// prune removes histories of items without bids during bidRetention.
+func (t *Tracker) prune(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	threshold := now.Add(-bidRetention).Unix()
+	for item, history := range t.bids {
+		if history[len(history)-1].Utime < threshold {
+			delete(t.bids, item)
+		}
+	}
+}
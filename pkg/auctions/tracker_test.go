@@ -0,0 +1,82 @@
+package auctions
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/bath"
+)
+
+var (
+	auction    = tongo.MustParseAddress("0:9c3c2ab7ef8efc6a8bd1bbd11bd5ad16d40f2fd0a4dc6e2e3e24be1ea52ae1cc").ID
+	nft        = tongo.MustParseAddress("0:b1f2d7d3a1d2cd7f8b64ef3bb0cd42cbbd03d1ae2beb6f9f0f1bf4e2bd3a3a2d").ID
+	collection = tongo.MustParseAddress("0:80d78a35f955a14b679faa887ff4cd5bfc0f43b4a4eea2a7e6927f3701b273c2").ID
+	bidder     = tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+)
+
+func TestTracker_add(t *testing.T) {
+	tracker := NewTracker(zap.L(), nil, nil)
+	for i := 0; i < maxBidsPerItem+5; i++ {
+		tracker.add(Bid{Auction: auction, Type: bath.GetGemsAuction, Nft: &nft, Bidder: bidder, Amount: int64(i), Utime: int64(i)})
+	}
+	tracker.add(Bid{Auction: auction, Type: bath.DnsTgAuction, Bidder: bidder, Amount: 1})
+
+	bids := tracker.Bids(nft)
+	require.Len(t, bids, maxBidsPerItem)
+	require.Equal(t, int64(maxBidsPerItem+4), bids[0].Amount)
+	require.Equal(t, int64(5), bids[len(bids)-1].Amount)
+
+	// a bid without a known item is kept in the history of its auction.
+	require.Equal(t, []Bid{{Auction: auction, Type: bath.DnsTgAuction, Bidder: bidder, Amount: 1}}, tracker.Bids(auction))
+	require.Empty(t, tracker.Bids(bidder))
+}
+
+func TestTracker_SubscribeToBids(t *testing.T) {
+	tests := []struct {
+		name string
+		opts SubscribeOptions
+		want int
+	}{
+		{name: "collection", opts: SubscribeOptions{Accounts: []tongo.AccountID{collection}}, want: 1},
+		{name: "item", opts: SubscribeOptions{Accounts: []tongo.AccountID{nft}}, want: 1},
+		{name: "auction", opts: SubscribeOptions{Accounts: []tongo.AccountID{auction}}, want: 1},
+		{name: "all", opts: SubscribeOptions{AllAccounts: true}, want: 1},
+		{name: "bidder", opts: SubscribeOptions{Accounts: []tongo.AccountID{bidder}}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewTracker(zap.L(), nil, nil)
+			var delivered []Bid
+			cancel := tracker.SubscribeToBids(context.Background(), func(data []byte) {
+				var bid Bid
+				require.Nil(t, json.Unmarshal(data, &bid))
+				delivered = append(delivered, bid)
+			}, tt.opts)
+			defer cancel()
+
+			bid := Bid{Auction: auction, Type: bath.GetGemsAuction, Nft: &nft, Collection: &collection, Bidder: bidder, Amount: 10}
+			tracker.add(bid)
+			require.Len(t, delivered, tt.want)
+			if tt.want > 0 {
+				require.Equal(t, bid, delivered[0])
+			}
+		})
+	}
+}
+
+func TestTracker_prune(t *testing.T) {
+	now := time.Now()
+	tracker := NewTracker(zap.L(), nil, nil)
+	tracker.add(Bid{Auction: auction, Nft: &nft, Utime: now.Add(-bidRetention - time.Hour).Unix()})
+	tracker.add(Bid{Auction: auction, Utime: now.Add(-time.Hour).Unix()})
+
+	tracker.prune(now)
+	require.Empty(t, tracker.Bids(nft))
+	require.Len(t, tracker.Bids(auction), 1)
+}
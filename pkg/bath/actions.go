@@ -36,6 +36,9 @@ const (
 	JettonSwap            ActionType = "JettonSwap"
 	AuctionBid            ActionType = "AuctionBid"
 	DomainRenew           ActionType = "DomainRenew"
+	DomainPurchase        ActionType = "DomainPurchase"
+	DnsRecordChange       ActionType = "DnsRecordChange"
+	ExtraCurrencyTransfer ActionType = "ExtraCurrencyTransfer"
 	InscriptionMint       ActionType = "InscriptionMint"
 	InscriptionTransfer   ActionType = "InscriptionTransfer"
 
@@ -86,6 +89,9 @@ type (
 		WithdrawStakeRequest  *WithdrawStakeRequestAction  `json:",omitempty"`
 		JettonSwap            *JettonSwapAction            `json:",omitempty"`
 		DnsRenew              *DnsRenewAction              `json:",omitempty"`
+		DomainPurchase        *DomainPurchaseAction        `json:",omitempty"`
+		DnsRecordChange       *DnsRecordChangeAction       `json:",omitempty"`
+		ExtraCurrencyTransfer *ExtraCurrencyTransferAction `json:",omitempty"`
 		InscriptionMint       *InscriptionMintAction       `json:",omitempty"`
 		InscriptionTransfer   *InscriptionTransferAction   `json:",omitempty"`
 		Success               bool
@@ -100,6 +106,15 @@ type (
 		Sender           tongo.AccountID
 		Refund           *Refund
 	}
+	// ExtraCurrencyTransferAction is a transfer of extra currencies, TonAttached is a value in TON of the same message.
+	ExtraCurrencyTransferAction struct {
+		Currencies       []core.Currency
+		TonAttached      int64
+		Comment          *string
+		EncryptedComment *EncryptedComment
+		Recipient        tongo.AccountID
+		Sender           tongo.AccountID
+	}
 	SmartContractAction struct {
 		TonAttached int64
 		Executor    tongo.AccountID
@@ -189,6 +204,8 @@ type (
 		Router     tongo.AccountID
 		In         assetTransfer
 		Out        assetTransfer
+		// Sandwich is set if the swap is likely surrounded by swaps of another actor, it is detected out of bath.
+		Sandwich *Sandwich `json:",omitempty"`
 	}
 	DepositStakeAction struct {
 		Staker         tongo.AccountID
@@ -262,16 +279,20 @@ func (a Action) ContributeToExtra(account tongo.AccountID) int64 {
 		return 0
 	}
 	switch a.Type {
-	case NftItemTransfer, ContractDeploy, UnSubscription, JettonMint, JettonBurn, WithdrawStakeRequest, DomainRenew, InscriptionMint, InscriptionTransfer: // actions without extra
+	case NftItemTransfer, ContractDeploy, UnSubscription, JettonMint, JettonBurn, WithdrawStakeRequest, DomainRenew, DnsRecordChange, InscriptionMint, InscriptionTransfer: // actions without extra
 		return 0
 	case TonTransfer:
 		return detectDirection(account, a.TonTransfer.Sender, a.TonTransfer.Recipient, a.TonTransfer.Amount)
+	case ExtraCurrencyTransfer:
+		return detectDirection(account, a.ExtraCurrencyTransfer.Sender, a.ExtraCurrencyTransfer.Recipient, a.ExtraCurrencyTransfer.TonAttached)
 	case SmartContractExec:
 		return detectDirection(account, a.SmartContractExec.Executor, a.SmartContractExec.Contract, a.SmartContractExec.TonAttached)
 	case NftPurchase:
 		return detectDirection(account, a.NftPurchase.Buyer, a.NftPurchase.Seller, a.NftPurchase.Price)
 	case AuctionBid:
 		return detectDirection(account, a.AuctionBid.Bidder, a.AuctionBid.Auction, a.AuctionBid.Amount)
+	case DomainPurchase:
+		return detectDirection(account, a.DomainPurchase.Buyer, a.DomainPurchase.Item, a.DomainPurchase.Amount)
 	case ElectionsDepositStake:
 		return detectDirection(account, a.ElectionsDepositStake.Staker, a.ElectionsDepositStake.Elector, a.ElectionsDepositStake.Amount)
 	case ElectionsRecoverStake:
@@ -310,6 +331,7 @@ func (a Action) ContributeToExtra(account tongo.AccountID) int64 {
 func (a Action) IsSubject(account tongo.AccountID) bool {
 	for _, i := range []interface{ SubjectAccounts() []tongo.AccountID }{
 		a.TonTransfer,
+		a.ExtraCurrencyTransfer,
 		a.SmartContractExec,
 		a.NftItemTransfer,
 		a.NftPurchase,
@@ -327,6 +349,8 @@ func (a Action) IsSubject(account tongo.AccountID) bool {
 		a.JettonMint,
 		a.JettonBurn,
 		a.DnsRenew,
+		a.DomainPurchase,
+		a.DnsRecordChange,
 	} {
 		if i != nil && !reflect.ValueOf(i).IsNil() {
 			return slices.Contains(i.SubjectAccounts(), account)
@@ -339,6 +363,10 @@ func (a *TonTransferAction) SubjectAccounts() []tongo.AccountID {
 	return []tongo.AccountID{a.Sender, a.Recipient}
 }
 
+func (a *ExtraCurrencyTransferAction) SubjectAccounts() []tongo.AccountID {
+	return []tongo.AccountID{a.Sender, a.Recipient}
+}
+
 func (a *SmartContractAction) SubjectAccounts() []tongo.AccountID {
 	return []tongo.AccountID{a.Contract, a.Executor}
 }
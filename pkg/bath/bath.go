@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/unresolved"
 	"github.com/tonkeeper/tongo"
 )
 
@@ -49,7 +50,8 @@ func FindActions(ctx context.Context, trace *core.Trace, opts ...Option) (*Actio
 		o(&options)
 	}
 	if err := core.CollectAdditionalInfo(ctx, options.informationSource, trace); err != nil {
-		return nil, err
+		// actions are still found, though some of them might be less specific.
+		unresolved.Record(ctx, unresolved.KindTraceInfo, trace.Hash.Hex(), err)
 	}
 	bubble := fromTrace(trace)
 	MergeAllBubbles(bubble, options.straws)
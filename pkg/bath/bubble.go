@@ -78,6 +78,7 @@ func fromTrace(trace *core.Trace) *Bubble {
 		btx.bounced = msg.Bounced
 		btx.inputAmount += msg.Value
 		btx.inputAmount += msg.IhrFee
+		btx.inputExtra = msg.ValueExtra
 		btx.opCode = msg.OpCode
 		btx.decodedBody = msg.DecodedBody
 		btx.inputFrom = source
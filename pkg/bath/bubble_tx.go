@@ -12,6 +12,7 @@ type BubbleTx struct {
 	success         bool
 	transactionType core.TransactionType
 	inputAmount     int64
+	inputExtra      []core.Currency
 	inputFrom       *Account
 	bounce          bool
 	bounced         bool
@@ -71,6 +72,9 @@ func (b BubbleTx) ToAction() *Action {
 			Type:    SmartContractExec,
 		}
 	}
+	if len(b.inputExtra) > 0 {
+		return b.extraCurrencyTransfer()
+	}
 	a := &Action{
 		TonTransfer: &TonTransferAction{
 			Amount:    b.inputAmount,
@@ -92,6 +96,29 @@ func (b BubbleTx) ToAction() *Action {
 	return a
 }
 
+func (b BubbleTx) extraCurrencyTransfer() *Action {
+	a := &Action{
+		ExtraCurrencyTransfer: &ExtraCurrencyTransferAction{
+			Currencies:  b.inputExtra,
+			TonAttached: b.inputAmount,
+			Recipient:   b.account.Address,
+			Sender:      b.inputFrom.Address,
+		},
+		Success: true,
+		Type:    ExtraCurrencyTransfer,
+	}
+	if b.decodedBody != nil {
+		switch s := b.decodedBody.Value.(type) {
+		case abi.TextCommentMsgBody:
+			converted := string(s.Text)
+			a.ExtraCurrencyTransfer.Comment = &converted
+		case abi.EncryptedTextCommentMsgBody:
+			a.ExtraCurrencyTransfer.EncryptedComment = &EncryptedComment{EncryptionType: "simple", CipherText: s.CipherText}
+		}
+	}
+	return a
+}
+
 func (b BubbleTx) operation(name string) bool {
 	return b.decodedBody != nil && b.decodedBody.Operation == name
 }
@@ -35,6 +35,30 @@ func TestBubbleTx_ToAction(t *testing.T) {
 				Type:    SmartContractExec,
 			},
 		},
+		{
+			name: "extra currency transfer",
+			tx: BubbleTx{
+				success:     true,
+				inputAmount: 100,
+				inputExtra:  []core.Currency{{ID: 239, Value: "1000"}},
+				inputFrom: &Account{
+					Address: tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb"),
+				},
+				account: Account{
+					Address: tongo.MustParseAccountID("0:da6b1b6663a0e4d18cc8574ccd9db5296e367dd9324706f3bbd9eb1cd2caf0bf"),
+				},
+			},
+			want: &Action{
+				ExtraCurrencyTransfer: &ExtraCurrencyTransferAction{
+					Currencies:  []core.Currency{{ID: 239, Value: "1000"}},
+					TonAttached: 100,
+					Sender:      tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb"),
+					Recipient:   tongo.MustParseAccountID("0:da6b1b6663a0e4d18cc8574ccd9db5296e367dd9324706f3bbd9eb1cd2caf0bf"),
+				},
+				Success: true,
+				Type:    ExtraCurrencyTransfer,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
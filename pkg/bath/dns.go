@@ -1,9 +1,13 @@
 package bath
 
 import (
+	"crypto/sha256"
+
 	"github.com/tonkeeper/tongo/abi"
 	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/references"
 )
 
 type BubbleDnsItemRenew struct {
@@ -40,3 +44,131 @@ var DNSRenewStraw = Straw[BubbleDnsItemRenew]{
 		CheckFuncs: []bubbleCheck{IsTx, HasOperation(abi.BounceMsgOp)},
 	},
 }
+
+type BubbleDomainPurchase struct {
+	DomainPurchaseAction
+	Success bool
+}
+
+// DomainPurchaseAction is a purchase of a new .ton domain,
+// the buyer's payment is the initial bid of the auction of the deployed item.
+type DomainPurchaseAction struct {
+	Domain string
+	Item   ton.AccountID
+	Buyer  ton.AccountID
+	Amount int64
+}
+
+func (b BubbleDomainPurchase) ToAction() *Action {
+	return &Action{Success: b.Success, Type: DomainPurchase, DomainPurchase: &b.DomainPurchaseAction}
+}
+
+func (a DomainPurchaseAction) SubjectAccounts() []ton.AccountID {
+	return []ton.AccountID{a.Buyer, a.Item}
+}
+
+var DomainPurchaseStraw = Straw[BubbleDomainPurchase]{
+	CheckFuncs: []bubbleCheck{IsTx, IsAccount(references.RootDotTon), HasOperation(abi.TextCommentMsgOp)},
+	Builder: func(newAction *BubbleDomainPurchase, bubble *Bubble) error {
+		tx := bubble.Info.(BubbleTx)
+		newAction.Buyer = tx.inputFrom.Address
+		newAction.Amount = tx.inputAmount
+		newAction.Domain = string(tx.decodedBody.Value.(abi.TextCommentMsgBody).Text) + references.DomainSuffixes[references.RootDotTon]
+		return nil
+	},
+	SingleChild: &Straw[BubbleDomainPurchase]{
+		CheckFuncs: []bubbleCheck{IsTx, HasInterface(abi.NftItem)},
+		Builder: func(newAction *BubbleDomainPurchase, bubble *Bubble) error {
+			tx := bubble.Info.(BubbleTx)
+			newAction.Item = tx.account.Address
+			newAction.Success = tx.success
+			return nil
+		},
+		SingleChild: &Straw[BubbleDomainPurchase]{
+			Optional:   true,
+			CheckFuncs: []bubbleCheck{Is(BubbleContractDeploy{})},
+		},
+	},
+}
+
+// dnsRecordNames maps keys of DNS records to their categories.
+var dnsRecordNames = map[tlb.Bits256]string{
+	sha256.Sum256([]byte("wallet")):            "wallet",
+	sha256.Sum256([]byte("site")):              "site",
+	sha256.Sum256([]byte("storage")):           "storage",
+	sha256.Sum256([]byte("dns_next_resolver")): "dns_next_resolver",
+}
+
+type BubbleDnsRecordChange struct {
+	DnsRecordChangeAction
+	Success bool
+}
+
+// DnsRecordChangeAction is a change of a DNS record of a domain.
+// OldValue is nil if the record didn't exist or its previous value is unknown,
+// NewValue is nil if the record is deleted.
+type DnsRecordChangeAction struct {
+	Item     ton.AccountID
+	Changer  ton.AccountID
+	Key      tlb.Bits256
+	OldValue *tlb.DNSRecord
+	NewValue *tlb.DNSRecord
+}
+
+// Record returns the category of the changed record or a hex key of an unknown record.
+func (a DnsRecordChangeAction) Record() string {
+	if name, ok := dnsRecordNames[a.Key]; ok {
+		return name
+	}
+	return a.Key.Hex()
+}
+
+func (b BubbleDnsRecordChange) ToAction() *Action {
+	return &Action{Success: b.Success, Type: DnsRecordChange, DnsRecordChange: &b.DnsRecordChangeAction}
+}
+
+func (a DnsRecordChangeAction) SubjectAccounts() []ton.AccountID {
+	return []ton.AccountID{a.Changer, a.Item}
+}
+
+func buildDnsRecordChange(newAction *BubbleDnsRecordChange, tx BubbleTx, key tlb.Bits256) {
+	newAction.Changer = tx.inputFrom.Address
+	newAction.Item = tx.account.Address
+	newAction.Key = key
+	newAction.Success = tx.success
+	if tx.additionalInfo != nil {
+		if old, ok := tx.additionalInfo.DNSRecords[key]; ok {
+			newAction.OldValue = &old
+		}
+	}
+}
+
+var DNSRecordChangeStraw = Straw[BubbleDnsRecordChange]{
+	CheckFuncs: []bubbleCheck{IsTx, HasOperation(abi.ChangeDnsRecordMsgOp), HasInterface(abi.NftItem)},
+	Builder: func(newAction *BubbleDnsRecordChange, bubble *Bubble) error {
+		tx := bubble.Info.(BubbleTx)
+		body := tx.decodedBody.Value.(abi.ChangeDnsRecordMsgBody)
+		buildDnsRecordChange(newAction, tx, body.Key)
+		newAction.NewValue = &body.Value
+		return nil
+	},
+	SingleChild: &Straw[BubbleDnsRecordChange]{
+		Optional:   true,
+		CheckFuncs: []bubbleCheck{IsTx, HasOperation(abi.BounceMsgOp)},
+	},
+}
+
+var DNSRecordDeleteStraw = Straw[BubbleDnsRecordChange]{
+	CheckFuncs: []bubbleCheck{IsTx, HasOperation(abi.DeleteDnsRecordMsgOp), HasInterface(abi.NftItem), func(bubble *Bubble) bool {
+		return !bubble.Info.(BubbleTx).decodedBody.Value.(abi.DeleteDnsRecordMsgBody).Key.Equal(tlb.Bits256{})
+	}},
+	Builder: func(newAction *BubbleDnsRecordChange, bubble *Bubble) error {
+		tx := bubble.Info.(BubbleTx)
+		buildDnsRecordChange(newAction, tx, tx.decodedBody.Value.(abi.DeleteDnsRecordMsgBody).Key)
+		return nil
+	},
+	SingleChild: &Straw[BubbleDnsRecordChange]{
+		Optional:   true,
+		CheckFuncs: []bubbleCheck{IsTx, HasOperation(abi.BounceMsgOp)},
+	},
+}
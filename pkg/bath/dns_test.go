@@ -0,0 +1,106 @@
+package bath
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/references"
+	pkgTesting "github.com/tonkeeper/opentonapi/pkg/testing"
+)
+
+var (
+	dnsOwner = tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	dnsItem  = tongo.MustParseAccountID("0:da6b1b6663a0e4d18cc8574ccd9db5296e367dd9324706f3bbd9eb1cd2caf0bf")
+)
+
+func TestFindActions_domainPurchase(t *testing.T) {
+	item := pkgTesting.NewTrace(dnsItem, pkgTesting.WithInterfaces(abi.NftItem))
+	item.OrigStatus = tlb.AccountNone
+	collection := pkgTesting.NewTrace(references.RootDotTon,
+		pkgTesting.WithInternalMsg(dnsOwner, 10_000_000_000),
+		pkgTesting.WithOperation(abi.TextCommentMsgOp, 0, abi.TextCommentMsgBody{Text: "vasya"}),
+		pkgTesting.WithChildren(item))
+	trace := pkgTesting.NewTrace(dnsOwner,
+		pkgTesting.WithExternalInMsg(),
+		pkgTesting.WithInterfaces(abi.WalletV4R2),
+		pkgTesting.WithChildren(collection))
+
+	result, err := FindActions(context.Background(), trace)
+	require.Nil(t, err)
+	require.Len(t, result.Actions, 1)
+	require.Equal(t, DomainPurchase, result.Actions[0].Type)
+	require.True(t, result.Actions[0].Success)
+	require.Equal(t, &DomainPurchaseAction{
+		Domain: "vasya.ton",
+		Item:   dnsItem,
+		Buyer:  dnsOwner,
+		Amount: 10_000_000_000,
+	}, result.Actions[0].DomainPurchase)
+	require.Equal(t, int64(-10_000_000_000), result.Actions[0].ContributeToExtra(dnsOwner))
+}
+
+func TestFindActions_dnsRecordChange(t *testing.T) {
+	wallet := tlb.Bits256(sha256.Sum256([]byte("wallet")))
+	site := tlb.Bits256(sha256.Sum256([]byte("site")))
+	oldValue := tlb.DNSRecord{SumType: "DNSNextResolver", DNSNextResolver: dnsItem.ToMsgAddress()}
+	newValue := tlb.DNSRecord{SumType: "DNSNextResolver", DNSNextResolver: dnsOwner.ToMsgAddress()}
+
+	tests := []struct {
+		name   string
+		opName abi.MsgOpName
+		body   any
+		want   *DnsRecordChangeAction
+		record string
+	}{
+		{
+			name:   "change",
+			opName: abi.ChangeDnsRecordMsgOp,
+			body:   abi.ChangeDnsRecordMsgBody{Key: wallet, Value: newValue},
+			want:   &DnsRecordChangeAction{Item: dnsItem, Changer: dnsOwner, Key: wallet, OldValue: &oldValue, NewValue: &newValue},
+			record: "wallet",
+		},
+		{
+			name:   "new record",
+			opName: abi.ChangeDnsRecordMsgOp,
+			body:   abi.ChangeDnsRecordMsgBody{Key: site, Value: newValue},
+			want:   &DnsRecordChangeAction{Item: dnsItem, Changer: dnsOwner, Key: site, NewValue: &newValue},
+			record: "site",
+		},
+		{
+			name:   "delete",
+			opName: abi.DeleteDnsRecordMsgOp,
+			body:   abi.DeleteDnsRecordMsgBody{Key: wallet},
+			want:   &DnsRecordChangeAction{Item: dnsItem, Changer: dnsOwner, Key: wallet, OldValue: &oldValue},
+			record: "wallet",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := pkgTesting.NewTrace(dnsItem,
+				pkgTesting.WithInterfaces(abi.NftItem),
+				pkgTesting.WithInternalMsg(dnsOwner, 50_000_000),
+				pkgTesting.WithOperation(tt.opName, uint32(abi.ChangeDnsRecordMsgOpCode), tt.body),
+				pkgTesting.WithAdditionalInfo(&core.TraceAdditionalInfo{
+					DNSRecords: map[tlb.Bits256]tlb.DNSRecord{wallet: oldValue},
+				}))
+			trace := pkgTesting.NewTrace(dnsOwner,
+				pkgTesting.WithExternalInMsg(),
+				pkgTesting.WithInterfaces(abi.WalletV4R2),
+				pkgTesting.WithChildren(item))
+
+			result, err := FindActions(context.Background(), trace)
+			require.Nil(t, err)
+			require.Len(t, result.Actions, 1)
+			require.Equal(t, DnsRecordChange, result.Actions[0].Type)
+			require.Equal(t, tt.want, result.Actions[0].DnsRecordChange)
+			require.Equal(t, tt.record, result.Actions[0].DnsRecordChange.Record())
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package bath
+
+import (
+	"github.com/tonkeeper/tongo/abi"
+
+	"github.com/tonkeeper/opentonapi/pkg/wallet"
+)
+
+// BubbleHighloadV3Batch is an internal_transfer message highload wallet v3 sends to itself to process a batch.
+// It is not an action on its own, transfers of the batch are its children in the order they were sent.
+type BubbleHighloadV3Batch struct{}
+
+func (b BubbleHighloadV3Batch) ToAction() *Action {
+	return nil
+}
+
+var HighloadV3BatchStraw = Straw[BubbleHighloadV3Batch]{
+	CheckFuncs: []bubbleCheck{IsTx, HasInterface(abi.WalletHighloadV3R1), HasOpcode(wallet.HighloadV3InternalTransferOpCode), func(bubble *Bubble) bool {
+		tx := bubble.Info.(BubbleTx)
+		return tx.inputFrom != nil && tx.inputFrom.Address == tx.account.Address
+	}},
+}
@@ -0,0 +1,57 @@
+package bath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+
+	"github.com/tonkeeper/opentonapi/internal/g"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/wallet"
+)
+
+func TestHighloadV3BatchStraw(t *testing.T) {
+	highload := tongo.MustParseAccountID("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c")
+	alice := tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	bob := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000001")
+
+	transfer := func(to tongo.AccountID, value int64, lt uint64) *core.Trace {
+		return &core.Trace{Transaction: core.Transaction{
+			TransactionID: core.TransactionID{Account: to},
+			Success:       true,
+			InMsg:         &core.Message{MessageID: core.MessageID{CreatedLt: lt, Source: &highload, Destination: &to}, MsgType: core.IntMsg, Value: value},
+		}}
+	}
+	trace := &core.Trace{
+		Transaction: core.Transaction{
+			TransactionID: core.TransactionID{Account: highload},
+			Success:       true,
+			InMsg:         &core.Message{MsgType: core.ExtInMsg},
+		},
+		AccountInterfaces: []abi.ContractInterface{abi.WalletHighloadV3R1},
+		Children: []*core.Trace{{
+			Transaction: core.Transaction{
+				TransactionID: core.TransactionID{Account: highload},
+				Success:       true,
+				InMsg: &core.Message{
+					MessageID: core.MessageID{Source: &highload, Destination: &highload},
+					MsgType:   core.IntMsg,
+					Value:     500_000_000,
+					OpCode:    g.Pointer(uint32(wallet.HighloadV3InternalTransferOpCode)),
+				},
+			},
+			AccountInterfaces: []abi.ContractInterface{abi.WalletHighloadV3R1},
+			// children are listed out of order on purpose.
+			Children: []*core.Trace{transfer(bob, 2, 12), transfer(alice, 1, 11)},
+		}},
+	}
+	bubble := fromTrace(trace)
+	MergeAllBubbles(bubble, DefaultStraws)
+	actions, _ := CollectActionsAndValueFlow(bubble, nil)
+	require.Len(t, actions, 2)
+	require.Equal(t, alice, actions[0].TonTransfer.Recipient)
+	require.Equal(t, highload, actions[0].TonTransfer.Sender)
+	require.Equal(t, bob, actions[1].TonTransfer.Recipient)
+}
@@ -0,0 +1,102 @@
+package bath
+
+import (
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// SwapLeg is a swap executed by a DEX pool, it is a single transaction of the pool.
+type SwapLeg struct {
+	Hash  tongo.Bits256
+	Lt    uint64
+	Block tongo.BlockID
+	Pool  tongo.AccountID
+	// Direction tells swaps of a pool in opposite directions apart:
+	// it is the router's jetton wallet (STON.fi) or the vault (DeDust) of the asset sold to the pool.
+	Direction tongo.AccountID
+	// Actor is the account receiving the result of the swap.
+	Actor tongo.AccountID
+}
+
+// Sandwich describes two swaps of one actor surrounding a swap of another one on the same pool in the same block:
+// the front run moves the price in the direction of the surrounded swap, the back run takes the profit.
+type Sandwich struct {
+	Attacker tongo.AccountID
+	FrontRun tongo.Bits256
+	BackRun  tongo.Bits256
+}
+
+// ExtractSwapLeg returns a swap executed by the transaction if it is a transaction of a STON.fi or DeDust pool.
+func ExtractSwapLeg(tx *core.Transaction) (SwapLeg, bool) {
+	if tx.InMsg == nil || tx.InMsg.DecodedBody == nil || tx.InMsg.Source == nil {
+		return SwapLeg{}, false
+	}
+	leg := SwapLeg{
+		Hash:  tx.Hash,
+		Lt:    tx.Lt,
+		Block: tx.BlockID,
+		Pool:  tx.Account,
+	}
+	var direction, actor *ton.AccountID
+	var err error
+	switch body := tx.InMsg.DecodedBody.Value.(type) {
+	case abi.StonfiSwapMsgBody:
+		if direction, err = ton.AccountIDFromTlb(body.SenderAddress); err != nil {
+			return SwapLeg{}, false
+		}
+		if actor, err = ton.AccountIDFromTlb(body.ToAddress); err != nil {
+			return SwapLeg{}, false
+		}
+	case abi.DedustSwapExternalMsgBody:
+		direction = tx.InMsg.Source
+		if actor, err = ton.AccountIDFromTlb(body.SenderAddr); err != nil {
+			return SwapLeg{}, false
+		}
+	default:
+		return SwapLeg{}, false
+	}
+	if direction == nil || actor == nil {
+		return SwapLeg{}, false
+	}
+	leg.Direction, leg.Actor = *direction, *actor
+	return leg, true
+}
+
+// TraceSwapLegs returns swaps executed by pools in the trace by hashes of pool transactions.
+func TraceSwapLegs(trace *core.Trace) map[tongo.Bits256]SwapLeg {
+	legs := map[tongo.Bits256]SwapLeg{}
+	core.Visit(trace, func(t *core.Trace) {
+		if leg, ok := ExtractSwapLeg(&t.Transaction); ok {
+			legs[leg.Hash] = leg
+		}
+	})
+	return legs
+}
+
+// FindSandwich looks for a sandwich around the victim among other swaps of the same block.
+// The front run is a swap in the same direction right before the victim,
+// the back run is a swap of the same actor in the opposite direction after it.
+func FindSandwich(victim SwapLeg, block []SwapLeg) *Sandwich {
+	var sandwich *Sandwich
+	var frontLt uint64
+	for _, front := range block {
+		if front.Pool != victim.Pool || front.Direction != victim.Direction || front.Actor == victim.Actor || front.Lt >= victim.Lt {
+			continue
+		}
+		for _, back := range block {
+			if back.Pool != victim.Pool || back.Direction == victim.Direction || back.Actor != front.Actor || back.Lt <= victim.Lt {
+				continue
+			}
+			// the closest front run wins if the attacker has surrounded the victim several times.
+			if sandwich == nil || front.Lt > frontLt {
+				sandwich = &Sandwich{Attacker: front.Actor, FrontRun: front.Hash, BackRun: back.Hash}
+				frontLt = front.Lt
+			}
+			break
+		}
+	}
+	return sandwich
+}
@@ -0,0 +1,113 @@
+package bath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+func TestExtractSwapLeg(t *testing.T) {
+	pool := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000001")
+	wallet := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000002")
+	user := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000003")
+	vault := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000004")
+	tx := func(source tongo.AccountID, body any) *core.Transaction {
+		return &core.Transaction{
+			TransactionID: core.TransactionID{Account: pool, Lt: 10},
+			BlockID:       tongo.BlockID{Workchain: 0, Shard: 0x8000000000000000, Seqno: 100},
+			InMsg: &core.Message{
+				MessageID:   core.MessageID{Source: &source},
+				DecodedBody: &core.DecodedMessageBody{Value: body},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		tx      *core.Transaction
+		wantLeg SwapLeg
+		wantOk  bool
+	}{
+		{
+			name: "stonfi",
+			tx: tx(wallet, abi.StonfiSwapMsgBody{
+				ToAddress:     user.ToMsgAddress(),
+				SenderAddress: wallet.ToMsgAddress(),
+			}),
+			wantLeg: SwapLeg{Lt: 10, Block: tongo.BlockID{Workchain: 0, Shard: 0x8000000000000000, Seqno: 100}, Pool: pool, Direction: wallet, Actor: user},
+			wantOk:  true,
+		},
+		{
+			name:    "dedust",
+			tx:      tx(vault, abi.DedustSwapExternalMsgBody{SenderAddr: user.ToMsgAddress()}),
+			wantLeg: SwapLeg{Lt: 10, Block: tongo.BlockID{Workchain: 0, Shard: 0x8000000000000000, Seqno: 100}, Pool: pool, Direction: vault, Actor: user},
+			wantOk:  true,
+		},
+		{
+			name:   "not a swap",
+			tx:     tx(user, abi.TextCommentMsgBody{Text: tlb.Text("hi")}),
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leg, ok := ExtractSwapLeg(tt.tx)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.wantLeg, leg)
+		})
+	}
+}
+
+func TestFindSandwich(t *testing.T) {
+	pool := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000001")
+	otherPool := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000002")
+	buy := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000003")
+	sell := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000004")
+	victim := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000005")
+	attacker := tongo.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000006")
+	leg := func(hash byte, lt uint64, pool, direction, actor tongo.AccountID) SwapLeg {
+		return SwapLeg{Hash: tongo.Bits256{hash}, Lt: lt, Pool: pool, Direction: direction, Actor: actor}
+	}
+	victimLeg := leg(2, 20, pool, buy, victim)
+	tests := []struct {
+		name  string
+		block []SwapLeg
+		want  *Sandwich
+	}{
+		{
+			name:  "sandwich",
+			block: []SwapLeg{leg(1, 10, pool, buy, attacker), victimLeg, leg(3, 30, pool, sell, attacker)},
+			want:  &Sandwich{Attacker: attacker, FrontRun: tongo.Bits256{1}, BackRun: tongo.Bits256{3}},
+		},
+		{
+			name:  "closest front run",
+			block: []SwapLeg{leg(1, 10, pool, buy, attacker), leg(4, 15, pool, buy, attacker), victimLeg, leg(3, 30, pool, sell, attacker)},
+			want:  &Sandwich{Attacker: attacker, FrontRun: tongo.Bits256{4}, BackRun: tongo.Bits256{3}},
+		},
+		{
+			name:  "back run in the same direction",
+			block: []SwapLeg{leg(1, 10, pool, buy, attacker), victimLeg, leg(3, 30, pool, buy, attacker)},
+		},
+		{
+			name:  "back run on another pool",
+			block: []SwapLeg{leg(1, 10, pool, buy, attacker), victimLeg, leg(3, 30, otherPool, sell, attacker)},
+		},
+		{
+			name:  "victim's own swaps",
+			block: []SwapLeg{leg(1, 10, pool, buy, victim), victimLeg, leg(3, 30, pool, sell, victim)},
+		},
+		{
+			name:  "swaps after the victim only",
+			block: []SwapLeg{victimLeg, leg(1, 25, pool, buy, attacker), leg(3, 30, pool, sell, attacker)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, FindSandwich(victimLeg, tt.block))
+		})
+	}
+}
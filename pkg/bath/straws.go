@@ -35,6 +35,7 @@ var SubscriptionStraws = []Merger{
 }
 
 var DefaultStraws = []Merger{
+	HighloadV3BatchStraw,
 	StrawFindAuctionBidFragmentSimple,
 	NftTransferStraw,
 	NftTransferNotifyStraw,
@@ -67,6 +68,9 @@ var DefaultStraws = []Merger{
 	WithdrawStakeImmediatelyStraw,
 	WithdrawLiquidStake,
 	DNSRenewStraw,
+	DNSRecordChangeStraw,
+	DNSRecordDeleteStraw,
+	DomainPurchaseStraw,
 }
 
 var JettonTransferPTONStraw = Straw[BubbleJettonTransfer]{
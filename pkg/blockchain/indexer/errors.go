@@ -1,6 +1,16 @@
 package indexer
 
-import "strings"
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// errReorg means that the next masterchain block doesn't continue the chain the indexer has seen so far.
+	errReorg = errors.New("chain reorganization")
+	// errReorgTooDeep means that no recent chunk is part of the canonical chain anymore.
+	errReorgTooDeep = errors.New("chain reorganization is deeper than the indexer history")
+)
 
 func isBlockNotReadyError(err error) bool {
 	if strings.Contains(err.Error(), "ltdb: block not found") {
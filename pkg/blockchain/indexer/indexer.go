@@ -2,63 +2,190 @@ package indexer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sourcegraph/conc/iter"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/liteapi"
+	"github.com/tonkeeper/tongo/liteclient"
 	"github.com/tonkeeper/tongo/tlb"
 	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/literetry"
 )
 
 type chunk struct {
 	masterID tongo.BlockID
-	ids      map[tongo.BlockIDExt]struct{}
-	blocks   []IDandBlock
+	// masterBlockID is a full ID of the masterchain block of this chunk.
+	masterBlockID tongo.BlockIDExt
+	ids           map[tongo.BlockIDExt]struct{}
+	blocks        []IDandBlock
 }
 
+var reorgCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "indexer_chain_reorganizations",
+	Help: "Number of chain reorganizations detected by the indexer",
+})
+
+var chainResetCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "indexer_chain_resets",
+	Help: "Number of chain resets detected by the indexer, a chain reset changes the zero state of the blockchain",
+})
+
+// maxReorgDepth defines how many recent chunks the indexer keeps to be able to roll back a reorganization.
+const maxReorgDepth = 16
+
 // Indexer tracks the blockchain and notifies subscribers about new blocks.
 type Indexer struct {
 	logger *zap.Logger
 	cli    *liteapi.Client
+	// history contains recently processed chunks, the last one is the current chunk.
+	history []*chunk
+	// lagMonitor tracks how far the indexer is behind the network head.
+	lagMonitor *LagMonitor
+	// catchUpConcurrency is a number of masterchain blocks fetched concurrently in the catch-up mode.
+	catchUpConcurrency int
+	// prefetched contains masterchain blocks downloaded in advance in the catch-up mode.
+	prefetched map[uint32]masterBlock
+	// resets receives a new zero state once trackHead detects a chain reset.
+	resets chan liteclient.TonNodeZeroStateIdExtC
+	// onChainReset is called when the chain has been reset, before the indexer starts over.
+	onChainReset func()
+	// retryPolicy retries block queries failing with transient errors,
+	// so a lite server lagging by a block doesn't make the indexer refetch the whole chunk.
+	retryPolicy literetry.Policy
+}
+
+// masterBlock is a masterchain block with its full ID.
+type masterBlock struct {
+	id    tongo.BlockIDExt
+	block tlb.Block
+}
+
+type Options struct {
+	lagMonitor         *LagMonitor
+	catchUpConcurrency int
+	onChainReset       func()
+	retryPolicy        literetry.Policy
 }
 
-func New(logger *zap.Logger, cli *liteapi.Client) *Indexer {
+type Option func(o *Options)
+
+// WithLagMonitor configures a monitor to report the indexer lag to.
+func WithLagMonitor(m *LagMonitor) Option {
+	return func(o *Options) {
+		o.lagMonitor = m
+	}
+}
+
+// WithCatchUpConcurrency configures a number of masterchain blocks fetched concurrently in the catch-up mode.
+func WithCatchUpConcurrency(n int) Option {
+	return func(o *Options) {
+		o.catchUpConcurrency = n
+	}
+}
+
+// WithChainResetHandler configures a function called when the indexer detects a chain reset,
+// it happens on testnet occasionally and invalidates everything indexed before.
+// The indexer starts over from the current masterchain block once the function returns.
+func WithChainResetHandler(fn func()) Option {
+	return func(o *Options) {
+		o.onChainReset = fn
+	}
+}
+
+// WithRetryPolicy configures how block queries failing with transient errors are retried,
+// literetry.DefaultPolicy is used by default.
+func WithRetryPolicy(p literetry.Policy) Option {
+	return func(o *Options) {
+		o.retryPolicy = p
+	}
+}
+
+func New(logger *zap.Logger, cli *liteapi.Client, opts ...Option) *Indexer {
+	options := Options{
+		catchUpConcurrency: 8,
+		retryPolicy:        literetry.DefaultPolicy,
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+	if options.lagMonitor == nil {
+		options.lagMonitor = NewLagMonitor(DefaultLagThreshold)
+	}
 	return &Indexer{
-		cli:    cli,
-		logger: logger,
+		cli:                cli,
+		logger:             logger,
+		lagMonitor:         options.lagMonitor,
+		catchUpConcurrency: options.catchUpConcurrency,
+		prefetched:         map[uint32]masterBlock{},
+		resets:             make(chan liteclient.TonNodeZeroStateIdExtC, 1),
+		onChainReset:       options.onChainReset,
+		retryPolicy:        options.retryPolicy,
 	}
 }
 
+// LagMonitor returns the monitor tracking how far the indexer is behind the network head.
+func (idx *Indexer) LagMonitor() *LagMonitor {
+	return idx.lagMonitor
+}
+
 type IDandBlock struct {
 	ID    tongo.BlockIDExt
 	Block *tlb.Block
+	// Orphaned is set when the block has been previously delivered
+	// but is no longer part of the canonical chain because of a reorganization.
+	// Subscribers have to roll back everything they derived from this block.
+	Orphaned bool
 }
 
 func (idx *Indexer) Run(ctx context.Context, channels []chan IDandBlock) {
-	var chunk *chunk
+	chunk, zeroState := idx.start(ctx)
+	go idx.trackHead(ctx, zeroState)
+
 	for {
-		time.Sleep(200 * time.Millisecond)
-		info, err := idx.cli.GetMasterchainInfo(ctx)
-		if err != nil {
-			idx.logger.Error("failed to get masterchain info", zap.Error(err))
+		select {
+		case zeroState := <-idx.resets:
+			idx.logger.Error("starting over after the chain reset",
+				zap.String("zero-state-root-hash", tongo.Bits256(zeroState.RootHash).Hex()))
+			if idx.onChainReset != nil {
+				idx.onChainReset()
+			}
+			chunk, _ = idx.start(ctx)
 			continue
+		default:
 		}
-		chunk, err = idx.initChunk(info.Last.Seqno)
-		if err != nil {
-			idx.logger.Error("failed to get init chunk", zap.Error(err))
-			continue
+		if idx.lagMonitor.CatchingUp() {
+			// no waiting in the catch-up mode, we download several masterchain blocks at once instead.
+			idx.prefetch(chunk.masterID.Seqno + 1)
+		} else {
+			time.Sleep(12000 * time.Millisecond)
 		}
-		break
-	}
-
-	for {
-		// time.Sleep(500 * time.Millisecond)
-		time.Sleep(12000 * time.Millisecond)
 		next, err := idx.next(chunk)
+		if errors.Is(err, errReorg) {
+			forkPoint, orphaned, err := idx.rollback()
+			if err != nil {
+				idx.logger.Error("failed to roll back reorganization", zap.Error(err))
+				continue
+			}
+			idx.logger.Warn("chain reorganization detected",
+				zap.Uint32("fork-point", forkPoint.masterID.Seqno),
+				zap.Int("orphaned-blocks", len(orphaned)))
+			reorgCounter.Inc()
+			for _, block := range orphaned {
+				for _, ch := range channels {
+					ch <- block
+				}
+			}
+			chunk = forkPoint
+			continue
+		}
 		if err != nil {
 			if isBlockNotReadyError(err) {
 				continue
@@ -71,26 +198,208 @@ func (idx *Indexer) Run(ctx context.Context, channels []chan IDandBlock) {
 				ch <- block
 			}
 		}
+		idx.remember(next)
+		idx.lagMonitor.SetIndexed(next.masterID.Seqno)
 		chunk = next
 	}
 
 }
 
+// start initializes the indexer at the current masterchain block dropping everything it remembers.
+// It retries until it succeeds and returns the initial chunk and the zero state of the chain.
+func (idx *Indexer) start(ctx context.Context) (*chunk, liteclient.TonNodeZeroStateIdExtC) {
+	for {
+		time.Sleep(200 * time.Millisecond)
+		info, err := idx.cli.GetMasterchainInfo(ctx)
+		if err != nil {
+			idx.logger.Error("failed to get masterchain info", zap.Error(err))
+			continue
+		}
+		initial, err := idx.initChunk(info.Last.Seqno)
+		if err != nil {
+			idx.logger.Error("failed to get init chunk", zap.Error(err))
+			continue
+		}
+		idx.history = []*chunk{initial}
+		idx.prefetched = map[uint32]masterBlock{}
+		idx.lagMonitor.SetHead(info.Last.Seqno)
+		idx.lagMonitor.SetIndexed(initial.masterID.Seqno)
+		return initial, info.Init
+	}
+}
+
+// trackHead periodically reports the latest masterchain seqno to the lag monitor
+// and watches the zero state of the chain to detect chain resets.
+func (idx *Indexer) trackHead(ctx context.Context, zeroState liteclient.TonNodeZeroStateIdExtC) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			info, err := idx.cli.GetMasterchainInfo(ctx)
+			if err != nil {
+				idx.logger.Error("failed to get masterchain info", zap.Error(err))
+				continue
+			}
+			idx.lagMonitor.SetHead(info.Last.Seqno)
+			zeroState = idx.checkZeroState(zeroState, info.Init)
+		}
+	}
+}
+
+// checkZeroState compares the known zero state of the chain with the current one reported by a lite server.
+// A different zero state means the chain has been reset and nothing indexed before is valid anymore,
+// so the main loop gets notified to start over. It returns the zero state to compare with next time.
+func (idx *Indexer) checkZeroState(known, current liteclient.TonNodeZeroStateIdExtC) liteclient.TonNodeZeroStateIdExtC {
+	if known == current {
+		return known
+	}
+	idx.logger.Error("CHAIN RESET DETECTED: the zero state of the blockchain has changed, all indexed data is stale",
+		zap.String("old-zero-state-root-hash", tongo.Bits256(known.RootHash).Hex()),
+		zap.String("new-zero-state-root-hash", tongo.Bits256(current.RootHash).Hex()))
+	chainResetCounter.Inc()
+	select {
+	case <-idx.resets:
+		// a reset hasn't been handled yet, the latest zero state is enough.
+	default:
+	}
+	idx.resets <- current
+	return current
+}
+
+// prefetch concurrently downloads masterchain blocks starting from the given seqno.
+// Blocks that fail to download are fetched again by next().
+func (idx *Indexer) prefetch(seqno uint32) {
+	var seqnos []uint32
+	for i := 0; i < idx.catchUpConcurrency; i++ {
+		if _, ok := idx.prefetched[seqno+uint32(i)]; !ok {
+			seqnos = append(seqnos, seqno+uint32(i))
+		}
+	}
+	blocks := iter.Map[uint32, *masterBlock](seqnos, func(seqno *uint32) *masterBlock {
+		block, err := idx.fetchMasterBlock(tongo.BlockID{Workchain: -1, Shard: 0x8000000000000000, Seqno: *seqno})
+		if err != nil {
+			return nil
+		}
+		return block
+	})
+	for i, block := range blocks {
+		if block != nil {
+			idx.prefetched[seqnos[i]] = *block
+		}
+	}
+}
+
+func (idx *Indexer) fetchMasterBlock(id tongo.BlockID) (*masterBlock, error) {
+	blockID, err := literetry.Do(context.Background(), idx.retryPolicy, "lookup_block", func() (tongo.BlockIDExt, error) {
+		blockID, _, err := idx.cli.LookupBlock(context.Background(), id, 1, nil, nil)
+		return blockID, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	block, err := idx.getBlock(blockID)
+	if err != nil {
+		return nil, err
+	}
+	return &masterBlock{id: blockID, block: block}, nil
+}
+
+// remember appends a chunk to the history keeping at most maxReorgDepth chunks.
+func (idx *Indexer) remember(c *chunk) {
+	idx.history = append(idx.history, c)
+	if len(idx.history) > maxReorgDepth {
+		idx.history = idx.history[len(idx.history)-maxReorgDepth:]
+	}
+}
+
+// rollback finds the latest chunk that is still part of the canonical chain,
+// drops all chunks after it from the history and returns their blocks marked as orphaned, newest first.
+func (idx *Indexer) rollback() (*chunk, []IDandBlock, error) {
+	// prefetched blocks might belong to the orphaned branch.
+	idx.prefetched = map[uint32]masterBlock{}
+	forkIndex, err := findForkPoint(idx.history, func(id tongo.BlockID) (tongo.BlockIDExt, error) {
+		ext, _, err := idx.cli.LookupBlock(context.Background(), id, 1, nil, nil)
+		return ext, err
+	})
+	if errors.Is(err, errReorgTooDeep) {
+		// nothing we remember is canonical anymore, so we start over from the current masterchain block.
+		info, err := idx.cli.GetMasterchainInfo(context.Background())
+		if err != nil {
+			return nil, nil, err
+		}
+		fresh, err := idx.initChunk(info.Last.Seqno)
+		if err != nil {
+			return nil, nil, err
+		}
+		orphaned := orphanedBlocks(idx.history)
+		idx.history = []*chunk{fresh}
+		return fresh, orphaned, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	orphaned := orphanedBlocks(idx.history[forkIndex+1:])
+	idx.history = idx.history[:forkIndex+1]
+	return idx.history[forkIndex], orphaned, nil
+}
+
+// findForkPoint returns an index of the latest chunk in the history
+// whose masterchain block matches the canonical one.
+func findForkPoint(history []*chunk, canonical func(id tongo.BlockID) (tongo.BlockIDExt, error)) (int, error) {
+	for i := len(history) - 1; i >= 0; i-- {
+		id, err := canonical(history[i].masterID)
+		if err != nil {
+			return 0, err
+		}
+		if id == history[i].masterBlockID {
+			return i, nil
+		}
+	}
+	return 0, errReorgTooDeep
+}
+
+// orphanedBlocks returns blocks of the given chunks in reverse order marked as orphaned.
+func orphanedBlocks(chunks []*chunk) []IDandBlock {
+	var blocks []IDandBlock
+	for i := len(chunks) - 1; i >= 0; i-- {
+		for j := len(chunks[i].blocks) - 1; j >= 0; j-- {
+			block := chunks[i].blocks[j]
+			block.Orphaned = true
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
 func (idx *Indexer) next(prevChunk *chunk) (*chunk, error) {
 	nextMasterID := prevChunk.masterID
 	nextMasterID.Seqno += 1
-	masterBlockID, _, err := idx.cli.LookupBlock(context.Background(), nextMasterID, 1, nil, nil)
-	if err != nil {
-		return nil, err
+	next, ok := idx.prefetched[nextMasterID.Seqno]
+	if ok {
+		delete(idx.prefetched, nextMasterID.Seqno)
+	} else {
+		fetched, err := idx.fetchMasterBlock(nextMasterID)
+		if err != nil {
+			return nil, err
+		}
+		next = *fetched
 	}
-	masterBlock, err := idx.cli.GetBlock(context.Background(), masterBlockID)
+	masterBlockID, masterBlock := next.id, next.block
+	masterParents, err := tongo.GetParents(masterBlock.Info)
 	if err != nil {
 		return nil, err
 	}
+	if len(masterParents) > 0 && masterParents[0] != prevChunk.masterBlockID {
+		return nil, errReorg
+	}
 	shards := tongo.ShardIDs(&masterBlock)
 	currentChunk := chunk{
-		masterID: nextMasterID,
-		ids:      make(map[tongo.BlockIDExt]struct{}, len(shards)+1),
+		masterID:      nextMasterID,
+		masterBlockID: masterBlockID,
+		ids:           make(map[tongo.BlockIDExt]struct{}, len(shards)+1),
 	}
 	for _, shardID := range shards {
 		currentChunk.ids[shardID] = struct{}{}
@@ -108,7 +417,7 @@ func (idx *Indexer) next(prevChunk *chunk) (*chunk, error) {
 			if _, ok := prevChunk.ids[*t]; ok {
 				return nil, nil
 			}
-			block, err := idx.cli.GetBlock(context.Background(), *t)
+			block, err := idx.getBlock(*t)
 			if err != nil {
 				if strings.Contains(err.Error(), "not in db") {
 					return nil, nil
@@ -158,6 +467,12 @@ func (idx *Indexer) next(prevChunk *chunk) (*chunk, error) {
 	return &currentChunk, nil
 }
 
+func (idx *Indexer) getBlock(id tongo.BlockIDExt) (tlb.Block, error) {
+	return literetry.Do(context.Background(), idx.retryPolicy, "get_block", func() (tlb.Block, error) {
+		return idx.cli.GetBlock(context.Background(), id)
+	})
+}
+
 func (idx *Indexer) initChunk(seqno uint32) (*chunk, error) {
 	init := tongo.BlockID{
 		Workchain: -1,
@@ -173,7 +488,8 @@ func (idx *Indexer) initChunk(seqno uint32) (*chunk, error) {
 		return nil, err
 	}
 	ch := &chunk{
-		masterID: init,
+		masterID:      init,
+		masterBlockID: id,
 		ids: map[tongo.BlockIDExt]struct{}{
 			id: {},
 		},
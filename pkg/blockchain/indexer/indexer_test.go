@@ -0,0 +1,118 @@
+package indexer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/liteclient"
+	"go.uber.org/zap"
+)
+
+func testChunk(seqno uint32, hash byte) *chunk {
+	masterID := tongo.BlockID{Workchain: -1, Shard: 0x8000000000000000, Seqno: seqno}
+	masterBlockID := tongo.BlockIDExt{BlockID: masterID}
+	masterBlockID.RootHash[0] = hash
+	return &chunk{
+		masterID:      masterID,
+		masterBlockID: masterBlockID,
+		blocks: []IDandBlock{
+			{ID: tongo.BlockIDExt{BlockID: tongo.BlockID{Workchain: 0, Seqno: seqno * 10}}},
+			{ID: masterBlockID},
+		},
+	}
+}
+
+func Test_findForkPoint(t *testing.T) {
+	history := []*chunk{testChunk(1, 1), testChunk(2, 2), testChunk(3, 3)}
+	tests := []struct {
+		name      string
+		canonical map[uint32]byte
+		want      int
+		wantErr   error
+	}{
+		{
+			name:      "last chunk is canonical",
+			canonical: map[uint32]byte{1: 1, 2: 2, 3: 3},
+			want:      2,
+		},
+		{
+			name:      "last chunk is orphaned",
+			canonical: map[uint32]byte{1: 1, 2: 2, 3: 30},
+			want:      1,
+		},
+		{
+			name:      "two chunks are orphaned",
+			canonical: map[uint32]byte{1: 1, 2: 20, 3: 30},
+			want:      0,
+		},
+		{
+			name:      "too deep",
+			canonical: map[uint32]byte{1: 10, 2: 20, 3: 30},
+			wantErr:   errReorgTooDeep,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findForkPoint(history, func(id tongo.BlockID) (tongo.BlockIDExt, error) {
+				hash, ok := tt.canonical[id.Seqno]
+				if !ok {
+					return tongo.BlockIDExt{}, fmt.Errorf("unknown seqno %v", id.Seqno)
+				}
+				ext := tongo.BlockIDExt{BlockID: id}
+				ext.RootHash[0] = hash
+				return ext, nil
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_orphanedBlocks(t *testing.T) {
+	blocks := orphanedBlocks([]*chunk{testChunk(2, 2), testChunk(3, 3)})
+	var seqnos []uint32
+	for _, block := range blocks {
+		require.True(t, block.Orphaned)
+		seqnos = append(seqnos, block.ID.Seqno)
+	}
+	require.Equal(t, []uint32{3, 30, 2, 20}, seqnos)
+}
+
+func TestIndexer_remember(t *testing.T) {
+	idx := &Indexer{}
+	for i := 0; i < maxReorgDepth+5; i++ {
+		idx.remember(testChunk(uint32(i), byte(i)))
+	}
+	require.Equal(t, maxReorgDepth, len(idx.history))
+	require.Equal(t, uint32(maxReorgDepth+4), idx.history[len(idx.history)-1].masterID.Seqno)
+}
+
+func TestIndexer_checkZeroState(t *testing.T) {
+	idx := &Indexer{
+		logger: zap.NewNop(),
+		resets: make(chan liteclient.TonNodeZeroStateIdExtC, 1),
+	}
+	zeroState := func(hash byte) liteclient.TonNodeZeroStateIdExtC {
+		state := liteclient.TonNodeZeroStateIdExtC{Workchain: 0xffffffff}
+		state.RootHash[0] = hash
+		state.FileHash[0] = hash
+		return state
+	}
+	known := idx.checkZeroState(zeroState(1), zeroState(1))
+	require.Equal(t, zeroState(1), known)
+	require.Len(t, idx.resets, 0)
+
+	known = idx.checkZeroState(known, zeroState(2))
+	require.Equal(t, zeroState(2), known)
+	// the second reset replaces the one that hasn't been handled yet.
+	known = idx.checkZeroState(known, zeroState(3))
+	require.Equal(t, zeroState(3), known)
+	require.Len(t, idx.resets, 1)
+	require.Equal(t, zeroState(3), <-idx.resets)
+}
@@ -0,0 +1,85 @@
+package indexer
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	masterchainLagGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "indexer_masterchain_lag",
+		Help: "Number of masterchain blocks the indexer is behind the network head",
+	})
+	catchUpModeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "indexer_catch_up_mode",
+		Help: "1 if the indexer is catching up with the network head, 0 otherwise",
+	})
+)
+
+// DefaultLagThreshold is a number of masterchain blocks
+// the indexer is allowed to be behind the network head before it switches to the catch-up mode.
+const DefaultLagThreshold = 10
+
+// LagMonitor measures how far the indexer is behind the network head.
+//
+// When the lag exceeds the threshold, the indexer switches to the catch-up mode:
+// it fetches several masterchain blocks concurrently and doesn't wait between chunks.
+// Other components can check CatchingUp to temporarily skip expensive enrichment.
+type LagMonitor struct {
+	threshold uint32
+	head      atomic.Uint32
+	indexed   atomic.Uint32
+}
+
+func NewLagMonitor(threshold uint32) *LagMonitor {
+	return &LagMonitor{threshold: threshold}
+}
+
+// SetHead records the latest masterchain seqno known to the network.
+func (m *LagMonitor) SetHead(seqno uint32) {
+	m.head.Store(seqno)
+	m.updateMetrics()
+}
+
+// SetIndexed records the latest masterchain seqno processed by the indexer.
+func (m *LagMonitor) SetIndexed(seqno uint32) {
+	m.indexed.Store(seqno)
+	m.updateMetrics()
+}
+
+// Lag returns a number of masterchain blocks the indexer is behind the network head.
+func (m *LagMonitor) Lag() uint32 {
+	head, indexed := m.head.Load(), m.indexed.Load()
+	if indexed == 0 || head <= indexed {
+		return 0
+	}
+	return head - indexed
+}
+
+// CatchingUp returns true if the lag exceeds the threshold.
+func (m *LagMonitor) CatchingUp() bool {
+	return m.Lag() > m.threshold
+}
+
+// Ready returns an error if the indexer hasn't started yet or is catching up with the network head.
+func (m *LagMonitor) Ready() error {
+	if m.indexed.Load() == 0 {
+		return fmt.Errorf("indexer hasn't processed any block yet")
+	}
+	if m.CatchingUp() {
+		return fmt.Errorf("indexer is %v masterchain blocks behind the network head", m.Lag())
+	}
+	return nil
+}
+
+func (m *LagMonitor) updateMetrics() {
+	masterchainLagGauge.Set(float64(m.Lag()))
+	if m.CatchingUp() {
+		catchUpModeGauge.Set(1)
+	} else {
+		catchUpModeGauge.Set(0)
+	}
+}
@@ -0,0 +1,59 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLagMonitor(t *testing.T) {
+	tests := []struct {
+		name           string
+		head           uint32
+		indexed        uint32
+		wantLag        uint32
+		wantCatchingUp bool
+		wantReady      bool
+	}{
+		{
+			name:      "not started",
+			head:      100,
+			wantReady: false,
+		},
+		{
+			name:      "up to date",
+			head:      100,
+			indexed:   100,
+			wantReady: true,
+		},
+		{
+			name:      "head is not known yet",
+			indexed:   100,
+			wantReady: true,
+		},
+		{
+			name:      "small lag",
+			head:      105,
+			indexed:   100,
+			wantLag:   5,
+			wantReady: true,
+		},
+		{
+			name:           "catching up",
+			head:           150,
+			indexed:        100,
+			wantLag:        50,
+			wantCatchingUp: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewLagMonitor(10)
+			m.SetHead(tt.head)
+			m.SetIndexed(tt.indexed)
+			require.Equal(t, tt.wantLag, m.Lag())
+			require.Equal(t, tt.wantCatchingUp, m.CatchingUp())
+			require.Equal(t, tt.wantReady, m.Ready() == nil)
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/tonkeeper/tongo/config"
 	"github.com/tonkeeper/tongo/liteapi"
 	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/scheduler"
 )
 
 const ttl = 5 * 60 // in seconds
@@ -58,7 +60,24 @@ func (m *ExtInMsgCopy) IsEmulation() bool {
 	return len(m.Accounts) > 0
 }
 
-func NewMsgSender(logger *zap.Logger, servers []config.LiteServer, receivers map[string]chan<- ExtInMsgCopy) (*MsgSender, error) {
+type Options struct {
+	scheduler *scheduler.Scheduler
+}
+
+type Option func(o *Options)
+
+// WithScheduler runs rebroadcasting of batches of messages as the "message_rebroadcast" job of the scheduler.
+func WithScheduler(s *scheduler.Scheduler) Option {
+	return func(o *Options) {
+		o.scheduler = s
+	}
+}
+
+func NewMsgSender(logger *zap.Logger, servers []config.LiteServer, receivers map[string]chan<- ExtInMsgCopy, opts ...Option) (*MsgSender, error) {
+	options := Options{}
+	for _, o := range opts {
+		o(&options)
+	}
 	var (
 		client  *liteapi.Client
 		clients []*liteapi.Client
@@ -97,13 +116,20 @@ func NewMsgSender(logger *zap.Logger, servers []config.LiteServer, receivers map
 		logger:         logger,
 		receivers:      receivers,
 	}
-	go func() {
-		for {
+	job := scheduler.Job{
+		Name:     "message_rebroadcast",
+		Interval: time.Second * 5,
+		Run: func(ctx context.Context) error {
 			msgSender.dropExpiredBatches()
 			msgSender.sendBatches()
-			time.Sleep(time.Second * 5)
-		}
-	}()
+			return nil
+		},
+	}
+	if options.scheduler != nil {
+		options.scheduler.Add(job)
+	} else {
+		go scheduler.RunJob(context.Background(), logger, job)
+	}
 	return msgSender, nil
 }
 
@@ -48,6 +48,13 @@ func (c *Cache[K, V]) Delete(key K) {
 	c.cache.Delete(key)
 }
 
+// Clear deletes all keys of the cache.
+func (c *Cache[K, V]) Clear() {
+	for _, key := range c.cache.Keys() {
+		c.cache.Delete(key)
+	}
+}
+
 // Keys returns the keys of the cache. the order is relied on algorithms.
 func (c *Cache[K, V]) Keys() []K {
 	return c.cache.Keys()
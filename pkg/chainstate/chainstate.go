@@ -3,6 +3,7 @@ package chainstate
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -10,6 +11,9 @@ import (
 
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/ton"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/scheduler"
 )
 
 type ChainState struct {
@@ -27,22 +31,44 @@ func (s *ChainState) GetAPY() float64 {
 	return s.apy
 }
 
-func NewChainState(c config) *ChainState {
+type Options struct {
+	scheduler *scheduler.Scheduler
+}
+
+type Option func(o *Options)
+
+// WithScheduler runs the refresh of the chain state as the "chain_state_refresh" job of the scheduler.
+func WithScheduler(s *scheduler.Scheduler) Option {
+	return func(o *Options) {
+		o.scheduler = s
+	}
+}
+
+func NewChainState(c config, opts ...Option) *ChainState {
+	options := Options{}
+	for _, o := range opts {
+		o(&options)
+	}
 	chain := &ChainState{apy: 3.3, banned: map[tongo.AccountID]struct{}{}, config: c}
 
-	go func() {
-		for {
-			chain.refresh()
-			time.Sleep(time.Minute * 30)
-		}
-	}()
+	job := scheduler.Job{
+		Name:      "chain_state_refresh",
+		Interval:  time.Minute * 30,
+		Immediate: true,
+		Run:       chain.refresh,
+	}
+	if options.scheduler != nil {
+		options.scheduler.Add(job)
+	} else {
+		go scheduler.RunJob(context.Background(), zap.L(), job)
+	}
 
 	return chain
 }
 
-func (s *ChainState) refresh() {
+func (s *ChainState) refresh(ctx context.Context) error {
 	apy, err1 := apyFromWhales()
-	banned, err2 := suspended(s.config)
+	banned, err2 := suspended(ctx, s.config)
 	s.mu.Lock()
 	if err1 == nil {
 		s.apy = apy
@@ -52,10 +78,11 @@ func (s *ChainState) refresh() {
 	}
 
 	s.mu.Unlock()
+	return errors.Join(err1, err2)
 }
 
-func suspended(conf config) (map[tongo.AccountID]struct{}, error) {
-	cfg, err := conf.GetLastConfig(context.TODO())
+func suspended(ctx context.Context, conf config) (map[tongo.AccountID]struct{}, error) {
+	cfg, err := conf.GetLastConfig(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+)
+
+func TestDefault(t *testing.T) {
+	t.Setenv("PORT", "9999")
+
+	c := Default()
+	require.Equal(t, 8081, c.API.Port)
+	require.Equal(t, "INFO", c.App.LogLevel)
+	require.Equal(t, 9010, c.App.MetricsPort)
+	require.Equal(t, "numbers.txt", c.App.AccountsFile)
+	require.False(t, c.App.IsTestnet)
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("PORT", "9999")
+	t.Setenv("LOG_LEVEL", "DEBUG")
+	t.Setenv("ACCOUNTS_FILE", "not-existing-file.txt")
+	t.Setenv("ACCOUNTS", "0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+
+	c, err := FromEnv()
+	require.Nil(t, err)
+	require.Equal(t, 9999, c.API.Port)
+	require.Equal(t, "DEBUG", c.App.LogLevel)
+	require.Equal(t, []tongo.AccountID{
+		tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351"),
+	}, []tongo.AccountID(c.App.Accounts))
+
+	t.Setenv("PORT", "not-a-number")
+	_, err = FromEnv()
+	require.NotNil(t, err)
+}
+
+func TestFromEnv_SchedulerIntervals(t *testing.T) {
+	t.Setenv("ACCOUNTS_FILE", "not-existing-file.txt")
+	t.Setenv("SCHEDULER_INTERVALS", "addressbook_sync=5m, retention_prune=0s")
+
+	c, err := FromEnv()
+	require.Nil(t, err)
+	require.Equal(t, jobIntervals{"addressbook_sync": 5 * time.Minute, "retention_prune": 0}, c.Scheduler.Intervals)
+
+	t.Setenv("SCHEDULER_INTERVALS", "addressbook_sync")
+	_, err = FromEnv()
+	require.NotNil(t, err)
+}
+
+func TestConfig_With(t *testing.T) {
+	account := tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+	base := Default()
+	c := base.With(
+		WithPort(8000),
+		WithLogLevel("WARN"),
+		WithAccounts(account),
+		WithTestnet(true),
+	)
+	require.Equal(t, 8000, c.API.Port)
+	require.Equal(t, "WARN", c.App.LogLevel)
+	require.Equal(t, []tongo.AccountID{account}, []tongo.AccountID(c.App.Accounts))
+	require.True(t, c.App.IsTestnet)
+	// the original configuration stays untouched.
+	require.Equal(t, 8081, base.API.Port)
+}
+
+func TestFromEnv_PriorityAccounts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "accounts.txt")
+	content := "0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351,priority=high\n" +
+		"0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580352,label=users\n" +
+		"0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580353\n"
+	require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+	t.Setenv("ACCOUNTS_FILE", path)
+	t.Setenv("PRIORITY_ACCOUNTS", "0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580353")
+
+	c, err := FromEnv()
+	require.Nil(t, err)
+	require.Len(t, c.App.Accounts, 3)
+	require.Equal(t, []tongo.AccountID{
+		tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580353"),
+		tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351"),
+	}, []tongo.AccountID(c.App.PriorityAccounts))
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/config"
+)
+
+// Override changes a single setting of Config.
+// Overrides let an application construct the configuration in code,
+// for example, from its own flags, on top of Default() or FromEnv().
+type Override func(c *Config)
+
+// With returns a copy of the configuration with the given overrides applied in order.
+func (c Config) With(overrides ...Override) Config {
+	for _, o := range overrides {
+		o(&c)
+	}
+	return c
+}
+
+func WithPort(port int) Override {
+	return func(c *Config) {
+		c.API.Port = port
+	}
+}
+
+func WithUnixSockets(sockets ...string) Override {
+	return func(c *Config) {
+		c.API.UnixSockets = sockets
+	}
+}
+
+func WithLogLevel(level string) Override {
+	return func(c *Config) {
+		c.App.LogLevel = level
+	}
+}
+
+func WithMetricsPort(port int) Override {
+	return func(c *Config) {
+		c.App.MetricsPort = port
+	}
+}
+
+// WithAccounts sets a list of accounts to watch for.
+func WithAccounts(accounts ...tongo.AccountID) Override {
+	return func(c *Config) {
+		c.App.Accounts = accounts
+	}
+}
+
+// WithPriorityAccounts sets a list of watched accounts whose notifications are dispatched from a dedicated queue.
+func WithPriorityAccounts(accounts ...tongo.AccountID) Override {
+	return func(c *Config) {
+		c.App.PriorityAccounts = accounts
+	}
+}
+
+func WithAccountsFile(path string) Override {
+	return func(c *Config) {
+		c.App.AccountsFile = path
+	}
+}
+
+func WithLiteServers(servers ...config.LiteServer) Override {
+	return func(c *Config) {
+		c.App.LiteServers = servers
+	}
+}
+
+func WithSendingLiteServers(servers ...config.LiteServer) Override {
+	return func(c *Config) {
+		c.App.SendingLiteservers = servers
+	}
+}
+
+func WithTestnet(isTestnet bool) Override {
+	return func(c *Config) {
+		c.App.IsTestnet = isTestnet
+	}
+}
+
+func WithTonConnectSecret(secret string) Override {
+	return func(c *Config) {
+		c.TonConnect.Secret = secret
+	}
+}
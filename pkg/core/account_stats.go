@@ -0,0 +1,171 @@
+package core
+
+import (
+	"sync"
+
+	"github.com/tonkeeper/tongo"
+)
+
+const (
+	secondsInHour = 60 * 60
+	secondsInDay  = 24 * secondsInHour
+)
+
+// Sizes of buckets of an activity heatmap in seconds.
+const (
+	HourlyBuckets int64 = secondsInHour
+	DailyBuckets  int64 = secondsInDay
+)
+
+// ActivityBucket is a number of transactions of an account with utime within [Start, Start+bucket size).
+type ActivityBucket struct {
+	Start        int64
+	Transactions int64
+}
+
+// AccountStats aggregates activity of an account over a time window.
+// All amounts are in nanotons.
+type AccountStats struct {
+	TransactionsCount int64
+	// FirstActivity and LastActivity are unix timestamps of the first and the last transaction in the window.
+	FirstActivity int64
+	LastActivity  int64
+	Received      int64
+	Sent          int64
+	Fees          int64
+}
+
+// AccountActivity maintains daily AccountStats and hourly numbers of transactions of an account incrementally,
+// so stats over any window can be obtained without going through the account's history.
+type AccountActivity struct {
+	mu   sync.Mutex
+	days map[int64]*AccountStats
+	// hours contains numbers of transactions by hours since the epoch.
+	hours map[int64]int64
+}
+
+func NewAccountActivity() *AccountActivity {
+	return &AccountActivity{days: map[int64]*AccountStats{}, hours: map[int64]int64{}}
+}
+
+// Add takes the given transaction into account.
+// The caller is responsible for adding each transaction only once.
+func (a *AccountActivity) Add(tx *Transaction) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	day := tx.Utime / secondsInDay
+	stats, ok := a.days[day]
+	if !ok {
+		stats = &AccountStats{FirstActivity: tx.Utime, LastActivity: tx.Utime}
+		a.days[day] = stats
+	}
+	received, sent := transactionValueFlow(tx)
+	stats.TransactionsCount += 1
+	a.hours[tx.Utime/secondsInHour] += 1
+	stats.Received += received
+	stats.Sent += sent
+	stats.Fees += tx.TotalFee
+	if tx.Utime < stats.FirstActivity {
+		stats.FirstActivity = tx.Utime
+	}
+	if tx.Utime > stats.LastActivity {
+		stats.LastActivity = tx.Utime
+	}
+}
+
+// Remove rolls back a transaction previously passed to Add.
+// Activity timestamps are not rolled back.
+func (a *AccountActivity) Remove(tx *Transaction) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	day := tx.Utime / secondsInDay
+	stats, ok := a.days[day]
+	if !ok {
+		return
+	}
+	received, sent := transactionValueFlow(tx)
+	stats.TransactionsCount -= 1
+	hour := tx.Utime / secondsInHour
+	if a.hours[hour] -= 1; a.hours[hour] <= 0 {
+		delete(a.hours, hour)
+	}
+	stats.Received -= received
+	stats.Sent -= sent
+	stats.Fees -= tx.TotalFee
+	if stats.TransactionsCount <= 0 {
+		delete(a.days, day)
+	}
+}
+
+// Stats returns aggregated stats of transactions with utime >= since.
+// Stats are kept with a daily granularity, so the window is extended to the beginning of the day of since.
+func (a *AccountActivity) Stats(since int64) AccountStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var result AccountStats
+	sinceDay := since / secondsInDay
+	for day, stats := range a.days {
+		if day < sinceDay {
+			continue
+		}
+		result.TransactionsCount += stats.TransactionsCount
+		result.Received += stats.Received
+		result.Sent += stats.Sent
+		result.Fees += stats.Fees
+		if result.FirstActivity == 0 || stats.FirstActivity < result.FirstActivity {
+			result.FirstActivity = stats.FirstActivity
+		}
+		if stats.LastActivity > result.LastActivity {
+			result.LastActivity = stats.LastActivity
+		}
+	}
+	return result
+}
+
+// Heatmap returns numbers of transactions in consecutive buckets of the given size covering [from, to),
+// including empty ones. The size is either HourlyBuckets or DailyBuckets, from is clamped at 0 and aligned down to the size,
+// buckets are in UTC.
+func (a *AccountActivity) Heatmap(from, to, size int64) []ActivityBucket {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	from = max(from, 0)
+	from -= from % size
+	buckets := make([]ActivityBucket, 0, max(to-from+size-1, 0)/size)
+	for start := from; start < to; start += size {
+		bucket := ActivityBucket{Start: start}
+		if size == DailyBuckets {
+			if stats, ok := a.days[start/secondsInDay]; ok {
+				bucket.Transactions = stats.TransactionsCount
+			}
+		} else {
+			bucket.Transactions = a.hours[start/secondsInHour]
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// transactionValueFlow returns TON received with the inbound message and sent with outbound messages.
+func transactionValueFlow(tx *Transaction) (received int64, sent int64) {
+	if tx.InMsg != nil && tx.InMsg.MsgType == IntMsg {
+		received = tx.InMsg.Value
+	}
+	for _, msg := range tx.OutMsgs {
+		sent += msg.Value
+	}
+	return received, sent
+}
+
+// LeaderboardEntry describes a tracked account in top accounts leaderboards.
+type LeaderboardEntry struct {
+	Account tongo.AccountID
+	Balance int64
+	// Transactions7d is a number of the account's transactions during the last 7 days.
+	Transactions7d int64
+}
+
+// Leaderboard is a periodically refreshed snapshot of tracked accounts.
+type Leaderboard struct {
+	UpdatedAt int64
+	Entries   []LeaderboardEntry
+}
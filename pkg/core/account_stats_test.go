@@ -0,0 +1,109 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountActivity(t *testing.T) {
+	const day = int64(secondsInDay)
+	incoming := &Transaction{Utime: 10 * day, TotalFee: 10, InMsg: &Message{MsgType: IntMsg, Value: 1000}}
+	outgoing := &Transaction{Utime: 12*day + 100, TotalFee: 20, InMsg: &Message{MsgType: ExtInMsg}, OutMsgs: []Message{{Value: 300}, {Value: 200}}}
+	later := &Transaction{Utime: 12*day + 500, TotalFee: 30, InMsg: &Message{MsgType: IntMsg, Value: 50}}
+
+	activity := NewAccountActivity()
+	activity.Add(incoming)
+	activity.Add(outgoing)
+	activity.Add(later)
+
+	tests := []struct {
+		name  string
+		since int64
+		want  AccountStats
+	}{
+		{
+			name: "all",
+			want: AccountStats{TransactionsCount: 3, FirstActivity: 10 * day, LastActivity: 12*day + 500, Received: 1050, Sent: 500, Fees: 60},
+		},
+		{
+			name:  "last day",
+			since: 12*day + 400,
+			want:  AccountStats{TransactionsCount: 2, FirstActivity: 12*day + 100, LastActivity: 12*day + 500, Received: 50, Sent: 500, Fees: 50},
+		},
+		{
+			name:  "future",
+			since: 13 * day,
+			want:  AccountStats{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, activity.Stats(tt.since))
+		})
+	}
+
+	activity.Remove(later)
+	require.Equal(t, AccountStats{TransactionsCount: 1, FirstActivity: 12*day + 100, LastActivity: 12*day + 500, Sent: 500, Fees: 20}, activity.Stats(12*day))
+	activity.Remove(outgoing)
+	require.Equal(t, AccountStats{}, activity.Stats(12*day))
+}
+
+func TestAccountActivity_Heatmap(t *testing.T) {
+	const day = int64(secondsInDay)
+	const hour = int64(secondsInHour)
+	activity := NewAccountActivity()
+	for _, utime := range []int64{10 * day, 10*day + 100, 10*day + 2*hour, 12*day + 5*hour} {
+		activity.Add(&Transaction{Utime: utime})
+	}
+	tests := []struct {
+		name     string
+		from, to int64
+		size     int64
+		want     []ActivityBucket
+	}{
+		{
+			name: "daily",
+			from: 10*day + 500,
+			to:   13 * day,
+			size: DailyBuckets,
+			want: []ActivityBucket{{Start: 10 * day, Transactions: 3}, {Start: 11 * day}, {Start: 12 * day, Transactions: 1}},
+		},
+		{
+			name: "hourly",
+			from: 10 * day,
+			to:   10*day + 2*hour + 1,
+			size: HourlyBuckets,
+			want: []ActivityBucket{{Start: 10 * day, Transactions: 2}, {Start: 10*day + hour}, {Start: 10*day + 2*hour, Transactions: 1}},
+		},
+		{
+			name: "before the epoch",
+			from: -hour - 1,
+			to:   hour,
+			size: HourlyBuckets,
+			want: []ActivityBucket{{Start: 0}},
+		},
+		{
+			name: "range before the epoch",
+			from: -2 * hour,
+			to:   -hour,
+			size: HourlyBuckets,
+			want: []ActivityBucket{},
+		},
+		{
+			name: "empty range",
+			from: 10 * day,
+			to:   10 * day,
+			size: HourlyBuckets,
+			want: []ActivityBucket{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, activity.Heatmap(tt.from, tt.to, tt.size))
+		})
+	}
+
+	activity.Remove(&Transaction{Utime: 10*day + 100})
+	require.Equal(t, []ActivityBucket{{Start: 10 * day, Transactions: 1}}, activity.Heatmap(10*day, 10*day+hour, HourlyBuckets))
+}
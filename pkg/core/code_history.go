@@ -0,0 +1,115 @@
+package core
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+)
+
+// CodeVersion is a code of an account installed by a transaction.
+type CodeVersion struct {
+	TransactionID
+	BlockID tongo.BlockIDExt
+	Utime   int64
+	// CodeHash is a hash of the root cell of Code.
+	CodeHash tongo.Bits256
+	// Code and Data are BOCs of the account's state at the end of the block of the transaction.
+	Code []byte
+	Data []byte
+}
+
+// MayChangeCode reports whether the given transaction may have changed the code of its account:
+// it either activates the account or performs special actions, one of which can be set_code.
+func MayChangeCode(tx *tlb.Transaction) bool {
+	if tx.OrigStatus != tlb.AccountActive && tx.EndStatus == tlb.AccountActive {
+		return true
+	}
+	var action tlb.Maybe[tlb.Ref[tlb.TrActionPhase]]
+	switch tx.Description.SumType {
+	case "TransOrd":
+		action = tx.Description.TransOrd.Action
+	case "TransTickTock":
+		action = tx.Description.TransTickTock.Action
+	default:
+		return false
+	}
+	return action.Exists && action.Value.Value.Success && action.Value.Value.SpecActions > 0
+}
+
+type codeCandidate struct {
+	tx *Transaction
+	// version is the code after the transaction, it is nil until resolved.
+	version *CodeVersion
+}
+
+// CodeHistory keeps transactions of an account that may have changed its code,
+// so the account's code history can be restored without going through all of its transactions.
+type CodeHistory struct {
+	mu         sync.Mutex
+	candidates map[tongo.Bits256]*codeCandidate
+}
+
+func NewCodeHistory() *CodeHistory {
+	return &CodeHistory{candidates: map[tongo.Bits256]*codeCandidate{}}
+}
+
+// Add remembers a transaction which may have changed the code.
+func (h *CodeHistory) Add(tx *Transaction) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.candidates[tx.Hash]; !ok {
+		h.candidates[tx.Hash] = &codeCandidate{tx: tx}
+	}
+}
+
+// Remove rolls back a transaction previously passed to Add.
+func (h *CodeHistory) Remove(tx *Transaction) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.candidates, tx.Hash)
+}
+
+// Resolve saves the code installed by the given transaction, so it isn't requested again.
+func (h *CodeHistory) Resolve(hash tongo.Bits256, version CodeVersion) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if candidate, ok := h.candidates[hash]; ok {
+		candidate.version = &version
+	}
+}
+
+// Candidates returns transactions which may have changed the code ordered by lt
+// along with the code after each of them, if it has been resolved.
+func (h *CodeHistory) Candidates() ([]*Transaction, []*CodeVersion) {
+	h.mu.Lock()
+	candidates := make([]codeCandidate, 0, len(h.candidates))
+	for _, candidate := range h.candidates {
+		candidates = append(candidates, *candidate)
+	}
+	h.mu.Unlock()
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].tx.Lt < candidates[j].tx.Lt
+	})
+	txs := make([]*Transaction, 0, len(candidates))
+	versions := make([]*CodeVersion, 0, len(candidates))
+	for _, candidate := range candidates {
+		txs = append(txs, candidate.tx)
+		versions = append(versions, candidate.version)
+	}
+	return txs, versions
+}
+
+// CodeUpgrades leaves versions that differ from the previous ones,
+// so candidates that haven't changed the code are dropped.
+func CodeUpgrades(versions []CodeVersion) []CodeVersion {
+	var upgrades []CodeVersion
+	for _, version := range versions {
+		if len(upgrades) > 0 && upgrades[len(upgrades)-1].CodeHash == version.CodeHash {
+			continue
+		}
+		upgrades = append(upgrades, version)
+	}
+	return upgrades
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+)
+
+func TestMayChangeCode(t *testing.T) {
+	ordinary := func(action *tlb.TrActionPhase) *tlb.Transaction {
+		tx := &tlb.Transaction{OrigStatus: tlb.AccountActive, EndStatus: tlb.AccountActive}
+		tx.Description.SumType = "TransOrd"
+		if action != nil {
+			tx.Description.TransOrd.Action.Exists = true
+			tx.Description.TransOrd.Action.Value.Value = *action
+		}
+		return tx
+	}
+	deploy := ordinary(nil)
+	deploy.OrigStatus = tlb.AccountUninit
+	tests := []struct {
+		name string
+		tx   *tlb.Transaction
+		want bool
+	}{
+		{
+			name: "deploy",
+			tx:   deploy,
+			want: true,
+		},
+		{
+			name: "no action phase",
+			tx:   ordinary(nil),
+		},
+		{
+			name: "messages only",
+			tx:   ordinary(&tlb.TrActionPhase{Success: true, TotActions: 2}),
+		},
+		{
+			name: "special action",
+			tx:   ordinary(&tlb.TrActionPhase{Success: true, TotActions: 2, SpecActions: 1}),
+			want: true,
+		},
+		{
+			name: "failed action phase",
+			tx:   ordinary(&tlb.TrActionPhase{TotActions: 1, SpecActions: 1}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, MayChangeCode(tt.tx))
+		})
+	}
+}
+
+func TestCodeHistory(t *testing.T) {
+	history := NewCodeHistory()
+	deploy := &Transaction{TransactionID: TransactionID{Hash: tongo.Bits256{1}, Lt: 10}}
+	upgrade := &Transaction{TransactionID: TransactionID{Hash: tongo.Bits256{2}, Lt: 20}}
+	reserve := &Transaction{TransactionID: TransactionID{Hash: tongo.Bits256{3}, Lt: 15}}
+	history.Add(upgrade)
+	history.Add(deploy)
+	history.Add(reserve)
+	history.Resolve(deploy.Hash, CodeVersion{TransactionID: deploy.TransactionID, CodeHash: tongo.Bits256{0xa}})
+
+	txs, versions := history.Candidates()
+	require.Equal(t, []*Transaction{deploy, reserve, upgrade}, txs)
+	require.NotNil(t, versions[0])
+	require.Nil(t, versions[1])
+
+	history.Remove(reserve)
+	txs, _ = history.Candidates()
+	require.Equal(t, []*Transaction{deploy, upgrade}, txs)
+}
+
+func TestCodeUpgrades(t *testing.T) {
+	versions := []CodeVersion{
+		{TransactionID: TransactionID{Lt: 10}, CodeHash: tongo.Bits256{0xa}},
+		{TransactionID: TransactionID{Lt: 15}, CodeHash: tongo.Bits256{0xa}},
+		{TransactionID: TransactionID{Lt: 20}, CodeHash: tongo.Bits256{0xb}},
+		{TransactionID: TransactionID{Lt: 30}, CodeHash: tongo.Bits256{0xa}},
+	}
+	upgrades := CodeUpgrades(versions)
+	require.Equal(t, []CodeVersion{versions[0], versions[2], versions[3]}, upgrades)
+}
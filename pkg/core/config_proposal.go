@@ -0,0 +1,155 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+)
+
+// ConfigProposal is a proposal to change a blockchain config param voted by validators in the config contract.
+type ConfigProposal struct {
+	Hash     tongo.Bits256
+	Expires  int64
+	Critical bool
+	ParamID  int32
+	// ParamValue is a new value of the param, nil if the proposal removes the param.
+	ParamValue *boc.Cell
+	// ParamHash, if set, is a hash of the current value of the param the proposal is supposed to replace.
+	ParamHash *tongo.Bits256
+	// VsetID is a hash of the validator set Voters belong to.
+	VsetID tongo.Bits256
+	// Voters are indexes of validators in the set who have voted in the current round.
+	Voters          []int32
+	WeightRemaining int64
+	RoundsRemaining int32
+	Wins            int32
+	Losses          int32
+}
+
+// configProposal describes a proposal returned by list_proposals of the config contract.
+// https://github.com/ton-blockchain/ton/blob/master/crypto/smartcont/config-code.fc
+type configProposal struct {
+	Hash     tlb.Int257
+	Expires  int64
+	Critical bool
+	Param    struct {
+		ID    int64
+		Value *boc.Cell
+		// Hash is -1 if the proposal doesn't depend on the current value of the param.
+		Hash tlb.Int257
+	}
+	VsetID          tlb.Int257
+	Voters          []int64
+	WeightRemaining int64
+	RoundsRemaining int64
+	Losses          int64
+	Wins            int64
+}
+
+// DecodeConfigProposals decodes a result of list_proposals of the config contract:
+// a list of tuples [hash, proposal...].
+func DecodeConfigProposals(stack tlb.VmStack) ([]ConfigProposal, error) {
+	if len(stack) == 0 {
+		return nil, fmt.Errorf("list_proposals returned an empty stack")
+	}
+	if stack[0].SumType == "VmStkNull" {
+		return nil, nil
+	}
+	if stack[0].SumType != "VmStkTuple" {
+		return nil, fmt.Errorf("unexpected list_proposals result %v", stack[0].SumType)
+	}
+	items, err := stack[0].VmStkTuple.RecursiveToSlice()
+	if err != nil {
+		return nil, err
+	}
+	proposals := make([]ConfigProposal, 0, len(items))
+	for _, item := range items {
+		if item.SumType != "VmStkTuple" || item.VmStkTuple.Len < 2 {
+			return nil, fmt.Errorf("unexpected proposal %v", item.SumType)
+		}
+		values, err := item.VmStkTuple.Data.RecursiveToSlice(int(item.VmStkTuple.Len))
+		if err != nil {
+			return nil, err
+		}
+		// a proposal follows its hash either in the same tuple or in a nested one.
+		if len(values) == 2 && values[1].SumType == "VmStkTuple" && values[1].VmStkTuple.Len >= 2 {
+			nested, err := values[1].VmStkTuple.Data.RecursiveToSlice(int(values[1].VmStkTuple.Len))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values[:1], nested...)
+		}
+		var raw configProposal
+		if err := tlb.VmStack(values).Unmarshal(&raw); err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, raw.convert())
+	}
+	return proposals, nil
+}
+
+func (p configProposal) convert() ConfigProposal {
+	proposal := ConfigProposal{
+		Hash:            int257ToBits256(p.Hash),
+		Expires:         p.Expires,
+		Critical:        p.Critical,
+		ParamID:         int32(p.Param.ID),
+		ParamValue:      p.Param.Value,
+		VsetID:          int257ToBits256(p.VsetID),
+		Voters:          make([]int32, 0, len(p.Voters)),
+		WeightRemaining: p.WeightRemaining,
+		RoundsRemaining: int32(p.RoundsRemaining),
+		Wins:            int32(p.Wins),
+		Losses:          int32(p.Losses),
+	}
+	if hash := big.Int(p.Param.Hash); hash.Sign() >= 0 {
+		paramHash := int257ToBits256(p.Param.Hash)
+		proposal.ParamHash = &paramHash
+	}
+	for _, voter := range p.Voters {
+		proposal.Voters = append(proposal.Voters, int32(voter))
+	}
+	return proposal
+}
+
+func int257ToBits256(v tlb.Int257) tongo.Bits256 {
+	var bits tongo.Bits256
+	i := big.Int(v)
+	i.FillBytes(bits[:])
+	return bits
+}
+
+// Passed reports whether the proposal has been applied by the given change of its param:
+// the param has got the proposed value, or has been removed if the proposal removes it.
+// A nil value means the param has been removed.
+func (p ConfigProposal) Passed(param int32, value *boc.Cell) bool {
+	if p.ParamID != param {
+		return false
+	}
+	if p.ParamValue == nil || value == nil {
+		return p.ParamValue == nil && value == nil
+	}
+	proposed, err := p.ParamValue.Hash256()
+	if err != nil {
+		return false
+	}
+	current, err := value.Hash256()
+	return err == nil && proposed == current
+}
+
+// VotedWeight returns a total weight of validators who have voted for the proposal in the current round,
+// weights are weights of validators of the current set by their indexes.
+// Votes of a previous validator set don't count, so 0 is returned if vsetID doesn't match the proposal.
+func (p ConfigProposal) VotedWeight(vsetID tongo.Bits256, weights map[int32]uint64) uint64 {
+	if p.VsetID != vsetID {
+		return 0
+	}
+	var total uint64
+	for _, voter := range p.Voters {
+		total += weights[voter]
+	}
+	return total
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+)
+
+func TestConfigProposal_VotedWeight(t *testing.T) {
+	weights := map[int32]uint64{0: 100, 1: 50, 2: 25}
+	tests := []struct {
+		name     string
+		proposal ConfigProposal
+		vsetID   tongo.Bits256
+		want     uint64
+	}{
+		{
+			name:     "votes of the current set",
+			proposal: ConfigProposal{VsetID: tongo.Bits256{1}, Voters: []int32{0, 2}},
+			vsetID:   tongo.Bits256{1},
+			want:     125,
+		},
+		{
+			name:     "votes of a previous set",
+			proposal: ConfigProposal{VsetID: tongo.Bits256{1}, Voters: []int32{0, 2}},
+			vsetID:   tongo.Bits256{2},
+		},
+		{
+			name:     "unknown validator",
+			proposal: ConfigProposal{VsetID: tongo.Bits256{1}, Voters: []int32{1, 7}},
+			vsetID:   tongo.Bits256{1},
+			want:     50,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.proposal.VotedWeight(tt.vsetID, weights))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/big"
 	"sort"
@@ -105,6 +106,7 @@ func convertComputePhase(phase tlb.TrComputePhase) *TxComputePhase {
 			Success:  phase.TrPhaseComputeVm.Success,
 			GasFees:  uint64(phase.TrPhaseComputeVm.GasFees),
 			GasUsed:  big.Int(phase.TrPhaseComputeVm.Vm.GasUsed),
+			GasLimit: big.Int(phase.TrPhaseComputeVm.Vm.GasLimit),
 			VmSteps:  phase.TrPhaseComputeVm.Vm.VmSteps,
 			ExitCode: phase.TrPhaseComputeVm.Vm.ExitCode,
 		}
@@ -187,6 +189,7 @@ func ConvertTransaction(workchain int32, tx tongo.Transaction) (*Transaction, er
 	var bouncePhase *TxBouncePhase
 	var aborted bool
 	var destroyed bool
+	var isTock bool
 
 	desc := tx.Description
 	switch desc.SumType {
@@ -204,6 +207,7 @@ func ConvertTransaction(workchain int32, tx tongo.Transaction) (*Transaction, er
 		storagePhase = convertStoragePhase(tx.StoragePh)
 	case "TransTickTock":
 		tx := desc.TransTickTock
+		isTock = tx.IsTock
 		aborted = tx.Aborted
 		destroyed = tx.Destroyed
 		computePhase = convertComputePhase(tx.ComputePh)
@@ -243,7 +247,8 @@ func ConvertTransaction(workchain int32, tx tongo.Transaction) (*Transaction, er
 		return nil, err
 	}
 	return &Transaction{
-		BlockID: tx.BlockID.BlockID,
+		BlockID:    tx.BlockID.BlockID,
+		BlockIDExt: tx.BlockID,
 		TransactionID: TransactionID{
 			Hash:    tongo.Bits256(tx.Hash()),
 			Lt:      tx.Lt,
@@ -263,6 +268,7 @@ func ConvertTransaction(workchain int32, tx tongo.Transaction) (*Transaction, er
 		EndStatus:       tx.EndStatus,
 		Aborted:         aborted,
 		Destroyed:       destroyed,
+		IsTock:          isTock,
 		ComputePhase:    computePhase,
 		StoragePhase:    storagePhase,
 		CreditPhase:     creditPhase,
@@ -320,6 +326,7 @@ func ConvertMessage(message tlb.Message, txLT uint64) (Message, error) {
 			Bounce:      info.Bounce,
 			Bounced:     info.Bounced,
 			Value:       int64(info.Value.Grams),
+			ValueExtra:  ConvertToCurrencyCollection(info.Value).Other,
 			FwdFee:      int64(info.FwdFee),
 			IhrFee:      int64(info.IhrFee),
 			ImportFee:   0,
@@ -408,6 +415,68 @@ func convertBodyCell(a tlb.Any) ([]byte, error) {
 	return c.ToBoc()
 }
 
+// ConvertToInternalMessage restores an internal message from its converted form,
+// so a message that hasn't been delivered yet can be emulated.
+// Extra currencies are not restored.
+func ConvertToInternalMessage(m Message) (tlb.Message, error) {
+	if m.MsgType != IntMsg || m.Destination == nil {
+		return tlb.Message{}, fmt.Errorf("not an internal message")
+	}
+	msg := tlb.Message{Info: tlb.CommonMsgInfo{SumType: "IntMsgInfo"}}
+	msg.Info.IntMsgInfo = &struct {
+		IhrDisabled bool
+		Bounce      bool
+		Bounced     bool
+		Src         tlb.MsgAddress
+		Dest        tlb.MsgAddress
+		Value       tlb.CurrencyCollection
+		IhrFee      tlb.Grams
+		FwdFee      tlb.Grams
+		CreatedLt   uint64
+		CreatedAt   uint32
+	}{
+		IhrDisabled: m.IhrDisabled,
+		Bounce:      m.Bounce,
+		Bounced:     m.Bounced,
+		Src:         m.Source.ToMsgAddress(),
+		Dest:        m.Destination.ToMsgAddress(),
+		Value:       tlb.CurrencyCollection{Grams: tlb.Grams(m.Value)},
+		IhrFee:      tlb.Grams(m.IhrFee),
+		FwdFee:      tlb.Grams(m.FwdFee),
+		CreatedLt:   m.CreatedLt,
+		CreatedAt:   m.CreatedAt,
+	}
+	if len(m.Init) > 0 {
+		cells, err := boc.DeserializeBoc(m.Init)
+		if err != nil {
+			return tlb.Message{}, err
+		}
+		var init tlb.StateInit
+		if err := tlb.Unmarshal(cells[0], &init); err != nil {
+			return tlb.Message{}, err
+		}
+		msg.Init.Exists = true
+		msg.Init.Value = tlb.EitherRef[tlb.StateInit]{IsRight: true, Value: init}
+	}
+	body := boc.NewCell()
+	switch {
+	case len(m.Body) == 0:
+	case len(m.Body) >= 4 && binary.BigEndian.Uint32(m.Body) == 0:
+		// convertBodyCell keeps bits of a text comment as is.
+		if err := body.WriteBytes(m.Body); err != nil {
+			return tlb.Message{}, err
+		}
+	default:
+		cells, err := boc.DeserializeBoc(m.Body)
+		if err != nil {
+			return tlb.Message{}, err
+		}
+		body = cells[0]
+	}
+	msg.Body = tlb.EitherRef[tlb.Any]{IsRight: true, Value: tlb.Any(*body)}
+	return msg, nil
+}
+
 func ConvertToAccount(accountId tongo.AccountID, shardAccount tlb.ShardAccount) (*Account, error) {
 	res := &Account{
 		AccountAddress: accountId,
@@ -11,8 +11,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
 	"github.com/tonkeeper/tongo/liteapi"
 	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/wallet"
 )
 
 func readFile[T any](filename string) (*T, error) {
@@ -121,3 +124,48 @@ func TestConvertTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertToInternalMessage(t *testing.T) {
+	source := tongo.MustParseAccountID("0:da6b1b6663a0e4d18cc8574ccd9db5296e367dd9324706f3bbd9eb1cd2caf0bf")
+	destination := tongo.MustParseAccountID("0:97264395bd65a255a429b11326c84128b7d70ffed7949abae3036d506ba38621")
+	comment := boc.NewCell()
+	require.NoError(t, tlb.Marshal(comment, abi.TextCommentMsgBody{Text: "hello"}))
+	transfer := boc.NewCell()
+	require.NoError(t, tlb.Marshal(transfer, abi.JettonTransferMsgBody{
+		QueryId:             1,
+		Amount:              tlb.VarUInteger16(*big.NewInt(1000)),
+		Destination:         destination.ToMsgAddress(),
+		ResponseDestination: source.ToMsgAddress(),
+	}))
+	tests := []struct {
+		name string
+		body *boc.Cell
+	}{
+		{name: "empty body", body: boc.NewCell()},
+		{name: "text comment", body: comment},
+		{name: "jetton transfer", body: transfer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, _, err := wallet.Message{Amount: 100, Address: destination, Body: tt.body, Bounce: true}.ToInternal()
+			require.NoError(t, err)
+			msg.Info.IntMsgInfo.Src = source.ToMsgAddress()
+			msg.Info.IntMsgInfo.CreatedLt = 42
+			msg.Body.IsRight = true
+
+			converted, err := ConvertMessage(msg, 42)
+			require.NoError(t, err)
+			restored, err := ConvertToInternalMessage(converted)
+			require.NoError(t, err)
+
+			hash := func(m tlb.Message) tongo.Bits256 {
+				cell := boc.NewCell()
+				require.NoError(t, tlb.Marshal(cell, m))
+				h, err := cell.Hash256()
+				require.NoError(t, err)
+				return h
+			}
+			require.Equal(t, hash(msg), hash(restored))
+		})
+	}
+}
@@ -5,3 +5,13 @@ import "errors"
 var ErrEntityNotFound = errors.New("entity not found")
 var ErrTooManyEntities = errors.New("too many entities")
 var ErrNotKeyBlock = errors.New("block must be a key block")
+
+// ErrNotIndexed means the requested data exists in the blockchain, but this instance doesn't index it,
+// e.g. an account isn't tracked.
+var ErrNotIndexed = errors.New("not indexed")
+
+// ErrUpstreamUnavailable means no lite server can serve a request at the moment.
+var ErrUpstreamUnavailable = errors.New("upstream unavailable")
+
+// ErrNotArchived means the requested data is too old for lite servers that don't keep the full history.
+var ErrNotArchived = errors.New("too old for a non-archival lite server")
@@ -0,0 +1,71 @@
+package core
+
+import "github.com/tonkeeper/tongo"
+
+// FeeBreakdown splits fees of a transaction by the phases they are charged in.
+// All values are in nanotons.
+type FeeBreakdown struct {
+	// Storage is a storage fee collected in the storage phase together with a storage debt collected in the credit phase.
+	Storage int64
+	// Gas is a fee for computations in the compute phase.
+	Gas int64
+	// Action is a fee collected in the action phase: a share of Forward taken immediately and fines for failed actions.
+	Action int64
+	// Forward is a fee for forwarding outbound messages.
+	// Only the Action share of it is a part of Total, the rest is carried by the messages and collected later.
+	Forward int64
+	// Import is a fee for importing an inbound external message.
+	Import int64
+	// Total is total_fees of the transaction as it is reported by the blockchain.
+	Total int64
+	// Paid is what the account actually paid for the transaction: Total together with the whole Forward fee.
+	Paid int64
+}
+
+// FeeBreakdown computes fees of the transaction from its phases.
+func (t *Transaction) FeeBreakdown() FeeBreakdown {
+	fees := FeeBreakdown{
+		Total: t.TotalFee,
+		Paid:  t.TotalFee,
+	}
+	if t.StoragePhase != nil {
+		fees.Storage += int64(t.StoragePhase.StorageFeesCollected)
+	}
+	if t.CreditPhase != nil {
+		fees.Storage += int64(t.CreditPhase.DueFeesCollected)
+	}
+	if t.ComputePhase != nil && !t.ComputePhase.Skipped {
+		fees.Gas = int64(t.ComputePhase.GasFees)
+	}
+	if t.ActionPhase != nil {
+		fees.Action = int64(t.ActionPhase.TotalFees)
+		fees.Forward = int64(t.ActionPhase.FwdFees)
+		fees.Paid += fees.Forward - fees.Action
+	}
+	if t.InMsg != nil && t.InMsg.MsgType == ExtInMsg {
+		fees.Import = t.InMsg.ImportFee
+	}
+	return fees
+}
+
+// Add returns a sum of two breakdowns.
+func (f FeeBreakdown) Add(other FeeBreakdown) FeeBreakdown {
+	return FeeBreakdown{
+		Storage: f.Storage + other.Storage,
+		Gas:     f.Gas + other.Gas,
+		Action:  f.Action + other.Action,
+		Forward: f.Forward + other.Forward,
+		Import:  f.Import + other.Import,
+		Total:   f.Total + other.Total,
+		Paid:    f.Paid + other.Paid,
+	}
+}
+
+// ComputeFeeBreakdown goes over the whole trace and sums fees of transactions of every involved account.
+func ComputeFeeBreakdown(trace *Trace) map[tongo.AccountID]FeeBreakdown {
+	fees := make(map[tongo.AccountID]FeeBreakdown)
+	Visit(trace, func(trace *Trace) {
+		fees[trace.Account] = fees[trace.Account].Add(trace.Transaction.FeeBreakdown())
+	})
+	return fees
+}
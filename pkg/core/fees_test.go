@@ -0,0 +1,51 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransaction_FeeBreakdown(t *testing.T) {
+	due := uint64(7)
+	tests := []struct {
+		name string
+		tx   Transaction
+		want FeeBreakdown
+	}{
+		{
+			name: "wallet sends a message",
+			tx: Transaction{
+				TotalFee:     3_000_000,
+				InMsg:        &Message{MsgType: ExtInMsg, ImportFee: 400_000},
+				StoragePhase: &TxStoragePhase{StorageFeesCollected: 100_000, StorageFeesDue: &due},
+				ComputePhase: &TxComputePhase{Success: true, GasFees: 2_000_000},
+				ActionPhase:  &TxActionPhase{Success: true, FwdFees: 1_500_000, TotalFees: 500_000},
+			},
+			want: FeeBreakdown{Storage: 100_000, Gas: 2_000_000, Action: 500_000, Forward: 1_500_000, Import: 400_000, Total: 3_000_000, Paid: 4_000_000},
+		},
+		{
+			name: "compute phase skipped",
+			tx: Transaction{
+				TotalFee:     50,
+				InMsg:        &Message{MsgType: IntMsg},
+				CreditPhase:  &TxCreditPhase{DueFeesCollected: 20},
+				StoragePhase: &TxStoragePhase{StorageFeesCollected: 30},
+				ComputePhase: &TxComputePhase{Skipped: true, GasFees: 1},
+			},
+			want: FeeBreakdown{Storage: 50, Total: 50, Paid: 50},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.tx.FeeBreakdown())
+		})
+	}
+}
+
+func TestComputeFeeBreakdown(t *testing.T) {
+	fees := ComputeFeeBreakdown(visitorTestTrace())
+	require.Equal(t, int64(10), fees[visitorAccount1].Total)
+	require.Equal(t, int64(7), fees[visitorAccount2].Total)
+	require.Equal(t, int64(1), fees[visitorAccount3].Paid)
+}
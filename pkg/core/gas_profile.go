@@ -0,0 +1,52 @@
+package core
+
+// GasProfile describes computations of a transaction together with computations of its descendants in a trace.
+type GasProfile struct {
+	// GasUsed, GasLimit and VmSteps describe the compute phase of the transaction itself.
+	GasUsed  int64
+	GasLimit int64
+	VmSteps  int64
+	// ExitCode is nil if the compute phase of the transaction has been skipped.
+	ExitCode *int32
+	// TotalGasUsed and TotalVmSteps are sums over the transaction and all its descendants.
+	TotalGasUsed int64
+	TotalVmSteps int64
+	// Failed is a number of transactions in the subtree with an unsuccessful compute phase.
+	Failed int
+	// ExitCodes counts exit codes of transactions in the subtree.
+	ExitCodes map[int32]int
+}
+
+// GasProfile returns a profile of the transaction without descendants.
+func (t *Transaction) GasProfile() GasProfile {
+	profile := GasProfile{ExitCodes: map[int32]int{}}
+	phase := t.ComputePhase
+	if phase == nil || phase.Skipped {
+		return profile
+	}
+	exitCode := phase.ExitCode
+	profile.GasUsed = phase.GasUsed.Int64()
+	profile.GasLimit = phase.GasLimit.Int64()
+	profile.VmSteps = int64(phase.VmSteps)
+	profile.ExitCode = &exitCode
+	profile.TotalGasUsed = profile.GasUsed
+	profile.TotalVmSteps = profile.VmSteps
+	profile.ExitCodes[exitCode] = 1
+	if !phase.Success {
+		profile.Failed = 1
+	}
+	return profile
+}
+
+// AddDescendant adds totals of a profile of a child subtree to the profile.
+func (p *GasProfile) AddDescendant(child GasProfile) {
+	p.TotalGasUsed += child.TotalGasUsed
+	p.TotalVmSteps += child.TotalVmSteps
+	p.Failed += child.Failed
+	if p.ExitCodes == nil {
+		p.ExitCodes = make(map[int32]int, len(child.ExitCodes))
+	}
+	for code, count := range child.ExitCodes {
+		p.ExitCodes[code] += count
+	}
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasProfile(t *testing.T) {
+	computed := func(success bool, exitCode int32, gasUsed int64, vmSteps uint32) *TxComputePhase {
+		return &TxComputePhase{
+			Success:  success,
+			ExitCode: exitCode,
+			GasUsed:  *big.NewInt(gasUsed),
+			GasLimit: *big.NewInt(1_000_000),
+			VmSteps:  vmSteps,
+		}
+	}
+	root := Transaction{ComputePhase: computed(true, 0, 3000, 60)}
+	child := Transaction{ComputePhase: computed(false, 709, 1500, 30)}
+	skipped := Transaction{ComputePhase: &TxComputePhase{Skipped: true}}
+
+	profile := root.GasProfile()
+	require.Equal(t, int64(3000), profile.GasUsed)
+	require.Equal(t, int64(1_000_000), profile.GasLimit)
+	require.Equal(t, int32(0), *profile.ExitCode)
+
+	childProfile := child.GasProfile()
+	childProfile.AddDescendant(skipped.GasProfile())
+	profile.AddDescendant(childProfile)
+	require.Equal(t, GasProfile{
+		GasUsed:      3000,
+		GasLimit:     1_000_000,
+		VmSteps:      60,
+		ExitCode:     profile.ExitCode,
+		TotalGasUsed: 4500,
+		TotalVmSteps: 90,
+		Failed:       1,
+		ExitCodes:    map[int32]int{0: 1, 709: 1},
+	}, profile)
+	require.Nil(t, skipped.GasProfile().ExitCode)
+}
@@ -15,7 +15,30 @@ type JettonWallet struct {
 	// JettonAddress of a jetton master.
 	JettonAddress tongo.AccountID
 	Lock          *JettonWalletLockData
-	Extensions    []string
+	// Status is reported by jetton wallets which can be locked by an admin of the jetton, e.g. regulated stablecoins.
+	Status     *JettonWalletStatus
+	Extensions []string
+	// CodeMismatch is set when the code of the wallet doesn't match the code of legitimate wallets of the jetton,
+	// so the wallet is a fake contract and its balance must not be trusted.
+	CodeMismatch bool
+}
+
+// JettonWalletStatus is a lock status of a jetton wallet as it is returned by the "get_status" get-method.
+type JettonWalletStatus uint8
+
+const (
+	// JettonWalletOutgoingLocked is set when the wallet can't send jettons.
+	JettonWalletOutgoingLocked JettonWalletStatus = 1
+	// JettonWalletIncomingLocked is set when the wallet can't receive jettons.
+	JettonWalletIncomingLocked JettonWalletStatus = 2
+)
+
+func (s JettonWalletStatus) OutgoingLocked() bool {
+	return s&JettonWalletOutgoingLocked != 0
+}
+
+func (s JettonWalletStatus) IncomingLocked() bool {
+	return s&JettonWalletIncomingLocked != 0
 }
 
 type JettonHolder struct {
@@ -37,3 +60,13 @@ type JettonWalletLockData struct {
 	FullBalance decimal.Decimal
 	UnlockTime  int64
 }
+
+// JettonVolume contains rolling transfer stats of a jetton observed by the indexer.
+type JettonVolume struct {
+	Master       tongo.AccountID
+	Transfers24h int64
+	Transfers7d  int64
+	// Volume24h and Volume7d are sums of transferred amounts in the smallest units of the jetton.
+	Volume24h big.Int
+	Volume7d  big.Int
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"math/big"
+	"slices"
+
+	"github.com/tonkeeper/tongo"
+)
+
+// maxLockupUnlocks caps unlocks returned by LockupSchedule.Unlocks, so a lockup with a tiny unlock period stays cheap.
+const maxLockupUnlocks = 1000
+
+// LockupSchedule describes how TON locked in a lockup wallet vests.
+// Nothing is unlocked until the cliff ends, then TotalAmount is unlocked in equal parts every UnlockPeriod
+// over TotalDuration since StartTime.
+type LockupSchedule struct {
+	Account       tongo.AccountID
+	StartTime     int64
+	CliffDuration int64
+	UnlockPeriod  int64
+	TotalDuration int64
+	TotalAmount   int64
+}
+
+// LockupUnlock is a moment when a part of locked TON becomes available.
+type LockupUnlock struct {
+	Account tongo.AccountID
+	Utime   int64
+	Amount  int64
+}
+
+// Vested returns the amount unlocked by the given moment.
+func (s LockupSchedule) Vested(utime int64) int64 {
+	if utime < s.StartTime+s.CliffDuration || utime < s.StartTime {
+		return 0
+	}
+	if s.TotalDuration <= 0 || utime >= s.StartTime+s.TotalDuration {
+		return s.TotalAmount
+	}
+	elapsed := utime - s.StartTime
+	if s.UnlockPeriod > 0 {
+		elapsed -= elapsed % s.UnlockPeriod
+	}
+	// TotalAmount * elapsed might overflow int64 for large lockups.
+	vested := new(big.Int).Mul(big.NewInt(s.TotalAmount), big.NewInt(elapsed))
+	return vested.Div(vested, big.NewInt(s.TotalDuration)).Int64()
+}
+
+// Unlocks returns unlocks happening in (from, until], so the end of a cliff and every unlock period after it.
+func (s LockupSchedule) Unlocks(from, until int64) []LockupUnlock {
+	end := s.StartTime + s.TotalDuration
+	if until > end {
+		until = end
+	}
+	var moments []int64
+	if cliff := s.StartTime + s.CliffDuration; cliff > from && cliff <= until {
+		moments = append(moments, cliff)
+	}
+	if s.UnlockPeriod > 0 && until > s.StartTime {
+		next := s.StartTime + s.UnlockPeriod
+		if from >= s.StartTime {
+			next = s.StartTime + ((from-s.StartTime)/s.UnlockPeriod+1)*s.UnlockPeriod
+		}
+		for ; next <= until && len(moments) < maxLockupUnlocks; next += s.UnlockPeriod {
+			moments = append(moments, next)
+		}
+	}
+	if end > from && end <= until {
+		moments = append(moments, end)
+	}
+	slices.Sort(moments)
+	var unlocks []LockupUnlock
+	previous := s.Vested(from)
+	for _, utime := range slices.Compact(moments) {
+		// unlock periods ending before the cliff unlock nothing on their own.
+		vested := s.Vested(utime)
+		if vested > previous {
+			unlocks = append(unlocks, LockupUnlock{Account: s.Account, Utime: utime, Amount: vested - previous})
+			previous = vested
+		}
+	}
+	return unlocks
+}
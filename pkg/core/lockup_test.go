@@ -0,0 +1,65 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockupSchedule_Unlocks(t *testing.T) {
+	const day = 24 * 60 * 60
+	schedule := LockupSchedule{
+		StartTime:     1_000_000,
+		CliffDuration: 90 * day,
+		UnlockPeriod:  30 * day,
+		TotalDuration: 360 * day,
+		TotalAmount:   12_000,
+	}
+	tests := []struct {
+		name  string
+		from  int64
+		until int64
+		want  []LockupUnlock
+	}{
+		{
+			name:  "before the cliff",
+			from:  schedule.StartTime,
+			until: schedule.StartTime + 89*day,
+		},
+		{
+			name:  "cliff unlocks periods vested so far",
+			from:  schedule.StartTime,
+			until: schedule.StartTime + 120*day,
+			want: []LockupUnlock{
+				{Utime: schedule.StartTime + 90*day, Amount: 3_000},
+				{Utime: schedule.StartTime + 120*day, Amount: 1_000},
+			},
+		},
+		{
+			name:  "last period",
+			from:  schedule.StartTime + 340*day,
+			until: schedule.StartTime + 400*day,
+			want: []LockupUnlock{
+				{Utime: schedule.StartTime + 360*day, Amount: 1_000},
+			},
+		},
+		{
+			name:  "fully vested",
+			from:  schedule.StartTime + 360*day,
+			until: schedule.StartTime + 400*day,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, schedule.Unlocks(tt.from, tt.until))
+		})
+	}
+}
+
+func TestLockupSchedule_Vested(t *testing.T) {
+	schedule := LockupSchedule{StartTime: 100, CliffDuration: 10, UnlockPeriod: 10, TotalDuration: 40, TotalAmount: 1_000_000_000_000_000_000}
+	require.Equal(t, int64(0), schedule.Vested(105))
+	require.Equal(t, int64(250_000_000_000_000_000), schedule.Vested(115))
+	require.Equal(t, int64(750_000_000_000_000_000), schedule.Vested(139))
+	require.Equal(t, schedule.TotalAmount, schedule.Vested(140))
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+)
+
+// MessageHashKind describes a form of a message hash.
+//
+// Integrators often track an external message by a hash that differs from the one stored in a block:
+// a wallet SDK may report the hash of the message body, the hash of the signed payload
+// or the hash of the message before it was re-serialized by a lite server.
+// That's why external inbound messages are indexed by every form listed below.
+type MessageHashKind string
+
+const (
+	// MessageHashFull is a hash of a message cell exactly as it is included in a block.
+	MessageHashFull MessageHashKind = "full"
+	// MessageHashNormalized is a hash of an external inbound message normalized according to TEP-467:
+	// src is replaced with addr_none, import_fee with 0, init is dropped and body is stored in a reference.
+	// It doesn't depend on how a message was serialized, so it is the recommended way to track sent messages.
+	MessageHashNormalized MessageHashKind = "normalized"
+	// MessageHashBody is a hash of a message body.
+	MessageHashBody MessageHashKind = "body"
+	// MessageHashSignedPayload is a hash of a message body without its leading 512-bit signature.
+	// This is exactly what a wallet signs.
+	MessageHashSignedPayload MessageHashKind = "signed_payload"
+)
+
+// signatureBits is the size of an ed25519 signature wallets put in front of a message body.
+const signatureBits = 512
+
+// MessageHashMatch is a result of resolving a message hash of any kind.
+type MessageHashMatch struct {
+	// Kind is the form of the hash that matched.
+	Kind            MessageHashKind
+	TransactionHash ton.Bits256
+	// NormalizedHash is set for external inbound messages only.
+	NormalizedHash *ton.Bits256
+}
+
+// MessageHashes returns all forms of a hash of the given message.
+// Only MessageHashFull is returned for internal and external outbound messages,
+// because their bodies are not unique.
+func MessageHashes(msg tlb.Message) (map[MessageHashKind]ton.Bits256, error) {
+	full := ton.Bits256(msg.Hash())
+	if full == (ton.Bits256{}) {
+		cell := boc.NewCell()
+		if err := tlb.Marshal(cell, msg); err != nil {
+			return nil, err
+		}
+		hash, err := cell.Hash256()
+		if err != nil {
+			return nil, err
+		}
+		full = hash
+	}
+	hashes := map[MessageHashKind]ton.Bits256{MessageHashFull: full}
+	if msg.Info.SumType != "ExtInMsgInfo" {
+		return hashes, nil
+	}
+	normalized, err := NormalizedMessageHash(msg)
+	if err != nil {
+		return nil, err
+	}
+	hashes[MessageHashNormalized] = normalized
+	body := messageBody(msg)
+	bodyHash, err := body.Hash256()
+	if err != nil {
+		return nil, err
+	}
+	hashes[MessageHashBody] = bodyHash
+	if body.BitSize() >= signatureBits {
+		if err := body.Skip(signatureBits); err != nil {
+			return nil, err
+		}
+		payloadHash, err := body.CopyRemaining().Hash256()
+		if err != nil {
+			return nil, err
+		}
+		hashes[MessageHashSignedPayload] = payloadHash
+	}
+	return hashes, nil
+}
+
+// NormalizedMessageHash returns a hash of an external inbound message normalized according to TEP-467.
+func NormalizedMessageHash(msg tlb.Message) (ton.Bits256, error) {
+	if msg.Info.SumType != "ExtInMsgInfo" {
+		return ton.Bits256{}, fmt.Errorf("not an external inbound message")
+	}
+	info := *msg.Info.ExtInMsgInfo
+	info.Src = tlb.MsgAddress{SumType: "AddrNone"}
+	info.ImportFee = tlb.VarUInteger16{}
+	normalized := tlb.Message{
+		Info: tlb.CommonMsgInfo{SumType: "ExtInMsgInfo", ExtInMsgInfo: &info},
+		Body: tlb.EitherRef[tlb.Any]{IsRight: true, Value: tlb.Any(*messageBody(msg))},
+	}
+	cell := boc.NewCell()
+	if err := tlb.Marshal(cell, normalized); err != nil {
+		return ton.Bits256{}, err
+	}
+	return cell.Hash256()
+}
+
+// messageBody returns a copy of the message body with reset read counters.
+func messageBody(msg tlb.Message) *boc.Cell {
+	body := boc.Cell(msg.Body.Value)
+	body.ResetCounters()
+	return &body
+}
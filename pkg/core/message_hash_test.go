@@ -0,0 +1,81 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+)
+
+func extInMessage(t *testing.T, payload *boc.Cell, bodyInRef bool, importFee int64) tlb.Message {
+	body := boc.NewCell()
+	require.Nil(t, body.WriteBytes(make([]byte, 64))) // signature
+	require.Nil(t, body.AddRef(payload))
+	dest := ton.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+	var msg tlb.Message
+	msg.Info.SumType = "ExtInMsgInfo"
+	msg.Info.ExtInMsgInfo = &struct {
+		Src       tlb.MsgAddress
+		Dest      tlb.MsgAddress
+		ImportFee tlb.VarUInteger16
+	}{
+		Src:       tlb.MsgAddress{SumType: "AddrNone"},
+		Dest:      dest.ToMsgAddress(),
+		ImportFee: tlb.VarUInteger16(*big.NewInt(importFee)),
+	}
+	msg.Body = tlb.EitherRef[tlb.Any]{IsRight: bodyInRef, Value: tlb.Any(*body)}
+	return msg
+}
+
+func TestMessageHashes(t *testing.T) {
+	payload := boc.NewCell()
+	require.Nil(t, payload.WriteUint(42, 32))
+	// the signed payload is what remains of the body after the signature.
+	signed := boc.NewCell()
+	require.Nil(t, signed.AddRef(payload))
+	signedHash, err := signed.Hash256()
+	require.Nil(t, err)
+
+	inline, err := MessageHashes(extInMessage(t, payload, false, 0))
+	require.Nil(t, err)
+	inRef, err := MessageHashes(extInMessage(t, payload, true, 0))
+	require.Nil(t, err)
+	withFee, err := MessageHashes(extInMessage(t, payload, true, 100))
+	require.Nil(t, err)
+
+	require.Len(t, inline, 4)
+	require.NotEqual(t, inline[MessageHashFull], inRef[MessageHashFull])
+	require.NotEqual(t, inRef[MessageHashFull], withFee[MessageHashFull])
+	// normalization removes differences in serialization and import fee.
+	require.Equal(t, inRef[MessageHashFull], inRef[MessageHashNormalized])
+	require.Equal(t, inRef[MessageHashNormalized], inline[MessageHashNormalized])
+	require.Equal(t, inRef[MessageHashNormalized], withFee[MessageHashNormalized])
+	require.Equal(t, inline[MessageHashBody], inRef[MessageHashBody])
+	require.Equal(t, ton.Bits256(signedHash), inline[MessageHashSignedPayload])
+
+	var internal tlb.Message
+	internal.Info.SumType = "IntMsgInfo"
+	internal.Info.IntMsgInfo = &struct {
+		IhrDisabled bool
+		Bounce      bool
+		Bounced     bool
+		Src         tlb.MsgAddress
+		Dest        tlb.MsgAddress
+		Value       tlb.CurrencyCollection
+		IhrFee      tlb.Grams
+		FwdFee      tlb.Grams
+		CreatedLt   uint64
+		CreatedAt   uint32
+	}{
+		Src:  tlb.MsgAddress{SumType: "AddrNone"},
+		Dest: tlb.MsgAddress{SumType: "AddrNone"},
+	}
+	internal.Body = tlb.EitherRef[tlb.Any]{Value: tlb.Any(*boc.NewCell())}
+	hashes, err := MessageHashes(internal)
+	require.Nil(t, err)
+	require.Len(t, hashes, 1)
+	require.Contains(t, hashes, MessageHashFull)
+}
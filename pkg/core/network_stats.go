@@ -0,0 +1,37 @@
+package core
+
+// NetworkStats contains chain-wide aggregates observed by the indexer.
+type NetworkStats struct {
+	// ObservedSince is a unix timestamp when the indexer started collecting stats.
+	ObservedSince int64
+	// TPS is a number of transactions per second over recent windows.
+	TPS TPSStats
+	// MasterchainBlockTime contains percentiles of intervals between masterchain blocks over the last hour, in seconds.
+	MasterchainBlockTime Percentiles
+	// AvgMasterchainBlockTime is an average interval between masterchain blocks over the last hour, in seconds.
+	AvgMasterchainBlockTime float64
+	// FeesCollected and FeesBurned are totals in nanotons since ObservedSince.
+	FeesCollected int64
+	FeesBurned    int64
+	// NewAccounts contains a number of accounts deployed per day among tracked accounts.
+	NewAccounts []DailyCount
+}
+
+type TPSStats struct {
+	LastMinute           float64
+	Last5Minutes         float64
+	LastHour             float64
+	TransactionsLastHour int64
+}
+
+type Percentiles struct {
+	P50 float64
+	P90 float64
+	P99 float64
+}
+
+// DailyCount is a number of events that happened during a day starting at Date (unix timestamp).
+type DailyCount struct {
+	Date  int64
+	Count int
+}
@@ -0,0 +1,86 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/ton"
+)
+
+// PayloadSearch describes a search of indexed transactions by payloads of their messages.
+type PayloadSearch struct {
+	// Accounts limits the search to the given accounts, nil means all indexed accounts.
+	Accounts []tongo.AccountID
+	// Comment is a case-insensitive substring of a text comment.
+	Comment string
+	// Hash is either a hash of a message body or a hash of a whole message.
+	Hash *ton.Bits256
+	// StartTime and EndTime limit utime of transactions, zero means no limit.
+	StartTime int64
+	EndTime   int64
+	// BeforeLt is used for pagination, zero means the latest transactions.
+	BeforeLt uint64
+	Limit    int
+}
+
+// MatchTransaction reports whether the inbound message or any of outbound messages of the transaction
+// matches the search. Accounts and pagination are not checked.
+func (s PayloadSearch) MatchTransaction(tx *Transaction) bool {
+	if s.StartTime != 0 && tx.Utime < s.StartTime {
+		return false
+	}
+	if s.EndTime != 0 && tx.Utime > s.EndTime {
+		return false
+	}
+	if tx.InMsg != nil && s.MatchMessage(tx.InMsg) {
+		return true
+	}
+	for i := range tx.OutMsgs {
+		if s.MatchMessage(&tx.OutMsgs[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchMessage reports whether the message matches both the comment and the hash of the search.
+func (s PayloadSearch) MatchMessage(msg *Message) bool {
+	if s.Comment != "" {
+		comment, ok := msg.TextComment()
+		if !ok || !strings.Contains(strings.ToLower(comment), strings.ToLower(s.Comment)) {
+			return false
+		}
+	}
+	if s.Hash != nil && msg.Hash != *s.Hash {
+		hash, ok := messageBodyHash(msg)
+		if !ok || hash != *s.Hash {
+			return false
+		}
+	}
+	return true
+}
+
+// messageBodyHash returns a hash of a message body cell.
+// Short text comments are kept as plain bytes in Message.Body instead of a BoC, so their cell is rebuilt.
+func messageBodyHash(msg *Message) (ton.Bits256, bool) {
+	if cells, err := boc.DeserializeBoc(msg.Body); err == nil {
+		if len(cells) != 1 {
+			return ton.Bits256{}, false
+		}
+		hash, err := cells[0].Hash256()
+		return hash, err == nil
+	}
+	if _, ok := msg.TextComment(); !ok {
+		return ton.Bits256{}, false
+	}
+	cell := boc.NewCell()
+	if err := cell.WriteUint(0, 32); err != nil {
+		return ton.Bits256{}, false
+	}
+	if err := cell.WriteBytes(msg.Body); err != nil {
+		return ton.Bits256{}, false
+	}
+	hash, err := cell.Hash256()
+	return hash, err == nil
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+)
+
+func TestPayloadSearch_MatchTransaction(t *testing.T) {
+	comment := func(text string) *Message {
+		return &Message{
+			Hash:        ton.Bits256{1},
+			Body:        []byte(text),
+			DecodedBody: &DecodedMessageBody{Operation: abi.TextCommentMsgOp, Value: abi.TextCommentMsgBody{Text: tlb.Text(text)}},
+		}
+	}
+	commentCell := boc.NewCell()
+	require.Nil(t, commentCell.WriteUint(0, 32))
+	require.Nil(t, commentCell.WriteBytes([]byte("Payment INV-1234")))
+	hash, err := commentCell.Hash256()
+	require.Nil(t, err)
+	commentHash := ton.Bits256(hash)
+
+	tx := &Transaction{
+		Utime:   1000,
+		InMsg:   comment("Payment INV-1234"),
+		OutMsgs: []Message{*comment("refund")},
+	}
+	tests := []struct {
+		name   string
+		search PayloadSearch
+		want   bool
+	}{
+		{name: "comment substring", search: PayloadSearch{Comment: "inv-1234"}, want: true},
+		{name: "outbound comment", search: PayloadSearch{Comment: "REFUND"}, want: true},
+		{name: "no such comment", search: PayloadSearch{Comment: "INV-9999"}},
+		{name: "body hash", search: PayloadSearch{Hash: &commentHash}, want: true},
+		{name: "message hash", search: PayloadSearch{Hash: &ton.Bits256{1}}, want: true},
+		{name: "unknown hash", search: PayloadSearch{Hash: &ton.Bits256{2}}},
+		{name: "comment and hash of different messages", search: PayloadSearch{Comment: "refund", Hash: &commentHash}},
+		{name: "within time range", search: PayloadSearch{Comment: "INV", StartTime: 900, EndTime: 1000}, want: true},
+		{name: "out of time range", search: PayloadSearch{Comment: "INV", StartTime: 1001}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.search.MatchTransaction(tx))
+		})
+	}
+}
@@ -3,11 +3,13 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	"github.com/shopspring/decimal"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
 	"golang.org/x/exp/maps"
 )
 
@@ -51,6 +53,13 @@ type TraceAdditionalInfo struct {
 	// This field is required because when a new NFT is created during emulation,
 	// there is no way to get it from the blockchain, and we have to store it somewhere.
 	EmulatedTeleitemNFT *EmulatedTeleitemNFT
+	// DNSRecords is set, if a transaction changes DNS records of a DNS item
+	// and InformationSource implements DNSRecordsSource.
+	// It contains the item's records as they were before the transaction.
+	DNSRecords map[tlb.Bits256]tlb.DNSRecord
+	// Incomplete is set, if some of the information couldn't be retrieved because of an InformationSource failure.
+	// Such a trace is inspected again by the next CollectAdditionalInfo call.
+	Incomplete bool
 }
 
 func (t *Trace) AdditionalInfo() *TraceAdditionalInfo {
@@ -108,6 +117,27 @@ type InformationSource interface {
 	STONfiPools(ctx context.Context, poolIDs []tongo.AccountID) (map[tongo.AccountID]STONfiPool, error)
 }
 
+// DNSRecordsSource is an optional extension of InformationSource.
+type DNSRecordsSource interface {
+	// DNSRecordsBefore returns DNS records of a DNS item as of the shard block preceding the given one.
+	DNSRecordsBefore(ctx context.Context, item tongo.AccountID, block tongo.BlockID) (map[tlb.Bits256]tlb.DNSRecord, error)
+}
+
+// isDNSRecordChange returns true if a message changes or deletes a DNS record.
+// Deletion of the zero key is a renewal of a domain, it doesn't change records.
+func isDNSRecordChange(inMsg *Message) bool {
+	if inMsg == nil || inMsg.DecodedBody == nil {
+		return false
+	}
+	switch body := inMsg.DecodedBody.Value.(type) {
+	case abi.ChangeDnsRecordMsgBody:
+		return true
+	case abi.DeleteDnsRecordMsgBody:
+		return body.Key != tlb.Bits256{}
+	}
+	return false
+}
+
 func isDestinationJettonWallet(inMsg *Message) bool {
 	if inMsg == nil || inMsg.DecodedBody == nil {
 		return false
@@ -145,6 +175,8 @@ func DistinctAccounts(trace *Trace) []tongo.AccountID {
 // CollectAdditionalInfo goes over the whole trace
 // and populates trace.TraceAdditionalInfo based on information
 // provided by InformationSource.
+// If some of the sources fail, the rest of information is still populated,
+// and the failures are returned as a single error.
 func CollectAdditionalInfo(ctx context.Context, infoSource InformationSource, trace *Trace) error {
 	if infoSource == nil {
 		return nil
@@ -152,12 +184,13 @@ func CollectAdditionalInfo(ctx context.Context, infoSource InformationSource, tr
 	var jettonWallets []tongo.AccountID
 	var saleContracts []tongo.AccountID
 	var stonfiPoolIDs []tongo.AccountID
+	var dnsChanges []*Trace
 	Visit(trace, func(trace *Trace) {
 		// when we emulate a trace,
 		// we construct "trace.AdditionalInfo" in emulatedTreeToTrace for all accounts the trace touches.
 		// moreover, some accounts change their states and some of them are not exist in the blockchain,
 		// so we must not inspect them again.
-		if trace.AdditionalInfo() != nil {
+		if info := trace.AdditionalInfo(); info != nil && !info.Incomplete {
 			return
 		}
 		if isDestinationJettonWallet(trace.InMsg) {
@@ -171,29 +204,45 @@ func CollectAdditionalInfo(ctx context.Context, infoSource InformationSource, tr
 		if hasInterface(trace.AccountInterfaces, abi.StonfiPool) {
 			stonfiPoolIDs = append(stonfiPoolIDs, trace.Account)
 		}
+		if isDNSRecordChange(trace.InMsg) {
+			dnsChanges = append(dnsChanges, trace)
+		}
 	})
-	stonfiPools, err := infoSource.STONfiPools(ctx, stonfiPoolIDs)
-	if err != nil {
-		return err
+	// a failing source doesn't prevent us from collecting the rest of information,
+	// traces relying on it are marked as incomplete.
+	var errs []error
+	stonfiPools, poolsErr := infoSource.STONfiPools(ctx, stonfiPoolIDs)
+	if poolsErr != nil {
+		errs = append(errs, fmt.Errorf("failed to get STONfi pools: %w", poolsErr))
 	}
 	for _, pool := range stonfiPools {
 		jettonWallets = append(jettonWallets, pool.Token0)
 		jettonWallets = append(jettonWallets, pool.Token1)
 	}
-	masters, err := infoSource.JettonMastersForWallets(ctx, jettonWallets)
-	if err != nil {
-		return err
+	masters, mastersErr := infoSource.JettonMastersForWallets(ctx, jettonWallets)
+	if mastersErr != nil {
+		errs = append(errs, fmt.Errorf("failed to get jetton masters: %w", mastersErr))
 	}
-	basicNftSales, err := infoSource.NftSaleContracts(ctx, saleContracts)
-	if err != nil {
-		return err
+	basicNftSales, salesErr := infoSource.NftSaleContracts(ctx, saleContracts)
+	if salesErr != nil {
+		errs = append(errs, fmt.Errorf("failed to get NFT sale contracts: %w", salesErr))
+	}
+	// previous records are nice to have, so an item without them is reported without old values.
+	dnsRecords := map[*Trace]map[tlb.Bits256]tlb.DNSRecord{}
+	if dnsSource, ok := infoSource.(DNSRecordsSource); ok {
+		for _, change := range dnsChanges {
+			records, err := dnsSource.DNSRecordsBefore(ctx, change.Account, change.BlockID)
+			if err == nil {
+				dnsRecords[change] = records
+			}
+		}
 	}
 	Visit(trace, func(trace *Trace) {
 		// when we emulate a trace,
 		// we construct "trace.AdditionalInfo" in emulatedTreeToTrace for all accounts the trace touches.
 		// moreover, some accounts change their states and some of them are not exist in the blockchain,
 		// so we must not inspect them again.
-		if trace.AdditionalInfo() != nil {
+		if info := trace.AdditionalInfo(); info != nil && !info.Incomplete {
 			return
 		}
 		additionalInfo := &TraceAdditionalInfo{}
@@ -201,6 +250,7 @@ func CollectAdditionalInfo(ctx context.Context, infoSource InformationSource, tr
 			if master, ok := masters[*trace.InMsg.Destination]; ok {
 				additionalInfo.SetJettonMaster(*trace.InMsg.Destination, master)
 			}
+			additionalInfo.Incomplete = additionalInfo.Incomplete || mastersErr != nil
 		}
 		if hasInterface(trace.AccountInterfaces, abi.NftSaleV1) ||
 			hasInterface(trace.AccountInterfaces, abi.NftSaleV2) ||
@@ -208,17 +258,22 @@ func CollectAdditionalInfo(ctx context.Context, infoSource InformationSource, tr
 			if sale, ok := basicNftSales[trace.Account]; ok {
 				additionalInfo.NftSaleContract = &sale
 			}
+			additionalInfo.Incomplete = additionalInfo.Incomplete || salesErr != nil
 		}
 		if hasInterface(trace.AccountInterfaces, abi.StonfiPool) {
+			additionalInfo.Incomplete = additionalInfo.Incomplete || poolsErr != nil || mastersErr != nil
 			if pool, ok := stonfiPools[trace.Account]; ok {
 				additionalInfo.STONfiPool = &pool
 				additionalInfo.SetJettonMaster(pool.Token0, masters[pool.Token0])
 				additionalInfo.SetJettonMaster(pool.Token1, masters[pool.Token1])
 			}
 		}
+		if records, ok := dnsRecords[trace]; ok {
+			additionalInfo.DNSRecords = records
+		}
 		trace.SetAdditionalInfo(additionalInfo)
 	})
-	return nil
+	return errors.Join(errs...)
 }
 
 func (info *TraceAdditionalInfo) JettonMaster(jettonWallet tongo.AccountID) (tongo.AccountID, bool) {
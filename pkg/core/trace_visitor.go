@@ -0,0 +1,174 @@
+package core
+
+import (
+	"github.com/tonkeeper/tongo"
+)
+
+// TraceVisitor is called for every node of a trace during a depth-first traversal.
+// Depth is 0 for the root of the trace.
+// If TraceVisitor returns false, children of the given node are not visited.
+type TraceVisitor func(trace *Trace, depth int) bool
+
+// TracePredicate reports whether the given node of a trace matches some condition.
+type TracePredicate func(trace *Trace) bool
+
+// VisitWithDepth walks the trace in pre-order and calls fn for each node.
+// Unlike Visit, fn receives a depth of the node and is able to prune a subtree by returning false.
+func VisitWithDepth(trace *Trace, fn TraceVisitor) {
+	visitWithDepth(trace, 0, fn)
+}
+
+func visitWithDepth(trace *Trace, depth int, fn TraceVisitor) {
+	if !fn(trace, depth) {
+		return
+	}
+	for _, child := range trace.Children {
+		visitWithDepth(child, depth+1, fn)
+	}
+}
+
+// Depth returns the length of the longest path from the root of the trace to its leaf.
+// A trace without children has depth 0.
+func Depth(trace *Trace) int {
+	maxDepth := 0
+	VisitWithDepth(trace, func(_ *Trace, depth int) bool {
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+		return true
+	})
+	return maxDepth
+}
+
+// Find returns all nodes of the trace matching the given predicate in pre-order.
+func Find(trace *Trace, pred TracePredicate) []*Trace {
+	var result []*Trace
+	Visit(trace, func(trace *Trace) {
+		if pred(trace) {
+			result = append(result, trace)
+		}
+	})
+	return result
+}
+
+// FindFirst returns the first node of the trace matching the given predicate in pre-order.
+func FindFirst(trace *Trace, pred TracePredicate) (*Trace, bool) {
+	var found *Trace
+	VisitWithDepth(trace, func(trace *Trace, _ int) bool {
+		if found != nil {
+			return false
+		}
+		if pred(trace) {
+			found = trace
+			return false
+		}
+		return true
+	})
+	return found, found != nil
+}
+
+// ByAccount matches nodes whose transaction belongs to the given account.
+func ByAccount(account tongo.AccountID) TracePredicate {
+	return func(trace *Trace) bool {
+		return trace.Account == account
+	}
+}
+
+// ByOpCode matches nodes whose inbound message body starts with the given operation code.
+func ByOpCode(opCode uint32) TracePredicate {
+	return func(trace *Trace) bool {
+		return trace.InMsg != nil && trace.InMsg.OpCode != nil && *trace.InMsg.OpCode == opCode
+	}
+}
+
+// ByOperation matches nodes whose inbound message was decoded as the given abi operation, e.g. "JettonTransfer".
+func ByOperation(operation string) TracePredicate {
+	return func(trace *Trace) bool {
+		return trace.InMsg != nil && trace.InMsg.DecodedBody != nil && trace.InMsg.DecodedBody.Operation == operation
+	}
+}
+
+// FindByAccount returns all nodes of the trace executed on the given account.
+func FindByAccount(trace *Trace, account tongo.AccountID) []*Trace {
+	return Find(trace, ByAccount(account))
+}
+
+// FindByOpCode returns all nodes of the trace triggered by a message with the given operation code.
+func FindByOpCode(trace *Trace, opCode uint32) []*Trace {
+	return Find(trace, ByOpCode(opCode))
+}
+
+// FindTransaction returns a node of the trace with the given transaction hash.
+func FindTransaction(trace *Trace, hash tongo.Bits256) (*Trace, bool) {
+	return FindFirst(trace, func(trace *Trace) bool {
+		return trace.Hash == hash
+	})
+}
+
+// PathToTransaction returns indexes of children leading from the root of the trace
+// to the node with the given transaction hash.
+// The path is empty if the root itself has the given hash.
+func PathToTransaction(trace *Trace, hash tongo.Bits256) ([]int, bool) {
+	if trace.Hash == hash {
+		return []int{}, true
+	}
+	for i, child := range trace.Children {
+		if path, ok := PathToTransaction(child, hash); ok {
+			return append([]int{i}, path...), true
+		}
+	}
+	return nil, false
+}
+
+// FlattenTransactions returns all transactions of the trace in pre-order.
+func FlattenTransactions(trace *Trace) []*Transaction {
+	var txs []*Transaction
+	Visit(trace, func(trace *Trace) {
+		txs = append(txs, &trace.Transaction)
+	})
+	return txs
+}
+
+// TonFlow contains a change of TON balance of a particular account caused by a trace.
+type TonFlow struct {
+	// Received is a sum of values of inbound internal messages.
+	Received int64
+	// Sent is a sum of values of outbound internal messages.
+	Sent int64
+	// Fees is a sum of total fees paid by the account.
+	Fees int64
+}
+
+// Net returns the resulting change of the account's balance.
+func (f TonFlow) Net() int64 {
+	return f.Received - f.Sent - f.Fees
+}
+
+// ComputeTonFlow goes over the whole trace and calculates how TON moved between the involved accounts.
+// Outbound messages are taken from the inbound messages of children,
+// because a trace keeps only external outbound messages in OutMsgs.
+func ComputeTonFlow(trace *Trace) map[tongo.AccountID]*TonFlow {
+	flows := make(map[tongo.AccountID]*TonFlow)
+	get := func(account tongo.AccountID) *TonFlow {
+		flow, ok := flows[account]
+		if !ok {
+			flow = &TonFlow{}
+			flows[account] = flow
+		}
+		return flow
+	}
+	Visit(trace, func(trace *Trace) {
+		flow := get(trace.Account)
+		flow.Fees += trace.TotalFee
+		if trace.InMsg != nil && trace.InMsg.MsgType == IntMsg {
+			flow.Received += trace.InMsg.Value
+		}
+		for _, child := range trace.Children {
+			if child.InMsg == nil || child.InMsg.MsgType != IntMsg {
+				continue
+			}
+			flow.Sent += child.InMsg.Value
+		}
+	})
+	return flows
+}
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+)
+
+var (
+	visitorAccount1 = tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+	visitorAccount2 = tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580352")
+	visitorAccount3 = tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580353")
+)
+
+func visitorTestTrace() *Trace {
+	opCode := uint32(0x0f8a7ea5)
+	return &Trace{
+		Transaction: Transaction{
+			TransactionID: TransactionID{Hash: tongo.Bits256{1}, Account: visitorAccount1},
+			TotalFee:      10,
+			InMsg:         &Message{MsgType: ExtInMsg},
+		},
+		Children: []*Trace{
+			{
+				Transaction: Transaction{
+					TransactionID: TransactionID{Hash: tongo.Bits256{2}, Account: visitorAccount2},
+					TotalFee:      5,
+					InMsg:         &Message{MsgType: IntMsg, Value: 1000, OpCode: &opCode},
+				},
+				Children: []*Trace{
+					{
+						Transaction: Transaction{
+							TransactionID: TransactionID{Hash: tongo.Bits256{3}, Account: visitorAccount3},
+							TotalFee:      1,
+							InMsg:         &Message{MsgType: IntMsg, Value: 100},
+						},
+					},
+				},
+			},
+			{
+				Transaction: Transaction{
+					TransactionID: TransactionID{Hash: tongo.Bits256{4}, Account: visitorAccount2},
+					TotalFee:      2,
+					InMsg:         &Message{MsgType: IntMsg, Value: 50},
+				},
+			},
+		},
+	}
+}
+
+func TestVisitWithDepth(t *testing.T) {
+	trace := visitorTestTrace()
+	var depths []int
+	VisitWithDepth(trace, func(trace *Trace, depth int) bool {
+		depths = append(depths, depth)
+		return true
+	})
+	require.Equal(t, []int{0, 1, 2, 1}, depths)
+	require.Equal(t, 2, Depth(trace))
+
+	var visited int
+	VisitWithDepth(trace, func(trace *Trace, depth int) bool {
+		visited++
+		return depth == 0
+	})
+	require.Equal(t, 3, visited)
+}
+
+func TestFind(t *testing.T) {
+	trace := visitorTestTrace()
+
+	found := FindByAccount(trace, visitorAccount2)
+	require.Len(t, found, 2)
+	require.Equal(t, tongo.Bits256{2}, found[0].Hash)
+	require.Equal(t, tongo.Bits256{4}, found[1].Hash)
+
+	found = FindByOpCode(trace, 0x0f8a7ea5)
+	require.Len(t, found, 1)
+	require.Equal(t, visitorAccount2, found[0].Account)
+
+	node, ok := FindTransaction(trace, tongo.Bits256{3})
+	require.True(t, ok)
+	require.Equal(t, visitorAccount3, node.Account)
+
+	_, ok = FindTransaction(trace, tongo.Bits256{5})
+	require.False(t, ok)
+}
+
+func TestPathToTransaction(t *testing.T) {
+	trace := visitorTestTrace()
+	tests := []struct {
+		name   string
+		hash   tongo.Bits256
+		want   []int
+		wantOk bool
+	}{
+		{name: "root", hash: tongo.Bits256{1}, want: []int{}, wantOk: true},
+		{name: "child", hash: tongo.Bits256{2}, want: []int{0}, wantOk: true},
+		{name: "grandchild", hash: tongo.Bits256{3}, want: []int{0, 0}, wantOk: true},
+		{name: "second child", hash: tongo.Bits256{4}, want: []int{1}, wantOk: true},
+		{name: "unknown", hash: tongo.Bits256{5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, ok := PathToTransaction(trace, tt.hash)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, path)
+		})
+	}
+}
+
+func TestFlattenTransactions(t *testing.T) {
+	txs := FlattenTransactions(visitorTestTrace())
+	hashes := make([]tongo.Bits256, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash)
+	}
+	require.Equal(t, []tongo.Bits256{{1}, {2}, {3}, {4}}, hashes)
+}
+
+func TestComputeTonFlow(t *testing.T) {
+	flows := ComputeTonFlow(visitorTestTrace())
+	require.Equal(t, TonFlow{Sent: 1050, Fees: 10}, *flows[visitorAccount1])
+	require.Equal(t, TonFlow{Received: 1050, Sent: 100, Fees: 7}, *flows[visitorAccount2])
+	require.Equal(t, TonFlow{Received: 100, Fees: 1}, *flows[visitorAccount3])
+	require.Equal(t, int64(943), flows[visitorAccount2].Net())
+}
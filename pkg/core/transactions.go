@@ -46,6 +46,7 @@ type TxComputePhase struct {
 	Success    bool
 	GasFees    uint64
 	GasUsed    big.Int
+	GasLimit   big.Int
 	VmSteps    uint32
 	ExitCode   int32
 }
@@ -90,6 +91,7 @@ type Transaction struct {
 	InMsg      *Message
 	OutMsgs    []Message
 	BlockID    tongo.BlockID
+	BlockIDExt tongo.BlockIDExt `json:"-"`
 	OrigStatus tlb.AccountStatus
 	EndStatus  tlb.AccountStatus
 
@@ -107,6 +109,8 @@ type Transaction struct {
 
 	Aborted   bool
 	Destroyed bool
+	// IsTock distinguishes a tock transaction from a tick one, it is only set for TickTockTx.
+	IsTock bool
 
 	// StorageFee collected during the Storage Phase.
 	StorageFee int64
@@ -163,6 +167,7 @@ type Message struct {
 	Bounce            bool
 	Bounced           bool
 	Value             int64
+	ValueExtra        []Currency
 	FwdFee            int64
 	IhrFee            int64
 	ImportFee         int64
@@ -181,6 +186,18 @@ type DecodedMessageBody struct {
 	Value     any
 }
 
+// TextComment returns a text comment carried by the message.
+func (m *Message) TextComment() (string, bool) {
+	if m.DecodedBody == nil || m.DecodedBody.Operation != abi.TextCommentMsgOp {
+		return "", false
+	}
+	body, ok := m.DecodedBody.Value.(abi.TextCommentMsgBody)
+	if !ok {
+		return "", false
+	}
+	return string(body.Text), true
+}
+
 // ExternalAddress represents either the source or destination address of
 // external inbound(ExtInMsg) or external outbound(ExtOutMsg) message correspondingly.
 type ExternalAddress = boc.BitString
@@ -0,0 +1,384 @@
+// Package deposits matches incoming transfers against deposits an exchange expects.
+//
+// An exchange registers an expected deposit as an address, an optional comment (memo) and a minimal amount.
+// Every incoming TON transfer to a registered address is classified as matched or unmatched,
+// unmatched transfers carry a reason, so a wrong or a missing memo is visible without custom reconciliation code.
+// Expectations are kept in a local JSON file or in a repository, so they survive restarts.
+package deposits
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+const (
+	maxCommentLength = 1024
+
+	// Collection is a name of the repository collection with expectations.
+	Collection = "deposits"
+	// repositoryTimeout limits a single change written to a repository.
+	repositoryTimeout = 5 * time.Second
+)
+
+// ErrInvalidExpectation is returned by Registry.Add when an expectation can't be registered as is.
+var ErrInvalidExpectation = errors.New("invalid expectation")
+
+// Expectation is a deposit an exchange expects to receive.
+type Expectation struct {
+	ID      string          `json:"id"`
+	Account tongo.AccountID `json:"account"`
+	// Comment is a memo identifying a depositor, an empty comment matches any transfer to the account.
+	Comment string `json:"comment,omitempty"`
+	// MinAmount is a minimal amount in nanotons, smaller transfers are reported as insufficient.
+	MinAmount int64 `json:"min_amount"`
+	// Tenant is a name of a tenant owning the expectation, empty for expectations registered by an operator.
+	Tenant    string `json:"tenant,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// Status is a result of matching an incoming transfer.
+type Status string
+
+const (
+	StatusMatched   Status = "matched"
+	StatusUnmatched Status = "unmatched"
+	// StatusReverted voids a previously reported transfer dropped by a chain reorganization.
+	StatusReverted Status = "reverted"
+)
+
+// Reason explains why a transfer is unmatched.
+type Reason string
+
+const (
+	// ReasonInsufficientAmount means the comment matches an expectation, but the amount is below its minimum.
+	ReasonInsufficientAmount Reason = "insufficient_amount"
+	// ReasonWrongMemo means the transfer has a comment that matches no expectation of the account.
+	ReasonWrongMemo Reason = "wrong_memo"
+	// ReasonMissingMemo means the transfer has no comment, but all expectations of the account require one.
+	ReasonMissingMemo Reason = "missing_memo"
+)
+
+// Event is a classification of an incoming transfer.
+// This is part of our API contract with subscribers.
+type Event struct {
+	Account tongo.AccountID  `json:"account"`
+	TxHash  string           `json:"tx_hash"`
+	Lt      uint64           `json:"lt"`
+	Sender  *tongo.AccountID `json:"sender,omitempty"`
+	Amount  int64            `json:"amount"`
+	Comment string           `json:"comment,omitempty"`
+	Status  Status           `json:"status"`
+	Reason  Reason           `json:"reason,omitempty"`
+	// ExpectationID is set when the transfer is matched or is insufficient for a matching expectation.
+	ExpectationID string `json:"expectation_id,omitempty"`
+}
+
+// Registry keeps expected deposits in memory and persists every change to a file or to a repository.
+type Registry struct {
+	// path is a file with expectations, an empty path keeps expectations in memory only.
+	path string
+	// repo, if set, is used instead of the file.
+	repo repository.Repository
+
+	// mu protects expectations and writes to the file.
+	mu           sync.RWMutex
+	expectations map[string]Expectation
+	byAccount    map[tongo.AccountID][]string
+	// generation is incremented by every change, so Reload can tell its records are outdated.
+	generation uint64
+}
+
+// NewRegistry returns a registry backed by the given file, the file is created with the first change.
+func NewRegistry(path string) (*Registry, error) {
+	r := &Registry{
+		path:         path,
+		expectations: map[string]Expectation{},
+		byAccount:    map[tongo.AccountID][]string{},
+	}
+	if path == "" {
+		return r, nil
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var expectations []Expectation
+	if err := json.Unmarshal(content, &expectations); err != nil {
+		return nil, fmt.Errorf("failed to decode %v: %w", path, err)
+	}
+	for _, e := range expectations {
+		r.add(e)
+	}
+	return r, nil
+}
+
+// NewRepositoryRegistry returns a registry backed by the repository.
+func NewRepositoryRegistry(ctx context.Context, repo repository.Repository) (*Registry, error) {
+	r := &Registry{
+		repo:         repo,
+		expectations: map[string]Expectation{},
+		byAccount:    map[tongo.AccountID][]string{},
+	}
+	if err := r.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload replaces expectations with the ones from the repository, so changes made by other replicas become visible.
+// It does nothing for a registry backed by a file.
+// If the registry is changed while the repository is being read, the records are discarded
+// as they might miss the change, the next reload picks it up.
+func (r *Registry) Reload(ctx context.Context) error {
+	if r.repo == nil {
+		return nil
+	}
+	r.mu.RLock()
+	generation := r.generation
+	r.mu.RUnlock()
+	records, err := r.repo.List(ctx, Collection)
+	if err != nil {
+		return err
+	}
+	expectations := make([]Expectation, 0, len(records))
+	for _, record := range records {
+		var e Expectation
+		if err := json.Unmarshal(record.Value, &e); err != nil {
+			return fmt.Errorf("failed to decode expectation %v: %w", record.Key, err)
+		}
+		expectations = append(expectations, e)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.generation != generation {
+		return nil
+	}
+	r.expectations = make(map[string]Expectation, len(expectations))
+	r.byAccount = map[tongo.AccountID][]string{}
+	for _, e := range expectations {
+		r.add(e)
+	}
+	return nil
+}
+
+// Add registers an expected deposit and returns it with a generated ID.
+func (r *Registry) Add(account tongo.AccountID, comment string, minAmount int64, tenant string) (Expectation, error) {
+	comment = strings.TrimSpace(comment)
+	if len(comment) > maxCommentLength {
+		return Expectation{}, fmt.Errorf("%w: comment is longer than %v bytes", ErrInvalidExpectation, maxCommentLength)
+	}
+	if minAmount < 0 {
+		return Expectation{}, fmt.Errorf("%w: min amount is negative", ErrInvalidExpectation)
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return Expectation{}, err
+	}
+	e := Expectation{
+		ID:        hex.EncodeToString(id),
+		Account:   account,
+		Comment:   comment,
+		MinAmount: minAmount,
+		Tenant:    tenant,
+		CreatedAt: time.Now().Unix(),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.generation++
+	r.add(e)
+	if err := r.persist(e.ID, &e); err != nil {
+		r.remove(e.ID)
+		return Expectation{}, err
+	}
+	return e, nil
+}
+
+func (r *Registry) add(e Expectation) {
+	r.expectations[e.ID] = e
+	r.byAccount[e.Account] = append(r.byAccount[e.Account], e.ID)
+}
+
+func (r *Registry) remove(id string) {
+	e := r.expectations[id]
+	delete(r.expectations, id)
+	ids := r.byAccount[e.Account]
+	for i := range ids {
+		if ids[i] == id {
+			ids = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+	if len(ids) == 0 {
+		delete(r.byAccount, e.Account)
+		return
+	}
+	r.byAccount[e.Account] = ids
+}
+
+// Get returns an expectation by its ID.
+func (r *Registry) Get(id string) (Expectation, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.expectations[id]
+	return e, ok
+}
+
+// List returns expectations accepted by the filter ordered by creation time, nil filter accepts all.
+func (r *Registry) List(filter func(e Expectation) bool) []Expectation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.list(filter)
+}
+
+func (r *Registry) list(filter func(e Expectation) bool) []Expectation {
+	expectations := make([]Expectation, 0, len(r.expectations))
+	for _, e := range r.expectations {
+		if filter == nil || filter(e) {
+			expectations = append(expectations, e)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		if expectations[i].CreatedAt != expectations[j].CreatedAt {
+			return expectations[i].CreatedAt < expectations[j].CreatedAt
+		}
+		return expectations[i].ID < expectations[j].ID
+	})
+	return expectations
+}
+
+// Delete removes an expectation and reports whether it existed.
+func (r *Registry) Delete(id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.expectations[id]
+	if !ok {
+		return false, nil
+	}
+	r.generation++
+	r.remove(id)
+	if err := r.persist(id, nil); err != nil {
+		r.add(e)
+		return false, err
+	}
+	return true, nil
+}
+
+// Watches reports whether the account has expected deposits.
+func (r *Registry) Watches(account tongo.AccountID) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.byAccount[account]) > 0
+}
+
+// Classify matches an incoming TON transfer of the transaction against expectations of its account.
+// It returns false if the transaction is not an incoming transfer or the account has no expectations.
+// Failed transactions are skipped, because a bounceable transfer goes back to its sender.
+func (r *Registry) Classify(tx *core.Transaction) (Event, bool) {
+	in := tx.InMsg
+	if !tx.Success || in == nil || in.MsgType != core.IntMsg || in.Bounced || in.Value <= 0 {
+		return Event{}, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := r.byAccount[tx.Account]
+	if len(ids) == 0 {
+		return Event{}, false
+	}
+	comment, hasComment := in.TextComment()
+	comment = strings.TrimSpace(comment)
+	event := Event{
+		Account: tx.Account,
+		TxHash:  tx.Hash.Hex(),
+		Lt:      tx.Lt,
+		Sender:  in.Source,
+		Amount:  in.Value,
+		Comment: comment,
+	}
+	// an expectation with the same comment takes precedence over one accepting any comment.
+	var match *Expectation
+	for _, id := range ids {
+		e := r.expectations[id]
+		if e.Comment == "" && match == nil {
+			match = &e
+		}
+		if e.Comment != "" && hasComment && e.Comment == comment {
+			match = &e
+			break
+		}
+	}
+	switch {
+	case match != nil && in.Value >= match.MinAmount:
+		event.Status = StatusMatched
+		event.ExpectationID = match.ID
+	case match != nil:
+		event.Status = StatusUnmatched
+		event.Reason = ReasonInsufficientAmount
+		event.ExpectationID = match.ID
+	case comment == "":
+		event.Status = StatusUnmatched
+		event.Reason = ReasonMissingMemo
+	default:
+		event.Status = StatusUnmatched
+		event.Reason = ReasonWrongMemo
+	}
+	return event, true
+}
+
+// persist stores a change of the expectation, a nil expectation means it has been deleted.
+func (r *Registry) persist(id string, e *Expectation) error {
+	if r.repo == nil {
+		return r.save()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), repositoryTimeout)
+	defer cancel()
+	if e == nil {
+		return r.repo.Delete(ctx, Collection, id)
+	}
+	value, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return r.repo.Put(ctx, Collection, repository.Record{Key: id, Value: value})
+}
+
+// save writes expectations to a temporary file and renames it, so the file is never left half-written.
+func (r *Registry) save() error {
+	if r.path == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(r.list(nil), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), r.path)
+}
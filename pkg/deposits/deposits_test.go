@@ -0,0 +1,167 @@
+package deposits
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+func TestRegistry(t *testing.T) {
+	exchange := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	path := filepath.Join(t.TempDir(), "deposits.json")
+
+	registry, err := NewRegistry(path)
+	require.Nil(t, err)
+	_, err = registry.Add(exchange, "user-1", -1, "")
+	require.ErrorIs(t, err, ErrInvalidExpectation)
+
+	e, err := registry.Add(exchange, " user-1 ", 1_000_000_000, "exchange")
+	require.Nil(t, err)
+	require.Equal(t, "user-1", e.Comment)
+	require.True(t, registry.Watches(exchange))
+
+	// expectations survive a restart.
+	registry, err = NewRegistry(path)
+	require.Nil(t, err)
+	got, ok := registry.Get(e.ID)
+	require.True(t, ok)
+	require.Equal(t, e, got)
+	require.Len(t, registry.List(func(e Expectation) bool { return e.Tenant == "exchange" }), 1)
+	require.Empty(t, registry.List(func(e Expectation) bool { return e.Tenant == "other" }))
+
+	deleted, err := registry.Delete(e.ID)
+	require.Nil(t, err)
+	require.True(t, deleted)
+	require.False(t, registry.Watches(exchange))
+
+	registry, err = NewRegistry(path)
+	require.Nil(t, err)
+	require.Empty(t, registry.List(nil))
+}
+
+func TestRegistry_Classify(t *testing.T) {
+	exchange := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	user := tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+	hotWallet := tongo.MustParseAddress("0:0000000000000000000000000000000000000000000000000000000000000001").ID
+
+	registry, err := NewRegistry("")
+	require.Nil(t, err)
+	expected, err := registry.Add(exchange, "user-1", 1_000_000_000, "")
+	require.Nil(t, err)
+	anyComment, err := registry.Add(hotWallet, "", 0, "")
+	require.Nil(t, err)
+
+	transfer := func(account tongo.AccountID, value int64, comment string) *core.Transaction {
+		msg := &core.Message{MsgType: core.IntMsg, MessageID: core.MessageID{Source: &user, Destination: &account}, Value: value}
+		if comment != "" {
+			msg.DecodedBody = &core.DecodedMessageBody{Operation: abi.TextCommentMsgOp, Value: abi.TextCommentMsgBody{Text: tlb.Text(comment)}}
+		}
+		return &core.Transaction{TransactionID: core.TransactionID{Account: account, Lt: 10}, Success: true, InMsg: msg}
+	}
+	tests := []struct {
+		name          string
+		tx            *core.Transaction
+		want          bool
+		status        Status
+		reason        Reason
+		expectationID string
+	}{
+		{name: "matched", tx: transfer(exchange, 1_000_000_000, "user-1 "), want: true, status: StatusMatched, expectationID: expected.ID},
+		{name: "insufficient amount", tx: transfer(exchange, 999, "user-1"), want: true, status: StatusUnmatched, reason: ReasonInsufficientAmount, expectationID: expected.ID},
+		{name: "wrong memo", tx: transfer(exchange, 1_000_000_000, "user-2"), want: true, status: StatusUnmatched, reason: ReasonWrongMemo},
+		{name: "missing memo", tx: transfer(exchange, 1_000_000_000, ""), want: true, status: StatusUnmatched, reason: ReasonMissingMemo},
+		{name: "any comment", tx: transfer(hotWallet, 1, "whatever"), want: true, status: StatusMatched, expectationID: anyComment.ID},
+		{name: "not registered", tx: transfer(user, 1_000_000_000, "user-1")},
+		{name: "failed", tx: func() *core.Transaction {
+			tx := transfer(exchange, 1_000_000_000, "user-1")
+			tx.Success = false
+			return tx
+		}()},
+		{name: "bounced", tx: func() *core.Transaction {
+			tx := transfer(exchange, 1_000_000_000, "user-1")
+			tx.InMsg.Bounced = true
+			return tx
+		}()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := registry.Classify(tt.tx)
+			require.Equal(t, tt.want, ok)
+			if !ok {
+				return
+			}
+			require.Equal(t, tt.status, event.Status)
+			require.Equal(t, tt.reason, event.Reason)
+			require.Equal(t, tt.expectationID, event.ExpectationID)
+			require.Equal(t, &user, event.Sender)
+		})
+	}
+}
+
+func TestRepositoryRegistry(t *testing.T) {
+	exchange := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	repo := repository.NewMemory()
+
+	registry, err := NewRepositoryRegistry(context.Background(), repo)
+	require.Nil(t, err)
+	e, err := registry.Add(exchange, "user-1", 1_000_000_000, "exchange")
+	require.Nil(t, err)
+
+	// another replica starts watching the account after a reload.
+	replica, err := NewRepositoryRegistry(context.Background(), repo)
+	require.Nil(t, err)
+	got, ok := replica.Get(e.ID)
+	require.True(t, ok)
+	require.Equal(t, e, got)
+	require.True(t, replica.Watches(exchange))
+
+	deleted, err := registry.Delete(e.ID)
+	require.Nil(t, err)
+	require.True(t, deleted)
+	require.Nil(t, replica.Reload(context.Background()))
+	require.False(t, replica.Watches(exchange))
+	require.Empty(t, replica.List(nil))
+}
+
+// changingRepository runs onList after reading records, like a change made while a reload is in flight.
+type changingRepository struct {
+	repository.Repository
+	onList func()
+}
+
+func (r *changingRepository) List(ctx context.Context, collection string) ([]repository.Record, error) {
+	records, err := r.Repository.List(ctx, Collection)
+	if r.onList != nil {
+		r.onList()
+	}
+	return records, err
+}
+
+func TestRegistry_Reload_concurrentChange(t *testing.T) {
+	exchange := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	repo := &changingRepository{Repository: repository.NewMemory()}
+	registry, err := NewRepositoryRegistry(context.Background(), repo)
+	require.Nil(t, err)
+
+	var e Expectation
+	repo.onList = func() {
+		e, err = registry.Add(exchange, "user-1", 1_000_000_000, "exchange")
+		require.Nil(t, err)
+	}
+	require.Nil(t, registry.Reload(context.Background()))
+	_, ok := registry.Get(e.ID)
+	require.True(t, ok)
+
+	repo.onList = nil
+	require.Nil(t, registry.Reload(context.Background()))
+	_, ok = registry.Get(e.ID)
+	require.True(t, ok)
+}
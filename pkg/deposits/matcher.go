@@ -0,0 +1,118 @@
+package deposits
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+)
+
+var depositNumber = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "deposits_classified",
+	Help: "Number of classified incoming transfers",
+}, []string{"status"})
+
+// SubscribeOptions configures a subscription to deposit events.
+type SubscribeOptions struct {
+	AllAccounts bool
+	Accounts    []tongo.AccountID
+}
+
+type storage interface {
+	GetTransaction(ctx context.Context, hash tongo.Bits256) (*core.Transaction, error)
+}
+
+// Matcher classifies incoming transfers to accounts with expected deposits and delivers Event to subscribers.
+// Transactions are loaded from the storage, so deposit addresses must be tracked by it.
+type Matcher struct {
+	logger     *zap.Logger
+	registry   *Registry
+	storage    storage
+	source     sources.TransactionSource
+	dispatcher *sources.TraceDispatcher
+}
+
+func NewMatcher(logger *zap.Logger, registry *Registry, storage storage, source sources.TransactionSource) *Matcher {
+	return &Matcher{
+		logger:   logger,
+		registry: registry,
+		storage:  storage,
+		source:   source,
+		// fan-out of deposit events by accounts is the same as of traces.
+		dispatcher: sources.NewTraceDispatcher(logger),
+	}
+}
+
+// SubscribeToDeposits delivers classifications of incoming transfers to the given accounts.
+func (m *Matcher) SubscribeToDeposits(ctx context.Context, deliveryFn sources.DeliveryFn, opts SubscribeOptions) sources.CancelFn {
+	return m.dispatcher.RegisterSubscriber(deliveryFn, sources.SubscribeToTraceOptions{
+		AllAccounts: opts.AllAccounts,
+		Accounts:    opts.Accounts,
+	})
+}
+
+func (m *Matcher) Run(ctx context.Context) {
+	txCh := make(chan sources.TransactionEventData, 1000)
+	cancelFn := m.source.SubscribeToTransactions(ctx, func(eventData []byte) {
+		var tx sources.TransactionEventData
+		if err := json.Unmarshal(eventData, &tx); err != nil {
+			m.logger.Error("json.Unmarshal() failed", zap.Error(err))
+			return
+		}
+		// synthetic transactions don't exist in the storage and must never be credited.
+		if tx.Simulated || !m.registry.Watches(tx.AccountID) {
+			return
+		}
+		txCh <- tx
+	}, sources.SubscribeToTransactionsOptions{AllAccounts: true, AllOperations: true})
+	defer cancelFn()
+
+	for txEvent := range txCh {
+		if ctx.Err() != nil {
+			return
+		}
+		if txEvent.Reverted {
+			m.dispatch(Event{Account: txEvent.AccountID, TxHash: txEvent.TxHash, Lt: txEvent.Lt, Status: StatusReverted})
+			continue
+		}
+		go m.classify(ctx, txEvent)
+	}
+}
+
+// classify waits for the storage to index the transaction and dispatches its classification.
+func (m *Matcher) classify(ctx context.Context, txEvent sources.TransactionEventData) {
+	var hash tongo.Bits256
+	if err := hash.FromHex(txEvent.TxHash); err != nil {
+		m.logger.Error("hash.FromHex() failed", zap.Error(err))
+		return
+	}
+	for i := 0; i < 15; i++ {
+		tx, err := m.storage.GetTransaction(ctx, hash)
+		if err != nil {
+			time.Sleep(1 * time.Second)
+			continue
+		}
+		if event, ok := m.registry.Classify(tx); ok {
+			m.dispatch(event)
+		}
+		return
+	}
+	depositNumber.With(map[string]string{"status": "failed-to-load"}).Inc()
+}
+
+func (m *Matcher) dispatch(event Event) {
+	depositNumber.With(map[string]string{"status": string(event.Status)}).Inc()
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		m.logger.Error("json.Marshal() failed", zap.Error(err))
+		return
+	}
+	m.dispatcher.Dispatch([]tongo.AccountID{event.Account}, eventJSON)
+}
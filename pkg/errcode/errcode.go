@@ -0,0 +1,183 @@
+// Package errcode is a catalog of stable error codes returned by all endpoints along with human-readable descriptions.
+// Clients are supposed to branch on codes, descriptions can change at any time.
+package errcode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tonkeeper/tongo/liteapi/pool"
+	"github.com/tonkeeper/tongo/liteclient"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// Code is a machine-readable error code, it is part of our API contract with clients.
+type Code string
+
+const (
+	BadRequest Code = "bad_request"
+	InvalidBoc Code = "invalid_boc"
+	// Unauthorized means a token is missing or invalid.
+	Unauthorized Code = "unauthorized"
+	// Forbidden means a token is valid but doesn't grant access to the requested data.
+	Forbidden      Code = "forbidden"
+	EntityNotFound Code = "entity_not_found"
+	// MessageRejected means the destination contract didn't accept a message during emulation.
+	MessageRejected Code = "message_rejected"
+	TraceTooLong    Code = "trace_too_long"
+	RateLimited     Code = "rate_limited"
+	// SubscriptionLimitExceeded means a streaming subscription lists too many accounts
+	// or a connection has too many subscriptions.
+	SubscriptionLimitExceeded Code = "subscription_limit_exceeded"
+	LiteServerTimeout         Code = "liteserver_timeout"
+	// LiteServerError means a lite server responded with an error.
+	LiteServerError Code = "liteserver_error"
+	NotImplemented  Code = "not_implemented"
+	// NotIndexed means the requested data exists in the blockchain, but the instance doesn't index it.
+	NotIndexed Code = "not_indexed"
+	// UpstreamUnavailable means no lite server can serve the request at the moment.
+	UpstreamUnavailable Code = "upstream_unavailable"
+	// NotArchived means the requested data is too old for lite servers that don't keep the full history.
+	NotArchived Code = "not_archived"
+	// Unavailable means the instance can't serve the request at the moment, e.g. it is catching up with the network.
+	Unavailable Code = "unavailable"
+	Internal    Code = "internal_error"
+)
+
+// Error attaches a code to an error when the code can't be derived from the error itself.
+type Error struct {
+	Code Code
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap attaches the given code to the error.
+func Wrap(code Code, err error) error {
+	return &Error{Code: code, Err: err}
+}
+
+// Of returns a code of the error responded with the given HTTP status.
+// A code attached with Wrap takes precedence, then well-known errors are recognized,
+// otherwise the code is derived from the status.
+func Of(status int, err error) Code {
+	var codeErr *Error
+	if errors.As(err, &codeErr) {
+		return codeErr.Code
+	}
+	var liteServerErr liteclient.LiteServerErrorC
+	switch {
+	case errors.Is(err, core.ErrEntityNotFound):
+		return EntityNotFound
+	case errors.Is(err, core.ErrTraceIsTooLong):
+		return TraceTooLong
+	case errors.Is(err, core.ErrNotIndexed):
+		return NotIndexed
+	case isNotArchived(err):
+		return NotArchived
+	case isUpstreamUnavailable(err):
+		return UpstreamUnavailable
+	case errors.Is(err, context.DeadlineExceeded), strings.HasPrefix(err.Error(), "request timeout"):
+		// the lite client reports timeouts with "request timeout: context deadline exceeded".
+		return LiteServerTimeout
+	case errors.As(err, &liteServerErr):
+		return LiteServerError
+	}
+	return FromStatus(status)
+}
+
+// Status returns an HTTP status of a domain error, so clients can act on it
+// instead of getting a generic 500 Internal Server Error.
+// It reports false if the error isn't a known domain error.
+func Status(err error) (int, bool) {
+	switch Of(http.StatusInternalServerError, err) {
+	case EntityNotFound:
+		return http.StatusNotFound, true
+	case TraceTooLong:
+		return http.StatusRequestEntityTooLarge, true
+	case RateLimited:
+		return http.StatusTooManyRequests, true
+	case NotIndexed:
+		return http.StatusUnprocessableEntity, true
+	case NotArchived:
+		return http.StatusGone, true
+	case UpstreamUnavailable:
+		return http.StatusBadGateway, true
+	case LiteServerTimeout:
+		return http.StatusGatewayTimeout, true
+	case Unavailable:
+		return http.StatusServiceUnavailable, true
+	}
+	return 0, false
+}
+
+// isNotArchived reports whether a lite server has failed because it doesn't keep the requested state or block anymore.
+func isNotArchived(err error) bool {
+	if errors.Is(err, core.ErrNotArchived) || strings.Contains(err.Error(), "no archive nodes available") {
+		return true
+	}
+	var liteServerErr liteclient.LiteServerErrorC
+	return errors.As(err, &liteServerErr) && strings.Contains(liteServerErr.Message, "state already gc'd")
+}
+
+func isUpstreamUnavailable(err error) bool {
+	if errors.Is(err, core.ErrUpstreamUnavailable) || errors.Is(err, pool.ErrNoConnections) {
+		return true
+	}
+	// the lite client doesn't export errors of broken connections.
+	msg := err.Error()
+	return strings.HasPrefix(msg, "failed to connect to") || strings.Contains(msg, "all liteservers are unavailable")
+}
+
+// FromStatus returns a generic code of the given HTTP status.
+func FromStatus(status int) Code {
+	switch status {
+	case http.StatusBadRequest:
+		return BadRequest
+	case http.StatusUnauthorized:
+		return Unauthorized
+	case http.StatusForbidden:
+		return Forbidden
+	case http.StatusNotFound:
+		return EntityNotFound
+	case http.StatusNotAcceptable:
+		return MessageRejected
+	case http.StatusRequestEntityTooLarge:
+		return TraceTooLong
+	case http.StatusTooManyRequests:
+		return RateLimited
+	case http.StatusNotImplemented:
+		return NotImplemented
+	case http.StatusServiceUnavailable:
+		return Unavailable
+	case http.StatusGatewayTimeout:
+		return LiteServerTimeout
+	}
+	if status >= 400 && status < 500 {
+		return BadRequest
+	}
+	return Internal
+}
+
+// Response is the JSON error envelope shared by all endpoints.
+type Response struct {
+	Error     string `json:"error"`
+	ErrorCode Code   `json:"error_code"`
+}
+
+// Write responds with the error envelope.
+func Write(w http.ResponseWriter, status int, code Code, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Response{Error: msg, ErrorCode: code})
+}
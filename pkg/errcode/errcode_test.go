@@ -0,0 +1,136 @@
+package errcode
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/liteapi/pool"
+	"github.com/tonkeeper/tongo/liteclient"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+func TestOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		err    error
+		want   Code
+	}{
+		{
+			name:   "explicit code",
+			status: http.StatusBadRequest,
+			err:    fmt.Errorf("failed to decode message: %w", Wrap(InvalidBoc, fmt.Errorf("invalid boc magic header"))),
+			want:   InvalidBoc,
+		},
+		{
+			name:   "entity not found",
+			status: http.StatusNotFound,
+			err:    fmt.Errorf("account: %w", core.ErrEntityNotFound),
+			want:   EntityNotFound,
+		},
+		{
+			name:   "trace is too long",
+			status: http.StatusRequestEntityTooLarge,
+			err:    core.ErrTraceIsTooLong,
+			want:   TraceTooLong,
+		},
+		{
+			name:   "deadline exceeded",
+			status: http.StatusInternalServerError,
+			err:    fmt.Errorf("get account state: %w", context.DeadlineExceeded),
+			want:   LiteServerTimeout,
+		},
+		{
+			name:   "lite client timeout",
+			status: http.StatusInternalServerError,
+			err:    fmt.Errorf("request timeout: context deadline exceeded"),
+			want:   LiteServerTimeout,
+		},
+		{
+			name:   "lite server error",
+			status: http.StatusInternalServerError,
+			err:    liteclient.LiteServerErrorC{Code: 651, Message: "block not found"},
+			want:   LiteServerError,
+		},
+		{
+			name:   "not indexed",
+			status: http.StatusInternalServerError,
+			err:    fmt.Errorf("account is not tracked: %w", core.ErrNotIndexed),
+			want:   NotIndexed,
+		},
+		{
+			name:   "state is gone",
+			status: http.StatusInternalServerError,
+			err:    liteclient.LiteServerErrorC{Code: 651, Message: "state already gc'd"},
+			want:   NotArchived,
+		},
+		{
+			name:   "no connections",
+			status: http.StatusInternalServerError,
+			err:    fmt.Errorf("get account state: %w", pool.ErrNoConnections),
+			want:   UpstreamUnavailable,
+		},
+		{
+			name:   "rate limit",
+			status: http.StatusTooManyRequests,
+			err:    fmt.Errorf("rate limit"),
+			want:   RateLimited,
+		},
+		{
+			name:   "unknown client error",
+			status: http.StatusConflict,
+			err:    fmt.Errorf("conflict"),
+			want:   BadRequest,
+		},
+		{
+			name:   "internal error",
+			status: http.StatusInternalServerError,
+			err:    fmt.Errorf("something went wrong"),
+			want:   Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Of(tt.status, tt.err))
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		want   int
+		wantOk bool
+	}{
+		{name: "entity not found", err: core.ErrEntityNotFound, want: http.StatusNotFound, wantOk: true},
+		{name: "not indexed", err: core.ErrNotIndexed, want: http.StatusUnprocessableEntity, wantOk: true},
+		{name: "not archived", err: core.ErrNotArchived, want: http.StatusGone, wantOk: true},
+		{name: "upstream unavailable", err: fmt.Errorf("failed to connect to 1.2.3.4:5"), want: http.StatusBadGateway, wantOk: true},
+		{name: "timeout", err: context.DeadlineExceeded, want: http.StatusGatewayTimeout, wantOk: true},
+		{name: "explicit code", err: Wrap(Unavailable, fmt.Errorf("catching up")), want: http.StatusServiceUnavailable, wantOk: true},
+		{name: "lite server error", err: liteclient.LiteServerErrorC{Code: 651, Message: "block not found"}},
+		{name: "unknown error", err: fmt.Errorf("something went wrong")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, ok := Status(tt.err)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, status)
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Write(rec, http.StatusUnauthorized, Unauthorized, "invalid token")
+
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.JSONEq(t, `{"error":"invalid token","error_code":"unauthorized"}`, rec.Body.String())
+}
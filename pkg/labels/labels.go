@@ -0,0 +1,233 @@
+// Package labels is a private address book of an operator.
+//
+// Internal tools annotate counterparties with labels and memos,
+// unlike the public address book they are never published and are visible to tokens with the admin scope only.
+// Labels are kept in a local JSON file or in a repository, so they survive restarts.
+package labels
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+const (
+	maxNameLength = 128
+	maxMemoLength = 1024
+
+	// Collection is a name of the repository collection with labels.
+	Collection = "labels"
+	// repositoryTimeout limits a single change written to a repository.
+	repositoryTimeout = 5 * time.Second
+)
+
+// ErrInvalidLabel is returned by Store.Set when a label can't be stored as is.
+var ErrInvalidLabel = errors.New("invalid label")
+
+// Label is a private annotation of an account.
+type Label struct {
+	Account   tongo.AccountID `json:"account"`
+	Name      string          `json:"name"`
+	Memo      string          `json:"memo,omitempty"`
+	UpdatedAt int64           `json:"updated_at"`
+}
+
+// Store keeps labels in memory and persists every change to a file or to a repository.
+type Store struct {
+	// path is a file with labels, an empty path keeps labels in memory only.
+	path string
+	// repo, if set, is used instead of the file.
+	repo repository.Repository
+
+	// mu protects labels and writes to the file.
+	mu     sync.RWMutex
+	labels map[tongo.AccountID]Label
+	// generation is incremented by every change, so Reload can tell its records are outdated.
+	generation uint64
+}
+
+// NewStore returns a store backed by the given file, the file is created with the first change.
+func NewStore(path string) (*Store, error) {
+	s := &Store{path: path, labels: map[tongo.AccountID]Label{}}
+	if path == "" {
+		return s, nil
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var labels []Label
+	if err := json.Unmarshal(content, &labels); err != nil {
+		return nil, fmt.Errorf("failed to decode %v: %w", path, err)
+	}
+	for _, label := range labels {
+		s.labels[label.Account] = label
+	}
+	return s, nil
+}
+
+// NewRepositoryStore returns a store backed by the repository.
+func NewRepositoryStore(ctx context.Context, repo repository.Repository) (*Store, error) {
+	s := &Store{repo: repo, labels: map[tongo.AccountID]Label{}}
+	if err := s.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload replaces labels with the ones from the repository, so changes made by other replicas become visible.
+// It does nothing for a store backed by a file.
+// If the store is changed while the repository is being read, the records are discarded
+// as they might miss the change, the next reload picks it up.
+func (s *Store) Reload(ctx context.Context) error {
+	if s.repo == nil {
+		return nil
+	}
+	s.mu.RLock()
+	generation := s.generation
+	s.mu.RUnlock()
+	records, err := s.repo.List(ctx, Collection)
+	if err != nil {
+		return err
+	}
+	labels := make(map[tongo.AccountID]Label, len(records))
+	for _, record := range records {
+		var label Label
+		if err := json.Unmarshal(record.Value, &label); err != nil {
+			return fmt.Errorf("failed to decode label %v: %w", record.Key, err)
+		}
+		labels[label.Account] = label
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation != generation {
+		return nil
+	}
+	s.labels = labels
+	return nil
+}
+
+// Get returns a label of the account.
+func (s *Store) Get(account tongo.AccountID) (Label, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	label, ok := s.labels[account]
+	return label, ok
+}
+
+// List returns all labels ordered by account.
+func (s *Store) List() []Label {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list()
+}
+
+func (s *Store) list() []Label {
+	labels := make([]Label, 0, len(s.labels))
+	for _, label := range s.labels {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Account.ToRaw() < labels[j].Account.ToRaw()
+	})
+	return labels
+}
+
+// Set attaches a label to the account replacing the previous one.
+func (s *Store) Set(account tongo.AccountID, name, memo string) (Label, error) {
+	if name == "" {
+		return Label{}, fmt.Errorf("%w: name is required", ErrInvalidLabel)
+	}
+	if len(name) > maxNameLength {
+		return Label{}, fmt.Errorf("%w: name is longer than %v bytes", ErrInvalidLabel, maxNameLength)
+	}
+	if len(memo) > maxMemoLength {
+		return Label{}, fmt.Errorf("%w: memo is longer than %v bytes", ErrInvalidLabel, maxMemoLength)
+	}
+	label := Label{Account: account, Name: name, Memo: memo, UpdatedAt: time.Now().Unix()}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation++
+	previous, existed := s.labels[account]
+	s.labels[account] = label
+	if err := s.persist(account, &label); err != nil {
+		if existed {
+			s.labels[account] = previous
+		} else {
+			delete(s.labels, account)
+		}
+		return Label{}, err
+	}
+	return label, nil
+}
+
+// Delete removes a label of the account and reports whether it existed.
+func (s *Store) Delete(account tongo.AccountID) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	label, ok := s.labels[account]
+	if !ok {
+		return false, nil
+	}
+	s.generation++
+	delete(s.labels, account)
+	if err := s.persist(account, nil); err != nil {
+		s.labels[account] = label
+		return false, err
+	}
+	return true, nil
+}
+
+// persist stores a change of the account's label, a nil label means the label has been deleted.
+func (s *Store) persist(account tongo.AccountID, label *Label) error {
+	if s.repo == nil {
+		return s.save()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), repositoryTimeout)
+	defer cancel()
+	if label == nil {
+		return s.repo.Delete(ctx, Collection, account.ToRaw())
+	}
+	value, err := json.Marshal(label)
+	if err != nil {
+		return err
+	}
+	return s.repo.Put(ctx, Collection, repository.Record{Key: account.ToRaw(), Value: value})
+}
+
+// save writes labels to a temporary file and renames it, so the file is never left half-written.
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(s.list(), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
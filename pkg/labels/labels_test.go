@@ -0,0 +1,116 @@
+package labels
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+func TestStore(t *testing.T) {
+	alice := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	bob := tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+	path := filepath.Join(t.TempDir(), "labels.json")
+
+	store, err := NewStore(path)
+	require.Nil(t, err)
+	require.Empty(t, store.List())
+
+	_, err = store.Set(alice, "", "memo")
+	require.ErrorIs(t, err, ErrInvalidLabel)
+	_, err = store.Set(alice, "Alice", strings.Repeat("x", maxMemoLength+1))
+	require.ErrorIs(t, err, ErrInvalidLabel)
+
+	label, err := store.Set(alice, "Alice", "INV-1234")
+	require.Nil(t, err)
+	require.Equal(t, "Alice", label.Name)
+	_, err = store.Set(bob, "Bob", "")
+	require.Nil(t, err)
+
+	// labels survive a restart.
+	store, err = NewStore(path)
+	require.Nil(t, err)
+	labels := store.List()
+	require.Len(t, labels, 2)
+	require.Equal(t, bob, labels[0].Account)
+	label, ok := store.Get(alice)
+	require.True(t, ok)
+	require.Equal(t, "INV-1234", label.Memo)
+
+	deleted, err := store.Delete(alice)
+	require.Nil(t, err)
+	require.True(t, deleted)
+	deleted, err = store.Delete(alice)
+	require.Nil(t, err)
+	require.False(t, deleted)
+
+	store, err = NewStore(path)
+	require.Nil(t, err)
+	_, ok = store.Get(alice)
+	require.False(t, ok)
+}
+
+func TestRepositoryStore(t *testing.T) {
+	alice := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	repo := repository.NewMemory()
+
+	store, err := NewRepositoryStore(context.Background(), repo)
+	require.Nil(t, err)
+	_, err = store.Set(alice, "Alice", "INV-1234")
+	require.Nil(t, err)
+
+	// another replica sees the label after a reload.
+	replica, err := NewRepositoryStore(context.Background(), repo)
+	require.Nil(t, err)
+	label, ok := replica.Get(alice)
+	require.True(t, ok)
+	require.Equal(t, "INV-1234", label.Memo)
+
+	deleted, err := store.Delete(alice)
+	require.Nil(t, err)
+	require.True(t, deleted)
+	_, ok = replica.Get(alice)
+	require.True(t, ok)
+	require.Nil(t, replica.Reload(context.Background()))
+	_, ok = replica.Get(alice)
+	require.False(t, ok)
+}
+
+// changingRepository runs onList after reading records, like a change made while a reload is in flight.
+type changingRepository struct {
+	repository.Repository
+	onList func()
+}
+
+func (r *changingRepository) List(ctx context.Context, collection string) ([]repository.Record, error) {
+	records, err := r.Repository.List(ctx, Collection)
+	if r.onList != nil {
+		r.onList()
+	}
+	return records, err
+}
+
+func TestStore_Reload_concurrentChange(t *testing.T) {
+	alice := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	repo := &changingRepository{Repository: repository.NewMemory()}
+	store, err := NewRepositoryStore(context.Background(), repo)
+	require.Nil(t, err)
+
+	repo.onList = func() {
+		_, err := store.Set(alice, "Alice", "INV-1234")
+		require.Nil(t, err)
+	}
+	require.Nil(t, store.Reload(context.Background()))
+	_, ok := store.Get(alice)
+	require.True(t, ok)
+
+	repo.onList = nil
+	require.Nil(t, store.Reload(context.Background()))
+	_, ok = store.Get(alice)
+	require.True(t, ok)
+}
@@ -0,0 +1,117 @@
+// Package literetry retries lite server queries failing with transient errors,
+// e.g. -400 "block is not applied" of a lite server lagging behind the network by a block or two.
+//
+// All lite server queries of the storage and the indexer share a Policy,
+// so a transient error is retried with an exponential backoff and jitter instead of being surfaced to users.
+package literetry
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tonkeeper/tongo/liteclient"
+)
+
+var retriesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "liteserver_retries_total",
+	Help: "Lite server queries sent again after a transient error, by operation",
+}, []string{"operation"})
+
+var retriedQueriesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "liteserver_retried_queries_total",
+	Help: "Lite server queries retried at least once by operation and outcome: recovered or exhausted",
+}, []string{"operation", "outcome"})
+
+// Lite server error codes worth retrying.
+const (
+	// codeFailure is reported by a lite server for blocks and states it doesn't have yet.
+	codeFailure  = -400
+	codeNotReady = 651
+	codeTimeout  = 652
+)
+
+// Policy describes how a query failing with a transient error is retried.
+type Policy struct {
+	// Attempts is a total number of attempts of a query, 1 or less disables retries.
+	Attempts int
+	// Delay is a delay before the first retry, it doubles with every next retry up to MaxDelay.
+	Delay    time.Duration
+	MaxDelay time.Duration
+	// MaxJitter is a maximum random delay added to every delay,
+	// so queries failed at the same time don't hit lite servers at the same time again.
+	MaxJitter time.Duration
+}
+
+// DefaultPolicy retries a query twice within ~200ms, it is enough for a lite server to catch up with a new block.
+var DefaultPolicy = Policy{
+	Attempts:  3,
+	Delay:     50 * time.Millisecond,
+	MaxDelay:  time.Second,
+	MaxJitter: 50 * time.Millisecond,
+}
+
+// IsTransient reports whether a query failed with the error is likely to succeed if sent again.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var liteServerErr liteclient.LiteServerErrorC
+	if errors.As(err, &liteServerErr) {
+		switch int32(liteServerErr.Code) {
+		case codeFailure:
+			// a lite server won't get a gc'd state or a block missing in its db back.
+			return !strings.Contains(liteServerErr.Message, "state already gc'd") &&
+				!strings.Contains(liteServerErr.Message, "not in db")
+		case codeNotReady, codeTimeout:
+			return true
+		}
+		return false
+	}
+	// the lite client reports timeouts with "request timeout: context deadline exceeded".
+	return strings.HasPrefix(err.Error(), "request timeout")
+}
+
+// Do runs the query until it succeeds, fails with a permanent error, or runs out of attempts of the policy.
+// The operation names the query in metrics.
+// It returns the last error of the query, so callers can inspect it as if the query was sent once.
+func Do[T any](ctx context.Context, p Policy, operation string, query func() (T, error)) (T, error) {
+	if p.Attempts <= 1 {
+		return query()
+	}
+	delayType := retry.BackOffDelay
+	if p.MaxJitter > 0 {
+		delayType = retry.CombineDelay(retry.BackOffDelay, retry.RandomDelay)
+	}
+	var value T
+	attempt := 0
+	err := retry.Do(func() error {
+		if attempt > 0 {
+			retriesMetric.WithLabelValues(operation).Inc()
+		}
+		attempt++
+		var err error
+		value, err = query()
+		return err
+	},
+		retry.Attempts(uint(p.Attempts)),
+		retry.Delay(p.Delay),
+		retry.MaxDelay(p.MaxDelay),
+		retry.MaxJitter(p.MaxJitter),
+		retry.DelayType(delayType),
+		retry.RetryIf(IsTransient),
+		retry.LastErrorOnly(true),
+		retry.Context(ctx))
+	if attempt > 1 {
+		outcome := "recovered"
+		if err != nil {
+			outcome = "exhausted"
+		}
+		retriedQueriesMetric.WithLabelValues(operation, outcome).Inc()
+	}
+	return value, err
+}
@@ -0,0 +1,80 @@
+package literetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/liteclient"
+)
+
+func liteServerError(code int32, msg string) error {
+	return liteclient.LiteServerErrorC{Code: uint32(code), Message: msg}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "block is not applied", err: liteServerError(-400, "block is not applied"), want: true},
+		{name: "wrapped", err: fmt.Errorf("failed to get block: %w", liteServerError(-400, "block is not applied")), want: true},
+		{name: "not ready", err: liteServerError(651, "not ready"), want: true},
+		{name: "request timeout", err: errors.New("request timeout: context deadline exceeded"), want: true},
+		{name: "state already gc'd", err: liteServerError(-400, "state already gc'd"), want: false},
+		{name: "not in db", err: liteServerError(-400, "block not in db"), want: false},
+		{name: "other lite server error", err: liteServerError(-256, "account not found")},
+		{name: "canceled", err: context.Canceled},
+		{name: "other", err: errors.New("invalid address")},
+		{name: "nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsTransient(tt.err))
+		})
+	}
+}
+
+func TestDo(t *testing.T) {
+	policy := Policy{Attempts: 3, Delay: time.Millisecond, MaxDelay: 5 * time.Millisecond, MaxJitter: time.Millisecond}
+	transient := liteServerError(-400, "block is not applied")
+	permanent := errors.New("invalid address")
+	tests := []struct {
+		name         string
+		policy       Policy
+		errs         []error
+		wantAttempts int
+		wantErr      error
+	}{
+		{name: "success", policy: policy, errs: []error{nil}, wantAttempts: 1},
+		{name: "recovered", policy: policy, errs: []error{transient, transient, nil}, wantAttempts: 3},
+		{name: "exhausted", policy: policy, errs: []error{transient, transient, transient, nil}, wantAttempts: 3, wantErr: transient},
+		{name: "permanent error", policy: policy, errs: []error{permanent, nil}, wantAttempts: 1, wantErr: permanent},
+		{name: "retries disabled", policy: Policy{Attempts: 1}, errs: []error{transient, nil}, wantAttempts: 1, wantErr: transient},
+		{name: "no jitter", policy: Policy{Attempts: 2, Delay: time.Millisecond}, errs: []error{transient, nil}, wantAttempts: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			value, err := Do(context.Background(), tt.policy, "test", func() (int, error) {
+				err := tt.errs[attempts]
+				attempts++
+				if err != nil {
+					return 0, err
+				}
+				return 42, nil
+			})
+			require.Equal(t, tt.wantAttempts, attempts)
+			if tt.wantErr != nil {
+				require.Equal(t, tt.wantErr, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, 42, value)
+		})
+	}
+}
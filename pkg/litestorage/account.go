@@ -6,10 +6,13 @@ import (
 	"errors"
 	"time"
 
+	"github.com/tonkeeper/tongo/liteapi"
 	"github.com/tonkeeper/tongo/tlb"
 	tongoWallet "github.com/tonkeeper/tongo/wallet"
 
 	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/literetry"
+	"github.com/tonkeeper/opentonapi/pkg/shardroute"
 	"github.com/tonkeeper/tongo"
 )
 
@@ -18,11 +21,26 @@ func (s *LiteStorage) GetSubscriptions(ctx context.Context, address tongo.Accoun
 }
 
 func (s *LiteStorage) GetSeqno(ctx context.Context, account tongo.AccountID) (uint32, error) {
-	return s.client.GetSeqno(ctx, account)
+	return literetry.Do(ctx, s.retryPolicy, "get_seqno", func() (uint32, error) {
+		return s.client.GetSeqno(ctx, account)
+	})
 }
 
 func (s *LiteStorage) GetAccountState(ctx context.Context, a tongo.AccountID) (tlb.ShardAccount, error) {
-	return s.client.GetAccountState(ctx, a)
+	return s.accountState(ctx, a)
+}
+
+// accountState gets the account state from a lite server picked by the shard router, if it is configured.
+// Every retry is routed again, so it may go to another lite server.
+func (s *LiteStorage) accountState(ctx context.Context, a tongo.AccountID) (tlb.ShardAccount, error) {
+	return literetry.Do(ctx, s.retryPolicy, "get_account_state", func() (tlb.ShardAccount, error) {
+		if s.shardRouter == nil {
+			return s.client.GetAccountState(ctx, a)
+		}
+		return shardroute.Query(ctx, s.shardRouter, a, func(ctx context.Context, client *liteapi.Client) (tlb.ShardAccount, error) {
+			return client.GetAccountState(ctx, a)
+		})
+	})
 }
 
 func (s *LiteStorage) SearchAccountsByPubKey(pubKey ed25519.PublicKey) ([]tongo.AccountID, error) {
@@ -57,3 +75,38 @@ func (s *LiteStorage) GetLatencyAndLastMasterchainSeqno(ctx context.Context) (in
 	latency := time.Now().Unix() - int64(blockHeader.GenUtime)
 	return latency, blockHeader.Seqno, nil
 }
+
+// GetAccountStateAtBlock returns the account's state as of the given masterchain block.
+func (s *LiteStorage) GetAccountStateAtBlock(ctx context.Context, a tongo.AccountID, block tongo.BlockIDExt) (tlb.ShardAccount, error) {
+	return literetry.Do(ctx, s.retryPolicy, "get_account_state_at_block", func() (tlb.ShardAccount, error) {
+		return s.client.WithBlock(block).GetAccountState(ctx, a)
+	})
+}
+
+// CountAccountTransactions walks the chain of the account's transactions back from the given last one
+// and counts transactions with lt greater than afterLt.
+// It stops after limit transactions and reports whether the count is exact.
+func (s *LiteStorage) CountAccountTransactions(ctx context.Context, a tongo.AccountID, afterLt uint64, lastLt uint64, lastHash tongo.Bits256, limit int) (int, bool, error) {
+	count := 0
+	lt, hash := lastLt, lastHash
+	for lt > afterLt {
+		if count >= limit {
+			return count, false, nil
+		}
+		txs, err := s.getTransactions(ctx, 16, a, lt, hash)
+		if err != nil {
+			return 0, false, err
+		}
+		if len(txs) == 0 {
+			break
+		}
+		for _, tx := range txs {
+			if tx.Lt <= afterLt {
+				return count, true, nil
+			}
+			count++
+			lt, hash = tx.PrevTransLt, tongo.Bits256(tx.PrevTransHash)
+		}
+	}
+	return count, true, nil
+}
@@ -0,0 +1,75 @@
+package litestorage
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// accountTransactions keeps indexed transactions of a single account sorted by lt,
+// so a page of the account's history is found without scanning the whole transaction index.
+type accountTransactions struct {
+	mu  sync.RWMutex
+	txs []*core.Transaction
+}
+
+func newAccountTransactions() *accountTransactions {
+	return &accountTransactions{}
+}
+
+// search returns an index of the first transaction with lt greater than or equal to the given one.
+func (a *accountTransactions) search(lt uint64) int {
+	return sort.Search(len(a.txs), func(i int) bool {
+		return a.txs[i].Lt >= lt
+	})
+}
+
+func (a *accountTransactions) add(tx *core.Transaction) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	i := a.search(tx.Lt)
+	if i < len(a.txs) && a.txs[i].Lt == tx.Lt {
+		a.txs[i] = tx
+		return
+	}
+	a.txs = append(a.txs, nil)
+	copy(a.txs[i+1:], a.txs[i:])
+	a.txs[i] = tx
+}
+
+func (a *accountTransactions) remove(tx *core.Transaction) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	i := a.search(tx.Lt)
+	if i < len(a.txs) && a.txs[i].Lt == tx.Lt {
+		a.txs = append(a.txs[:i], a.txs[i+1:]...)
+	}
+}
+
+// after returns up to limit transactions with lt greater than afterLt, the earliest ones go first.
+func (a *accountTransactions) after(afterLt uint64, limit int) []*core.Transaction {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	txs := a.txs[a.search(afterLt+1):]
+	if limit > 0 && len(txs) > limit {
+		txs = txs[:limit]
+	}
+	return append([]*core.Transaction(nil), txs...)
+}
+
+// before returns up to limit transactions with lt less than beforeLt, the latest ones go first.
+func (a *accountTransactions) before(beforeLt uint64, limit int) []*core.Transaction {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	end := a.search(beforeLt)
+	start := 0
+	if limit > 0 && end > limit {
+		start = end - limit
+	}
+	txs := make([]*core.Transaction, 0, end-start)
+	for i := end - 1; i >= start; i-- {
+		txs = append(txs, a.txs[i])
+	}
+	return txs
+}
@@ -0,0 +1,72 @@
+package litestorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// storeTransaction puts a transaction into the local index and updates the account's activity stats.
+// Stats are updated only once per transaction, so it's safe to index the same transaction several times.
+func (s *LiteStorage) storeTransaction(accountID tongo.AccountID, hash tongo.Bits256, transaction *core.Transaction, tx *tlb.Transaction) {
+	if _, loaded := s.transactionsIndexByHash.LoadOrStore(hash, transaction); !loaded {
+		txs, _ := s.transactionsByAccount.LoadOrCompute(accountID, newAccountTransactions)
+		txs.add(transaction)
+		activity, _ := s.accountActivity.LoadOrCompute(accountID, core.NewAccountActivity)
+		activity.Add(transaction)
+		if core.MayChangeCode(tx) {
+			history, _ := s.codeHistories.LoadOrCompute(accountID, core.NewCodeHistory)
+			history.Add(transaction)
+		}
+	}
+	if createLT, ok := extractInMsgCreatedLT(accountID, tx); ok {
+		s.transactionsByInMsgLT.Store(createLT, hash)
+	}
+	s.indexMessageHashes(hash, tx)
+	s.indexBounces(hash, transaction)
+}
+
+// removeTransaction rolls back storeTransaction.
+func (s *LiteStorage) removeTransaction(accountID tongo.AccountID, hash tongo.Bits256, tx *tlb.Transaction) {
+	if transaction, loaded := s.transactionsIndexByHash.LoadAndDelete(hash); loaded {
+		if txs, ok := s.transactionsByAccount.Load(accountID); ok {
+			txs.remove(transaction)
+		}
+		if activity, ok := s.accountActivity.Load(accountID); ok {
+			activity.Remove(transaction)
+		}
+		if history, ok := s.codeHistories.Load(accountID); ok {
+			history.Remove(transaction)
+		}
+		s.unindexBounces(hash, transaction)
+	}
+	if createLT, ok := extractInMsgCreatedLT(accountID, tx); ok {
+		s.transactionsByInMsgLT.Delete(createLT)
+	}
+	s.unindexMessageHashes(hash, tx)
+}
+
+// GetAccountStats returns activity stats of an account for transactions with utime >= since.
+// Stats are maintained incrementally while transactions are indexed,
+// so they are available only for tracked accounts.
+func (s *LiteStorage) GetAccountStats(ctx context.Context, accountID tongo.AccountID, since int64) (core.AccountStats, error) {
+	activity, ok := s.accountActivity.Load(accountID)
+	if !ok {
+		return core.AccountStats{}, fmt.Errorf("account is not tracked: %w", core.ErrNotIndexed)
+	}
+	return activity.Stats(since), nil
+}
+
+// GetAccountActivityHeatmap returns numbers of transactions of an account in buckets of the given size covering [from, to).
+// Like stats, the heatmap is available only for tracked accounts.
+func (s *LiteStorage) GetAccountActivityHeatmap(ctx context.Context, accountID tongo.AccountID, from, to, size int64) ([]core.ActivityBucket, error) {
+	activity, ok := s.accountActivity.Load(accountID)
+	if !ok {
+		return nil, fmt.Errorf("account is not tracked: %w", core.ErrNotIndexed)
+	}
+	return activity.Heatmap(from, to, size), nil
+}
@@ -0,0 +1,214 @@
+package litestorage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/liteclient"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// backfillPageSize is a number of transactions requested from a lite server at once.
+const backfillPageSize = 16
+
+// BackfillOptions configures LiteStorage.Backfill.
+type BackfillOptions struct {
+	// Limit caps the number of transactions to load, 0 means the full history.
+	Limit int
+	// StopAtLt, if set, stops the walk at the first transaction with lt <= StopAtLt.
+	// It is used to catch up with the chain after restoring a snapshot.
+	StopAtLt uint64
+	// Replay, if set, is called for every loaded transaction from the oldest to the newest one,
+	// so notifications can be delivered for the history that existed before the account was added.
+	Replay func(tx *core.Transaction)
+}
+
+// BackfillStatus describes the progress of a backfill.
+type BackfillStatus struct {
+	Account      string    `json:"account"`
+	Transactions int       `json:"transactions"`
+	StartedAt    time.Time `json:"started_at"`
+	Done         bool      `json:"done"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// backfills keeps track of backfills started with StartBackfill.
+type backfills struct {
+	mu       sync.Mutex
+	statuses map[tongo.AccountID]*BackfillStatus
+}
+
+// Backfill starts tracking the given account and walks its full transaction history backwards
+// from the current state populating the local index.
+// It returns a number of loaded transactions.
+// Lite servers might not keep the full history, so the walk stops at the oldest transaction available.
+func (s *LiteStorage) Backfill(ctx context.Context, accountID tongo.AccountID, opts BackfillOptions) (int, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		observeStorageTime(ctx, "backfill", v)
+	}))
+	defer timer.ObserveDuration()
+
+	// we start tracking the account first, so new blocks are indexed while we are walking the history.
+	s.trackAccount(accountID)
+
+	state, err := s.accountState(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+	var loaded []*core.Transaction
+	lastLt, lastHash := state.LastTransLt, tongo.Bits256(state.LastTransHash)
+	for lastLt != 0 {
+		count := backfillPageSize
+		if opts.Limit > 0 && opts.Limit-len(loaded) < count {
+			count = opts.Limit - len(loaded)
+		}
+		txs, err := s.getTransactions(ctx, uint32(count), accountID, lastLt, lastHash)
+		if err != nil {
+			if e, ok := err.(liteclient.LiteServerErrorC); ok && int32(e.Code) == -400 {
+				// the lite server doesn't keep older transactions.
+				break
+			}
+			return len(loaded), err
+		}
+		if len(txs) == 0 {
+			break
+		}
+		caughtUp, err := s.storeBackfillPage(accountID, txs, opts.StopAtLt, &loaded)
+		if err != nil {
+			return len(loaded), err
+		}
+		s.backfills.progress(accountID, len(loaded))
+		if caughtUp || (opts.Limit > 0 && len(loaded) >= opts.Limit) {
+			break
+		}
+		last := txs[len(txs)-1]
+		lastLt, lastHash = last.PrevTransLt, tongo.Bits256(last.PrevTransHash)
+	}
+	if opts.Replay != nil {
+		for i := len(loaded) - 1; i >= 0; i-- {
+			opts.Replay(loaded[i])
+		}
+	}
+	return len(loaded), nil
+}
+
+// storeBackfillPage stores a page of transactions loaded by Backfill
+// and reports whether it has reached a transaction with lt <= stopAtLt.
+func (s *LiteStorage) storeBackfillPage(accountID tongo.AccountID, txs []tongo.Transaction, stopAtLt uint64, loaded *[]*core.Transaction) (bool, error) {
+	s.indexMu.RLock()
+	defer s.indexMu.RUnlock()
+	for _, tx := range txs {
+		if stopAtLt > 0 && tx.Lt <= stopAtLt {
+			return true, nil
+		}
+		transaction, err := core.ConvertTransaction(accountID.Workchain, tx)
+		if err != nil {
+			return false, err
+		}
+		s.storeTransaction(accountID, tongo.Bits256(tx.Hash()), transaction, &tx.Transaction)
+		*loaded = append(*loaded, transaction)
+	}
+	return false, nil
+}
+
+// StartBackfill runs Backfill in the background, its progress is available with BackfillStatuses.
+func (s *LiteStorage) StartBackfill(accountID tongo.AccountID, opts BackfillOptions) {
+	s.backfills.start(accountID)
+	go func() {
+		count, err := s.Backfill(context.Background(), accountID, opts)
+		if err != nil {
+			s.logger.Error("failed to backfill account",
+				zap.String("account", accountID.ToRaw()),
+				zap.Error(err))
+		} else {
+			s.logger.Info("account backfilled",
+				zap.String("account", accountID.ToRaw()),
+				zap.Int("transactions", count))
+		}
+		s.backfills.finish(accountID, count, err)
+	}()
+}
+
+// BackfillStatuses returns the progress of all backfills started with StartBackfill.
+func (s *LiteStorage) BackfillStatuses() []BackfillStatus {
+	s.backfills.mu.Lock()
+	defer s.backfills.mu.Unlock()
+	statuses := make([]BackfillStatus, 0, len(s.backfills.statuses))
+	for _, status := range s.backfills.statuses {
+		statuses = append(statuses, *status)
+	}
+	return statuses
+}
+
+// BackfillHandler returns an http.Handler to run backfills at runtime.
+// It is supposed to be exposed on an internal port only:
+//
+//	GET  <prefix>                     lists backfills and their progress
+//	POST <prefix><account>[?limit=N]  starts a backfill of the account
+func (s *LiteStorage) BackfillHandler(prefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		accountStr := strings.TrimPrefix(r.URL.Path, prefix)
+		switch {
+		case accountStr == "" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(s.BackfillStatuses())
+		case accountStr != "" && r.Method == http.MethodPost:
+			account, err := tongo.ParseAddress(accountStr)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			var opts BackfillOptions
+			if limit := r.URL.Query().Get("limit"); limit != "" {
+				if opts.Limit, err = strconv.Atoi(limit); err != nil || opts.Limit < 0 {
+					http.Error(w, "invalid limit", http.StatusBadRequest)
+					return
+				}
+			}
+			s.StartBackfill(account.ID, opts)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (b *backfills) start(accountID tongo.AccountID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.statuses == nil {
+		b.statuses = map[tongo.AccountID]*BackfillStatus{}
+	}
+	b.statuses[accountID] = &BackfillStatus{Account: accountID.ToRaw(), StartedAt: time.Now()}
+}
+
+func (b *backfills) progress(accountID tongo.AccountID, count int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if status, ok := b.statuses[accountID]; ok {
+		status.Transactions = count
+	}
+}
+
+func (b *backfills) finish(accountID tongo.AccountID, count int, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	status, ok := b.statuses[accountID]
+	if !ok {
+		return
+	}
+	status.Transactions = count
+	status.Done = true
+	if err != nil {
+		status.Error = err.Error()
+	}
+}
@@ -0,0 +1,48 @@
+package litestorage
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+)
+
+func TestLiteStorage_BackfillHandler(t *testing.T) {
+	account := tongo.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+	s := &LiteStorage{}
+	s.backfills.start(account)
+	s.backfills.progress(account, 10)
+	s.backfills.finish(account, 20, errors.New("lite server is down"))
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		wantCode int
+	}{
+		{name: "list", method: http.MethodGet, path: "/admin/backfill/", wantCode: http.StatusOK},
+		{name: "invalid account", method: http.MethodPost, path: "/admin/backfill/not-an-account", wantCode: http.StatusBadRequest},
+		{name: "invalid limit", method: http.MethodPost, path: "/admin/backfill/" + account.ToRaw() + "?limit=-1", wantCode: http.StatusBadRequest},
+		{name: "method not allowed", method: http.MethodDelete, path: "/admin/backfill/" + account.ToRaw(), wantCode: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			s.BackfillHandler("/admin/backfill/").ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+			require.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+
+	w := httptest.NewRecorder()
+	s.BackfillHandler("/admin/backfill/").ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/backfill/", nil))
+	var statuses []BackfillStatus
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &statuses))
+	require.Equal(t, 1, len(statuses))
+	require.Equal(t, 20, statuses[0].Transactions)
+	require.True(t, statuses[0].Done)
+	require.Equal(t, "lite server is down", statuses[0].Error)
+}
@@ -0,0 +1,38 @@
+package litestorage
+
+import (
+	"context"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// indexBounces remembers the transaction that has sent bounces,
+// so a transaction processing a bounce can be linked back to the bounced message.
+func (s *LiteStorage) indexBounces(hash tongo.Bits256, transaction *core.Transaction) {
+	for _, msg := range transaction.OutMsgs {
+		if msg.Bounced {
+			s.bounceOrigins.Store(msg.Hash, hash)
+		}
+	}
+}
+
+// unindexBounces rolls back indexBounces.
+func (s *LiteStorage) unindexBounces(hash tongo.Bits256, transaction *core.Transaction) {
+	for _, msg := range transaction.OutMsgs {
+		if origin, ok := s.bounceOrigins.Load(msg.Hash); ok && origin == hash {
+			s.bounceOrigins.Delete(msg.Hash)
+		}
+	}
+}
+
+// FindBounceOrigin returns a hash of the transaction that has sent the bounce message with the given hash.
+// The inbound message of that transaction is the bounced one.
+func (s *LiteStorage) FindBounceOrigin(ctx context.Context, msgHash tongo.Bits256) (tongo.Bits256, error) {
+	origin, ok := s.bounceOrigins.Load(msgHash)
+	if !ok {
+		return tongo.Bits256{}, core.ErrEntityNotFound
+	}
+	return origin, nil
+}
@@ -0,0 +1,38 @@
+package litestorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+func TestLiteStorage_FindBounceOrigin(t *testing.T) {
+	s := newSnapshotTestStorage()
+	account := tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	txHash := tongo.MustParseHash("c5ca880c8e667af78d193ac5d2f1437c2bcec08d16436f6579f3493e556b4f48")
+	bounce := tongo.MustParseHash("1219de582369ac80ee1afe12147930f458a54ff1eea612611a8bc6bd31581a6c")
+	regular := tongo.MustParseHash("55e8809519cd3c49098c9ee45afdafcea7a894a74d0f628d94a115a50e045122")
+	transaction := &core.Transaction{
+		TransactionID: core.TransactionID{Hash: txHash, Account: account},
+		OutMsgs: []core.Message{
+			{Hash: bounce, Bounced: true},
+			{Hash: regular},
+		},
+	}
+
+	s.storeTransaction(account, txHash, transaction, &tlb.Transaction{})
+	origin, err := s.FindBounceOrigin(context.Background(), bounce)
+	require.Nil(t, err)
+	require.Equal(t, txHash, origin)
+	_, err = s.FindBounceOrigin(context.Background(), regular)
+	require.ErrorIs(t, err, core.ErrEntityNotFound)
+
+	s.removeTransaction(account, txHash, &tlb.Transaction{})
+	_, err = s.FindBounceOrigin(context.Background(), bounce)
+	require.ErrorIs(t, err, core.ErrEntityNotFound)
+}
@@ -0,0 +1,85 @@
+package litestorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// GetAccountCodeHistory returns codes installed in the account ordered by lt.
+// Only transactions indexed for tracked accounts are taken into account,
+// a code is taken from the account's state at the end of the block of the transaction that has installed it.
+func (s *LiteStorage) GetAccountCodeHistory(ctx context.Context, accountID tongo.AccountID) ([]core.CodeVersion, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		observeStorageTime(ctx, "get_account_code_history", v)
+	}))
+	defer timer.ObserveDuration()
+	if !s.isTracking(accountID) {
+		return nil, fmt.Errorf("account is not tracked: %w", core.ErrNotIndexed)
+	}
+	history, ok := s.codeHistories.Load(accountID)
+	if !ok {
+		return nil, nil
+	}
+	txs, resolved := history.Candidates()
+	versions := make([]core.CodeVersion, 0, len(txs))
+	for i, tx := range txs {
+		version := resolved[i]
+		if version == nil {
+			var err error
+			version, err = s.codeVersion(ctx, accountID, tx)
+			if err != nil {
+				return nil, err
+			}
+			history.Resolve(tx.Hash, *version)
+		}
+		// the account has been destroyed or frozen by the end of the block.
+		if len(version.Code) == 0 {
+			continue
+		}
+		versions = append(versions, *version)
+	}
+	return core.CodeUpgrades(versions), nil
+}
+
+// codeVersion reads the code of the account at the end of the block of the given transaction.
+func (s *LiteStorage) codeVersion(ctx context.Context, accountID tongo.AccountID, tx *core.Transaction) (*core.CodeVersion, error) {
+	blockID, _, err := s.lookupBlock(ctx, tx.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.GetAccountStateAtBlock(ctx, accountID, blockID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := core.ConvertToAccount(accountID, state)
+	if err != nil {
+		return nil, err
+	}
+	version := core.CodeVersion{
+		TransactionID: tx.TransactionID,
+		BlockID:       blockID,
+		Utime:         tx.Utime,
+		Code:          account.Code,
+		Data:          account.Data,
+	}
+	if len(account.Code) > 0 {
+		cells, err := boc.DeserializeBoc(account.Code)
+		if err != nil {
+			return nil, err
+		}
+		if len(cells) != 1 {
+			return nil, boc.ErrNotSingleRoot
+		}
+		version.CodeHash, err = cells[0].Hash256()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &version, nil
+}
@@ -2,6 +2,7 @@ package litestorage
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	cache "github.com/Code-Hex/go-generics-cache"
@@ -10,7 +11,6 @@ import (
 	"github.com/tonkeeper/tongo/boc"
 	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
-	"go.uber.org/zap"
 )
 
 // allowedConfigKeys is a list of blockchain config keys
@@ -34,7 +34,7 @@ var allowedConfigKeys = []uint32{
 
 func (c *LiteStorage) GetLastConfig(ctx context.Context) (ton.BlockchainConfig, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_last_config").Observe(v)
+		observeStorageTime(ctx, "get_last_config", v)
 	}))
 	defer timer.ObserveDuration()
 	config, prs := c.configCache.Get(1)
@@ -42,7 +42,7 @@ func (c *LiteStorage) GetLastConfig(ctx context.Context) (ton.BlockchainConfig,
 		return config, nil
 
 	}
-	rawConfig, err := c.client.GetConfigAll(ctx, 0)
+	rawConfig, err := c.getConfigAll(ctx)
 	if err != nil {
 		return ton.BlockchainConfig{}, err
 	}
@@ -57,10 +57,10 @@ func (c *LiteStorage) GetLastConfig(ctx context.Context) (ton.BlockchainConfig,
 
 func (c *LiteStorage) GetConfigFromBlock(ctx context.Context, id ton.BlockID) (tlb.ConfigParams, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_config_from_block").Observe(v)
+		observeStorageTime(ctx, "get_config_from_block", v)
 	}))
 	defer timer.ObserveDuration()
-	extID, info, err := c.client.LookupBlock(ctx, id, 1, nil, nil)
+	extID, info, err := c.lookupBlock(ctx, id)
 	if err != nil {
 		return tlb.ConfigParams{}, err
 	}
@@ -73,7 +73,7 @@ func (c *LiteStorage) GetConfigFromBlock(ctx context.Context, id ton.BlockID) (t
 
 func (c *LiteStorage) GetConfigRaw(ctx context.Context) ([]byte, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_config_raw").Observe(v)
+		observeStorageTime(ctx, "get_config_raw", v)
 	}))
 	defer timer.ObserveDuration()
 	raw, err := c.client.GetConfigAllRaw(ctx, 0)
@@ -92,7 +92,7 @@ func (c *LiteStorage) TrimmedConfigBase64() (string, error) {
 	}
 	// we haven't updated the config yet, so let's do it now.
 	// this can happen at start up.
-	params, err := c.client.GetConfigAll(context.TODO(), 0)
+	params, err := c.getConfigAll(context.TODO())
 	if err != nil {
 		return "", err
 	}
@@ -121,25 +121,15 @@ func (c *LiteStorage) updateBlockchainConfig(params tlb.ConfigParams) (string, e
 	return configBase64, nil
 }
 
-func (s *LiteStorage) runBlockchainConfigUpdate(updateInterval time.Duration) {
-	go func() {
-		for {
-			select {
-			case <-s.stopCh:
-				return
-			// TODO: find better way to update config.
-			// For example, we can update a config once a new key block is added to the blockchain.
-			case <-time.After(updateInterval):
-				params, err := s.client.GetConfigAll(context.TODO(), 0)
-				if err != nil {
-					s.logger.Error("failed to get blockchain config", zap.Error(err))
-					continue
-				}
-				if _, err := s.updateBlockchainConfig(params); err != nil {
-					s.logger.Error("failed to get blockchain config", zap.Error(err))
-					continue
-				}
-			}
-		}
-	}()
+// TODO: find better way to update config.
+// For example, we can update a config once a new key block is added to the blockchain.
+func (s *LiteStorage) refreshBlockchainConfig(ctx context.Context) error {
+	params, err := s.getConfigAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get blockchain config: %w", err)
+	}
+	if _, err := s.updateBlockchainConfig(params); err != nil {
+		return fmt.Errorf("failed to update blockchain config: %w", err)
+	}
+	return nil
 }
@@ -3,9 +3,62 @@ package litestorage
 import (
 	"context"
 
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+
 	"github.com/tonkeeper/opentonapi/pkg/core"
 )
 
+var _ core.DNSRecordsSource = (*LiteStorage)(nil)
+
 func (s *LiteStorage) GetDomainInfo(ctx context.Context, domain string) (core.NftItem, int64, error) {
 	return core.NftItem{}, 0, nil
 }
+
+// dnsItemData is a prefix of the persistent data of a .ton DNS item,
+// its content is an onchain dictionary of DNS records.
+type dnsItemData struct {
+	Index      tlb.Bits256
+	Collection tlb.MsgAddress
+	Owner      tlb.MsgAddress
+	Content    struct {
+		Layout  uint8
+		Records tlb.HashmapE[tlb.Bits256, tlb.Ref[tlb.DNSRecord]]
+	} `tlb:"^"`
+}
+
+// DNSRecordsBefore returns DNS records of a DNS item as of the shard block preceding the given one.
+// An item that doesn't exist at that block has no records.
+func (s *LiteStorage) DNSRecordsBefore(ctx context.Context, item tongo.AccountID, block tongo.BlockID) (map[tlb.Bits256]tlb.DNSRecord, error) {
+	prev := block
+	prev.Seqno--
+	extID, _, err := s.lookupBlock(ctx, prev)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.client.WithBlock(extID).GetAccountState(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	if state.Account.Status() != tlb.AccountActive {
+		return map[tlb.Bits256]tlb.DNSRecord{}, nil
+	}
+	data := state.Account.Account.Storage.State.AccountActive.StateInit.Data
+	if !data.Exists {
+		return map[tlb.Bits256]tlb.DNSRecord{}, nil
+	}
+	return dnsItemRecords(&data.Value.Value)
+}
+
+func dnsItemRecords(data *boc.Cell) (map[tlb.Bits256]tlb.DNSRecord, error) {
+	var item dnsItemData
+	if err := tlb.Unmarshal(data, &item); err != nil {
+		return nil, err
+	}
+	records := make(map[tlb.Bits256]tlb.DNSRecord, len(item.Content.Records.Keys()))
+	for _, kv := range item.Content.Records.Items() {
+		records[kv.Key] = kv.Value.Value
+	}
+	return records, nil
+}
@@ -0,0 +1,35 @@
+package litestorage
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+)
+
+func Test_dnsItemRecords(t *testing.T) {
+	owner := tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	collection := tongo.MustParseAccountID("0:b774d95eb20543f186c06b371ab88ad704f7e256130caf96189368a7d0cb6ccf")
+	resolver := tlb.Bits256(sha256.Sum256([]byte("dns_next_resolver")))
+	storage := tlb.Bits256(sha256.Sum256([]byte("storage")))
+	resolverRecord := tlb.DNSRecord{SumType: "DNSNextResolver", DNSNextResolver: owner.ToMsgAddress()}
+	storageRecord := tlb.DNSRecord{SumType: "DNSStorageAddress", DNSStorageAddress: tlb.Bits256{1, 2, 3}}
+
+	var item dnsItemData
+	item.Collection = collection.ToMsgAddress()
+	item.Owner = tlb.MsgAddress{SumType: "AddrNone"}
+	item.Content.Records = tlb.NewHashmapE(
+		[]tlb.Bits256{resolver, storage},
+		[]tlb.Ref[tlb.DNSRecord]{{Value: resolverRecord}, {Value: storageRecord}})
+	cell := boc.NewCell()
+	require.Nil(t, tlb.Marshal(cell, item))
+
+	records, err := dnsItemRecords(cell)
+	require.Nil(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, storageRecord, records[storage])
+	require.Equal(t, resolverRecord, records[resolver])
+}
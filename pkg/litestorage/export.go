@@ -0,0 +1,38 @@
+package litestorage
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// IndexedAccountTransactions returns indexed transactions of the account with lt greater than afterLt,
+// the earliest ones go first. Unlike GetAccountTransactions, it never queries lite servers.
+func (s *LiteStorage) IndexedAccountTransactions(ctx context.Context, account tongo.AccountID, afterLt uint64, limit int) ([]*core.Transaction, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		observeStorageTime(ctx, "indexed_account_transactions", v)
+	}))
+	defer timer.ObserveDuration()
+	txs, ok := s.transactionsByAccount.Load(account)
+	if !ok {
+		return nil, nil
+	}
+	return txs.after(afterLt, limit), nil
+}
+
+// IndexedAccountTransactionsBefore returns indexed transactions of the account with lt less than beforeLt,
+// the latest ones go first. Unlike GetAccountTransactions, it never queries lite servers.
+func (s *LiteStorage) IndexedAccountTransactionsBefore(ctx context.Context, account tongo.AccountID, beforeLt uint64, limit int) ([]*core.Transaction, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		observeStorageTime(ctx, "indexed_account_transactions_before", v)
+	}))
+	defer timer.ObserveDuration()
+	txs, ok := s.transactionsByAccount.Load(account)
+	if !ok {
+		return nil, nil
+	}
+	return txs.before(beforeLt, limit), nil
+}
@@ -0,0 +1,52 @@
+package litestorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+func TestLiteStorage_IndexedAccountTransactions(t *testing.T) {
+	s := newSnapshotTestStorage()
+	account := tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	other := tongo.MustParseAccountID("0:da6b1b6663a0e4d18cc8574ccd9db5296e367dd9324706f3bbd9eb1cd2caf0bf")
+	for i, tx := range []core.TransactionID{
+		{Account: account, Lt: 30},
+		{Account: account, Lt: 10},
+		{Account: other, Lt: 15},
+		{Account: account, Lt: 20},
+	} {
+		tx.Hash = tongo.Bits256{byte(i + 1)}
+		s.storeTransaction(tx.Account, tx.Hash, &core.Transaction{TransactionID: tx}, &tlb.Transaction{})
+	}
+	lts := func(txs []*core.Transaction) []uint64 {
+		var result []uint64
+		for _, tx := range txs {
+			result = append(result, tx.Lt)
+		}
+		return result
+	}
+
+	txs, err := s.IndexedAccountTransactions(context.Background(), account, 0, 2)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{10, 20}, lts(txs))
+	txs, err = s.IndexedAccountTransactions(context.Background(), account, 20, 2)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{30}, lts(txs))
+	txs, err = s.IndexedAccountTransactionsBefore(context.Background(), account, 30, 1)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{20}, lts(txs))
+	txs, err = s.IndexedAccountTransactionsBefore(context.Background(), account, 31, 0)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{30, 20, 10}, lts(txs))
+
+	s.removeTransaction(account, tongo.Bits256{4}, &tlb.Transaction{})
+	txs, err = s.IndexedAccountTransactions(context.Background(), account, 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{10, 30}, lts(txs))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/shopspring/decimal"
 	"github.com/sourcegraph/conc/iter"
+	"github.com/tonkeeper/opentonapi/internal/g"
 	"github.com/tonkeeper/opentonapi/pkg/core"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/abi"
@@ -18,7 +19,7 @@ import (
 
 func (s *LiteStorage) GetJettonWalletsByOwnerAddress(ctx context.Context, address ton.AccountID, jetton *ton.AccountID, mintless bool) ([]core.JettonWallet, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_jetton_wallets_by_owner").Observe(v)
+		observeStorageTime(ctx, "get_jetton_wallets_by_owner", v)
 	}))
 	defer timer.ObserveDuration()
 	jettons := s.knownAccounts["jettons"]
@@ -64,6 +65,15 @@ func (s *LiteStorage) GetJettonWalletsByOwnerAddress(ctx context.Context, addres
 			OwnerAddress:  &address,
 			JettonAddress: *jettonMaster,
 		}
+		if verified, err := s.verifyJettonWalletCode(ctx, *jettonMaster, *walletAddress); err == nil && !verified {
+			wallet.CodeMismatch = true
+		}
+		// only wallets of lockable jettons implement get_status, for others the method fails.
+		if _, result, err := abi.GetStatus(ctx, s.executor, *walletAddress); err == nil {
+			if status, ok := result.(abi.GetStatusResult); ok {
+				wallet.Status = g.Pointer(core.JettonWalletStatus(status.Status))
+			}
+		}
 		return &wallet, nil
 	})
 	if err != nil {
@@ -80,14 +90,14 @@ func (s *LiteStorage) GetJettonWalletsByOwnerAddress(ctx context.Context, addres
 
 func (s *LiteStorage) GetJettonMasterMetadata(ctx context.Context, master tongo.AccountID) (tongo.JettonMetadata, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_jetton_master_metadata").Observe(v)
+		observeStorageTime(ctx, "get_jetton_master_metadata", v)
 	}))
 	defer timer.ObserveDuration()
 	meta, ok := s.jettonMetaCache.Load(master.ToRaw())
 	if ok {
 		return meta, nil
 	}
-	rawMeta, err := s.client.GetJettonData(ctx, master)
+	rawMeta, err := s.getJettonData(ctx, master)
 	if err != nil {
 		return tongo.JettonMetadata{}, err
 	}
@@ -95,9 +105,42 @@ func (s *LiteStorage) GetJettonMasterMetadata(ctx context.Context, master tongo.
 	return rawMeta, nil
 }
 
+// refreshJettonMetadata loads metadata of known jettons and reloads metadata of all cached ones,
+// so the cache is warm at startup and changes of metadata are picked up eventually.
+func (s *LiteStorage) refreshJettonMetadata(ctx context.Context) error {
+	masters := map[tongo.AccountID]struct{}{}
+	for _, master := range s.knownAccounts["jettons"] {
+		masters[master] = struct{}{}
+	}
+	s.jettonMetaCache.Range(func(key string, _ tongo.JettonMetadata) bool {
+		if master, err := tongo.ParseAccountID(key); err == nil {
+			masters[master] = struct{}{}
+		}
+		return true
+	})
+	var failed int
+	var lastErr error
+	for master := range masters {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		meta, err := s.getJettonData(ctx, master)
+		if err != nil {
+			failed++
+			lastErr = err
+			continue
+		}
+		s.jettonMetaCache.Store(master.ToRaw(), meta)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to refresh metadata of %v out of %v jettons: %w", failed, len(masters), lastErr)
+	}
+	return nil
+}
+
 func (s *LiteStorage) GetJettonMasterData(ctx context.Context, master tongo.AccountID) (core.JettonMaster, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_jetton_master_data").Observe(v)
+		observeStorageTime(ctx, "get_jetton_master_data", v)
 	}))
 	defer timer.ObserveDuration()
 	_, value, err := abi.GetJettonData(ctx, s.executor, master)
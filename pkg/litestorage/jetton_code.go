@@ -0,0 +1,82 @@
+package litestorage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+)
+
+// jettonWalletCodes contains code hashes of legitimate wallets observed for every jetton master.
+// A master reports the code of its wallets with get_jetton_data,
+// the set keeps all versions reported so far because wallets deployed before an upgrade keep the older code.
+type jettonWalletCodes struct {
+	mu     sync.RWMutex
+	hashes map[tongo.AccountID]map[tongo.Bits256]struct{}
+}
+
+func (c *jettonWalletCodes) add(master tongo.AccountID, hash tongo.Bits256) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.hashes == nil {
+		c.hashes = map[tongo.AccountID]map[tongo.Bits256]struct{}{}
+	}
+	if c.hashes[master] == nil {
+		c.hashes[master] = map[tongo.Bits256]struct{}{}
+	}
+	c.hashes[master][hash] = struct{}{}
+}
+
+func (c *jettonWalletCodes) known(master tongo.AccountID, hash tongo.Bits256) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.hashes[master][hash]
+	return ok
+}
+
+// verifyJettonWalletCode checks the code of the jetton wallet against the code of legitimate wallets of the master.
+// A mismatch means the wallet is a fake contract pretending to hold jettons of the master.
+func (s *LiteStorage) verifyJettonWalletCode(ctx context.Context, master, wallet tongo.AccountID) (bool, error) {
+	account, err := s.GetRawAccount(ctx, wallet)
+	if err != nil {
+		return false, err
+	}
+	hash, err := codeHash(account.Code)
+	if err != nil {
+		return false, err
+	}
+	if s.jettonWalletCodes.known(master, hash) {
+		return true, nil
+	}
+	// the master might have upgraded the code of its wallets since we've seen it last time.
+	_, value, err := abi.GetJettonData(ctx, s.executor, master)
+	if err != nil {
+		return false, err
+	}
+	data, ok := value.(abi.GetJettonDataResult)
+	if !ok {
+		return false, fmt.Errorf("invalid jetton data result")
+	}
+	walletCode := boc.Cell(data.JettonWalletCode)
+	masterCodeHash, err := walletCode.Hash256()
+	if err != nil {
+		return false, err
+	}
+	s.jettonWalletCodes.add(master, masterCodeHash)
+	return masterCodeHash == hash, nil
+}
+
+func codeHash(code []byte) (tongo.Bits256, error) {
+	cells, err := boc.DeserializeBoc(code)
+	if err != nil {
+		return tongo.Bits256{}, err
+	}
+	if len(cells) != 1 {
+		return tongo.Bits256{}, fmt.Errorf("invalid code boc roots number %v", len(cells))
+	}
+	hash, err := cells[0].Hash256()
+	return tongo.Bits256(hash), err
+}
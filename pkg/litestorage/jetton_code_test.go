@@ -0,0 +1,31 @@
+package litestorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+)
+
+func Test_jettonWalletCodes(t *testing.T) {
+	master := tongo.MustParseAccountID("0:b113a994b5024a16719f69139328eb759596c38a25f59028b146fecdc3621dfe")
+	other := tongo.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+
+	cell := boc.NewCell()
+	require.Nil(t, cell.WriteUint(0xdeadbeef, 32))
+	code, err := cell.ToBoc()
+	require.Nil(t, err)
+	hash, err := codeHash(code)
+	require.Nil(t, err)
+
+	var codes jettonWalletCodes
+	require.False(t, codes.known(master, hash))
+	codes.add(master, hash)
+	require.True(t, codes.known(master, hash))
+	// a code of legitimate wallets of one jetton doesn't make wallets of another one legitimate.
+	require.False(t, codes.known(other, hash))
+
+	_, err = codeHash([]byte("not a boc"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,222 @@
+package litestorage
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+var droppedJettonTransfers = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "litestorage_jetton_volume_dropped_transfers",
+	Help: "Number of jetton transfers not taken into account in volume analytics because the resolver couldn't keep up",
+})
+
+const (
+	secondsInHour = 60 * 60
+	// jettonVolumeRetention defines the longest window of jetton volume analytics.
+	jettonVolumeRetention = 7 * 24 * time.Hour
+)
+
+// jettonTransfer is a jetton transfer observed in a block.
+type jettonTransfer struct {
+	// wallet is a jetton wallet of the sender.
+	wallet tongo.AccountID
+	utime  int64
+	amount big.Int
+}
+
+type jettonVolumeBucket struct {
+	transfers int64
+	volume    big.Int
+}
+
+// jettonVolumes maintains hourly transfer counts and volumes per jetton master.
+type jettonVolumes struct {
+	mu      sync.Mutex
+	buckets map[tongo.AccountID]map[int64]*jettonVolumeBucket
+}
+
+func (v *jettonVolumes) add(master tongo.AccountID, utime int64, amount *big.Int) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.buckets == nil {
+		v.buckets = map[tongo.AccountID]map[int64]*jettonVolumeBucket{}
+	}
+	hours, ok := v.buckets[master]
+	if !ok {
+		hours = map[int64]*jettonVolumeBucket{}
+		v.buckets[master] = hours
+	}
+	hour := utime / secondsInHour
+	bucket, ok := hours[hour]
+	if !ok {
+		bucket = &jettonVolumeBucket{}
+		hours[hour] = bucket
+	}
+	bucket.transfers += 1
+	bucket.volume.Add(&bucket.volume, amount)
+}
+
+// prune removes buckets that are out of the longest window.
+func (v *jettonVolumes) prune(now int64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	oldest := (now - int64(jettonVolumeRetention.Seconds())) / secondsInHour
+	for master, hours := range v.buckets {
+		for hour := range hours {
+			if hour < oldest {
+				delete(hours, hour)
+			}
+		}
+		if len(hours) == 0 {
+			delete(v.buckets, master)
+		}
+	}
+}
+
+func (v *jettonVolumes) volume(master tongo.AccountID, now int64) core.JettonVolume {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.volumeLocked(master, now)
+}
+
+func (v *jettonVolumes) volumeLocked(master tongo.AccountID, now int64) core.JettonVolume {
+	result := core.JettonVolume{Master: master}
+	dayAgo := (now - 24*secondsInHour) / secondsInHour
+	weekAgo := (now - int64(jettonVolumeRetention.Seconds())) / secondsInHour
+	for hour, bucket := range v.buckets[master] {
+		if hour < weekAgo {
+			continue
+		}
+		result.Transfers7d += bucket.transfers
+		result.Volume7d.Add(&result.Volume7d, &bucket.volume)
+		if hour >= dayAgo {
+			result.Transfers24h += bucket.transfers
+			result.Volume24h.Add(&result.Volume24h, &bucket.volume)
+		}
+	}
+	return result
+}
+
+// top returns jettons with the highest number of transfers during the given period.
+// Jettons have different decimals, so volumes aren't comparable and the number of transfers is used for ranking.
+func (v *jettonVolumes) top(now int64, period time.Duration, limit int) []core.JettonVolume {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	volumes := make([]core.JettonVolume, 0, len(v.buckets))
+	for master := range v.buckets {
+		volumes = append(volumes, v.volumeLocked(master, now))
+	}
+	transfers := func(volume core.JettonVolume) int64 {
+		if period <= 24*time.Hour {
+			return volume.Transfers24h
+		}
+		return volume.Transfers7d
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return transfers(volumes[i]) > transfers(volumes[j])
+	})
+	var result []core.JettonVolume
+	for _, volume := range volumes {
+		if len(result) >= limit || transfers(volume) == 0 {
+			break
+		}
+		result = append(result, volume)
+	}
+	return result
+}
+
+// extractJettonTransfer returns a jetton transfer if the transaction successfully processed a JettonTransfer message.
+func extractJettonTransfer(workchain int32, tx *tlb.Transaction) (jettonTransfer, bool) {
+	if !tx.Msgs.InMsg.Exists || tx.Msgs.InMsg.Value.Value.Info.IntMsgInfo == nil {
+		return jettonTransfer{}, false
+	}
+	if tx.Description.SumType != "TransOrd" || tx.Description.TransOrd.Aborted {
+		return jettonTransfer{}, false
+	}
+	cell := boc.Cell(tx.Msgs.InMsg.Value.Value.Body.Value)
+	opCode, err := cell.PickUint(32)
+	if err != nil || opCode != uint64(abi.JettonTransferMsgOpCode) {
+		return jettonTransfer{}, false
+	}
+	_, _, value, err := abi.InternalMessageDecoder(&cell, nil)
+	if err != nil {
+		return jettonTransfer{}, false
+	}
+	body, ok := value.(abi.JettonTransferMsgBody)
+	if !ok {
+		return jettonTransfer{}, false
+	}
+	return jettonTransfer{
+		wallet: *ton.NewAccountID(workchain, tx.AccountAddr),
+		utime:  int64(tx.Now),
+		amount: big.Int(body.Amount),
+	}, true
+}
+
+// runJettonVolumeResolver attributes jetton transfers to jetton masters and maintains volume analytics.
+// Resolving a master of a jetton wallet requires a get method call, so it happens out of the indexing loop.
+// Outdated volumes are pruned by the "jetton_volume_prune" job.
+func (s *LiteStorage) runJettonVolumeResolver(ch <-chan jettonTransfer) {
+	for transfer := range ch {
+		master, ok := s.jettonWalletMasters.Load(transfer.wallet)
+		if !ok {
+			masters, err := s.JettonMastersForWallets(context.Background(), []tongo.AccountID{transfer.wallet})
+			if err != nil {
+				s.logger.Debug("failed to resolve jetton master", zap.String("wallet", transfer.wallet.ToRaw()), zap.Error(err))
+				continue
+			}
+			if master, ok = masters[transfer.wallet]; !ok {
+				continue
+			}
+			s.jettonWalletMasters.Store(transfer.wallet, master)
+		}
+		s.jettonVolumes.add(master, transfer.utime, &transfer.amount)
+	}
+}
+
+// observeJettonTransfers passes jetton transfers of the block to the volume resolver.
+func (s *LiteStorage) observeJettonTransfers(id tongo.BlockIDExt, block *tlb.Block) {
+	if s.jettonTransfersCh == nil {
+		return
+	}
+	for _, tx := range block.AllTransactions() {
+		transfer, ok := extractJettonTransfer(id.Workchain, tx)
+		if !ok {
+			continue
+		}
+		select {
+		case s.jettonTransfersCh <- transfer:
+		default:
+			droppedJettonTransfers.Inc()
+		}
+	}
+}
+
+// GetJettonVolumes returns rolling transfer stats of the given jetton masters.
+func (s *LiteStorage) GetJettonVolumes(ctx context.Context, masters []tongo.AccountID) (map[tongo.AccountID]core.JettonVolume, error) {
+	now := time.Now().Unix()
+	result := make(map[tongo.AccountID]core.JettonVolume, len(masters))
+	for _, master := range masters {
+		result[master] = s.jettonVolumes.volume(master, now)
+	}
+	return result, nil
+}
+
+// GetTopJettonsByVolume returns jettons with the highest number of transfers during the given period.
+func (s *LiteStorage) GetTopJettonsByVolume(ctx context.Context, period time.Duration, limit int) ([]core.JettonVolume, error) {
+	return s.jettonVolumes.top(time.Now().Unix(), period, limit), nil
+}
@@ -0,0 +1,37 @@
+package litestorage
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+)
+
+func Test_jettonVolumes(t *testing.T) {
+	usdt := tongo.MustParseAccountID("0:b113a994b5024a16719f69139328eb759596c38a25f59028b146fecdc3621dfe")
+	not := tongo.MustParseAccountID("0:2f956143c461769579baef2e32cc2d7bc18283f40d20bb03e432cd603ac33ffc")
+	const now = int64(1_700_000_000)
+
+	var v jettonVolumes
+	v.add(usdt, now-100, big.NewInt(10))
+	v.add(usdt, now-2*24*secondsInHour, big.NewInt(20))
+	v.add(usdt, now-8*24*secondsInHour, big.NewInt(40))
+	v.add(not, now-200, big.NewInt(1000))
+	v.add(not, now-300, big.NewInt(1000))
+
+	volume := v.volume(usdt, now)
+	require.Equal(t, int64(1), volume.Transfers24h)
+	require.Equal(t, "10", volume.Volume24h.String())
+	require.Equal(t, int64(2), volume.Transfers7d)
+	require.Equal(t, "30", volume.Volume7d.String())
+
+	top := v.top(now, 24*time.Hour, 10)
+	require.Equal(t, 2, len(top))
+	require.Equal(t, not, top[0].Master)
+	require.Equal(t, 1, len(v.top(now, 24*time.Hour, 1)))
+
+	v.prune(now)
+	require.Equal(t, 2, len(v.buckets[usdt]))
+}
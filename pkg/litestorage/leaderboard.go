@@ -0,0 +1,60 @@
+package litestorage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// leaderboard keeps the latest snapshot of tracked accounts used by top accounts endpoints.
+type leaderboard struct {
+	mu       sync.RWMutex
+	snapshot core.Leaderboard
+}
+
+// trackedAccounts returns a copy of the list of tracked accounts.
+func (s *LiteStorage) trackedAccounts() []tongo.AccountID {
+	s.trackingMu.RLock()
+	defer s.trackingMu.RUnlock()
+	accounts := make([]tongo.AccountID, 0, len(s.trackingAccounts))
+	for account := range s.trackingAccounts {
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// updateLeaderboard builds a new snapshot of tracked accounts with their balances and recent activity.
+func (s *LiteStorage) updateLeaderboard(ctx context.Context) error {
+	accounts, err := s.GetRawAccounts(ctx, s.trackedAccounts())
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	weekAgo := now.Add(-7 * 24 * time.Hour).Unix()
+	entries := make([]core.LeaderboardEntry, 0, len(accounts))
+	for _, account := range accounts {
+		entry := core.LeaderboardEntry{
+			Account: account.AccountAddress,
+			Balance: account.TonBalance,
+		}
+		if activity, ok := s.accountActivity.Load(account.AccountAddress); ok {
+			entry.Transactions7d = activity.Stats(weekAgo).TransactionsCount
+		}
+		entries = append(entries, entry)
+	}
+	s.leaderboard.mu.Lock()
+	defer s.leaderboard.mu.Unlock()
+	s.leaderboard.snapshot = core.Leaderboard{UpdatedAt: now.Unix(), Entries: entries}
+	return nil
+}
+
+// GetLeaderboard returns the latest snapshot of tracked accounts with their balances and recent activity.
+func (s *LiteStorage) GetLeaderboard(ctx context.Context) (core.Leaderboard, error) {
+	s.leaderboard.mu.RLock()
+	defer s.leaderboard.mu.RUnlock()
+	return s.leaderboard.snapshot, nil
+}
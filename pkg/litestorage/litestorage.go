@@ -11,7 +11,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/avast/retry-go"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/puzpuzpuz/xsync/v2"
@@ -23,11 +22,16 @@ import (
 	"github.com/tonkeeper/tongo/tep64"
 	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/utils"
 	"go.uber.org/zap"
 
 	"github.com/tonkeeper/opentonapi/pkg/blockchain/indexer"
 	"github.com/tonkeeper/opentonapi/pkg/cache"
 	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/literetry"
+	"github.com/tonkeeper/opentonapi/pkg/scheduler"
+	"github.com/tonkeeper/opentonapi/pkg/shardroute"
+	"github.com/tonkeeper/opentonapi/pkg/slowlog"
 )
 
 var storageTimeHistogramVec = promauto.NewHistogramVec(
@@ -39,6 +43,13 @@ var storageTimeHistogramVec = promauto.NewHistogramVec(
 	[]string{"method"},
 )
 
+// observeStorageTime records a duration of a storage method in seconds
+// both in the histogram and in the slow-request breakdown of the request being served.
+func observeStorageTime(ctx context.Context, method string, v float64) {
+	storageTimeHistogramVec.WithLabelValues(method).Observe(v)
+	slowlog.Record(ctx, method, time.Duration(v*float64(time.Second)))
+}
+
 // inMsgCreatedLT is used as a key to look up a transaction's hash based on in msg's account and created lt.
 type inMsgCreatedLT struct {
 	account tongo.AccountID
@@ -55,24 +66,57 @@ func extractInMsgCreatedLT(accountID tongo.AccountID, tx *tlb.Transaction) (inMs
 type LiteStorage struct {
 	logger                  *zap.Logger
 	client                  *liteapi.Client
+	shardRouter             *shardroute.Router
 	executor                abi.Executor
 	jettonMetaCache         *xsync.MapOf[string, tep64.Metadata]
 	transactionsIndexByHash *xsync.MapOf[tongo.Bits256, *core.Transaction]
 	transactionsByInMsgLT   *xsync.MapOf[inMsgCreatedLT, tongo.Bits256]
-	blockCache              *xsync.MapOf[tongo.BlockIDExt, *tlb.Block]
-	accountInterfacesCache  *xsync.MapOf[tongo.AccountID, []abi.ContractInterface]
+	// transactionsByMessageHash maps every form of an inbound message hash to the transaction it created.
+	transactionsByMessageHash *xsync.MapOf[tongo.Bits256, core.MessageHashMatch]
+	// bounceOrigins maps a hash of a bounce message to the transaction that has sent it.
+	bounceOrigins *xsync.MapOf[tongo.Bits256, tongo.Bits256]
+	// transactionsByAccount contains indexed transactions of every account sorted by lt.
+	transactionsByAccount *xsync.MapOf[tongo.AccountID, *accountTransactions]
+	// accountActivity contains activity stats of accounts maintained incrementally while indexing transactions.
+	accountActivity *xsync.MapOf[tongo.AccountID, *core.AccountActivity]
+	// codeHistories contains transactions of accounts that may have changed their code.
+	codeHistories          *xsync.MapOf[tongo.AccountID, *core.CodeHistory]
+	blockCache             *xsync.MapOf[tongo.BlockIDExt, *tlb.Block]
+	accountInterfacesCache *xsync.MapOf[tongo.AccountID, []abi.ContractInterface]
 	// tvmLibraryCache contains public tvm libraries.
 	// As a library is immutable, it's ok to cache it.
 	tvmLibraryCache cache.Cache[string, boc.Cell]
 	knownAccounts   map[string][]tongo.AccountID
 	// maxGoroutines specifies a number of goroutines used to perform some time-consuming operations.
 	maxGoroutines int
-	// trackingAccounts is a list of accounts we track. Defined with ACCOUNTS env variable.
+	// trackingMu protects trackingAccounts.
+	trackingMu sync.RWMutex
+	// trackingAccounts is a list of accounts we track. Defined with ACCOUNTS env variable
+	// and extended at runtime with Backfill.
 	trackingAccounts  map[tongo.AccountID]struct{}
 	pubKeyByAccountID *xsync.MapOf[tongo.AccountID, ed25519.PublicKey]
 	configCache       cache.Cache[int, ton.BlockchainConfig]
-
-	stopCh chan struct{}
+	backfills         backfills
+	networkStats      networkStats
+	leaderboard       leaderboard
+	lockupSchedules   lockupSchedules
+	// jettonTransfersCh passes jetton transfers observed in new blocks to the volume resolver.
+	jettonTransfersCh   chan jettonTransfer
+	jettonVolumes       jettonVolumes
+	jettonWalletMasters *xsync.MapOf[tongo.AccountID, tongo.AccountID]
+	jettonWalletCodes   jettonWalletCodes
+
+	// indexMu is held for reading while the transaction index is being modified,
+	// so Snapshot can block all modifications and capture a consistent state.
+	indexMu sync.RWMutex
+
+	// retryPolicy retries lite server queries failing with transient errors.
+	retryPolicy literetry.Policy
+	// snapshotCipher, if set, encrypts snapshots of the index, see WriteSnapshot.
+	snapshotCipher SnapshotCipher
+	// scheduler, if set, runs background jobs instead of goroutines of the storage.
+	scheduler *scheduler.Scheduler
+	stopCh    chan struct{}
 	// mu protects trimmedConfigBase64.
 	mu sync.RWMutex
 	// trimmedConfigBase64 is a blockchain config but with a limited set of keys.
@@ -87,8 +131,14 @@ type Options struct {
 	tfPools         []tongo.AccountID
 	jettons         []tongo.AccountID
 	executor        abi.Executor
+	retryPolicy     literetry.Policy
 	// blockCh is used to receive new blocks in the blockchain, if set.
-	blockCh <-chan indexer.IDandBlock
+	blockCh   <-chan indexer.IDandBlock
+	retention Retention
+	// shardRouter, if set, routes account state queries among lite servers.
+	shardRouter    *shardroute.Router
+	scheduler      *scheduler.Scheduler
+	snapshotCipher SnapshotCipher
 }
 
 func WithPreloadAccounts(a []tongo.AccountID) Option {
@@ -122,10 +172,43 @@ func WithBlockChannel(ch <-chan indexer.IDandBlock) Option {
 	}
 }
 
+// WithShardRouter routes account state queries to lite servers serving the shard of an account,
+// other queries go through the default client.
+func WithShardRouter(r *shardroute.Router) Option {
+	return func(o *Options) {
+		o.shardRouter = r
+	}
+}
+
+// WithScheduler runs background jobs of the storage with the scheduler:
+// "blockchain_config_refresh", "leaderboard_refresh", "lockup_schedules_refresh", "jetton_metadata_refresh",
+// "jetton_volume_prune" and "retention_prune".
+func WithScheduler(s *scheduler.Scheduler) Option {
+	return func(o *Options) {
+		o.scheduler = s
+	}
+}
+
+// WithRetryPolicy configures how lite server queries failing with transient errors are retried,
+// literetry.DefaultPolicy is used by default.
+func WithRetryPolicy(p literetry.Policy) Option {
+	return func(o *Options) {
+		o.retryPolicy = p
+	}
+}
+
+// WithSnapshotCipher encrypts snapshots of the index exported at /admin/snapshot,
+// so a snapshot kept on disk to bootstrap replicas isn't in plain text.
+func WithSnapshotCipher(c SnapshotCipher) Option {
+	return func(o *Options) {
+		o.snapshotCipher = c
+	}
+}
+
 type Option func(o *Options)
 
 func NewLiteStorage(log *zap.Logger, cli *liteapi.Client, opts ...Option) (*LiteStorage, error) {
-	o := &Options{}
+	o := &Options{retryPolicy: literetry.DefaultPolicy}
 	for i := range opts {
 		opts[i](o)
 	}
@@ -144,23 +227,34 @@ func NewLiteStorage(log *zap.Logger, cli *liteapi.Client, opts ...Option) (*Lite
 		// Set maxGoroutines to the double number of CPU cores
 		maxGoroutines: numCPU,
 
-		client:   cli,
-		executor: o.executor,
-		stopCh:   make(chan struct{}),
+		client:         cli,
+		shardRouter:    o.shardRouter,
+		executor:       o.executor,
+		retryPolicy:    o.retryPolicy,
+		snapshotCipher: o.snapshotCipher,
+		scheduler:      o.scheduler,
+		stopCh:         make(chan struct{}),
 		// read-only data
 		knownAccounts: make(map[string][]tongo.AccountID),
 		//Accounts we loaded from file (who knows? :) )
 		trackingAccounts: map[tongo.AccountID]struct{}{},
 		// data for concurrent access
 		// TODO: implement expiration logic for the caches below.
-		jettonMetaCache:         xsync.NewMapOf[tep64.Metadata](),
-		transactionsIndexByHash: xsync.NewTypedMapOf[tongo.Bits256, *core.Transaction](hashBits256),
-		transactionsByInMsgLT:   xsync.NewTypedMapOf[inMsgCreatedLT, tongo.Bits256](hashInMsgCreatedLT),
-		blockCache:              xsync.NewTypedMapOf[tongo.BlockIDExt, *tlb.Block](hashBlockIDExt),
-		accountInterfacesCache:  xsync.NewTypedMapOf[tongo.AccountID, []abi.ContractInterface](hashAccountID),
-		pubKeyByAccountID:       xsync.NewTypedMapOf[tongo.AccountID, ed25519.PublicKey](hashAccountID),
-		tvmLibraryCache:         cache.NewLRUCache[string, boc.Cell](10000, "tvm_libraries"),
-		configCache:             cache.NewLRUCache[int, ton.BlockchainConfig](4, "config"),
+		jettonMetaCache:           xsync.NewMapOf[tep64.Metadata](),
+		transactionsIndexByHash:   xsync.NewTypedMapOf[tongo.Bits256, *core.Transaction](hashBits256),
+		transactionsByInMsgLT:     xsync.NewTypedMapOf[inMsgCreatedLT, tongo.Bits256](hashInMsgCreatedLT),
+		transactionsByMessageHash: xsync.NewTypedMapOf[tongo.Bits256, core.MessageHashMatch](hashBits256),
+		bounceOrigins:             xsync.NewTypedMapOf[tongo.Bits256, tongo.Bits256](hashBits256),
+		transactionsByAccount:     xsync.NewTypedMapOf[tongo.AccountID, *accountTransactions](hashAccountID),
+		accountActivity:           xsync.NewTypedMapOf[tongo.AccountID, *core.AccountActivity](hashAccountID),
+		codeHistories:             xsync.NewTypedMapOf[tongo.AccountID, *core.CodeHistory](hashAccountID),
+		jettonWalletMasters:       xsync.NewTypedMapOf[tongo.AccountID, tongo.AccountID](hashAccountID),
+		jettonTransfersCh:         make(chan jettonTransfer, 10_000),
+		blockCache:                xsync.NewTypedMapOf[tongo.BlockIDExt, *tlb.Block](hashBlockIDExt),
+		accountInterfacesCache:    xsync.NewTypedMapOf[tongo.AccountID, []abi.ContractInterface](hashAccountID),
+		pubKeyByAccountID:         xsync.NewTypedMapOf[tongo.AccountID, ed25519.PublicKey](hashAccountID),
+		tvmLibraryCache:           cache.NewLRUCache[string, boc.Cell](10000, "tvm_libraries"),
+		configCache:               cache.NewLRUCache[int, ton.BlockchainConfig](4, "config"),
 	}
 	storage.knownAccounts["tf_pools"] = o.tfPools
 	storage.knownAccounts["jettons"] = o.jettons
@@ -189,7 +283,48 @@ func NewLiteStorage(log *zap.Logger, cli *liteapi.Client, opts ...Option) (*Lite
 		}
 	})
 	go storage.run(o.blockCh)
-	go storage.runBlockchainConfigUpdate(5 * time.Second)
+	go storage.runJettonVolumeResolver(storage.jettonTransfersCh)
+	storage.schedule(scheduler.Job{
+		Name:     "blockchain_config_refresh",
+		Interval: 5 * time.Second,
+		Run:      storage.refreshBlockchainConfig,
+	})
+	storage.schedule(scheduler.Job{
+		Name:      "leaderboard_refresh",
+		Interval:  10 * time.Minute,
+		Immediate: true,
+		Run:       storage.updateLeaderboard,
+	})
+	storage.schedule(scheduler.Job{
+		Name:      "lockup_schedules_refresh",
+		Interval:  time.Hour,
+		Immediate: true,
+		Run:       storage.updateLockupSchedules,
+	})
+	storage.schedule(scheduler.Job{
+		Name:      "jetton_metadata_refresh",
+		Interval:  6 * time.Hour,
+		Immediate: true,
+		Run:       storage.refreshJettonMetadata,
+	})
+	storage.schedule(scheduler.Job{
+		Name:     "jetton_volume_prune",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			storage.jettonVolumes.prune(time.Now().Unix())
+			return nil
+		},
+	})
+	if o.retention.enabled() {
+		storage.schedule(scheduler.Job{
+			Name:     "retention_prune",
+			Interval: o.retention.Interval,
+			Run: func(ctx context.Context) error {
+				storage.prune(o.retention, time.Now())
+				return nil
+			},
+		})
+	}
 	return storage, nil
 }
 
@@ -199,7 +334,21 @@ func (s *LiteStorage) SetExecutor(e abi.Executor) {
 
 // Shutdown stops all background goroutines.
 func (s *LiteStorage) Shutdown() {
-	s.stopCh <- struct{}{}
+	close(s.stopCh)
+}
+
+// schedule runs the job with the scheduler if it is configured, otherwise in its own goroutine until Shutdown.
+func (s *LiteStorage) schedule(job scheduler.Job) {
+	if s.scheduler != nil {
+		s.scheduler.Add(job)
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-s.stopCh
+		cancel()
+	}()
+	go scheduler.RunJob(ctx, s.logger, job)
 }
 
 func (s *LiteStorage) run(ch <-chan indexer.IDandBlock) {
@@ -207,26 +356,57 @@ func (s *LiteStorage) run(ch <-chan indexer.IDandBlock) {
 		return
 	}
 	for block := range ch {
-		for _, tx := range block.Block.AllTransactions() {
-			accountID := *ton.NewAccountID(block.ID.Workchain, tx.AccountAddr)
-			if _, ok := s.trackingAccounts[accountID]; ok {
-				hash := tongo.Bits256(tx.Hash())
-				transaction, err := core.ConvertTransaction(accountID.Workchain, tongo.Transaction{Transaction: *tx, BlockID: block.ID})
-				if err != nil {
-					s.logger.Error("failed to process tx",
-						zap.String("tx-hash", hash.Hex()),
-						zap.Error(err))
-					continue
-				}
-				s.transactionsIndexByHash.Store(hash, transaction)
-				if createLT, ok := extractInMsgCreatedLT(accountID, tx); ok {
-					s.transactionsByInMsgLT.Store(createLT, hash)
-				}
+		if !block.Orphaned {
+			if s.shardRouter != nil {
+				s.shardRouter.ObserveBlock(block.ID)
 			}
+			s.networkStats.observe(block.ID, block.Block, s.isTracking)
+			s.observeJettonTransfers(block.ID, block.Block)
+		}
+		s.indexBlock(block)
+	}
+}
+
+// indexBlock stores transactions of tracked accounts from the given block
+// or removes them if the block is orphaned.
+func (s *LiteStorage) indexBlock(block indexer.IDandBlock) {
+	s.indexMu.RLock()
+	defer s.indexMu.RUnlock()
+	for _, tx := range block.Block.AllTransactions() {
+		accountID := *ton.NewAccountID(block.ID.Workchain, tx.AccountAddr)
+		if !s.isTracking(accountID) {
+			continue
+		}
+		hash := tongo.Bits256(tx.Hash())
+		if block.Orphaned {
+			// the block is no longer part of the canonical chain, so we roll back its index entries.
+			s.removeTransaction(accountID, hash, tx)
+			continue
+		}
+		transaction, err := core.ConvertTransaction(accountID.Workchain, tongo.Transaction{Transaction: *tx, BlockID: block.ID})
+		if err != nil {
+			s.logger.Error("failed to process tx",
+				zap.String("tx-hash", hash.Hex()),
+				zap.Error(err))
+			continue
 		}
+		s.storeTransaction(accountID, hash, transaction, tx)
 	}
 }
 
+func (s *LiteStorage) isTracking(accountID tongo.AccountID) bool {
+	s.trackingMu.RLock()
+	defer s.trackingMu.RUnlock()
+	_, ok := s.trackingAccounts[accountID]
+	return ok
+}
+
+func (s *LiteStorage) trackAccount(accountID tongo.AccountID) {
+	s.trackingMu.Lock()
+	defer s.trackingMu.Unlock()
+	s.trackingAccounts[accountID] = struct{}{}
+}
+
 func (s *LiteStorage) GetContract(ctx context.Context, id tongo.AccountID) (*core.Contract, error) {
 	account, err := s.GetRawAccount(ctx, id)
 	if err != nil {
@@ -245,19 +425,10 @@ func (s *LiteStorage) GetContract(ctx context.Context, id tongo.AccountID) (*cor
 // GetRawAccount returns low-level information about an account taken directly from the blockchain.
 func (s *LiteStorage) GetRawAccount(ctx context.Context, address tongo.AccountID) (*core.Account, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_raw_account").Observe(v)
+		observeStorageTime(ctx, "get_raw_account", v)
 	}))
 	defer timer.ObserveDuration()
-	var account tlb.ShardAccount
-	err := retry.Do(func() error {
-		state, err := s.client.GetAccountState(ctx, address)
-		if err != nil {
-			return err
-		}
-		account = state
-		return nil
-	}, retry.Attempts(10), retry.Delay(10*time.Millisecond))
-
+	account, err := s.accountState(ctx, address)
 	if err != nil {
 		return nil, err
 	}
@@ -267,20 +438,12 @@ func (s *LiteStorage) GetRawAccount(ctx context.Context, address tongo.AccountID
 // GetRawAccounts returns low-level information about several accounts taken directly from the blockchain.
 func (s *LiteStorage) GetRawAccounts(ctx context.Context, ids []tongo.AccountID) ([]*core.Account, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_raw_accounts").Observe(v)
+		observeStorageTime(ctx, "get_raw_accounts", v)
 	}))
 	defer timer.ObserveDuration()
 	var accounts []*core.Account
 	for _, address := range ids {
-		var account tlb.ShardAccount
-		err := retry.Do(func() error {
-			state, err := s.client.GetAccountState(ctx, address)
-			if err != nil {
-				return err
-			}
-			account = state
-			return nil
-		}, retry.Attempts(10), retry.Delay(10*time.Millisecond))
+		account, err := s.accountState(ctx, address)
 		if err != nil {
 			return nil, err
 		}
@@ -295,7 +458,7 @@ func (s *LiteStorage) GetRawAccounts(ctx context.Context, ids []tongo.AccountID)
 
 func (s *LiteStorage) preloadAccount(a tongo.AccountID) error {
 	ctx := context.Background()
-	accountTxs, err := s.client.GetLastTransactions(ctx, a, 2000)
+	accountTxs, err := s.getLastTransactions(ctx, a, 2000)
 	if err != nil {
 		return err
 	}
@@ -304,22 +467,18 @@ func (s *LiteStorage) preloadAccount(a tongo.AccountID) error {
 		if err != nil {
 			return err
 		}
-		hash := tongo.Bits256(tx.Hash())
-		s.transactionsIndexByHash.Store(hash, t)
-		if createLT, ok := extractInMsgCreatedLT(a, &tx.Transaction); ok {
-			s.transactionsByInMsgLT.Store(createLT, hash)
-		}
+		s.storeTransaction(a, tongo.Bits256(tx.Hash()), t, &tx.Transaction)
 	}
 	return nil
 }
 
 func (s *LiteStorage) preloadBlock(id tongo.BlockID) error {
 	ctx := context.Background()
-	extID, _, err := s.client.LookupBlock(ctx, id, 1, nil, nil)
+	extID, _, err := s.lookupBlock(ctx, id)
 	if err != nil {
 		return err
 	}
-	block, err := s.client.GetBlock(ctx, extID)
+	block, err := s.getBlock(ctx, extID)
 	if err != nil {
 		return err
 	}
@@ -333,25 +492,21 @@ func (s *LiteStorage) preloadBlock(id tongo.BlockID) error {
 		if err != nil {
 			return err
 		}
-		hash := tongo.Bits256(tx.Hash())
-		s.transactionsIndexByHash.Store(hash, t)
-		if createLT, ok := extractInMsgCreatedLT(accountID, tx); ok {
-			s.transactionsByInMsgLT.Store(createLT, hash)
-		}
+		s.storeTransaction(accountID, tongo.Bits256(tx.Hash()), t, tx)
 	}
 	return nil
 }
 
 func (s *LiteStorage) GetBlockHeader(ctx context.Context, id tongo.BlockID) (*core.BlockHeader, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_block_header").Observe(v)
+		observeStorageTime(ctx, "get_block_header", v)
 	}))
 	defer timer.ObserveDuration()
-	blockID, _, err := s.client.LookupBlock(ctx, id, 1, nil, nil)
+	blockID, _, err := s.lookupBlock(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	block, err := s.client.GetBlock(ctx, blockID)
+	block, err := s.getBlock(ctx, blockID)
 	if err != nil {
 		return nil, err
 	}
@@ -366,10 +521,10 @@ func (s *LiteStorage) GetBlockHeader(ctx context.Context, id tongo.BlockID) (*co
 
 func (s *LiteStorage) GetBlockShards(ctx context.Context, id tongo.BlockID) ([]ton.BlockID, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_block_shards").Observe(v)
+		observeStorageTime(ctx, "get_block_shards", v)
 	}))
 	defer timer.ObserveDuration()
-	blockID, _, err := s.client.LookupBlock(ctx, id, 1, nil, nil)
+	blockID, _, err := s.lookupBlock(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -389,10 +544,10 @@ func (s *LiteStorage) GetBlockShards(ctx context.Context, id tongo.BlockID) ([]t
 
 func (s *LiteStorage) LastMasterchainBlockHeader(ctx context.Context) (*core.BlockHeader, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_masterchain").Observe(v)
+		observeStorageTime(ctx, "get_masterchain", v)
 	}))
 	defer timer.ObserveDuration()
-	info, err := s.client.GetMasterchainInfo(ctx)
+	info, err := s.getMasterchainInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -401,7 +556,7 @@ func (s *LiteStorage) LastMasterchainBlockHeader(ctx context.Context) (*core.Blo
 
 func (s *LiteStorage) GetTransaction(ctx context.Context, hash tongo.Bits256) (*core.Transaction, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_transaction").Observe(v)
+		observeStorageTime(ctx, "get_transaction", v)
 	}))
 	defer timer.ObserveDuration()
 	tx, prs := s.transactionsIndexByHash.Load(hash)
@@ -411,33 +566,16 @@ func (s *LiteStorage) GetTransaction(ctx context.Context, hash tongo.Bits256) (*
 	return nil, fmt.Errorf("not found tx %x", hash)
 }
 
-func (s *LiteStorage) SearchTransactionByMessageHash(ctx context.Context, hash tongo.Bits256) (*tongo.Bits256, error) {
-	//var txHash tongo.Bits256
-	//found := false
-	//s.transactionsIndexByHash.Range(func(key tongo.Bits256, value *core.Transaction) bool {
-	//	if value.InMsg != nil && value.InMsg.Hash {
-	//		txHash = key
-	//		found =true
-	//		return false
-	//	}
-	//	return true
-	//})
-	//if found {
-	//	return &txHash, nil
-	//}
-	return nil, core.ErrEntityNotFound
-}
-
 func (s *LiteStorage) GetBlockTransactions(ctx context.Context, id tongo.BlockID) ([]*core.Transaction, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_block_transactions").Observe(v)
+		observeStorageTime(ctx, "get_block_transactions", v)
 	}))
 	defer timer.ObserveDuration()
-	blockID, _, err := s.client.LookupBlock(ctx, id, 1, nil, nil)
+	blockID, _, err := s.lookupBlock(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	block, err := s.client.GetBlock(ctx, blockID)
+	block, err := s.getBlock(ctx, blockID)
 	if err != nil {
 		return nil, err
 	}
@@ -458,7 +596,7 @@ func (s *LiteStorage) searchTxInCache(a tongo.AccountID, lt uint64) *core.Transa
 
 func (s *LiteStorage) GetStorageProviders(ctx context.Context) ([]core.StorageProvider, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_storage_providers").Observe(v)
+		observeStorageTime(ctx, "get_storage_providers", v)
 	}))
 	defer timer.ObserveDuration()
 
@@ -467,26 +605,26 @@ func (s *LiteStorage) GetStorageProviders(ctx context.Context) ([]core.StoragePr
 
 func (s *LiteStorage) RunSmcMethod(ctx context.Context, id tongo.AccountID, method string, stack tlb.VmStack) (uint32, tlb.VmStack, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("run_smc_method").Observe(v)
+		observeStorageTime(ctx, "run_smc_method", v)
 	}))
 	defer timer.ObserveDuration()
-	return s.client.RunSmcMethod(ctx, id, method, stack)
+	return s.runSmcMethodByID(ctx, id, utils.MethodIdFromName(method), stack)
 }
 
 func (s *LiteStorage) RunSmcMethodByID(ctx context.Context, id tongo.AccountID, method int, stack tlb.VmStack) (uint32, tlb.VmStack, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("run_smc_method_by_id").Observe(v)
+		observeStorageTime(ctx, "run_smc_method_by_id", v)
 	}))
 	defer timer.ObserveDuration()
-	return s.client.RunSmcMethodByID(ctx, id, method, stack)
+	return s.runSmcMethodByID(ctx, id, method, stack)
 }
 
 func (s *LiteStorage) GetAccountTransactions(ctx context.Context, id tongo.AccountID, limit int, beforeLt, afterLt uint64, descendingOrder bool) ([]*core.Transaction, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_account_transactions").Observe(v)
+		observeStorageTime(ctx, "get_account_transactions", v)
 	}))
 	defer timer.ObserveDuration()
-	txs, err := s.client.GetLastTransactions(ctx, id, limit) //todo: custom with beforeLt, afterLt and descendingOrder
+	txs, err := s.getLastTransactions(ctx, id, limit) //todo: custom with beforeLt, afterLt and descendingOrder
 	if err != nil {
 		return nil, err
 	}
@@ -503,7 +641,7 @@ func (s *LiteStorage) GetAccountTransactions(ctx context.Context, id tongo.Accou
 
 func (s *LiteStorage) FindAllDomainsResolvedToAddress(ctx context.Context, a tongo.AccountID, collections map[tongo.AccountID]string) ([]string, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("find_all_domains_resolved_to_address").Observe(v)
+		observeStorageTime(ctx, "find_all_domains_resolved_to_address", v)
 	}))
 	defer timer.ObserveDuration()
 	return nil, nil
@@ -511,7 +649,7 @@ func (s *LiteStorage) FindAllDomainsResolvedToAddress(ctx context.Context, a ton
 
 func (s *LiteStorage) GetWalletPubKey(ctx context.Context, address tongo.AccountID) (ed25519.PublicKey, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_wallet_by_pubkey").Observe(v)
+		observeStorageTime(ctx, "get_wallet_by_pubkey", v)
 	}))
 	defer timer.ObserveDuration()
 	_, result, err := abi.GetPublicKey(ctx, s.executor, address)
@@ -534,7 +672,7 @@ func (s *LiteStorage) GetWalletPubKey(ctx context.Context, address tongo.Account
 
 func (s *LiteStorage) ReindexAccount(ctx context.Context, accountID tongo.AccountID) error {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("reindex_account").Observe(v)
+		observeStorageTime(ctx, "reindex_account", v)
 	}))
 	defer timer.ObserveDuration()
 	return nil
@@ -542,7 +680,7 @@ func (s *LiteStorage) ReindexAccount(ctx context.Context, accountID tongo.Accoun
 
 func (s *LiteStorage) GetDnsExpiring(ctx context.Context, id tongo.AccountID, period *int) ([]core.DnsExpiring, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_dns_expiring").Observe(v)
+		observeStorageTime(ctx, "get_dns_expiring", v)
 	}))
 	defer timer.ObserveDuration()
 	return nil, nil
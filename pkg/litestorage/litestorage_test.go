@@ -48,10 +48,15 @@ func TestLiteStorage_run(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &LiteStorage{
-				logger:                  zap.L(),
-				transactionsIndexByHash: xsync.NewTypedMapOf[tongo.Bits256, *core.Transaction](hashBits256),
-				transactionsByInMsgLT:   xsync.NewTypedMapOf[inMsgCreatedLT, tongo.Bits256](hashInMsgCreatedLT),
-				trackingAccounts:        tt.trackingAccounts,
+				logger:                    zap.L(),
+				transactionsIndexByHash:   xsync.NewTypedMapOf[tongo.Bits256, *core.Transaction](hashBits256),
+				transactionsByInMsgLT:     xsync.NewTypedMapOf[inMsgCreatedLT, tongo.Bits256](hashInMsgCreatedLT),
+				transactionsByAccount:     xsync.NewTypedMapOf[tongo.AccountID, *accountTransactions](hashAccountID),
+				accountActivity:           xsync.NewTypedMapOf[tongo.AccountID, *core.AccountActivity](hashAccountID),
+				codeHistories:             xsync.NewTypedMapOf[tongo.AccountID, *core.CodeHistory](hashAccountID),
+				transactionsByMessageHash: xsync.NewTypedMapOf[tongo.Bits256, core.MessageHashMatch](hashBits256),
+				bounceOrigins:             xsync.NewTypedMapOf[tongo.Bits256, tongo.Bits256](hashBits256),
+				trackingAccounts:          tt.trackingAccounts,
 			}
 			ch := make(chan indexer.IDandBlock)
 			go s.run(ch)
@@ -76,16 +81,14 @@ func TestLiteStorage_run(t *testing.T) {
 	}
 }
 
-func TestLiteStorage_runBlockchainConfigUpdate(t *testing.T) {
+func TestLiteStorage_refreshBlockchainConfig(t *testing.T) {
 	cli, err := liteapi.NewClient(liteapi.FromEnvsOrMainnet())
 	require.Nil(t, err)
 	s := &LiteStorage{
 		logger: zap.L(),
 		client: cli,
 	}
-	s.runBlockchainConfigUpdate(100 * time.Millisecond)
-
-	time.Sleep(2 * time.Second)
+	require.Nil(t, s.refreshBlockchainConfig(context.Background()))
 
 	configBase64 := s.blockchainConfig()
 	require.NotEmpty(t, configBase64)
@@ -0,0 +1,64 @@
+package litestorage
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/tonkeeper/tongo/abi"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// lockupSchedules keeps vesting schedules of tracked lockup wallets used by the chain calendar.
+type lockupSchedules struct {
+	mu        sync.RWMutex
+	schedules []core.LockupSchedule
+}
+
+// updateLockupSchedules reads vesting schedules of tracked accounts implementing the lockup wallet interface.
+// A schedule never changes once a lockup wallet is deployed, but tracked accounts do.
+func (s *LiteStorage) updateLockupSchedules(ctx context.Context) error {
+	var schedules []core.LockupSchedule
+	for _, account := range s.trackedAccounts() {
+		interfaces, err := s.getAccountInterfaces(ctx, account)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+		if !slices.Contains(interfaces, abi.LockupVesting) {
+			continue
+		}
+		_, value, err := abi.GetLockupData(ctx, s.executor, account)
+		if err != nil {
+			s.logger.Warn("failed to get lockup data", zap.String("account", account.String()), zap.Error(err))
+			continue
+		}
+		data, ok := value.(abi.GetLockupDataResult)
+		if !ok {
+			continue
+		}
+		schedules = append(schedules, core.LockupSchedule{
+			Account:       account,
+			StartTime:     data.StartTime,
+			CliffDuration: data.CliffDiration,
+			UnlockPeriod:  data.UnlockPeriod,
+			TotalDuration: data.TotalDuration,
+			TotalAmount:   data.TotalAmount,
+		})
+	}
+	s.lockupSchedules.mu.Lock()
+	defer s.lockupSchedules.mu.Unlock()
+	s.lockupSchedules.schedules = schedules
+	return nil
+}
+
+// GetLockupSchedules returns vesting schedules of tracked lockup wallets.
+func (s *LiteStorage) GetLockupSchedules(ctx context.Context) ([]core.LockupSchedule, error) {
+	s.lockupSchedules.mu.RLock()
+	defer s.lockupSchedules.mu.RUnlock()
+	return s.lockupSchedules.schedules, nil
+}
@@ -0,0 +1,68 @@
+package litestorage
+
+import (
+	"context"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// indexMessageHashes makes the transaction discoverable by any form of its inbound message hash.
+func (s *LiteStorage) indexMessageHashes(txHash tongo.Bits256, tx *tlb.Transaction) {
+	if tx == nil || !tx.Msgs.InMsg.Exists {
+		return
+	}
+	hashes, err := core.MessageHashes(tx.Msgs.InMsg.Value.Value)
+	if err != nil {
+		s.logger.Warn("failed to compute message hashes", zap.String("tx", txHash.Hex()), zap.Error(err))
+		return
+	}
+	var normalized *tongo.Bits256
+	if hash, ok := hashes[core.MessageHashNormalized]; ok {
+		normalized = &hash
+	}
+	for kind, hash := range hashes {
+		s.transactionsByMessageHash.Store(hash, core.MessageHashMatch{
+			Kind:            kind,
+			TransactionHash: txHash,
+			NormalizedHash:  normalized,
+		})
+	}
+}
+
+// unindexMessageHashes rolls back indexMessageHashes.
+func (s *LiteStorage) unindexMessageHashes(txHash tongo.Bits256, tx *tlb.Transaction) {
+	if tx == nil || !tx.Msgs.InMsg.Exists {
+		return
+	}
+	hashes, err := core.MessageHashes(tx.Msgs.InMsg.Value.Value)
+	if err != nil {
+		return
+	}
+	for _, hash := range hashes {
+		if match, ok := s.transactionsByMessageHash.Load(hash); ok && match.TransactionHash == txHash {
+			s.transactionsByMessageHash.Delete(hash)
+		}
+	}
+}
+
+// ResolveMessageHash finds a transaction created by a message with the given hash.
+// The hash can be of any form described by core.MessageHashKind.
+func (s *LiteStorage) ResolveMessageHash(ctx context.Context, hash tongo.Bits256) (core.MessageHashMatch, error) {
+	match, ok := s.transactionsByMessageHash.Load(hash)
+	if !ok {
+		return core.MessageHashMatch{}, core.ErrEntityNotFound
+	}
+	return match, nil
+}
+
+func (s *LiteStorage) SearchTransactionByMessageHash(ctx context.Context, hash tongo.Bits256) (*tongo.Bits256, error) {
+	match, err := s.ResolveMessageHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return &match.TransactionHash, nil
+}
@@ -0,0 +1,160 @@
+package litestorage
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// networkStatsWindow defines how long block samples are kept to calculate TPS and block time percentiles.
+const networkStatsWindow = time.Hour
+
+// blockSample is a summary of a block used to calculate network stats.
+type blockSample struct {
+	genUtime     int64
+	transactions int
+}
+
+// networkStats collects chain-wide aggregates from blocks passing through the indexer.
+type networkStats struct {
+	mu            sync.Mutex
+	observedSince int64
+	// blocks contains samples of all blocks generated during the last networkStatsWindow.
+	blocks []blockSample
+	// masterchainUtimes contains generation times of masterchain blocks during the last networkStatsWindow.
+	masterchainUtimes []int64
+	feesCollected     int64
+	feesBurned        int64
+	// newAccounts maps the beginning of a day to a number of tracked accounts deployed during that day.
+	newAccounts map[int64]int
+}
+
+// observe takes the given block into account.
+// isTracking reports whether an account belongs to the set of tracked accounts.
+func (n *networkStats) observe(id tongo.BlockIDExt, block *tlb.Block, isTracking func(tongo.AccountID) bool) {
+	genUtime := int64(block.Info.GenUtime)
+	transactions := block.AllTransactions()
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.observedSince == 0 {
+		n.observedSince = genUtime
+	}
+	if n.newAccounts == nil {
+		n.newAccounts = map[int64]int{}
+	}
+	n.blocks = append(n.blocks, blockSample{genUtime: genUtime, transactions: len(transactions)})
+	if id.Workchain == -1 {
+		// a masterchain block's value flow accounts for fees of all shard blocks it commits.
+		n.masterchainUtimes = append(n.masterchainUtimes, genUtime)
+		n.feesCollected += int64(block.ValueFlow.FeesCollected.Grams)
+		if block.ValueFlow.Burned != nil {
+			n.feesBurned += int64(block.ValueFlow.Burned.Grams)
+		}
+	}
+	for _, tx := range transactions {
+		if tx.OrigStatus != tlb.AccountNone || tx.EndStatus == tlb.AccountNone {
+			continue
+		}
+		if !isTracking(*ton.NewAccountID(id.Workchain, tx.AccountAddr)) {
+			continue
+		}
+		day := int64(tx.Now) - int64(tx.Now)%(24*60*60)
+		n.newAccounts[day] += 1
+	}
+	n.prune(genUtime - int64(networkStatsWindow.Seconds()))
+}
+
+// prune removes samples generated before the given time.
+func (n *networkStats) prune(before int64) {
+	i := sort.Search(len(n.blocks), func(i int) bool { return n.blocks[i].genUtime >= before })
+	n.blocks = n.blocks[i:]
+	j := sort.Search(len(n.masterchainUtimes), func(j int) bool { return n.masterchainUtimes[j] >= before })
+	n.masterchainUtimes = n.masterchainUtimes[j:]
+}
+
+// stats returns network stats as of now.
+func (n *networkStats) stats(now int64) core.NetworkStats {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	result := core.NetworkStats{
+		ObservedSince: n.observedSince,
+		FeesCollected: n.feesCollected,
+		FeesBurned:    n.feesBurned,
+	}
+	result.TPS.LastMinute = n.tps(now, time.Minute)
+	result.TPS.Last5Minutes = n.tps(now, 5*time.Minute)
+	result.TPS.LastHour = n.tps(now, time.Hour)
+	for _, sample := range n.blocks {
+		if sample.genUtime >= now-int64(time.Hour.Seconds()) {
+			result.TPS.TransactionsLastHour += int64(sample.transactions)
+		}
+	}
+	var intervals []float64
+	for i := 1; i < len(n.masterchainUtimes); i++ {
+		intervals = append(intervals, float64(n.masterchainUtimes[i]-n.masterchainUtimes[i-1]))
+	}
+	if len(intervals) > 0 {
+		last := len(n.masterchainUtimes) - 1
+		result.AvgMasterchainBlockTime = float64(n.masterchainUtimes[last]-n.masterchainUtimes[0]) / float64(len(intervals))
+	}
+	sort.Float64s(intervals)
+	result.MasterchainBlockTime = core.Percentiles{
+		P50: percentile(intervals, 0.5),
+		P90: percentile(intervals, 0.9),
+		P99: percentile(intervals, 0.99),
+	}
+	for day, count := range n.newAccounts {
+		result.NewAccounts = append(result.NewAccounts, core.DailyCount{Date: day, Count: count})
+	}
+	sort.Slice(result.NewAccounts, func(i, j int) bool {
+		return result.NewAccounts[i].Date < result.NewAccounts[j].Date
+	})
+	return result
+}
+
+// tps returns transactions per second over the given window ending at now.
+// If the indexer has been running for less than the window, the actual observation time is used.
+func (n *networkStats) tps(now int64, window time.Duration) float64 {
+	since := now - int64(window.Seconds())
+	if since < n.observedSince {
+		since = n.observedSince
+	}
+	if now <= since {
+		return 0
+	}
+	total := 0
+	for _, sample := range n.blocks {
+		if sample.genUtime > since {
+			total += sample.transactions
+		}
+	}
+	return float64(total) / float64(now-since)
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// GetNetworkStats returns chain-wide aggregates collected from blocks observed by the indexer.
+func (s *LiteStorage) GetNetworkStats(ctx context.Context) (core.NetworkStats, error) {
+	return s.networkStats.stats(time.Now().Unix()), nil
+}
@@ -0,0 +1,53 @@
+package litestorage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+func testBlock(genUtime uint32, fees, burned uint64) *tlb.Block {
+	block := &tlb.Block{}
+	block.Info.GenUtime = genUtime
+	block.ValueFlow.FeesCollected.Grams = tlb.Grams(fees)
+	burnedCC := tlb.CurrencyCollection{Grams: tlb.Grams(burned)}
+	block.ValueFlow.Burned = &burnedCC
+	return block
+}
+
+func Test_networkStats(t *testing.T) {
+	master := tongo.BlockIDExt{BlockID: tongo.BlockID{Workchain: -1}}
+	basechain := tongo.BlockIDExt{BlockID: tongo.BlockID{Workchain: 0}}
+	notTracking := func(tongo.AccountID) bool { return false }
+
+	var n networkStats
+	n.observe(master, testBlock(1000, 10, 5), notTracking)
+	n.observe(basechain, testBlock(1003, 100, 100), notTracking)
+	n.observe(master, testBlock(1005, 20, 10), notTracking)
+	n.observe(master, testBlock(1011, 30, 15), notTracking)
+
+	stats := n.stats(1020)
+	require.Equal(t, int64(1000), stats.ObservedSince)
+	require.Equal(t, int64(60), stats.FeesCollected)
+	require.Equal(t, int64(30), stats.FeesBurned)
+	require.Equal(t, core.Percentiles{P50: 5, P90: 6, P99: 6}, stats.MasterchainBlockTime)
+	require.Equal(t, 5.5, stats.AvgMasterchainBlockTime)
+	require.Equal(t, float64(0), stats.TPS.LastMinute)
+
+	// samples older than an hour are dropped.
+	n.observe(master, testBlock(5000, 0, 0), notTracking)
+	require.Equal(t, 1, len(n.blocks))
+	require.Equal(t, []int64{5000}, n.masterchainUtimes)
+}
+
+func Test_percentile(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	require.Equal(t, float64(5), percentile(values, 0.5))
+	require.Equal(t, float64(9), percentile(values, 0.9))
+	require.Equal(t, float64(10), percentile(values, 0.99))
+	require.Equal(t, float64(0), percentile(nil, 0.5))
+}
@@ -35,7 +35,7 @@ func (s *LiteStorage) GetNftCollections(ctx context.Context, limit, offset *int3
 
 func (s *LiteStorage) GetNftCollectionByCollectionAddress(ctx context.Context, address tongo.AccountID) (core.NftCollection, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_nft_collection").Observe(v)
+		observeStorageTime(ctx, "get_nft_collection", v)
 	}))
 	defer timer.ObserveDuration()
 	_, value, err := abi.GetCollectionData(ctx, s.executor, address)
@@ -0,0 +1,50 @@
+package litestorage
+
+import (
+	"context"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// SearchTransactionsByPayload looks for indexed transactions of tracked accounts
+// whose messages match the search, the latest transactions go first.
+func (s *LiteStorage) SearchTransactionsByPayload(ctx context.Context, search core.PayloadSearch) ([]*core.Transaction, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		observeStorageTime(ctx, "search_transactions_by_payload", v)
+	}))
+	defer timer.ObserveDuration()
+	var accounts map[tongo.AccountID]struct{}
+	if search.Accounts != nil {
+		accounts = make(map[tongo.AccountID]struct{}, len(search.Accounts))
+		for _, account := range search.Accounts {
+			accounts[account] = struct{}{}
+		}
+	}
+	var result []*core.Transaction
+	s.transactionsIndexByHash.Range(func(_ tongo.Bits256, tx *core.Transaction) bool {
+		if search.BeforeLt != 0 && tx.Lt >= search.BeforeLt {
+			return true
+		}
+		if _, ok := accounts[tx.Account]; accounts != nil && !ok {
+			return true
+		}
+		if search.MatchTransaction(tx) {
+			result = append(result, tx)
+		}
+		return ctx.Err() == nil
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Lt > result[j].Lt
+	})
+	if search.Limit > 0 && len(result) > search.Limit {
+		result = result[:search.Limit]
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package litestorage
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// PurgeIndex drops everything indexed so far and backfills tracked accounts again.
+// It is supposed to be called after a chain reset, when the indexed data doesn't belong to the current chain anymore.
+// Tracked accounts are kept.
+func (s *LiteStorage) PurgeIndex() {
+	purged := s.purgeIndex()
+	accounts := s.trackedAccounts()
+	s.logger.Warn("local index purged",
+		zap.Int("transactions", purged),
+		zap.Int("tracked-accounts", len(accounts)))
+	for _, account := range accounts {
+		s.StartBackfill(account, BackfillOptions{})
+	}
+}
+
+// purgeIndex clears the transaction index and all data derived from indexed blocks,
+// including caches of account states which don't belong to the current chain either.
+// It returns a number of dropped transactions.
+func (s *LiteStorage) purgeIndex() int {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+	purged := s.transactionsIndexByHash.Size()
+	s.transactionsIndexByHash.Clear()
+	s.transactionsByAccount.Clear()
+	s.transactionsByInMsgLT.Clear()
+	s.transactionsByMessageHash.Clear()
+	s.bounceOrigins.Clear()
+	s.accountActivity.Clear()
+	s.codeHistories.Clear()
+	s.blockCache.Clear()
+	s.jettonWalletMasters.Clear()
+	s.accountInterfacesCache.Clear()
+	s.pubKeyByAccountID.Clear()
+	s.configCache.Clear()
+
+	s.lockupSchedules.mu.Lock()
+	s.lockupSchedules.schedules = nil
+	s.lockupSchedules.mu.Unlock()
+
+	s.networkStats.mu.Lock()
+	s.networkStats.observedSince = 0
+	s.networkStats.blocks = nil
+	s.networkStats.masterchainUtimes = nil
+	s.networkStats.feesCollected = 0
+	s.networkStats.feesBurned = 0
+	s.networkStats.newAccounts = nil
+	s.networkStats.mu.Unlock()
+
+	s.jettonVolumes.mu.Lock()
+	s.jettonVolumes.buckets = nil
+	s.jettonVolumes.mu.Unlock()
+
+	s.leaderboard.mu.Lock()
+	s.leaderboard.snapshot = core.Leaderboard{}
+	s.leaderboard.mu.Unlock()
+	return purged
+}
@@ -0,0 +1,44 @@
+package litestorage
+
+import (
+	"crypto/ed25519"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+func TestLiteStorage_purgeIndex(t *testing.T) {
+	txBoc, err := os.ReadFile("testdata/transaction.boc")
+	require.Nil(t, err)
+	account := tongo.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+
+	s := newSnapshotTestStorage()
+	restored, err := s.restoreTransactions([]tongo.AccountID{account}, []SnapshotTransaction{
+		{Block: "(0,8000000000000000,30816553,f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f80,0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0)", Boc: txBoc},
+	}, map[tongo.AccountID]uint64{})
+	require.Nil(t, err)
+	require.Equal(t, 1, restored)
+	require.NotZero(t, s.transactionsByMessageHash.Size())
+	wallet := tongo.MustParseAccountID("0:1111111111111111111111111111111111111111111111111111111111111111")
+	s.jettonWalletMasters.Store(wallet, account)
+	s.pubKeyByAccountID.Store(account, ed25519.PublicKey{})
+	s.configCache.Set(0, ton.BlockchainConfig{})
+	s.lockupSchedules.schedules = []core.LockupSchedule{{}}
+
+	require.Equal(t, 1, s.purgeIndex())
+	require.Zero(t, s.transactionsIndexByHash.Size())
+	require.Zero(t, s.transactionsByInMsgLT.Size())
+	require.Zero(t, s.transactionsByMessageHash.Size())
+	require.Zero(t, s.accountActivity.Size())
+	require.Zero(t, s.jettonWalletMasters.Size())
+	require.Zero(t, s.pubKeyByAccountID.Size())
+	require.Empty(t, s.configCache.Keys())
+	require.Nil(t, s.lockupSchedules.schedules)
+	// tracked accounts survive the purge to be backfilled again.
+	require.True(t, s.isTracking(account))
+}
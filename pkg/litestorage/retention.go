@@ -0,0 +1,171 @@
+package litestorage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+var (
+	prunedEntries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "litestorage_pruned_entries_total",
+		Help: "Number of entries removed from the local index by the retention policy",
+	}, []string{"category"})
+	reclaimedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "litestorage_reclaimed_bytes_total",
+		Help: "Approximate number of bytes reclaimed by the retention policy",
+	}, []string{"category"})
+	indexBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "litestorage_index_bytes",
+		Help: "Approximate size of the local index after the latest pruning",
+	}, []string{"category"})
+)
+
+const (
+	retentionCategoryTransactions = "transactions"
+	retentionCategoryBlocks       = "blocks"
+)
+
+// RetentionPolicy limits how much data of a particular category the local index keeps.
+// Zero values mean no limit.
+type RetentionPolicy struct {
+	// MaxAge is the longest time an entry is kept.
+	MaxAge time.Duration
+	// MaxBytes is an approximate total size of entries,
+	// the oldest entries are removed first once it's exceeded.
+	MaxBytes int64
+}
+
+func (p RetentionPolicy) enabled() bool {
+	return p.MaxAge > 0 || p.MaxBytes > 0
+}
+
+// Retention configures a background pruner of the local index.
+type Retention struct {
+	// Transactions limits indexed transactions of tracked accounts.
+	// The size of a transaction is the size of its raw BoC.
+	// Pruning doesn't affect account stats.
+	Transactions RetentionPolicy
+	// Blocks limits the cache of downloaded blocks. Only MaxAge is supported.
+	Blocks RetentionPolicy
+	// Interval between two pruning runs.
+	Interval time.Duration
+}
+
+// WithRetention configures how long the local index keeps its data.
+func WithRetention(retention Retention) Option {
+	return func(o *Options) {
+		o.retention = retention
+	}
+}
+
+// retentionEntry describes an entry of the local index considered for pruning.
+type retentionEntry[K comparable] struct {
+	key K
+	// lt orders entries with the same time.
+	lt   uint64
+	time int64
+	size int64
+}
+
+// expiredEntries returns entries to be removed according to the policy along with the total size of the remaining ones.
+func expiredEntries[K comparable](entries []retentionEntry[K], policy RetentionPolicy, now time.Time) ([]retentionEntry[K], int64) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].time == entries[j].time {
+			return entries[i].lt < entries[j].lt
+		}
+		return entries[i].time < entries[j].time
+	})
+	var total int64
+	for _, entry := range entries {
+		total += entry.size
+	}
+	var expired []retentionEntry[K]
+	for _, entry := range entries {
+		tooOld := policy.MaxAge > 0 && entry.time < now.Add(-policy.MaxAge).Unix()
+		tooBig := policy.MaxBytes > 0 && total > policy.MaxBytes
+		if !tooOld && !tooBig {
+			break
+		}
+		expired = append(expired, entry)
+		total -= entry.size
+	}
+	return expired, total
+}
+
+func (r Retention) enabled() bool {
+	return r.Interval > 0 && (r.Transactions.enabled() || r.Blocks.enabled())
+}
+
+func (s *LiteStorage) prune(retention Retention, now time.Time) {
+	if retention.Transactions.enabled() {
+		s.pruneTransactions(retention.Transactions, now)
+	}
+	if retention.Blocks.enabled() {
+		s.pruneBlocks(retention.Blocks, now)
+	}
+}
+
+// pruneTransactions removes transactions from all indexes except account stats.
+func (s *LiteStorage) pruneTransactions(policy RetentionPolicy, now time.Time) {
+	s.indexMu.RLock()
+	defer s.indexMu.RUnlock()
+	var entries []retentionEntry[tongo.Bits256]
+	s.transactionsIndexByHash.Range(func(hash tongo.Bits256, tx *core.Transaction) bool {
+		entries = append(entries, retentionEntry[tongo.Bits256]{key: hash, lt: tx.Lt, time: tx.Utime, size: int64(len(tx.Raw))})
+		return true
+	})
+	expired, total := expiredEntries(entries, policy, now)
+	var pruned, reclaimed int64
+	for _, entry := range expired {
+		transaction, ok := s.transactionsIndexByHash.LoadAndDelete(entry.key)
+		if !ok {
+			continue
+		}
+		pruned++
+		reclaimed += entry.size
+		if txs, ok := s.transactionsByAccount.Load(transaction.Account); ok {
+			txs.remove(transaction)
+		}
+		cells, err := boc.DeserializeBoc(transaction.Raw)
+		if err != nil || len(cells) != 1 {
+			continue
+		}
+		var tx tlb.Transaction
+		if err := tlb.Unmarshal(cells[0], &tx); err != nil {
+			continue
+		}
+		if createLT, ok := extractInMsgCreatedLT(transaction.Account, &tx); ok {
+			s.transactionsByInMsgLT.Delete(createLT)
+		}
+		s.unindexMessageHashes(entry.key, &tx)
+		s.unindexBounces(entry.key, transaction)
+	}
+	prunedEntries.WithLabelValues(retentionCategoryTransactions).Add(float64(pruned))
+	reclaimedBytes.WithLabelValues(retentionCategoryTransactions).Add(float64(reclaimed))
+	indexBytes.WithLabelValues(retentionCategoryTransactions).Set(float64(total))
+	if pruned > 0 {
+		s.logger.Info("pruned transactions", zap.Int64("count", pruned), zap.Int64("reclaimed_bytes", reclaimed))
+	}
+}
+
+func (s *LiteStorage) pruneBlocks(policy RetentionPolicy, now time.Time) {
+	var entries []retentionEntry[tongo.BlockIDExt]
+	s.blockCache.Range(func(id tongo.BlockIDExt, block *tlb.Block) bool {
+		entries = append(entries, retentionEntry[tongo.BlockIDExt]{key: id, time: int64(block.Info.GenUtime)})
+		return true
+	})
+	expired, _ := expiredEntries(entries, RetentionPolicy{MaxAge: policy.MaxAge}, now)
+	for _, entry := range expired {
+		s.blockCache.Delete(entry.key)
+	}
+	prunedEntries.WithLabelValues(retentionCategoryBlocks).Add(float64(len(expired)))
+}
@@ -0,0 +1,43 @@
+package litestorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_expiredEntries(t *testing.T) {
+	now := time.Unix(10_000, 0)
+	entries := func() []retentionEntry[int] {
+		return []retentionEntry[int]{
+			{key: 3, time: 9_900, lt: 1, size: 100},
+			{key: 1, time: 5_000, lt: 1, size: 100},
+			{key: 2, time: 5_000, lt: 2, size: 300},
+			{key: 4, time: 9_900, lt: 2, size: 50},
+		}
+	}
+	tests := []struct {
+		name        string
+		policy      RetentionPolicy
+		wantExpired []int
+		wantTotal   int64
+	}{
+		{name: "no limits", wantTotal: 550},
+		{name: "max age", policy: RetentionPolicy{MaxAge: time.Hour}, wantExpired: []int{1, 2}, wantTotal: 150},
+		{name: "max bytes", policy: RetentionPolicy{MaxBytes: 200}, wantExpired: []int{1, 2}, wantTotal: 150},
+		{name: "max bytes keeps the newest", policy: RetentionPolicy{MaxBytes: 100}, wantExpired: []int{1, 2, 3}, wantTotal: 50},
+		{name: "both", policy: RetentionPolicy{MaxAge: time.Hour, MaxBytes: 500}, wantExpired: []int{1, 2}, wantTotal: 150},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expired, total := expiredEntries(entries(), tt.policy, now)
+			var keys []int
+			for _, entry := range expired {
+				keys = append(keys, entry.key)
+			}
+			require.Equal(t, tt.wantExpired, keys)
+			require.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package litestorage
+
+import (
+	"context"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/liteclient"
+	"github.com/tonkeeper/tongo/tep64"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/literetry"
+)
+
+// The methods below send lite server queries retrying transient errors according to the retry policy of the storage.
+// Raw lite server endpoints use the client directly, so their clients see errors as they are.
+
+func (s *LiteStorage) lookupBlock(ctx context.Context, id tongo.BlockID) (tongo.BlockIDExt, tlb.BlockInfo, error) {
+	var info tlb.BlockInfo
+	extID, err := literetry.Do(ctx, s.retryPolicy, "lookup_block", func() (tongo.BlockIDExt, error) {
+		extID, blockInfo, err := s.client.LookupBlock(ctx, id, 1, nil, nil)
+		info = blockInfo
+		return extID, err
+	})
+	return extID, info, err
+}
+
+func (s *LiteStorage) getBlock(ctx context.Context, id tongo.BlockIDExt) (tlb.Block, error) {
+	return literetry.Do(ctx, s.retryPolicy, "get_block", func() (tlb.Block, error) {
+		return s.client.GetBlock(ctx, id)
+	})
+}
+
+func (s *LiteStorage) getMasterchainInfo(ctx context.Context) (liteclient.LiteServerMasterchainInfoC, error) {
+	return literetry.Do(ctx, s.retryPolicy, "get_masterchain_info", func() (liteclient.LiteServerMasterchainInfoC, error) {
+		return s.client.GetMasterchainInfo(ctx)
+	})
+}
+
+func (s *LiteStorage) getLastTransactions(ctx context.Context, a tongo.AccountID, limit int) ([]tongo.Transaction, error) {
+	return literetry.Do(ctx, s.retryPolicy, "get_last_transactions", func() ([]tongo.Transaction, error) {
+		return s.client.GetLastTransactions(ctx, a, limit)
+	})
+}
+
+func (s *LiteStorage) getTransactions(ctx context.Context, count uint32, a tongo.AccountID, lt uint64, hash tongo.Bits256) ([]tongo.Transaction, error) {
+	return literetry.Do(ctx, s.retryPolicy, "get_transactions", func() ([]tongo.Transaction, error) {
+		return s.client.GetTransactions(ctx, count, a, lt, hash)
+	})
+}
+
+func (s *LiteStorage) getConfigAll(ctx context.Context) (tlb.ConfigParams, error) {
+	return literetry.Do(ctx, s.retryPolicy, "get_config_all", func() (tlb.ConfigParams, error) {
+		return s.client.GetConfigAll(ctx, 0)
+	})
+}
+
+func (s *LiteStorage) runSmcMethodByID(ctx context.Context, id tongo.AccountID, method int, stack tlb.VmStack) (uint32, tlb.VmStack, error) {
+	var exitCode uint32
+	result, err := literetry.Do(ctx, s.retryPolicy, "run_smc_method", func() (tlb.VmStack, error) {
+		code, result, err := s.client.RunSmcMethodByID(ctx, id, method, stack)
+		exitCode = code
+		return result, err
+	})
+	return exitCode, result, err
+}
+
+func (s *LiteStorage) getJettonData(ctx context.Context, master tongo.AccountID) (tep64.Metadata, error) {
+	return literetry.Do(ctx, s.retryPolicy, "get_jetton_data", func() (tep64.Metadata, error) {
+		return s.client.GetJettonData(ctx, master)
+	})
+}
@@ -0,0 +1,248 @@
+package litestorage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// snapshotVersion is incremented on every incompatible change of the snapshot format.
+// Version 2 keeps full block IDs of transactions.
+const snapshotVersion = 2
+
+// SnapshotCipher encrypts snapshots at rest, it is implemented by repository.Encrypted.
+type SnapshotCipher interface {
+	Seal(name string, blob []byte) ([]byte, error)
+	Open(name string, blob []byte) ([]byte, error)
+}
+
+// snapshotBlobName binds an encrypted snapshot to its kind.
+const snapshotBlobName = "litestorage/snapshot"
+
+// Snapshot is a consistent copy of the local index and the list of tracked accounts.
+// It is used to bootstrap a new replica without walking the history of every account.
+type Snapshot struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	// Accounts are tracked accounts in the raw form.
+	Accounts     []string              `json:"accounts"`
+	Transactions []SnapshotTransaction `json:"transactions"`
+}
+
+// SnapshotTransaction is a raw transaction along with the block it belongs to.
+type SnapshotTransaction struct {
+	// Block is a full ID of the block: (workchain,shard,seqno,root_hash,file_hash).
+	Block string `json:"block"`
+	Boc   []byte `json:"boc"`
+}
+
+// RestoreResult describes what has been restored from a snapshot.
+type RestoreResult struct {
+	Accounts     int `json:"accounts"`
+	Transactions int `json:"transactions"`
+}
+
+// Snapshot blocks modifications of the index for a moment and returns its copy.
+func (s *LiteStorage) Snapshot() Snapshot {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+	accounts := s.trackedAccounts()
+	snapshot := Snapshot{
+		Version:   snapshotVersion,
+		CreatedAt: time.Now(),
+		Accounts:  make([]string, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		snapshot.Accounts = append(snapshot.Accounts, account.ToRaw())
+	}
+	s.transactionsIndexByHash.Range(func(_ tongo.Bits256, tx *core.Transaction) bool {
+		snapshot.Transactions = append(snapshot.Transactions, SnapshotTransaction{
+			Block: tx.BlockIDExt.String(),
+			Boc:   tx.Raw,
+		})
+		return true
+	})
+	return snapshot
+}
+
+// WriteSnapshot writes a gzip-compressed snapshot of the index to w,
+// the snapshot is encrypted if the storage has a SnapshotCipher.
+func (s *LiteStorage) WriteSnapshot(w io.Writer) error {
+	snapshot := s.Snapshot()
+	if s.snapshotCipher == nil {
+		return writeSnapshot(w, snapshot)
+	}
+	var buf bytes.Buffer
+	if err := writeSnapshot(&buf, snapshot); err != nil {
+		return err
+	}
+	sealed, err := s.snapshotCipher.Seal(snapshotBlobName, buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(sealed)
+	return err
+}
+
+func writeSnapshot(w io.Writer, snapshot Snapshot) error {
+	gz := gzip.NewWriter(w)
+	if err := json.NewEncoder(gz).Encode(snapshot); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// OpenSnapshot reads a snapshot written by WriteSnapshot of a storage with the same SnapshotCipher.
+func (s *LiteStorage) OpenSnapshot(r io.Reader) (Snapshot, error) {
+	if s.snapshotCipher == nil {
+		return ReadSnapshot(r)
+	}
+	sealed, err := io.ReadAll(r)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	content, err := s.snapshotCipher.Open(snapshotBlobName, sealed)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return ReadSnapshot(bytes.NewReader(content))
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot without encryption.
+func ReadSnapshot(r io.Reader) (Snapshot, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer gz.Close()
+	var snapshot Snapshot
+	if err := json.NewDecoder(gz).Decode(&snapshot); err != nil {
+		return Snapshot{}, err
+	}
+	if snapshot.Version != snapshotVersion {
+		return Snapshot{}, fmt.Errorf("unsupported snapshot version %v", snapshot.Version)
+	}
+	return snapshot, nil
+}
+
+// RestoreSnapshot populates the index with the snapshot and starts tracking its accounts.
+// Then it catches up with the chain by loading transactions that happened after the snapshot was taken,
+// the progress is available with BackfillStatuses.
+func (s *LiteStorage) RestoreSnapshot(snapshot Snapshot) (RestoreResult, error) {
+	accounts := make([]tongo.AccountID, 0, len(snapshot.Accounts))
+	for _, raw := range snapshot.Accounts {
+		account, err := tongo.ParseAddress(raw)
+		if err != nil {
+			return RestoreResult{}, err
+		}
+		accounts = append(accounts, account.ID)
+	}
+	lastLts := make(map[tongo.AccountID]uint64, len(accounts))
+	restored, err := s.restoreTransactions(accounts, snapshot.Transactions, lastLts)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	for _, account := range accounts {
+		s.StartBackfill(account, BackfillOptions{StopAtLt: lastLts[account]})
+	}
+	s.logger.Info("snapshot restored",
+		zap.Time("created_at", snapshot.CreatedAt),
+		zap.Int("accounts", len(accounts)),
+		zap.Int("transactions", restored))
+	return RestoreResult{Accounts: len(accounts), Transactions: restored}, nil
+}
+
+// restoreTransactions stores transactions of the snapshot and collects the latest lt of every account.
+func (s *LiteStorage) restoreTransactions(accounts []tongo.AccountID, txs []SnapshotTransaction, lastLts map[tongo.AccountID]uint64) (int, error) {
+	s.indexMu.RLock()
+	defer s.indexMu.RUnlock()
+	for _, account := range accounts {
+		s.trackAccount(account)
+	}
+	for _, item := range txs {
+		blockID, err := parseBlockIDExt(item.Block)
+		if err != nil {
+			return 0, err
+		}
+		cells, err := boc.DeserializeBoc(item.Boc)
+		if err != nil {
+			return 0, err
+		}
+		if len(cells) != 1 {
+			return 0, fmt.Errorf("invalid transaction boc roots number %v", len(cells))
+		}
+		var tx tlb.Transaction
+		if err := tlb.Unmarshal(cells[0], &tx); err != nil {
+			return 0, err
+		}
+		accountID := tongo.AccountID{Workchain: blockID.Workchain, Address: tx.AccountAddr}
+		transaction, err := core.ConvertTransaction(blockID.Workchain, tongo.Transaction{
+			Transaction: tx,
+			BlockID:     blockID,
+		})
+		if err != nil {
+			return 0, err
+		}
+		s.storeTransaction(accountID, tongo.Bits256(tx.Hash()), transaction, &tx)
+		if tx.Lt > lastLts[accountID] {
+			lastLts[accountID] = tx.Lt
+		}
+	}
+	return len(txs), nil
+}
+
+// parseBlockIDExt parses a full block ID formatted by tongo.BlockIDExt.String.
+func parseBlockIDExt(s string) (tongo.BlockIDExt, error) {
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(s, "("), ")"), ",")
+	if len(parts) != 5 {
+		return tongo.BlockIDExt{}, fmt.Errorf("invalid block id %q", s)
+	}
+	blockID, err := tongo.ParseBlockID("(" + strings.Join(parts[:3], ",") + ")")
+	if err != nil {
+		return tongo.BlockIDExt{}, fmt.Errorf("invalid block id %q: %w", s, err)
+	}
+	id := tongo.BlockIDExt{BlockID: blockID}
+	for i, hash := range []*tongo.Bits256{&id.RootHash, &id.FileHash} {
+		b, err := hex.DecodeString(parts[3+i])
+		if err != nil || len(b) != len(hash) {
+			return tongo.BlockIDExt{}, fmt.Errorf("invalid block id %q", s)
+		}
+		copy(hash[:], b)
+	}
+	return id, nil
+}
+
+// SnapshotHandler returns an http.Handler exporting a snapshot written by WriteSnapshot on GET.
+// A snapshot is restored only at startup from a file given by the operator,
+// so the index serving API responses can't be populated with transactions sent over the network.
+func (s *LiteStorage) SnapshotHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if s.snapshotCipher != nil {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", `attachment; filename="opentonapi-snapshot.json.gz.enc"`)
+		} else {
+			w.Header().Set("Content-Type", "application/gzip")
+			w.Header().Set("Content-Disposition", `attachment; filename="opentonapi-snapshot.json.gz"`)
+		}
+		if err := s.WriteSnapshot(w); err != nil {
+			s.logger.Error("failed to write snapshot", zap.Error(err))
+		}
+	})
+}
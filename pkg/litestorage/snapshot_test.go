@@ -0,0 +1,117 @@
+package litestorage
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"os"
+	"testing"
+
+	"github.com/puzpuzpuz/xsync/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/cache"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+func newSnapshotTestStorage() *LiteStorage {
+	return &LiteStorage{
+		logger:                    zap.L(),
+		trackingAccounts:          map[tongo.AccountID]struct{}{},
+		transactionsIndexByHash:   xsync.NewTypedMapOf[tongo.Bits256, *core.Transaction](hashBits256),
+		transactionsByInMsgLT:     xsync.NewTypedMapOf[inMsgCreatedLT, tongo.Bits256](hashInMsgCreatedLT),
+		transactionsByMessageHash: xsync.NewTypedMapOf[tongo.Bits256, core.MessageHashMatch](hashBits256),
+		bounceOrigins:             xsync.NewTypedMapOf[tongo.Bits256, tongo.Bits256](hashBits256),
+		transactionsByAccount:     xsync.NewTypedMapOf[tongo.AccountID, *accountTransactions](hashAccountID),
+		accountActivity:           xsync.NewTypedMapOf[tongo.AccountID, *core.AccountActivity](hashAccountID),
+		codeHistories:             xsync.NewTypedMapOf[tongo.AccountID, *core.CodeHistory](hashAccountID),
+		blockCache:                xsync.NewTypedMapOf[tongo.BlockIDExt, *tlb.Block](hashBlockIDExt),
+		jettonWalletMasters:       xsync.NewTypedMapOf[tongo.AccountID, tongo.AccountID](hashAccountID),
+		accountInterfacesCache:    xsync.NewTypedMapOf[tongo.AccountID, []abi.ContractInterface](hashAccountID),
+		pubKeyByAccountID:         xsync.NewTypedMapOf[tongo.AccountID, ed25519.PublicKey](hashAccountID),
+		configCache:               cache.NewLRUCache[int, ton.BlockchainConfig](4, "config"),
+	}
+}
+
+func TestLiteStorage_Snapshot(t *testing.T) {
+	txBoc, err := os.ReadFile("testdata/transaction.boc")
+	require.Nil(t, err)
+	txHash := tongo.MustParseHash("c5ca880c8e667af78d193ac5d2f1437c2bcec08d16436f6579f3493e556b4f48")
+	account := tongo.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+
+	source := newSnapshotTestStorage()
+	lastLts := map[tongo.AccountID]uint64{}
+	restored, err := source.restoreTransactions([]tongo.AccountID{account}, []SnapshotTransaction{
+		{Block: "(0,8000000000000000,30816553,f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f80,0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0)", Boc: txBoc},
+	}, lastLts)
+	require.Nil(t, err)
+	require.Equal(t, 1, restored)
+
+	var buf bytes.Buffer
+	require.Nil(t, source.WriteSnapshot(&buf))
+	snapshot, err := ReadSnapshot(&buf)
+	require.Nil(t, err)
+	require.Equal(t, []string{account.ToRaw()}, snapshot.Accounts)
+	require.Len(t, snapshot.Transactions, 1)
+
+	replica := newSnapshotTestStorage()
+	lastLts = map[tongo.AccountID]uint64{}
+	_, err = replica.restoreTransactions([]tongo.AccountID{account}, snapshot.Transactions, lastLts)
+	require.Nil(t, err)
+	require.True(t, replica.isTracking(account))
+
+	original, ok := source.transactionsIndexByHash.Load(txHash)
+	require.True(t, ok)
+	copied, ok := replica.transactionsIndexByHash.Load(txHash)
+	require.True(t, ok)
+	require.Equal(t, original, copied)
+	require.Equal(t, "(0,8000000000000000,30816553,f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f80,0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0)", copied.BlockIDExt.String())
+	require.Equal(t, original.Lt, lastLts[original.Account])
+	_, ok = replica.accountActivity.Load(original.Account)
+	require.True(t, ok)
+
+	_, err = ReadSnapshot(bytes.NewReader([]byte("not a snapshot")))
+	require.NotNil(t, err)
+	// a short block id of version 1 is not accepted.
+	_, err = replica.restoreTransactions(nil, []SnapshotTransaction{{Block: "(0,8000000000000000,30816553)", Boc: txBoc}}, lastLts)
+	require.NotNil(t, err)
+}
+
+func TestLiteStorage_OpenSnapshot_encrypted(t *testing.T) {
+	txBoc, err := os.ReadFile("testdata/transaction.boc")
+	require.Nil(t, err)
+	account := tongo.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+	cipher, err := repository.NewEncrypted(repository.NewMemory(), bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+
+	source := newSnapshotTestStorage()
+	source.snapshotCipher = cipher
+	_, err = source.restoreTransactions([]tongo.AccountID{account}, []SnapshotTransaction{
+		{Block: "(0,8000000000000000,30816553,f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f80,0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0)", Boc: txBoc},
+	}, map[tongo.AccountID]uint64{})
+	require.Nil(t, err)
+
+	var buf bytes.Buffer
+	require.Nil(t, source.WriteSnapshot(&buf))
+	sealed := buf.Bytes()
+	_, err = ReadSnapshot(bytes.NewReader(sealed))
+	require.NotNil(t, err)
+
+	snapshot, err := source.OpenSnapshot(bytes.NewReader(sealed))
+	require.Nil(t, err)
+	require.Equal(t, []string{account.ToRaw()}, snapshot.Accounts)
+	require.Len(t, snapshot.Transactions, 1)
+
+	// a replica without the key can't read the snapshot.
+	other, err := repository.NewEncrypted(repository.NewMemory(), bytes.Repeat([]byte{2}, 32))
+	require.Nil(t, err)
+	replica := newSnapshotTestStorage()
+	replica.snapshotCipher = other
+	_, err = replica.OpenSnapshot(bytes.NewReader(sealed))
+	require.ErrorIs(t, err, repository.ErrDecryption)
+}
@@ -19,7 +19,7 @@ import (
 
 func (s *LiteStorage) GetWhalesPoolMemberInfo(ctx context.Context, pool, member tongo.AccountID) (core.Nominator, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_whales_pool_member_info").Observe(v)
+		observeStorageTime(ctx, "get_whales_pool_member_info", v)
 	}))
 	defer timer.ObserveDuration()
 	_, value, err := abi.GetMember(ctx, s.executor, pool, member.ToMsgAddress())
@@ -45,7 +45,7 @@ func (s *LiteStorage) GetWhalesPoolMemberInfo(ctx context.Context, pool, member
 
 func (s *LiteStorage) GetParticipatingInWhalesPools(ctx context.Context, member tongo.AccountID) ([]core.Nominator, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_participating_in_whales_pool").Observe(v)
+		observeStorageTime(ctx, "get_participating_in_whales_pool", v)
 	}))
 	defer timer.ObserveDuration()
 	var result []core.Nominator
@@ -61,7 +61,7 @@ func (s *LiteStorage) GetParticipatingInWhalesPools(ctx context.Context, member
 
 func (s *LiteStorage) GetParticipatingInTfPools(ctx context.Context, member tongo.AccountID) ([]core.Nominator, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_participating_in_tf_pools").Observe(v)
+		observeStorageTime(ctx, "get_participating_in_tf_pools", v)
 	}))
 	defer timer.ObserveDuration()
 	var result []core.Nominator
@@ -91,7 +91,7 @@ func (s *LiteStorage) GetParticipatingInTfPools(ctx context.Context, member tong
 
 func (s *LiteStorage) GetWhalesPoolInfo(ctx context.Context, id tongo.AccountID) (abi.GetParams_WhalesNominatorResult, abi.GetStakingStatusResult, int, uint64, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_whales_pool_info").Observe(v)
+		observeStorageTime(ctx, "get_whales_pool_info", v)
 	}))
 	defer timer.ObserveDuration()
 	var params abi.GetParams_WhalesNominatorResult
@@ -127,7 +127,7 @@ func (s *LiteStorage) GetWhalesPoolInfo(ctx context.Context, id tongo.AccountID)
 
 func (s *LiteStorage) GetTFPool(ctx context.Context, pool tongo.AccountID) (core.TFPool, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_tf_pool").Observe(v)
+		observeStorageTime(ctx, "get_tf_pool", v)
 	}))
 	defer timer.ObserveDuration()
 	t, v, err := abi.GetPoolData(ctx, s.executor, pool)
@@ -138,7 +138,7 @@ func (s *LiteStorage) GetTFPool(ctx context.Context, pool tongo.AccountID) (core
 	if !ok {
 		return core.TFPool{}, fmt.Errorf("invalid type %v", t)
 	}
-	state, err := s.client.GetAccountState(ctx, pool)
+	state, err := s.accountState(ctx, pool)
 	if err != nil {
 		return core.TFPool{}, err
 	}
@@ -188,7 +188,7 @@ func (s *LiteStorage) GetLiquidPool(ctx context.Context, pool tongo.AccountID) (
 	if !ok {
 		return core.LiquidPool{}, fmt.Errorf("invalid type")
 	}
-	state, err := s.client.GetAccountState(ctx, pool)
+	state, err := s.accountState(ctx, pool)
 	if err != nil {
 		return core.LiquidPool{}, err
 	}
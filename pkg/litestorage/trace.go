@@ -2,6 +2,7 @@ package litestorage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -11,6 +12,7 @@ import (
 	"github.com/tonkeeper/tongo/boc"
 
 	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/querybudget"
 )
 
 const (
@@ -25,7 +27,7 @@ var (
 
 func (s *LiteStorage) GetTrace(ctx context.Context, hash tongo.Bits256) (*core.Trace, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		storageTimeHistogramVec.WithLabelValues("get_trace").Observe(v)
+		observeStorageTime(ctx, "get_trace", v)
 	}))
 	defer timer.ObserveDuration()
 	tx, err := s.GetTransaction(ctx, hash)
@@ -53,6 +55,12 @@ func (s *LiteStorage) recursiveGetChildren(ctx context.Context, tx core.Transact
 			continue
 		}
 		tx, err := s.searchTransactionNearBlock(ctx, *m.Destination, m.CreatedLt, tx.BlockID, false, depth+1)
+		if errors.Is(err, querybudget.ErrExhausted) {
+			// the rest of the trace is beyond the budget of the request,
+			// so the message is left as if it hasn't been delivered yet.
+			externalMessages = append(externalMessages, m)
+			continue
+		}
 		if err != nil {
 			return core.Trace{}, err
 		}
@@ -64,7 +72,7 @@ func (s *LiteStorage) recursiveGetChildren(ctx context.Context, tx core.Transact
 	}
 	var err error
 	trace.AccountInterfaces, err = s.getAccountInterfaces(ctx, tx.Account)
-	if err != nil {
+	if err != nil && !errors.Is(err, querybudget.ErrExhausted) {
 		return core.Trace{}, nil
 	}
 	trace.OutMsgs = externalMessages
@@ -78,13 +86,15 @@ func (s *LiteStorage) findRoot(ctx context.Context, tx *core.Transaction, depth
 	if tx.InMsg == nil || tx.InMsg.IsExternal() || tx.InMsg.IsEmission() {
 		return tx, nil
 	}
-	var err error
-	tx, err = s.searchTransactionNearBlock(ctx, *tx.InMsg.Source, tx.InMsg.CreatedLt, tx.BlockID, true, depth)
+	parent, err := s.searchTransactionNearBlock(ctx, *tx.InMsg.Source, tx.InMsg.CreatedLt, tx.BlockID, true, depth)
+	if errors.Is(err, querybudget.ErrExhausted) {
+		// the trace is assembled from the highest transaction found within the budget of the request.
+		return tx, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-
-	return s.findRoot(ctx, tx, depth+1)
+	return s.findRoot(ctx, parent, depth+1)
 }
 
 func (s *LiteStorage) searchTransactionNearBlock(ctx context.Context, a tongo.AccountID, lt uint64, blockID tongo.BlockID, back bool, depth int) (*core.Transaction, error) {
@@ -112,13 +122,19 @@ func (s *LiteStorage) searchTransactionNearBlock(ctx context.Context, a tongo.Ac
 }
 
 func (s *LiteStorage) searchTransactionInBlock(ctx context.Context, a tongo.AccountID, lt uint64, blockID tongo.BlockID, back bool) (*core.Transaction, error) {
-	blockIDExt, _, err := s.client.LookupBlock(ctx, blockID, 1, nil, nil)
+	if err := querybudget.Spend(ctx); err != nil {
+		return nil, err
+	}
+	blockIDExt, _, err := s.lookupBlock(ctx, blockID)
 	if err != nil {
 		return nil, err
 	}
 	block, prs := s.blockCache.Load(blockIDExt)
 	if !prs {
-		b, err := s.client.GetBlock(ctx, blockIDExt)
+		if err := querybudget.Spend(ctx); err != nil {
+			return nil, err
+		}
+		b, err := s.getBlock(ctx, blockIDExt)
 		if err != nil {
 			return nil, err
 		}
@@ -149,6 +165,9 @@ func (s *LiteStorage) getAccountInterfaces(ctx context.Context, id tongo.Account
 	if ok {
 		return interfaces, nil
 	}
+	if err := querybudget.Spend(ctx); err != nil {
+		return nil, err
+	}
 	account, err := s.GetRawAccount(ctx, id)
 	if err != nil {
 		return nil, err
@@ -9,6 +9,9 @@ import (
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/abi"
 	"github.com/tonkeeper/tongo/liteapi"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/querybudget"
 )
 
 func TestLiteStorage_getAccountInterfaces(t *testing.T) {
@@ -44,3 +47,39 @@ func TestLiteStorage_getAccountInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func TestLiteStorage_recursiveGetChildren_queryBudget(t *testing.T) {
+	wallet := tongo.MustParseAccountID("0:16b94207124b1613aadd084b65fc2c67bc33e62fbbdd83beef4b194ca2ff5fe7")
+	cached := tongo.MustParseAccountID("0:7e809e6484f6af180b18f6760bff96d87c8f27f1fe84e0acb0b48fb86714ed8f")
+	unknown := tongo.MustParseAccountID("0:5555555555555555555555555555555555555555555555555555555555555555")
+	storage := LiteStorage{
+		transactionsIndexByHash: xsync.NewTypedMapOf[tongo.Bits256, *core.Transaction](hashBits256),
+		transactionsByInMsgLT:   xsync.NewTypedMapOf[inMsgCreatedLT, tongo.Bits256](hashInMsgCreatedLT),
+		accountInterfacesCache:  xsync.NewTypedMapOf[tongo.AccountID, []abi.ContractInterface](hashAccountID),
+	}
+	child := &core.Transaction{TransactionID: core.TransactionID{Hash: tongo.Bits256{2}, Lt: 11, Account: cached}}
+	storage.transactionsIndexByHash.Store(child.Hash, child)
+	storage.transactionsByInMsgLT.Store(inMsgCreatedLT{account: cached, lt: 10}, child.Hash)
+	storage.accountInterfacesCache.Store(wallet, nil)
+	storage.accountInterfacesCache.Store(cached, nil)
+
+	root := core.Transaction{
+		TransactionID: core.TransactionID{Hash: tongo.Bits256{1}, Lt: 9, Account: wallet},
+		OutMsgs: []core.Message{
+			{MessageID: core.MessageID{Destination: &cached, CreatedLt: 10}},
+			{MessageID: core.MessageID{Destination: &unknown, CreatedLt: 10}},
+		},
+	}
+	ctx, budget := querybudget.NewContext(context.Background(), 1)
+	require.Nil(t, querybudget.Spend(ctx))
+
+	// transactions found in the cache don't need lite servers,
+	// the rest of the trace is left undelivered once the budget is exhausted.
+	trace, err := storage.recursiveGetChildren(ctx, root, 0)
+	require.Nil(t, err)
+	require.True(t, budget.Exceeded())
+	require.Len(t, trace.Children, 1)
+	require.Equal(t, child.Hash, trace.Children[0].Hash)
+	require.Len(t, trace.OutMsgs, 1)
+	require.Equal(t, unknown, *trace.OutMsgs[0].Destination)
+}
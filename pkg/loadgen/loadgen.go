@@ -0,0 +1,292 @@
+// Package loadgen replays a recorded mix of requests against a running instance and measures its throughput,
+// so performance of two releases can be compared under the same load.
+package loadgen
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Request is a request of a recorded mix, the mix is a file with a JSON-encoded request per line:
+//
+//	{"method":"GET","path":"/v2/accounts/0:97264395bd65a255a429b11326c84128b7d70ffed7949abae3036d506ba38621/events?limit=20","weight":10}
+//	{"method":"POST","path":"/v2/blockchain/message","body":{"boc":"te6c..."},"name":"send"}
+type Request struct {
+	// Name groups requests in a report, by default it is the method and the path without the query.
+	Name   string          `json:"name,omitempty"`
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// Weight is a relative frequency of the request in the mix, 1 by default.
+	Weight int `json:"weight,omitempty"`
+}
+
+// ReadMix reads a recorded mix of requests, empty lines and lines starting with "#" are skipped.
+func ReadMix(r io.Reader) ([]Request, error) {
+	var mix []Request
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		var req Request
+		if err := json.Unmarshal([]byte(text), &req); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if req.Method == "" {
+			req.Method = http.MethodGet
+		}
+		if !strings.HasPrefix(req.Path, "/") {
+			return nil, fmt.Errorf("line %d: path must start with /", line)
+		}
+		if req.Weight < 0 {
+			return nil, fmt.Errorf("line %d: negative weight", line)
+		}
+		if req.Weight == 0 {
+			req.Weight = 1
+		}
+		if req.Name == "" {
+			path, _, _ := strings.Cut(req.Path, "?")
+			req.Name = req.Method + " " + path
+		}
+		mix = append(mix, req)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(mix) == 0 {
+		return nil, fmt.Errorf("mix is empty")
+	}
+	return mix, nil
+}
+
+// Options configures a load test.
+type Options struct {
+	// Target is a base URL of the instance, e.g. "http://localhost:8081".
+	Target string
+	// Token, if set, is sent as a bearer token.
+	Token string
+	// Concurrency is a number of workers sending requests in parallel.
+	Concurrency int
+	// Duration is how long the load is applied.
+	Duration time.Duration
+	// Rate limits the total number of requests per second, 0 means workers send requests as fast as they can.
+	Rate float64
+	// Seed makes the order of requests reproducible.
+	Seed int64
+}
+
+// Stats describes responses to requests of the same name.
+type Stats struct {
+	Name     string        `json:"name"`
+	Requests int           `json:"requests"`
+	Errors   int           `json:"errors"`
+	Statuses map[int]int   `json:"statuses"`
+	P50      time.Duration `json:"p50_ns"`
+	P90      time.Duration `json:"p90_ns"`
+	P99      time.Duration `json:"p99_ns"`
+	Max      time.Duration `json:"max_ns"`
+	latency  []time.Duration
+}
+
+// Report summarizes a load test, a request failing with a transport error or a 5xx status counts as an error.
+type Report struct {
+	Duration   time.Duration `json:"duration_ns"`
+	Requests   int           `json:"requests"`
+	Errors     int           `json:"errors"`
+	Throughput float64       `json:"throughput"`
+	Total      Stats         `json:"total"`
+	ByName     []Stats       `json:"by_name"`
+}
+
+type result struct {
+	name    string
+	status  int
+	failed  bool
+	latency time.Duration
+}
+
+// Run sends requests picked from the mix according to their weights until the duration elapses or ctx is done.
+func Run(ctx context.Context, client *http.Client, mix []Request, options Options) (Report, error) {
+	if len(mix) == 0 {
+		return Report{}, fmt.Errorf("mix is empty")
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = 1
+	}
+	ctx, cancel := context.WithTimeout(ctx, options.Duration)
+	defer cancel()
+
+	var ticks <-chan time.Time
+	if options.Rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / options.Rate))
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+	picker := newPicker(mix, options.Seed)
+	jobs := make(chan Request)
+	results := make(chan result, options.Concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < options.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for req := range jobs {
+				results <- send(ctx, client, options, req)
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for {
+			if ticks != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticks:
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- picker.next():
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	started := time.Now()
+	byName := map[string]*Stats{}
+	total := Stats{Name: "total", Statuses: map[int]int{}}
+	for res := range results {
+		// requests interrupted by the end of the test aren't taken into account.
+		if res.failed && ctx.Err() != nil {
+			continue
+		}
+		stats, ok := byName[res.name]
+		if !ok {
+			stats = &Stats{Name: res.name, Statuses: map[int]int{}}
+			byName[res.name] = stats
+		}
+		stats.add(res)
+		total.add(res)
+	}
+	report := Report{
+		Duration: time.Since(started),
+		Requests: total.Requests,
+		Errors:   total.Errors,
+	}
+	report.Throughput = float64(report.Requests) / report.Duration.Seconds()
+	total.summarize()
+	report.Total = total
+	for _, stats := range byName {
+		stats.summarize()
+		report.ByName = append(report.ByName, *stats)
+	}
+	sort.Slice(report.ByName, func(i, j int) bool {
+		return report.ByName[i].Name < report.ByName[j].Name
+	})
+	return report, nil
+}
+
+func send(ctx context.Context, client *http.Client, options Options, req Request) result {
+	res := result{name: req.Name}
+	var body io.Reader
+	if len(req.Body) > 0 {
+		body = bytes.NewReader(req.Body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, strings.TrimSuffix(options.Target, "/")+req.Path, body)
+	if err != nil {
+		res.failed = true
+		return res
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if options.Token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+options.Token)
+	}
+	started := time.Now()
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		res.failed = true
+		res.latency = time.Since(started)
+		return res
+	}
+	// the whole body is read, so the latency includes the serialization of a response.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	res.latency = time.Since(started)
+	res.status = resp.StatusCode
+	res.failed = resp.StatusCode >= http.StatusInternalServerError
+	return res
+}
+
+func (s *Stats) add(res result) {
+	s.Requests++
+	if res.failed {
+		s.Errors++
+	}
+	if res.status != 0 {
+		s.Statuses[res.status]++
+	}
+	s.latency = append(s.latency, res.latency)
+}
+
+func (s *Stats) summarize() {
+	if len(s.latency) == 0 {
+		return
+	}
+	sort.Slice(s.latency, func(i, j int) bool { return s.latency[i] < s.latency[j] })
+	percentile := func(p float64) time.Duration {
+		return s.latency[int(p*float64(len(s.latency)-1))]
+	}
+	s.P50 = percentile(0.5)
+	s.P90 = percentile(0.9)
+	s.P99 = percentile(0.99)
+	s.Max = s.latency[len(s.latency)-1]
+}
+
+// picker picks requests of a mix at random according to their weights.
+type picker struct {
+	mix []Request
+	// cumulative contains a running total of weights of the mix.
+	cumulative []int
+	mu         sync.Mutex
+	rnd        *rand.Rand
+}
+
+func newPicker(mix []Request, seed int64) *picker {
+	p := &picker{mix: mix, rnd: rand.New(rand.NewSource(seed))}
+	total := 0
+	for _, req := range mix {
+		total += req.Weight
+		p.cumulative = append(p.cumulative, total)
+	}
+	return p
+}
+
+func (p *picker) next() Request {
+	p.mu.Lock()
+	n := p.rnd.Intn(p.cumulative[len(p.cumulative)-1])
+	p.mu.Unlock()
+	i := sort.SearchInts(p.cumulative, n+1)
+	return p.mix[i]
+}
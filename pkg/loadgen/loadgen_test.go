@@ -0,0 +1,115 @@
+package loadgen
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadMix(t *testing.T) {
+	tests := []struct {
+		name    string
+		mix     string
+		want    []Request
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			mix: `# recorded on mainnet
+{"path":"/v2/status"}
+
+{"method":"POST","path":"/v2/blockchain/message","body":{"boc":"te6c"},"name":"send","weight":3}`,
+			want: []Request{
+				{Name: "GET /v2/status", Method: "GET", Path: "/v2/status", Weight: 1},
+				{Name: "send", Method: "POST", Path: "/v2/blockchain/message", Body: []byte(`{"boc":"te6c"}`), Weight: 3},
+			},
+		},
+		{
+			name: "name without query",
+			mix:  `{"path":"/v2/accounts/x/events?limit=20"}`,
+			want: []Request{{Name: "GET /v2/accounts/x/events", Method: "GET", Path: "/v2/accounts/x/events?limit=20", Weight: 1}},
+		},
+		{name: "relative path", mix: `{"path":"v2/status"}`, wantErr: "line 1: path must start with /"},
+		{name: "negative weight", mix: "\n" + `{"path":"/v2/status","weight":-1}`, wantErr: "line 2: negative weight"},
+		{name: "empty", mix: "# nothing\n", wantErr: "mix is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mix, err := ReadMix(strings.NewReader(tt.mix))
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.want, mix)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	var unauthorized atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			unauthorized.Add(1)
+		}
+		switch r.URL.Path {
+		case "/v2/status":
+			w.WriteHeader(http.StatusOK)
+		case "/v2/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	mix := []Request{
+		{Name: "status", Method: http.MethodGet, Path: "/v2/status", Weight: 5},
+		{Name: "missing", Method: http.MethodGet, Path: "/v2/missing", Weight: 1},
+		{Name: "broken", Method: http.MethodGet, Path: "/v2/broken", Weight: 1},
+	}
+	report, err := Run(context.Background(), server.Client(), mix, Options{
+		Target:      server.URL,
+		Token:       "secret",
+		Concurrency: 4,
+		Duration:    300 * time.Millisecond,
+	})
+	require.Nil(t, err)
+	require.Zero(t, unauthorized.Load())
+	require.Greater(t, report.Requests, 0)
+	require.Greater(t, report.Throughput, 0.0)
+	require.Len(t, report.ByName, 3)
+
+	byName := map[string]Stats{}
+	for _, stats := range report.ByName {
+		byName[stats.Name] = stats
+	}
+	require.Equal(t, byName["status"].Requests, byName["status"].Statuses[http.StatusOK])
+	require.Zero(t, byName["status"].Errors)
+	require.Zero(t, byName["missing"].Errors)
+	require.Equal(t, byName["broken"].Requests, byName["broken"].Errors)
+	require.Equal(t, report.Errors, byName["broken"].Errors)
+	require.Greater(t, byName["status"].Requests, byName["missing"].Requests)
+	require.LessOrEqual(t, report.Total.P50, report.Total.P99)
+}
+
+func TestRun_rate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	report, err := Run(context.Background(), server.Client(), []Request{{Name: "status", Method: http.MethodGet, Path: "/", Weight: 1}}, Options{
+		Target:      server.URL,
+		Concurrency: 4,
+		Duration:    500 * time.Millisecond,
+		Rate:        20,
+	})
+	require.Nil(t, err)
+	require.LessOrEqual(t, report.Requests, 11)
+	require.Greater(t, report.Requests, 5)
+}
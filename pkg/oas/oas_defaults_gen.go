@@ -17,3 +17,11 @@ func (s *ServiceStatus) setDefaults() {
 		s.RestOnline = val
 	}
 }
+
+// setDefaults set default value of fields.
+func (s *WalletTransferItem) setDefaults() {
+	{
+		val := bool(false)
+		s.Bounce.SetTo(val)
+	}
+}
@@ -137,20 +137,22 @@ func (s *Server) handleAccountDnsBackResolveRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleAddressParseRequest handles addressParse operation.
+// handleAddExpectedDepositRequest handles addExpectedDeposit operation.
 //
-// Parse address and display in all formats.
+// Register an expected deposit. Incoming transfers to the account are classified as matched or
+// unmatched and streamed via /v2/sse/deposits. The account must be tracked by the instance. Requires
+// a tenant token or a token with the admin scope.
 //
-// GET /v2/address/{account_id}/parse
-func (s *Server) handleAddressParseRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/deposits
+func (s *Server) handleAddExpectedDepositRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("addressParse"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/address/{account_id}/parse"),
+		otelogen.OperationID("addExpectedDeposit"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/deposits"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddressParse",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddExpectedDeposit",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -175,42 +177,42 @@ func (s *Server) handleAddressParseRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "AddressParse",
-			ID:   "addressParse",
+			Name: "AddExpectedDeposit",
+			ID:   "addExpectedDeposit",
 		}
 	)
-	params, err := decodeAddressParseParams(args, argsEscaped, r)
+	request, close, err := s.decodeAddExpectedDepositRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *AddressParseOK
+	var response *ExpectedDeposit
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "AddressParse",
+			OperationName:    "AddExpectedDeposit",
 			OperationSummary: "",
-			OperationID:      "addressParse",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-			},
-			Raw: r,
+			OperationID:      "addExpectedDeposit",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = AddressParseParams
-			Response = *AddressParseOK
+			Request  = *AddExpectedDepositReq
+			Params   = struct{}
+			Response = *ExpectedDeposit
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -219,14 +221,14 @@ func (s *Server) handleAddressParseRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackAddressParseParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.AddressParse(ctx, params)
+				response, err = s.h.AddExpectedDeposit(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.AddressParse(ctx, params)
+		response, err = s.h.AddExpectedDeposit(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -245,7 +247,7 @@ func (s *Server) handleAddressParseRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeAddressParseResponse(response, w, span); err != nil {
+	if err := encodeAddExpectedDepositResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -254,20 +256,24 @@ func (s *Server) handleAddressParseRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleBlockchainAccountInspectRequest handles blockchainAccountInspect operation.
+// handleAddWebhookRequest handles addWebhook operation.
 //
-// Blockchain account inspect.
+// Register a callback URL receiving notifications about transactions and traces of the accounts for
+// backend services that can't hold a streaming connection. Every notification is POSTed to the URL,
+// failed deliveries are retried with an exponential backoff. Notifications are signed with a secret
+// returned only in this response. Requires a tenant token or a token with the admin scope. A tenant
+// can register up to 100 webhooks.
 //
-// GET /v2/blockchain/accounts/{account_id}/inspect
-func (s *Server) handleBlockchainAccountInspectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/webhooks
+func (s *Server) handleAddWebhookRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("blockchainAccountInspect"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}/inspect"),
+		otelogen.OperationID("addWebhook"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/webhooks"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "BlockchainAccountInspect",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddWebhook",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -292,42 +298,42 @@ func (s *Server) handleBlockchainAccountInspectRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "BlockchainAccountInspect",
-			ID:   "blockchainAccountInspect",
+			Name: "AddWebhook",
+			ID:   "addWebhook",
 		}
 	)
-	params, err := decodeBlockchainAccountInspectParams(args, argsEscaped, r)
+	request, close, err := s.decodeAddWebhookRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *BlockchainAccountInspect
+	var response *Webhook
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "BlockchainAccountInspect",
+			OperationName:    "AddWebhook",
 			OperationSummary: "",
-			OperationID:      "blockchainAccountInspect",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-			},
-			Raw: r,
+			OperationID:      "addWebhook",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = BlockchainAccountInspectParams
-			Response = *BlockchainAccountInspect
+			Request  = *AddWebhookReq
+			Params   = struct{}
+			Response = *Webhook
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -336,14 +342,14 @@ func (s *Server) handleBlockchainAccountInspectRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackBlockchainAccountInspectParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.BlockchainAccountInspect(ctx, params)
+				response, err = s.h.AddWebhook(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.BlockchainAccountInspect(ctx, params)
+		response, err = s.h.AddWebhook(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -362,7 +368,7 @@ func (s *Server) handleBlockchainAccountInspectRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeBlockchainAccountInspectResponse(response, w, span); err != nil {
+	if err := encodeAddWebhookResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -371,20 +377,20 @@ func (s *Server) handleBlockchainAccountInspectRequest(args [1]string, argsEscap
 	}
 }
 
-// handleDecodeMessageRequest handles decodeMessage operation.
+// handleAddressParseRequest handles addressParse operation.
 //
-// Decode a given message. Only external incoming messages can be decoded currently.
+// Parse address and display in all formats.
 //
-// POST /v2/message/decode
-func (s *Server) handleDecodeMessageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/address/{account_id}/parse
+func (s *Server) handleAddressParseRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("decodeMessage"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/message/decode"),
+		otelogen.OperationID("addressParse"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/address/{account_id}/parse"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "DecodeMessage",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "AddressParse",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -409,42 +415,42 @@ func (s *Server) handleDecodeMessageRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "DecodeMessage",
-			ID:   "decodeMessage",
+			Name: "AddressParse",
+			ID:   "addressParse",
 		}
 	)
-	request, close, err := s.decodeDecodeMessageRequest(r)
+	params, err := decodeAddressParseParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeRequest", err)
+		recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *DecodedMessage
+	var response *AddressParseOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "DecodeMessage",
+			OperationName:    "AddressParse",
 			OperationSummary: "",
-			OperationID:      "decodeMessage",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "addressParse",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *DecodeMessageReq
-			Params   = struct{}
-			Response = *DecodedMessage
+			Request  = struct{}
+			Params   = AddressParseParams
+			Response = *AddressParseOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -453,14 +459,14 @@ func (s *Server) handleDecodeMessageRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackAddressParseParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DecodeMessage(ctx, request)
+				response, err = s.h.AddressParse(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DecodeMessage(ctx, request)
+		response, err = s.h.AddressParse(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -479,7 +485,7 @@ func (s *Server) handleDecodeMessageRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDecodeMessageResponse(response, w, span); err != nil {
+	if err := encodeAddressParseResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -488,20 +494,20 @@ func (s *Server) handleDecodeMessageRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleDnsResolveRequest handles dnsResolve operation.
+// handleBlockchainAccountInspectRequest handles blockchainAccountInspect operation.
 //
-// DNS resolve for domain name.
+// Blockchain account inspect.
 //
-// GET /v2/dns/{domain_name}/resolve
-func (s *Server) handleDnsResolveRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/accounts/{account_id}/inspect
+func (s *Server) handleBlockchainAccountInspectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("dnsResolve"),
+		otelogen.OperationID("blockchainAccountInspect"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/dns/{domain_name}/resolve"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}/inspect"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "DnsResolve",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "BlockchainAccountInspect",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -526,11 +532,11 @@ func (s *Server) handleDnsResolveRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "DnsResolve",
-			ID:   "dnsResolve",
+			Name: "BlockchainAccountInspect",
+			ID:   "blockchainAccountInspect",
 		}
 	)
-	params, err := decodeDnsResolveParams(args, argsEscaped, r)
+	params, err := decodeBlockchainAccountInspectParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -541,27 +547,27 @@ func (s *Server) handleDnsResolveRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	var response *DnsRecord
+	var response *BlockchainAccountInspect
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "DnsResolve",
+			OperationName:    "BlockchainAccountInspect",
 			OperationSummary: "",
-			OperationID:      "dnsResolve",
+			OperationID:      "blockchainAccountInspect",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "domain_name",
+					Name: "account_id",
 					In:   "path",
-				}: params.DomainName,
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DnsResolveParams
-			Response = *DnsRecord
+			Params   = BlockchainAccountInspectParams
+			Response = *BlockchainAccountInspect
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -570,14 +576,14 @@ func (s *Server) handleDnsResolveRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDnsResolveParams,
+			unpackBlockchainAccountInspectParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DnsResolve(ctx, params)
+				response, err = s.h.BlockchainAccountInspect(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DnsResolve(ctx, params)
+		response, err = s.h.BlockchainAccountInspect(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -596,7 +602,7 @@ func (s *Server) handleDnsResolveRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDnsResolveResponse(response, w, span); err != nil {
+	if err := encodeBlockchainAccountInspectResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -605,20 +611,23 @@ func (s *Server) handleDnsResolveRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleEmulateMessageToAccountEventRequest handles emulateMessageToAccountEvent operation.
+// handleBuildWalletTransferRequest handles buildWalletTransfer operation.
 //
-// Emulate sending message to blockchain.
+// Construct an unsigned body of an external message to a wallet transferring TON, jettons or NFTs.
+// The hash of the body is signed externally, the signature is written before (wallets v3 and v4) or
+// after (wallet v5) the bits of the body, and the result is sent in an external message to the
+// wallet.
 //
-// POST /v2/accounts/{account_id}/events/emulate
-func (s *Server) handleEmulateMessageToAccountEventRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/wallet/{account_id}/transfer/build
+func (s *Server) handleBuildWalletTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("emulateMessageToAccountEvent"),
+		otelogen.OperationID("buildWalletTransfer"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/events/emulate"),
+		semconv.HTTPRouteKey.String("/v2/wallet/{account_id}/transfer/build"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "EmulateMessageToAccountEvent",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "BuildWalletTransfer",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -643,11 +652,11 @@ func (s *Server) handleEmulateMessageToAccountEventRequest(args [1]string, argsE
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "EmulateMessageToAccountEvent",
-			ID:   "emulateMessageToAccountEvent",
+			Name: "BuildWalletTransfer",
+			ID:   "buildWalletTransfer",
 		}
 	)
-	params, err := decodeEmulateMessageToAccountEventParams(args, argsEscaped, r)
+	params, err := decodeBuildWalletTransferParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -657,7 +666,7 @@ func (s *Server) handleEmulateMessageToAccountEventRequest(args [1]string, argsE
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeEmulateMessageToAccountEventRequest(r)
+	request, close, err := s.decodeBuildWalletTransferRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -673,35 +682,27 @@ func (s *Server) handleEmulateMessageToAccountEventRequest(args [1]string, argsE
 		}
 	}()
 
-	var response *AccountEvent
+	var response *UnsignedWalletTransfer
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "EmulateMessageToAccountEvent",
+			OperationName:    "BuildWalletTransfer",
 			OperationSummary: "",
-			OperationID:      "emulateMessageToAccountEvent",
+			OperationID:      "buildWalletTransfer",
 			Body:             request,
 			Params: middleware.Parameters{
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
 				{
 					Name: "account_id",
 					In:   "path",
 				}: params.AccountID,
-				{
-					Name: "ignore_signature_check",
-					In:   "query",
-				}: params.IgnoreSignatureCheck,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *EmulateMessageToAccountEventReq
-			Params   = EmulateMessageToAccountEventParams
-			Response = *AccountEvent
+			Request  = *BuildWalletTransferReq
+			Params   = BuildWalletTransferParams
+			Response = *UnsignedWalletTransfer
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -710,14 +711,14 @@ func (s *Server) handleEmulateMessageToAccountEventRequest(args [1]string, argsE
 		](
 			m,
 			mreq,
-			unpackEmulateMessageToAccountEventParams,
+			unpackBuildWalletTransferParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EmulateMessageToAccountEvent(ctx, request, params)
+				response, err = s.h.BuildWalletTransfer(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EmulateMessageToAccountEvent(ctx, request, params)
+		response, err = s.h.BuildWalletTransfer(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -736,7 +737,7 @@ func (s *Server) handleEmulateMessageToAccountEventRequest(args [1]string, argsE
 		return
 	}
 
-	if err := encodeEmulateMessageToAccountEventResponse(response, w, span); err != nil {
+	if err := encodeBuildWalletTransferResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -745,20 +746,23 @@ func (s *Server) handleEmulateMessageToAccountEventRequest(args [1]string, argsE
 	}
 }
 
-// handleEmulateMessageToEventRequest handles emulateMessageToEvent operation.
+// handleCheckAccountBounceRequest handles checkAccountBounce operation.
 //
-// Emulate sending message to blockchain.
+// Predict whether a transfer to the account will bounce, so a wallet can warn a user before sending.
+// The prediction is based on the current state of the account and an emulation of the transfer
+// against it, so it doesn't take into account transactions happening before the transfer is
+// delivered.
 //
-// POST /v2/events/emulate
-func (s *Server) handleEmulateMessageToEventRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/accounts/{account_id}/bounce-check
+func (s *Server) handleCheckAccountBounceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("emulateMessageToEvent"),
+		otelogen.OperationID("checkAccountBounce"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/events/emulate"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/bounce-check"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "EmulateMessageToEvent",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "CheckAccountBounce",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -783,11 +787,11 @@ func (s *Server) handleEmulateMessageToEventRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "EmulateMessageToEvent",
-			ID:   "emulateMessageToEvent",
+			Name: "CheckAccountBounce",
+			ID:   "checkAccountBounce",
 		}
 	)
-	params, err := decodeEmulateMessageToEventParams(args, argsEscaped, r)
+	params, err := decodeCheckAccountBounceParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -797,7 +801,7 @@ func (s *Server) handleEmulateMessageToEventRequest(args [0]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeEmulateMessageToEventRequest(r)
+	request, close, err := s.decodeCheckAccountBounceRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -813,31 +817,27 @@ func (s *Server) handleEmulateMessageToEventRequest(args [0]string, argsEscaped
 		}
 	}()
 
-	var response *Event
+	var response *BouncePrediction
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "EmulateMessageToEvent",
+			OperationName:    "CheckAccountBounce",
 			OperationSummary: "",
-			OperationID:      "emulateMessageToEvent",
+			OperationID:      "checkAccountBounce",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
-				{
-					Name: "ignore_signature_check",
-					In:   "query",
-				}: params.IgnoreSignatureCheck,
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *EmulateMessageToEventReq
-			Params   = EmulateMessageToEventParams
-			Response = *Event
+			Request  = *CheckAccountBounceReq
+			Params   = CheckAccountBounceParams
+			Response = *BouncePrediction
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -846,14 +846,14 @@ func (s *Server) handleEmulateMessageToEventRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackEmulateMessageToEventParams,
+			unpackCheckAccountBounceParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EmulateMessageToEvent(ctx, request, params)
+				response, err = s.h.CheckAccountBounce(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EmulateMessageToEvent(ctx, request, params)
+		response, err = s.h.CheckAccountBounce(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -872,7 +872,7 @@ func (s *Server) handleEmulateMessageToEventRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeEmulateMessageToEventResponse(response, w, span); err != nil {
+	if err := encodeCheckAccountBounceResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -881,20 +881,20 @@ func (s *Server) handleEmulateMessageToEventRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleEmulateMessageToTraceRequest handles emulateMessageToTrace operation.
+// handleDecodeMessageRequest handles decodeMessage operation.
 //
-// Emulate sending message to blockchain.
+// Decode a given message. Only external incoming messages can be decoded currently.
 //
-// POST /v2/traces/emulate
-func (s *Server) handleEmulateMessageToTraceRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/message/decode
+func (s *Server) handleDecodeMessageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("emulateMessageToTrace"),
+		otelogen.OperationID("decodeMessage"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/traces/emulate"),
+		semconv.HTTPRouteKey.String("/v2/message/decode"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "EmulateMessageToTrace",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DecodeMessage",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -919,21 +919,11 @@ func (s *Server) handleEmulateMessageToTraceRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "EmulateMessageToTrace",
-			ID:   "emulateMessageToTrace",
+			Name: "DecodeMessage",
+			ID:   "decodeMessage",
 		}
 	)
-	params, err := decodeEmulateMessageToTraceParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeEmulateMessageToTraceRequest(r)
+	request, close, err := s.decodeDecodeMessageRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -949,27 +939,22 @@ func (s *Server) handleEmulateMessageToTraceRequest(args [0]string, argsEscaped
 		}
 	}()
 
-	var response *Trace
+	var response *DecodedMessage
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "EmulateMessageToTrace",
+			OperationName:    "DecodeMessage",
 			OperationSummary: "",
-			OperationID:      "emulateMessageToTrace",
+			OperationID:      "decodeMessage",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "ignore_signature_check",
-					In:   "query",
-				}: params.IgnoreSignatureCheck,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *EmulateMessageToTraceReq
-			Params   = EmulateMessageToTraceParams
-			Response = *Trace
+			Request  = *DecodeMessageReq
+			Params   = struct{}
+			Response = *DecodedMessage
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -978,14 +963,14 @@ func (s *Server) handleEmulateMessageToTraceRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackEmulateMessageToTraceParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EmulateMessageToTrace(ctx, request, params)
+				response, err = s.h.DecodeMessage(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EmulateMessageToTrace(ctx, request, params)
+		response, err = s.h.DecodeMessage(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1004,7 +989,7 @@ func (s *Server) handleEmulateMessageToTraceRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeEmulateMessageToTraceResponse(response, w, span); err != nil {
+	if err := encodeDecodeMessageResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1013,20 +998,20 @@ func (s *Server) handleEmulateMessageToTraceRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleEmulateMessageToWalletRequest handles emulateMessageToWallet operation.
+// handleDeleteEventAnnotationRequest handles deleteEventAnnotation operation.
 //
-// Emulate sending message to blockchain.
+// Remove a private annotation attached to the event.
 //
-// POST /v2/wallet/emulate
-func (s *Server) handleEmulateMessageToWalletRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v2/events/{event_id}/annotation
+func (s *Server) handleDeleteEventAnnotationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("emulateMessageToWallet"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/wallet/emulate"),
+		otelogen.OperationID("deleteEventAnnotation"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/v2/events/{event_id}/annotation"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "EmulateMessageToWallet",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DeleteEventAnnotation",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1051,11 +1036,11 @@ func (s *Server) handleEmulateMessageToWalletRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "EmulateMessageToWallet",
-			ID:   "emulateMessageToWallet",
+			Name: "DeleteEventAnnotation",
+			ID:   "deleteEventAnnotation",
 		}
 	)
-	params, err := decodeEmulateMessageToWalletParams(args, argsEscaped, r)
+	params, err := decodeDeleteEventAnnotationParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1065,43 +1050,28 @@ func (s *Server) handleEmulateMessageToWalletRequest(args [0]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeEmulateMessageToWalletRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *MessageConsequences
+	var response *DeleteEventAnnotationOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "EmulateMessageToWallet",
+			OperationName:    "DeleteEventAnnotation",
 			OperationSummary: "",
-			OperationID:      "emulateMessageToWallet",
-			Body:             request,
+			OperationID:      "deleteEventAnnotation",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
+					Name: "event_id",
+					In:   "path",
+				}: params.EventID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *EmulateMessageToWalletReq
-			Params   = EmulateMessageToWalletParams
-			Response = *MessageConsequences
+			Request  = struct{}
+			Params   = DeleteEventAnnotationParams
+			Response = *DeleteEventAnnotationOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1110,14 +1080,14 @@ func (s *Server) handleEmulateMessageToWalletRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackEmulateMessageToWalletParams,
+			unpackDeleteEventAnnotationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EmulateMessageToWallet(ctx, request, params)
+				err = s.h.DeleteEventAnnotation(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EmulateMessageToWallet(ctx, request, params)
+		err = s.h.DeleteEventAnnotation(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1136,7 +1106,7 @@ func (s *Server) handleEmulateMessageToWalletRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeEmulateMessageToWalletResponse(response, w, span); err != nil {
+	if err := encodeDeleteEventAnnotationResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1145,20 +1115,20 @@ func (s *Server) handleEmulateMessageToWalletRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleExecGetMethodForBlockchainAccountRequest handles execGetMethodForBlockchainAccount operation.
+// handleDeleteExpectedDepositRequest handles deleteExpectedDeposit operation.
 //
-// Execute get method for account.
+// Remove an expected deposit.
 //
-// GET /v2/blockchain/accounts/{account_id}/methods/{method_name}
-func (s *Server) handleExecGetMethodForBlockchainAccountRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v2/deposits/{id}
+func (s *Server) handleDeleteExpectedDepositRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("execGetMethodForBlockchainAccount"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}/methods/{method_name}"),
+		otelogen.OperationID("deleteExpectedDeposit"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/v2/deposits/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ExecGetMethodForBlockchainAccount",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DeleteExpectedDeposit",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1183,11 +1153,11 @@ func (s *Server) handleExecGetMethodForBlockchainAccountRequest(args [2]string,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ExecGetMethodForBlockchainAccount",
-			ID:   "execGetMethodForBlockchainAccount",
+			Name: "DeleteExpectedDeposit",
+			ID:   "deleteExpectedDeposit",
 		}
 	)
-	params, err := decodeExecGetMethodForBlockchainAccountParams(args, argsEscaped, r)
+	params, err := decodeDeleteExpectedDepositParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1198,35 +1168,27 @@ func (s *Server) handleExecGetMethodForBlockchainAccountRequest(args [2]string,
 		return
 	}
 
-	var response *MethodExecutionResult
+	var response *DeleteExpectedDepositOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ExecGetMethodForBlockchainAccount",
+			OperationName:    "DeleteExpectedDeposit",
 			OperationSummary: "",
-			OperationID:      "execGetMethodForBlockchainAccount",
+			OperationID:      "deleteExpectedDeposit",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "method_name",
+					Name: "id",
 					In:   "path",
-				}: params.MethodName,
-				{
-					Name: "args",
-					In:   "query",
-				}: params.Args,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ExecGetMethodForBlockchainAccountParams
-			Response = *MethodExecutionResult
+			Params   = DeleteExpectedDepositParams
+			Response = *DeleteExpectedDepositOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1235,14 +1197,14 @@ func (s *Server) handleExecGetMethodForBlockchainAccountRequest(args [2]string,
 		](
 			m,
 			mreq,
-			unpackExecGetMethodForBlockchainAccountParams,
+			unpackDeleteExpectedDepositParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ExecGetMethodForBlockchainAccount(ctx, params)
+				err = s.h.DeleteExpectedDeposit(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ExecGetMethodForBlockchainAccount(ctx, params)
+		err = s.h.DeleteExpectedDeposit(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1261,7 +1223,7 @@ func (s *Server) handleExecGetMethodForBlockchainAccountRequest(args [2]string,
 		return
 	}
 
-	if err := encodeExecGetMethodForBlockchainAccountResponse(response, w, span); err != nil {
+	if err := encodeDeleteExpectedDepositResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1270,20 +1232,20 @@ func (s *Server) handleExecGetMethodForBlockchainAccountRequest(args [2]string,
 	}
 }
 
-// handleGaslessConfigRequest handles gaslessConfig operation.
+// handleDeletePrivateLabelRequest handles deletePrivateLabel operation.
 //
-// Returns configuration of gasless transfers.
+// Remove a private label of an account. Requires a token with the admin scope.
 //
-// GET /v2/gasless/config
-func (s *Server) handleGaslessConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v2/labels/{account_id}
+func (s *Server) handleDeletePrivateLabelRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("gaslessConfig"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/gasless/config"),
+		otelogen.OperationID("deletePrivateLabel"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/v2/labels/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GaslessConfig",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DeletePrivateLabel",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1306,25 +1268,44 @@ func (s *Server) handleGaslessConfigRequest(args [0]string, argsEscaped bool, w
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "DeletePrivateLabel",
+			ID:   "deletePrivateLabel",
+		}
 	)
+	params, err := decodeDeletePrivateLabelParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *GaslessConfig
+	var response *DeletePrivateLabelOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GaslessConfig",
+			OperationName:    "DeletePrivateLabel",
 			OperationSummary: "",
-			OperationID:      "gaslessConfig",
+			OperationID:      "deletePrivateLabel",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *GaslessConfig
+			Params   = DeletePrivateLabelParams
+			Response = *DeletePrivateLabelOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1333,14 +1314,14 @@ func (s *Server) handleGaslessConfigRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeletePrivateLabelParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GaslessConfig(ctx)
+				err = s.h.DeletePrivateLabel(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GaslessConfig(ctx)
+		err = s.h.DeletePrivateLabel(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1359,7 +1340,7 @@ func (s *Server) handleGaslessConfigRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGaslessConfigResponse(response, w, span); err != nil {
+	if err := encodeDeletePrivateLabelResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1368,20 +1349,20 @@ func (s *Server) handleGaslessConfigRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGaslessEstimateRequest handles gaslessEstimate operation.
+// handleDeleteWebhookRequest handles deleteWebhook operation.
 //
-// Estimates the cost of the given messages and returns a payload to sign.
+// Remove a webhook, its pending notifications are dropped.
 //
-// POST /v2/gasless/estimate/{master_id}
-func (s *Server) handleGaslessEstimateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v2/webhooks/{id}
+func (s *Server) handleDeleteWebhookRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("gaslessEstimate"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/gasless/estimate/{master_id}"),
+		otelogen.OperationID("deleteWebhook"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/v2/webhooks/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GaslessEstimate",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DeleteWebhook",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1406,11 +1387,11 @@ func (s *Server) handleGaslessEstimateRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GaslessEstimate",
-			ID:   "gaslessEstimate",
+			Name: "DeleteWebhook",
+			ID:   "deleteWebhook",
 		}
 	)
-	params, err := decodeGaslessEstimateParams(args, argsEscaped, r)
+	params, err := decodeDeleteWebhookParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1420,43 +1401,28 @@ func (s *Server) handleGaslessEstimateRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeGaslessEstimateRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *SignRawParams
+	var response *DeleteWebhookOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GaslessEstimate",
+			OperationName:    "DeleteWebhook",
 			OperationSummary: "",
-			OperationID:      "gaslessEstimate",
-			Body:             request,
+			OperationID:      "deleteWebhook",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "master_id",
+					Name: "id",
 					In:   "path",
-				}: params.MasterID,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *GaslessEstimateReq
-			Params   = GaslessEstimateParams
-			Response = *SignRawParams
+			Request  = struct{}
+			Params   = DeleteWebhookParams
+			Response = *DeleteWebhookOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1465,14 +1431,14 @@ func (s *Server) handleGaslessEstimateRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGaslessEstimateParams,
+			unpackDeleteWebhookParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GaslessEstimate(ctx, request, params)
+				err = s.h.DeleteWebhook(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GaslessEstimate(ctx, request, params)
+		err = s.h.DeleteWebhook(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1491,7 +1457,7 @@ func (s *Server) handleGaslessEstimateRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGaslessEstimateResponse(response, w, span); err != nil {
+	if err := encodeDeleteWebhookResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1500,20 +1466,20 @@ func (s *Server) handleGaslessEstimateRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGaslessSendRequest handles gaslessSend operation.
+// handleDnsResolveRequest handles dnsResolve operation.
 //
-// Submits the signed gasless transaction message to the network.
+// DNS resolve for domain name.
 //
-// POST /v2/gasless/send
-func (s *Server) handleGaslessSendRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/dns/{domain_name}/resolve
+func (s *Server) handleDnsResolveRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("gaslessSend"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/gasless/send"),
+		otelogen.OperationID("dnsResolve"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/dns/{domain_name}/resolve"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GaslessSend",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DnsResolve",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1538,43 +1504,43 @@ func (s *Server) handleGaslessSendRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GaslessSend",
-			ID:   "gaslessSend",
+			Name: "DnsResolve",
+			ID:   "dnsResolve",
 		}
 	)
-	request, close, err := s.decodeGaslessSendRequest(r)
+	params, err := decodeDnsResolveParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeRequest", err)
+		recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *GaslessSendOK
+	var response *DnsRecord
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GaslessSend",
+			OperationName:    "DnsResolve",
 			OperationSummary: "",
-			OperationID:      "gaslessSend",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
-		}
-
-		type (
-			Request  = *GaslessSendReq
-			Params   = struct{}
-			Response = *GaslessSendOK
-		)
+			OperationID:      "dnsResolve",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "domain_name",
+					In:   "path",
+				}: params.DomainName,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DnsResolveParams
+			Response = *DnsRecord
+		)
 		response, err = middleware.HookMiddleware[
 			Request,
 			Params,
@@ -1582,14 +1548,14 @@ func (s *Server) handleGaslessSendRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			nil,
+			unpackDnsResolveParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.GaslessSend(ctx, request)
+				response, err = s.h.DnsResolve(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.GaslessSend(ctx, request)
+		response, err = s.h.DnsResolve(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1608,7 +1574,7 @@ func (s *Server) handleGaslessSendRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGaslessSendResponse(response, w, span); err != nil {
+	if err := encodeDnsResolveResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1617,20 +1583,20 @@ func (s *Server) handleGaslessSendRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetAccountRequest handles getAccount operation.
+// handleEmulateMessageToAccountEventRequest handles emulateMessageToAccountEvent operation.
 //
-// Get human-friendly information about an account without low-level details.
+// Emulate sending message to blockchain.
 //
-// GET /v2/accounts/{account_id}
-func (s *Server) handleGetAccountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/accounts/{account_id}/events/emulate
+func (s *Server) handleEmulateMessageToAccountEventRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccount"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}"),
+		otelogen.OperationID("emulateMessageToAccountEvent"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/events/emulate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccount",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "EmulateMessageToAccountEvent",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1655,11 +1621,11 @@ func (s *Server) handleGetAccountRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccount",
-			ID:   "getAccount",
+			Name: "EmulateMessageToAccountEvent",
+			ID:   "emulateMessageToAccountEvent",
 		}
 	)
-	params, err := decodeGetAccountParams(args, argsEscaped, r)
+	params, err := decodeEmulateMessageToAccountEventParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1669,28 +1635,51 @@ func (s *Server) handleGetAccountRequest(args [1]string, argsEscaped bool, w htt
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeEmulateMessageToAccountEventRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *Account
+	var response *AccountEvent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccount",
+			OperationName:    "EmulateMessageToAccountEvent",
 			OperationSummary: "",
-			OperationID:      "getAccount",
-			Body:             nil,
+			OperationID:      "emulateMessageToAccountEvent",
+			Body:             request,
 			Params: middleware.Parameters{
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
 				{
 					Name: "account_id",
 					In:   "path",
 				}: params.AccountID,
+				{
+					Name: "ignore_signature_check",
+					In:   "query",
+				}: params.IgnoreSignatureCheck,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetAccountParams
-			Response = *Account
+			Request  = *EmulateMessageToAccountEventReq
+			Params   = EmulateMessageToAccountEventParams
+			Response = *AccountEvent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1699,14 +1688,14 @@ func (s *Server) handleGetAccountRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetAccountParams,
+			unpackEmulateMessageToAccountEventParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccount(ctx, params)
+				response, err = s.h.EmulateMessageToAccountEvent(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccount(ctx, params)
+		response, err = s.h.EmulateMessageToAccountEvent(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1725,7 +1714,7 @@ func (s *Server) handleGetAccountRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetAccountResponse(response, w, span); err != nil {
+	if err := encodeEmulateMessageToAccountEventResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1734,20 +1723,20 @@ func (s *Server) handleGetAccountRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetAccountDiffRequest handles getAccountDiff operation.
+// handleEmulateMessageToEventRequest handles emulateMessageToEvent operation.
 //
-// Get account's balance change.
+// Emulate sending message to blockchain.
 //
-// GET /v2/accounts/{account_id}/diff
-func (s *Server) handleGetAccountDiffRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/events/emulate
+func (s *Server) handleEmulateMessageToEventRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountDiff"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/diff"),
+		otelogen.OperationID("emulateMessageToEvent"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/events/emulate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountDiff",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "EmulateMessageToEvent",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1772,11 +1761,11 @@ func (s *Server) handleGetAccountDiffRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountDiff",
-			ID:   "getAccountDiff",
+			Name: "EmulateMessageToEvent",
+			ID:   "emulateMessageToEvent",
 		}
 	)
-	params, err := decodeGetAccountDiffParams(args, argsEscaped, r)
+	params, err := decodeEmulateMessageToEventParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1786,36 +1775,47 @@ func (s *Server) handleGetAccountDiffRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeEmulateMessageToEventRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *GetAccountDiffOK
+	var response *Event
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountDiff",
+			OperationName:    "EmulateMessageToEvent",
 			OperationSummary: "",
-			OperationID:      "getAccountDiff",
-			Body:             nil,
+			OperationID:      "emulateMessageToEvent",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "start_date",
-					In:   "query",
-				}: params.StartDate,
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
 				{
-					Name: "end_date",
+					Name: "ignore_signature_check",
 					In:   "query",
-				}: params.EndDate,
+				}: params.IgnoreSignatureCheck,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetAccountDiffParams
-			Response = *GetAccountDiffOK
+			Request  = *EmulateMessageToEventReq
+			Params   = EmulateMessageToEventParams
+			Response = *Event
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1824,14 +1824,14 @@ func (s *Server) handleGetAccountDiffRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetAccountDiffParams,
+			unpackEmulateMessageToEventParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountDiff(ctx, params)
+				response, err = s.h.EmulateMessageToEvent(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountDiff(ctx, params)
+		response, err = s.h.EmulateMessageToEvent(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1850,7 +1850,7 @@ func (s *Server) handleGetAccountDiffRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetAccountDiffResponse(response, w, span); err != nil {
+	if err := encodeEmulateMessageToEventResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1859,20 +1859,20 @@ func (s *Server) handleGetAccountDiffRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetAccountDnsExpiringRequest handles getAccountDnsExpiring operation.
+// handleEmulateMessageToTraceRequest handles emulateMessageToTrace operation.
 //
-// Get expiring account .ton dns.
+// Emulate sending message to blockchain.
 //
-// GET /v2/accounts/{account_id}/dns/expiring
-func (s *Server) handleGetAccountDnsExpiringRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/traces/emulate
+func (s *Server) handleEmulateMessageToTraceRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountDnsExpiring"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/dns/expiring"),
+		otelogen.OperationID("emulateMessageToTrace"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/traces/emulate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountDnsExpiring",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "EmulateMessageToTrace",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1897,11 +1897,11 @@ func (s *Server) handleGetAccountDnsExpiringRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountDnsExpiring",
-			ID:   "getAccountDnsExpiring",
+			Name: "EmulateMessageToTrace",
+			ID:   "emulateMessageToTrace",
 		}
 	)
-	params, err := decodeGetAccountDnsExpiringParams(args, argsEscaped, r)
+	params, err := decodeEmulateMessageToTraceParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1911,32 +1911,43 @@ func (s *Server) handleGetAccountDnsExpiringRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeEmulateMessageToTraceRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DnsExpiring
+	var response *Trace
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountDnsExpiring",
+			OperationName:    "EmulateMessageToTrace",
 			OperationSummary: "",
-			OperationID:      "getAccountDnsExpiring",
-			Body:             nil,
+			OperationID:      "emulateMessageToTrace",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "period",
+					Name: "ignore_signature_check",
 					In:   "query",
-				}: params.Period,
+				}: params.IgnoreSignatureCheck,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetAccountDnsExpiringParams
-			Response = *DnsExpiring
+			Request  = *EmulateMessageToTraceReq
+			Params   = EmulateMessageToTraceParams
+			Response = *Trace
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1945,14 +1956,14 @@ func (s *Server) handleGetAccountDnsExpiringRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetAccountDnsExpiringParams,
+			unpackEmulateMessageToTraceParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountDnsExpiring(ctx, params)
+				response, err = s.h.EmulateMessageToTrace(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountDnsExpiring(ctx, params)
+		response, err = s.h.EmulateMessageToTrace(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1971,7 +1982,7 @@ func (s *Server) handleGetAccountDnsExpiringRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetAccountDnsExpiringResponse(response, w, span); err != nil {
+	if err := encodeEmulateMessageToTraceResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1980,20 +1991,20 @@ func (s *Server) handleGetAccountDnsExpiringRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetAccountEventRequest handles getAccountEvent operation.
+// handleEmulateMessageToWalletRequest handles emulateMessageToWallet operation.
 //
-// Get event for an account by event_id.
+// Emulate sending message to blockchain.
 //
-// GET /v2/accounts/{account_id}/events/{event_id}
-func (s *Server) handleGetAccountEventRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/wallet/emulate
+func (s *Server) handleEmulateMessageToWalletRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountEvent"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/events/{event_id}"),
+		otelogen.OperationID("emulateMessageToWallet"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/wallet/emulate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountEvent",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "EmulateMessageToWallet",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2018,11 +2029,11 @@ func (s *Server) handleGetAccountEventRequest(args [2]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountEvent",
-			ID:   "getAccountEvent",
+			Name: "EmulateMessageToWallet",
+			ID:   "emulateMessageToWallet",
 		}
 	)
-	params, err := decodeGetAccountEventParams(args, argsEscaped, r)
+	params, err := decodeEmulateMessageToWalletParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2032,14 +2043,146 @@ func (s *Server) handleGetAccountEventRequest(args [2]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-
-	var response *AccountEvent
-	if m := s.cfg.Middleware; m != nil {
+	request, close, err := s.decodeEmulateMessageToWalletRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *MessageConsequences
+	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountEvent",
+			OperationName:    "EmulateMessageToWallet",
 			OperationSummary: "",
-			OperationID:      "getAccountEvent",
+			OperationID:      "emulateMessageToWallet",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *EmulateMessageToWalletReq
+			Params   = EmulateMessageToWalletParams
+			Response = *MessageConsequences
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackEmulateMessageToWalletParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.EmulateMessageToWallet(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.EmulateMessageToWallet(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeEmulateMessageToWalletResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleExecGetMethodForBlockchainAccountRequest handles execGetMethodForBlockchainAccount operation.
+//
+// Execute get method for account.
+//
+// GET /v2/blockchain/accounts/{account_id}/methods/{method_name}
+func (s *Server) handleExecGetMethodForBlockchainAccountRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("execGetMethodForBlockchainAccount"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}/methods/{method_name}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ExecGetMethodForBlockchainAccount",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ExecGetMethodForBlockchainAccount",
+			ID:   "execGetMethodForBlockchainAccount",
+		}
+	)
+	params, err := decodeExecGetMethodForBlockchainAccountParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *MethodExecutionResult
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ExecGetMethodForBlockchainAccount",
+			OperationSummary: "",
+			OperationID:      "execGetMethodForBlockchainAccount",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -2047,25 +2190,5297 @@ func (s *Server) handleGetAccountEventRequest(args [2]string, argsEscaped bool,
 					In:   "path",
 				}: params.AccountID,
 				{
-					Name: "event_id",
+					Name: "method_name",
 					In:   "path",
-				}: params.EventID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
+				}: params.MethodName,
 				{
-					Name: "subject_only",
+					Name: "args",
 					In:   "query",
-				}: params.SubjectOnly,
+				}: params.Args,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountEventParams
-			Response = *AccountEvent
+			Params   = ExecGetMethodForBlockchainAccountParams
+			Response = *MethodExecutionResult
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackExecGetMethodForBlockchainAccountParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ExecGetMethodForBlockchainAccount(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ExecGetMethodForBlockchainAccount(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeExecGetMethodForBlockchainAccountResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGaslessConfigRequest handles gaslessConfig operation.
+//
+// Returns configuration of gasless transfers.
+//
+// GET /v2/gasless/config
+func (s *Server) handleGaslessConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("gaslessConfig"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/gasless/config"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GaslessConfig",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response *GaslessConfig
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GaslessConfig",
+			OperationSummary: "",
+			OperationID:      "gaslessConfig",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *GaslessConfig
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GaslessConfig(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GaslessConfig(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGaslessConfigResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGaslessEstimateRequest handles gaslessEstimate operation.
+//
+// Estimates the cost of the given messages and returns a payload to sign.
+//
+// POST /v2/gasless/estimate/{master_id}
+func (s *Server) handleGaslessEstimateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("gaslessEstimate"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/gasless/estimate/{master_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GaslessEstimate",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GaslessEstimate",
+			ID:   "gaslessEstimate",
+		}
+	)
+	params, err := decodeGaslessEstimateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeGaslessEstimateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *SignRawParams
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GaslessEstimate",
+			OperationSummary: "",
+			OperationID:      "gaslessEstimate",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "master_id",
+					In:   "path",
+				}: params.MasterID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *GaslessEstimateReq
+			Params   = GaslessEstimateParams
+			Response = *SignRawParams
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGaslessEstimateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GaslessEstimate(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GaslessEstimate(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGaslessEstimateResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGaslessSendRequest handles gaslessSend operation.
+//
+// Submits the signed gasless transaction message to the network.
+//
+// POST /v2/gasless/send
+func (s *Server) handleGaslessSendRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("gaslessSend"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/gasless/send"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GaslessSend",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GaslessSend",
+			ID:   "gaslessSend",
+		}
+	)
+	request, close, err := s.decodeGaslessSendRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *GaslessSendOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GaslessSend",
+			OperationSummary: "",
+			OperationID:      "gaslessSend",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GaslessSendReq
+			Params   = struct{}
+			Response = *GaslessSendOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.GaslessSend(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.GaslessSend(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGaslessSendResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountRequest handles getAccount operation.
+//
+// Get human-friendly information about an account without low-level details.
+//
+// GET /v2/accounts/{account_id}
+func (s *Server) handleGetAccountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccount"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccount",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccount",
+			ID:   "getAccount",
+		}
+	)
+	params, err := decodeGetAccountParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Account
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccount",
+			OperationSummary: "",
+			OperationID:      "getAccount",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountParams
+			Response = *Account
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccount(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccount(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountActivityRequest handles getAccountActivity operation.
+//
+// Get an activity feed of an account as wallets render it on the home screen. The feed merges
+// confirmed events, traces that are still being executed and pending messages from the mempool into
+// one list ordered from the newest item to the oldest one. Every item has an explicit status.
+// Pending items are returned only on the first page, that is when before_lt is omitted.
+//
+// GET /v2/accounts/{account_id}/activity
+func (s *Server) handleGetAccountActivityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountActivity"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/activity"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountActivity",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountActivity",
+			ID:   "getAccountActivity",
+		}
+	)
+	params, err := decodeGetAccountActivityParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountActivity
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountActivity",
+			OperationSummary: "",
+			OperationID:      "getAccountActivity",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "subject_only",
+					In:   "query",
+				}: params.SubjectOnly,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountActivityParams
+			Response = *AccountActivity
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountActivityParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountActivity(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountActivity(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountActivityResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountActivityHeatmapRequest handles getAccountActivityHeatmap operation.
+//
+// Get numbers of account's transactions bucketed by hours or days, so an activity heatmap can be
+// rendered without downloading the transaction history.
+// Buckets are in UTC and include empty ones. Available only for accounts tracked by the indexer.
+//
+// GET /v2/accounts/{account_id}/heatmap
+func (s *Server) handleGetAccountActivityHeatmapRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountActivityHeatmap"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/heatmap"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountActivityHeatmap",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountActivityHeatmap",
+			ID:   "getAccountActivityHeatmap",
+		}
+	)
+	params, err := decodeGetAccountActivityHeatmapParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountActivityHeatmap
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountActivityHeatmap",
+			OperationSummary: "",
+			OperationID:      "getAccountActivityHeatmap",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "interval",
+					In:   "query",
+				}: params.Interval,
+				{
+					Name: "start_date",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "end_date",
+					In:   "query",
+				}: params.EndDate,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountActivityHeatmapParams
+			Response = *AccountActivityHeatmap
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountActivityHeatmapParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountActivityHeatmap(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountActivityHeatmap(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountActivityHeatmapResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountCodeHistoryRequest handles getAccountCodeHistory operation.
+//
+// Get the history of code upgrades of an account. Available only for accounts tracked by the indexer.
+//
+// GET /v2/accounts/{account_id}/code-history
+func (s *Server) handleGetAccountCodeHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountCodeHistory"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/code-history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountCodeHistory",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountCodeHistory",
+			ID:   "getAccountCodeHistory",
+		}
+	)
+	params, err := decodeGetAccountCodeHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountCodeHistory
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountCodeHistory",
+			OperationSummary: "",
+			OperationID:      "getAccountCodeHistory",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountCodeHistoryParams
+			Response = *AccountCodeHistory
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountCodeHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountCodeHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountCodeHistory(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountCodeHistoryResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountDiffRequest handles getAccountDiff operation.
+//
+// Get account's balance change.
+//
+// GET /v2/accounts/{account_id}/diff
+func (s *Server) handleGetAccountDiffRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountDiff"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/diff"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountDiff",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountDiff",
+			ID:   "getAccountDiff",
+		}
+	)
+	params, err := decodeGetAccountDiffParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *GetAccountDiffOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountDiff",
+			OperationSummary: "",
+			OperationID:      "getAccountDiff",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "start_date",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "end_date",
+					In:   "query",
+				}: params.EndDate,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountDiffParams
+			Response = *GetAccountDiffOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountDiffParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountDiff(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountDiff(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountDiffResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountDnsExpiringRequest handles getAccountDnsExpiring operation.
+//
+// Get expiring account .ton dns.
+//
+// GET /v2/accounts/{account_id}/dns/expiring
+func (s *Server) handleGetAccountDnsExpiringRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountDnsExpiring"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/dns/expiring"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountDnsExpiring",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountDnsExpiring",
+			ID:   "getAccountDnsExpiring",
+		}
+	)
+	params, err := decodeGetAccountDnsExpiringParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *DnsExpiring
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountDnsExpiring",
+			OperationSummary: "",
+			OperationID:      "getAccountDnsExpiring",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "period",
+					In:   "query",
+				}: params.Period,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountDnsExpiringParams
+			Response = *DnsExpiring
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountDnsExpiringParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountDnsExpiring(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountDnsExpiring(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountDnsExpiringResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountEventRequest handles getAccountEvent operation.
+//
+// Get event for an account by event_id.
+//
+// GET /v2/accounts/{account_id}/events/{event_id}
+func (s *Server) handleGetAccountEventRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountEvent"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/events/{event_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountEvent",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountEvent",
+			ID:   "getAccountEvent",
+		}
+	)
+	params, err := decodeGetAccountEventParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountEvent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountEvent",
+			OperationSummary: "",
+			OperationID:      "getAccountEvent",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "event_id",
+					In:   "path",
+				}: params.EventID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "subject_only",
+					In:   "query",
+				}: params.SubjectOnly,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountEventParams
+			Response = *AccountEvent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountEventParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountEvent(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountEvent(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountEventResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountEventsRequest handles getAccountEvents operation.
+//
+// Get events for an account. Each event is built on top of a trace which is a series of transactions
+// caused by one inbound message. TonAPI looks for known patterns inside the trace and splits the
+// trace into actions, where a single action represents a meaningful high-level operation like a
+// Jetton Transfer or an NFT Purchase. Actions are expected to be shown to users. It is advised not
+// to build any logic on top of actions because actions can be changed at any time.
+//
+// GET /v2/accounts/{account_id}/events
+func (s *Server) handleGetAccountEventsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountEvents"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/events"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountEvents",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountEvents",
+			ID:   "getAccountEvents",
+		}
+	)
+	params, err := decodeGetAccountEventsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountEvents
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountEvents",
+			OperationSummary: "",
+			OperationID:      "getAccountEvents",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "initiator",
+					In:   "query",
+				}: params.Initiator,
+				{
+					Name: "subject_only",
+					In:   "query",
+				}: params.SubjectOnly,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "start_date",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "end_date",
+					In:   "query",
+				}: params.EndDate,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountEventsParams
+			Response = *AccountEvents
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountEventsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountEvents(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountEvents(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountEventsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountEventsDeltaRequest handles getAccountEventsDelta operation.
+//
+// Get changes of an account's events since a cursor, it is a poll-friendly alternative to streaming
+// for clients that can't keep a connection open.
+// A change is a new event or a new status of an event returned before, e.g. a pending event that has
+// been confirmed or dropped from the mempool.
+// The first request without since_cursor returns the latest events as changes, every response
+// contains a cursor for the next request.
+//
+// GET /v2/accounts/{account_id}/events/delta
+func (s *Server) handleGetAccountEventsDeltaRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountEventsDelta"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/events/delta"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountEventsDelta",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountEventsDelta",
+			ID:   "getAccountEventsDelta",
+		}
+	)
+	params, err := decodeGetAccountEventsDeltaParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountEventsDelta
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountEventsDelta",
+			OperationSummary: "",
+			OperationID:      "getAccountEventsDelta",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "subject_only",
+					In:   "query",
+				}: params.SubjectOnly,
+				{
+					Name: "since_cursor",
+					In:   "query",
+				}: params.SinceCursor,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountEventsDeltaParams
+			Response = *AccountEventsDelta
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountEventsDeltaParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountEventsDelta(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountEventsDelta(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountEventsDeltaResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountInfoByStateInitRequest handles getAccountInfoByStateInit operation.
+//
+// Get account info by state init.
+//
+// POST /v2/tonconnect/stateinit
+func (s *Server) handleGetAccountInfoByStateInitRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountInfoByStateInit"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/tonconnect/stateinit"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountInfoByStateInit",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountInfoByStateInit",
+			ID:   "getAccountInfoByStateInit",
+		}
+	)
+	request, close, err := s.decodeGetAccountInfoByStateInitRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *AccountInfoByStateInit
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountInfoByStateInit",
+			OperationSummary: "",
+			OperationID:      "getAccountInfoByStateInit",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetAccountInfoByStateInitReq
+			Params   = struct{}
+			Response = *AccountInfoByStateInit
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountInfoByStateInit(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountInfoByStateInit(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountInfoByStateInitResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountInscriptionsRequest handles getAccountInscriptions operation.
+//
+// Get all inscriptions by owner address. It's experimental API and can be dropped in the future.
+//
+// GET /v2/experimental/accounts/{account_id}/inscriptions
+func (s *Server) handleGetAccountInscriptionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountInscriptions"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/experimental/accounts/{account_id}/inscriptions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountInscriptions",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountInscriptions",
+			ID:   "getAccountInscriptions",
+		}
+	)
+	params, err := decodeGetAccountInscriptionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *InscriptionBalances
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountInscriptions",
+			OperationSummary: "",
+			OperationID:      "getAccountInscriptions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountInscriptionsParams
+			Response = *InscriptionBalances
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountInscriptionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountInscriptions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountInscriptions(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountInscriptionsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountInscriptionsHistoryRequest handles getAccountInscriptionsHistory operation.
+//
+// Get the transfer inscriptions history for account. It's experimental API and can be dropped in the
+// future.
+//
+// GET /v2/experimental/accounts/{account_id}/inscriptions/history
+func (s *Server) handleGetAccountInscriptionsHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountInscriptionsHistory"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/experimental/accounts/{account_id}/inscriptions/history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountInscriptionsHistory",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountInscriptionsHistory",
+			ID:   "getAccountInscriptionsHistory",
+		}
+	)
+	params, err := decodeGetAccountInscriptionsHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountEvents
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountInscriptionsHistory",
+			OperationSummary: "",
+			OperationID:      "getAccountInscriptionsHistory",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountInscriptionsHistoryParams
+			Response = *AccountEvents
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountInscriptionsHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountInscriptionsHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountInscriptionsHistory(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountInscriptionsHistoryResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountInscriptionsHistoryByTickerRequest handles getAccountInscriptionsHistoryByTicker operation.
+//
+// Get the transfer inscriptions history for account. It's experimental API and can be dropped in the
+// future.
+//
+// GET /v2/experimental/accounts/{account_id}/inscriptions/{ticker}/history
+func (s *Server) handleGetAccountInscriptionsHistoryByTickerRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountInscriptionsHistoryByTicker"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/experimental/accounts/{account_id}/inscriptions/{ticker}/history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountInscriptionsHistoryByTicker",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountInscriptionsHistoryByTicker",
+			ID:   "getAccountInscriptionsHistoryByTicker",
+		}
+	)
+	params, err := decodeGetAccountInscriptionsHistoryByTickerParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountEvents
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountInscriptionsHistoryByTicker",
+			OperationSummary: "",
+			OperationID:      "getAccountInscriptionsHistoryByTicker",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "ticker",
+					In:   "path",
+				}: params.Ticker,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountInscriptionsHistoryByTickerParams
+			Response = *AccountEvents
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountInscriptionsHistoryByTickerParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountInscriptionsHistoryByTicker(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountInscriptionsHistoryByTicker(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountInscriptionsHistoryByTickerResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountJettonBalanceRequest handles getAccountJettonBalance operation.
+//
+// Get Jetton balance by owner address.
+//
+// GET /v2/accounts/{account_id}/jettons/{jetton_id}
+func (s *Server) handleGetAccountJettonBalanceRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountJettonBalance"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/jettons/{jetton_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountJettonBalance",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountJettonBalance",
+			ID:   "getAccountJettonBalance",
+		}
+	)
+	params, err := decodeGetAccountJettonBalanceParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *JettonBalance
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountJettonBalance",
+			OperationSummary: "",
+			OperationID:      "getAccountJettonBalance",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "jetton_id",
+					In:   "path",
+				}: params.JettonID,
+				{
+					Name: "currencies",
+					In:   "query",
+				}: params.Currencies,
+				{
+					Name: "supported_extensions",
+					In:   "query",
+				}: params.SupportedExtensions,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountJettonBalanceParams
+			Response = *JettonBalance
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountJettonBalanceParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountJettonBalance(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountJettonBalance(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountJettonBalanceResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountJettonHistoryByIDRequest handles getAccountJettonHistoryByID operation.
+//
+// Get the transfer jetton history for account and jetton.
+//
+// GET /v2/accounts/{account_id}/jettons/{jetton_id}/history
+func (s *Server) handleGetAccountJettonHistoryByIDRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountJettonHistoryByID"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/jettons/{jetton_id}/history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountJettonHistoryByID",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountJettonHistoryByID",
+			ID:   "getAccountJettonHistoryByID",
+		}
+	)
+	params, err := decodeGetAccountJettonHistoryByIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountEvents
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountJettonHistoryByID",
+			OperationSummary: "",
+			OperationID:      "getAccountJettonHistoryByID",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "jetton_id",
+					In:   "path",
+				}: params.JettonID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "start_date",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "end_date",
+					In:   "query",
+				}: params.EndDate,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountJettonHistoryByIDParams
+			Response = *AccountEvents
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountJettonHistoryByIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountJettonHistoryByID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountJettonHistoryByID(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountJettonHistoryByIDResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountJettonsBalancesRequest handles getAccountJettonsBalances operation.
+//
+// Get all Jettons balances by owner address.
+//
+// GET /v2/accounts/{account_id}/jettons
+func (s *Server) handleGetAccountJettonsBalancesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountJettonsBalances"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/jettons"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountJettonsBalances",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountJettonsBalances",
+			ID:   "getAccountJettonsBalances",
+		}
+	)
+	params, err := decodeGetAccountJettonsBalancesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *JettonsBalances
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountJettonsBalances",
+			OperationSummary: "",
+			OperationID:      "getAccountJettonsBalances",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "currencies",
+					In:   "query",
+				}: params.Currencies,
+				{
+					Name: "supported_extensions",
+					In:   "query",
+				}: params.SupportedExtensions,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountJettonsBalancesParams
+			Response = *JettonsBalances
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountJettonsBalancesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountJettonsBalances(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountJettonsBalances(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountJettonsBalancesResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountJettonsHistoryRequest handles getAccountJettonsHistory operation.
+//
+// Get the transfer jettons history for account.
+//
+// GET /v2/accounts/{account_id}/jettons/history
+func (s *Server) handleGetAccountJettonsHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountJettonsHistory"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/jettons/history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountJettonsHistory",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountJettonsHistory",
+			ID:   "getAccountJettonsHistory",
+		}
+	)
+	params, err := decodeGetAccountJettonsHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountEvents
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountJettonsHistory",
+			OperationSummary: "",
+			OperationID:      "getAccountJettonsHistory",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "start_date",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "end_date",
+					In:   "query",
+				}: params.EndDate,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountJettonsHistoryParams
+			Response = *AccountEvents
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountJettonsHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountJettonsHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountJettonsHistory(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountJettonsHistoryResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountMultisigsRequest handles getAccountMultisigs operation.
+//
+// Get account's multisigs.
+//
+// GET /v2/accounts/{account_id}/multisigs
+func (s *Server) handleGetAccountMultisigsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountMultisigs"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/multisigs"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountMultisigs",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountMultisigs",
+			ID:   "getAccountMultisigs",
+		}
+	)
+	params, err := decodeGetAccountMultisigsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Multisigs
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountMultisigs",
+			OperationSummary: "",
+			OperationID:      "getAccountMultisigs",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountMultisigsParams
+			Response = *Multisigs
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountMultisigsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountMultisigs(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountMultisigs(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountMultisigsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountNftHistoryRequest handles getAccountNftHistory operation.
+//
+// Get the transfer nft history.
+//
+// GET /v2/accounts/{account_id}/nfts/history
+func (s *Server) handleGetAccountNftHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountNftHistory"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/nfts/history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountNftHistory",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountNftHistory",
+			ID:   "getAccountNftHistory",
+		}
+	)
+	params, err := decodeGetAccountNftHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountEvents
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountNftHistory",
+			OperationSummary: "",
+			OperationID:      "getAccountNftHistory",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "start_date",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "end_date",
+					In:   "query",
+				}: params.EndDate,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountNftHistoryParams
+			Response = *AccountEvents
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountNftHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountNftHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountNftHistory(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountNftHistoryResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountNftItemsRequest handles getAccountNftItems operation.
+//
+// Get all NFT items by owner address.
+//
+// GET /v2/accounts/{account_id}/nfts
+func (s *Server) handleGetAccountNftItemsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountNftItems"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/nfts"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountNftItems",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountNftItems",
+			ID:   "getAccountNftItems",
+		}
+	)
+	params, err := decodeGetAccountNftItemsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *NftItems
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountNftItems",
+			OperationSummary: "",
+			OperationID:      "getAccountNftItems",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "collection",
+					In:   "query",
+				}: params.Collection,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "indirect_ownership",
+					In:   "query",
+				}: params.IndirectOwnership,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountNftItemsParams
+			Response = *NftItems
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountNftItemsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountNftItems(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountNftItems(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountNftItemsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountNominatorsPoolsRequest handles getAccountNominatorsPools operation.
+//
+// All pools where account participates.
+//
+// GET /v2/staking/nominator/{account_id}/pools
+func (s *Server) handleGetAccountNominatorsPoolsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountNominatorsPools"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/staking/nominator/{account_id}/pools"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountNominatorsPools",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountNominatorsPools",
+			ID:   "getAccountNominatorsPools",
+		}
+	)
+	params, err := decodeGetAccountNominatorsPoolsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountStaking
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountNominatorsPools",
+			OperationSummary: "",
+			OperationID:      "getAccountNominatorsPools",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountNominatorsPoolsParams
+			Response = *AccountStaking
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountNominatorsPoolsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountNominatorsPools(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountNominatorsPools(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountNominatorsPoolsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountPublicKeyRequest handles getAccountPublicKey operation.
+//
+// Get public key by account id.
+//
+// GET /v2/accounts/{account_id}/publickey
+func (s *Server) handleGetAccountPublicKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountPublicKey"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/publickey"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountPublicKey",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountPublicKey",
+			ID:   "getAccountPublicKey",
+		}
+	)
+	params, err := decodeGetAccountPublicKeyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *GetAccountPublicKeyOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountPublicKey",
+			OperationSummary: "",
+			OperationID:      "getAccountPublicKey",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountPublicKeyParams
+			Response = *GetAccountPublicKeyOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountPublicKeyParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountPublicKey(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountPublicKey(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountPublicKeyResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountSeqnoRequest handles getAccountSeqno operation.
+//
+// Get account seqno. Highload wallet v3 has no seqno, so its query ID capacity and timeout are
+// returned instead.
+//
+// GET /v2/wallet/{account_id}/seqno
+func (s *Server) handleGetAccountSeqnoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountSeqno"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/wallet/{account_id}/seqno"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountSeqno",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountSeqno",
+			ID:   "getAccountSeqno",
+		}
+	)
+	params, err := decodeGetAccountSeqnoParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Seqno
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountSeqno",
+			OperationSummary: "",
+			OperationID:      "getAccountSeqno",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountSeqnoParams
+			Response = *Seqno
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountSeqnoParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountSeqno(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountSeqno(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountSeqnoResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountStateDiffRequest handles getAccountStateDiff operation.
+//
+// Get the difference of account's state between two masterchain blocks, useful for audits and
+// debugging of an unexpected balance drift.
+//
+// GET /v2/accounts/{account_id}/state-diff
+func (s *Server) handleGetAccountStateDiffRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountStateDiff"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/state-diff"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountStateDiff",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountStateDiff",
+			ID:   "getAccountStateDiff",
+		}
+	)
+	params, err := decodeGetAccountStateDiffParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountStateDiff
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountStateDiff",
+			OperationSummary: "",
+			OperationID:      "getAccountStateDiff",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "from_seqno",
+					In:   "query",
+				}: params.FromSeqno,
+				{
+					Name: "to_seqno",
+					In:   "query",
+				}: params.ToSeqno,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountStateDiffParams
+			Response = *AccountStateDiff
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountStateDiffParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountStateDiff(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountStateDiff(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountStateDiffResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountStatsRequest handles getAccountStats operation.
+//
+// Get aggregated statistics of account's transactions. Available only for accounts tracked by the
+// indexer.
+//
+// GET /v2/accounts/{account_id}/stats
+func (s *Server) handleGetAccountStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountStats"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/stats"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountStats",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountStats",
+			ID:   "getAccountStats",
+		}
+	)
+	params, err := decodeGetAccountStatsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountStats
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountStats",
+			OperationSummary: "",
+			OperationID:      "getAccountStats",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "window",
+					In:   "query",
+				}: params.Window,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountStatsParams
+			Response = *AccountStats
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountStatsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountStats(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountStats(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountStatsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountStorageRentRequest handles getAccountStorageRent operation.
+//
+// Get account's storage fee debt, rent per day at the current storage prices and projected dates
+// when the account is frozen and deleted if its balance isn't topped up.
+//
+// GET /v2/accounts/{account_id}/storage-rent
+func (s *Server) handleGetAccountStorageRentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountStorageRent"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/storage-rent"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountStorageRent",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountStorageRent",
+			ID:   "getAccountStorageRent",
+		}
+	)
+	params, err := decodeGetAccountStorageRentParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountStorageRent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountStorageRent",
+			OperationSummary: "",
+			OperationID:      "getAccountStorageRent",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountStorageRentParams
+			Response = *AccountStorageRent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountStorageRentParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountStorageRent(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountStorageRent(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountStorageRentResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountSubscriptionsRequest handles getAccountSubscriptions operation.
+//
+// Get all subscriptions by wallet address.
+//
+// GET /v2/accounts/{account_id}/subscriptions
+func (s *Server) handleGetAccountSubscriptionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountSubscriptions"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/subscriptions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountSubscriptions",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountSubscriptions",
+			ID:   "getAccountSubscriptions",
+		}
+	)
+	params, err := decodeGetAccountSubscriptionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Subscriptions
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountSubscriptions",
+			OperationSummary: "",
+			OperationID:      "getAccountSubscriptions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountSubscriptionsParams
+			Response = *Subscriptions
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountSubscriptionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountSubscriptions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountSubscriptions(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountSubscriptionsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountTracesRequest handles getAccountTraces operation.
+//
+// Get traces for account.
+//
+// GET /v2/accounts/{account_id}/traces
+func (s *Server) handleGetAccountTracesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccountTraces"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/traces"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountTraces",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccountTraces",
+			ID:   "getAccountTraces",
+		}
+	)
+	params, err := decodeGetAccountTracesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *TraceIDs
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccountTraces",
+			OperationSummary: "",
+			OperationID:      "getAccountTraces",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAccountTracesParams
+			Response = *TraceIDs
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountTracesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccountTraces(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccountTraces(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountTracesResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAccountsRequest handles getAccounts operation.
+//
+// Get human-friendly information about several accounts without low-level details.
+//
+// POST /v2/accounts/_bulk
+func (s *Server) handleGetAccountsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAccounts"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/accounts/_bulk"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccounts",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAccounts",
+			ID:   "getAccounts",
+		}
+	)
+	params, err := decodeGetAccountsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeGetAccountsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *Accounts
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAccounts",
+			OperationSummary: "",
+			OperationID:      "getAccounts",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "currency",
+					In:   "query",
+				}: params.Currency,
+				{
+					Name: "partial",
+					In:   "query",
+				}: params.Partial,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = OptGetAccountsReq
+			Params   = GetAccountsParams
+			Response = *Accounts
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAccountsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAccounts(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAccounts(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAccountsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAllAuctionsRequest handles getAllAuctions operation.
+//
+// Get all auctions.
+//
+// GET /v2/dns/auctions
+func (s *Server) handleGetAllAuctionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAllAuctions"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/dns/auctions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAllAuctions",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAllAuctions",
+			ID:   "getAllAuctions",
+		}
+	)
+	params, err := decodeGetAllAuctionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Auctions
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAllAuctions",
+			OperationSummary: "",
+			OperationID:      "getAllAuctions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "tld",
+					In:   "query",
+				}: params.Tld,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAllAuctionsParams
+			Response = *Auctions
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAllAuctionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAllAuctions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAllAuctions(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAllAuctionsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAllRawShardsInfoRequest handles getAllRawShardsInfo operation.
+//
+// Get all raw shards info.
+//
+// GET /v2/liteserver/get_all_shards_info/{block_id}
+func (s *Server) handleGetAllRawShardsInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAllRawShardsInfo"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_all_shards_info/{block_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAllRawShardsInfo",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetAllRawShardsInfo",
+			ID:   "getAllRawShardsInfo",
+		}
+	)
+	params, err := decodeGetAllRawShardsInfoParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *GetAllRawShardsInfoOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetAllRawShardsInfo",
+			OperationSummary: "",
+			OperationID:      "getAllRawShardsInfo",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "block_id",
+					In:   "path",
+				}: params.BlockID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAllRawShardsInfoParams
+			Response = *GetAllRawShardsInfoOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAllRawShardsInfoParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAllRawShardsInfo(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAllRawShardsInfo(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetAllRawShardsInfoResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainAccountLogsRequest handles getBlockchainAccountLogs operation.
+//
+// Get external outbound messages emitted by the account, contracts often use such messages as event
+// logs.
+//
+// GET /v2/blockchain/accounts/{account_id}/logs
+func (s *Server) handleGetBlockchainAccountLogsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainAccountLogs"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}/logs"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainAccountLogs",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetBlockchainAccountLogs",
+			ID:   "getBlockchainAccountLogs",
+		}
+	)
+	params, err := decodeGetBlockchainAccountLogsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AccountLogs
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainAccountLogs",
+			OperationSummary: "",
+			OperationID:      "getBlockchainAccountLogs",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "operation",
+					In:   "query",
+				}: params.Operation,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBlockchainAccountLogsParams
+			Response = *AccountLogs
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBlockchainAccountLogsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBlockchainAccountLogs(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBlockchainAccountLogs(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetBlockchainAccountLogsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainAccountTransactionsRequest handles getBlockchainAccountTransactions operation.
+//
+// Get account transactions.
+//
+// GET /v2/blockchain/accounts/{account_id}/transactions
+func (s *Server) handleGetBlockchainAccountTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainAccountTransactions"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}/transactions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainAccountTransactions",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetBlockchainAccountTransactions",
+			ID:   "getBlockchainAccountTransactions",
+		}
+	)
+	params, err := decodeGetBlockchainAccountTransactionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Transactions
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainAccountTransactions",
+			OperationSummary: "",
+			OperationID:      "getBlockchainAccountTransactions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+				{
+					Name: "after_lt",
+					In:   "query",
+				}: params.AfterLt,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "sort_order",
+					In:   "query",
+				}: params.SortOrder,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBlockchainAccountTransactionsParams
+			Response = *Transactions
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBlockchainAccountTransactionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBlockchainAccountTransactions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBlockchainAccountTransactions(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetBlockchainAccountTransactionsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainBlockRequest handles getBlockchainBlock operation.
+//
+// Get blockchain block data.
+//
+// GET /v2/blockchain/blocks/{block_id}
+func (s *Server) handleGetBlockchainBlockRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainBlock"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/blocks/{block_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainBlock",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetBlockchainBlock",
+			ID:   "getBlockchainBlock",
+		}
+	)
+	params, err := decodeGetBlockchainBlockParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *BlockchainBlock
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainBlock",
+			OperationSummary: "",
+			OperationID:      "getBlockchainBlock",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "block_id",
+					In:   "path",
+				}: params.BlockID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBlockchainBlockParams
+			Response = *BlockchainBlock
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBlockchainBlockParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBlockchainBlock(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBlockchainBlock(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetBlockchainBlockResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainBlockTransactionsRequest handles getBlockchainBlockTransactions operation.
+//
+// Get transactions from block.
+//
+// GET /v2/blockchain/blocks/{block_id}/transactions
+func (s *Server) handleGetBlockchainBlockTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainBlockTransactions"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/blocks/{block_id}/transactions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainBlockTransactions",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetBlockchainBlockTransactions",
+			ID:   "getBlockchainBlockTransactions",
+		}
+	)
+	params, err := decodeGetBlockchainBlockTransactionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *Transactions
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainBlockTransactions",
+			OperationSummary: "",
+			OperationID:      "getBlockchainBlockTransactions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "block_id",
+					In:   "path",
+				}: params.BlockID,
+				{
+					Name: "type",
+					In:   "query",
+				}: params.Type,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBlockchainBlockTransactionsParams
+			Response = *Transactions
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBlockchainBlockTransactionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBlockchainBlockTransactions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBlockchainBlockTransactions(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetBlockchainBlockTransactionsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainConfigRequest handles getBlockchainConfig operation.
+//
+// Get blockchain config.
+//
+// GET /v2/blockchain/config
+func (s *Server) handleGetBlockchainConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainConfig"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/config"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainConfig",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response *BlockchainConfig
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainConfig",
+			OperationSummary: "",
+			OperationID:      "getBlockchainConfig",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *BlockchainConfig
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBlockchainConfig(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBlockchainConfig(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetBlockchainConfigResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainConfigContractRequest handles getBlockchainConfigContract operation.
+//
+// Get the state of the config contract with pending proposals to change the blockchain config.
+//
+// GET /v2/blockchain/system/config
+func (s *Server) handleGetBlockchainConfigContractRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainConfigContract"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/system/config"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainConfigContract",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response *ConfigContractState
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainConfigContract",
+			OperationSummary: "",
+			OperationID:      "getBlockchainConfigContract",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *ConfigContractState
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBlockchainConfigContract(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBlockchainConfigContract(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetBlockchainConfigContractResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainConfigFromBlockRequest handles getBlockchainConfigFromBlock operation.
+//
+// Get blockchain config from a specific block, if present.
+//
+// GET /v2/blockchain/masterchain/{masterchain_seqno}/config
+func (s *Server) handleGetBlockchainConfigFromBlockRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainConfigFromBlock"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/config"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainConfigFromBlock",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetBlockchainConfigFromBlock",
+			ID:   "getBlockchainConfigFromBlock",
+		}
+	)
+	params, err := decodeGetBlockchainConfigFromBlockParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *BlockchainConfig
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainConfigFromBlock",
+			OperationSummary: "",
+			OperationID:      "getBlockchainConfigFromBlock",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "masterchain_seqno",
+					In:   "path",
+				}: params.MasterchainSeqno,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBlockchainConfigFromBlockParams
+			Response = *BlockchainConfig
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBlockchainConfigFromBlockParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBlockchainConfigFromBlock(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBlockchainConfigFromBlock(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetBlockchainConfigFromBlockResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainElectorRequest handles getBlockchainElector operation.
+//
+// Get the state of the elector contract with the stakes of the current elections.
+//
+// GET /v2/blockchain/system/elector
+func (s *Server) handleGetBlockchainElectorRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainElector"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/system/elector"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainElector",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response *ElectorState
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainElector",
+			OperationSummary: "",
+			OperationID:      "getBlockchainElector",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *ElectorState
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBlockchainElector(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBlockchainElector(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetBlockchainElectorResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBlockchainFeesRequest handles getBlockchainFees operation.
+//
+// Get current gas, forward and storage prices of the blockchain config along with estimated costs of
+// typical operations.
+//
+// GET /v2/blockchain/fees
+func (s *Server) handleGetBlockchainFeesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBlockchainFees"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/fees"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainFees",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response *BlockchainFees
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBlockchainFees",
+			OperationSummary: "",
+			OperationID:      "getBlockchainFees",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *BlockchainFees
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2074,14 +7489,14 @@ func (s *Server) handleGetAccountEventRequest(args [2]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetAccountEventParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountEvent(ctx, params)
+				response, err = s.h.GetBlockchainFees(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountEvent(ctx, params)
+		response, err = s.h.GetBlockchainFees(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2100,7 +7515,7 @@ func (s *Server) handleGetAccountEventRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetAccountEventResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainFeesResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2109,24 +7524,22 @@ func (s *Server) handleGetAccountEventRequest(args [2]string, argsEscaped bool,
 	}
 }
 
-// handleGetAccountEventsRequest handles getAccountEvents operation.
+// handleGetBlockchainMasterchainBlocksRequest handles getBlockchainMasterchainBlocks operation.
 //
-// Get events for an account. Each event is built on top of a trace which is a series of transactions
-// caused by one inbound message. TonAPI looks for known patterns inside the trace and splits the
-// trace into actions, where a single action represents a meaningful high-level operation like a
-// Jetton Transfer or an NFT Purchase. Actions are expected to be shown to users. It is advised not
-// to build any logic on top of actions because actions can be changed at any time.
+// Get all blocks in all shards and workchains between target and previous masterchain block
+// according to shards last blocks snapshot in masterchain.  We don't recommend to build your app
+// around this method because it has problem with scalability and will work very slow in the future.
 //
-// GET /v2/accounts/{account_id}/events
-func (s *Server) handleGetAccountEventsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/masterchain/{masterchain_seqno}/blocks
+func (s *Server) handleGetBlockchainMasterchainBlocksRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountEvents"),
+		otelogen.OperationID("getBlockchainMasterchainBlocks"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/events"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/blocks"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountEvents",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMasterchainBlocks",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2151,11 +7564,11 @@ func (s *Server) handleGetAccountEventsRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountEvents",
-			ID:   "getAccountEvents",
+			Name: "GetBlockchainMasterchainBlocks",
+			ID:   "getBlockchainMasterchainBlocks",
 		}
 	)
-	params, err := decodeGetAccountEventsParams(args, argsEscaped, r)
+	params, err := decodeGetBlockchainMasterchainBlocksParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2166,55 +7579,27 @@ func (s *Server) handleGetAccountEventsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *AccountEvents
+	var response *BlockchainBlocks
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountEvents",
+			OperationName:    "GetBlockchainMasterchainBlocks",
 			OperationSummary: "",
-			OperationID:      "getAccountEvents",
+			OperationID:      "getBlockchainMasterchainBlocks",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "masterchain_seqno",
 					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
-				{
-					Name: "initiator",
-					In:   "query",
-				}: params.Initiator,
-				{
-					Name: "subject_only",
-					In:   "query",
-				}: params.SubjectOnly,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "start_date",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "end_date",
-					In:   "query",
-				}: params.EndDate,
+				}: params.MasterchainSeqno,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountEventsParams
-			Response = *AccountEvents
+			Params   = GetBlockchainMasterchainBlocksParams
+			Response = *BlockchainBlocks
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2223,14 +7608,14 @@ func (s *Server) handleGetAccountEventsRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetAccountEventsParams,
+			unpackGetBlockchainMasterchainBlocksParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountEvents(ctx, params)
+				response, err = s.h.GetBlockchainMasterchainBlocks(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountEvents(ctx, params)
+		response, err = s.h.GetBlockchainMasterchainBlocks(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2249,7 +7634,7 @@ func (s *Server) handleGetAccountEventsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetAccountEventsResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainMasterchainBlocksResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2258,20 +7643,20 @@ func (s *Server) handleGetAccountEventsRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetAccountInfoByStateInitRequest handles getAccountInfoByStateInit operation.
+// handleGetBlockchainMasterchainHeadRequest handles getBlockchainMasterchainHead operation.
 //
-// Get account info by state init.
+// Get last known masterchain block.
 //
-// POST /v2/tonconnect/stateinit
-func (s *Server) handleGetAccountInfoByStateInitRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/masterchain-head
+func (s *Server) handleGetBlockchainMasterchainHeadRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountInfoByStateInit"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/tonconnect/stateinit"),
+		otelogen.OperationID("getBlockchainMasterchainHead"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain-head"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountInfoByStateInit",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMasterchainHead",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2294,44 +7679,25 @@ func (s *Server) handleGetAccountInfoByStateInitRequest(args [0]string, argsEsca
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountInfoByStateInit",
-			ID:   "getAccountInfoByStateInit",
-		}
+		err error
 	)
-	request, close, err := s.decodeGetAccountInfoByStateInitRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *AccountInfoByStateInit
+	var response *BlockchainBlock
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountInfoByStateInit",
+			OperationName:    "GetBlockchainMasterchainHead",
 			OperationSummary: "",
-			OperationID:      "getAccountInfoByStateInit",
-			Body:             request,
+			OperationID:      "getBlockchainMasterchainHead",
+			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetAccountInfoByStateInitReq
+			Request  = struct{}
 			Params   = struct{}
-			Response = *AccountInfoByStateInit
+			Response = *BlockchainBlock
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2342,12 +7708,12 @@ func (s *Server) handleGetAccountInfoByStateInitRequest(args [0]string, argsEsca
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountInfoByStateInit(ctx, request)
+				response, err = s.h.GetBlockchainMasterchainHead(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountInfoByStateInit(ctx, request)
+		response, err = s.h.GetBlockchainMasterchainHead(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2366,7 +7732,7 @@ func (s *Server) handleGetAccountInfoByStateInitRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeGetAccountInfoByStateInitResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainMasterchainHeadResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2375,20 +7741,20 @@ func (s *Server) handleGetAccountInfoByStateInitRequest(args [0]string, argsEsca
 	}
 }
 
-// handleGetAccountInscriptionsRequest handles getAccountInscriptions operation.
+// handleGetBlockchainMasterchainShardsRequest handles getBlockchainMasterchainShards operation.
 //
-// Get all inscriptions by owner address. It's experimental API and can be dropped in the future.
+// Get blockchain block shards.
 //
-// GET /v2/experimental/accounts/{account_id}/inscriptions
-func (s *Server) handleGetAccountInscriptionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/masterchain/{masterchain_seqno}/shards
+func (s *Server) handleGetBlockchainMasterchainShardsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountInscriptions"),
+		otelogen.OperationID("getBlockchainMasterchainShards"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/experimental/accounts/{account_id}/inscriptions"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/shards"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountInscriptions",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMasterchainShards",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2413,11 +7779,11 @@ func (s *Server) handleGetAccountInscriptionsRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountInscriptions",
-			ID:   "getAccountInscriptions",
+			Name: "GetBlockchainMasterchainShards",
+			ID:   "getBlockchainMasterchainShards",
 		}
 	)
-	params, err := decodeGetAccountInscriptionsParams(args, argsEscaped, r)
+	params, err := decodeGetBlockchainMasterchainShardsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2428,35 +7794,27 @@ func (s *Server) handleGetAccountInscriptionsRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response *InscriptionBalances
+	var response *BlockchainBlockShards
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountInscriptions",
+			OperationName:    "GetBlockchainMasterchainShards",
 			OperationSummary: "",
-			OperationID:      "getAccountInscriptions",
+			OperationID:      "getBlockchainMasterchainShards",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "masterchain_seqno",
 					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+				}: params.MasterchainSeqno,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountInscriptionsParams
-			Response = *InscriptionBalances
+			Params   = GetBlockchainMasterchainShardsParams
+			Response = *BlockchainBlockShards
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2465,14 +7823,14 @@ func (s *Server) handleGetAccountInscriptionsRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetAccountInscriptionsParams,
+			unpackGetBlockchainMasterchainShardsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountInscriptions(ctx, params)
+				response, err = s.h.GetBlockchainMasterchainShards(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountInscriptions(ctx, params)
+		response, err = s.h.GetBlockchainMasterchainShards(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2491,7 +7849,7 @@ func (s *Server) handleGetAccountInscriptionsRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetAccountInscriptionsResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainMasterchainShardsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2500,21 +7858,22 @@ func (s *Server) handleGetAccountInscriptionsRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetAccountInscriptionsHistoryRequest handles getAccountInscriptionsHistory operation.
+// handleGetBlockchainMasterchainTransactionsRequest handles getBlockchainMasterchainTransactions operation.
 //
-// Get the transfer inscriptions history for account. It's experimental API and can be dropped in the
-// future.
+// Get all transactions in all shards and workchains between target and previous masterchain block
+// according to shards last blocks snapshot in masterchain. We don't recommend to build your app
+// around this method because it has problem with scalability and will work very slow in the future.
 //
-// GET /v2/experimental/accounts/{account_id}/inscriptions/history
-func (s *Server) handleGetAccountInscriptionsHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/masterchain/{masterchain_seqno}/transactions
+func (s *Server) handleGetBlockchainMasterchainTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountInscriptionsHistory"),
+		otelogen.OperationID("getBlockchainMasterchainTransactions"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/experimental/accounts/{account_id}/inscriptions/history"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/transactions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountInscriptionsHistory",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMasterchainTransactions",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2539,11 +7898,11 @@ func (s *Server) handleGetAccountInscriptionsHistoryRequest(args [1]string, args
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountInscriptionsHistory",
-			ID:   "getAccountInscriptionsHistory",
+			Name: "GetBlockchainMasterchainTransactions",
+			ID:   "getBlockchainMasterchainTransactions",
 		}
 	)
-	params, err := decodeGetAccountInscriptionsHistoryParams(args, argsEscaped, r)
+	params, err := decodeGetBlockchainMasterchainTransactionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2554,39 +7913,31 @@ func (s *Server) handleGetAccountInscriptionsHistoryRequest(args [1]string, args
 		return
 	}
 
-	var response *AccountEvents
+	var response *Transactions
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountInscriptionsHistory",
+			OperationName:    "GetBlockchainMasterchainTransactions",
 			OperationSummary: "",
-			OperationID:      "getAccountInscriptionsHistory",
+			OperationID:      "getBlockchainMasterchainTransactions",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "masterchain_seqno",
 					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
+				}: params.MasterchainSeqno,
 				{
-					Name: "limit",
+					Name: "type",
 					In:   "query",
-				}: params.Limit,
+				}: params.Type,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountInscriptionsHistoryParams
-			Response = *AccountEvents
+			Params   = GetBlockchainMasterchainTransactionsParams
+			Response = *Transactions
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2595,14 +7946,14 @@ func (s *Server) handleGetAccountInscriptionsHistoryRequest(args [1]string, args
 		](
 			m,
 			mreq,
-			unpackGetAccountInscriptionsHistoryParams,
+			unpackGetBlockchainMasterchainTransactionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountInscriptionsHistory(ctx, params)
+				response, err = s.h.GetBlockchainMasterchainTransactions(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountInscriptionsHistory(ctx, params)
+		response, err = s.h.GetBlockchainMasterchainTransactions(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2621,7 +7972,7 @@ func (s *Server) handleGetAccountInscriptionsHistoryRequest(args [1]string, args
 		return
 	}
 
-	if err := encodeGetAccountInscriptionsHistoryResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainMasterchainTransactionsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2630,21 +7981,20 @@ func (s *Server) handleGetAccountInscriptionsHistoryRequest(args [1]string, args
 	}
 }
 
-// handleGetAccountInscriptionsHistoryByTickerRequest handles getAccountInscriptionsHistoryByTicker operation.
+// handleGetBlockchainMinterRequest handles getBlockchainMinter operation.
 //
-// Get the transfer inscriptions history for account. It's experimental API and can be dropped in the
-// future.
+// Get the state of the minter contract along with the total supply of TON.
 //
-// GET /v2/experimental/accounts/{account_id}/inscriptions/{ticker}/history
-func (s *Server) handleGetAccountInscriptionsHistoryByTickerRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/system/minter
+func (s *Server) handleGetBlockchainMinterRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountInscriptionsHistoryByTicker"),
+		otelogen.OperationID("getBlockchainMinter"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/experimental/accounts/{account_id}/inscriptions/{ticker}/history"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/system/minter"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountInscriptionsHistoryByTicker",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMinter",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2667,60 +8017,25 @@ func (s *Server) handleGetAccountInscriptionsHistoryByTickerRequest(args [2]stri
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountInscriptionsHistoryByTicker",
-			ID:   "getAccountInscriptionsHistoryByTicker",
-		}
+		err error
 	)
-	params, err := decodeGetAccountInscriptionsHistoryByTickerParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *AccountEvents
+	var response *MinterState
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountInscriptionsHistoryByTicker",
+			OperationName:    "GetBlockchainMinter",
 			OperationSummary: "",
-			OperationID:      "getAccountInscriptionsHistoryByTicker",
+			OperationID:      "getBlockchainMinter",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
-				{
-					Name: "ticker",
-					In:   "path",
-				}: params.Ticker,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountInscriptionsHistoryByTickerParams
-			Response = *AccountEvents
+			Params   = struct{}
+			Response = *MinterState
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2729,14 +8044,14 @@ func (s *Server) handleGetAccountInscriptionsHistoryByTickerRequest(args [2]stri
 		](
 			m,
 			mreq,
-			unpackGetAccountInscriptionsHistoryByTickerParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountInscriptionsHistoryByTicker(ctx, params)
+				response, err = s.h.GetBlockchainMinter(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountInscriptionsHistoryByTicker(ctx, params)
+		response, err = s.h.GetBlockchainMinter(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2755,7 +8070,7 @@ func (s *Server) handleGetAccountInscriptionsHistoryByTickerRequest(args [2]stri
 		return
 	}
 
-	if err := encodeGetAccountInscriptionsHistoryByTickerResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainMinterResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2764,20 +8079,20 @@ func (s *Server) handleGetAccountInscriptionsHistoryByTickerRequest(args [2]stri
 	}
 }
 
-// handleGetAccountJettonBalanceRequest handles getAccountJettonBalance operation.
+// handleGetBlockchainRawAccountRequest handles getBlockchainRawAccount operation.
 //
-// Get Jetton balance by owner address.
+// Get low-level information about an account taken directly from the blockchain.
 //
-// GET /v2/accounts/{account_id}/jettons/{jetton_id}
-func (s *Server) handleGetAccountJettonBalanceRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/accounts/{account_id}
+func (s *Server) handleGetBlockchainRawAccountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountJettonBalance"),
+		otelogen.OperationID("getBlockchainRawAccount"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/jettons/{jetton_id}"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountJettonBalance",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainRawAccount",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2802,11 +8117,11 @@ func (s *Server) handleGetAccountJettonBalanceRequest(args [2]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountJettonBalance",
-			ID:   "getAccountJettonBalance",
+			Name: "GetBlockchainRawAccount",
+			ID:   "getBlockchainRawAccount",
 		}
 	)
-	params, err := decodeGetAccountJettonBalanceParams(args, argsEscaped, r)
+	params, err := decodeGetBlockchainRawAccountParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2817,39 +8132,27 @@ func (s *Server) handleGetAccountJettonBalanceRequest(args [2]string, argsEscape
 		return
 	}
 
-	var response *JettonBalance
+	var response *BlockchainRawAccount
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountJettonBalance",
+			OperationName:    "GetBlockchainRawAccount",
 			OperationSummary: "",
-			OperationID:      "getAccountJettonBalance",
+			OperationID:      "getBlockchainRawAccount",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "account_id",
 					In:   "path",
 				}: params.AccountID,
-				{
-					Name: "jetton_id",
-					In:   "path",
-				}: params.JettonID,
-				{
-					Name: "currencies",
-					In:   "query",
-				}: params.Currencies,
-				{
-					Name: "supported_extensions",
-					In:   "query",
-				}: params.SupportedExtensions,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountJettonBalanceParams
-			Response = *JettonBalance
+			Params   = GetBlockchainRawAccountParams
+			Response = *BlockchainRawAccount
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2858,14 +8161,14 @@ func (s *Server) handleGetAccountJettonBalanceRequest(args [2]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackGetAccountJettonBalanceParams,
+			unpackGetBlockchainRawAccountParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountJettonBalance(ctx, params)
+				response, err = s.h.GetBlockchainRawAccount(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountJettonBalance(ctx, params)
+		response, err = s.h.GetBlockchainRawAccount(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2884,7 +8187,7 @@ func (s *Server) handleGetAccountJettonBalanceRequest(args [2]string, argsEscape
 		return
 	}
 
-	if err := encodeGetAccountJettonBalanceResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainRawAccountResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2893,20 +8196,20 @@ func (s *Server) handleGetAccountJettonBalanceRequest(args [2]string, argsEscape
 	}
 }
 
-// handleGetAccountJettonHistoryByIDRequest handles getAccountJettonHistoryByID operation.
+// handleGetBlockchainTransactionRequest handles getBlockchainTransaction operation.
 //
-// Get the transfer jetton history for account and jetton.
+// Get transaction data.
 //
-// GET /v2/accounts/{account_id}/jettons/{jetton_id}/history
-func (s *Server) handleGetAccountJettonHistoryByIDRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/transactions/{transaction_id}
+func (s *Server) handleGetBlockchainTransactionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountJettonHistoryByID"),
+		otelogen.OperationID("getBlockchainTransaction"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/jettons/{jetton_id}/history"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/transactions/{transaction_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountJettonHistoryByID",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainTransaction",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2931,11 +8234,11 @@ func (s *Server) handleGetAccountJettonHistoryByIDRequest(args [2]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountJettonHistoryByID",
-			ID:   "getAccountJettonHistoryByID",
+			Name: "GetBlockchainTransaction",
+			ID:   "getBlockchainTransaction",
 		}
 	)
-	params, err := decodeGetAccountJettonHistoryByIDParams(args, argsEscaped, r)
+	params, err := decodeGetBlockchainTransactionParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2946,51 +8249,27 @@ func (s *Server) handleGetAccountJettonHistoryByIDRequest(args [2]string, argsEs
 		return
 	}
 
-	var response *AccountEvents
+	var response *Transaction
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountJettonHistoryByID",
+			OperationName:    "GetBlockchainTransaction",
 			OperationSummary: "",
-			OperationID:      "getAccountJettonHistoryByID",
+			OperationID:      "getBlockchainTransaction",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "jetton_id",
+					Name: "transaction_id",
 					In:   "path",
-				}: params.JettonID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "start_date",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "end_date",
-					In:   "query",
-				}: params.EndDate,
+				}: params.TransactionID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountJettonHistoryByIDParams
-			Response = *AccountEvents
+			Params   = GetBlockchainTransactionParams
+			Response = *Transaction
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2999,14 +8278,14 @@ func (s *Server) handleGetAccountJettonHistoryByIDRequest(args [2]string, argsEs
 		](
 			m,
 			mreq,
-			unpackGetAccountJettonHistoryByIDParams,
+			unpackGetBlockchainTransactionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountJettonHistoryByID(ctx, params)
+				response, err = s.h.GetBlockchainTransaction(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountJettonHistoryByID(ctx, params)
+		response, err = s.h.GetBlockchainTransaction(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3025,7 +8304,7 @@ func (s *Server) handleGetAccountJettonHistoryByIDRequest(args [2]string, argsEs
 		return
 	}
 
-	if err := encodeGetAccountJettonHistoryByIDResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainTransactionResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3034,20 +8313,20 @@ func (s *Server) handleGetAccountJettonHistoryByIDRequest(args [2]string, argsEs
 	}
 }
 
-// handleGetAccountJettonsBalancesRequest handles getAccountJettonsBalances operation.
+// handleGetBlockchainTransactionByMessageHashRequest handles getBlockchainTransactionByMessageHash operation.
 //
-// Get all Jettons balances by owner address.
+// Get transaction data by message hash.
 //
-// GET /v2/accounts/{account_id}/jettons
-func (s *Server) handleGetAccountJettonsBalancesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/messages/{msg_id}/transaction
+func (s *Server) handleGetBlockchainTransactionByMessageHashRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountJettonsBalances"),
+		otelogen.OperationID("getBlockchainTransactionByMessageHash"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/jettons"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/messages/{msg_id}/transaction"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountJettonsBalances",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainTransactionByMessageHash",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3072,11 +8351,11 @@ func (s *Server) handleGetAccountJettonsBalancesRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountJettonsBalances",
-			ID:   "getAccountJettonsBalances",
+			Name: "GetBlockchainTransactionByMessageHash",
+			ID:   "getBlockchainTransactionByMessageHash",
 		}
 	)
-	params, err := decodeGetAccountJettonsBalancesParams(args, argsEscaped, r)
+	params, err := decodeGetBlockchainTransactionByMessageHashParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3087,35 +8366,27 @@ func (s *Server) handleGetAccountJettonsBalancesRequest(args [1]string, argsEsca
 		return
 	}
 
-	var response *JettonsBalances
+	var response *Transaction
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountJettonsBalances",
+			OperationName:    "GetBlockchainTransactionByMessageHash",
 			OperationSummary: "",
-			OperationID:      "getAccountJettonsBalances",
+			OperationID:      "getBlockchainTransactionByMessageHash",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "msg_id",
 					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "currencies",
-					In:   "query",
-				}: params.Currencies,
-				{
-					Name: "supported_extensions",
-					In:   "query",
-				}: params.SupportedExtensions,
+				}: params.MsgID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountJettonsBalancesParams
-			Response = *JettonsBalances
+			Params   = GetBlockchainTransactionByMessageHashParams
+			Response = *Transaction
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3124,14 +8395,14 @@ func (s *Server) handleGetAccountJettonsBalancesRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetAccountJettonsBalancesParams,
+			unpackGetBlockchainTransactionByMessageHashParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountJettonsBalances(ctx, params)
+				response, err = s.h.GetBlockchainTransactionByMessageHash(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountJettonsBalances(ctx, params)
+		response, err = s.h.GetBlockchainTransactionByMessageHash(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3150,7 +8421,7 @@ func (s *Server) handleGetAccountJettonsBalancesRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeGetAccountJettonsBalancesResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainTransactionByMessageHashResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3159,20 +8430,20 @@ func (s *Server) handleGetAccountJettonsBalancesRequest(args [1]string, argsEsca
 	}
 }
 
-// handleGetAccountJettonsHistoryRequest handles getAccountJettonsHistory operation.
+// handleGetBlockchainValidatorsRequest handles getBlockchainValidators operation.
 //
-// Get the transfer jettons history for account.
+// Get blockchain validators.
 //
-// GET /v2/accounts/{account_id}/jettons/history
-func (s *Server) handleGetAccountJettonsHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/validators
+func (s *Server) handleGetBlockchainValidatorsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountJettonsHistory"),
+		otelogen.OperationID("getBlockchainValidators"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/jettons/history"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/validators"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountJettonsHistory",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainValidators",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3193,66 +8464,27 @@ func (s *Server) handleGetAccountJettonsHistoryRequest(args [1]string, argsEscap
 		recordError = func(stage string, err error) {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountJettonsHistory",
-			ID:   "getAccountJettonsHistory",
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		err error
 	)
-	params, err := decodeGetAccountJettonsHistoryParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *AccountEvents
+	var response *Validators
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountJettonsHistory",
+			OperationName:    "GetBlockchainValidators",
 			OperationSummary: "",
-			OperationID:      "getAccountJettonsHistory",
+			OperationID:      "getBlockchainValidators",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "start_date",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "end_date",
-					In:   "query",
-				}: params.EndDate,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountJettonsHistoryParams
-			Response = *AccountEvents
+			Params   = struct{}
+			Response = *Validators
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3261,14 +8493,14 @@ func (s *Server) handleGetAccountJettonsHistoryRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackGetAccountJettonsHistoryParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountJettonsHistory(ctx, params)
+				response, err = s.h.GetBlockchainValidators(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountJettonsHistory(ctx, params)
+		response, err = s.h.GetBlockchainValidators(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3287,7 +8519,7 @@ func (s *Server) handleGetAccountJettonsHistoryRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeGetAccountJettonsHistoryResponse(response, w, span); err != nil {
+	if err := encodeGetBlockchainValidatorsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3296,20 +8528,20 @@ func (s *Server) handleGetAccountJettonsHistoryRequest(args [1]string, argsEscap
 	}
 }
 
-// handleGetAccountMultisigsRequest handles getAccountMultisigs operation.
+// handleGetBocHashRequest handles getBocHash operation.
 //
-// Get account's multisigs.
+// Compute representation hashes of all roots of a bag-of-cells.
 //
-// GET /v2/accounts/{account_id}/multisigs
-func (s *Server) handleGetAccountMultisigsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/boc/hash
+func (s *Server) handleGetBocHashRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountMultisigs"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/multisigs"),
+		otelogen.OperationID("getBocHash"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/boc/hash"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountMultisigs",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBocHash",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3334,42 +8566,42 @@ func (s *Server) handleGetAccountMultisigsRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountMultisigs",
-			ID:   "getAccountMultisigs",
+			Name: "GetBocHash",
+			ID:   "getBocHash",
 		}
 	)
-	params, err := decodeGetAccountMultisigsParams(args, argsEscaped, r)
+	request, close, err := s.decodeGetBocHashRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *Multisigs
+	var response *BocHashes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountMultisigs",
+			OperationName:    "GetBocHash",
 			OperationSummary: "",
-			OperationID:      "getAccountMultisigs",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-			},
-			Raw: r,
+			OperationID:      "getBocHash",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetAccountMultisigsParams
-			Response = *Multisigs
+			Request  = *GetBocHashReq
+			Params   = struct{}
+			Response = *BocHashes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3378,14 +8610,14 @@ func (s *Server) handleGetAccountMultisigsRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetAccountMultisigsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountMultisigs(ctx, params)
+				response, err = s.h.GetBocHash(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountMultisigs(ctx, params)
+		response, err = s.h.GetBocHash(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3404,7 +8636,7 @@ func (s *Server) handleGetAccountMultisigsRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetAccountMultisigsResponse(response, w, span); err != nil {
+	if err := encodeGetBocHashResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3413,20 +8645,21 @@ func (s *Server) handleGetAccountMultisigsRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetAccountNftHistoryRequest handles getAccountNftHistory operation.
+// handleGetChainCalendarRequest handles getChainCalendar operation.
 //
-// Get the transfer nft history.
+// Get upcoming events of the blockchain the server can compute in advance, like elections,
+// validation round ends, stake unfreezes and vesting unlocks of tracked lockup wallets.
 //
-// GET /v2/accounts/{account_id}/nfts/history
-func (s *Server) handleGetAccountNftHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/calendar
+func (s *Server) handleGetChainCalendarRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountNftHistory"),
+		otelogen.OperationID("getChainCalendar"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/nfts/history"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/calendar"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountNftHistory",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetChainCalendar",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3451,11 +8684,11 @@ func (s *Server) handleGetAccountNftHistoryRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountNftHistory",
-			ID:   "getAccountNftHistory",
+			Name: "GetChainCalendar",
+			ID:   "getChainCalendar",
 		}
 	)
-	params, err := decodeGetAccountNftHistoryParams(args, argsEscaped, r)
+	params, err := decodeGetChainCalendarParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3466,47 +8699,27 @@ func (s *Server) handleGetAccountNftHistoryRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	var response *AccountEvents
+	var response *ChainCalendar
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountNftHistory",
+			OperationName:    "GetChainCalendar",
 			OperationSummary: "",
-			OperationID:      "getAccountNftHistory",
+			OperationID:      "getChainCalendar",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "start_date",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "end_date",
+					Name: "period",
 					In:   "query",
-				}: params.EndDate,
+				}: params.Period,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountNftHistoryParams
-			Response = *AccountEvents
+			Params   = GetChainCalendarParams
+			Response = *ChainCalendar
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3515,14 +8728,14 @@ func (s *Server) handleGetAccountNftHistoryRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetAccountNftHistoryParams,
+			unpackGetChainCalendarParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountNftHistory(ctx, params)
+				response, err = s.h.GetChainCalendar(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountNftHistory(ctx, params)
+		response, err = s.h.GetChainCalendar(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3541,7 +8754,7 @@ func (s *Server) handleGetAccountNftHistoryRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetAccountNftHistoryResponse(response, w, span); err != nil {
+	if err := encodeGetChainCalendarResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3550,20 +8763,20 @@ func (s *Server) handleGetAccountNftHistoryRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetAccountNftItemsRequest handles getAccountNftItems operation.
+// handleGetChartRatesRequest handles getChartRates operation.
 //
-// Get all NFT items by owner address.
+// Get chart by token.
 //
-// GET /v2/accounts/{account_id}/nfts
-func (s *Server) handleGetAccountNftItemsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/rates/chart
+func (s *Server) handleGetChartRatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountNftItems"),
+		otelogen.OperationID("getChartRates"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/nfts"),
+		semconv.HTTPRouteKey.String("/v2/rates/chart"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountNftItems",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetChartRates",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3588,11 +8801,11 @@ func (s *Server) handleGetAccountNftItemsRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountNftItems",
-			ID:   "getAccountNftItems",
+			Name: "GetChartRates",
+			ID:   "getChartRates",
 		}
 	)
-	params, err := decodeGetAccountNftItemsParams(args, argsEscaped, r)
+	params, err := decodeGetChartRatesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3603,43 +8816,43 @@ func (s *Server) handleGetAccountNftItemsRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response *NftItems
+	var response *GetChartRatesOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountNftItems",
+			OperationName:    "GetChartRates",
 			OperationSummary: "",
-			OperationID:      "getAccountNftItems",
+			OperationID:      "getChartRates",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
+					Name: "token",
+					In:   "query",
+				}: params.Token,
 				{
-					Name: "collection",
+					Name: "currency",
 					In:   "query",
-				}: params.Collection,
+				}: params.Currency,
 				{
-					Name: "limit",
+					Name: "start_date",
 					In:   "query",
-				}: params.Limit,
+				}: params.StartDate,
 				{
-					Name: "offset",
+					Name: "end_date",
 					In:   "query",
-				}: params.Offset,
+				}: params.EndDate,
 				{
-					Name: "indirect_ownership",
+					Name: "points_count",
 					In:   "query",
-				}: params.IndirectOwnership,
+				}: params.PointsCount,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountNftItemsParams
-			Response = *NftItems
+			Params   = GetChartRatesParams
+			Response = *GetChartRatesOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3648,14 +8861,14 @@ func (s *Server) handleGetAccountNftItemsRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetAccountNftItemsParams,
+			unpackGetChartRatesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountNftItems(ctx, params)
+				response, err = s.h.GetChartRates(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountNftItems(ctx, params)
+		response, err = s.h.GetChartRates(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3674,7 +8887,7 @@ func (s *Server) handleGetAccountNftItemsRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetAccountNftItemsResponse(response, w, span); err != nil {
+	if err := encodeGetChartRatesResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3683,20 +8896,20 @@ func (s *Server) handleGetAccountNftItemsRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetAccountNominatorsPoolsRequest handles getAccountNominatorsPools operation.
+// handleGetConfigProposalsRequest handles getConfigProposals operation.
 //
-// All pools where account participates.
+// Get active proposals to change the blockchain config along with the voting status.
 //
-// GET /v2/staking/nominator/{account_id}/pools
-func (s *Server) handleGetAccountNominatorsPoolsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/config/proposals
+func (s *Server) handleGetConfigProposalsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountNominatorsPools"),
+		otelogen.OperationID("getConfigProposals"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/staking/nominator/{account_id}/pools"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/config/proposals"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountNominatorsPools",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetConfigProposals",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3719,44 +8932,25 @@ func (s *Server) handleGetAccountNominatorsPoolsRequest(args [1]string, argsEsca
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountNominatorsPools",
-			ID:   "getAccountNominatorsPools",
-		}
+		err error
 	)
-	params, err := decodeGetAccountNominatorsPoolsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *AccountStaking
+	var response *ConfigProposals
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountNominatorsPools",
+			OperationName:    "GetConfigProposals",
 			OperationSummary: "",
-			OperationID:      "getAccountNominatorsPools",
+			OperationID:      "getConfigProposals",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountNominatorsPoolsParams
-			Response = *AccountStaking
+			Params   = struct{}
+			Response = *ConfigProposals
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3765,14 +8959,14 @@ func (s *Server) handleGetAccountNominatorsPoolsRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetAccountNominatorsPoolsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountNominatorsPools(ctx, params)
+				response, err = s.h.GetConfigProposals(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountNominatorsPools(ctx, params)
+		response, err = s.h.GetConfigProposals(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3791,7 +8985,7 @@ func (s *Server) handleGetAccountNominatorsPoolsRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeGetAccountNominatorsPoolsResponse(response, w, span); err != nil {
+	if err := encodeGetConfigProposalsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3800,20 +8994,20 @@ func (s *Server) handleGetAccountNominatorsPoolsRequest(args [1]string, argsEsca
 	}
 }
 
-// handleGetAccountPublicKeyRequest handles getAccountPublicKey operation.
-//
-// Get public key by account id.
+// handleGetDnsInfoRequest handles getDnsInfo operation.
 //
-// GET /v2/accounts/{account_id}/publickey
-func (s *Server) handleGetAccountPublicKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Get full information about domain name.
+//
+// GET /v2/dns/{domain_name}
+func (s *Server) handleGetDnsInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountPublicKey"),
+		otelogen.OperationID("getDnsInfo"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/publickey"),
+		semconv.HTTPRouteKey.String("/v2/dns/{domain_name}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountPublicKey",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetDnsInfo",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3838,11 +9032,11 @@ func (s *Server) handleGetAccountPublicKeyRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountPublicKey",
-			ID:   "getAccountPublicKey",
+			Name: "GetDnsInfo",
+			ID:   "getDnsInfo",
 		}
 	)
-	params, err := decodeGetAccountPublicKeyParams(args, argsEscaped, r)
+	params, err := decodeGetDnsInfoParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3853,27 +9047,27 @@ func (s *Server) handleGetAccountPublicKeyRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response *GetAccountPublicKeyOK
+	var response *DomainInfo
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountPublicKey",
+			OperationName:    "GetDnsInfo",
 			OperationSummary: "",
-			OperationID:      "getAccountPublicKey",
+			OperationID:      "getDnsInfo",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "domain_name",
 					In:   "path",
-				}: params.AccountID,
+				}: params.DomainName,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountPublicKeyParams
-			Response = *GetAccountPublicKeyOK
+			Params   = GetDnsInfoParams
+			Response = *DomainInfo
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3882,14 +9076,14 @@ func (s *Server) handleGetAccountPublicKeyRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetAccountPublicKeyParams,
+			unpackGetDnsInfoParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountPublicKey(ctx, params)
+				response, err = s.h.GetDnsInfo(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountPublicKey(ctx, params)
+		response, err = s.h.GetDnsInfo(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3908,7 +9102,7 @@ func (s *Server) handleGetAccountPublicKeyRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetAccountPublicKeyResponse(response, w, span); err != nil {
+	if err := encodeGetDnsInfoResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3917,20 +9111,20 @@ func (s *Server) handleGetAccountPublicKeyRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetAccountSeqnoRequest handles getAccountSeqno operation.
+// handleGetDomainBidsRequest handles getDomainBids operation.
 //
-// Get account seqno.
+// Get domain bids.
 //
-// GET /v2/wallet/{account_id}/seqno
-func (s *Server) handleGetAccountSeqnoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/dns/{domain_name}/bids
+func (s *Server) handleGetDomainBidsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountSeqno"),
+		otelogen.OperationID("getDomainBids"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/wallet/{account_id}/seqno"),
+		semconv.HTTPRouteKey.String("/v2/dns/{domain_name}/bids"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountSeqno",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetDomainBids",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3955,11 +9149,11 @@ func (s *Server) handleGetAccountSeqnoRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountSeqno",
-			ID:   "getAccountSeqno",
+			Name: "GetDomainBids",
+			ID:   "getDomainBids",
 		}
 	)
-	params, err := decodeGetAccountSeqnoParams(args, argsEscaped, r)
+	params, err := decodeGetDomainBidsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3970,27 +9164,27 @@ func (s *Server) handleGetAccountSeqnoRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *Seqno
+	var response *DomainBids
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountSeqno",
+			OperationName:    "GetDomainBids",
 			OperationSummary: "",
-			OperationID:      "getAccountSeqno",
+			OperationID:      "getDomainBids",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "domain_name",
 					In:   "path",
-				}: params.AccountID,
+				}: params.DomainName,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountSeqnoParams
-			Response = *Seqno
+			Params   = GetDomainBidsParams
+			Response = *DomainBids
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3999,14 +9193,14 @@ func (s *Server) handleGetAccountSeqnoRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetAccountSeqnoParams,
+			unpackGetDomainBidsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountSeqno(ctx, params)
+				response, err = s.h.GetDomainBids(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountSeqno(ctx, params)
+		response, err = s.h.GetDomainBids(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4025,7 +9219,7 @@ func (s *Server) handleGetAccountSeqnoRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetAccountSeqnoResponse(response, w, span); err != nil {
+	if err := encodeGetDomainBidsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4034,20 +9228,25 @@ func (s *Server) handleGetAccountSeqnoRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetAccountSubscriptionsRequest handles getAccountSubscriptions operation.
+// handleGetEventRequest handles getEvent operation.
 //
-// Get all subscriptions by wallet address.
+// Get an event either by event ID or a hash of any transaction in a trace. An event is built on top
+// of a trace which is a series of transactions caused by one inbound message. TonAPI looks for known
+// patterns inside the trace and splits the trace into actions, where a single action represents a
+// meaningful high-level operation like a Jetton Transfer or an NFT Purchase. Actions are expected to
+// be shown to users. It is advised not to build any logic on top of actions because actions can be
+// changed at any time.
 //
-// GET /v2/accounts/{account_id}/subscriptions
-func (s *Server) handleGetAccountSubscriptionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/events/{event_id}
+func (s *Server) handleGetEventRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountSubscriptions"),
+		otelogen.OperationID("getEvent"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/subscriptions"),
+		semconv.HTTPRouteKey.String("/v2/events/{event_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountSubscriptions",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetEvent",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4072,11 +9271,11 @@ func (s *Server) handleGetAccountSubscriptionsRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountSubscriptions",
-			ID:   "getAccountSubscriptions",
+			Name: "GetEvent",
+			ID:   "getEvent",
 		}
 	)
-	params, err := decodeGetAccountSubscriptionsParams(args, argsEscaped, r)
+	params, err := decodeGetEventParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4087,27 +9286,31 @@ func (s *Server) handleGetAccountSubscriptionsRequest(args [1]string, argsEscape
 		return
 	}
 
-	var response *Subscriptions
+	var response *Event
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountSubscriptions",
+			OperationName:    "GetEvent",
 			OperationSummary: "",
-			OperationID:      "getAccountSubscriptions",
+			OperationID:      "getEvent",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "event_id",
 					In:   "path",
-				}: params.AccountID,
+				}: params.EventID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountSubscriptionsParams
-			Response = *Subscriptions
+			Params   = GetEventParams
+			Response = *Event
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4116,14 +9319,14 @@ func (s *Server) handleGetAccountSubscriptionsRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackGetAccountSubscriptionsParams,
+			unpackGetEventParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountSubscriptions(ctx, params)
+				response, err = s.h.GetEvent(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountSubscriptions(ctx, params)
+		response, err = s.h.GetEvent(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4142,7 +9345,7 @@ func (s *Server) handleGetAccountSubscriptionsRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeGetAccountSubscriptionsResponse(response, w, span); err != nil {
+	if err := encodeGetEventResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4151,20 +9354,21 @@ func (s *Server) handleGetAccountSubscriptionsRequest(args [1]string, argsEscape
 	}
 }
 
-// handleGetAccountTracesRequest handles getAccountTraces operation.
+// handleGetEventAnnotationRequest handles getEventAnnotation operation.
 //
-// Get traces for account.
+// Get a private annotation attached to the event. A tenant gets its own annotation, a token with the
+// admin scope gets the operator's one.
 //
-// GET /v2/accounts/{account_id}/traces
-func (s *Server) handleGetAccountTracesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/events/{event_id}/annotation
+func (s *Server) handleGetEventAnnotationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccountTraces"),
+		otelogen.OperationID("getEventAnnotation"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/traces"),
+		semconv.HTTPRouteKey.String("/v2/events/{event_id}/annotation"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccountTraces",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetEventAnnotation",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4189,11 +9393,11 @@ func (s *Server) handleGetAccountTracesRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccountTraces",
-			ID:   "getAccountTraces",
+			Name: "GetEventAnnotation",
+			ID:   "getEventAnnotation",
 		}
 	)
-	params, err := decodeGetAccountTracesParams(args, argsEscaped, r)
+	params, err := decodeGetEventAnnotationParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4204,35 +9408,27 @@ func (s *Server) handleGetAccountTracesRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *TraceIDs
+	var response *EventAnnotation
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccountTraces",
+			OperationName:    "GetEventAnnotation",
 			OperationSummary: "",
-			OperationID:      "getAccountTraces",
+			OperationID:      "getEventAnnotation",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "event_id",
 					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+				}: params.EventID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAccountTracesParams
-			Response = *TraceIDs
+			Params   = GetEventAnnotationParams
+			Response = *EventAnnotation
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4241,14 +9437,14 @@ func (s *Server) handleGetAccountTracesRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetAccountTracesParams,
+			unpackGetEventAnnotationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccountTraces(ctx, params)
+				response, err = s.h.GetEventAnnotation(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccountTraces(ctx, params)
+		response, err = s.h.GetEventAnnotation(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4267,7 +9463,7 @@ func (s *Server) handleGetAccountTracesRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetAccountTracesResponse(response, w, span); err != nil {
+	if err := encodeGetEventAnnotationResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4276,20 +9472,21 @@ func (s *Server) handleGetAccountTracesRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetAccountsRequest handles getAccounts operation.
+// handleGetExpectedDepositsRequest handles getExpectedDeposits operation.
 //
-// Get human-friendly information about several accounts without low-level details.
+// Get expected deposits. A tenant gets its own expectations, a token with the admin scope gets all
+// of them.
 //
-// POST /v2/accounts/_bulk
-func (s *Server) handleGetAccountsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/deposits
+func (s *Server) handleGetExpectedDepositsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAccounts"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/accounts/_bulk"),
+		otelogen.OperationID("getExpectedDeposits"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/deposits"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAccounts",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetExpectedDeposits",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4314,11 +9511,11 @@ func (s *Server) handleGetAccountsRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAccounts",
-			ID:   "getAccounts",
+			Name: "GetExpectedDeposits",
+			ID:   "getExpectedDeposits",
 		}
 	)
-	params, err := decodeGetAccountsParams(args, argsEscaped, r)
+	params, err := decodeGetExpectedDepositsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4328,43 +9525,28 @@ func (s *Server) handleGetAccountsRequest(args [0]string, argsEscaped bool, w ht
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeGetAccountsRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *Accounts
+	var response *ExpectedDeposits
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAccounts",
+			OperationName:    "GetExpectedDeposits",
 			OperationSummary: "",
-			OperationID:      "getAccounts",
-			Body:             request,
+			OperationID:      "getExpectedDeposits",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "currency",
+					Name: "account_id",
 					In:   "query",
-				}: params.Currency,
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptGetAccountsReq
-			Params   = GetAccountsParams
-			Response = *Accounts
+			Request  = struct{}
+			Params   = GetExpectedDepositsParams
+			Response = *ExpectedDeposits
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4373,14 +9555,14 @@ func (s *Server) handleGetAccountsRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetAccountsParams,
+			unpackGetExpectedDepositsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAccounts(ctx, request, params)
+				response, err = s.h.GetExpectedDeposits(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAccounts(ctx, request, params)
+		response, err = s.h.GetExpectedDeposits(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4399,7 +9581,7 @@ func (s *Server) handleGetAccountsRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetAccountsResponse(response, w, span); err != nil {
+	if err := encodeGetExpectedDepositsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4408,20 +9590,21 @@ func (s *Server) handleGetAccountsRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetAllAuctionsRequest handles getAllAuctions operation.
+// handleGetInscriptionOpTemplateRequest handles getInscriptionOpTemplate operation.
 //
-// Get all auctions.
+// Return comment for making operation with inscription. please don't use it if you don't know what
+// you are doing.
 //
-// GET /v2/dns/auctions
-func (s *Server) handleGetAllAuctionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/experimental/inscriptions/op-template
+func (s *Server) handleGetInscriptionOpTemplateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAllAuctions"),
+		otelogen.OperationID("getInscriptionOpTemplate"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/dns/auctions"),
+		semconv.HTTPRouteKey.String("/v2/experimental/inscriptions/op-template"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAllAuctions",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetInscriptionOpTemplate",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4446,11 +9629,11 @@ func (s *Server) handleGetAllAuctionsRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAllAuctions",
-			ID:   "getAllAuctions",
+			Name: "GetInscriptionOpTemplate",
+			ID:   "getInscriptionOpTemplate",
 		}
 	)
-	params, err := decodeGetAllAuctionsParams(args, argsEscaped, r)
+	params, err := decodeGetInscriptionOpTemplateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4461,27 +9644,51 @@ func (s *Server) handleGetAllAuctionsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	var response *Auctions
+	var response *GetInscriptionOpTemplateOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAllAuctions",
+			OperationName:    "GetInscriptionOpTemplate",
 			OperationSummary: "",
-			OperationID:      "getAllAuctions",
+			OperationID:      "getInscriptionOpTemplate",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "tld",
+					Name: "type",
+					In:   "query",
+				}: params.Type,
+				{
+					Name: "destination",
+					In:   "query",
+				}: params.Destination,
+				{
+					Name: "comment",
+					In:   "query",
+				}: params.Comment,
+				{
+					Name: "operation",
+					In:   "query",
+				}: params.Operation,
+				{
+					Name: "amount",
+					In:   "query",
+				}: params.Amount,
+				{
+					Name: "ticker",
+					In:   "query",
+				}: params.Ticker,
+				{
+					Name: "who",
 					In:   "query",
-				}: params.Tld,
+				}: params.Who,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAllAuctionsParams
-			Response = *Auctions
+			Params   = GetInscriptionOpTemplateParams
+			Response = *GetInscriptionOpTemplateOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4490,14 +9697,14 @@ func (s *Server) handleGetAllAuctionsRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetAllAuctionsParams,
+			unpackGetInscriptionOpTemplateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAllAuctions(ctx, params)
+				response, err = s.h.GetInscriptionOpTemplate(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAllAuctions(ctx, params)
+		response, err = s.h.GetInscriptionOpTemplate(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4516,7 +9723,7 @@ func (s *Server) handleGetAllAuctionsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetAllAuctionsResponse(response, w, span); err != nil {
+	if err := encodeGetInscriptionOpTemplateResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4525,20 +9732,20 @@ func (s *Server) handleGetAllAuctionsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetAllRawShardsInfoRequest handles getAllRawShardsInfo operation.
+// handleGetItemsFromCollectionRequest handles getItemsFromCollection operation.
 //
-// Get all raw shards info.
+// Get NFT items from collection by collection address.
 //
-// GET /v2/liteserver/get_all_shards_info/{block_id}
-func (s *Server) handleGetAllRawShardsInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/nfts/collections/{account_id}/items
+func (s *Server) handleGetItemsFromCollectionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAllRawShardsInfo"),
+		otelogen.OperationID("getItemsFromCollection"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_all_shards_info/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/nfts/collections/{account_id}/items"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetAllRawShardsInfo",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetItemsFromCollection",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4563,11 +9770,11 @@ func (s *Server) handleGetAllRawShardsInfoRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetAllRawShardsInfo",
-			ID:   "getAllRawShardsInfo",
+			Name: "GetItemsFromCollection",
+			ID:   "getItemsFromCollection",
 		}
 	)
-	params, err := decodeGetAllRawShardsInfoParams(args, argsEscaped, r)
+	params, err := decodeGetItemsFromCollectionParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4578,27 +9785,35 @@ func (s *Server) handleGetAllRawShardsInfoRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response *GetAllRawShardsInfoOK
+	var response *NftItems
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetAllRawShardsInfo",
+			OperationName:    "GetItemsFromCollection",
 			OperationSummary: "",
-			OperationID:      "getAllRawShardsInfo",
+			OperationID:      "getItemsFromCollection",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
+					Name: "account_id",
 					In:   "path",
-				}: params.BlockID,
+				}: params.AccountID,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAllRawShardsInfoParams
-			Response = *GetAllRawShardsInfoOK
+			Params   = GetItemsFromCollectionParams
+			Response = *NftItems
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4607,14 +9822,14 @@ func (s *Server) handleGetAllRawShardsInfoRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetAllRawShardsInfoParams,
+			unpackGetItemsFromCollectionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAllRawShardsInfo(ctx, params)
+				response, err = s.h.GetItemsFromCollection(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAllRawShardsInfo(ctx, params)
+		response, err = s.h.GetItemsFromCollection(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4633,7 +9848,7 @@ func (s *Server) handleGetAllRawShardsInfoRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetAllRawShardsInfoResponse(response, w, span); err != nil {
+	if err := encodeGetItemsFromCollectionResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4642,20 +9857,20 @@ func (s *Server) handleGetAllRawShardsInfoRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetBlockchainAccountTransactionsRequest handles getBlockchainAccountTransactions operation.
+// handleGetJettonHoldersRequest handles getJettonHolders operation.
 //
-// Get account transactions.
+// Get jetton's holders.
 //
-// GET /v2/blockchain/accounts/{account_id}/transactions
-func (s *Server) handleGetBlockchainAccountTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/jettons/{account_id}/holders
+func (s *Server) handleGetJettonHoldersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainAccountTransactions"),
+		otelogen.OperationID("getJettonHolders"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}/transactions"),
+		semconv.HTTPRouteKey.String("/v2/jettons/{account_id}/holders"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainAccountTransactions",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettonHolders",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4680,11 +9895,11 @@ func (s *Server) handleGetBlockchainAccountTransactionsRequest(args [1]string, a
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainAccountTransactions",
-			ID:   "getBlockchainAccountTransactions",
+			Name: "GetJettonHolders",
+			ID:   "getJettonHolders",
 		}
 	)
-	params, err := decodeGetBlockchainAccountTransactionsParams(args, argsEscaped, r)
+	params, err := decodeGetJettonHoldersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4695,43 +9910,35 @@ func (s *Server) handleGetBlockchainAccountTransactionsRequest(args [1]string, a
 		return
 	}
 
-	var response *Transactions
+	var response *JettonHolders
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainAccountTransactions",
+			OperationName:    "GetJettonHolders",
 			OperationSummary: "",
-			OperationID:      "getBlockchainAccountTransactions",
+			OperationID:      "getJettonHolders",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "account_id",
 					In:   "path",
 				}: params.AccountID,
-				{
-					Name: "after_lt",
-					In:   "query",
-				}: params.AfterLt,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
 				{
 					Name: "limit",
 					In:   "query",
 				}: params.Limit,
 				{
-					Name: "sort_order",
+					Name: "offset",
 					In:   "query",
-				}: params.SortOrder,
+				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainAccountTransactionsParams
-			Response = *Transactions
+			Params   = GetJettonHoldersParams
+			Response = *JettonHolders
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4740,14 +9947,14 @@ func (s *Server) handleGetBlockchainAccountTransactionsRequest(args [1]string, a
 		](
 			m,
 			mreq,
-			unpackGetBlockchainAccountTransactionsParams,
+			unpackGetJettonHoldersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainAccountTransactions(ctx, params)
+				response, err = s.h.GetJettonHolders(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainAccountTransactions(ctx, params)
+		response, err = s.h.GetJettonHolders(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4766,7 +9973,7 @@ func (s *Server) handleGetBlockchainAccountTransactionsRequest(args [1]string, a
 		return
 	}
 
-	if err := encodeGetBlockchainAccountTransactionsResponse(response, w, span); err != nil {
+	if err := encodeGetJettonHoldersResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4775,20 +9982,20 @@ func (s *Server) handleGetBlockchainAccountTransactionsRequest(args [1]string, a
 	}
 }
 
-// handleGetBlockchainBlockRequest handles getBlockchainBlock operation.
+// handleGetJettonInfoRequest handles getJettonInfo operation.
 //
-// Get blockchain block data.
+// Get jetton metadata by jetton master address.
 //
-// GET /v2/blockchain/blocks/{block_id}
-func (s *Server) handleGetBlockchainBlockRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/jettons/{account_id}
+func (s *Server) handleGetJettonInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainBlock"),
+		otelogen.OperationID("getJettonInfo"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/blocks/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/jettons/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainBlock",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettonInfo",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4813,11 +10020,11 @@ func (s *Server) handleGetBlockchainBlockRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainBlock",
-			ID:   "getBlockchainBlock",
+			Name: "GetJettonInfo",
+			ID:   "getJettonInfo",
 		}
 	)
-	params, err := decodeGetBlockchainBlockParams(args, argsEscaped, r)
+	params, err := decodeGetJettonInfoParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4828,27 +10035,27 @@ func (s *Server) handleGetBlockchainBlockRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response *BlockchainBlock
+	var response *JettonInfo
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainBlock",
+			OperationName:    "GetJettonInfo",
 			OperationSummary: "",
-			OperationID:      "getBlockchainBlock",
+			OperationID:      "getJettonInfo",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
+					Name: "account_id",
 					In:   "path",
-				}: params.BlockID,
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainBlockParams
-			Response = *BlockchainBlock
+			Params   = GetJettonInfoParams
+			Response = *JettonInfo
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4857,14 +10064,14 @@ func (s *Server) handleGetBlockchainBlockRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetBlockchainBlockParams,
+			unpackGetJettonInfoParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainBlock(ctx, params)
+				response, err = s.h.GetJettonInfo(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainBlock(ctx, params)
+		response, err = s.h.GetJettonInfo(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4883,7 +10090,7 @@ func (s *Server) handleGetBlockchainBlockRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetBlockchainBlockResponse(response, w, span); err != nil {
+	if err := encodeGetJettonInfoResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4892,20 +10099,20 @@ func (s *Server) handleGetBlockchainBlockRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetBlockchainBlockTransactionsRequest handles getBlockchainBlockTransactions operation.
+// handleGetJettonTransferPayloadRequest handles getJettonTransferPayload operation.
 //
-// Get transactions from block.
+// Get jetton's custom payload and state init required for transfer.
 //
-// GET /v2/blockchain/blocks/{block_id}/transactions
-func (s *Server) handleGetBlockchainBlockTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/jettons/{jetton_id}/transfer/{account_id}/payload
+func (s *Server) handleGetJettonTransferPayloadRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainBlockTransactions"),
+		otelogen.OperationID("getJettonTransferPayload"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/blocks/{block_id}/transactions"),
+		semconv.HTTPRouteKey.String("/v2/jettons/{jetton_id}/transfer/{account_id}/payload"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainBlockTransactions",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettonTransferPayload",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4930,11 +10137,11 @@ func (s *Server) handleGetBlockchainBlockTransactionsRequest(args [1]string, arg
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainBlockTransactions",
-			ID:   "getBlockchainBlockTransactions",
+			Name: "GetJettonTransferPayload",
+			ID:   "getJettonTransferPayload",
 		}
 	)
-	params, err := decodeGetBlockchainBlockTransactionsParams(args, argsEscaped, r)
+	params, err := decodeGetJettonTransferPayloadParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4945,27 +10152,31 @@ func (s *Server) handleGetBlockchainBlockTransactionsRequest(args [1]string, arg
 		return
 	}
 
-	var response *Transactions
+	var response *JettonTransferPayload
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainBlockTransactions",
+			OperationName:    "GetJettonTransferPayload",
 			OperationSummary: "",
-			OperationID:      "getBlockchainBlockTransactions",
+			OperationID:      "getJettonTransferPayload",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
+					Name: "account_id",
 					In:   "path",
-				}: params.BlockID,
+				}: params.AccountID,
+				{
+					Name: "jetton_id",
+					In:   "path",
+				}: params.JettonID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainBlockTransactionsParams
-			Response = *Transactions
+			Params   = GetJettonTransferPayloadParams
+			Response = *JettonTransferPayload
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4974,14 +10185,14 @@ func (s *Server) handleGetBlockchainBlockTransactionsRequest(args [1]string, arg
 		](
 			m,
 			mreq,
-			unpackGetBlockchainBlockTransactionsParams,
+			unpackGetJettonTransferPayloadParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainBlockTransactions(ctx, params)
+				response, err = s.h.GetJettonTransferPayload(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainBlockTransactions(ctx, params)
+		response, err = s.h.GetJettonTransferPayload(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5000,7 +10211,7 @@ func (s *Server) handleGetBlockchainBlockTransactionsRequest(args [1]string, arg
 		return
 	}
 
-	if err := encodeGetBlockchainBlockTransactionsResponse(response, w, span); err != nil {
+	if err := encodeGetJettonTransferPayloadResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5009,20 +10220,20 @@ func (s *Server) handleGetBlockchainBlockTransactionsRequest(args [1]string, arg
 	}
 }
 
-// handleGetBlockchainConfigRequest handles getBlockchainConfig operation.
+// handleGetJettonsRequest handles getJettons operation.
 //
-// Get blockchain config.
+// Get a list of all indexed jetton masters in the blockchain.
 //
-// GET /v2/blockchain/config
-func (s *Server) handleGetBlockchainConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/jettons
+func (s *Server) handleGetJettonsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainConfig"),
+		otelogen.OperationID("getJettons"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/config"),
+		semconv.HTTPRouteKey.String("/v2/jettons"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainConfig",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettons",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5045,25 +10256,48 @@ func (s *Server) handleGetBlockchainConfigRequest(args [0]string, argsEscaped bo
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetJettons",
+			ID:   "getJettons",
+		}
 	)
+	params, err := decodeGetJettonsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *BlockchainConfig
+	var response *Jettons
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainConfig",
+			OperationName:    "GetJettons",
 			OperationSummary: "",
-			OperationID:      "getBlockchainConfig",
+			OperationID:      "getJettons",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *BlockchainConfig
+			Params   = GetJettonsParams
+			Response = *Jettons
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5072,14 +10306,14 @@ func (s *Server) handleGetBlockchainConfigRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetJettonsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainConfig(ctx)
+				response, err = s.h.GetJettons(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainConfig(ctx)
+		response, err = s.h.GetJettons(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5098,7 +10332,7 @@ func (s *Server) handleGetBlockchainConfigRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetBlockchainConfigResponse(response, w, span); err != nil {
+	if err := encodeGetJettonsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5107,20 +10341,20 @@ func (s *Server) handleGetBlockchainConfigRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleGetBlockchainConfigFromBlockRequest handles getBlockchainConfigFromBlock operation.
+// handleGetJettonsEventsRequest handles getJettonsEvents operation.
 //
-// Get blockchain config from a specific block, if present.
+// Get only jetton transfers in the event.
 //
-// GET /v2/blockchain/masterchain/{masterchain_seqno}/config
-func (s *Server) handleGetBlockchainConfigFromBlockRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/events/{event_id}/jettons
+func (s *Server) handleGetJettonsEventsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainConfigFromBlock"),
+		otelogen.OperationID("getJettonsEvents"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/config"),
+		semconv.HTTPRouteKey.String("/v2/events/{event_id}/jettons"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainConfigFromBlock",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettonsEvents",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5145,11 +10379,11 @@ func (s *Server) handleGetBlockchainConfigFromBlockRequest(args [1]string, argsE
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainConfigFromBlock",
-			ID:   "getBlockchainConfigFromBlock",
+			Name: "GetJettonsEvents",
+			ID:   "getJettonsEvents",
 		}
 	)
-	params, err := decodeGetBlockchainConfigFromBlockParams(args, argsEscaped, r)
+	params, err := decodeGetJettonsEventsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5160,27 +10394,31 @@ func (s *Server) handleGetBlockchainConfigFromBlockRequest(args [1]string, argsE
 		return
 	}
 
-	var response *BlockchainConfig
+	var response *Event
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainConfigFromBlock",
+			OperationName:    "GetJettonsEvents",
 			OperationSummary: "",
-			OperationID:      "getBlockchainConfigFromBlock",
+			OperationID:      "getJettonsEvents",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "masterchain_seqno",
+					Name: "event_id",
 					In:   "path",
-				}: params.MasterchainSeqno,
+				}: params.EventID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainConfigFromBlockParams
-			Response = *BlockchainConfig
+			Params   = GetJettonsEventsParams
+			Response = *Event
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5189,14 +10427,14 @@ func (s *Server) handleGetBlockchainConfigFromBlockRequest(args [1]string, argsE
 		](
 			m,
 			mreq,
-			unpackGetBlockchainConfigFromBlockParams,
+			unpackGetJettonsEventsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainConfigFromBlock(ctx, params)
+				response, err = s.h.GetJettonsEvents(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainConfigFromBlock(ctx, params)
+		response, err = s.h.GetJettonsEvents(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5215,7 +10453,7 @@ func (s *Server) handleGetBlockchainConfigFromBlockRequest(args [1]string, argsE
 		return
 	}
 
-	if err := encodeGetBlockchainConfigFromBlockResponse(response, w, span); err != nil {
+	if err := encodeGetJettonsEventsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5224,22 +10462,20 @@ func (s *Server) handleGetBlockchainConfigFromBlockRequest(args [1]string, argsE
 	}
 }
 
-// handleGetBlockchainMasterchainBlocksRequest handles getBlockchainMasterchainBlocks operation.
+// handleGetMarketsRatesRequest handles getMarketsRates operation.
 //
-// Get all blocks in all shards and workchains between target and previous masterchain block
-// according to shards last blocks snapshot in masterchain.  We don't recommend to build your app
-// around this method because it has problem with scalability and will work very slow in the future.
+// Get the TON price from markets.
 //
-// GET /v2/blockchain/masterchain/{masterchain_seqno}/blocks
-func (s *Server) handleGetBlockchainMasterchainBlocksRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/rates/markets
+func (s *Server) handleGetMarketsRatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainMasterchainBlocks"),
+		otelogen.OperationID("getMarketsRates"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/blocks"),
+		semconv.HTTPRouteKey.String("/v2/rates/markets"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMasterchainBlocks",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetMarketsRates",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5262,44 +10498,25 @@ func (s *Server) handleGetBlockchainMasterchainBlocksRequest(args [1]string, arg
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainMasterchainBlocks",
-			ID:   "getBlockchainMasterchainBlocks",
-		}
+		err error
 	)
-	params, err := decodeGetBlockchainMasterchainBlocksParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *BlockchainBlocks
+	var response *GetMarketsRatesOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainMasterchainBlocks",
+			OperationName:    "GetMarketsRates",
 			OperationSummary: "",
-			OperationID:      "getBlockchainMasterchainBlocks",
+			OperationID:      "getMarketsRates",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "masterchain_seqno",
-					In:   "path",
-				}: params.MasterchainSeqno,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainMasterchainBlocksParams
-			Response = *BlockchainBlocks
+			Params   = struct{}
+			Response = *GetMarketsRatesOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5308,14 +10525,14 @@ func (s *Server) handleGetBlockchainMasterchainBlocksRequest(args [1]string, arg
 		](
 			m,
 			mreq,
-			unpackGetBlockchainMasterchainBlocksParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainMasterchainBlocks(ctx, params)
+				response, err = s.h.GetMarketsRates(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainMasterchainBlocks(ctx, params)
+		response, err = s.h.GetMarketsRates(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5334,7 +10551,7 @@ func (s *Server) handleGetBlockchainMasterchainBlocksRequest(args [1]string, arg
 		return
 	}
 
-	if err := encodeGetBlockchainMasterchainBlocksResponse(response, w, span); err != nil {
+	if err := encodeGetMarketsRatesResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5343,20 +10560,22 @@ func (s *Server) handleGetBlockchainMasterchainBlocksRequest(args [1]string, arg
 	}
 }
 
-// handleGetBlockchainMasterchainHeadRequest handles getBlockchainMasterchainHead operation.
+// handleGetMasterchainEtaRequest handles getMasterchainEta operation.
 //
-// Get last known masterchain block.
+// Estimate when a future masterchain block is generated or when the next validation deadline comes.
+// Estimations are based on the average masterchain block time over the last hour, so countdowns stay
+// consistent between clients.
 //
-// GET /v2/blockchain/masterchain-head
-func (s *Server) handleGetBlockchainMasterchainHeadRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/masterchain/eta
+func (s *Server) handleGetMasterchainEtaRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainMasterchainHead"),
+		otelogen.OperationID("getMasterchainEta"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain-head"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/eta"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMasterchainHead",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetMasterchainEta",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5379,25 +10598,48 @@ func (s *Server) handleGetBlockchainMasterchainHeadRequest(args [0]string, argsE
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetMasterchainEta",
+			ID:   "getMasterchainEta",
+		}
 	)
+	params, err := decodeGetMasterchainEtaParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *BlockchainBlock
+	var response *MasterchainEta
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainMasterchainHead",
+			OperationName:    "GetMasterchainEta",
 			OperationSummary: "",
-			OperationID:      "getBlockchainMasterchainHead",
+			OperationID:      "getMasterchainEta",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "seqno",
+					In:   "query",
+				}: params.Seqno,
+				{
+					Name: "event",
+					In:   "query",
+				}: params.Event,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *BlockchainBlock
+			Params   = GetMasterchainEtaParams
+			Response = *MasterchainEta
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5406,14 +10648,14 @@ func (s *Server) handleGetBlockchainMasterchainHeadRequest(args [0]string, argsE
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetMasterchainEtaParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainMasterchainHead(ctx)
+				response, err = s.h.GetMasterchainEta(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainMasterchainHead(ctx)
+		response, err = s.h.GetMasterchainEta(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5432,7 +10674,7 @@ func (s *Server) handleGetBlockchainMasterchainHeadRequest(args [0]string, argsE
 		return
 	}
 
-	if err := encodeGetBlockchainMasterchainHeadResponse(response, w, span); err != nil {
+	if err := encodeGetMasterchainEtaResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5441,20 +10683,20 @@ func (s *Server) handleGetBlockchainMasterchainHeadRequest(args [0]string, argsE
 	}
 }
 
-// handleGetBlockchainMasterchainShardsRequest handles getBlockchainMasterchainShards operation.
+// handleGetMultisigAccountRequest handles getMultisigAccount operation.
 //
-// Get blockchain block shards.
+// Get multisig account info.
 //
-// GET /v2/blockchain/masterchain/{masterchain_seqno}/shards
-func (s *Server) handleGetBlockchainMasterchainShardsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/multisig/{account_id}
+func (s *Server) handleGetMultisigAccountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainMasterchainShards"),
+		otelogen.OperationID("getMultisigAccount"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/shards"),
+		semconv.HTTPRouteKey.String("/v2/multisig/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMasterchainShards",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetMultisigAccount",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5479,11 +10721,11 @@ func (s *Server) handleGetBlockchainMasterchainShardsRequest(args [1]string, arg
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainMasterchainShards",
-			ID:   "getBlockchainMasterchainShards",
+			Name: "GetMultisigAccount",
+			ID:   "getMultisigAccount",
 		}
 	)
-	params, err := decodeGetBlockchainMasterchainShardsParams(args, argsEscaped, r)
+	params, err := decodeGetMultisigAccountParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5494,27 +10736,27 @@ func (s *Server) handleGetBlockchainMasterchainShardsRequest(args [1]string, arg
 		return
 	}
 
-	var response *BlockchainBlockShards
+	var response *Multisig
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainMasterchainShards",
+			OperationName:    "GetMultisigAccount",
 			OperationSummary: "",
-			OperationID:      "getBlockchainMasterchainShards",
+			OperationID:      "getMultisigAccount",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "masterchain_seqno",
+					Name: "account_id",
 					In:   "path",
-				}: params.MasterchainSeqno,
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainMasterchainShardsParams
-			Response = *BlockchainBlockShards
+			Params   = GetMultisigAccountParams
+			Response = *Multisig
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5523,14 +10765,14 @@ func (s *Server) handleGetBlockchainMasterchainShardsRequest(args [1]string, arg
 		](
 			m,
 			mreq,
-			unpackGetBlockchainMasterchainShardsParams,
+			unpackGetMultisigAccountParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainMasterchainShards(ctx, params)
+				response, err = s.h.GetMultisigAccount(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainMasterchainShards(ctx, params)
+		response, err = s.h.GetMultisigAccount(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5549,7 +10791,7 @@ func (s *Server) handleGetBlockchainMasterchainShardsRequest(args [1]string, arg
 		return
 	}
 
-	if err := encodeGetBlockchainMasterchainShardsResponse(response, w, span); err != nil {
+	if err := encodeGetMultisigAccountResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5558,22 +10800,20 @@ func (s *Server) handleGetBlockchainMasterchainShardsRequest(args [1]string, arg
 	}
 }
 
-// handleGetBlockchainMasterchainTransactionsRequest handles getBlockchainMasterchainTransactions operation.
+// handleGetNetworkStatsRequest handles getNetworkStats operation.
 //
-// Get all transactions in all shards and workchains between target and previous masterchain block
-// according to shards last blocks snapshot in masterchain. We don't recommend to build your app
-// around this method because it has problem with scalability and will work very slow in the future.
+// Get chain-wide statistics observed by the indexer.
 //
-// GET /v2/blockchain/masterchain/{masterchain_seqno}/transactions
-func (s *Server) handleGetBlockchainMasterchainTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/stats/network
+func (s *Server) handleGetNetworkStatsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainMasterchainTransactions"),
+		otelogen.OperationID("getNetworkStats"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/transactions"),
+		semconv.HTTPRouteKey.String("/v2/stats/network"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainMasterchainTransactions",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNetworkStats",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5596,44 +10836,25 @@ func (s *Server) handleGetBlockchainMasterchainTransactionsRequest(args [1]strin
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainMasterchainTransactions",
-			ID:   "getBlockchainMasterchainTransactions",
-		}
+		err error
 	)
-	params, err := decodeGetBlockchainMasterchainTransactionsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *Transactions
+	var response *NetworkStats
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainMasterchainTransactions",
+			OperationName:    "GetNetworkStats",
 			OperationSummary: "",
-			OperationID:      "getBlockchainMasterchainTransactions",
+			OperationID:      "getNetworkStats",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "masterchain_seqno",
-					In:   "path",
-				}: params.MasterchainSeqno,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainMasterchainTransactionsParams
-			Response = *Transactions
+			Params   = struct{}
+			Response = *NetworkStats
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5642,14 +10863,14 @@ func (s *Server) handleGetBlockchainMasterchainTransactionsRequest(args [1]strin
 		](
 			m,
 			mreq,
-			unpackGetBlockchainMasterchainTransactionsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainMasterchainTransactions(ctx, params)
+				response, err = s.h.GetNetworkStats(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainMasterchainTransactions(ctx, params)
+		response, err = s.h.GetNetworkStats(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5668,7 +10889,7 @@ func (s *Server) handleGetBlockchainMasterchainTransactionsRequest(args [1]strin
 		return
 	}
 
-	if err := encodeGetBlockchainMasterchainTransactionsResponse(response, w, span); err != nil {
+	if err := encodeGetNetworkStatsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5677,20 +10898,20 @@ func (s *Server) handleGetBlockchainMasterchainTransactionsRequest(args [1]strin
 	}
 }
 
-// handleGetBlockchainRawAccountRequest handles getBlockchainRawAccount operation.
+// handleGetNftCollectionRequest handles getNftCollection operation.
 //
-// Get low-level information about an account taken directly from the blockchain.
+// Get NFT collection by collection address.
 //
-// GET /v2/blockchain/accounts/{account_id}
-func (s *Server) handleGetBlockchainRawAccountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/nfts/collections/{account_id}
+func (s *Server) handleGetNftCollectionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainRawAccount"),
+		otelogen.OperationID("getNftCollection"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/accounts/{account_id}"),
+		semconv.HTTPRouteKey.String("/v2/nfts/collections/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainRawAccount",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftCollection",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5715,11 +10936,11 @@ func (s *Server) handleGetBlockchainRawAccountRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainRawAccount",
-			ID:   "getBlockchainRawAccount",
+			Name: "GetNftCollection",
+			ID:   "getNftCollection",
 		}
 	)
-	params, err := decodeGetBlockchainRawAccountParams(args, argsEscaped, r)
+	params, err := decodeGetNftCollectionParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5730,13 +10951,13 @@ func (s *Server) handleGetBlockchainRawAccountRequest(args [1]string, argsEscape
 		return
 	}
 
-	var response *BlockchainRawAccount
+	var response *NftCollection
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainRawAccount",
+			OperationName:    "GetNftCollection",
 			OperationSummary: "",
-			OperationID:      "getBlockchainRawAccount",
+			OperationID:      "getNftCollection",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -5749,8 +10970,8 @@ func (s *Server) handleGetBlockchainRawAccountRequest(args [1]string, argsEscape
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainRawAccountParams
-			Response = *BlockchainRawAccount
+			Params   = GetNftCollectionParams
+			Response = *NftCollection
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5759,14 +10980,14 @@ func (s *Server) handleGetBlockchainRawAccountRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackGetBlockchainRawAccountParams,
+			unpackGetNftCollectionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainRawAccount(ctx, params)
+				response, err = s.h.GetNftCollection(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainRawAccount(ctx, params)
+		response, err = s.h.GetNftCollection(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5785,7 +11006,7 @@ func (s *Server) handleGetBlockchainRawAccountRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeGetBlockchainRawAccountResponse(response, w, span); err != nil {
+	if err := encodeGetNftCollectionResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5794,20 +11015,20 @@ func (s *Server) handleGetBlockchainRawAccountRequest(args [1]string, argsEscape
 	}
 }
 
-// handleGetBlockchainTransactionRequest handles getBlockchainTransaction operation.
+// handleGetNftCollectionsRequest handles getNftCollections operation.
 //
-// Get transaction data.
+// Get NFT collections.
 //
-// GET /v2/blockchain/transactions/{transaction_id}
-func (s *Server) handleGetBlockchainTransactionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/nfts/collections
+func (s *Server) handleGetNftCollectionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainTransaction"),
+		otelogen.OperationID("getNftCollections"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/transactions/{transaction_id}"),
+		semconv.HTTPRouteKey.String("/v2/nfts/collections"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainTransaction",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftCollections",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5832,11 +11053,11 @@ func (s *Server) handleGetBlockchainTransactionRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainTransaction",
-			ID:   "getBlockchainTransaction",
+			Name: "GetNftCollections",
+			ID:   "getNftCollections",
 		}
 	)
-	params, err := decodeGetBlockchainTransactionParams(args, argsEscaped, r)
+	params, err := decodeGetNftCollectionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5847,27 +11068,31 @@ func (s *Server) handleGetBlockchainTransactionRequest(args [1]string, argsEscap
 		return
 	}
 
-	var response *Transaction
+	var response *NftCollections
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainTransaction",
+			OperationName:    "GetNftCollections",
 			OperationSummary: "",
-			OperationID:      "getBlockchainTransaction",
+			OperationID:      "getNftCollections",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "transaction_id",
-					In:   "path",
-				}: params.TransactionID,
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainTransactionParams
-			Response = *Transaction
+			Params   = GetNftCollectionsParams
+			Response = *NftCollections
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5876,14 +11101,14 @@ func (s *Server) handleGetBlockchainTransactionRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackGetBlockchainTransactionParams,
+			unpackGetNftCollectionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainTransaction(ctx, params)
+				response, err = s.h.GetNftCollections(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainTransaction(ctx, params)
+		response, err = s.h.GetNftCollections(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5902,7 +11127,7 @@ func (s *Server) handleGetBlockchainTransactionRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeGetBlockchainTransactionResponse(response, w, span); err != nil {
+	if err := encodeGetNftCollectionsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5911,20 +11136,20 @@ func (s *Server) handleGetBlockchainTransactionRequest(args [1]string, argsEscap
 	}
 }
 
-// handleGetBlockchainTransactionByMessageHashRequest handles getBlockchainTransactionByMessageHash operation.
+// handleGetNftHistoryByIDRequest handles getNftHistoryByID operation.
 //
-// Get transaction data by message hash.
+// Get the transfer nfts history for account.
 //
-// GET /v2/blockchain/messages/{msg_id}/transaction
-func (s *Server) handleGetBlockchainTransactionByMessageHashRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/nfts/{account_id}/history
+func (s *Server) handleGetNftHistoryByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainTransactionByMessageHash"),
+		otelogen.OperationID("getNftHistoryByID"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/messages/{msg_id}/transaction"),
+		semconv.HTTPRouteKey.String("/v2/nfts/{account_id}/history"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainTransactionByMessageHash",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftHistoryByID",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5949,11 +11174,11 @@ func (s *Server) handleGetBlockchainTransactionByMessageHashRequest(args [1]stri
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetBlockchainTransactionByMessageHash",
-			ID:   "getBlockchainTransactionByMessageHash",
+			Name: "GetNftHistoryByID",
+			ID:   "getNftHistoryByID",
 		}
 	)
-	params, err := decodeGetBlockchainTransactionByMessageHashParams(args, argsEscaped, r)
+	params, err := decodeGetNftHistoryByIDParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5964,27 +11189,47 @@ func (s *Server) handleGetBlockchainTransactionByMessageHashRequest(args [1]stri
 		return
 	}
 
-	var response *Transaction
+	var response *AccountEvents
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainTransactionByMessageHash",
+			OperationName:    "GetNftHistoryByID",
 			OperationSummary: "",
-			OperationID:      "getBlockchainTransactionByMessageHash",
+			OperationID:      "getNftHistoryByID",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "msg_id",
+					Name: "account_id",
 					In:   "path",
-				}: params.MsgID,
+				}: params.AccountID,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "start_date",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "end_date",
+					In:   "query",
+				}: params.EndDate,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBlockchainTransactionByMessageHashParams
-			Response = *Transaction
+			Params   = GetNftHistoryByIDParams
+			Response = *AccountEvents
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5993,14 +11238,14 @@ func (s *Server) handleGetBlockchainTransactionByMessageHashRequest(args [1]stri
 		](
 			m,
 			mreq,
-			unpackGetBlockchainTransactionByMessageHashParams,
+			unpackGetNftHistoryByIDParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainTransactionByMessageHash(ctx, params)
+				response, err = s.h.GetNftHistoryByID(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainTransactionByMessageHash(ctx, params)
+		response, err = s.h.GetNftHistoryByID(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6019,7 +11264,7 @@ func (s *Server) handleGetBlockchainTransactionByMessageHashRequest(args [1]stri
 		return
 	}
 
-	if err := encodeGetBlockchainTransactionByMessageHashResponse(response, w, span); err != nil {
+	if err := encodeGetNftHistoryByIDResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6028,20 +11273,21 @@ func (s *Server) handleGetBlockchainTransactionByMessageHashRequest(args [1]stri
 	}
 }
 
-// handleGetBlockchainValidatorsRequest handles getBlockchainValidators operation.
+// handleGetNftItemBidsRequest handles getNftItemBids operation.
 //
-// Get blockchain validators.
+// Get the history of bids on an NFT item or on an auction contract observed by this instance, the
+// latest go first.
 //
-// GET /v2/blockchain/validators
-func (s *Server) handleGetBlockchainValidatorsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/nfts/{account_id}/bids
+func (s *Server) handleGetNftItemBidsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBlockchainValidators"),
+		otelogen.OperationID("getNftItemBids"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/validators"),
+		semconv.HTTPRouteKey.String("/v2/nfts/{account_id}/bids"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBlockchainValidators",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftItemBids",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6064,25 +11310,44 @@ func (s *Server) handleGetBlockchainValidatorsRequest(args [0]string, argsEscape
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetNftItemBids",
+			ID:   "getNftItemBids",
+		}
 	)
+	params, err := decodeGetNftItemBidsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *Validators
+	var response *NftBids
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetBlockchainValidators",
+			OperationName:    "GetNftItemBids",
 			OperationSummary: "",
-			OperationID:      "getBlockchainValidators",
+			OperationID:      "getNftItemBids",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *Validators
+			Params   = GetNftItemBidsParams
+			Response = *NftBids
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6091,14 +11356,14 @@ func (s *Server) handleGetBlockchainValidatorsRequest(args [0]string, argsEscape
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetNftItemBidsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBlockchainValidators(ctx)
+				response, err = s.h.GetNftItemBids(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBlockchainValidators(ctx)
+		response, err = s.h.GetNftItemBids(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6117,7 +11382,7 @@ func (s *Server) handleGetBlockchainValidatorsRequest(args [0]string, argsEscape
 		return
 	}
 
-	if err := encodeGetBlockchainValidatorsResponse(response, w, span); err != nil {
+	if err := encodeGetNftItemBidsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6126,20 +11391,20 @@ func (s *Server) handleGetBlockchainValidatorsRequest(args [0]string, argsEscape
 	}
 }
 
-// handleGetChartRatesRequest handles getChartRates operation.
+// handleGetNftItemByAddressRequest handles getNftItemByAddress operation.
 //
-// Get chart by token.
+// Get NFT item by its address.
 //
-// GET /v2/rates/chart
-func (s *Server) handleGetChartRatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/nfts/{account_id}
+func (s *Server) handleGetNftItemByAddressRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getChartRates"),
+		otelogen.OperationID("getNftItemByAddress"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/rates/chart"),
+		semconv.HTTPRouteKey.String("/v2/nfts/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetChartRates",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftItemByAddress",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6164,11 +11429,11 @@ func (s *Server) handleGetChartRatesRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetChartRates",
-			ID:   "getChartRates",
+			Name: "GetNftItemByAddress",
+			ID:   "getNftItemByAddress",
 		}
 	)
-	params, err := decodeGetChartRatesParams(args, argsEscaped, r)
+	params, err := decodeGetNftItemByAddressParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6179,43 +11444,27 @@ func (s *Server) handleGetChartRatesRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	var response *GetChartRatesOK
+	var response *NftItem
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetChartRates",
+			OperationName:    "GetNftItemByAddress",
 			OperationSummary: "",
-			OperationID:      "getChartRates",
+			OperationID:      "getNftItemByAddress",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "token",
-					In:   "query",
-				}: params.Token,
-				{
-					Name: "currency",
-					In:   "query",
-				}: params.Currency,
-				{
-					Name: "start_date",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "end_date",
-					In:   "query",
-				}: params.EndDate,
-				{
-					Name: "points_count",
-					In:   "query",
-				}: params.PointsCount,
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetChartRatesParams
-			Response = *GetChartRatesOK
+			Params   = GetNftItemByAddressParams
+			Response = *NftItem
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6224,14 +11473,14 @@ func (s *Server) handleGetChartRatesRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetChartRatesParams,
+			unpackGetNftItemByAddressParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetChartRates(ctx, params)
+				response, err = s.h.GetNftItemByAddress(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetChartRates(ctx, params)
+		response, err = s.h.GetNftItemByAddress(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6250,7 +11499,7 @@ func (s *Server) handleGetChartRatesRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetChartRatesResponse(response, w, span); err != nil {
+	if err := encodeGetNftItemByAddressResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6259,20 +11508,20 @@ func (s *Server) handleGetChartRatesRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetDnsInfoRequest handles getDnsInfo operation.
+// handleGetNftItemsByAddressesRequest handles getNftItemsByAddresses operation.
 //
-// Get full information about domain name.
+// Get NFT items by their addresses.
 //
-// GET /v2/dns/{domain_name}
-func (s *Server) handleGetDnsInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/nfts/_bulk
+func (s *Server) handleGetNftItemsByAddressesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDnsInfo"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/dns/{domain_name}"),
+		otelogen.OperationID("getNftItemsByAddresses"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/nfts/_bulk"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetDnsInfo",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftItemsByAddresses",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6297,42 +11546,57 @@ func (s *Server) handleGetDnsInfoRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetDnsInfo",
-			ID:   "getDnsInfo",
+			Name: "GetNftItemsByAddresses",
+			ID:   "getNftItemsByAddresses",
 		}
 	)
-	params, err := decodeGetDnsInfoParams(args, argsEscaped, r)
+	params, err := decodeGetNftItemsByAddressesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeGetNftItemsByAddressesRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DomainInfo
+	var response *NftItems
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetDnsInfo",
+			OperationName:    "GetNftItemsByAddresses",
 			OperationSummary: "",
-			OperationID:      "getDnsInfo",
-			Body:             nil,
+			OperationID:      "getNftItemsByAddresses",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "domain_name",
-					In:   "path",
-				}: params.DomainName,
+					Name: "partial",
+					In:   "query",
+				}: params.Partial,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetDnsInfoParams
-			Response = *DomainInfo
+			Request  = OptGetNftItemsByAddressesReq
+			Params   = GetNftItemsByAddressesParams
+			Response = *NftItems
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6341,14 +11605,14 @@ func (s *Server) handleGetDnsInfoRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetDnsInfoParams,
+			unpackGetNftItemsByAddressesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDnsInfo(ctx, params)
+				response, err = s.h.GetNftItemsByAddresses(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDnsInfo(ctx, params)
+		response, err = s.h.GetNftItemsByAddresses(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6367,7 +11631,7 @@ func (s *Server) handleGetDnsInfoRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetDnsInfoResponse(response, w, span); err != nil {
+	if err := encodeGetNftItemsByAddressesResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6376,20 +11640,20 @@ func (s *Server) handleGetDnsInfoRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetDomainBidsRequest handles getDomainBids operation.
+// handleGetOutMsgQueueSizesRequest handles getOutMsgQueueSizes operation.
 //
-// Get domain bids.
+// Get out msg queue sizes.
 //
-// GET /v2/dns/{domain_name}/bids
-func (s *Server) handleGetDomainBidsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_out_msg_queue_sizes
+func (s *Server) handleGetOutMsgQueueSizesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDomainBids"),
+		otelogen.OperationID("getOutMsgQueueSizes"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/dns/{domain_name}/bids"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_out_msg_queue_sizes"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetDomainBids",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOutMsgQueueSizes",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6412,44 +11676,25 @@ func (s *Server) handleGetDomainBidsRequest(args [1]string, argsEscaped bool, w
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetDomainBids",
-			ID:   "getDomainBids",
-		}
+		err error
 	)
-	params, err := decodeGetDomainBidsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *DomainBids
+	var response *GetOutMsgQueueSizesOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetDomainBids",
+			OperationName:    "GetOutMsgQueueSizes",
 			OperationSummary: "",
-			OperationID:      "getDomainBids",
+			OperationID:      "getOutMsgQueueSizes",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "domain_name",
-					In:   "path",
-				}: params.DomainName,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetDomainBidsParams
-			Response = *DomainBids
+			Params   = struct{}
+			Response = *GetOutMsgQueueSizesOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6458,14 +11703,14 @@ func (s *Server) handleGetDomainBidsRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetDomainBidsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDomainBids(ctx, params)
+				response, err = s.h.GetOutMsgQueueSizes(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDomainBids(ctx, params)
+		response, err = s.h.GetOutMsgQueueSizes(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6484,7 +11729,7 @@ func (s *Server) handleGetDomainBidsRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetDomainBidsResponse(response, w, span); err != nil {
+	if err := encodeGetOutMsgQueueSizesResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6493,25 +11738,20 @@ func (s *Server) handleGetDomainBidsRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetEventRequest handles getEvent operation.
+// handleGetPrivateLabelRequest handles getPrivateLabel operation.
 //
-// Get an event either by event ID or a hash of any transaction in a trace. An event is built on top
-// of a trace which is a series of transactions caused by one inbound message. TonAPI looks for known
-// patterns inside the trace and splits the trace into actions, where a single action represents a
-// meaningful high-level operation like a Jetton Transfer or an NFT Purchase. Actions are expected to
-// be shown to users. It is advised not to build any logic on top of actions because actions can be
-// changed at any time.
+// Get a private label of an account. Requires a token with the admin scope.
 //
-// GET /v2/events/{event_id}
-func (s *Server) handleGetEventRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/labels/{account_id}
+func (s *Server) handleGetPrivateLabelRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getEvent"),
+		otelogen.OperationID("getPrivateLabel"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/events/{event_id}"),
+		semconv.HTTPRouteKey.String("/v2/labels/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetEvent",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPrivateLabel",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6536,11 +11776,11 @@ func (s *Server) handleGetEventRequest(args [1]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetEvent",
-			ID:   "getEvent",
+			Name: "GetPrivateLabel",
+			ID:   "getPrivateLabel",
 		}
 	)
-	params, err := decodeGetEventParams(args, argsEscaped, r)
+	params, err := decodeGetPrivateLabelParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6551,31 +11791,27 @@ func (s *Server) handleGetEventRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	var response *Event
+	var response *PrivateLabel
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetEvent",
+			OperationName:    "GetPrivateLabel",
 			OperationSummary: "",
-			OperationID:      "getEvent",
+			OperationID:      "getPrivateLabel",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "event_id",
+					Name: "account_id",
 					In:   "path",
-				}: params.EventID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetEventParams
-			Response = *Event
+			Params   = GetPrivateLabelParams
+			Response = *PrivateLabel
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6584,14 +11820,14 @@ func (s *Server) handleGetEventRequest(args [1]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetEventParams,
+			unpackGetPrivateLabelParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetEvent(ctx, params)
+				response, err = s.h.GetPrivateLabel(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetEvent(ctx, params)
+		response, err = s.h.GetPrivateLabel(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6610,7 +11846,7 @@ func (s *Server) handleGetEventRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetEventResponse(response, w, span); err != nil {
+	if err := encodeGetPrivateLabelResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6619,21 +11855,21 @@ func (s *Server) handleGetEventRequest(args [1]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetInscriptionOpTemplateRequest handles getInscriptionOpTemplate operation.
+// handleGetPrivateLabelsRequest handles getPrivateLabels operation.
 //
-// Return comment for making operation with inscription. please don't use it if you don't know what
-// you are doing.
+// Get private labels and memos attached to accounts by the operator of the instance. Requires a
+// token with the admin scope.
 //
-// GET /v2/experimental/inscriptions/op-template
-func (s *Server) handleGetInscriptionOpTemplateRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/labels
+func (s *Server) handleGetPrivateLabelsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getInscriptionOpTemplate"),
+		otelogen.OperationID("getPrivateLabels"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/experimental/inscriptions/op-template"),
+		semconv.HTTPRouteKey.String("/v2/labels"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetInscriptionOpTemplate",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetPrivateLabels",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6656,68 +11892,25 @@ func (s *Server) handleGetInscriptionOpTemplateRequest(args [0]string, argsEscap
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetInscriptionOpTemplate",
-			ID:   "getInscriptionOpTemplate",
-		}
+		err error
 	)
-	params, err := decodeGetInscriptionOpTemplateParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *GetInscriptionOpTemplateOK
+	var response *PrivateLabels
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetInscriptionOpTemplate",
+			OperationName:    "GetPrivateLabels",
 			OperationSummary: "",
-			OperationID:      "getInscriptionOpTemplate",
+			OperationID:      "getPrivateLabels",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "type",
-					In:   "query",
-				}: params.Type,
-				{
-					Name: "destination",
-					In:   "query",
-				}: params.Destination,
-				{
-					Name: "comment",
-					In:   "query",
-				}: params.Comment,
-				{
-					Name: "operation",
-					In:   "query",
-				}: params.Operation,
-				{
-					Name: "amount",
-					In:   "query",
-				}: params.Amount,
-				{
-					Name: "ticker",
-					In:   "query",
-				}: params.Ticker,
-				{
-					Name: "who",
-					In:   "query",
-				}: params.Who,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetInscriptionOpTemplateParams
-			Response = *GetInscriptionOpTemplateOK
+			Params   = struct{}
+			Response = *PrivateLabels
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6726,14 +11919,14 @@ func (s *Server) handleGetInscriptionOpTemplateRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackGetInscriptionOpTemplateParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetInscriptionOpTemplate(ctx, params)
+				response, err = s.h.GetPrivateLabels(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetInscriptionOpTemplate(ctx, params)
+		response, err = s.h.GetPrivateLabels(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6752,7 +11945,7 @@ func (s *Server) handleGetInscriptionOpTemplateRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeGetInscriptionOpTemplateResponse(response, w, span); err != nil {
+	if err := encodeGetPrivateLabelsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6761,20 +11954,21 @@ func (s *Server) handleGetInscriptionOpTemplateRequest(args [0]string, argsEscap
 	}
 }
 
-// handleGetItemsFromCollectionRequest handles getItemsFromCollection operation.
+// handleGetRatesRequest handles getRates operation.
 //
-// Get NFT items from collection by collection address.
+// Get the token price in the chosen currency for display only. Don’t use this for financial
+// transactions.
 //
-// GET /v2/nfts/collections/{account_id}/items
-func (s *Server) handleGetItemsFromCollectionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/rates
+func (s *Server) handleGetRatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getItemsFromCollection"),
+		otelogen.OperationID("getRates"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/nfts/collections/{account_id}/items"),
+		semconv.HTTPRouteKey.String("/v2/rates"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetItemsFromCollection",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRates",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6799,11 +11993,11 @@ func (s *Server) handleGetItemsFromCollectionRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetItemsFromCollection",
-			ID:   "getItemsFromCollection",
+			Name: "GetRates",
+			ID:   "getRates",
 		}
 	)
-	params, err := decodeGetItemsFromCollectionParams(args, argsEscaped, r)
+	params, err := decodeGetRatesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6814,35 +12008,31 @@ func (s *Server) handleGetItemsFromCollectionRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response *NftItems
+	var response *GetRatesOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetItemsFromCollection",
+			OperationName:    "GetRates",
 			OperationSummary: "",
-			OperationID:      "getItemsFromCollection",
+			OperationID:      "getRates",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "limit",
+					Name: "tokens",
 					In:   "query",
-				}: params.Limit,
+				}: params.Tokens,
 				{
-					Name: "offset",
+					Name: "currencies",
 					In:   "query",
-				}: params.Offset,
+				}: params.Currencies,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetItemsFromCollectionParams
-			Response = *NftItems
+			Params   = GetRatesParams
+			Response = *GetRatesOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6851,14 +12041,14 @@ func (s *Server) handleGetItemsFromCollectionRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetItemsFromCollectionParams,
+			unpackGetRatesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetItemsFromCollection(ctx, params)
+				response, err = s.h.GetRates(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetItemsFromCollection(ctx, params)
+		response, err = s.h.GetRates(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6877,7 +12067,7 @@ func (s *Server) handleGetItemsFromCollectionRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetItemsFromCollectionResponse(response, w, span); err != nil {
+	if err := encodeGetRatesResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6886,20 +12076,20 @@ func (s *Server) handleGetItemsFromCollectionRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetJettonHoldersRequest handles getJettonHolders operation.
+// handleGetRawAccountStateRequest handles getRawAccountState operation.
 //
-// Get jetton's holders.
+// Get raw account state.
 //
-// GET /v2/jettons/{account_id}/holders
-func (s *Server) handleGetJettonHoldersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_account_state/{account_id}
+func (s *Server) handleGetRawAccountStateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJettonHolders"),
+		otelogen.OperationID("getRawAccountState"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/jettons/{account_id}/holders"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_account_state/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettonHolders",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawAccountState",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6924,11 +12114,11 @@ func (s *Server) handleGetJettonHoldersRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetJettonHolders",
-			ID:   "getJettonHolders",
+			Name: "GetRawAccountState",
+			ID:   "getRawAccountState",
 		}
 	)
-	params, err := decodeGetJettonHoldersParams(args, argsEscaped, r)
+	params, err := decodeGetRawAccountStateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6939,13 +12129,13 @@ func (s *Server) handleGetJettonHoldersRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *JettonHolders
+	var response *GetRawAccountStateOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetJettonHolders",
+			OperationName:    "GetRawAccountState",
 			OperationSummary: "",
-			OperationID:      "getJettonHolders",
+			OperationID:      "getRawAccountState",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -6953,21 +12143,17 @@ func (s *Server) handleGetJettonHoldersRequest(args [1]string, argsEscaped bool,
 					In:   "path",
 				}: params.AccountID,
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
+					Name: "target_block",
 					In:   "query",
-				}: params.Offset,
+				}: params.TargetBlock,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetJettonHoldersParams
-			Response = *JettonHolders
+			Params   = GetRawAccountStateParams
+			Response = *GetRawAccountStateOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6976,14 +12162,14 @@ func (s *Server) handleGetJettonHoldersRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetJettonHoldersParams,
+			unpackGetRawAccountStateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJettonHolders(ctx, params)
+				response, err = s.h.GetRawAccountState(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJettonHolders(ctx, params)
+		response, err = s.h.GetRawAccountState(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7002,7 +12188,7 @@ func (s *Server) handleGetJettonHoldersRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetJettonHoldersResponse(response, w, span); err != nil {
+	if err := encodeGetRawAccountStateResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7011,20 +12197,20 @@ func (s *Server) handleGetJettonHoldersRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetJettonInfoRequest handles getJettonInfo operation.
+// handleGetRawBlockProofRequest handles getRawBlockProof operation.
 //
-// Get jetton metadata by jetton master address.
+// Get raw block proof.
 //
-// GET /v2/jettons/{account_id}
-func (s *Server) handleGetJettonInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_block_proof
+func (s *Server) handleGetRawBlockProofRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJettonInfo"),
+		otelogen.OperationID("getRawBlockProof"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/jettons/{account_id}"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_block_proof"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettonInfo",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockProof",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7049,11 +12235,11 @@ func (s *Server) handleGetJettonInfoRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetJettonInfo",
-			ID:   "getJettonInfo",
+			Name: "GetRawBlockProof",
+			ID:   "getRawBlockProof",
 		}
 	)
-	params, err := decodeGetJettonInfoParams(args, argsEscaped, r)
+	params, err := decodeGetRawBlockProofParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7064,27 +12250,35 @@ func (s *Server) handleGetJettonInfoRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	var response *JettonInfo
+	var response *GetRawBlockProofOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetJettonInfo",
+			OperationName:    "GetRawBlockProof",
 			OperationSummary: "",
-			OperationID:      "getJettonInfo",
+			OperationID:      "getRawBlockProof",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
+					Name: "known_block",
+					In:   "query",
+				}: params.KnownBlock,
+				{
+					Name: "target_block",
+					In:   "query",
+				}: params.TargetBlock,
+				{
+					Name: "mode",
+					In:   "query",
+				}: params.Mode,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetJettonInfoParams
-			Response = *JettonInfo
+			Params   = GetRawBlockProofParams
+			Response = *GetRawBlockProofOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7093,14 +12287,14 @@ func (s *Server) handleGetJettonInfoRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetJettonInfoParams,
+			unpackGetRawBlockProofParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJettonInfo(ctx, params)
+				response, err = s.h.GetRawBlockProof(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJettonInfo(ctx, params)
+		response, err = s.h.GetRawBlockProof(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7119,7 +12313,7 @@ func (s *Server) handleGetJettonInfoRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetJettonInfoResponse(response, w, span); err != nil {
+	if err := encodeGetRawBlockProofResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7128,20 +12322,20 @@ func (s *Server) handleGetJettonInfoRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetJettonTransferPayloadRequest handles getJettonTransferPayload operation.
+// handleGetRawBlockchainBlockRequest handles getRawBlockchainBlock operation.
 //
-// Get jetton's custom payload and state init required for transfer.
+// Get raw blockchain block.
 //
-// GET /v2/jettons/{jetton_id}/transfer/{account_id}/payload
-func (s *Server) handleGetJettonTransferPayloadRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_block/{block_id}
+func (s *Server) handleGetRawBlockchainBlockRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJettonTransferPayload"),
+		otelogen.OperationID("getRawBlockchainBlock"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/jettons/{jetton_id}/transfer/{account_id}/payload"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_block/{block_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettonTransferPayload",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainBlock",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7166,11 +12360,11 @@ func (s *Server) handleGetJettonTransferPayloadRequest(args [2]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetJettonTransferPayload",
-			ID:   "getJettonTransferPayload",
+			Name: "GetRawBlockchainBlock",
+			ID:   "getRawBlockchainBlock",
 		}
 	)
-	params, err := decodeGetJettonTransferPayloadParams(args, argsEscaped, r)
+	params, err := decodeGetRawBlockchainBlockParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7181,31 +12375,27 @@ func (s *Server) handleGetJettonTransferPayloadRequest(args [2]string, argsEscap
 		return
 	}
 
-	var response *JettonTransferPayload
+	var response *GetRawBlockchainBlockOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetJettonTransferPayload",
+			OperationName:    "GetRawBlockchainBlock",
 			OperationSummary: "",
-			OperationID:      "getJettonTransferPayload",
+			OperationID:      "getRawBlockchainBlock",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "jetton_id",
+					Name: "block_id",
 					In:   "path",
-				}: params.JettonID,
+				}: params.BlockID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetJettonTransferPayloadParams
-			Response = *JettonTransferPayload
+			Params   = GetRawBlockchainBlockParams
+			Response = *GetRawBlockchainBlockOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7214,14 +12404,14 @@ func (s *Server) handleGetJettonTransferPayloadRequest(args [2]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackGetJettonTransferPayloadParams,
+			unpackGetRawBlockchainBlockParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJettonTransferPayload(ctx, params)
+				response, err = s.h.GetRawBlockchainBlock(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJettonTransferPayload(ctx, params)
+		response, err = s.h.GetRawBlockchainBlock(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7240,7 +12430,7 @@ func (s *Server) handleGetJettonTransferPayloadRequest(args [2]string, argsEscap
 		return
 	}
 
-	if err := encodeGetJettonTransferPayloadResponse(response, w, span); err != nil {
+	if err := encodeGetRawBlockchainBlockResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7249,20 +12439,20 @@ func (s *Server) handleGetJettonTransferPayloadRequest(args [2]string, argsEscap
 	}
 }
 
-// handleGetJettonsRequest handles getJettons operation.
+// handleGetRawBlockchainBlockHeaderRequest handles getRawBlockchainBlockHeader operation.
 //
-// Get a list of all indexed jetton masters in the blockchain.
+// Get raw blockchain block header.
 //
-// GET /v2/jettons
-func (s *Server) handleGetJettonsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_block_header/{block_id}
+func (s *Server) handleGetRawBlockchainBlockHeaderRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJettons"),
+		otelogen.OperationID("getRawBlockchainBlockHeader"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/jettons"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_block_header/{block_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettons",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainBlockHeader",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7287,11 +12477,11 @@ func (s *Server) handleGetJettonsRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetJettons",
-			ID:   "getJettons",
+			Name: "GetRawBlockchainBlockHeader",
+			ID:   "getRawBlockchainBlockHeader",
 		}
 	)
-	params, err := decodeGetJettonsParams(args, argsEscaped, r)
+	params, err := decodeGetRawBlockchainBlockHeaderParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7302,31 +12492,31 @@ func (s *Server) handleGetJettonsRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	var response *Jettons
+	var response *GetRawBlockchainBlockHeaderOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetJettons",
+			OperationName:    "GetRawBlockchainBlockHeader",
 			OperationSummary: "",
-			OperationID:      "getJettons",
+			OperationID:      "getRawBlockchainBlockHeader",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "block_id",
+					In:   "path",
+				}: params.BlockID,
 				{
-					Name: "offset",
+					Name: "mode",
 					In:   "query",
-				}: params.Offset,
+				}: params.Mode,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetJettonsParams
-			Response = *Jettons
+			Params   = GetRawBlockchainBlockHeaderParams
+			Response = *GetRawBlockchainBlockHeaderOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7335,14 +12525,14 @@ func (s *Server) handleGetJettonsRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetJettonsParams,
+			unpackGetRawBlockchainBlockHeaderParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJettons(ctx, params)
+				response, err = s.h.GetRawBlockchainBlockHeader(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJettons(ctx, params)
+		response, err = s.h.GetRawBlockchainBlockHeader(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7361,7 +12551,7 @@ func (s *Server) handleGetJettonsRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetJettonsResponse(response, w, span); err != nil {
+	if err := encodeGetRawBlockchainBlockHeaderResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7370,20 +12560,20 @@ func (s *Server) handleGetJettonsRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetJettonsEventsRequest handles getJettonsEvents operation.
+// handleGetRawBlockchainBlockStateRequest handles getRawBlockchainBlockState operation.
 //
-// Get only jetton transfers in the event.
+// Get raw blockchain block state.
 //
-// GET /v2/events/{event_id}/jettons
-func (s *Server) handleGetJettonsEventsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_state/{block_id}
+func (s *Server) handleGetRawBlockchainBlockStateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJettonsEvents"),
+		otelogen.OperationID("getRawBlockchainBlockState"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/events/{event_id}/jettons"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_state/{block_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetJettonsEvents",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainBlockState",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7408,11 +12598,11 @@ func (s *Server) handleGetJettonsEventsRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetJettonsEvents",
-			ID:   "getJettonsEvents",
+			Name: "GetRawBlockchainBlockState",
+			ID:   "getRawBlockchainBlockState",
 		}
 	)
-	params, err := decodeGetJettonsEventsParams(args, argsEscaped, r)
+	params, err := decodeGetRawBlockchainBlockStateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7423,31 +12613,27 @@ func (s *Server) handleGetJettonsEventsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *Event
+	var response *GetRawBlockchainBlockStateOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetJettonsEvents",
+			OperationName:    "GetRawBlockchainBlockState",
 			OperationSummary: "",
-			OperationID:      "getJettonsEvents",
+			OperationID:      "getRawBlockchainBlockState",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "event_id",
+					Name: "block_id",
 					In:   "path",
-				}: params.EventID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
+				}: params.BlockID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetJettonsEventsParams
-			Response = *Event
+			Params   = GetRawBlockchainBlockStateParams
+			Response = *GetRawBlockchainBlockStateOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7456,14 +12642,14 @@ func (s *Server) handleGetJettonsEventsRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetJettonsEventsParams,
+			unpackGetRawBlockchainBlockStateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJettonsEvents(ctx, params)
+				response, err = s.h.GetRawBlockchainBlockState(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJettonsEvents(ctx, params)
+		response, err = s.h.GetRawBlockchainBlockState(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7482,7 +12668,7 @@ func (s *Server) handleGetJettonsEventsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetJettonsEventsResponse(response, w, span); err != nil {
+	if err := encodeGetRawBlockchainBlockStateResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7491,20 +12677,20 @@ func (s *Server) handleGetJettonsEventsRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetMarketsRatesRequest handles getMarketsRates operation.
+// handleGetRawBlockchainConfigRequest handles getRawBlockchainConfig operation.
 //
-// Get the TON price from markets.
+// Get raw blockchain config.
 //
-// GET /v2/rates/markets
-func (s *Server) handleGetMarketsRatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/config/raw
+func (s *Server) handleGetRawBlockchainConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMarketsRates"),
+		otelogen.OperationID("getRawBlockchainConfig"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/rates/markets"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/config/raw"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetMarketsRates",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainConfig",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7530,13 +12716,13 @@ func (s *Server) handleGetMarketsRatesRequest(args [0]string, argsEscaped bool,
 		err error
 	)
 
-	var response *GetMarketsRatesOK
+	var response *RawBlockchainConfig
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetMarketsRates",
+			OperationName:    "GetRawBlockchainConfig",
 			OperationSummary: "",
-			OperationID:      "getMarketsRates",
+			OperationID:      "getRawBlockchainConfig",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -7545,7 +12731,7 @@ func (s *Server) handleGetMarketsRatesRequest(args [0]string, argsEscaped bool,
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = *GetMarketsRatesOK
+			Response = *RawBlockchainConfig
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7556,12 +12742,12 @@ func (s *Server) handleGetMarketsRatesRequest(args [0]string, argsEscaped bool,
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetMarketsRates(ctx)
+				response, err = s.h.GetRawBlockchainConfig(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetMarketsRates(ctx)
+		response, err = s.h.GetRawBlockchainConfig(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7580,7 +12766,7 @@ func (s *Server) handleGetMarketsRatesRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetMarketsRatesResponse(response, w, span); err != nil {
+	if err := encodeGetRawBlockchainConfigResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7589,20 +12775,20 @@ func (s *Server) handleGetMarketsRatesRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetMultisigAccountRequest handles getMultisigAccount operation.
+// handleGetRawBlockchainConfigFromBlockRequest handles getRawBlockchainConfigFromBlock operation.
 //
-// Get multisig account info.
+// Get raw blockchain config from a specific block, if present.
 //
-// GET /v2/multisig/{account_id}
-func (s *Server) handleGetMultisigAccountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/masterchain/{masterchain_seqno}/config/raw
+func (s *Server) handleGetRawBlockchainConfigFromBlockRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMultisigAccount"),
+		otelogen.OperationID("getRawBlockchainConfigFromBlock"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/multisig/{account_id}"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/config/raw"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetMultisigAccount",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainConfigFromBlock",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7627,11 +12813,11 @@ func (s *Server) handleGetMultisigAccountRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetMultisigAccount",
-			ID:   "getMultisigAccount",
+			Name: "GetRawBlockchainConfigFromBlock",
+			ID:   "getRawBlockchainConfigFromBlock",
 		}
 	)
-	params, err := decodeGetMultisigAccountParams(args, argsEscaped, r)
+	params, err := decodeGetRawBlockchainConfigFromBlockParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7642,27 +12828,27 @@ func (s *Server) handleGetMultisigAccountRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response *Multisig
+	var response *RawBlockchainConfig
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetMultisigAccount",
+			OperationName:    "GetRawBlockchainConfigFromBlock",
 			OperationSummary: "",
-			OperationID:      "getMultisigAccount",
+			OperationID:      "getRawBlockchainConfigFromBlock",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "masterchain_seqno",
 					In:   "path",
-				}: params.AccountID,
+				}: params.MasterchainSeqno,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetMultisigAccountParams
-			Response = *Multisig
+			Params   = GetRawBlockchainConfigFromBlockParams
+			Response = *RawBlockchainConfig
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7671,14 +12857,14 @@ func (s *Server) handleGetMultisigAccountRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetMultisigAccountParams,
+			unpackGetRawBlockchainConfigFromBlockParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetMultisigAccount(ctx, params)
+				response, err = s.h.GetRawBlockchainConfigFromBlock(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetMultisigAccount(ctx, params)
+		response, err = s.h.GetRawBlockchainConfigFromBlock(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7697,7 +12883,7 @@ func (s *Server) handleGetMultisigAccountRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetMultisigAccountResponse(response, w, span); err != nil {
+	if err := encodeGetRawBlockchainConfigFromBlockResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7706,20 +12892,20 @@ func (s *Server) handleGetMultisigAccountRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetNftCollectionRequest handles getNftCollection operation.
+// handleGetRawConfigRequest handles getRawConfig operation.
 //
-// Get NFT collection by collection address.
+// Get raw config.
 //
-// GET /v2/nfts/collections/{account_id}
-func (s *Server) handleGetNftCollectionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_config_all/{block_id}
+func (s *Server) handleGetRawConfigRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getNftCollection"),
+		otelogen.OperationID("getRawConfig"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/nfts/collections/{account_id}"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_config_all/{block_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftCollection",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawConfig",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7744,11 +12930,11 @@ func (s *Server) handleGetNftCollectionRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetNftCollection",
-			ID:   "getNftCollection",
+			Name: "GetRawConfig",
+			ID:   "getRawConfig",
 		}
 	)
-	params, err := decodeGetNftCollectionParams(args, argsEscaped, r)
+	params, err := decodeGetRawConfigParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7759,27 +12945,31 @@ func (s *Server) handleGetNftCollectionRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *NftCollection
+	var response *GetRawConfigOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetNftCollection",
+			OperationName:    "GetRawConfig",
 			OperationSummary: "",
-			OperationID:      "getNftCollection",
+			OperationID:      "getRawConfig",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "block_id",
 					In:   "path",
-				}: params.AccountID,
+				}: params.BlockID,
+				{
+					Name: "mode",
+					In:   "query",
+				}: params.Mode,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetNftCollectionParams
-			Response = *NftCollection
+			Params   = GetRawConfigParams
+			Response = *GetRawConfigOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7788,14 +12978,14 @@ func (s *Server) handleGetNftCollectionRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetNftCollectionParams,
+			unpackGetRawConfigParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetNftCollection(ctx, params)
+				response, err = s.h.GetRawConfig(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetNftCollection(ctx, params)
+		response, err = s.h.GetRawConfig(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7814,7 +13004,7 @@ func (s *Server) handleGetNftCollectionRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetNftCollectionResponse(response, w, span); err != nil {
+	if err := encodeGetRawConfigResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7823,20 +13013,20 @@ func (s *Server) handleGetNftCollectionRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetNftCollectionsRequest handles getNftCollections operation.
+// handleGetRawListBlockTransactionsRequest handles getRawListBlockTransactions operation.
 //
-// Get NFT collections.
+// Get raw list block transactions.
 //
-// GET /v2/nfts/collections
-func (s *Server) handleGetNftCollectionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/list_block_transactions/{block_id}
+func (s *Server) handleGetRawListBlockTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getNftCollections"),
+		otelogen.OperationID("getRawListBlockTransactions"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/nfts/collections"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/list_block_transactions/{block_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftCollections",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawListBlockTransactions",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7861,11 +13051,11 @@ func (s *Server) handleGetNftCollectionsRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetNftCollections",
-			ID:   "getNftCollections",
+			Name: "GetRawListBlockTransactions",
+			ID:   "getRawListBlockTransactions",
 		}
 	)
-	params, err := decodeGetNftCollectionsParams(args, argsEscaped, r)
+	params, err := decodeGetRawListBlockTransactionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7876,31 +13066,43 @@ func (s *Server) handleGetNftCollectionsRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	var response *NftCollections
+	var response *GetRawListBlockTransactionsOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetNftCollections",
+			OperationName:    "GetRawListBlockTransactions",
 			OperationSummary: "",
-			OperationID:      "getNftCollections",
+			OperationID:      "getRawListBlockTransactions",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "limit",
+					Name: "block_id",
+					In:   "path",
+				}: params.BlockID,
+				{
+					Name: "mode",
 					In:   "query",
-				}: params.Limit,
+				}: params.Mode,
 				{
-					Name: "offset",
+					Name: "count",
 					In:   "query",
-				}: params.Offset,
+				}: params.Count,
+				{
+					Name: "account_id",
+					In:   "query",
+				}: params.AccountID,
+				{
+					Name: "lt",
+					In:   "query",
+				}: params.Lt,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetNftCollectionsParams
-			Response = *NftCollections
+			Params   = GetRawListBlockTransactionsParams
+			Response = *GetRawListBlockTransactionsOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7909,14 +13111,14 @@ func (s *Server) handleGetNftCollectionsRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetNftCollectionsParams,
+			unpackGetRawListBlockTransactionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetNftCollections(ctx, params)
+				response, err = s.h.GetRawListBlockTransactions(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetNftCollections(ctx, params)
+		response, err = s.h.GetRawListBlockTransactions(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7935,7 +13137,7 @@ func (s *Server) handleGetNftCollectionsRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetNftCollectionsResponse(response, w, span); err != nil {
+	if err := encodeGetRawListBlockTransactionsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7944,20 +13146,20 @@ func (s *Server) handleGetNftCollectionsRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleGetNftHistoryByIDRequest handles getNftHistoryByID operation.
+// handleGetRawMasterchainInfoRequest handles getRawMasterchainInfo operation.
 //
-// Get the transfer nfts history for account.
+// Get raw masterchain info.
 //
-// GET /v2/nfts/{account_id}/history
-func (s *Server) handleGetNftHistoryByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_masterchain_info
+func (s *Server) handleGetRawMasterchainInfoRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getNftHistoryByID"),
+		otelogen.OperationID("getRawMasterchainInfo"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/nfts/{account_id}/history"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_masterchain_info"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftHistoryByID",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawMasterchainInfo",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7980,64 +13182,25 @@ func (s *Server) handleGetNftHistoryByIDRequest(args [1]string, argsEscaped bool
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetNftHistoryByID",
-			ID:   "getNftHistoryByID",
-		}
+		err error
 	)
-	params, err := decodeGetNftHistoryByIDParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *AccountEvents
+	var response *GetRawMasterchainInfoOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetNftHistoryByID",
+			OperationName:    "GetRawMasterchainInfo",
 			OperationSummary: "",
-			OperationID:      "getNftHistoryByID",
+			OperationID:      "getRawMasterchainInfo",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
-				{
-					Name: "before_lt",
-					In:   "query",
-				}: params.BeforeLt,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "start_date",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "end_date",
-					In:   "query",
-				}: params.EndDate,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetNftHistoryByIDParams
-			Response = *AccountEvents
+			Params   = struct{}
+			Response = *GetRawMasterchainInfoOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8046,14 +13209,14 @@ func (s *Server) handleGetNftHistoryByIDRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetNftHistoryByIDParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetNftHistoryByID(ctx, params)
+				response, err = s.h.GetRawMasterchainInfo(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetNftHistoryByID(ctx, params)
+		response, err = s.h.GetRawMasterchainInfo(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8072,7 +13235,7 @@ func (s *Server) handleGetNftHistoryByIDRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetNftHistoryByIDResponse(response, w, span); err != nil {
+	if err := encodeGetRawMasterchainInfoResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8081,20 +13244,20 @@ func (s *Server) handleGetNftHistoryByIDRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleGetNftItemByAddressRequest handles getNftItemByAddress operation.
+// handleGetRawMasterchainInfoExtRequest handles getRawMasterchainInfoExt operation.
 //
-// Get NFT item by its address.
+// Get raw masterchain info ext.
 //
-// GET /v2/nfts/{account_id}
-func (s *Server) handleGetNftItemByAddressRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_masterchain_info_ext
+func (s *Server) handleGetRawMasterchainInfoExtRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getNftItemByAddress"),
+		otelogen.OperationID("getRawMasterchainInfoExt"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/nfts/{account_id}"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_masterchain_info_ext"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftItemByAddress",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawMasterchainInfoExt",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8119,11 +13282,11 @@ func (s *Server) handleGetNftItemByAddressRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetNftItemByAddress",
-			ID:   "getNftItemByAddress",
+			Name: "GetRawMasterchainInfoExt",
+			ID:   "getRawMasterchainInfoExt",
 		}
 	)
-	params, err := decodeGetNftItemByAddressParams(args, argsEscaped, r)
+	params, err := decodeGetRawMasterchainInfoExtParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8134,27 +13297,27 @@ func (s *Server) handleGetNftItemByAddressRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response *NftItem
+	var response *GetRawMasterchainInfoExtOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetNftItemByAddress",
+			OperationName:    "GetRawMasterchainInfoExt",
 			OperationSummary: "",
-			OperationID:      "getNftItemByAddress",
+			OperationID:      "getRawMasterchainInfoExt",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
+					Name: "mode",
+					In:   "query",
+				}: params.Mode,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetNftItemByAddressParams
-			Response = *NftItem
+			Params   = GetRawMasterchainInfoExtParams
+			Response = *GetRawMasterchainInfoExtOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8163,14 +13326,14 @@ func (s *Server) handleGetNftItemByAddressRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetNftItemByAddressParams,
+			unpackGetRawMasterchainInfoExtParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetNftItemByAddress(ctx, params)
+				response, err = s.h.GetRawMasterchainInfoExt(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetNftItemByAddress(ctx, params)
+		response, err = s.h.GetRawMasterchainInfoExt(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8189,7 +13352,7 @@ func (s *Server) handleGetNftItemByAddressRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetNftItemByAddressResponse(response, w, span); err != nil {
+	if err := encodeGetRawMasterchainInfoExtResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8198,20 +13361,20 @@ func (s *Server) handleGetNftItemByAddressRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetNftItemsByAddressesRequest handles getNftItemsByAddresses operation.
+// handleGetRawShardBlockProofRequest handles getRawShardBlockProof operation.
 //
-// Get NFT items by their addresses.
+// Get raw shard block proof.
 //
-// POST /v2/nfts/_bulk
-func (s *Server) handleGetNftItemsByAddressesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_shard_block_proof/{block_id}
+func (s *Server) handleGetRawShardBlockProofRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getNftItemsByAddresses"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/nfts/_bulk"),
+		otelogen.OperationID("getRawShardBlockProof"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_shard_block_proof/{block_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetNftItemsByAddresses",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawShardBlockProof",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8236,42 +13399,42 @@ func (s *Server) handleGetNftItemsByAddressesRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetNftItemsByAddresses",
-			ID:   "getNftItemsByAddresses",
+			Name: "GetRawShardBlockProof",
+			ID:   "getRawShardBlockProof",
 		}
 	)
-	request, close, err := s.decodeGetNftItemsByAddressesRequest(r)
+	params, err := decodeGetRawShardBlockProofParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeRequest", err)
+		recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *NftItems
+	var response *GetRawShardBlockProofOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetNftItemsByAddresses",
+			OperationName:    "GetRawShardBlockProof",
 			OperationSummary: "",
-			OperationID:      "getNftItemsByAddresses",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationID:      "getRawShardBlockProof",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "block_id",
+					In:   "path",
+				}: params.BlockID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = OptGetNftItemsByAddressesReq
-			Params   = struct{}
-			Response = *NftItems
+			Request  = struct{}
+			Params   = GetRawShardBlockProofParams
+			Response = *GetRawShardBlockProofOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8280,14 +13443,14 @@ func (s *Server) handleGetNftItemsByAddressesRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetRawShardBlockProofParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetNftItemsByAddresses(ctx, request)
+				response, err = s.h.GetRawShardBlockProof(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetNftItemsByAddresses(ctx, request)
+		response, err = s.h.GetRawShardBlockProof(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8306,7 +13469,7 @@ func (s *Server) handleGetNftItemsByAddressesRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetNftItemsByAddressesResponse(response, w, span); err != nil {
+	if err := encodeGetRawShardBlockProofResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8315,20 +13478,20 @@ func (s *Server) handleGetNftItemsByAddressesRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleGetOutMsgQueueSizesRequest handles getOutMsgQueueSizes operation.
+// handleGetRawShardInfoRequest handles getRawShardInfo operation.
 //
-// Get out msg queue sizes.
+// Get raw shard info.
 //
-// GET /v2/liteserver/get_out_msg_queue_sizes
-func (s *Server) handleGetOutMsgQueueSizesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_shard_info/{block_id}
+func (s *Server) handleGetRawShardInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOutMsgQueueSizes"),
+		otelogen.OperationID("getRawShardInfo"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_out_msg_queue_sizes"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_shard_info/{block_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetOutMsgQueueSizes",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawShardInfo",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8351,25 +13514,56 @@ func (s *Server) handleGetOutMsgQueueSizesRequest(args [0]string, argsEscaped bo
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetRawShardInfo",
+			ID:   "getRawShardInfo",
+		}
 	)
+	params, err := decodeGetRawShardInfoParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *GetOutMsgQueueSizesOK
+	var response *GetRawShardInfoOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetOutMsgQueueSizes",
+			OperationName:    "GetRawShardInfo",
 			OperationSummary: "",
-			OperationID:      "getOutMsgQueueSizes",
+			OperationID:      "getRawShardInfo",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "block_id",
+					In:   "path",
+				}: params.BlockID,
+				{
+					Name: "workchain",
+					In:   "query",
+				}: params.Workchain,
+				{
+					Name: "shard",
+					In:   "query",
+				}: params.Shard,
+				{
+					Name: "exact",
+					In:   "query",
+				}: params.Exact,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *GetOutMsgQueueSizesOK
+			Params   = GetRawShardInfoParams
+			Response = *GetRawShardInfoOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8378,14 +13572,14 @@ func (s *Server) handleGetOutMsgQueueSizesRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetRawShardInfoParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOutMsgQueueSizes(ctx)
+				response, err = s.h.GetRawShardInfo(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOutMsgQueueSizes(ctx)
+		response, err = s.h.GetRawShardInfo(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8404,7 +13598,7 @@ func (s *Server) handleGetOutMsgQueueSizesRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetOutMsgQueueSizesResponse(response, w, span); err != nil {
+	if err := encodeGetRawShardInfoResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8413,21 +13607,20 @@ func (s *Server) handleGetOutMsgQueueSizesRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleGetRatesRequest handles getRates operation.
+// handleGetRawTimeRequest handles getRawTime operation.
 //
-// Get the token price in the chosen currency for display only. Don’t use this for financial
-// transactions.
+// Get raw time.
 //
-// GET /v2/rates
-func (s *Server) handleGetRatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_time
+func (s *Server) handleGetRawTimeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRates"),
+		otelogen.OperationID("getRawTime"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/rates"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_time"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRates",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawTime",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8450,48 +13643,25 @@ func (s *Server) handleGetRatesRequest(args [0]string, argsEscaped bool, w http.
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRates",
-			ID:   "getRates",
-		}
+		err error
 	)
-	params, err := decodeGetRatesParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *GetRatesOK
+	var response *GetRawTimeOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRates",
+			OperationName:    "GetRawTime",
 			OperationSummary: "",
-			OperationID:      "getRates",
+			OperationID:      "getRawTime",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "tokens",
-					In:   "query",
-				}: params.Tokens,
-				{
-					Name: "currencies",
-					In:   "query",
-				}: params.Currencies,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRatesParams
-			Response = *GetRatesOK
+			Params   = struct{}
+			Response = *GetRawTimeOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8500,14 +13670,14 @@ func (s *Server) handleGetRatesRequest(args [0]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetRatesParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRates(ctx, params)
+				response, err = s.h.GetRawTime(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRates(ctx, params)
+		response, err = s.h.GetRawTime(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8526,7 +13696,7 @@ func (s *Server) handleGetRatesRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetRatesResponse(response, w, span); err != nil {
+	if err := encodeGetRawTimeResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8535,20 +13705,20 @@ func (s *Server) handleGetRatesRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetRawAccountStateRequest handles getRawAccountState operation.
+// handleGetRawTransactionsRequest handles getRawTransactions operation.
 //
-// Get raw account state.
+// Get raw transactions.
 //
-// GET /v2/liteserver/get_account_state/{account_id}
-func (s *Server) handleGetRawAccountStateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/liteserver/get_transactions/{account_id}
+func (s *Server) handleGetRawTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawAccountState"),
+		otelogen.OperationID("getRawTransactions"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_account_state/{account_id}"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/get_transactions/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawAccountState",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawTransactions",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8573,11 +13743,11 @@ func (s *Server) handleGetRawAccountStateRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawAccountState",
-			ID:   "getRawAccountState",
+			Name: "GetRawTransactions",
+			ID:   "getRawTransactions",
 		}
 	)
-	params, err := decodeGetRawAccountStateParams(args, argsEscaped, r)
+	params, err := decodeGetRawTransactionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8588,13 +13758,13 @@ func (s *Server) handleGetRawAccountStateRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response *GetRawAccountStateOK
+	var response *GetRawTransactionsOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawAccountState",
+			OperationName:    "GetRawTransactions",
 			OperationSummary: "",
-			OperationID:      "getRawAccountState",
+			OperationID:      "getRawTransactions",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -8602,17 +13772,25 @@ func (s *Server) handleGetRawAccountStateRequest(args [1]string, argsEscaped boo
 					In:   "path",
 				}: params.AccountID,
 				{
-					Name: "target_block",
+					Name: "count",
 					In:   "query",
-				}: params.TargetBlock,
+				}: params.Count,
+				{
+					Name: "lt",
+					In:   "query",
+				}: params.Lt,
+				{
+					Name: "hash",
+					In:   "query",
+				}: params.Hash,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawAccountStateParams
-			Response = *GetRawAccountStateOK
+			Params   = GetRawTransactionsParams
+			Response = *GetRawTransactionsOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8621,14 +13799,14 @@ func (s *Server) handleGetRawAccountStateRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetRawAccountStateParams,
+			unpackGetRawTransactionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawAccountState(ctx, params)
+				response, err = s.h.GetRawTransactions(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawAccountState(ctx, params)
+		response, err = s.h.GetRawTransactions(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8647,7 +13825,7 @@ func (s *Server) handleGetRawAccountStateRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetRawAccountStateResponse(response, w, span); err != nil {
+	if err := encodeGetRawTransactionsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8656,20 +13834,20 @@ func (s *Server) handleGetRawAccountStateRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetRawBlockProofRequest handles getRawBlockProof operation.
+// handleGetReducedBlockchainBlocksRequest handles getReducedBlockchainBlocks operation.
 //
-// Get raw block proof.
+// Get reduced blockchain blocks data.
 //
-// GET /v2/liteserver/get_block_proof
-func (s *Server) handleGetRawBlockProofRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/reduced/blocks
+func (s *Server) handleGetReducedBlockchainBlocksRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawBlockProof"),
+		otelogen.OperationID("getReducedBlockchainBlocks"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_block_proof"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/reduced/blocks"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockProof",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetReducedBlockchainBlocks",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8694,11 +13872,11 @@ func (s *Server) handleGetRawBlockProofRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawBlockProof",
-			ID:   "getRawBlockProof",
+			Name: "GetReducedBlockchainBlocks",
+			ID:   "getReducedBlockchainBlocks",
 		}
 	)
-	params, err := decodeGetRawBlockProofParams(args, argsEscaped, r)
+	params, err := decodeGetReducedBlockchainBlocksParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8709,35 +13887,31 @@ func (s *Server) handleGetRawBlockProofRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	var response *GetRawBlockProofOK
+	var response *ReducedBlocks
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawBlockProof",
+			OperationName:    "GetReducedBlockchainBlocks",
 			OperationSummary: "",
-			OperationID:      "getRawBlockProof",
+			OperationID:      "getReducedBlockchainBlocks",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "known_block",
-					In:   "query",
-				}: params.KnownBlock,
-				{
-					Name: "target_block",
+					Name: "from",
 					In:   "query",
-				}: params.TargetBlock,
+				}: params.From,
 				{
-					Name: "mode",
+					Name: "to",
 					In:   "query",
-				}: params.Mode,
+				}: params.To,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawBlockProofParams
-			Response = *GetRawBlockProofOK
+			Params   = GetReducedBlockchainBlocksParams
+			Response = *ReducedBlocks
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8746,14 +13920,14 @@ func (s *Server) handleGetRawBlockProofRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetRawBlockProofParams,
+			unpackGetReducedBlockchainBlocksParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawBlockProof(ctx, params)
+				response, err = s.h.GetReducedBlockchainBlocks(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawBlockProof(ctx, params)
+		response, err = s.h.GetReducedBlockchainBlocks(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8772,7 +13946,7 @@ func (s *Server) handleGetRawBlockProofRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetRawBlockProofResponse(response, w, span); err != nil {
+	if err := encodeGetReducedBlockchainBlocksResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8781,20 +13955,20 @@ func (s *Server) handleGetRawBlockProofRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetRawBlockchainBlockRequest handles getRawBlockchainBlock operation.
+// handleGetStakingPoolHistoryRequest handles getStakingPoolHistory operation.
 //
-// Get raw blockchain block.
+// Pool history.
 //
-// GET /v2/liteserver/get_block/{block_id}
-func (s *Server) handleGetRawBlockchainBlockRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/staking/pool/{account_id}/history
+func (s *Server) handleGetStakingPoolHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawBlockchainBlock"),
+		otelogen.OperationID("getStakingPoolHistory"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_block/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/staking/pool/{account_id}/history"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainBlock",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetStakingPoolHistory",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8819,11 +13993,11 @@ func (s *Server) handleGetRawBlockchainBlockRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawBlockchainBlock",
-			ID:   "getRawBlockchainBlock",
+			Name: "GetStakingPoolHistory",
+			ID:   "getStakingPoolHistory",
 		}
 	)
-	params, err := decodeGetRawBlockchainBlockParams(args, argsEscaped, r)
+	params, err := decodeGetStakingPoolHistoryParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8834,27 +14008,27 @@ func (s *Server) handleGetRawBlockchainBlockRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response *GetRawBlockchainBlockOK
+	var response *GetStakingPoolHistoryOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawBlockchainBlock",
+			OperationName:    "GetStakingPoolHistory",
 			OperationSummary: "",
-			OperationID:      "getRawBlockchainBlock",
+			OperationID:      "getStakingPoolHistory",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
+					Name: "account_id",
 					In:   "path",
-				}: params.BlockID,
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawBlockchainBlockParams
-			Response = *GetRawBlockchainBlockOK
+			Params   = GetStakingPoolHistoryParams
+			Response = *GetStakingPoolHistoryOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8863,14 +14037,14 @@ func (s *Server) handleGetRawBlockchainBlockRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetRawBlockchainBlockParams,
+			unpackGetStakingPoolHistoryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawBlockchainBlock(ctx, params)
+				response, err = s.h.GetStakingPoolHistory(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawBlockchainBlock(ctx, params)
+		response, err = s.h.GetStakingPoolHistory(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8889,7 +14063,7 @@ func (s *Server) handleGetRawBlockchainBlockRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetRawBlockchainBlockResponse(response, w, span); err != nil {
+	if err := encodeGetStakingPoolHistoryResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8898,20 +14072,20 @@ func (s *Server) handleGetRawBlockchainBlockRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetRawBlockchainBlockHeaderRequest handles getRawBlockchainBlockHeader operation.
+// handleGetStakingPoolInfoRequest handles getStakingPoolInfo operation.
 //
-// Get raw blockchain block header.
+// Stacking pool info.
 //
-// GET /v2/liteserver/get_block_header/{block_id}
-func (s *Server) handleGetRawBlockchainBlockHeaderRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/staking/pool/{account_id}
+func (s *Server) handleGetStakingPoolInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawBlockchainBlockHeader"),
+		otelogen.OperationID("getStakingPoolInfo"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_block_header/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/staking/pool/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainBlockHeader",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetStakingPoolInfo",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8936,11 +14110,11 @@ func (s *Server) handleGetRawBlockchainBlockHeaderRequest(args [1]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawBlockchainBlockHeader",
-			ID:   "getRawBlockchainBlockHeader",
+			Name: "GetStakingPoolInfo",
+			ID:   "getStakingPoolInfo",
 		}
 	)
-	params, err := decodeGetRawBlockchainBlockHeaderParams(args, argsEscaped, r)
+	params, err := decodeGetStakingPoolInfoParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8951,31 +14125,31 @@ func (s *Server) handleGetRawBlockchainBlockHeaderRequest(args [1]string, argsEs
 		return
 	}
 
-	var response *GetRawBlockchainBlockHeaderOK
+	var response *GetStakingPoolInfoOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawBlockchainBlockHeader",
+			OperationName:    "GetStakingPoolInfo",
 			OperationSummary: "",
-			OperationID:      "getRawBlockchainBlockHeader",
+			OperationID:      "getStakingPoolInfo",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
+					Name: "account_id",
 					In:   "path",
-				}: params.BlockID,
+				}: params.AccountID,
 				{
-					Name: "mode",
-					In:   "query",
-				}: params.Mode,
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawBlockchainBlockHeaderParams
-			Response = *GetRawBlockchainBlockHeaderOK
+			Params   = GetStakingPoolInfoParams
+			Response = *GetStakingPoolInfoOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8984,14 +14158,14 @@ func (s *Server) handleGetRawBlockchainBlockHeaderRequest(args [1]string, argsEs
 		](
 			m,
 			mreq,
-			unpackGetRawBlockchainBlockHeaderParams,
+			unpackGetStakingPoolInfoParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawBlockchainBlockHeader(ctx, params)
+				response, err = s.h.GetStakingPoolInfo(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawBlockchainBlockHeader(ctx, params)
+		response, err = s.h.GetStakingPoolInfo(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9010,7 +14184,7 @@ func (s *Server) handleGetRawBlockchainBlockHeaderRequest(args [1]string, argsEs
 		return
 	}
 
-	if err := encodeGetRawBlockchainBlockHeaderResponse(response, w, span); err != nil {
+	if err := encodeGetStakingPoolInfoResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9019,20 +14193,20 @@ func (s *Server) handleGetRawBlockchainBlockHeaderRequest(args [1]string, argsEs
 	}
 }
 
-// handleGetRawBlockchainBlockStateRequest handles getRawBlockchainBlockState operation.
+// handleGetStakingPoolsRequest handles getStakingPools operation.
 //
-// Get raw blockchain block state.
+// All pools available in network.
 //
-// GET /v2/liteserver/get_state/{block_id}
-func (s *Server) handleGetRawBlockchainBlockStateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/staking/pools
+func (s *Server) handleGetStakingPoolsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawBlockchainBlockState"),
+		otelogen.OperationID("getStakingPools"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_state/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/staking/pools"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainBlockState",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetStakingPools",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9057,11 +14231,11 @@ func (s *Server) handleGetRawBlockchainBlockStateRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawBlockchainBlockState",
-			ID:   "getRawBlockchainBlockState",
+			Name: "GetStakingPools",
+			ID:   "getStakingPools",
 		}
 	)
-	params, err := decodeGetRawBlockchainBlockStateParams(args, argsEscaped, r)
+	params, err := decodeGetStakingPoolsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9072,27 +14246,35 @@ func (s *Server) handleGetRawBlockchainBlockStateRequest(args [1]string, argsEsc
 		return
 	}
 
-	var response *GetRawBlockchainBlockStateOK
+	var response *GetStakingPoolsOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawBlockchainBlockState",
+			OperationName:    "GetStakingPools",
 			OperationSummary: "",
-			OperationID:      "getRawBlockchainBlockState",
+			OperationID:      "getStakingPools",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
-					In:   "path",
-				}: params.BlockID,
+					Name: "available_for",
+					In:   "query",
+				}: params.AvailableFor,
+				{
+					Name: "include_unverified",
+					In:   "query",
+				}: params.IncludeUnverified,
+				{
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawBlockchainBlockStateParams
-			Response = *GetRawBlockchainBlockStateOK
+			Params   = GetStakingPoolsParams
+			Response = *GetStakingPoolsOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9101,14 +14283,14 @@ func (s *Server) handleGetRawBlockchainBlockStateRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackGetRawBlockchainBlockStateParams,
+			unpackGetStakingPoolsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawBlockchainBlockState(ctx, params)
+				response, err = s.h.GetStakingPools(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawBlockchainBlockState(ctx, params)
+		response, err = s.h.GetStakingPools(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9127,7 +14309,7 @@ func (s *Server) handleGetRawBlockchainBlockStateRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeGetRawBlockchainBlockStateResponse(response, w, span); err != nil {
+	if err := encodeGetStakingPoolsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9136,20 +14318,20 @@ func (s *Server) handleGetRawBlockchainBlockStateRequest(args [1]string, argsEsc
 	}
 }
 
-// handleGetRawBlockchainConfigRequest handles getRawBlockchainConfig operation.
+// handleGetStorageProvidersRequest handles getStorageProviders operation.
 //
-// Get raw blockchain config.
+// Get TON storage providers deployed to the blockchain.
 //
-// GET /v2/blockchain/config/raw
-func (s *Server) handleGetRawBlockchainConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/storage/providers
+func (s *Server) handleGetStorageProvidersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawBlockchainConfig"),
+		otelogen.OperationID("getStorageProviders"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/config/raw"),
+		semconv.HTTPRouteKey.String("/v2/storage/providers"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainConfig",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetStorageProviders",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9175,13 +14357,13 @@ func (s *Server) handleGetRawBlockchainConfigRequest(args [0]string, argsEscaped
 		err error
 	)
 
-	var response *RawBlockchainConfig
+	var response *GetStorageProvidersOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawBlockchainConfig",
+			OperationName:    "GetStorageProviders",
 			OperationSummary: "",
-			OperationID:      "getRawBlockchainConfig",
+			OperationID:      "getStorageProviders",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -9190,7 +14372,7 @@ func (s *Server) handleGetRawBlockchainConfigRequest(args [0]string, argsEscaped
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = *RawBlockchainConfig
+			Response = *GetStorageProvidersOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9201,12 +14383,12 @@ func (s *Server) handleGetRawBlockchainConfigRequest(args [0]string, argsEscaped
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawBlockchainConfig(ctx)
+				response, err = s.h.GetStorageProviders(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawBlockchainConfig(ctx)
+		response, err = s.h.GetStorageProviders(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9225,7 +14407,7 @@ func (s *Server) handleGetRawBlockchainConfigRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetRawBlockchainConfigResponse(response, w, span); err != nil {
+	if err := encodeGetStorageProvidersResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9234,20 +14416,20 @@ func (s *Server) handleGetRawBlockchainConfigRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleGetRawBlockchainConfigFromBlockRequest handles getRawBlockchainConfigFromBlock operation.
+// handleGetTonConnectPayloadRequest handles getTonConnectPayload operation.
 //
-// Get raw blockchain config from a specific block, if present.
+// Get a payload for further token receipt.
 //
-// GET /v2/blockchain/masterchain/{masterchain_seqno}/config/raw
-func (s *Server) handleGetRawBlockchainConfigFromBlockRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/tonconnect/payload
+func (s *Server) handleGetTonConnectPayloadRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawBlockchainConfigFromBlock"),
+		otelogen.OperationID("getTonConnectPayload"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/masterchain/{masterchain_seqno}/config/raw"),
+		semconv.HTTPRouteKey.String("/v2/tonconnect/payload"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawBlockchainConfigFromBlock",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetTonConnectPayload",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9270,44 +14452,25 @@ func (s *Server) handleGetRawBlockchainConfigFromBlockRequest(args [1]string, ar
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawBlockchainConfigFromBlock",
-			ID:   "getRawBlockchainConfigFromBlock",
-		}
+		err error
 	)
-	params, err := decodeGetRawBlockchainConfigFromBlockParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *RawBlockchainConfig
+	var response *GetTonConnectPayloadOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawBlockchainConfigFromBlock",
-			OperationSummary: "",
-			OperationID:      "getRawBlockchainConfigFromBlock",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "masterchain_seqno",
-					In:   "path",
-				}: params.MasterchainSeqno,
-			},
-			Raw: r,
+			OperationName:    "GetTonConnectPayload",
+			OperationSummary: "",
+			OperationID:      "getTonConnectPayload",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawBlockchainConfigFromBlockParams
-			Response = *RawBlockchainConfig
+			Params   = struct{}
+			Response = *GetTonConnectPayloadOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9316,14 +14479,14 @@ func (s *Server) handleGetRawBlockchainConfigFromBlockRequest(args [1]string, ar
 		](
 			m,
 			mreq,
-			unpackGetRawBlockchainConfigFromBlockParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawBlockchainConfigFromBlock(ctx, params)
+				response, err = s.h.GetTonConnectPayload(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawBlockchainConfigFromBlock(ctx, params)
+		response, err = s.h.GetTonConnectPayload(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9342,7 +14505,7 @@ func (s *Server) handleGetRawBlockchainConfigFromBlockRequest(args [1]string, ar
 		return
 	}
 
-	if err := encodeGetRawBlockchainConfigFromBlockResponse(response, w, span); err != nil {
+	if err := encodeGetTonConnectPayloadResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9351,20 +14514,21 @@ func (s *Server) handleGetRawBlockchainConfigFromBlockRequest(args [1]string, ar
 	}
 }
 
-// handleGetRawConfigRequest handles getRawConfig operation.
+// handleGetTopAccountsRequest handles getTopAccounts operation.
 //
-// Get raw config.
+// Get top accounts by TON balance or activity among accounts tracked by the indexer. The list is
+// refreshed periodically.
 //
-// GET /v2/liteserver/get_config_all/{block_id}
-func (s *Server) handleGetRawConfigRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/accounts/top
+func (s *Server) handleGetTopAccountsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawConfig"),
+		otelogen.OperationID("getTopAccounts"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_config_all/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/accounts/top"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawConfig",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetTopAccounts",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9389,11 +14553,11 @@ func (s *Server) handleGetRawConfigRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawConfig",
-			ID:   "getRawConfig",
+			Name: "GetTopAccounts",
+			ID:   "getTopAccounts",
 		}
 	)
-	params, err := decodeGetRawConfigParams(args, argsEscaped, r)
+	params, err := decodeGetTopAccountsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9404,31 +14568,39 @@ func (s *Server) handleGetRawConfigRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	var response *GetRawConfigOK
+	var response *GetTopAccountsOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawConfig",
+			OperationName:    "GetTopAccounts",
 			OperationSummary: "",
-			OperationID:      "getRawConfig",
+			OperationID:      "getTopAccounts",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
-					In:   "path",
-				}: params.BlockID,
+					Name: "order",
+					In:   "query",
+				}: params.Order,
 				{
-					Name: "mode",
+					Name: "exclude_labeled",
 					In:   "query",
-				}: params.Mode,
+				}: params.ExcludeLabeled,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawConfigParams
-			Response = *GetRawConfigOK
+			Params   = GetTopAccountsParams
+			Response = *GetTopAccountsOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9437,14 +14609,14 @@ func (s *Server) handleGetRawConfigRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackGetRawConfigParams,
+			unpackGetTopAccountsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawConfig(ctx, params)
+				response, err = s.h.GetTopAccounts(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawConfig(ctx, params)
+		response, err = s.h.GetTopAccounts(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9463,7 +14635,7 @@ func (s *Server) handleGetRawConfigRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetRawConfigResponse(response, w, span); err != nil {
+	if err := encodeGetTopAccountsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9472,20 +14644,20 @@ func (s *Server) handleGetRawConfigRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetRawListBlockTransactionsRequest handles getRawListBlockTransactions operation.
+// handleGetTopJettonsByVolumeRequest handles getTopJettonsByVolume operation.
 //
-// Get raw list block transactions.
+// Get jettons with the highest number of transfers observed by the indexer during the given period.
 //
-// GET /v2/liteserver/list_block_transactions/{block_id}
-func (s *Server) handleGetRawListBlockTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/jettons/top
+func (s *Server) handleGetTopJettonsByVolumeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawListBlockTransactions"),
+		otelogen.OperationID("getTopJettonsByVolume"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/list_block_transactions/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/jettons/top"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawListBlockTransactions",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetTopJettonsByVolume",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9510,11 +14682,11 @@ func (s *Server) handleGetRawListBlockTransactionsRequest(args [1]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawListBlockTransactions",
-			ID:   "getRawListBlockTransactions",
+			Name: "GetTopJettonsByVolume",
+			ID:   "getTopJettonsByVolume",
 		}
 	)
-	params, err := decodeGetRawListBlockTransactionsParams(args, argsEscaped, r)
+	params, err := decodeGetTopJettonsByVolumeParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9525,43 +14697,31 @@ func (s *Server) handleGetRawListBlockTransactionsRequest(args [1]string, argsEs
 		return
 	}
 
-	var response *GetRawListBlockTransactionsOK
+	var response *GetTopJettonsByVolumeOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawListBlockTransactions",
+			OperationName:    "GetTopJettonsByVolume",
 			OperationSummary: "",
-			OperationID:      "getRawListBlockTransactions",
+			OperationID:      "getTopJettonsByVolume",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
-					In:   "path",
-				}: params.BlockID,
-				{
-					Name: "mode",
-					In:   "query",
-				}: params.Mode,
-				{
-					Name: "count",
-					In:   "query",
-				}: params.Count,
-				{
-					Name: "account_id",
+					Name: "period",
 					In:   "query",
-				}: params.AccountID,
+				}: params.Period,
 				{
-					Name: "lt",
+					Name: "limit",
 					In:   "query",
-				}: params.Lt,
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawListBlockTransactionsParams
-			Response = *GetRawListBlockTransactionsOK
+			Params   = GetTopJettonsByVolumeParams
+			Response = *GetTopJettonsByVolumeOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9570,14 +14730,14 @@ func (s *Server) handleGetRawListBlockTransactionsRequest(args [1]string, argsEs
 		](
 			m,
 			mreq,
-			unpackGetRawListBlockTransactionsParams,
+			unpackGetTopJettonsByVolumeParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawListBlockTransactions(ctx, params)
+				response, err = s.h.GetTopJettonsByVolume(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawListBlockTransactions(ctx, params)
+		response, err = s.h.GetTopJettonsByVolume(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9596,7 +14756,7 @@ func (s *Server) handleGetRawListBlockTransactionsRequest(args [1]string, argsEs
 		return
 	}
 
-	if err := encodeGetRawListBlockTransactionsResponse(response, w, span); err != nil {
+	if err := encodeGetTopJettonsByVolumeResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9605,20 +14765,21 @@ func (s *Server) handleGetRawListBlockTransactionsRequest(args [1]string, argsEs
 	}
 }
 
-// handleGetRawMasterchainInfoRequest handles getRawMasterchainInfo operation.
+// handleGetTraceRequest handles getTrace operation.
 //
-// Get raw masterchain info.
+// Get the trace by trace ID, hash of any transaction in trace or any form of a hash of its inbound
+// message. The root node points to the matched transaction.
 //
-// GET /v2/liteserver/get_masterchain_info
-func (s *Server) handleGetRawMasterchainInfoRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/traces/{trace_id}
+func (s *Server) handleGetTraceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawMasterchainInfo"),
+		otelogen.OperationID("getTrace"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_masterchain_info"),
+		semconv.HTTPRouteKey.String("/v2/traces/{trace_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawMasterchainInfo",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetTrace",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9641,25 +14802,56 @@ func (s *Server) handleGetRawMasterchainInfoRequest(args [0]string, argsEscaped
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "GetTrace",
+			ID:   "getTrace",
+		}
 	)
+	params, err := decodeGetTraceParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *GetRawMasterchainInfoOK
+	var response GetTraceRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawMasterchainInfo",
+			OperationName:    "GetTrace",
 			OperationSummary: "",
-			OperationID:      "getRawMasterchainInfo",
+			OperationID:      "getTrace",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "trace_id",
+					In:   "path",
+				}: params.TraceID,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "gas_profile",
+					In:   "query",
+				}: params.GasProfile,
+				{
+					Name: "emulate_pending",
+					In:   "query",
+				}: params.EmulatePending,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *GetRawMasterchainInfoOK
+			Params   = GetTraceParams
+			Response = GetTraceRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9668,14 +14860,14 @@ func (s *Server) handleGetRawMasterchainInfoRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetTraceParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawMasterchainInfo(ctx)
+				response, err = s.h.GetTrace(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawMasterchainInfo(ctx)
+		response, err = s.h.GetTrace(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9694,7 +14886,7 @@ func (s *Server) handleGetRawMasterchainInfoRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetRawMasterchainInfoResponse(response, w, span); err != nil {
+	if err := encodeGetTraceResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9703,20 +14895,21 @@ func (s *Server) handleGetRawMasterchainInfoRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleGetRawMasterchainInfoExtRequest handles getRawMasterchainInfoExt operation.
+// handleGetUsageRequest handles getUsage operation.
 //
-// Get raw masterchain info ext.
+// Get upstream resources consumed by API tokens since the start of the instance for internal
+// chargeback. A tenant gets its own usage, a token with the admin scope gets usage of all tokens.
 //
-// GET /v2/liteserver/get_masterchain_info_ext
-func (s *Server) handleGetRawMasterchainInfoExtRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/usage
+func (s *Server) handleGetUsageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawMasterchainInfoExt"),
+		otelogen.OperationID("getUsage"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_masterchain_info_ext"),
+		semconv.HTTPRouteKey.String("/v2/usage"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawMasterchainInfoExt",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetUsage",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9739,44 +14932,25 @@ func (s *Server) handleGetRawMasterchainInfoExtRequest(args [0]string, argsEscap
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawMasterchainInfoExt",
-			ID:   "getRawMasterchainInfoExt",
-		}
+		err error
 	)
-	params, err := decodeGetRawMasterchainInfoExtParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response *GetRawMasterchainInfoExtOK
+	var response *Usage
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawMasterchainInfoExt",
+			OperationName:    "GetUsage",
 			OperationSummary: "",
-			OperationID:      "getRawMasterchainInfoExt",
+			OperationID:      "getUsage",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "mode",
-					In:   "query",
-				}: params.Mode,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawMasterchainInfoExtParams
-			Response = *GetRawMasterchainInfoExtOK
+			Params   = struct{}
+			Response = *Usage
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9785,14 +14959,14 @@ func (s *Server) handleGetRawMasterchainInfoExtRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackGetRawMasterchainInfoExtParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawMasterchainInfoExt(ctx, params)
+				response, err = s.h.GetUsage(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawMasterchainInfoExt(ctx, params)
+		response, err = s.h.GetUsage(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9811,7 +14985,7 @@ func (s *Server) handleGetRawMasterchainInfoExtRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeGetRawMasterchainInfoExtResponse(response, w, span); err != nil {
+	if err := encodeGetUsageResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9820,20 +14994,20 @@ func (s *Server) handleGetRawMasterchainInfoExtRequest(args [0]string, argsEscap
 	}
 }
 
-// handleGetRawShardBlockProofRequest handles getRawShardBlockProof operation.
+// handleGetWalletBackupRequest handles getWalletBackup operation.
 //
-// Get raw shard block proof.
+// Get backup info.
 //
-// GET /v2/liteserver/get_shard_block_proof/{block_id}
-func (s *Server) handleGetRawShardBlockProofRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/wallet/backup
+func (s *Server) handleGetWalletBackupRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawShardBlockProof"),
+		otelogen.OperationID("getWalletBackup"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_shard_block_proof/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/wallet/backup"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawShardBlockProof",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetWalletBackup",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9858,11 +15032,11 @@ func (s *Server) handleGetRawShardBlockProofRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawShardBlockProof",
-			ID:   "getRawShardBlockProof",
+			Name: "GetWalletBackup",
+			ID:   "getWalletBackup",
 		}
 	)
-	params, err := decodeGetRawShardBlockProofParams(args, argsEscaped, r)
+	params, err := decodeGetWalletBackupParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9873,27 +15047,27 @@ func (s *Server) handleGetRawShardBlockProofRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response *GetRawShardBlockProofOK
+	var response *GetWalletBackupOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawShardBlockProof",
+			OperationName:    "GetWalletBackup",
 			OperationSummary: "",
-			OperationID:      "getRawShardBlockProof",
+			OperationID:      "getWalletBackup",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
-					In:   "path",
-				}: params.BlockID,
+					Name: "X-TonConnect-Auth",
+					In:   "header",
+				}: params.XTonConnectAuth,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawShardBlockProofParams
-			Response = *GetRawShardBlockProofOK
+			Params   = GetWalletBackupParams
+			Response = *GetWalletBackupOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9902,14 +15076,14 @@ func (s *Server) handleGetRawShardBlockProofRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetRawShardBlockProofParams,
+			unpackGetWalletBackupParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawShardBlockProof(ctx, params)
+				response, err = s.h.GetWalletBackup(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawShardBlockProof(ctx, params)
+		response, err = s.h.GetWalletBackup(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9928,7 +15102,7 @@ func (s *Server) handleGetRawShardBlockProofRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetRawShardBlockProofResponse(response, w, span); err != nil {
+	if err := encodeGetWalletBackupResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9937,20 +15111,20 @@ func (s *Server) handleGetRawShardBlockProofRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetRawShardInfoRequest handles getRawShardInfo operation.
+// handleGetWalletsByPublicKeyRequest handles getWalletsByPublicKey operation.
 //
-// Get raw shard info.
+// Get wallets by public key.
 //
-// GET /v2/liteserver/get_shard_info/{block_id}
-func (s *Server) handleGetRawShardInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/pubkeys/{public_key}/wallets
+func (s *Server) handleGetWalletsByPublicKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawShardInfo"),
+		otelogen.OperationID("getWalletsByPublicKey"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_shard_info/{block_id}"),
+		semconv.HTTPRouteKey.String("/v2/pubkeys/{public_key}/wallets"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawShardInfo",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetWalletsByPublicKey",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9975,11 +15149,11 @@ func (s *Server) handleGetRawShardInfoRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawShardInfo",
-			ID:   "getRawShardInfo",
+			Name: "GetWalletsByPublicKey",
+			ID:   "getWalletsByPublicKey",
 		}
 	)
-	params, err := decodeGetRawShardInfoParams(args, argsEscaped, r)
+	params, err := decodeGetWalletsByPublicKeyParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9990,39 +15164,27 @@ func (s *Server) handleGetRawShardInfoRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response *GetRawShardInfoOK
+	var response *Accounts
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawShardInfo",
+			OperationName:    "GetWalletsByPublicKey",
 			OperationSummary: "",
-			OperationID:      "getRawShardInfo",
+			OperationID:      "getWalletsByPublicKey",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "block_id",
+					Name: "public_key",
 					In:   "path",
-				}: params.BlockID,
-				{
-					Name: "workchain",
-					In:   "query",
-				}: params.Workchain,
-				{
-					Name: "shard",
-					In:   "query",
-				}: params.Shard,
-				{
-					Name: "exact",
-					In:   "query",
-				}: params.Exact,
+				}: params.PublicKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRawShardInfoParams
-			Response = *GetRawShardInfoOK
+			Params   = GetWalletsByPublicKeyParams
+			Response = *Accounts
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10031,14 +15193,14 @@ func (s *Server) handleGetRawShardInfoRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetRawShardInfoParams,
+			unpackGetWalletsByPublicKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawShardInfo(ctx, params)
+				response, err = s.h.GetWalletsByPublicKey(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawShardInfo(ctx, params)
+		response, err = s.h.GetWalletsByPublicKey(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10057,7 +15219,7 @@ func (s *Server) handleGetRawShardInfoRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetRawShardInfoResponse(response, w, span); err != nil {
+	if err := encodeGetWalletsByPublicKeyResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10066,20 +15228,21 @@ func (s *Server) handleGetRawShardInfoRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetRawTimeRequest handles getRawTime operation.
+// handleGetWebhooksRequest handles getWebhooks operation.
 //
-// Get raw time.
+// Get webhooks along with their delivery statuses. A tenant gets its own webhooks, a token with the
+// admin scope gets all of them.
 //
-// GET /v2/liteserver/get_time
-func (s *Server) handleGetRawTimeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/webhooks
+func (s *Server) handleGetWebhooksRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawTime"),
+		otelogen.OperationID("getWebhooks"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_time"),
+		semconv.HTTPRouteKey.String("/v2/webhooks"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawTime",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetWebhooks",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10105,13 +15268,13 @@ func (s *Server) handleGetRawTimeRequest(args [0]string, argsEscaped bool, w htt
 		err error
 	)
 
-	var response *GetRawTimeOK
+	var response *Webhooks
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawTime",
+			OperationName:    "GetWebhooks",
 			OperationSummary: "",
-			OperationID:      "getRawTime",
+			OperationID:      "getWebhooks",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -10120,7 +15283,7 @@ func (s *Server) handleGetRawTimeRequest(args [0]string, argsEscaped bool, w htt
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = *GetRawTimeOK
+			Response = *Webhooks
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10131,12 +15294,12 @@ func (s *Server) handleGetRawTimeRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawTime(ctx)
+				response, err = s.h.GetWebhooks(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawTime(ctx)
+		response, err = s.h.GetWebhooks(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10155,7 +15318,7 @@ func (s *Server) handleGetRawTimeRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetRawTimeResponse(response, w, span); err != nil {
+	if err := encodeGetWebhooksResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10164,20 +15327,20 @@ func (s *Server) handleGetRawTimeRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetRawTransactionsRequest handles getRawTransactions operation.
+// handleInspectBocRequest handles inspectBoc operation.
 //
-// Get raw transactions.
+// Inspect a bag-of-cells and return its cells with depths and references along with a printable tree.
 //
-// GET /v2/liteserver/get_transactions/{account_id}
-func (s *Server) handleGetRawTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/boc/inspect
+func (s *Server) handleInspectBocRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRawTransactions"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/get_transactions/{account_id}"),
+		otelogen.OperationID("inspectBoc"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/boc/inspect"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetRawTransactions",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "InspectBoc",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10202,54 +15365,42 @@ func (s *Server) handleGetRawTransactionsRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetRawTransactions",
-			ID:   "getRawTransactions",
+			Name: "InspectBoc",
+			ID:   "inspectBoc",
 		}
 	)
-	params, err := decodeGetRawTransactionsParams(args, argsEscaped, r)
+	request, close, err := s.decodeInspectBocRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *GetRawTransactionsOK
+	var response *BocInspection
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetRawTransactions",
+			OperationName:    "InspectBoc",
 			OperationSummary: "",
-			OperationID:      "getRawTransactions",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "count",
-					In:   "query",
-				}: params.Count,
-				{
-					Name: "lt",
-					In:   "query",
-				}: params.Lt,
-				{
-					Name: "hash",
-					In:   "query",
-				}: params.Hash,
-			},
-			Raw: r,
+			OperationID:      "inspectBoc",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetRawTransactionsParams
-			Response = *GetRawTransactionsOK
+			Request  = *InspectBocReq
+			Params   = struct{}
+			Response = *BocInspection
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10258,14 +15409,14 @@ func (s *Server) handleGetRawTransactionsRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetRawTransactionsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRawTransactions(ctx, params)
+				response, err = s.h.InspectBoc(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRawTransactions(ctx, params)
+		response, err = s.h.InspectBoc(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10284,7 +15435,7 @@ func (s *Server) handleGetRawTransactionsRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetRawTransactionsResponse(response, w, span); err != nil {
+	if err := encodeInspectBocResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10293,20 +15444,21 @@ func (s *Server) handleGetRawTransactionsRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetReducedBlockchainBlocksRequest handles getReducedBlockchainBlocks operation.
+// handleInspectContractCodeRequest handles inspectContractCode operation.
 //
-// Get reduced blockchain blocks data.
+// Detect interfaces and get methods of a contract by its code or state init, the contract doesn't
+// have to be deployed.
 //
-// GET /v2/blockchain/reduced/blocks
-func (s *Server) handleGetReducedBlockchainBlocksRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/boc/contract
+func (s *Server) handleInspectContractCodeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getReducedBlockchainBlocks"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/reduced/blocks"),
+		otelogen.OperationID("inspectContractCode"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/boc/contract"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetReducedBlockchainBlocks",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "InspectContractCode",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10331,46 +15483,42 @@ func (s *Server) handleGetReducedBlockchainBlocksRequest(args [0]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetReducedBlockchainBlocks",
-			ID:   "getReducedBlockchainBlocks",
+			Name: "InspectContractCode",
+			ID:   "inspectContractCode",
 		}
 	)
-	params, err := decodeGetReducedBlockchainBlocksParams(args, argsEscaped, r)
+	request, close, err := s.decodeInspectContractCodeRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *ReducedBlocks
+	var response *ContractCodeInspection
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetReducedBlockchainBlocks",
+			OperationName:    "InspectContractCode",
 			OperationSummary: "",
-			OperationID:      "getReducedBlockchainBlocks",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "from",
-					In:   "query",
-				}: params.From,
-				{
-					Name: "to",
-					In:   "query",
-				}: params.To,
-			},
-			Raw: r,
+			OperationID:      "inspectContractCode",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetReducedBlockchainBlocksParams
-			Response = *ReducedBlocks
+			Request  = *InspectContractCodeReq
+			Params   = struct{}
+			Response = *ContractCodeInspection
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10379,14 +15527,14 @@ func (s *Server) handleGetReducedBlockchainBlocksRequest(args [0]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackGetReducedBlockchainBlocksParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetReducedBlockchainBlocks(ctx, params)
+				response, err = s.h.InspectContractCode(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetReducedBlockchainBlocks(ctx, params)
+		response, err = s.h.InspectContractCode(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10405,7 +15553,7 @@ func (s *Server) handleGetReducedBlockchainBlocksRequest(args [0]string, argsEsc
 		return
 	}
 
-	if err := encodeGetReducedBlockchainBlocksResponse(response, w, span); err != nil {
+	if err := encodeInspectContractCodeResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10414,20 +15562,20 @@ func (s *Server) handleGetReducedBlockchainBlocksRequest(args [0]string, argsEsc
 	}
 }
 
-// handleGetStakingPoolHistoryRequest handles getStakingPoolHistory operation.
+// handleParseStateInitRequest handles parseStateInit operation.
 //
-// Pool history.
+// Extract code, data and other components of a state init.
 //
-// GET /v2/staking/pool/{account_id}/history
-func (s *Server) handleGetStakingPoolHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/boc/stateinit
+func (s *Server) handleParseStateInitRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getStakingPoolHistory"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/staking/pool/{account_id}/history"),
+		otelogen.OperationID("parseStateInit"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/boc/stateinit"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetStakingPoolHistory",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ParseStateInit",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10452,11 +15600,11 @@ func (s *Server) handleGetStakingPoolHistoryRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetStakingPoolHistory",
-			ID:   "getStakingPoolHistory",
+			Name: "ParseStateInit",
+			ID:   "parseStateInit",
 		}
 	)
-	params, err := decodeGetStakingPoolHistoryParams(args, argsEscaped, r)
+	params, err := decodeParseStateInitParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10466,28 +15614,43 @@ func (s *Server) handleGetStakingPoolHistoryRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeParseStateInitRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *GetStakingPoolHistoryOK
+	var response *StateInitComponents
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetStakingPoolHistory",
+			OperationName:    "ParseStateInit",
 			OperationSummary: "",
-			OperationID:      "getStakingPoolHistory",
-			Body:             nil,
+			OperationID:      "parseStateInit",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
+					Name: "workchain",
+					In:   "query",
+				}: params.Workchain,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetStakingPoolHistoryParams
-			Response = *GetStakingPoolHistoryOK
+			Request  = *ParseStateInitReq
+			Params   = ParseStateInitParams
+			Response = *StateInitComponents
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10496,14 +15659,14 @@ func (s *Server) handleGetStakingPoolHistoryRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetStakingPoolHistoryParams,
+			unpackParseStateInitParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetStakingPoolHistory(ctx, params)
+				response, err = s.h.ParseStateInit(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetStakingPoolHistory(ctx, params)
+		response, err = s.h.ParseStateInit(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10522,7 +15685,7 @@ func (s *Server) handleGetStakingPoolHistoryRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetStakingPoolHistoryResponse(response, w, span); err != nil {
+	if err := encodeParseStateInitResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10531,20 +15694,21 @@ func (s *Server) handleGetStakingPoolHistoryRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetStakingPoolInfoRequest handles getStakingPoolInfo operation.
+// handleRecoverWalletsRequest handles recoverWallets operation.
 //
-// Stacking pool info.
+// Scan wallets of the given versions and subwallet IDs derived from the public key and summarize the
+// deployed ones.
 //
-// GET /v2/staking/pool/{account_id}
-func (s *Server) handleGetStakingPoolInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/pubkeys/{public_key}/wallets/recover
+func (s *Server) handleRecoverWalletsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getStakingPoolInfo"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/staking/pool/{account_id}"),
+		otelogen.OperationID("recoverWallets"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/pubkeys/{public_key}/wallets/recover"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetStakingPoolInfo",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "RecoverWallets",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10569,11 +15733,11 @@ func (s *Server) handleGetStakingPoolInfoRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetStakingPoolInfo",
-			ID:   "getStakingPoolInfo",
+			Name: "RecoverWallets",
+			ID:   "recoverWallets",
 		}
 	)
-	params, err := decodeGetStakingPoolInfoParams(args, argsEscaped, r)
+	params, err := decodeRecoverWalletsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10583,32 +15747,43 @@ func (s *Server) handleGetStakingPoolInfoRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeRecoverWalletsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *GetStakingPoolInfoOK
+	var response *WalletRecovery
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetStakingPoolInfo",
+			OperationName:    "RecoverWallets",
 			OperationSummary: "",
-			OperationID:      "getStakingPoolInfo",
-			Body:             nil,
+			OperationID:      "recoverWallets",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "account_id",
+					Name: "public_key",
 					In:   "path",
-				}: params.AccountID,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
+				}: params.PublicKey,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetStakingPoolInfoParams
-			Response = *GetStakingPoolInfoOK
+			Request  = OptRecoverWalletsReq
+			Params   = RecoverWalletsParams
+			Response = *WalletRecovery
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10617,14 +15792,14 @@ func (s *Server) handleGetStakingPoolInfoRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetStakingPoolInfoParams,
+			unpackRecoverWalletsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetStakingPoolInfo(ctx, params)
+				response, err = s.h.RecoverWallets(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetStakingPoolInfo(ctx, params)
+		response, err = s.h.RecoverWallets(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10643,7 +15818,7 @@ func (s *Server) handleGetStakingPoolInfoRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetStakingPoolInfoResponse(response, w, span); err != nil {
+	if err := encodeRecoverWalletsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10652,20 +15827,20 @@ func (s *Server) handleGetStakingPoolInfoRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetStakingPoolsRequest handles getStakingPools operation.
+// handleReindexAccountRequest handles reindexAccount operation.
 //
-// All pools available in network.
+// Update internal cache for a particular account.
 //
-// GET /v2/staking/pools
-func (s *Server) handleGetStakingPoolsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/accounts/{account_id}/reindex
+func (s *Server) handleReindexAccountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getStakingPools"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/staking/pools"),
+		otelogen.OperationID("reindexAccount"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/reindex"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetStakingPools",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReindexAccount",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10690,11 +15865,11 @@ func (s *Server) handleGetStakingPoolsRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetStakingPools",
-			ID:   "getStakingPools",
+			Name: "ReindexAccount",
+			ID:   "reindexAccount",
 		}
 	)
-	params, err := decodeGetStakingPoolsParams(args, argsEscaped, r)
+	params, err := decodeReindexAccountParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10705,35 +15880,27 @@ func (s *Server) handleGetStakingPoolsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	var response *GetStakingPoolsOK
+	var response *ReindexAccountOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetStakingPools",
+			OperationName:    "ReindexAccount",
 			OperationSummary: "",
-			OperationID:      "getStakingPools",
+			OperationID:      "reindexAccount",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "available_for",
-					In:   "query",
-				}: params.AvailableFor,
-				{
-					Name: "include_unverified",
-					In:   "query",
-				}: params.IncludeUnverified,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetStakingPoolsParams
-			Response = *GetStakingPoolsOK
+			Params   = ReindexAccountParams
+			Response = *ReindexAccountOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10742,14 +15909,14 @@ func (s *Server) handleGetStakingPoolsRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetStakingPoolsParams,
+			unpackReindexAccountParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetStakingPools(ctx, params)
+				err = s.h.ReindexAccount(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetStakingPools(ctx, params)
+		err = s.h.ReindexAccount(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10768,7 +15935,7 @@ func (s *Server) handleGetStakingPoolsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetStakingPoolsResponse(response, w, span); err != nil {
+	if err := encodeReindexAccountResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10777,20 +15944,31 @@ func (s *Server) handleGetStakingPoolsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetStorageProvidersRequest handles getStorageProviders operation.
+// handleResolveMessageHashRequest handles resolveMessageHash operation.
 //
-// Get TON storage providers deployed to the blockchain.
+// Resolve any form of a message hash to the transaction created by the message and its trace.
+// External inbound messages are indexed by the following hashes:
+// * full - the hash of the message cell exactly as it is included in a block;
+// * normalized - the hash of the message normalized according to TEP-467:
+// src is replaced with addr_none, import_fee with 0, init is dropped and body is stored in a
+// reference.
+// It doesn't depend on how the message was serialized, so it's recommended to track sent messages by
+// this hash;
+// * body - the hash of the message body;
+// * signed_payload - the hash of the message body without its leading 512-bit signature, this is
+// what a wallet signs.
+// Internal messages are indexed by the full hash only.
 //
-// GET /v2/storage/providers
-func (s *Server) handleGetStorageProvidersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/blockchain/messages/{msg_id}/resolve
+func (s *Server) handleResolveMessageHashRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getStorageProviders"),
+		otelogen.OperationID("resolveMessageHash"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/storage/providers"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/messages/{msg_id}/resolve"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetStorageProviders",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ResolveMessageHash",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10813,25 +15991,44 @@ func (s *Server) handleGetStorageProvidersRequest(args [0]string, argsEscaped bo
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "ResolveMessageHash",
+			ID:   "resolveMessageHash",
+		}
 	)
+	params, err := decodeResolveMessageHashParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *GetStorageProvidersOK
+	var response *MessageHashResolution
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetStorageProviders",
+			OperationName:    "ResolveMessageHash",
 			OperationSummary: "",
-			OperationID:      "getStorageProviders",
+			OperationID:      "resolveMessageHash",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "msg_id",
+					In:   "path",
+				}: params.MsgID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *GetStorageProvidersOK
+			Params   = ResolveMessageHashParams
+			Response = *MessageHashResolution
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10840,14 +16037,14 @@ func (s *Server) handleGetStorageProvidersRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackResolveMessageHashParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetStorageProviders(ctx)
+				response, err = s.h.ResolveMessageHash(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetStorageProviders(ctx)
+		response, err = s.h.ResolveMessageHash(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10866,7 +16063,7 @@ func (s *Server) handleGetStorageProvidersRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetStorageProvidersResponse(response, w, span); err != nil {
+	if err := encodeResolveMessageHashResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10875,20 +16072,22 @@ func (s *Server) handleGetStorageProvidersRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleGetTonConnectPayloadRequest handles getTonConnectPayload operation.
+// handleRotateWebhookSecretRequest handles rotateWebhookSecret operation.
 //
-// Get a payload for further token receipt.
+// Replace a secret signing notifications to a webhook, the new secret is returned only in this
+// response. During the grace period notifications are signed with both the new and the previous
+// secrets, so a receiver can switch to the new one without failing deliveries.
 //
-// GET /v2/tonconnect/payload
-func (s *Server) handleGetTonConnectPayloadRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/webhooks/{id}/rotate-secret
+func (s *Server) handleRotateWebhookSecretRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTonConnectPayload"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/tonconnect/payload"),
+		otelogen.OperationID("rotateWebhookSecret"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/webhooks/{id}/rotate-secret"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetTonConnectPayload",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "RotateWebhookSecret",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10911,25 +16110,48 @@ func (s *Server) handleGetTonConnectPayloadRequest(args [0]string, argsEscaped b
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "RotateWebhookSecret",
+			ID:   "rotateWebhookSecret",
+		}
 	)
+	params, err := decodeRotateWebhookSecretParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response *GetTonConnectPayloadOK
+	var response *Webhook
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetTonConnectPayload",
+			OperationName:    "RotateWebhookSecret",
 			OperationSummary: "",
-			OperationID:      "getTonConnectPayload",
+			OperationID:      "rotateWebhookSecret",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "grace_period",
+					In:   "query",
+				}: params.GracePeriod,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *GetTonConnectPayloadOK
+			Params   = RotateWebhookSecretParams
+			Response = *Webhook
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10938,14 +16160,14 @@ func (s *Server) handleGetTonConnectPayloadRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackRotateWebhookSecretParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTonConnectPayload(ctx)
+				response, err = s.h.RotateWebhookSecret(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTonConnectPayload(ctx)
+		response, err = s.h.RotateWebhookSecret(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10964,7 +16186,7 @@ func (s *Server) handleGetTonConnectPayloadRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetTonConnectPayloadResponse(response, w, span); err != nil {
+	if err := encodeRotateWebhookSecretResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10973,20 +16195,20 @@ func (s *Server) handleGetTonConnectPayloadRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGetTraceRequest handles getTrace operation.
+// handleSearchAccountsRequest handles searchAccounts operation.
 //
-// Get the trace by trace ID or hash of any transaction in trace.
+// Search by account domain name.
 //
-// GET /v2/traces/{trace_id}
-func (s *Server) handleGetTraceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/accounts/search
+func (s *Server) handleSearchAccountsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTrace"),
+		otelogen.OperationID("searchAccounts"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/traces/{trace_id}"),
+		semconv.HTTPRouteKey.String("/v2/accounts/search"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetTrace",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SearchAccounts",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11011,11 +16233,11 @@ func (s *Server) handleGetTraceRequest(args [1]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetTrace",
-			ID:   "getTrace",
+			Name: "SearchAccounts",
+			ID:   "searchAccounts",
 		}
 	)
-	params, err := decodeGetTraceParams(args, argsEscaped, r)
+	params, err := decodeSearchAccountsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11026,27 +16248,27 @@ func (s *Server) handleGetTraceRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	var response *Trace
+	var response *FoundAccounts
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetTrace",
+			OperationName:    "SearchAccounts",
 			OperationSummary: "",
-			OperationID:      "getTrace",
+			OperationID:      "searchAccounts",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "trace_id",
-					In:   "path",
-				}: params.TraceID,
+					Name: "name",
+					In:   "query",
+				}: params.Name,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetTraceParams
-			Response = *Trace
+			Params   = SearchAccountsParams
+			Response = *FoundAccounts
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11055,14 +16277,14 @@ func (s *Server) handleGetTraceRequest(args [1]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetTraceParams,
+			unpackSearchAccountsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTrace(ctx, params)
+				response, err = s.h.SearchAccounts(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTrace(ctx, params)
+		response, err = s.h.SearchAccounts(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11081,7 +16303,7 @@ func (s *Server) handleGetTraceRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetTraceResponse(response, w, span); err != nil {
+	if err := encodeSearchAccountsResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11090,20 +16312,21 @@ func (s *Server) handleGetTraceRequest(args [1]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetWalletBackupRequest handles getWalletBackup operation.
+// handleSearchMessagesRequest handles searchMessages operation.
 //
-// Get backup info.
+// Search indexed transactions of accounts tracked by the indexer by a text comment or a payload hash
+// of their inbound or outbound messages. The latest transactions go first.
 //
-// GET /v2/wallet/backup
-func (s *Server) handleGetWalletBackupRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/messages/search
+func (s *Server) handleSearchMessagesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWalletBackup"),
+		otelogen.OperationID("searchMessages"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/wallet/backup"),
+		semconv.HTTPRouteKey.String("/v2/messages/search"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetWalletBackup",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SearchMessages",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11128,11 +16351,11 @@ func (s *Server) handleGetWalletBackupRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetWalletBackup",
-			ID:   "getWalletBackup",
+			Name: "SearchMessages",
+			ID:   "searchMessages",
 		}
 	)
-	params, err := decodeGetWalletBackupParams(args, argsEscaped, r)
+	params, err := decodeSearchMessagesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11143,27 +16366,51 @@ func (s *Server) handleGetWalletBackupRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	var response *GetWalletBackupOK
+	var response *Transactions
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "GetWalletBackup",
+			OperationName:    "SearchMessages",
 			OperationSummary: "",
-			OperationID:      "getWalletBackup",
+			OperationID:      "searchMessages",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "X-TonConnect-Auth",
-					In:   "header",
-				}: params.XTonConnectAuth,
+					Name: "comment",
+					In:   "query",
+				}: params.Comment,
+				{
+					Name: "payload_hash",
+					In:   "query",
+				}: params.PayloadHash,
+				{
+					Name: "account_id",
+					In:   "query",
+				}: params.AccountID,
+				{
+					Name: "start_date",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "end_date",
+					In:   "query",
+				}: params.EndDate,
+				{
+					Name: "before_lt",
+					In:   "query",
+				}: params.BeforeLt,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWalletBackupParams
-			Response = *GetWalletBackupOK
+			Params   = SearchMessagesParams
+			Response = *Transactions
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11172,14 +16419,14 @@ func (s *Server) handleGetWalletBackupRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetWalletBackupParams,
+			unpackSearchMessagesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWalletBackup(ctx, params)
+				response, err = s.h.SearchMessages(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWalletBackup(ctx, params)
+		response, err = s.h.SearchMessages(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11198,7 +16445,7 @@ func (s *Server) handleGetWalletBackupRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetWalletBackupResponse(response, w, span); err != nil {
+	if err := encodeSearchMessagesResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11207,20 +16454,20 @@ func (s *Server) handleGetWalletBackupRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetWalletsByPublicKeyRequest handles getWalletsByPublicKey operation.
+// handleSendBlockchainMessageRequest handles sendBlockchainMessage operation.
 //
-// Get wallets by public key.
+// Send message to blockchain.
 //
-// GET /v2/pubkeys/{public_key}/wallets
-func (s *Server) handleGetWalletsByPublicKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/blockchain/message
+func (s *Server) handleSendBlockchainMessageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWalletsByPublicKey"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/pubkeys/{public_key}/wallets"),
+		otelogen.OperationID("sendBlockchainMessage"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/blockchain/message"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetWalletsByPublicKey",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SendBlockchainMessage",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11245,42 +16492,42 @@ func (s *Server) handleGetWalletsByPublicKeyRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "GetWalletsByPublicKey",
-			ID:   "getWalletsByPublicKey",
+			Name: "SendBlockchainMessage",
+			ID:   "sendBlockchainMessage",
 		}
 	)
-	params, err := decodeGetWalletsByPublicKeyParams(args, argsEscaped, r)
+	request, close, err := s.decodeSendBlockchainMessageRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *Accounts
+	var response *SendBlockchainMessageOK
 	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    "GetWalletsByPublicKey",
-			OperationSummary: "",
-			OperationID:      "getWalletsByPublicKey",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "public_key",
-					In:   "path",
-				}: params.PublicKey,
-			},
-			Raw: r,
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "SendBlockchainMessage",
+			OperationSummary: "",
+			OperationID:      "sendBlockchainMessage",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetWalletsByPublicKeyParams
-			Response = *Accounts
+			Request  = *SendBlockchainMessageReq
+			Params   = struct{}
+			Response = *SendBlockchainMessageOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11289,14 +16536,14 @@ func (s *Server) handleGetWalletsByPublicKeyRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetWalletsByPublicKeyParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWalletsByPublicKey(ctx, params)
+				err = s.h.SendBlockchainMessage(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWalletsByPublicKey(ctx, params)
+		err = s.h.SendBlockchainMessage(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11315,7 +16562,7 @@ func (s *Server) handleGetWalletsByPublicKeyRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetWalletsByPublicKeyResponse(response, w, span); err != nil {
+	if err := encodeSendBlockchainMessageResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11324,20 +16571,20 @@ func (s *Server) handleGetWalletsByPublicKeyRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleReindexAccountRequest handles reindexAccount operation.
+// handleSendRawMessageRequest handles sendRawMessage operation.
 //
-// Update internal cache for a particular account.
+// Send raw message to blockchain.
 //
-// POST /v2/accounts/{account_id}/reindex
-func (s *Server) handleReindexAccountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/liteserver/send_message
+func (s *Server) handleSendRawMessageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("reindexAccount"),
+		otelogen.OperationID("sendRawMessage"),
 		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/reindex"),
+		semconv.HTTPRouteKey.String("/v2/liteserver/send_message"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "ReindexAccount",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SendRawMessage",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11362,42 +16609,42 @@ func (s *Server) handleReindexAccountRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "ReindexAccount",
-			ID:   "reindexAccount",
+			Name: "SendRawMessage",
+			ID:   "sendRawMessage",
 		}
 	)
-	params, err := decodeReindexAccountParams(args, argsEscaped, r)
+	request, close, err := s.decodeSendRawMessageRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeParams", err)
+		recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *ReindexAccountOK
+	var response *SendRawMessageOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "ReindexAccount",
+			OperationName:    "SendRawMessage",
 			OperationSummary: "",
-			OperationID:      "reindexAccount",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "account_id",
-					In:   "path",
-				}: params.AccountID,
-			},
-			Raw: r,
+			OperationID:      "sendRawMessage",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ReindexAccountParams
-			Response = *ReindexAccountOK
+			Request  = *SendRawMessageReq
+			Params   = struct{}
+			Response = *SendRawMessageOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11406,14 +16653,14 @@ func (s *Server) handleReindexAccountRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackReindexAccountParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.ReindexAccount(ctx, params)
+				response, err = s.h.SendRawMessage(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.ReindexAccount(ctx, params)
+		response, err = s.h.SendRawMessage(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11432,7 +16679,7 @@ func (s *Server) handleReindexAccountRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeReindexAccountResponse(response, w, span); err != nil {
+	if err := encodeSendRawMessageResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11441,20 +16688,22 @@ func (s *Server) handleReindexAccountRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleSearchAccountsRequest handles searchAccounts operation.
+// handleSetEventAnnotationRequest handles setEventAnnotation operation.
 //
-// Search by account domain name.
+// Attach a private annotation to the event replacing the previous one. The annotation is returned
+// along with the event to the same tenant only. Requires a tenant token or a token with the admin
+// scope.
 //
-// GET /v2/accounts/search
-func (s *Server) handleSearchAccountsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /v2/events/{event_id}/annotation
+func (s *Server) handleSetEventAnnotationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("searchAccounts"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v2/accounts/search"),
+		otelogen.OperationID("setEventAnnotation"),
+		semconv.HTTPMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/v2/events/{event_id}/annotation"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SearchAccounts",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetEventAnnotation",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11479,11 +16728,11 @@ func (s *Server) handleSearchAccountsRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SearchAccounts",
-			ID:   "searchAccounts",
+			Name: "SetEventAnnotation",
+			ID:   "setEventAnnotation",
 		}
 	)
-	params, err := decodeSearchAccountsParams(args, argsEscaped, r)
+	params, err := decodeSetEventAnnotationParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11493,28 +16742,43 @@ func (s *Server) handleSearchAccountsRequest(args [0]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeSetEventAnnotationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *FoundAccounts
+	var response *EventAnnotation
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SearchAccounts",
+			OperationName:    "SetEventAnnotation",
 			OperationSummary: "",
-			OperationID:      "searchAccounts",
-			Body:             nil,
+			OperationID:      "setEventAnnotation",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "name",
-					In:   "query",
-				}: params.Name,
+					Name: "event_id",
+					In:   "path",
+				}: params.EventID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = SearchAccountsParams
-			Response = *FoundAccounts
+			Request  = *SetEventAnnotationReq
+			Params   = SetEventAnnotationParams
+			Response = *EventAnnotation
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11523,14 +16787,14 @@ func (s *Server) handleSearchAccountsRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackSearchAccountsParams,
+			unpackSetEventAnnotationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SearchAccounts(ctx, params)
+				response, err = s.h.SetEventAnnotation(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SearchAccounts(ctx, params)
+		response, err = s.h.SetEventAnnotation(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11549,7 +16813,7 @@ func (s *Server) handleSearchAccountsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSearchAccountsResponse(response, w, span); err != nil {
+	if err := encodeSetEventAnnotationResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11558,20 +16822,22 @@ func (s *Server) handleSearchAccountsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSendBlockchainMessageRequest handles sendBlockchainMessage operation.
+// handleSetPrivateLabelRequest handles setPrivateLabel operation.
 //
-// Send message to blockchain.
+// Attach a private label and a memo to an account, they are returned along with the account to
+// tokens with the admin scope only and never published in the public address book. Requires a token
+// with the admin scope.
 //
-// POST /v2/blockchain/message
-func (s *Server) handleSendBlockchainMessageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /v2/labels/{account_id}
+func (s *Server) handleSetPrivateLabelRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("sendBlockchainMessage"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/blockchain/message"),
+		otelogen.OperationID("setPrivateLabel"),
+		semconv.HTTPMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/v2/labels/{account_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SendBlockchainMessage",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetPrivateLabel",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11596,11 +16862,21 @@ func (s *Server) handleSendBlockchainMessageRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SendBlockchainMessage",
-			ID:   "sendBlockchainMessage",
+			Name: "SetPrivateLabel",
+			ID:   "setPrivateLabel",
 		}
 	)
-	request, close, err := s.decodeSendBlockchainMessageRequest(r)
+	params, err := decodeSetPrivateLabelParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeSetPrivateLabelRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11616,22 +16892,27 @@ func (s *Server) handleSendBlockchainMessageRequest(args [0]string, argsEscaped
 		}
 	}()
 
-	var response *SendBlockchainMessageOK
+	var response *PrivateLabel
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SendBlockchainMessage",
+			OperationName:    "SetPrivateLabel",
 			OperationSummary: "",
-			OperationID:      "sendBlockchainMessage",
+			OperationID:      "setPrivateLabel",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *SendBlockchainMessageReq
-			Params   = struct{}
-			Response = *SendBlockchainMessageOK
+			Request  = *SetPrivateLabelReq
+			Params   = SetPrivateLabelParams
+			Response = *PrivateLabel
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11640,14 +16921,14 @@ func (s *Server) handleSendBlockchainMessageRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			nil,
+			unpackSetPrivateLabelParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.SendBlockchainMessage(ctx, request)
+				response, err = s.h.SetPrivateLabel(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.SendBlockchainMessage(ctx, request)
+		response, err = s.h.SetPrivateLabel(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11666,7 +16947,7 @@ func (s *Server) handleSendBlockchainMessageRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeSendBlockchainMessageResponse(response, w, span); err != nil {
+	if err := encodeSetPrivateLabelResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11675,20 +16956,20 @@ func (s *Server) handleSendBlockchainMessageRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleSendRawMessageRequest handles sendRawMessage operation.
+// handleSetWalletBackupRequest handles setWalletBackup operation.
 //
-// Send raw message to blockchain.
+// Set backup info.
 //
-// POST /v2/liteserver/send_message
-func (s *Server) handleSendRawMessageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /v2/wallet/backup
+func (s *Server) handleSetWalletBackupRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("sendRawMessage"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v2/liteserver/send_message"),
+		otelogen.OperationID("setWalletBackup"),
+		semconv.HTTPMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/v2/wallet/backup"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SendRawMessage",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetWalletBackup",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11713,11 +16994,21 @@ func (s *Server) handleSendRawMessageRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SendRawMessage",
-			ID:   "sendRawMessage",
+			Name: "SetWalletBackup",
+			ID:   "setWalletBackup",
 		}
 	)
-	request, close, err := s.decodeSendRawMessageRequest(r)
+	params, err := decodeSetWalletBackupParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeSetWalletBackupRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11733,22 +17024,27 @@ func (s *Server) handleSendRawMessageRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *SendRawMessageOK
+	var response *SetWalletBackupOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SendRawMessage",
+			OperationName:    "SetWalletBackup",
 			OperationSummary: "",
-			OperationID:      "sendRawMessage",
+			OperationID:      "setWalletBackup",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "X-TonConnect-Auth",
+					In:   "header",
+				}: params.XTonConnectAuth,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *SendRawMessageReq
-			Params   = struct{}
-			Response = *SendRawMessageOK
+			Request  = SetWalletBackupReq
+			Params   = SetWalletBackupParams
+			Response = *SetWalletBackupOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11757,14 +17053,14 @@ func (s *Server) handleSendRawMessageRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackSetWalletBackupParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SendRawMessage(ctx, request)
+				err = s.h.SetWalletBackup(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SendRawMessage(ctx, request)
+		err = s.h.SetWalletBackup(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11783,7 +17079,7 @@ func (s *Server) handleSendRawMessageRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSendRawMessageResponse(response, w, span); err != nil {
+	if err := encodeSetWalletBackupResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11792,20 +17088,23 @@ func (s *Server) handleSendRawMessageRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSetWalletBackupRequest handles setWalletBackup operation.
+// handleSimulateAccountTransactionRequest handles simulateAccountTransaction operation.
 //
-// Set backup info.
+// Inject a synthetic transaction of the account into streaming subscriptions (SSE and websocket), so
+// deposit processing can be tested end-to-end without real transfers. The transaction doesn't exist
+// on-chain, notifications about it are flagged with "simulated". The endpoint is available only on
+// testnet instances and requires a token with admin scope.
 //
-// PUT /v2/wallet/backup
-func (s *Server) handleSetWalletBackupRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v2/accounts/{account_id}/transactions/simulate
+func (s *Server) handleSimulateAccountTransactionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setWalletBackup"),
-		semconv.HTTPMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/v2/wallet/backup"),
+		otelogen.OperationID("simulateAccountTransaction"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v2/accounts/{account_id}/transactions/simulate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "SetWalletBackup",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "SimulateAccountTransaction",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11830,11 +17129,11 @@ func (s *Server) handleSetWalletBackupRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "SetWalletBackup",
-			ID:   "setWalletBackup",
+			Name: "SimulateAccountTransaction",
+			ID:   "simulateAccountTransaction",
 		}
 	)
-	params, err := decodeSetWalletBackupParams(args, argsEscaped, r)
+	params, err := decodeSimulateAccountTransactionParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11844,7 +17143,7 @@ func (s *Server) handleSetWalletBackupRequest(args [0]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeSetWalletBackupRequest(r)
+	request, close, err := s.decodeSimulateAccountTransactionRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11860,27 +17159,27 @@ func (s *Server) handleSetWalletBackupRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response *SetWalletBackupOK
+	var response *SimulatedTransaction
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "SetWalletBackup",
+			OperationName:    "SimulateAccountTransaction",
 			OperationSummary: "",
-			OperationID:      "setWalletBackup",
+			OperationID:      "simulateAccountTransaction",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "X-TonConnect-Auth",
-					In:   "header",
-				}: params.XTonConnectAuth,
+					Name: "account_id",
+					In:   "path",
+				}: params.AccountID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = SetWalletBackupReq
-			Params   = SetWalletBackupParams
-			Response = *SetWalletBackupOK
+			Request  = OptSimulateAccountTransactionReq
+			Params   = SimulateAccountTransactionParams
+			Response = *SimulatedTransaction
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11889,14 +17188,14 @@ func (s *Server) handleSetWalletBackupRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackSetWalletBackupParams,
+			unpackSimulateAccountTransactionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.SetWalletBackup(ctx, request, params)
+				response, err = s.h.SimulateAccountTransaction(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.SetWalletBackup(ctx, request, params)
+		response, err = s.h.SimulateAccountTransaction(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11915,7 +17214,7 @@ func (s *Server) handleSetWalletBackupRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSetWalletBackupResponse(response, w, span); err != nil {
+	if err := encodeSimulateAccountTransactionResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
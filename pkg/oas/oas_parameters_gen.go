@@ -3,6 +3,7 @@
 package oas
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 
@@ -213,25 +214,25 @@ func decodeBlockchainAccountInspectParams(args [1]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// DnsResolveParams is parameters of dnsResolve operation.
-type DnsResolveParams struct {
-	// Domain name with .ton or .t.me.
-	DomainName string
+// BuildWalletTransferParams is parameters of buildWalletTransfer operation.
+type BuildWalletTransferParams struct {
+	// Account ID.
+	AccountID string
 }
 
-func unpackDnsResolveParams(packed middleware.Parameters) (params DnsResolveParams) {
+func unpackBuildWalletTransferParams(packed middleware.Parameters) (params BuildWalletTransferParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "domain_name",
+			Name: "account_id",
 			In:   "path",
 		}
-		params.DomainName = packed[key].(string)
+		params.AccountID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDnsResolveParams(args [1]string, argsEscaped bool, r *http.Request) (params DnsResolveParams, _ error) {
-	// Decode path: domain_name.
+func decodeBuildWalletTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params BuildWalletTransferParams, _ error) {
+	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -243,7 +244,7 @@ func decodeDnsResolveParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "domain_name",
+				Param:   "account_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -260,7 +261,7 @@ func decodeDnsResolveParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.DomainName = c
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -271,7 +272,7 @@ func decodeDnsResolveParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "domain_name",
+			Name: "account_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -279,24 +280,13 @@ func decodeDnsResolveParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// EmulateMessageToAccountEventParams is parameters of emulateMessageToAccountEvent operation.
-type EmulateMessageToAccountEventParams struct {
-	AcceptLanguage OptString
+// CheckAccountBounceParams is parameters of checkAccountBounce operation.
+type CheckAccountBounceParams struct {
 	// Account ID.
-	AccountID            string
-	IgnoreSignatureCheck OptBool
+	AccountID string
 }
 
-func unpackEmulateMessageToAccountEventParams(packed middleware.Parameters) (params EmulateMessageToAccountEventParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
+func unpackCheckAccountBounceParams(packed middleware.Parameters) (params CheckAccountBounceParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -304,65 +294,10 @@ func unpackEmulateMessageToAccountEventParams(packed middleware.Parameters) (par
 		}
 		params.AccountID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "ignore_signature_check",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IgnoreSignatureCheck = v.(OptBool)
-		}
-	}
 	return params
 }
 
-func decodeEmulateMessageToAccountEventParams(args [1]string, argsEscaped bool, r *http.Request) (params EmulateMessageToAccountEventParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
-	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
-		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotAcceptLanguageVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
-			Err:  err,
-		}
-	}
+func decodeCheckAccountBounceParams(args [1]string, argsEscaped bool, r *http.Request) (params CheckAccountBounceParams, _ error) {
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -408,239 +343,346 @@ func decodeEmulateMessageToAccountEventParams(args [1]string, argsEscaped bool,
 			Err:  err,
 		}
 	}
-	// Decode query: ignore_signature_check.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "ignore_signature_check",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// DeleteEventAnnotationParams is parameters of deleteEventAnnotation operation.
+type DeleteEventAnnotationParams struct {
+	// Event ID or transaction hash in hex (without 0x) or base64url format.
+	EventID string
+}
+
+func unpackDeleteEventAnnotationParams(packed middleware.Parameters) (params DeleteEventAnnotationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "event_id",
+			In:   "path",
 		}
+		params.EventID = packed[key].(string)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIgnoreSignatureCheckVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeDeleteEventAnnotationParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteEventAnnotationParams, _ error) {
+	// Decode path: event_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "event_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotIgnoreSignatureCheckVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.IgnoreSignatureCheck.SetTo(paramsDotIgnoreSignatureCheckVal)
+
+				params.EventID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "ignore_signature_check",
-			In:   "query",
+			Name: "event_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// EmulateMessageToEventParams is parameters of emulateMessageToEvent operation.
-type EmulateMessageToEventParams struct {
-	AcceptLanguage       OptString
-	IgnoreSignatureCheck OptBool
+// DeleteExpectedDepositParams is parameters of deleteExpectedDeposit operation.
+type DeleteExpectedDepositParams struct {
+	ID string
 }
 
-func unpackEmulateMessageToEventParams(packed middleware.Parameters) (params EmulateMessageToEventParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
+func unpackDeleteExpectedDepositParams(packed middleware.Parameters) (params DeleteExpectedDepositParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "ignore_signature_check",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IgnoreSignatureCheck = v.(OptBool)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeEmulateMessageToEventParams(args [0]string, argsEscaped bool, r *http.Request) (params EmulateMessageToEventParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
+func decodeDeleteExpectedDepositParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteExpectedDepositParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotAcceptLanguageVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: ignore_signature_check.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "ignore_signature_check",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIgnoreSignatureCheckVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+// DeletePrivateLabelParams is parameters of deletePrivateLabel operation.
+type DeletePrivateLabelParams struct {
+	// Account ID.
+	AccountID string
+}
 
-					paramsDotIgnoreSignatureCheckVal = c
-					return nil
-				}(); err != nil {
+func unpackDeletePrivateLabelParams(packed middleware.Parameters) (params DeletePrivateLabelParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDeletePrivateLabelParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePrivateLabelParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.IgnoreSignatureCheck.SetTo(paramsDotIgnoreSignatureCheckVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "ignore_signature_check",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// EmulateMessageToTraceParams is parameters of emulateMessageToTrace operation.
-type EmulateMessageToTraceParams struct {
-	IgnoreSignatureCheck OptBool
+// DeleteWebhookParams is parameters of deleteWebhook operation.
+type DeleteWebhookParams struct {
+	ID string
 }
 
-func unpackEmulateMessageToTraceParams(packed middleware.Parameters) (params EmulateMessageToTraceParams) {
+func unpackDeleteWebhookParams(packed middleware.Parameters) (params DeleteWebhookParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "ignore_signature_check",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IgnoreSignatureCheck = v.(OptBool)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeEmulateMessageToTraceParams(args [0]string, argsEscaped bool, r *http.Request) (params EmulateMessageToTraceParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: ignore_signature_check.
+func decodeDeleteWebhookParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWebhookParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "ignore_signature_check",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIgnoreSignatureCheckVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
 
-					paramsDotIgnoreSignatureCheckVal = c
-					return nil
-				}(); err != nil {
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DnsResolveParams is parameters of dnsResolve operation.
+type DnsResolveParams struct {
+	// Domain name with .ton or .t.me.
+	DomainName string
+}
+
+func unpackDnsResolveParams(packed middleware.Parameters) (params DnsResolveParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "domain_name",
+			In:   "path",
+		}
+		params.DomainName = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDnsResolveParams(args [1]string, argsEscaped bool, r *http.Request) (params DnsResolveParams, _ error) {
+	// Decode path: domain_name.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "domain_name",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.IgnoreSignatureCheck.SetTo(paramsDotIgnoreSignatureCheckVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.DomainName = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "ignore_signature_check",
-			In:   "query",
+			Name: "domain_name",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// EmulateMessageToWalletParams is parameters of emulateMessageToWallet operation.
-type EmulateMessageToWalletParams struct {
+// EmulateMessageToAccountEventParams is parameters of emulateMessageToAccountEvent operation.
+type EmulateMessageToAccountEventParams struct {
 	AcceptLanguage OptString
+	// Account ID.
+	AccountID            string
+	IgnoreSignatureCheck OptBool
 }
 
-func unpackEmulateMessageToWalletParams(packed middleware.Parameters) (params EmulateMessageToWalletParams) {
+func unpackEmulateMessageToAccountEventParams(packed middleware.Parameters) (params EmulateMessageToAccountEventParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "Accept-Language",
@@ -650,10 +692,27 @@ func unpackEmulateMessageToWalletParams(packed middleware.Parameters) (params Em
 			params.AcceptLanguage = v.(OptString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "ignore_signature_check",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IgnoreSignatureCheck = v.(OptBool)
+		}
+	}
 	return params
 }
 
-func decodeEmulateMessageToWalletParams(args [0]string, argsEscaped bool, r *http.Request) (params EmulateMessageToWalletParams, _ error) {
+func decodeEmulateMessageToAccountEventParams(args [1]string, argsEscaped bool, r *http.Request) (params EmulateMessageToAccountEventParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	h := uri.NewHeaderDecoder(r.Header)
 	// Set default value for header: Accept-Language.
 	{
@@ -699,47 +758,6 @@ func decodeEmulateMessageToWalletParams(args [0]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ExecGetMethodForBlockchainAccountParams is parameters of execGetMethodForBlockchainAccount operation.
-type ExecGetMethodForBlockchainAccountParams struct {
-	// Account ID.
-	AccountID string
-	// Contract get method name.
-	MethodName string
-	Args       []string
-}
-
-func unpackExecGetMethodForBlockchainAccountParams(packed middleware.Parameters) (params ExecGetMethodForBlockchainAccountParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
-		}
-		params.AccountID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "method_name",
-			In:   "path",
-		}
-		params.MethodName = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "args",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Args = v.([]string)
-		}
-	}
-	return params
-}
-
-func decodeExecGetMethodForBlockchainAccountParams(args [2]string, argsEscaped bool, r *http.Request) (params ExecGetMethodForBlockchainAccountParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -785,82 +803,154 @@ func decodeExecGetMethodForBlockchainAccountParams(args [2]string, argsEscaped b
 			Err:  err,
 		}
 	}
-	// Decode path: method_name.
+	// Decode query: ignore_signature_check.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "ignore_signature_check",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "method_name",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIgnoreSignatureCheckVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIgnoreSignatureCheckVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.IgnoreSignatureCheck.SetTo(paramsDotIgnoreSignatureCheckVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "ignore_signature_check",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+// EmulateMessageToEventParams is parameters of emulateMessageToEvent operation.
+type EmulateMessageToEventParams struct {
+	AcceptLanguage       OptString
+	IgnoreSignatureCheck OptBool
+}
+
+func unpackEmulateMessageToEventParams(packed middleware.Parameters) (params EmulateMessageToEventParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "ignore_signature_check",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IgnoreSignatureCheck = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeEmulateMessageToEventParams(args [0]string, argsEscaped bool, r *http.Request) (params EmulateMessageToEventParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.MethodName = c
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "method_name",
-			In:   "path",
+			Name: "Accept-Language",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	// Decode query: args.
+	// Decode query: ignore_signature_check.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "args",
+			Name:    "ignore_signature_check",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotArgsVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotIgnoreSignatureCheckVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotArgsVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
 						return err
 					}
-					params.Args = append(params.Args, paramsDotArgsVal)
+
+					paramsDotIgnoreSignatureCheckVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.IgnoreSignatureCheck.SetTo(paramsDotIgnoreSignatureCheckVal)
+				return nil
 			}); err != nil {
 				return err
 			}
@@ -868,7 +958,7 @@ func decodeExecGetMethodForBlockchainAccountParams(args [2]string, argsEscaped b
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "args",
+			Name: "ignore_signature_check",
 			In:   "query",
 			Err:  err,
 		}
@@ -876,147 +966,147 @@ func decodeExecGetMethodForBlockchainAccountParams(args [2]string, argsEscaped b
 	return params, nil
 }
 
-// GaslessEstimateParams is parameters of gaslessEstimate operation.
-type GaslessEstimateParams struct {
-	// Jetton to pay commission.
-	MasterID string
+// EmulateMessageToTraceParams is parameters of emulateMessageToTrace operation.
+type EmulateMessageToTraceParams struct {
+	IgnoreSignatureCheck OptBool
 }
 
-func unpackGaslessEstimateParams(packed middleware.Parameters) (params GaslessEstimateParams) {
+func unpackEmulateMessageToTraceParams(packed middleware.Parameters) (params EmulateMessageToTraceParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "master_id",
-			In:   "path",
+			Name: "ignore_signature_check",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IgnoreSignatureCheck = v.(OptBool)
 		}
-		params.MasterID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGaslessEstimateParams(args [1]string, argsEscaped bool, r *http.Request) (params GaslessEstimateParams, _ error) {
-	// Decode path: master_id.
+func decodeEmulateMessageToTraceParams(args [0]string, argsEscaped bool, r *http.Request) (params EmulateMessageToTraceParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: ignore_signature_check.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "ignore_signature_check",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "master_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIgnoreSignatureCheckVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIgnoreSignatureCheckVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.MasterID = c
+				params.IgnoreSignatureCheck.SetTo(paramsDotIgnoreSignatureCheckVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "master_id",
-			In:   "path",
+			Name: "ignore_signature_check",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetAccountParams is parameters of getAccount operation.
-type GetAccountParams struct {
-	// Account ID.
-	AccountID string
+// EmulateMessageToWalletParams is parameters of emulateMessageToWallet operation.
+type EmulateMessageToWalletParams struct {
+	AcceptLanguage OptString
 }
 
-func unpackGetAccountParams(packed middleware.Parameters) (params GetAccountParams) {
+func unpackEmulateMessageToWalletParams(packed middleware.Parameters) (params EmulateMessageToWalletParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
 		}
-		params.AccountID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetAccountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountParams, _ error) {
-	// Decode path: account_id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+func decodeEmulateMessageToWalletParams(args [0]string, argsEscaped bool, r *http.Request) (params EmulateMessageToWalletParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.AccountID = c
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "Accept-Language",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetAccountDiffParams is parameters of getAccountDiff operation.
-type GetAccountDiffParams struct {
+// ExecGetMethodForBlockchainAccountParams is parameters of execGetMethodForBlockchainAccount operation.
+type ExecGetMethodForBlockchainAccountParams struct {
 	// Account ID.
 	AccountID string
-	StartDate int64
-	EndDate   int64
+	// Contract get method name.
+	MethodName string
+	Args       []string
 }
 
-func unpackGetAccountDiffParams(packed middleware.Parameters) (params GetAccountDiffParams) {
+func unpackExecGetMethodForBlockchainAccountParams(packed middleware.Parameters) (params ExecGetMethodForBlockchainAccountParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -1026,22 +1116,24 @@ func unpackGetAccountDiffParams(packed middleware.Parameters) (params GetAccount
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "start_date",
-			In:   "query",
+			Name: "method_name",
+			In:   "path",
 		}
-		params.StartDate = packed[key].(int64)
+		params.MethodName = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "end_date",
+			Name: "args",
 			In:   "query",
 		}
-		params.EndDate = packed[key].(int64)
+		if v, ok := packed[key]; ok {
+			params.Args = v.([]string)
+		}
 	}
 	return params
 }
 
-func decodeGetAccountDiffParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountDiffParams, _ error) {
+func decodeExecGetMethodForBlockchainAccountParams(args [2]string, argsEscaped bool, r *http.Request) (params ExecGetMethodForBlockchainAccountParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: account_id.
 	if err := func() error {
@@ -1088,29 +1180,38 @@ func decodeGetAccountDiffParams(args [1]string, argsEscaped bool, r *http.Reques
 			Err:  err,
 		}
 	}
-	// Decode query: start_date.
+	// Decode path: method_name.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_date",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "method_name",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToInt64(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.StartDate = c
+				params.MethodName = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -1119,34 +1220,107 @@ func decodeGetAccountDiffParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start_date",
-			In:   "query",
+			Name: "method_name",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: end_date.
+	// Decode query: args.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_date",
+			Name:    "args",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotArgsVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotArgsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Args = append(params.Args, paramsDotArgsVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "args",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GaslessEstimateParams is parameters of gaslessEstimate operation.
+type GaslessEstimateParams struct {
+	// Jetton to pay commission.
+	MasterID string
+}
+
+func unpackGaslessEstimateParams(packed middleware.Parameters) (params GaslessEstimateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "master_id",
+			In:   "path",
+		}
+		params.MasterID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGaslessEstimateParams(args [1]string, argsEscaped bool, r *http.Request) (params GaslessEstimateParams, _ error) {
+	// Decode path: master_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "master_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToInt64(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.EndDate = c
+				params.MasterID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -1155,23 +1329,21 @@ func decodeGetAccountDiffParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end_date",
-			In:   "query",
+			Name: "master_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetAccountDnsExpiringParams is parameters of getAccountDnsExpiring operation.
-type GetAccountDnsExpiringParams struct {
+// GetAccountParams is parameters of getAccount operation.
+type GetAccountParams struct {
 	// Account ID.
 	AccountID string
-	// Number of days before expiration.
-	Period OptInt
 }
 
-func unpackGetAccountDnsExpiringParams(packed middleware.Parameters) (params GetAccountDnsExpiringParams) {
+func unpackGetAccountParams(packed middleware.Parameters) (params GetAccountParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -1179,20 +1351,10 @@ func unpackGetAccountDnsExpiringParams(packed middleware.Parameters) (params Get
 		}
 		params.AccountID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "period",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Period = v.(OptInt)
-		}
-	}
 	return params
 }
 
-func decodeGetAccountDnsExpiringParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountDnsExpiringParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeGetAccountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountParams, _ error) {
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -1238,86 +1400,22 @@ func decodeGetAccountDnsExpiringParams(args [1]string, argsEscaped bool, r *http
 			Err:  err,
 		}
 	}
-	// Decode query: period.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "period",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPeriodVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+// GetAccountActivityParams is parameters of getAccountActivity operation.
+type GetAccountActivityParams struct {
+	// Account ID.
+	AccountID      string
+	AcceptLanguage OptString
+	// Filter actions where requested account is not real subject (for example sender or receiver jettons).
+	SubjectOnly OptBool
+	// Omit this parameter to get the latest items including pending ones.
+	BeforeLt OptInt64
+	Limit    int
+}
 
-					paramsDotPeriodVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Period.SetTo(paramsDotPeriodVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Period.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           3660,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "period",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetAccountEventParams is parameters of getAccountEvent operation.
-type GetAccountEventParams struct {
-	// Account ID.
-	AccountID string
-	// Event ID or transaction hash in hex (without 0x) or base64url format.
-	EventID        string
-	AcceptLanguage OptString
-	// Filter actions where requested account is not real subject (for example sender or receiver jettons).
-	SubjectOnly OptBool
-}
-
-func unpackGetAccountEventParams(packed middleware.Parameters) (params GetAccountEventParams) {
+func unpackGetAccountActivityParams(packed middleware.Parameters) (params GetAccountActivityParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -1325,13 +1423,6 @@ func unpackGetAccountEventParams(packed middleware.Parameters) (params GetAccoun
 		}
 		params.AccountID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "event_id",
-			In:   "path",
-		}
-		params.EventID = packed[key].(string)
-	}
 	{
 		key := middleware.ParameterKey{
 			Name: "Accept-Language",
@@ -1350,10 +1441,26 @@ func unpackGetAccountEventParams(packed middleware.Parameters) (params GetAccoun
 			params.SubjectOnly = v.(OptBool)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "before_lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		params.Limit = packed[key].(int)
+	}
 	return params
 }
 
-func decodeGetAccountEventParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAccountEventParams, _ error) {
+func decodeGetAccountActivityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountActivityParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: account_id.
@@ -1401,51 +1508,6 @@ func decodeGetAccountEventParams(args [2]string, argsEscaped bool, r *http.Reque
 			Err:  err,
 		}
 	}
-	// Decode path: event_id.
-	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "event_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.EventID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "event_id",
-			In:   "path",
-			Err:  err,
-		}
-	}
 	// Set default value for header: Accept-Language.
 	{
 		val := string("en")
@@ -1536,26 +1598,116 @@ func decodeGetAccountEventParams(args [2]string, argsEscaped bool, r *http.Reque
 			Err:  err,
 		}
 	}
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "before_lt",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Limit = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           100,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Limit)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// GetAccountEventsParams is parameters of getAccountEvents operation.
-type GetAccountEventsParams struct {
+// GetAccountActivityHeatmapParams is parameters of getAccountActivityHeatmap operation.
+type GetAccountActivityHeatmapParams struct {
 	// Account ID.
-	AccountID      string
-	AcceptLanguage OptString
-	// Show only events that are initiated by this account.
-	Initiator OptBool
-	// Filter actions where requested account is not real subject (for example sender or receiver jettons).
-	SubjectOnly OptBool
-	// Omit this parameter to get last events.
-	BeforeLt  OptInt64
-	Limit     int
+	AccountID string
+	// Size of a bucket.
+	Interval OptGetAccountActivityHeatmapInterval
+	// By default, the last 7 days for hourly buckets and the last 365 days for daily ones.
 	StartDate OptInt64
-	EndDate   OptInt64
+	// The current time by default.
+	EndDate OptInt64
 }
 
-func unpackGetAccountEventsParams(packed middleware.Parameters) (params GetAccountEventsParams) {
+func unpackGetAccountActivityHeatmapParams(packed middleware.Parameters) (params GetAccountActivityHeatmapParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -1565,71 +1717,36 @@ func unpackGetAccountEventsParams(packed middleware.Parameters) (params GetAccou
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "initiator",
+			Name: "interval",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Initiator = v.(OptBool)
+			params.Interval = v.(OptGetAccountActivityHeatmapInterval)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "subject_only",
+			Name: "start_date",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SubjectOnly = v.(OptBool)
+			params.StartDate = v.(OptInt64)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "before_lt",
+			Name: "end_date",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.BeforeLt = v.(OptInt64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		params.Limit = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start_date",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptInt64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "end_date",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptInt64)
+			params.EndDate = v.(OptInt64)
 		}
 	}
 	return params
 }
 
-func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountEventsParams, _ error) {
+func decodeGetAccountActivityHeatmapParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountActivityHeatmapParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -1675,20 +1792,22 @@ func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
+	// Set default value for query: interval.
 	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
+		val := GetAccountActivityHeatmapInterval("day")
+		params.Interval.SetTo(val)
 	}
-	// Decode header: Accept-Language.
+	// Decode query: interval.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIntervalVal GetAccountActivityHeatmapInterval
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1700,58 +1819,68 @@ func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 						return err
 					}
 
-					paramsDotAcceptLanguageVal = c
+					paramsDotIntervalVal = GetAccountActivityHeatmapInterval(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				params.Interval.SetTo(paramsDotIntervalVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Interval.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "interval",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: initiator.
-	{
-		val := bool(false)
-		params.Initiator.SetTo(val)
-	}
-	// Decode query: initiator.
+	// Decode query: start_date.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "initiator",
+			Name:    "start_date",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotInitiatorVal bool
+				var paramsDotStartDateVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToInt64(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotInitiatorVal = c
+					paramsDotStartDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Initiator.SetTo(paramsDotInitiatorVal)
+				params.StartDate.SetTo(paramsDotStartDateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1760,44 +1889,39 @@ func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "initiator",
+			Name: "start_date",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: subject_only.
-	{
-		val := bool(false)
-		params.SubjectOnly.SetTo(val)
-	}
-	// Decode query: subject_only.
+	// Decode query: end_date.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "subject_only",
+			Name:    "end_date",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSubjectOnlyVal bool
+				var paramsDotEndDateVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToInt64(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSubjectOnlyVal = c
+					paramsDotEndDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.SubjectOnly.SetTo(paramsDotSubjectOnlyVal)
+				params.EndDate.SetTo(paramsDotEndDateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1806,56 +1930,164 @@ func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "subject_only",
+			Name: "end_date",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: before_lt.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "before_lt",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// GetAccountCodeHistoryParams is parameters of getAccountCodeHistory operation.
+type GetAccountCodeHistoryParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetAccountCodeHistoryParams(packed middleware.Parameters) (params GetAccountCodeHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
 		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBeforeLtVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeGetAccountCodeHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountCodeHistoryParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotBeforeLtVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+
+				params.AccountID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "before_lt",
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountDiffParams is parameters of getAccountDiff operation.
+type GetAccountDiffParams struct {
+	// Account ID.
+	AccountID string
+	StartDate int64
+	EndDate   int64
+}
+
+func unpackGetAccountDiffParams(packed middleware.Parameters) (params GetAccountDiffParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start_date",
 			In:   "query",
+		}
+		params.StartDate = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end_date",
+			In:   "query",
+		}
+		params.EndDate = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeGetAccountDiffParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountDiffParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
+	// Decode query: start_date.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "start_date",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
@@ -1867,29 +2099,4129 @@ func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToInt64(val)
 				if err != nil {
 					return err
 				}
 
-				params.Limit = c
+				params.StartDate = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.EndDate = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountDnsExpiringParams is parameters of getAccountDnsExpiring operation.
+type GetAccountDnsExpiringParams struct {
+	// Account ID.
+	AccountID string
+	// Number of days before expiration.
+	Period OptInt
+}
+
+func unpackGetAccountDnsExpiringParams(packed middleware.Parameters) (params GetAccountDnsExpiringParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "period",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Period = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountDnsExpiringParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountDnsExpiringParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           1,
-					MaxSet:        true,
-					Max:           100,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Limit)); err != nil {
-					return errors.Wrap(err, "int")
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: period.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPeriodVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPeriodVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Period.SetTo(paramsDotPeriodVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Period.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           3660,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "period",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountEventParams is parameters of getAccountEvent operation.
+type GetAccountEventParams struct {
+	// Account ID.
+	AccountID string
+	// Event ID or transaction hash in hex (without 0x) or base64url format.
+	EventID        string
+	AcceptLanguage OptString
+	// Filter actions where requested account is not real subject (for example sender or receiver jettons).
+	SubjectOnly OptBool
+}
+
+func unpackGetAccountEventParams(packed middleware.Parameters) (params GetAccountEventParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "event_id",
+			In:   "path",
+		}
+		params.EventID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "subject_only",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SubjectOnly = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountEventParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAccountEventParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: event_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "event_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.EventID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "event_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Set default value for query: subject_only.
+	{
+		val := bool(false)
+		params.SubjectOnly.SetTo(val)
+	}
+	// Decode query: subject_only.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "subject_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSubjectOnlyVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSubjectOnlyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SubjectOnly.SetTo(paramsDotSubjectOnlyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "subject_only",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountEventsParams is parameters of getAccountEvents operation.
+type GetAccountEventsParams struct {
+	// Account ID.
+	AccountID      string
+	AcceptLanguage OptString
+	// Show only events that are initiated by this account.
+	Initiator OptBool
+	// Filter actions where requested account is not real subject (for example sender or receiver jettons).
+	SubjectOnly OptBool
+	// Omit this parameter to get last events.
+	BeforeLt  OptInt64
+	Limit     int
+	StartDate OptInt64
+	EndDate   OptInt64
+}
+
+func unpackGetAccountEventsParams(packed middleware.Parameters) (params GetAccountEventsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "initiator",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Initiator = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "subject_only",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SubjectOnly = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "before_lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		params.Limit = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDate = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndDate = v.(OptInt64)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountEventsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Set default value for query: initiator.
+	{
+		val := bool(false)
+		params.Initiator.SetTo(val)
+	}
+	// Decode query: initiator.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "initiator",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotInitiatorVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotInitiatorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Initiator.SetTo(paramsDotInitiatorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "initiator",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: subject_only.
+	{
+		val := bool(false)
+		params.SubjectOnly.SetTo(val)
+	}
+	// Decode query: subject_only.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "subject_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSubjectOnlyVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSubjectOnlyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SubjectOnly.SetTo(paramsDotSubjectOnlyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "subject_only",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "before_lt",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Limit = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           100,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Limit)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartDate.SetTo(paramsDotStartDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndDate.SetTo(paramsDotEndDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountEventsDeltaParams is parameters of getAccountEventsDelta operation.
+type GetAccountEventsDeltaParams struct {
+	// Account ID.
+	AccountID      string
+	AcceptLanguage OptString
+	// Filter actions where requested account is not real subject (for example sender or receiver jettons).
+	SubjectOnly OptBool
+	// Next_cursor of the previous response.
+	SinceCursor OptString
+	Limit       OptInt
+}
+
+func unpackGetAccountEventsDeltaParams(packed middleware.Parameters) (params GetAccountEventsDeltaParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "subject_only",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SubjectOnly = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "since_cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SinceCursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountEventsDeltaParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountEventsDeltaParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Set default value for query: subject_only.
+	{
+		val := bool(false)
+		params.SubjectOnly.SetTo(val)
+	}
+	// Decode query: subject_only.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "subject_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSubjectOnlyVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSubjectOnlyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SubjectOnly.SetTo(paramsDotSubjectOnlyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "subject_only",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: since_cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "since_cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSinceCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSinceCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SinceCursor.SetTo(paramsDotSinceCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "since_cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountInscriptionsParams is parameters of getAccountInscriptions operation.
+type GetAccountInscriptionsParams struct {
+	// Account ID.
+	AccountID string
+	Limit     OptInt
+	Offset    OptInt
+}
+
+func unpackGetAccountInscriptionsParams(packed middleware.Parameters) (params GetAccountInscriptionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountInscriptionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountInscriptionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(1000)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountInscriptionsHistoryParams is parameters of getAccountInscriptionsHistory operation.
+type GetAccountInscriptionsHistoryParams struct {
+	// Account ID.
+	AccountID      string
+	AcceptLanguage OptString
+	// Omit this parameter to get last events.
+	BeforeLt OptInt64
+	Limit    OptInt
+}
+
+func unpackGetAccountInscriptionsHistoryParams(packed middleware.Parameters) (params GetAccountInscriptionsHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "before_lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountInscriptionsHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountInscriptionsHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "before_lt",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountInscriptionsHistoryByTickerParams is parameters of getAccountInscriptionsHistoryByTicker operation.
+type GetAccountInscriptionsHistoryByTickerParams struct {
+	// Account ID.
+	AccountID      string
+	AcceptLanguage OptString
+	Ticker         string
+	// Omit this parameter to get last events.
+	BeforeLt OptInt64
+	Limit    OptInt
+}
+
+func unpackGetAccountInscriptionsHistoryByTickerParams(packed middleware.Parameters) (params GetAccountInscriptionsHistoryByTickerParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "ticker",
+			In:   "path",
+		}
+		params.Ticker = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "before_lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountInscriptionsHistoryByTickerParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAccountInscriptionsHistoryByTickerParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: ticker.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "ticker",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Ticker = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "ticker",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "before_lt",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountJettonBalanceParams is parameters of getAccountJettonBalance operation.
+type GetAccountJettonBalanceParams struct {
+	// Account ID.
+	AccountID string
+	// Jetton ID.
+	JettonID string
+	// Accept ton and all possible fiat currencies, separated by commas.
+	Currencies []string
+	// Comma separated list supported extensions.
+	SupportedExtensions []string
+}
+
+func unpackGetAccountJettonBalanceParams(packed middleware.Parameters) (params GetAccountJettonBalanceParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "jetton_id",
+			In:   "path",
+		}
+		params.JettonID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "currencies",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Currencies = v.([]string)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "supported_extensions",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SupportedExtensions = v.([]string)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountJettonBalanceParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAccountJettonBalanceParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: jetton_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "jetton_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.JettonID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "jetton_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: currencies.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "currencies",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotCurrenciesVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotCurrenciesVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Currencies = append(params.Currencies, paramsDotCurrenciesVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "currencies",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: supported_extensions.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "supported_extensions",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotSupportedExtensionsVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotSupportedExtensionsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.SupportedExtensions = append(params.SupportedExtensions, paramsDotSupportedExtensionsVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "supported_extensions",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountJettonHistoryByIDParams is parameters of getAccountJettonHistoryByID operation.
+type GetAccountJettonHistoryByIDParams struct {
+	// Account ID.
+	AccountID string
+	// Jetton ID.
+	JettonID       string
+	AcceptLanguage OptString
+	// Omit this parameter to get last events.
+	BeforeLt  OptInt64
+	Limit     int
+	StartDate OptInt64
+	EndDate   OptInt64
+}
+
+func unpackGetAccountJettonHistoryByIDParams(packed middleware.Parameters) (params GetAccountJettonHistoryByIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "jetton_id",
+			In:   "path",
+		}
+		params.JettonID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "before_lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		params.Limit = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDate = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndDate = v.(OptInt64)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountJettonHistoryByIDParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAccountJettonHistoryByIDParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: jetton_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "jetton_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.JettonID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "jetton_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "before_lt",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Limit = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           1000,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Limit)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartDate.SetTo(paramsDotStartDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndDate.SetTo(paramsDotEndDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountJettonsBalancesParams is parameters of getAccountJettonsBalances operation.
+type GetAccountJettonsBalancesParams struct {
+	// Account ID.
+	AccountID string
+	// Accept ton and all possible fiat currencies, separated by commas.
+	Currencies []string
+	// Comma separated list supported extensions.
+	SupportedExtensions []string
+}
+
+func unpackGetAccountJettonsBalancesParams(packed middleware.Parameters) (params GetAccountJettonsBalancesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "currencies",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Currencies = v.([]string)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "supported_extensions",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SupportedExtensions = v.([]string)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountJettonsBalancesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountJettonsBalancesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: currencies.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "currencies",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotCurrenciesVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotCurrenciesVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Currencies = append(params.Currencies, paramsDotCurrenciesVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "currencies",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: supported_extensions.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "supported_extensions",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotSupportedExtensionsVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotSupportedExtensionsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.SupportedExtensions = append(params.SupportedExtensions, paramsDotSupportedExtensionsVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "supported_extensions",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountJettonsHistoryParams is parameters of getAccountJettonsHistory operation.
+type GetAccountJettonsHistoryParams struct {
+	// Account ID.
+	AccountID      string
+	AcceptLanguage OptString
+	// Omit this parameter to get last events.
+	BeforeLt  OptInt64
+	Limit     int
+	StartDate OptInt64
+	EndDate   OptInt64
+}
+
+func unpackGetAccountJettonsHistoryParams(packed middleware.Parameters) (params GetAccountJettonsHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "before_lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		params.Limit = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDate = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndDate = v.(OptInt64)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountJettonsHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountJettonsHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "before_lt",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Limit = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           1000,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Limit)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartDate.SetTo(paramsDotStartDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndDate.SetTo(paramsDotEndDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountMultisigsParams is parameters of getAccountMultisigs operation.
+type GetAccountMultisigsParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetAccountMultisigsParams(packed middleware.Parameters) (params GetAccountMultisigsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAccountMultisigsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountMultisigsParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountNftHistoryParams is parameters of getAccountNftHistory operation.
+type GetAccountNftHistoryParams struct {
+	// Account ID.
+	AccountID      string
+	AcceptLanguage OptString
+	// Omit this parameter to get last events.
+	BeforeLt  OptInt64
+	Limit     int
+	StartDate OptInt64
+	EndDate   OptInt64
+}
+
+func unpackGetAccountNftHistoryParams(packed middleware.Parameters) (params GetAccountNftHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "before_lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		params.Limit = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDate = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndDate = v.(OptInt64)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountNftHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountNftHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "before_lt",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Limit = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           1000,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Limit)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartDate.SetTo(paramsDotStartDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndDate.SetTo(paramsDotEndDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end_date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountNftItemsParams is parameters of getAccountNftItems operation.
+type GetAccountNftItemsParams struct {
+	// Account ID.
+	AccountID string
+	// Nft collection.
+	Collection OptString
+	Limit      OptInt
+	Offset     OptInt
+	// Selling nft items in ton implemented usually via transfer items to special selling account. This
+	// option enables including items which owned not directly.
+	IndirectOwnership OptBool
+}
+
+func unpackGetAccountNftItemsParams(packed middleware.Parameters) (params GetAccountNftItemsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "collection",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Collection = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "indirect_ownership",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IndirectOwnership = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeGetAccountNftItemsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountNftItemsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: collection.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "collection",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCollectionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCollectionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Collection.SetTo(paramsDotCollectionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "collection",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(1000)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: indirect_ownership.
+	{
+		val := bool(false)
+		params.IndirectOwnership.SetTo(val)
+	}
+	// Decode query: indirect_ownership.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "indirect_ownership",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIndirectOwnershipVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIndirectOwnershipVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IndirectOwnership.SetTo(paramsDotIndirectOwnershipVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "indirect_ownership",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountNominatorsPoolsParams is parameters of getAccountNominatorsPools operation.
+type GetAccountNominatorsPoolsParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetAccountNominatorsPoolsParams(packed middleware.Parameters) (params GetAccountNominatorsPoolsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAccountNominatorsPoolsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountNominatorsPoolsParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountPublicKeyParams is parameters of getAccountPublicKey operation.
+type GetAccountPublicKeyParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetAccountPublicKeyParams(packed middleware.Parameters) (params GetAccountPublicKeyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAccountPublicKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountPublicKeyParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountSeqnoParams is parameters of getAccountSeqno operation.
+type GetAccountSeqnoParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetAccountSeqnoParams(packed middleware.Parameters) (params GetAccountSeqnoParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAccountSeqnoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountSeqnoParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountStateDiffParams is parameters of getAccountStateDiff operation.
+type GetAccountStateDiffParams struct {
+	// Account ID.
+	AccountID string
+	// Masterchain seqno of the first block.
+	FromSeqno int32
+	// Masterchain seqno of the second block, it must be greater than from_seqno.
+	ToSeqno int32
+}
+
+func unpackGetAccountStateDiffParams(packed middleware.Parameters) (params GetAccountStateDiffParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from_seqno",
+			In:   "query",
+		}
+		params.FromSeqno = packed[key].(int32)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to_seqno",
+			In:   "query",
+		}
+		params.ToSeqno = packed[key].(int32)
+	}
+	return params
+}
+
+func decodeGetAccountStateDiffParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountStateDiffParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1900,89 +6232,79 @@ func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: start_date.
+	// Decode query: from_seqno.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_date",
+			Name:    "from_seqno",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotStartDateVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt32(val)
+				if err != nil {
 					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
+
+				params.FromSeqno = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start_date",
+			Name: "from_seqno",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: end_date.
+	// Decode query: to_seqno.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_date",
+			Name:    "to_seqno",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotEndDateVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt32(val)
+				if err != nil {
 					return err
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
+
+				params.ToSeqno = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end_date",
+			Name: "to_seqno",
 			In:   "query",
 			Err:  err,
 		}
@@ -1990,15 +6312,15 @@ func decodeGetAccountEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetAccountInscriptionsParams is parameters of getAccountInscriptions operation.
-type GetAccountInscriptionsParams struct {
+// GetAccountStatsParams is parameters of getAccountStats operation.
+type GetAccountStatsParams struct {
 	// Account ID.
 	AccountID string
-	Limit     OptInt
-	Offset    OptInt
+	// Time window to aggregate transactions over.
+	Window OptGetAccountStatsWindow
 }
 
-func unpackGetAccountInscriptionsParams(packed middleware.Parameters) (params GetAccountInscriptionsParams) {
+func unpackGetAccountStatsParams(packed middleware.Parameters) (params GetAccountStatsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -2008,26 +6330,17 @@ func unpackGetAccountInscriptionsParams(packed middleware.Parameters) (params Ge
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "window",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.Window = v.(OptGetAccountStatsWindow)
 		}
 	}
 	return params
 }
 
-func decodeGetAccountInscriptionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountInscriptionsParams, _ error) {
+func decodeGetAccountStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountStatsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: account_id.
 	if err := func() error {
@@ -2074,57 +6387,48 @@ func decodeGetAccountInscriptionsParams(args [1]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	// Set default value for query: window.
 	{
-		val := int(1000)
-		params.Limit.SetTo(val)
+		val := GetAccountStatsWindow("all")
+		params.Window.SetTo(val)
 	}
-	// Decode query: limit.
+	// Decode query: window.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "window",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotWindowVal GetAccountStatsWindow
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotWindowVal = GetAccountStatsWindow(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Window.SetTo(paramsDotWindowVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.Window.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -2139,95 +6443,156 @@ func decodeGetAccountInscriptionsParams(args [1]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "window",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// GetAccountStorageRentParams is parameters of getAccountStorageRent operation.
+type GetAccountStorageRentParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetAccountStorageRentParams(packed middleware.Parameters) (params GetAccountStorageRentParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
 	}
-	// Decode query: offset.
+	return params
+}
+
+func decodeGetAccountStorageRentParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountStorageRentParams, _ error) {
+	// Decode path: account_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.AccountID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAccountSubscriptionsParams is parameters of getAccountSubscriptions operation.
+type GetAccountSubscriptionsParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetAccountSubscriptionsParams(packed middleware.Parameters) (params GetAccountSubscriptionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAccountSubscriptionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountSubscriptionsParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetAccountInscriptionsHistoryParams is parameters of getAccountInscriptionsHistory operation.
-type GetAccountInscriptionsHistoryParams struct {
+// GetAccountTracesParams is parameters of getAccountTraces operation.
+type GetAccountTracesParams struct {
 	// Account ID.
-	AccountID      string
-	AcceptLanguage OptString
+	AccountID string
 	// Omit this parameter to get last events.
 	BeforeLt OptInt64
 	Limit    OptInt
 }
 
-func unpackGetAccountInscriptionsHistoryParams(packed middleware.Parameters) (params GetAccountInscriptionsHistoryParams) {
+func unpackGetAccountTracesParams(packed middleware.Parameters) (params GetAccountTracesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -2235,15 +6600,6 @@ func unpackGetAccountInscriptionsHistoryParams(packed middleware.Parameters) (pa
 		}
 		params.AccountID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
 	{
 		key := middleware.ParameterKey{
 			Name: "before_lt",
@@ -2265,9 +6621,8 @@ func unpackGetAccountInscriptionsHistoryParams(packed middleware.Parameters) (pa
 	return params
 }
 
-func decodeGetAccountInscriptionsHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountInscriptionsHistoryParams, _ error) {
+func decodeGetAccountTracesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountTracesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -2313,50 +6668,6 @@ func decodeGetAccountInscriptionsHistoryParams(args [1]string, argsEscaped bool,
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
-	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
-		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotAcceptLanguageVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
-			Err:  err,
-		}
-	}
 	// Decode query: before_lt.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -2471,124 +6782,92 @@ func decodeGetAccountInscriptionsHistoryParams(args [1]string, argsEscaped bool,
 	return params, nil
 }
 
-// GetAccountInscriptionsHistoryByTickerParams is parameters of getAccountInscriptionsHistoryByTicker operation.
-type GetAccountInscriptionsHistoryByTickerParams struct {
-	// Account ID.
-	AccountID      string
-	AcceptLanguage OptString
-	Ticker         string
-	// Omit this parameter to get last events.
-	BeforeLt OptInt64
-	Limit    OptInt
+// GetAccountsParams is parameters of getAccounts operation.
+type GetAccountsParams struct {
+	Currency OptString
+	// "allow" makes the endpoint respond with successfully retrieved items when some of them fail or
+	// time out.
+	// Such a response is marked with "partial" and lists errors of the missing items.
+	// Without the parameter a single failed item fails the whole request.
+	Partial OptGetAccountsPartial
 }
 
-func unpackGetAccountInscriptionsHistoryByTickerParams(packed middleware.Parameters) (params GetAccountInscriptionsHistoryByTickerParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
-		}
-		params.AccountID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "ticker",
-			In:   "path",
-		}
-		params.Ticker = packed[key].(string)
-	}
+func unpackGetAccountsParams(packed middleware.Parameters) (params GetAccountsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "before_lt",
+			Name: "currency",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.BeforeLt = v.(OptInt64)
+			params.Currency = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "partial",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.Partial = v.(OptGetAccountsPartial)
 		}
 	}
 	return params
 }
 
-func decodeGetAccountInscriptionsHistoryByTickerParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAccountInscriptionsHistoryByTickerParams, _ error) {
+func decodeGetAccountsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAccountsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode path: account_id.
+	// Decode query: currency.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "currency",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCurrencyVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCurrencyVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.AccountID = c
+				params.Currency.SetTo(paramsDotCurrencyVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "currency",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
+	// Decode query: partial.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "partial",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPartialVal GetAccountsPartial
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -2600,98 +6879,92 @@ func decodeGetAccountInscriptionsHistoryByTickerParams(args [2]string, argsEscap
 						return err
 					}
 
-					paramsDotAcceptLanguageVal = c
+					paramsDotPartialVal = GetAccountsPartial(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				params.Partial.SetTo(paramsDotPartialVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
-			Err:  err,
-		}
-	}
-	// Decode path: ticker.
-	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "ticker",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
 			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
+				if value, ok := params.Partial.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
-
-				params.Ticker = c
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "ticker",
-			In:   "path",
+			Name: "partial",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: before_lt.
+	return params, nil
+}
+
+// GetAllAuctionsParams is parameters of getAllAuctions operation.
+type GetAllAuctionsParams struct {
+	// Domain filter for current auctions "ton" or "t.me".
+	Tld OptString
+}
+
+func unpackGetAllAuctionsParams(packed middleware.Parameters) (params GetAllAuctionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "tld",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Tld = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetAllAuctionsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAllAuctionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: tld.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "before_lt",
+			Name:    "tld",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBeforeLtVal int64
+				var paramsDotTldVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt64(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotBeforeLtVal = c
+					paramsDotTldVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				params.Tld.SetTo(paramsDotTldVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2700,97 +6973,92 @@ func decodeGetAccountInscriptionsHistoryByTickerParams(args [2]string, argsEscap
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "before_lt",
+			Name: "tld",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// GetAllRawShardsInfoParams is parameters of getAllRawShardsInfo operation.
+type GetAllRawShardsInfoParams struct {
+	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
+	BlockID string
+}
+
+func unpackGetAllRawShardsInfoParams(packed middleware.Parameters) (params GetAllRawShardsInfoParams) {
 	{
-		val := int(100)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "block_id",
+			In:   "path",
+		}
+		params.BlockID = packed[key].(string)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeGetAllRawShardsInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAllRawShardsInfoParams, _ error) {
+	// Decode path: block_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "block_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "block_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetAccountJettonBalanceParams is parameters of getAccountJettonBalance operation.
-type GetAccountJettonBalanceParams struct {
+// GetBlockchainAccountLogsParams is parameters of getBlockchainAccountLogs operation.
+type GetBlockchainAccountLogsParams struct {
 	// Account ID.
 	AccountID string
-	// Jetton ID.
-	JettonID string
-	// Accept ton and all possible fiat currencies, separated by commas.
-	Currencies []string
-	// Comma separated list supported extensions.
-	SupportedExtensions []string
+	// Omit this parameter to get last logs.
+	BeforeLt OptInt64
+	Limit    OptInt32
+	// Either an operation name like "JettonNotify" or a hex opcode like "0x7362d09c".
+	Operation OptString
 }
 
-func unpackGetAccountJettonBalanceParams(packed middleware.Parameters) (params GetAccountJettonBalanceParams) {
+func unpackGetBlockchainAccountLogsParams(packed middleware.Parameters) (params GetBlockchainAccountLogsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -2800,33 +7068,35 @@ func unpackGetAccountJettonBalanceParams(packed middleware.Parameters) (params G
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "jetton_id",
-			In:   "path",
+			Name: "before_lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
 		}
-		params.JettonID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "currencies",
+			Name: "limit",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Currencies = v.([]string)
+			params.Limit = v.(OptInt32)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "supported_extensions",
+			Name: "operation",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SupportedExtensions = v.([]string)
+			params.Operation = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetAccountJettonBalanceParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAccountJettonBalanceParams, _ error) {
+func decodeGetBlockchainAccountLogsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainAccountLogsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: account_id.
 	if err := func() error {
@@ -2873,125 +7143,146 @@ func decodeGetAccountJettonBalanceParams(args [2]string, argsEscaped bool, r *ht
 			Err:  err,
 		}
 	}
-	// Decode path: jetton_id.
+	// Decode query: before_lt.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jetton_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.JettonID = c
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "jetton_id",
-			In:   "path",
+			Name: "before_lt",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: currencies.
+	// Set default value for query: limit.
+	{
+		val := int32(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "currencies",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotCurrenciesVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
 						}
-
-						paramsDotCurrenciesVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					params.Currencies = append(params.Currencies, paramsDotCurrenciesVal)
-					return nil
-				})
-			}); err != nil {
+				}
+				return nil
+			}(); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "currencies",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: supported_extensions.
+	// Decode query: operation.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "supported_extensions",
+			Name:    "operation",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotSupportedExtensionsVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotOperationVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotSupportedExtensionsVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					params.SupportedExtensions = append(params.SupportedExtensions, paramsDotSupportedExtensionsVal)
+
+					paramsDotOperationVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Operation.SetTo(paramsDotOperationVal)
+				return nil
 			}); err != nil {
 				return err
 			}
@@ -2999,7 +7290,7 @@ func decodeGetAccountJettonBalanceParams(args [2]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "supported_extensions",
+			Name: "operation",
 			In:   "query",
 			Err:  err,
 		}
@@ -3007,21 +7298,19 @@ func decodeGetAccountJettonBalanceParams(args [2]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetAccountJettonHistoryByIDParams is parameters of getAccountJettonHistoryByID operation.
-type GetAccountJettonHistoryByIDParams struct {
+// GetBlockchainAccountTransactionsParams is parameters of getBlockchainAccountTransactions operation.
+type GetBlockchainAccountTransactionsParams struct {
 	// Account ID.
 	AccountID string
-	// Jetton ID.
-	JettonID       string
-	AcceptLanguage OptString
-	// Omit this parameter to get last events.
+	// Omit this parameter to get last transactions.
+	AfterLt OptInt64
+	// Omit this parameter to get last transactions.
 	BeforeLt  OptInt64
-	Limit     int
-	StartDate OptInt64
-	EndDate   OptInt64
+	Limit     OptInt32
+	SortOrder OptGetBlockchainAccountTransactionsSortOrder
 }
 
-func unpackGetAccountJettonHistoryByIDParams(packed middleware.Parameters) (params GetAccountJettonHistoryByIDParams) {
+func unpackGetBlockchainAccountTransactionsParams(packed middleware.Parameters) (params GetBlockchainAccountTransactionsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -3031,18 +7320,11 @@ func unpackGetAccountJettonHistoryByIDParams(packed middleware.Parameters) (para
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "jetton_id",
-			In:   "path",
-		}
-		params.JettonID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "after_lt",
+			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
+			params.AfterLt = v.(OptInt64)
 		}
 	}
 	{
@@ -3059,32 +7341,24 @@ func unpackGetAccountJettonHistoryByIDParams(packed middleware.Parameters) (para
 			Name: "limit",
 			In:   "query",
 		}
-		params.Limit = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start_date",
-			In:   "query",
-		}
 		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptInt64)
+			params.Limit = v.(OptInt32)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "end_date",
+			Name: "sort_order",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptInt64)
+			params.SortOrder = v.(OptGetBlockchainAccountTransactionsSortOrder)
 		}
 	}
 	return params
 }
 
-func decodeGetAccountJettonHistoryByIDParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAccountJettonHistoryByIDParams, _ error) {
+func decodeGetBlockchainAccountTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainAccountTransactionsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -3130,82 +7404,34 @@ func decodeGetAccountJettonHistoryByIDParams(args [2]string, argsEscaped bool, r
 			Err:  err,
 		}
 	}
-	// Decode path: jetton_id.
+	// Decode query: after_lt.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "after_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jetton_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
 
-				params.JettonID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "jetton_id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
-	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
-		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAfterLtVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt64(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotAcceptLanguageVal = c
+					paramsDotAfterLtVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				params.AfterLt.SetTo(paramsDotAfterLtVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3214,8 +7440,8 @@ func decodeGetAccountJettonHistoryByIDParams(args [2]string, argsEscaped bool, r
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "after_lt",
+			In:   "query",
 			Err:  err,
 		}
 	}
@@ -3260,6 +7486,11 @@ func decodeGetAccountJettonHistoryByIDParams(args [2]string, argsEscaped bool, r
 			Err:  err,
 		}
 	}
+	// Set default value for query: limit.
+	{
+		val := int32(100)
+		params.Limit.SetTo(val)
+	}
 	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -3270,127 +7501,118 @@ func decodeGetAccountJettonHistoryByIDParams(args [2]string, argsEscaped bool, r
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt(val)
-				if err != nil {
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Limit = c
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           1,
-					MaxSet:        true,
-					Max:           1000,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Limit)); err != nil {
-					return errors.Wrap(err, "int")
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: start_date.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_date",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotStartDateVal = c
-					return nil
-				}(); err != nil {
-					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start_date",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: end_date.
+	// Set default value for query: sort_order.
+	{
+		val := GetBlockchainAccountTransactionsSortOrder("desc")
+		params.SortOrder.SetTo(val)
+	}
+	// Decode query: sort_order.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_date",
+			Name:    "sort_order",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal int64
+				var paramsDotSortOrderVal GetBlockchainAccountTransactionsSortOrder
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt64(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEndDateVal = c
+					paramsDotSortOrderVal = GetBlockchainAccountTransactionsSortOrder(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
+				params.SortOrder.SetTo(paramsDotSortOrderVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.SortOrder.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end_date",
+			Name: "sort_order",
 			In:   "query",
 			Err:  err,
 		}
@@ -3398,48 +7620,103 @@ func decodeGetAccountJettonHistoryByIDParams(args [2]string, argsEscaped bool, r
 	return params, nil
 }
 
-// GetAccountJettonsBalancesParams is parameters of getAccountJettonsBalances operation.
-type GetAccountJettonsBalancesParams struct {
-	// Account ID.
-	AccountID string
-	// Accept ton and all possible fiat currencies, separated by commas.
-	Currencies []string
-	// Comma separated list supported extensions.
-	SupportedExtensions []string
+// GetBlockchainBlockParams is parameters of getBlockchainBlock operation.
+type GetBlockchainBlockParams struct {
+	// Block ID.
+	BlockID string
 }
 
-func unpackGetAccountJettonsBalancesParams(packed middleware.Parameters) (params GetAccountJettonsBalancesParams) {
+func unpackGetBlockchainBlockParams(packed middleware.Parameters) (params GetBlockchainBlockParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
+		params.BlockID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetBlockchainBlockParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainBlockParams, _ error) {
+	// Decode path: block_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "block_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.BlockID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "block_id",
+			In:   "path",
+			Err:  err,
+		}
 	}
+	return params, nil
+}
+
+// GetBlockchainBlockTransactionsParams is parameters of getBlockchainBlockTransactions operation.
+type GetBlockchainBlockTransactionsParams struct {
+	// Block ID.
+	BlockID string
+	// Return only transactions of the given kinds, separated by commas.
+	Type []TransactionType
+}
+
+func unpackGetBlockchainBlockTransactionsParams(packed middleware.Parameters) (params GetBlockchainBlockTransactionsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "currencies",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Currencies = v.([]string)
+			Name: "block_id",
+			In:   "path",
 		}
+		params.BlockID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "supported_extensions",
+			Name: "type",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SupportedExtensions = v.([]string)
+			params.Type = v.([]TransactionType)
 		}
 	}
 	return params
 }
 
-func decodeGetAccountJettonsBalancesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountJettonsBalancesParams, _ error) {
+func decodeGetBlockchainBlockTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainBlockTransactionsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: account_id.
+	// Decode path: block_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3451,7 +7728,7 @@ func decodeGetAccountJettonsBalancesParams(args [1]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "block_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3468,7 +7745,7 @@ func decodeGetAccountJettonsBalancesParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				params.AccountID = c
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3479,15 +7756,15 @@ func decodeGetAccountJettonsBalancesParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: currencies.
+	// Decode query: type.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "currencies",
+			Name:    "type",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
@@ -3495,7 +7772,7 @@ func decodeGetAccountJettonsBalancesParams(args [1]string, argsEscaped bool, r *
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotCurrenciesVal string
+					var paramsDotTypeVal TransactionType
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
@@ -3507,65 +7784,44 @@ func decodeGetAccountJettonsBalancesParams(args [1]string, argsEscaped bool, r *
 							return err
 						}
 
-						paramsDotCurrenciesVal = c
+						paramsDotTypeVal = TransactionType(c)
 						return nil
 					}(); err != nil {
 						return err
 					}
-					params.Currencies = append(params.Currencies, paramsDotCurrenciesVal)
+					params.Type = append(params.Type, paramsDotTypeVal)
 					return nil
 				})
 			}); err != nil {
 				return err
 			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "currencies",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: supported_extensions.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "supported_extensions",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotSupportedExtensionsVal string
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Type {
 					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
+						if err := elem.Validate(); err != nil {
 							return err
 						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						paramsDotSupportedExtensionsVal = c
 						return nil
 					}(); err != nil {
-						return err
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
 					}
-					params.SupportedExtensions = append(params.SupportedExtensions, paramsDotSupportedExtensionsVal)
-					return nil
-				})
-			}); err != nil {
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "supported_extensions",
+			Name: "type",
 			In:   "query",
 			Err:  err,
 		}
@@ -3573,76 +7829,25 @@ func decodeGetAccountJettonsBalancesParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// GetAccountJettonsHistoryParams is parameters of getAccountJettonsHistory operation.
-type GetAccountJettonsHistoryParams struct {
-	// Account ID.
-	AccountID      string
-	AcceptLanguage OptString
-	// Omit this parameter to get last events.
-	BeforeLt  OptInt64
-	Limit     int
-	StartDate OptInt64
-	EndDate   OptInt64
+// GetBlockchainConfigFromBlockParams is parameters of getBlockchainConfigFromBlock operation.
+type GetBlockchainConfigFromBlockParams struct {
+	// Masterchain block seqno.
+	MasterchainSeqno int32
 }
 
-func unpackGetAccountJettonsHistoryParams(packed middleware.Parameters) (params GetAccountJettonsHistoryParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
-		}
-		params.AccountID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "before_lt",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.BeforeLt = v.(OptInt64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		params.Limit = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start_date",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptInt64)
-		}
-	}
+func unpackGetBlockchainConfigFromBlockParams(packed middleware.Parameters) (params GetBlockchainConfigFromBlockParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "end_date",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptInt64)
+			Name: "masterchain_seqno",
+			In:   "path",
 		}
+		params.MasterchainSeqno = packed[key].(int32)
 	}
 	return params
 }
 
-func decodeGetAccountJettonsHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountJettonsHistoryParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode path: account_id.
+func decodeGetBlockchainConfigFromBlockParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainConfigFromBlockParams, _ error) {
+	// Decode path: masterchain_seqno.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3654,7 +7859,7 @@ func decodeGetAccountJettonsHistoryParams(args [1]string, argsEscaped bool, r *h
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "masterchain_seqno",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3666,12 +7871,12 @@ func decodeGetAccountJettonsHistoryParams(args [1]string, argsEscaped bool, r *h
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt32(val)
 				if err != nil {
 					return err
 				}
 
-				params.AccountID = c
+				params.MasterchainSeqno = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3682,134 +7887,128 @@ func decodeGetAccountJettonsHistoryParams(args [1]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "masterchain_seqno",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
+	return params, nil
+}
+
+// GetBlockchainMasterchainBlocksParams is parameters of getBlockchainMasterchainBlocks operation.
+type GetBlockchainMasterchainBlocksParams struct {
+	// Masterchain block seqno.
+	MasterchainSeqno int32
+}
+
+func unpackGetBlockchainMasterchainBlocksParams(packed middleware.Parameters) (params GetBlockchainMasterchainBlocksParams) {
 	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "masterchain_seqno",
+			In:   "path",
+		}
+		params.MasterchainSeqno = packed[key].(int32)
 	}
-	// Decode header: Accept-Language.
+	return params
+}
+
+func decodeGetBlockchainMasterchainBlocksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainMasterchainBlocksParams, _ error) {
+	// Decode path: masterchain_seqno.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "masterchain_seqno",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotAcceptLanguageVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt32(val)
+				if err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+
+				params.MasterchainSeqno = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "masterchain_seqno",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: before_lt.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "before_lt",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBeforeLtVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+// GetBlockchainMasterchainShardsParams is parameters of getBlockchainMasterchainShards operation.
+type GetBlockchainMasterchainShardsParams struct {
+	// Masterchain block seqno.
+	MasterchainSeqno int32
+}
 
-					paramsDotBeforeLtVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "before_lt",
-			In:   "query",
-			Err:  err,
+func unpackGetBlockchainMasterchainShardsParams(packed middleware.Parameters) (params GetBlockchainMasterchainShardsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "masterchain_seqno",
+			In:   "path",
 		}
+		params.MasterchainSeqno = packed[key].(int32)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeGetBlockchainMasterchainShardsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainMasterchainShardsParams, _ error) {
+	// Decode path: masterchain_seqno.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "masterchain_seqno",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToInt32(val)
 				if err != nil {
 					return err
 				}
 
-				params.Limit = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           1,
-					MaxSet:        true,
-					Max:           1000,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Limit)); err != nil {
-					return errors.Wrap(err, "int")
-				}
+				params.MasterchainSeqno = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3820,89 +8019,150 @@ func decodeGetAccountJettonsHistoryParams(args [1]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "masterchain_seqno",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: start_date.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_date",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// GetBlockchainMasterchainTransactionsParams is parameters of getBlockchainMasterchainTransactions operation.
+type GetBlockchainMasterchainTransactionsParams struct {
+	// Masterchain block seqno.
+	MasterchainSeqno int32
+	// Return only transactions of the given kinds, separated by commas.
+	Type []TransactionType
+}
+
+func unpackGetBlockchainMasterchainTransactionsParams(packed middleware.Parameters) (params GetBlockchainMasterchainTransactionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "masterchain_seqno",
+			In:   "path",
+		}
+		params.MasterchainSeqno = packed[key].(int32)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "type",
+			In:   "query",
 		}
+		if v, ok := packed[key]; ok {
+			params.Type = v.([]TransactionType)
+		}
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeGetBlockchainMasterchainTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainMasterchainTransactionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: masterchain_seqno.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "masterchain_seqno",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotStartDateVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt32(val)
+				if err != nil {
 					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
+
+				params.MasterchainSeqno = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start_date",
-			In:   "query",
+			Name: "masterchain_seqno",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: end_date.
+	// Decode query: type.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_date",
+			Name:    "type",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotTypeVal TransactionType
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToInt64(val)
-					if err != nil {
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotTypeVal = TransactionType(c)
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotEndDateVal = c
+					params.Type = append(params.Type, paramsDotTypeVal)
 					return nil
-				}(); err != nil {
-					return err
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Type {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end_date",
+			Name: "type",
 			In:   "query",
 			Err:  err,
 		}
@@ -3910,13 +8170,13 @@ func decodeGetAccountJettonsHistoryParams(args [1]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// GetAccountMultisigsParams is parameters of getAccountMultisigs operation.
-type GetAccountMultisigsParams struct {
+// GetBlockchainRawAccountParams is parameters of getBlockchainRawAccount operation.
+type GetBlockchainRawAccountParams struct {
 	// Account ID.
 	AccountID string
 }
 
-func unpackGetAccountMultisigsParams(packed middleware.Parameters) (params GetAccountMultisigsParams) {
+func unpackGetBlockchainRawAccountParams(packed middleware.Parameters) (params GetBlockchainRawAccountParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -3927,7 +8187,7 @@ func unpackGetAccountMultisigsParams(packed middleware.Parameters) (params GetAc
 	return params
 }
 
-func decodeGetAccountMultisigsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountMultisigsParams, _ error) {
+func decodeGetBlockchainRawAccountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainRawAccountParams, _ error) {
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -3976,76 +8236,25 @@ func decodeGetAccountMultisigsParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetAccountNftHistoryParams is parameters of getAccountNftHistory operation.
-type GetAccountNftHistoryParams struct {
-	// Account ID.
-	AccountID      string
-	AcceptLanguage OptString
-	// Omit this parameter to get last events.
-	BeforeLt  OptInt64
-	Limit     int
-	StartDate OptInt64
-	EndDate   OptInt64
+// GetBlockchainTransactionParams is parameters of getBlockchainTransaction operation.
+type GetBlockchainTransactionParams struct {
+	// Transaction ID.
+	TransactionID string
 }
 
-func unpackGetAccountNftHistoryParams(packed middleware.Parameters) (params GetAccountNftHistoryParams) {
+func unpackGetBlockchainTransactionParams(packed middleware.Parameters) (params GetBlockchainTransactionParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "transaction_id",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "before_lt",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.BeforeLt = v.(OptInt64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		params.Limit = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start_date",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptInt64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "end_date",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptInt64)
-		}
+		params.TransactionID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetAccountNftHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountNftHistoryParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode path: account_id.
+func decodeGetBlockchainTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainTransactionParams, _ error) {
+	// Decode path: transaction_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4057,7 +8266,7 @@ func decodeGetAccountNftHistoryParams(args [1]string, argsEscaped bool, r *http.
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "transaction_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4074,7 +8283,7 @@ func decodeGetAccountNftHistoryParams(args [1]string, argsEscaped bool, r *http.
 					return err
 				}
 
-				params.AccountID = c
+				params.TransactionID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4085,134 +8294,62 @@ func decodeGetAccountNftHistoryParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "transaction_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
-	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
-		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+// GetBlockchainTransactionByMessageHashParams is parameters of getBlockchainTransactionByMessageHash operation.
+type GetBlockchainTransactionByMessageHashParams struct {
+	// Message ID.
+	MsgID string
+}
 
-					paramsDotAcceptLanguageVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
-			Err:  err,
+func unpackGetBlockchainTransactionByMessageHashParams(packed middleware.Parameters) (params GetBlockchainTransactionByMessageHashParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "msg_id",
+			In:   "path",
 		}
+		params.MsgID = packed[key].(string)
 	}
-	// Decode query: before_lt.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "before_lt",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBeforeLtVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+	return params
+}
 
-					paramsDotBeforeLtVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "before_lt",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: limit.
+func decodeGetBlockchainTransactionByMessageHashParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainTransactionByMessageHashParams, _ error) {
+	// Decode path: msg_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "msg_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.Limit = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           1,
-					MaxSet:        true,
-					Max:           1000,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Limit)); err != nil {
-					return errors.Wrap(err, "int")
-				}
+				params.MsgID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4223,22 +8360,51 @@ func decodeGetAccountNftHistoryParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "msg_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: start_date.
+	return params, nil
+}
+
+// GetChainCalendarParams is parameters of getChainCalendar operation.
+type GetChainCalendarParams struct {
+	// How far ahead to look, in seconds.
+	Period OptInt64
+}
+
+func unpackGetChainCalendarParams(packed middleware.Parameters) (params GetChainCalendarParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "period",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Period = v.(OptInt64)
+		}
+	}
+	return params
+}
+
+func decodeGetChainCalendarParams(args [0]string, argsEscaped bool, r *http.Request) (params GetChainCalendarParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: period.
+	{
+		val := int64(604800)
+		params.Period.SetTo(val)
+	}
+	// Decode query: period.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_date",
+			Name:    "period",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal int64
+				var paramsDotPeriodVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4250,62 +8416,45 @@ func decodeGetAccountNftHistoryParams(args [1]string, argsEscaped bool, r *http.
 						return err
 					}
 
-					paramsDotStartDateVal = c
+					paramsDotPeriodVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
+				params.Period.SetTo(paramsDotPeriodVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "start_date",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: end_date.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_date",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
+			if err := func() error {
+				if value, ok := params.Period.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           2592000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotEndDateVal = c
-					return nil
-				}(); err != nil {
-					return err
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end_date",
+			Name: "period",
 			In:   "query",
 			Err:  err,
 		}
@@ -4313,87 +8462,75 @@ func decodeGetAccountNftHistoryParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetAccountNftItemsParams is parameters of getAccountNftItems operation.
-type GetAccountNftItemsParams struct {
-	// Account ID.
-	AccountID string
-	// Nft collection.
-	Collection OptString
-	Limit      OptInt
-	Offset     OptInt
-	// Selling nft items in ton implemented usually via transfer items to special selling account. This
-	// option enables including items which owned not directly.
-	IndirectOwnership OptBool
+// GetChartRatesParams is parameters of getChartRates operation.
+type GetChartRatesParams struct {
+	// Accept jetton master address.
+	Token       string
+	Currency    OptString
+	StartDate   OptInt64
+	EndDate     OptInt64
+	PointsCount OptInt
 }
 
-func unpackGetAccountNftItemsParams(packed middleware.Parameters) (params GetAccountNftItemsParams) {
+func unpackGetChartRatesParams(packed middleware.Parameters) (params GetChartRatesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
+			Name: "token",
+			In:   "query",
 		}
-		params.AccountID = packed[key].(string)
+		params.Token = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "collection",
+			Name: "currency",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Collection = v.(OptString)
+			params.Currency = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "start_date",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.StartDate = v.(OptInt64)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "end_date",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.EndDate = v.(OptInt64)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "indirect_ownership",
+			Name: "points_count",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.IndirectOwnership = v.(OptBool)
+			params.PointsCount = v.(OptInt)
 		}
 	}
 	return params
 }
 
-func decodeGetAccountNftItemsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountNftItemsParams, _ error) {
+func decodeGetChartRatesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetChartRatesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: account_id.
+	// Decode query: token.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "token",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -4404,9 +8541,9 @@ func decodeGetAccountNftItemsParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.AccountID = c
+				params.Token = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
@@ -4415,22 +8552,22 @@ func decodeGetAccountNftItemsParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "token",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: collection.
+	// Decode query: currency.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "collection",
+			Name:    "currency",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCollectionVal string
+				var paramsDotCurrencyVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4442,12 +8579,12 @@ func decodeGetAccountNftItemsParams(args [1]string, argsEscaped bool, r *http.Re
 						return err
 					}
 
-					paramsDotCollectionVal = c
+					paramsDotCurrencyVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Collection.SetTo(paramsDotCollectionVal)
+				params.Currency.SetTo(paramsDotCurrencyVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4456,193 +8593,159 @@ func decodeGetAccountNftItemsParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "collection",
+			Name: "currency",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(1000)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: start_date.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "start_date",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotStartDateVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartDateVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.StartDate.SetTo(paramsDotStartDateVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "start_date",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
-	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode query: end_date.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "end_date",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotEndDateVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToInt64(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotEndDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.EndDate.SetTo(paramsDotEndDateVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "end_date",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: indirect_ownership.
+	// Set default value for query: points_count.
 	{
-		val := bool(false)
-		params.IndirectOwnership.SetTo(val)
+		val := int(200)
+		params.PointsCount.SetTo(val)
 	}
-	// Decode query: indirect_ownership.
+	// Decode query: points_count.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "indirect_ownership",
+			Name:    "points_count",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIndirectOwnershipVal bool
+				var paramsDotPointsCountVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotIndirectOwnershipVal = c
+					paramsDotPointsCountVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.IndirectOwnership.SetTo(paramsDotIndirectOwnershipVal)
+				params.PointsCount.SetTo(paramsDotPointsCountVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.PointsCount.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "indirect_ownership",
+			Name: "points_count",
 			In:   "query",
 			Err:  err,
 		}
@@ -4650,25 +8753,25 @@ func decodeGetAccountNftItemsParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetAccountNominatorsPoolsParams is parameters of getAccountNominatorsPools operation.
-type GetAccountNominatorsPoolsParams struct {
-	// Account ID.
-	AccountID string
+// GetDnsInfoParams is parameters of getDnsInfo operation.
+type GetDnsInfoParams struct {
+	// Domain name with .ton or .t.me.
+	DomainName string
 }
 
-func unpackGetAccountNominatorsPoolsParams(packed middleware.Parameters) (params GetAccountNominatorsPoolsParams) {
+func unpackGetDnsInfoParams(packed middleware.Parameters) (params GetDnsInfoParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "domain_name",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
+		params.DomainName = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetAccountNominatorsPoolsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountNominatorsPoolsParams, _ error) {
-	// Decode path: account_id.
+func decodeGetDnsInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDnsInfoParams, _ error) {
+	// Decode path: domain_name.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4680,7 +8783,7 @@ func decodeGetAccountNominatorsPoolsParams(args [1]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "domain_name",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4697,7 +8800,7 @@ func decodeGetAccountNominatorsPoolsParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				params.AccountID = c
+				params.DomainName = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4708,7 +8811,7 @@ func decodeGetAccountNominatorsPoolsParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "domain_name",
 			In:   "path",
 			Err:  err,
 		}
@@ -4716,25 +8819,25 @@ func decodeGetAccountNominatorsPoolsParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// GetAccountPublicKeyParams is parameters of getAccountPublicKey operation.
-type GetAccountPublicKeyParams struct {
-	// Account ID.
-	AccountID string
+// GetDomainBidsParams is parameters of getDomainBids operation.
+type GetDomainBidsParams struct {
+	// Domain name with .ton or .t.me.
+	DomainName string
 }
 
-func unpackGetAccountPublicKeyParams(packed middleware.Parameters) (params GetAccountPublicKeyParams) {
+func unpackGetDomainBidsParams(packed middleware.Parameters) (params GetDomainBidsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "domain_name",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
+		params.DomainName = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetAccountPublicKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountPublicKeyParams, _ error) {
-	// Decode path: account_id.
+func decodeGetDomainBidsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDomainBidsParams, _ error) {
+	// Decode path: domain_name.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4746,7 +8849,7 @@ func decodeGetAccountPublicKeyParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "domain_name",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4763,7 +8866,7 @@ func decodeGetAccountPublicKeyParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.AccountID = c
+				params.DomainName = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4774,7 +8877,7 @@ func decodeGetAccountPublicKeyParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "domain_name",
 			In:   "path",
 			Err:  err,
 		}
@@ -4782,25 +8885,36 @@ func decodeGetAccountPublicKeyParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetAccountSeqnoParams is parameters of getAccountSeqno operation.
-type GetAccountSeqnoParams struct {
-	// Account ID.
-	AccountID string
+// GetEventParams is parameters of getEvent operation.
+type GetEventParams struct {
+	// Event ID or transaction hash in hex (without 0x) or base64url format.
+	EventID        string
+	AcceptLanguage OptString
 }
 
-func unpackGetAccountSeqnoParams(packed middleware.Parameters) (params GetAccountSeqnoParams) {
+func unpackGetEventParams(packed middleware.Parameters) (params GetEventParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "event_id",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
+		params.EventID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
 	}
 	return params
 }
 
-func decodeGetAccountSeqnoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountSeqnoParams, _ error) {
-	// Decode path: account_id.
+func decodeGetEventParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEventParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: event_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4812,7 +8926,7 @@ func decodeGetAccountSeqnoParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "event_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4829,7 +8943,7 @@ func decodeGetAccountSeqnoParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.AccountID = c
+				params.EventID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4840,121 +8954,77 @@ func decodeGetAccountSeqnoParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "event_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetAccountSubscriptionsParams is parameters of getAccountSubscriptions operation.
-type GetAccountSubscriptionsParams struct {
-	// Account ID.
-	AccountID string
-}
-
-func unpackGetAccountSubscriptionsParams(packed middleware.Parameters) (params GetAccountSubscriptionsParams) {
+	// Set default value for header: Accept-Language.
 	{
-		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
-		}
-		params.AccountID = packed[key].(string)
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetAccountSubscriptionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountSubscriptionsParams, _ error) {
-	// Decode path: account_id.
+	// Decode header: Accept-Language.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.AccountID = c
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "Accept-Language",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetAccountTracesParams is parameters of getAccountTraces operation.
-type GetAccountTracesParams struct {
-	// Account ID.
-	AccountID string
-	// Omit this parameter to get last events.
-	BeforeLt OptInt64
-	Limit    OptInt
+// GetEventAnnotationParams is parameters of getEventAnnotation operation.
+type GetEventAnnotationParams struct {
+	// Event ID or transaction hash in hex (without 0x) or base64url format.
+	EventID string
 }
 
-func unpackGetAccountTracesParams(packed middleware.Parameters) (params GetAccountTracesParams) {
+func unpackGetEventAnnotationParams(packed middleware.Parameters) (params GetEventAnnotationParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "event_id",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "before_lt",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.BeforeLt = v.(OptInt64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
+		params.EventID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetAccountTracesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAccountTracesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: account_id.
+func decodeGetEventAnnotationParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEventAnnotationParams, _ error) {
+	// Decode path: event_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4966,7 +9036,7 @@ func decodeGetAccountTracesParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "event_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4983,7 +9053,7 @@ func decodeGetAccountTracesParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.AccountID = c
+				params.EventID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4994,39 +9064,63 @@ func decodeGetAccountTracesParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "event_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: before_lt.
+	return params, nil
+}
+
+// GetExpectedDepositsParams is parameters of getExpectedDeposits operation.
+type GetExpectedDepositsParams struct {
+	// List expectations of this account only.
+	AccountID OptString
+}
+
+func unpackGetExpectedDepositsParams(packed middleware.Parameters) (params GetExpectedDepositsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AccountID = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetExpectedDepositsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetExpectedDepositsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: account_id.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "before_lt",
+			Name:    "account_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBeforeLtVal int64
+				var paramsDotAccountIDVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt64(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotBeforeLtVal = c
+					paramsDotAccountIDVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				params.AccountID.SetTo(paramsDotAccountIDVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5035,115 +9129,139 @@ func decodeGetAccountTracesParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "before_lt",
+			Name: "account_id",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// GetInscriptionOpTemplateParams is parameters of getInscriptionOpTemplate operation.
+type GetInscriptionOpTemplateParams struct {
+	Type        GetInscriptionOpTemplateType
+	Destination OptString
+	Comment     OptString
+	Operation   GetInscriptionOpTemplateOperation
+	Amount      string
+	Ticker      string
+	Who         string
+}
+
+func unpackGetInscriptionOpTemplateParams(packed middleware.Parameters) (params GetInscriptionOpTemplateParams) {
 	{
-		val := int(100)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "type",
+			In:   "query",
+		}
+		params.Type = packed[key].(GetInscriptionOpTemplateType)
 	}
-	// Decode query: limit.
+	{
+		key := middleware.ParameterKey{
+			Name: "destination",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Destination = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "comment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Comment = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "operation",
+			In:   "query",
+		}
+		params.Operation = packed[key].(GetInscriptionOpTemplateOperation)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "amount",
+			In:   "query",
+		}
+		params.Amount = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "ticker",
+			In:   "query",
+		}
+		params.Ticker = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "who",
+			In:   "query",
+		}
+		params.Who = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetInscriptionOpTemplateParams(args [0]string, argsEscaped bool, r *http.Request) (params GetInscriptionOpTemplateParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: type.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "type",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.Type = GetInscriptionOpTemplateType(c)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				if err := params.Type.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "type",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetAccountsParams is parameters of getAccounts operation.
-type GetAccountsParams struct {
-	Currency OptString
-}
-
-func unpackGetAccountsParams(packed middleware.Parameters) (params GetAccountsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "currency",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Currency = v.(OptString)
-		}
-	}
-	return params
-}
-
-func decodeGetAccountsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAccountsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: currency.
+	// Decode query: destination.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "currency",
+			Name:    "destination",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCurrencyVal string
+				var paramsDotDestinationVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -5155,12 +9273,12 @@ func decodeGetAccountsParams(args [0]string, argsEscaped bool, r *http.Request)
 						return err
 					}
 
-					paramsDotCurrencyVal = c
+					paramsDotDestinationVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Currency.SetTo(paramsDotCurrencyVal)
+				params.Destination.SetTo(paramsDotDestinationVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5169,46 +9287,22 @@ func decodeGetAccountsParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "currency",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetAllAuctionsParams is parameters of getAllAuctions operation.
-type GetAllAuctionsParams struct {
-	// Domain filter for current auctions "ton" or "t.me".
-	Tld OptString
-}
-
-func unpackGetAllAuctionsParams(packed middleware.Parameters) (params GetAllAuctionsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "tld",
+			Name: "destination",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Tld = v.(OptString)
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeGetAllAuctionsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAllAuctionsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: tld.
+	// Decode query: comment.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "tld",
+			Name:    "comment",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTldVal string
+				var paramsDotCommentVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -5220,12 +9314,12 @@ func decodeGetAllAuctionsParams(args [0]string, argsEscaped bool, r *http.Reques
 						return err
 					}
 
-					paramsDotTldVal = c
+					paramsDotCommentVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Tld.SetTo(paramsDotTldVal)
+				params.Comment.SetTo(paramsDotCommentVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5234,51 +9328,21 @@ func decodeGetAllAuctionsParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "tld",
+			Name: "comment",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetAllRawShardsInfoParams is parameters of getAllRawShardsInfo operation.
-type GetAllRawShardsInfoParams struct {
-	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
-	BlockID string
-}
-
-func unpackGetAllRawShardsInfoParams(packed middleware.Parameters) (params GetAllRawShardsInfoParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
-		}
-		params.BlockID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetAllRawShardsInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAllRawShardsInfoParams, _ error) {
-	// Decode path: block_id.
+	// Decode query: operation.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "operation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -5289,7 +9353,15 @@ func decodeGetAllRawShardsInfoParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.BlockID = c
+				params.Operation = GetInscriptionOpTemplateOperation(c)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Operation.Validate(); err != nil {
+					return err
+				}
 				return nil
 			}(); err != nil {
 				return err
@@ -5300,94 +9372,57 @@ func decodeGetAllRawShardsInfoParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
-			In:   "path",
+			Name: "operation",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: amount.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "amount",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// GetBlockchainAccountTransactionsParams is parameters of getBlockchainAccountTransactions operation.
-type GetBlockchainAccountTransactionsParams struct {
-	// Account ID.
-	AccountID string
-	// Omit this parameter to get last transactions.
-	AfterLt OptInt64
-	// Omit this parameter to get last transactions.
-	BeforeLt  OptInt64
-	Limit     OptInt32
-	SortOrder OptGetBlockchainAccountTransactionsSortOrder
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-func unpackGetBlockchainAccountTransactionsParams(packed middleware.Parameters) (params GetBlockchainAccountTransactionsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
-		}
-		params.AccountID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "after_lt",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.AfterLt = v.(OptInt64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "before_lt",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.BeforeLt = v.(OptInt64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Amount = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "sort_order",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "amount",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.SortOrder = v.(OptGetBlockchainAccountTransactionsSortOrder)
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeGetBlockchainAccountTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainAccountTransactionsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: account_id.
+	// Decode query: ticker.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "ticker",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -5398,9 +9433,9 @@ func decodeGetBlockchainAccountTransactionsParams(args [1]string, argsEscaped bo
 					return err
 				}
 
-				params.AccountID = c
+				params.Ticker = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
@@ -5409,96 +9444,137 @@ func decodeGetBlockchainAccountTransactionsParams(args [1]string, argsEscaped bo
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "ticker",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: after_lt.
+	// Decode query: who.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "after_lt",
+			Name:    "who",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAfterLtVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotAfterLtVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.AfterLt.SetTo(paramsDotAfterLtVal)
+
+				params.Who = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "who",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetItemsFromCollectionParams is parameters of getItemsFromCollection operation.
+type GetItemsFromCollectionParams struct {
+	// Account ID.
+	AccountID string
+	Limit     OptInt
+	Offset    OptInt
+}
+
+func unpackGetItemsFromCollectionParams(packed middleware.Parameters) (params GetItemsFromCollectionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "after_lt",
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
 			In:   "query",
-			Err:  err,
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
 		}
 	}
-	// Decode query: before_lt.
+	return params
+}
+
+func decodeGetItemsFromCollectionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetItemsFromCollectionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "before_lt",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBeforeLtVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotBeforeLtVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+
+				params.AccountID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "before_lt",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	// Set default value for query: limit.
 	{
-		val := int32(100)
+		val := int(1000)
 		params.Limit.SetTo(val)
 	}
 	// Decode query: limit.
@@ -5511,14 +9587,14 @@ func decodeGetBlockchainAccountTransactionsParams(args [1]string, argsEscaped bo
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt32(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
@@ -5566,48 +9642,57 @@ func decodeGetBlockchainAccountTransactionsParams(args [1]string, argsEscaped bo
 			Err:  err,
 		}
 	}
-	// Set default value for query: sort_order.
+	// Set default value for query: offset.
 	{
-		val := GetBlockchainAccountTransactionsSortOrder("desc")
-		params.SortOrder.SetTo(val)
+		val := int(0)
+		params.Offset.SetTo(val)
 	}
-	// Decode query: sort_order.
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort_order",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortOrderVal GetBlockchainAccountTransactionsSortOrder
+				var paramsDotOffsetVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSortOrderVal = GetBlockchainAccountTransactionsSortOrder(c)
+					paramsDotOffsetVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.SortOrder.SetTo(paramsDotSortOrderVal)
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.SortOrder.Get(); ok {
+				if value, ok := params.Offset.Get(); ok {
 					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
 						}
 						return nil
 					}(); err != nil {
@@ -5622,7 +9707,7 @@ func decodeGetBlockchainAccountTransactionsParams(args [1]string, argsEscaped bo
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort_order",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
@@ -5630,157 +9715,46 @@ func decodeGetBlockchainAccountTransactionsParams(args [1]string, argsEscaped bo
 	return params, nil
 }
 
-// GetBlockchainBlockParams is parameters of getBlockchainBlock operation.
-type GetBlockchainBlockParams struct {
-	// Block ID.
-	BlockID string
-}
-
-func unpackGetBlockchainBlockParams(packed middleware.Parameters) (params GetBlockchainBlockParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
-		}
-		params.BlockID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetBlockchainBlockParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainBlockParams, _ error) {
-	// Decode path: block_id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.BlockID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetBlockchainBlockTransactionsParams is parameters of getBlockchainBlockTransactions operation.
-type GetBlockchainBlockTransactionsParams struct {
-	// Block ID.
-	BlockID string
-}
-
-func unpackGetBlockchainBlockTransactionsParams(packed middleware.Parameters) (params GetBlockchainBlockTransactionsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
-		}
-		params.BlockID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetBlockchainBlockTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainBlockTransactionsParams, _ error) {
-	// Decode path: block_id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.BlockID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetBlockchainConfigFromBlockParams is parameters of getBlockchainConfigFromBlock operation.
-type GetBlockchainConfigFromBlockParams struct {
-	// Masterchain block seqno.
-	MasterchainSeqno int32
+// GetJettonHoldersParams is parameters of getJettonHolders operation.
+type GetJettonHoldersParams struct {
+	// Account ID.
+	AccountID string
+	Limit     OptInt
+	Offset    OptInt
 }
 
-func unpackGetBlockchainConfigFromBlockParams(packed middleware.Parameters) (params GetBlockchainConfigFromBlockParams) {
+func unpackGetJettonHoldersParams(packed middleware.Parameters) (params GetJettonHoldersParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "masterchain_seqno",
+			Name: "account_id",
 			In:   "path",
 		}
-		params.MasterchainSeqno = packed[key].(int32)
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
 	}
 	return params
 }
 
-func decodeGetBlockchainConfigFromBlockParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainConfigFromBlockParams, _ error) {
-	// Decode path: masterchain_seqno.
+func decodeGetJettonHoldersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetJettonHoldersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5792,7 +9766,7 @@ func decodeGetBlockchainConfigFromBlockParams(args [1]string, argsEscaped bool,
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "masterchain_seqno",
+				Param:   "account_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5804,12 +9778,12 @@ func decodeGetBlockchainConfigFromBlockParams(args [1]string, argsEscaped bool,
 					return err
 				}
 
-				c, err := conv.ToInt32(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.MasterchainSeqno = c
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5820,165 +9794,173 @@ func decodeGetBlockchainConfigFromBlockParams(args [1]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "masterchain_seqno",
+			Name: "account_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetBlockchainMasterchainBlocksParams is parameters of getBlockchainMasterchainBlocks operation.
-type GetBlockchainMasterchainBlocksParams struct {
-	// Masterchain block seqno.
-	MasterchainSeqno int32
-}
-
-func unpackGetBlockchainMasterchainBlocksParams(packed middleware.Parameters) (params GetBlockchainMasterchainBlocksParams) {
+	// Set default value for query: limit.
 	{
-		key := middleware.ParameterKey{
-			Name: "masterchain_seqno",
-			In:   "path",
-		}
-		params.MasterchainSeqno = packed[key].(int32)
+		val := int(1000)
+		params.Limit.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetBlockchainMasterchainBlocksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainMasterchainBlocksParams, _ error) {
-	// Decode path: masterchain_seqno.
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "masterchain_seqno",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.MasterchainSeqno = c
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "masterchain_seqno",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetBlockchainMasterchainShardsParams is parameters of getBlockchainMasterchainShards operation.
-type GetBlockchainMasterchainShardsParams struct {
-	// Masterchain block seqno.
-	MasterchainSeqno int32
-}
-
-func unpackGetBlockchainMasterchainShardsParams(packed middleware.Parameters) (params GetBlockchainMasterchainShardsParams) {
+	// Set default value for query: offset.
 	{
-		key := middleware.ParameterKey{
-			Name: "masterchain_seqno",
-			In:   "path",
-		}
-		params.MasterchainSeqno = packed[key].(int32)
+		val := int(0)
+		params.Offset.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetBlockchainMasterchainShardsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainMasterchainShardsParams, _ error) {
-	// Decode path: masterchain_seqno.
+	// Decode query: offset.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "masterchain_seqno",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.MasterchainSeqno = c
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "masterchain_seqno",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetBlockchainMasterchainTransactionsParams is parameters of getBlockchainMasterchainTransactions operation.
-type GetBlockchainMasterchainTransactionsParams struct {
-	// Masterchain block seqno.
-	MasterchainSeqno int32
+// GetJettonInfoParams is parameters of getJettonInfo operation.
+type GetJettonInfoParams struct {
+	// Account ID.
+	AccountID string
 }
 
-func unpackGetBlockchainMasterchainTransactionsParams(packed middleware.Parameters) (params GetBlockchainMasterchainTransactionsParams) {
+func unpackGetJettonInfoParams(packed middleware.Parameters) (params GetJettonInfoParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "masterchain_seqno",
+			Name: "account_id",
 			In:   "path",
 		}
-		params.MasterchainSeqno = packed[key].(int32)
+		params.AccountID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetBlockchainMasterchainTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainMasterchainTransactionsParams, _ error) {
-	// Decode path: masterchain_seqno.
+func decodeGetJettonInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetJettonInfoParams, _ error) {
+	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5990,7 +9972,7 @@ func decodeGetBlockchainMasterchainTransactionsParams(args [1]string, argsEscape
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "masterchain_seqno",
+				Param:   "account_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -6002,12 +9984,12 @@ func decodeGetBlockchainMasterchainTransactionsParams(args [1]string, argsEscape
 					return err
 				}
 
-				c, err := conv.ToInt32(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.MasterchainSeqno = c
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -6018,7 +10000,7 @@ func decodeGetBlockchainMasterchainTransactionsParams(args [1]string, argsEscape
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "masterchain_seqno",
+			Name: "account_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -6026,13 +10008,15 @@ func decodeGetBlockchainMasterchainTransactionsParams(args [1]string, argsEscape
 	return params, nil
 }
 
-// GetBlockchainRawAccountParams is parameters of getBlockchainRawAccount operation.
-type GetBlockchainRawAccountParams struct {
+// GetJettonTransferPayloadParams is parameters of getJettonTransferPayload operation.
+type GetJettonTransferPayloadParams struct {
 	// Account ID.
 	AccountID string
+	// Jetton ID.
+	JettonID string
 }
 
-func unpackGetBlockchainRawAccountParams(packed middleware.Parameters) (params GetBlockchainRawAccountParams) {
+func unpackGetJettonTransferPayloadParams(packed middleware.Parameters) (params GetJettonTransferPayloadParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -6040,15 +10024,22 @@ func unpackGetBlockchainRawAccountParams(packed middleware.Parameters) (params G
 		}
 		params.AccountID = packed[key].(string)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "jetton_id",
+			In:   "path",
+		}
+		params.JettonID = packed[key].(string)
+	}
 	return params
 }
 
-func decodeGetBlockchainRawAccountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainRawAccountParams, _ error) {
+func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *http.Request) (params GetJettonTransferPayloadParams, _ error) {
 	// Decode path: account_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -6089,28 +10080,7 @@ func decodeGetBlockchainRawAccountParams(args [1]string, argsEscaped bool, r *ht
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetBlockchainTransactionParams is parameters of getBlockchainTransaction operation.
-type GetBlockchainTransactionParams struct {
-	// Transaction ID.
-	TransactionID string
-}
-
-func unpackGetBlockchainTransactionParams(packed middleware.Parameters) (params GetBlockchainTransactionParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "transaction_id",
-			In:   "path",
-		}
-		params.TransactionID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetBlockchainTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainTransactionParams, _ error) {
-	// Decode path: transaction_id.
+	// Decode path: jetton_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -6122,7 +10092,7 @@ func decodeGetBlockchainTransactionParams(args [1]string, argsEscaped bool, r *h
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "transaction_id",
+				Param:   "jetton_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -6139,7 +10109,7 @@ func decodeGetBlockchainTransactionParams(args [1]string, argsEscaped bool, r *h
 					return err
 				}
 
-				params.TransactionID = c
+				params.JettonID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -6150,7 +10120,7 @@ func decodeGetBlockchainTransactionParams(args [1]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "transaction_id",
+			Name: "jetton_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -6158,25 +10128,209 @@ func decodeGetBlockchainTransactionParams(args [1]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// GetBlockchainTransactionByMessageHashParams is parameters of getBlockchainTransactionByMessageHash operation.
-type GetBlockchainTransactionByMessageHashParams struct {
-	// Message ID.
-	MsgID string
+// GetJettonsParams is parameters of getJettons operation.
+type GetJettonsParams struct {
+	Limit  OptInt32
+	Offset OptInt32
 }
 
-func unpackGetBlockchainTransactionByMessageHashParams(packed middleware.Parameters) (params GetBlockchainTransactionByMessageHashParams) {
+func unpackGetJettonsParams(packed middleware.Parameters) (params GetJettonsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "msg_id",
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeGetJettonsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetJettonsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int32(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int32(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetJettonsEventsParams is parameters of getJettonsEvents operation.
+type GetJettonsEventsParams struct {
+	// Event ID or transaction hash in hex (without 0x) or base64url format.
+	EventID        string
+	AcceptLanguage OptString
+}
+
+func unpackGetJettonsEventsParams(packed middleware.Parameters) (params GetJettonsEventsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "event_id",
 			In:   "path",
 		}
-		params.MsgID = packed[key].(string)
+		params.EventID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
 	}
 	return params
 }
 
-func decodeGetBlockchainTransactionByMessageHashParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBlockchainTransactionByMessageHashParams, _ error) {
-	// Decode path: msg_id.
+func decodeGetJettonsEventsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetJettonsEventsParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: event_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -6188,7 +10342,7 @@ func decodeGetBlockchainTransactionByMessageHashParams(args [1]string, argsEscap
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "msg_id",
+				Param:   "event_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -6205,7 +10359,7 @@ func decodeGetBlockchainTransactionByMessageHashParams(args [1]string, argsEscap
 					return err
 				}
 
-				params.MsgID = c
+				params.EventID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -6216,120 +10370,142 @@ func decodeGetBlockchainTransactionByMessageHashParams(args [1]string, argsEscap
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "msg_id",
+			Name: "event_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetChartRatesParams is parameters of getChartRates operation.
-type GetChartRatesParams struct {
-	// Accept jetton master address.
-	Token       string
-	Currency    OptString
-	StartDate   OptInt64
-	EndDate     OptInt64
-	PointsCount OptInt
-}
-
-func unpackGetChartRatesParams(packed middleware.Parameters) (params GetChartRatesParams) {
+	// Set default value for header: Accept-Language.
 	{
-		key := middleware.ParameterKey{
-			Name: "token",
-			In:   "query",
-		}
-		params.Token = packed[key].(string)
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "currency",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Currency = v.(OptString)
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start_date",
-			In:   "query",
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptInt64)
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetMasterchainEtaParams is parameters of getMasterchainEta operation.
+type GetMasterchainEtaParams struct {
+	// Seqno of a future masterchain block.
+	Seqno OptInt32
+	// A validation deadline, either seqno or event is required.
+	Event OptGetMasterchainEtaEvent
+}
+
+func unpackGetMasterchainEtaParams(packed middleware.Parameters) (params GetMasterchainEtaParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "end_date",
+			Name: "seqno",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptInt64)
+			params.Seqno = v.(OptInt32)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "points_count",
+			Name: "event",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.PointsCount = v.(OptInt)
+			params.Event = v.(OptGetMasterchainEtaEvent)
 		}
 	}
 	return params
 }
 
-func decodeGetChartRatesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetChartRatesParams, _ error) {
+func decodeGetMasterchainEtaParams(args [0]string, argsEscaped bool, r *http.Request) (params GetMasterchainEtaParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: token.
+	// Decode query: seqno.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "token",
+			Name:    "seqno",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotSeqnoVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSeqnoVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Token = c
+				params.Seqno.SetTo(paramsDotSeqnoVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "token",
+			Name: "seqno",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: currency.
+	// Decode query: event.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "currency",
+			Name:    "event",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCurrencyVal string
+				var paramsDotEventVal GetMasterchainEtaEvent
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -6341,152 +10517,320 @@ func decodeGetChartRatesParams(args [0]string, argsEscaped bool, r *http.Request
 						return err
 					}
 
-					paramsDotCurrencyVal = c
+					paramsDotEventVal = GetMasterchainEtaEvent(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Currency.SetTo(paramsDotCurrencyVal)
+				params.Event.SetTo(paramsDotEventVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Event.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "currency",
+			Name: "event",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: start_date.
+	return params, nil
+}
+
+// GetMultisigAccountParams is parameters of getMultisigAccount operation.
+type GetMultisigAccountParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetMultisigAccountParams(packed middleware.Parameters) (params GetMultisigAccountParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetMultisigAccountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMultisigAccountParams, _ error) {
+	// Decode path: account_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_date",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.AccountID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "account_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetNftCollectionParams is parameters of getNftCollection operation.
+type GetNftCollectionParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetNftCollectionParams(packed middleware.Parameters) (params GetNftCollectionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetNftCollectionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetNftCollectionParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotStartDateVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
+
+				params.AccountID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start_date",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: end_date.
+	return params, nil
+}
+
+// GetNftCollectionsParams is parameters of getNftCollections operation.
+type GetNftCollectionsParams struct {
+	Limit  OptInt32
+	Offset OptInt32
+}
+
+func unpackGetNftCollectionsParams(packed middleware.Parameters) (params GetNftCollectionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeGetNftCollectionsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetNftCollectionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int32(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_date",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal int64
+				var paramsDotLimitVal int32
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt64(val)
+					c, err := conv.ToInt32(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEndDateVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end_date",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: points_count.
+	// Set default value for query: offset.
 	{
-		val := int(200)
-		params.PointsCount.SetTo(val)
+		val := int32(0)
+		params.Offset.SetTo(val)
 	}
-	// Decode query: points_count.
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "points_count",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPointsCountVal int
+				var paramsDotOffsetVal int32
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToInt32(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPointsCountVal = c
+					paramsDotOffsetVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PointsCount.SetTo(paramsDotPointsCountVal)
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.PointsCount.Get(); ok {
+				if value, ok := params.Offset.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
 							MinSet:        true,
 							Min:           0,
-							MaxSet:        true,
-							Max:           200,
+							MaxSet:        false,
+							Max:           0,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -6507,7 +10851,7 @@ func decodeGetChartRatesParams(args [0]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "points_count",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
@@ -6515,168 +10859,76 @@ func decodeGetChartRatesParams(args [0]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetDnsInfoParams is parameters of getDnsInfo operation.
-type GetDnsInfoParams struct {
-	// Domain name with .ton or .t.me.
-	DomainName string
+// GetNftHistoryByIDParams is parameters of getNftHistoryByID operation.
+type GetNftHistoryByIDParams struct {
+	// Account ID.
+	AccountID      string
+	AcceptLanguage OptString
+	// Omit this parameter to get last events.
+	BeforeLt  OptInt64
+	Limit     int
+	StartDate OptInt64
+	EndDate   OptInt64
 }
 
-func unpackGetDnsInfoParams(packed middleware.Parameters) (params GetDnsInfoParams) {
+func unpackGetNftHistoryByIDParams(packed middleware.Parameters) (params GetNftHistoryByIDParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "domain_name",
+			Name: "account_id",
 			In:   "path",
 		}
-		params.DomainName = packed[key].(string)
+		params.AccountID = packed[key].(string)
 	}
-	return params
-}
-
-func decodeGetDnsInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDnsInfoParams, _ error) {
-	// Decode path: domain_name.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "domain_name",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.DomainName = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "domain_name",
-			In:   "path",
-			Err:  err,
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
 		}
 	}
-	return params, nil
-}
-
-// GetDomainBidsParams is parameters of getDomainBids operation.
-type GetDomainBidsParams struct {
-	// Domain name with .ton or .t.me.
-	DomainName string
-}
-
-func unpackGetDomainBidsParams(packed middleware.Parameters) (params GetDomainBidsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "domain_name",
-			In:   "path",
-		}
-		params.DomainName = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetDomainBidsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDomainBidsParams, _ error) {
-	// Decode path: domain_name.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+			Name: "before_lt",
+			In:   "query",
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "domain_name",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.DomainName = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
+		if v, ok := packed[key]; ok {
+			params.BeforeLt = v.(OptInt64)
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "domain_name",
-			In:   "path",
-			Err:  err,
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
 		}
+		params.Limit = packed[key].(int)
 	}
-	return params, nil
-}
-
-// GetEventParams is parameters of getEvent operation.
-type GetEventParams struct {
-	// Event ID or transaction hash in hex (without 0x) or base64url format.
-	EventID        string
-	AcceptLanguage OptString
-}
-
-func unpackGetEventParams(packed middleware.Parameters) (params GetEventParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "event_id",
-			In:   "path",
+			Name: "start_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDate = v.(OptInt64)
 		}
-		params.EventID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "end_date",
+			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
+			params.EndDate = v.(OptInt64)
 		}
 	}
 	return params
 }
 
-func decodeGetEventParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEventParams, _ error) {
+func decodeGetNftHistoryByIDParams(args [1]string, argsEscaped bool, r *http.Request) (params GetNftHistoryByIDParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	h := uri.NewHeaderDecoder(r.Header)
-	// Decode path: event_id.
+	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -6688,7 +10940,7 @@ func decodeGetEventParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "event_id",
+				Param:   "account_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -6705,7 +10957,7 @@ func decodeGetEventParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.EventID = c
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -6716,7 +10968,7 @@ func decodeGetEventParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "event_id",
+			Name: "account_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -6765,89 +11017,222 @@ func decodeGetEventParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// GetInscriptionOpTemplateParams is parameters of getInscriptionOpTemplate operation.
-type GetInscriptionOpTemplateParams struct {
-	Type        GetInscriptionOpTemplateType
-	Destination OptString
-	Comment     OptString
-	Operation   GetInscriptionOpTemplateOperation
-	Amount      string
-	Ticker      string
-	Who         string
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackGetInscriptionOpTemplateParams(packed middleware.Parameters) (params GetInscriptionOpTemplateParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "type",
-			In:   "query",
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-		params.Type = packed[key].(GetInscriptionOpTemplateType)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "destination",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "before_lt",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Destination = v.(OptString)
+			Err:  err,
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "comment",
-			In:   "query",
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if v, ok := packed[key]; ok {
-			params.Comment = v.(OptString)
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Limit = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           1000,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Limit)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "operation",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
 			In:   "query",
+			Err:  err,
 		}
-		params.Operation = packed[key].(GetInscriptionOpTemplateOperation)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "amount",
+	// Decode query: start_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartDate.SetTo(paramsDotStartDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start_date",
 			In:   "query",
+			Err:  err,
 		}
-		params.Amount = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "ticker",
+	// Decode query: end_date.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndDateVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndDate.SetTo(paramsDotEndDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end_date",
 			In:   "query",
+			Err:  err,
 		}
-		params.Ticker = packed[key].(string)
 	}
+	return params, nil
+}
+
+// GetNftItemBidsParams is parameters of getNftItemBids operation.
+type GetNftItemBidsParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetNftItemBidsParams(packed middleware.Parameters) (params GetNftItemBidsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "who",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 		}
-		params.Who = packed[key].(string)
+		params.AccountID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetInscriptionOpTemplateParams(args [0]string, argsEscaped bool, r *http.Request) (params GetInscriptionOpTemplateParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: type.
+func decodeGetNftItemBidsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetNftItemBidsParams, _ error) {
+	// Decode path: account_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "type",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -6858,15 +11243,7 @@ func decodeGetInscriptionOpTemplateParams(args [0]string, argsEscaped bool, r *h
 					return err
 				}
 
-				params.Type = GetInscriptionOpTemplateType(c)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := params.Type.Validate(); err != nil {
-					return err
-				}
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -6877,63 +11254,115 @@ func decodeGetInscriptionOpTemplateParams(args [0]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "type",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: destination.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "destination",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// GetNftItemByAddressParams is parameters of getNftItemByAddress operation.
+type GetNftItemByAddressParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetNftItemByAddressParams(packed middleware.Parameters) (params GetNftItemByAddressParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
 		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDestinationVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeGetNftItemByAddressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetNftItemByAddressParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotDestinationVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Destination.SetTo(paramsDotDestinationVal)
+
+				params.AccountID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "destination",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: comment.
+	return params, nil
+}
+
+// GetNftItemsByAddressesParams is parameters of getNftItemsByAddresses operation.
+type GetNftItemsByAddressesParams struct {
+	// "allow" makes the endpoint respond with successfully retrieved items when some of them fail or
+	// time out.
+	// Such a response is marked with "partial" and lists errors of the missing items.
+	// Without the parameter a single failed item fails the whole request.
+	Partial OptGetNftItemsByAddressesPartial
+}
+
+func unpackGetNftItemsByAddressesParams(packed middleware.Parameters) (params GetNftItemsByAddressesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "partial",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Partial = v.(OptGetNftItemsByAddressesPartial)
+		}
+	}
+	return params
+}
+
+func decodeGetNftItemsByAddressesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetNftItemsByAddressesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: partial.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "comment",
+			Name:    "partial",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCommentVal string
+				var paramsDotPartialVal GetNftItemsByAddressesPartial
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -6945,35 +11374,80 @@ func decodeGetInscriptionOpTemplateParams(args [0]string, argsEscaped bool, r *h
 						return err
 					}
 
-					paramsDotCommentVal = c
+					paramsDotPartialVal = GetNftItemsByAddressesPartial(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Comment.SetTo(paramsDotCommentVal)
+				params.Partial.SetTo(paramsDotPartialVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Partial.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "comment",
+			Name: "partial",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: operation.
+	return params, nil
+}
+
+// GetPrivateLabelParams is parameters of getPrivateLabel operation.
+type GetPrivateLabelParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetPrivateLabelParams(packed middleware.Parameters) (params GetPrivateLabelParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetPrivateLabelParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPrivateLabelParams, _ error) {
+	// Decode path: account_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "operation",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -6984,15 +11458,7 @@ func decodeGetInscriptionOpTemplateParams(args [0]string, argsEscaped bool, r *h
 					return err
 				}
 
-				params.Operation = GetInscriptionOpTemplateOperation(c)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := params.Operation.Validate(); err != nil {
-					return err
-				}
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7003,115 +11469,160 @@ func decodeGetInscriptionOpTemplateParams(args [0]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "operation",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: amount.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "amount",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+	return params, nil
+}
 
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
+// GetRatesParams is parameters of getRates operation.
+type GetRatesParams struct {
+	// Accept ton and jetton master addresses, separated by commas.
+	Tokens []string
+	// Accept ton and all possible fiat currencies, separated by commas.
+	Currencies []string
+}
 
-				params.Amount = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
+func unpackGetRatesParams(packed middleware.Parameters) (params GetRatesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "tokens",
+			In:   "query",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "amount",
+		params.Tokens = packed[key].([]string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "currencies",
 			In:   "query",
-			Err:  err,
 		}
+		params.Currencies = packed[key].([]string)
 	}
-	// Decode query: ticker.
+	return params
+}
+
+func decodeGetRatesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRatesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: tokens.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "ticker",
+			Name:    "tokens",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotTokensVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
 
-				params.Ticker = c
-				return nil
+						paramsDotTokensVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Tokens = append(params.Tokens, paramsDotTokensVal)
+					return nil
+				})
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if params.Tokens == nil {
+					return errors.New("nil is invalid value")
+				}
+				if err := (validate.Array{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    100,
+					MaxLengthSet: true,
+				}).ValidateLength(len(params.Tokens)); err != nil {
+					return errors.Wrap(err, "array")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		} else {
 			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "ticker",
+			Name: "tokens",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: who.
+	// Decode query: currencies.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "who",
+			Name:    "currencies",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotCurrenciesVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
 
-				params.Who = c
-				return nil
+						paramsDotCurrenciesVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Currencies = append(params.Currencies, paramsDotCurrenciesVal)
+					return nil
+				})
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if params.Currencies == nil {
+					return errors.New("nil is invalid value")
+				}
+				if err := (validate.Array{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    50,
+					MaxLengthSet: true,
+				}).ValidateLength(len(params.Currencies)); err != nil {
+					return errors.Wrap(err, "array")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		} else {
 			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "who",
+			Name: "currencies",
 			In:   "query",
 			Err:  err,
 		}
@@ -7119,15 +11630,15 @@ func decodeGetInscriptionOpTemplateParams(args [0]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// GetItemsFromCollectionParams is parameters of getItemsFromCollection operation.
-type GetItemsFromCollectionParams struct {
+// GetRawAccountStateParams is parameters of getRawAccountState operation.
+type GetRawAccountStateParams struct {
 	// Account ID.
 	AccountID string
-	Limit     OptInt
-	Offset    OptInt
+	// Target block: (workchain,shard,seqno,root_hash,file_hash).
+	TargetBlock OptString
 }
 
-func unpackGetItemsFromCollectionParams(packed middleware.Parameters) (params GetItemsFromCollectionParams) {
+func unpackGetRawAccountStateParams(packed middleware.Parameters) (params GetRawAccountStateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -7137,26 +11648,17 @@ func unpackGetItemsFromCollectionParams(packed middleware.Parameters) (params Ge
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "target_block",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.TargetBlock = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetItemsFromCollectionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetItemsFromCollectionParams, _ error) {
+func decodeGetRawAccountStateParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawAccountStateParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: account_id.
 	if err := func() error {
@@ -7203,142 +11705,43 @@ func decodeGetItemsFromCollectionParams(args [1]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(1000)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Set default value for query: offset.
-	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode query: target_block.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "target_block",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTargetBlockVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
+
+					paramsDotTargetBlockVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.TargetBlock.SetTo(paramsDotTargetBlockVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "target_block",
 			In:   "query",
 			Err:  err,
 		}
@@ -7346,64 +11749,55 @@ func decodeGetItemsFromCollectionParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// GetJettonHoldersParams is parameters of getJettonHolders operation.
-type GetJettonHoldersParams struct {
-	// Account ID.
-	AccountID string
-	Limit     OptInt
-	Offset    OptInt
+// GetRawBlockProofParams is parameters of getRawBlockProof operation.
+type GetRawBlockProofParams struct {
+	// Known block: (workchain,shard,seqno,root_hash,file_hash).
+	KnownBlock string
+	// Target block: (workchain,shard,seqno,root_hash,file_hash).
+	TargetBlock OptString
+	// Mode.
+	Mode int32
 }
 
-func unpackGetJettonHoldersParams(packed middleware.Parameters) (params GetJettonHoldersParams) {
+func unpackGetRawBlockProofParams(packed middleware.Parameters) (params GetRawBlockProofParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
+			Name: "known_block",
+			In:   "query",
 		}
-		params.AccountID = packed[key].(string)
+		params.KnownBlock = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "target_block",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.TargetBlock = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "mode",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
+		params.Mode = packed[key].(int32)
 	}
 	return params
 }
 
-func decodeGetJettonHoldersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetJettonHoldersParams, _ error) {
+func decodeGetRawBlockProofParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRawBlockProofParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: account_id.
+	// Decode query: known_block.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "known_block",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -7414,9 +11808,9 @@ func decodeGetJettonHoldersParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.AccountID = c
+				params.KnownBlock = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
@@ -7425,173 +11819,186 @@ func decodeGetJettonHoldersParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "known_block",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(1000)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: target_block.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "target_block",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotTargetBlockVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotTargetBlockVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.TargetBlock.SetTo(paramsDotTargetBlockVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "target_block",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
-	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode query: mode.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "mode",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt32(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.Mode = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mode",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRawBlockchainBlockParams is parameters of getRawBlockchainBlock operation.
+type GetRawBlockchainBlockParams struct {
+	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
+	BlockID string
+}
+
+func unpackGetRawBlockchainBlockParams(packed middleware.Parameters) (params GetRawBlockchainBlockParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "block_id",
+			In:   "path",
+		}
+		params.BlockID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetRawBlockchainBlockParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawBlockchainBlockParams, _ error) {
+	// Decode path: block_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "block_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "block_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetJettonInfoParams is parameters of getJettonInfo operation.
-type GetJettonInfoParams struct {
-	// Account ID.
-	AccountID string
+// GetRawBlockchainBlockHeaderParams is parameters of getRawBlockchainBlockHeader operation.
+type GetRawBlockchainBlockHeaderParams struct {
+	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
+	BlockID string
+	// Mode.
+	Mode int32
 }
 
-func unpackGetJettonInfoParams(packed middleware.Parameters) (params GetJettonInfoParams) {
+func unpackGetRawBlockchainBlockHeaderParams(packed middleware.Parameters) (params GetRawBlockchainBlockHeaderParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
+		params.BlockID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mode",
+			In:   "query",
+		}
+		params.Mode = packed[key].(int32)
 	}
 	return params
 }
 
-func decodeGetJettonInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetJettonInfoParams, _ error) {
-	// Decode path: account_id.
+func decodeGetRawBlockchainBlockHeaderParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawBlockchainBlockHeaderParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: block_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7603,7 +12010,7 @@ func decodeGetJettonInfoParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "block_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7620,7 +12027,7 @@ func decodeGetJettonInfoParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.AccountID = c
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7631,46 +12038,73 @@ func decodeGetJettonInfoParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
+	// Decode query: mode.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mode",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt32(val)
+				if err != nil {
+					return err
+				}
+
+				params.Mode = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mode",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// GetJettonTransferPayloadParams is parameters of getJettonTransferPayload operation.
-type GetJettonTransferPayloadParams struct {
-	// Account ID.
-	AccountID string
-	// Jetton ID.
-	JettonID string
+// GetRawBlockchainBlockStateParams is parameters of getRawBlockchainBlockState operation.
+type GetRawBlockchainBlockStateParams struct {
+	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
+	BlockID string
 }
 
-func unpackGetJettonTransferPayloadParams(packed middleware.Parameters) (params GetJettonTransferPayloadParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
-		}
-		params.AccountID = packed[key].(string)
-	}
+func unpackGetRawBlockchainBlockStateParams(packed middleware.Parameters) (params GetRawBlockchainBlockStateParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "jetton_id",
+			Name: "block_id",
 			In:   "path",
 		}
-		params.JettonID = packed[key].(string)
+		params.BlockID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *http.Request) (params GetJettonTransferPayloadParams, _ error) {
-	// Decode path: account_id.
+func decodeGetRawBlockchainBlockStateParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawBlockchainBlockStateParams, _ error) {
+	// Decode path: block_id.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -7678,7 +12112,7 @@ func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *h
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "block_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7695,7 +12129,7 @@ func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *h
 					return err
 				}
 
-				params.AccountID = c
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7706,12 +12140,33 @@ func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: jetton_id.
+	return params, nil
+}
+
+// GetRawBlockchainConfigFromBlockParams is parameters of getRawBlockchainConfigFromBlock operation.
+type GetRawBlockchainConfigFromBlockParams struct {
+	// Masterchain block seqno.
+	MasterchainSeqno int32
+}
+
+func unpackGetRawBlockchainConfigFromBlockParams(packed middleware.Parameters) (params GetRawBlockchainConfigFromBlockParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "masterchain_seqno",
+			In:   "path",
+		}
+		params.MasterchainSeqno = packed[key].(int32)
+	}
+	return params
+}
+
+func decodeGetRawBlockchainConfigFromBlockParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawBlockchainConfigFromBlockParams, _ error) {
+	// Decode path: masterchain_seqno.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7723,7 +12178,7 @@ func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *h
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jetton_id",
+				Param:   "masterchain_seqno",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7735,12 +12190,12 @@ func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *h
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt32(val)
 				if err != nil {
 					return err
 				}
 
-				params.JettonID = c
+				params.MasterchainSeqno = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7751,7 +12206,7 @@ func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "jetton_id",
+			Name: "masterchain_seqno",
 			In:   "path",
 			Err:  err,
 		}
@@ -7759,172 +12214,111 @@ func decodeGetJettonTransferPayloadParams(args [2]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// GetJettonsParams is parameters of getJettons operation.
-type GetJettonsParams struct {
-	Limit  OptInt32
-	Offset OptInt32
+// GetRawConfigParams is parameters of getRawConfig operation.
+type GetRawConfigParams struct {
+	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
+	BlockID string
+	// Mode.
+	Mode int32
 }
 
-func unpackGetJettonsParams(packed middleware.Parameters) (params GetJettonsParams) {
+func unpackGetRawConfigParams(packed middleware.Parameters) (params GetRawConfigParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
+			Name: "block_id",
+			In:   "path",
 		}
+		params.BlockID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "mode",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
-		}
+		params.Mode = packed[key].(int32)
 	}
 	return params
 }
 
-func decodeGetJettonsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetJettonsParams, _ error) {
+func decodeGetRawConfigParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawConfigParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int32(100)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode path: block_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "block_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "block_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
-	{
-		val := int32(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode query: mode.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "mode",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt32(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.Mode = c
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "mode",
 			In:   "query",
 			Err:  err,
 		}
@@ -7932,36 +12326,66 @@ func decodeGetJettonsParams(args [0]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// GetJettonsEventsParams is parameters of getJettonsEvents operation.
-type GetJettonsEventsParams struct {
-	// Event ID or transaction hash in hex (without 0x) or base64url format.
-	EventID        string
-	AcceptLanguage OptString
+// GetRawListBlockTransactionsParams is parameters of getRawListBlockTransactions operation.
+type GetRawListBlockTransactionsParams struct {
+	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
+	BlockID string
+	// Mode.
+	Mode int32
+	// Count.
+	Count int32
+	// Account ID.
+	AccountID OptString
+	// Lt.
+	Lt OptInt64
 }
 
-func unpackGetJettonsEventsParams(packed middleware.Parameters) (params GetJettonsEventsParams) {
+func unpackGetRawListBlockTransactionsParams(packed middleware.Parameters) (params GetRawListBlockTransactionsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "event_id",
+			Name: "block_id",
 			In:   "path",
 		}
-		params.EventID = packed[key].(string)
+		params.BlockID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "mode",
+			In:   "query",
+		}
+		params.Mode = packed[key].(int32)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "count",
+			In:   "query",
+		}
+		params.Count = packed[key].(int32)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
+			params.AccountID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "lt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Lt = v.(OptInt64)
 		}
 	}
 	return params
 }
 
-func decodeGetJettonsEventsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetJettonsEventsParams, _ error) {
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode path: event_id.
+func decodeGetRawListBlockTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawListBlockTransactionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: block_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7973,7 +12397,7 @@ func decodeGetJettonsEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "event_id",
+				Param:   "block_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7990,7 +12414,7 @@ func decodeGetJettonsEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.EventID = c
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8001,25 +12425,94 @@ func decodeGetJettonsEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "event_id",
+			Name: "block_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
+	// Decode query: mode.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mode",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt32(val)
+				if err != nil {
+					return err
+				}
+
+				params.Mode = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mode",
+			In:   "query",
+			Err:  err,
+		}
 	}
-	// Decode header: Accept-Language.
+	// Decode query: count.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt32(val)
+				if err != nil {
+					return err
+				}
+
+				params.Count = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "count",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: account_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "account_id",
+			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAccountIDVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -8031,12 +12524,12 @@ func decodeGetJettonsEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 						return err
 					}
 
-					paramsDotAcceptLanguageVal = c
+					paramsDotAccountIDVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				params.AccountID.SetTo(paramsDotAccountIDVal)
 				return nil
 			}); err != nil {
 				return err
@@ -8045,64 +12538,97 @@ func decodeGetJettonsEventsParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "account_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Lt.SetTo(paramsDotLtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "lt",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetMultisigAccountParams is parameters of getMultisigAccount operation.
-type GetMultisigAccountParams struct {
-	// Account ID.
-	AccountID string
+// GetRawMasterchainInfoExtParams is parameters of getRawMasterchainInfoExt operation.
+type GetRawMasterchainInfoExtParams struct {
+	// Mode.
+	Mode int32
 }
 
-func unpackGetMultisigAccountParams(packed middleware.Parameters) (params GetMultisigAccountParams) {
+func unpackGetRawMasterchainInfoExtParams(packed middleware.Parameters) (params GetRawMasterchainInfoExtParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
+			Name: "mode",
+			In:   "query",
 		}
-		params.AccountID = packed[key].(string)
+		params.Mode = packed[key].(int32)
 	}
 	return params
 }
 
-func decodeGetMultisigAccountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMultisigAccountParams, _ error) {
-	// Decode path: account_id.
+func decodeGetRawMasterchainInfoExtParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRawMasterchainInfoExtParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: mode.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mode",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt32(val)
 				if err != nil {
 					return err
 				}
 
-				params.AccountID = c
+				params.Mode = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
@@ -8111,33 +12637,33 @@ func decodeGetMultisigAccountParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "mode",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetNftCollectionParams is parameters of getNftCollection operation.
-type GetNftCollectionParams struct {
-	// Account ID.
-	AccountID string
+// GetRawShardBlockProofParams is parameters of getRawShardBlockProof operation.
+type GetRawShardBlockProofParams struct {
+	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
+	BlockID string
 }
 
-func unpackGetNftCollectionParams(packed middleware.Parameters) (params GetNftCollectionParams) {
+func unpackGetRawShardBlockProofParams(packed middleware.Parameters) (params GetRawShardBlockProofParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
+		params.BlockID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetNftCollectionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetNftCollectionParams, _ error) {
-	// Decode path: account_id.
+func decodeGetRawShardBlockProofParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawShardBlockProofParams, _ error) {
+	// Decode path: block_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8149,7 +12675,7 @@ func decodeGetNftCollectionParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "block_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8166,7 +12692,7 @@ func decodeGetNftCollectionParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.AccountID = c
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8177,7 +12703,7 @@ func decodeGetNftCollectionParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -8185,249 +12711,53 @@ func decodeGetNftCollectionParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetNftCollectionsParams is parameters of getNftCollections operation.
-type GetNftCollectionsParams struct {
-	Limit  OptInt32
-	Offset OptInt32
-}
-
-func unpackGetNftCollectionsParams(packed middleware.Parameters) (params GetNftCollectionsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
-		}
-	}
-	return params
-}
-
-func decodeGetNftCollectionsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetNftCollectionsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int32(100)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Set default value for query: offset.
-	{
-		val := int32(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetNftHistoryByIDParams is parameters of getNftHistoryByID operation.
-type GetNftHistoryByIDParams struct {
-	// Account ID.
-	AccountID      string
-	AcceptLanguage OptString
-	// Omit this parameter to get last events.
-	BeforeLt  OptInt64
-	Limit     int
-	StartDate OptInt64
-	EndDate   OptInt64
+// GetRawShardInfoParams is parameters of getRawShardInfo operation.
+type GetRawShardInfoParams struct {
+	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
+	BlockID string
+	// Workchain.
+	Workchain int32
+	// Shard.
+	Shard int64
+	// Exact.
+	Exact bool
 }
 
-func unpackGetNftHistoryByIDParams(packed middleware.Parameters) (params GetNftHistoryByIDParams) {
+func unpackGetRawShardInfoParams(packed middleware.Parameters) (params GetRawShardInfoParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "before_lt",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.BeforeLt = v.(OptInt64)
-		}
+		params.BlockID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "workchain",
 			In:   "query",
 		}
-		params.Limit = packed[key].(int)
+		params.Workchain = packed[key].(int32)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "start_date",
+			Name: "shard",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptInt64)
-		}
+		params.Shard = packed[key].(int64)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "end_date",
+			Name: "exact",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptInt64)
-		}
+		params.Exact = packed[key].(bool)
 	}
 	return params
 }
 
-func decodeGetNftHistoryByIDParams(args [1]string, argsEscaped bool, r *http.Request) (params GetNftHistoryByIDParams, _ error) {
+func decodeGetRawShardInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawShardInfoParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode path: account_id.
+	// Decode path: block_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8439,7 +12769,7 @@ func decodeGetNftHistoryByIDParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "block_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8456,7 +12786,7 @@ func decodeGetNftHistoryByIDParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.AccountID = c
+				params.BlockID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8467,100 +12797,87 @@ func decodeGetNftHistoryByIDParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "block_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
+	// Decode query: workchain.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "workchain",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotAcceptLanguageVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt32(val)
+				if err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+
+				params.Workchain = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "workchain",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: before_lt.
+	// Decode query: shard.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "before_lt",
+			Name:    "shard",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBeforeLtVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotBeforeLtVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt64(val)
+				if err != nil {
 					return err
 				}
-				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
+
+				params.Shard = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "before_lt",
+			Name: "shard",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
+	// Decode query: exact.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "exact",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
@@ -8572,29 +12889,106 @@ func decodeGetNftHistoryByIDParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToBool(val)
 				if err != nil {
 					return err
 				}
 
-				params.Limit = c
+				params.Exact = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "exact",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRawTransactionsParams is parameters of getRawTransactions operation.
+type GetRawTransactionsParams struct {
+	// Account ID.
+	AccountID string
+	// Count.
+	Count int32
+	// Lt.
+	Lt int64
+	// Hash.
+	Hash string
+}
+
+func unpackGetRawTransactionsParams(packed middleware.Parameters) (params GetRawTransactionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "count",
+			In:   "query",
+		}
+		params.Count = packed[key].(int32)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "lt",
+			In:   "query",
+		}
+		params.Lt = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "hash",
+			In:   "query",
+		}
+		params.Hash = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetRawTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawTransactionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           1,
-					MaxSet:        true,
-					Max:           1000,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Limit)); err != nil {
-					return errors.Wrap(err, "int")
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8605,133 +12999,93 @@ func decodeGetNftHistoryByIDParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: start_date.
+	// Decode query: count.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_date",
+			Name:    "count",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStartDateVal = c
-					return nil
-				}(); err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
+
+				c, err := conv.ToInt32(val)
+				if err != nil {
+					return err
+				}
+
+				params.Count = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start_date",
+			Name: "count",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: end_date.
+	// Decode query: lt.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_date",
+			Name:    "lt",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotEndDateVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt64(val)
+				if err != nil {
 					return err
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
+
+				params.Lt = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end_date",
+			Name: "lt",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetNftItemByAddressParams is parameters of getNftItemByAddress operation.
-type GetNftItemByAddressParams struct {
-	// Account ID.
-	AccountID string
-}
-
-func unpackGetNftItemByAddressParams(packed middleware.Parameters) (params GetNftItemByAddressParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
-		}
-		params.AccountID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetNftItemByAddressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetNftItemByAddressParams, _ error) {
-	// Decode path: account_id.
+	// Decode query: hash.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "hash",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -8742,9 +13096,9 @@ func decodeGetNftItemByAddressParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.AccountID = c
+				params.Hash = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
@@ -8753,90 +13107,63 @@ func decodeGetNftItemByAddressParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "hash",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetRatesParams is parameters of getRates operation.
-type GetRatesParams struct {
-	// Accept ton and jetton master addresses, separated by commas.
-	Tokens []string
-	// Accept ton and all possible fiat currencies, separated by commas.
-	Currencies []string
+// GetReducedBlockchainBlocksParams is parameters of getReducedBlockchainBlocks operation.
+type GetReducedBlockchainBlocksParams struct {
+	From int64
+	To   int64
 }
 
-func unpackGetRatesParams(packed middleware.Parameters) (params GetRatesParams) {
+func unpackGetReducedBlockchainBlocksParams(packed middleware.Parameters) (params GetReducedBlockchainBlocksParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "tokens",
+			Name: "from",
 			In:   "query",
 		}
-		params.Tokens = packed[key].([]string)
+		params.From = packed[key].(int64)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "currencies",
+			Name: "to",
 			In:   "query",
 		}
-		params.Currencies = packed[key].([]string)
+		params.To = packed[key].(int64)
 	}
 	return params
 }
 
-func decodeGetRatesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRatesParams, _ error) {
+func decodeGetReducedBlockchainBlocksParams(args [0]string, argsEscaped bool, r *http.Request) (params GetReducedBlockchainBlocksParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: tokens.
+	// Decode query: from.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "tokens",
+			Name:    "from",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotTokensVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						paramsDotTokensVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.Tokens = append(params.Tokens, paramsDotTokensVal)
-					return nil
-				})
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if params.Tokens == nil {
-					return errors.New("nil is invalid value")
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
-				if err := (validate.Array{
-					MinLength:    0,
-					MinLengthSet: false,
-					MaxLength:    100,
-					MaxLengthSet: true,
-				}).ValidateLength(len(params.Tokens)); err != nil {
-					return errors.Wrap(err, "array")
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
 				}
+
+				params.From = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
@@ -8845,57 +13172,98 @@ func decodeGetRatesParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "tokens",
+			Name: "from",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: currencies.
+	// Decode query: to.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "currencies",
+			Name:    "to",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotCurrenciesVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
 
-						paramsDotCurrenciesVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.Currencies = append(params.Currencies, paramsDotCurrenciesVal)
-					return nil
-				})
+				params.To = c
+				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetStakingPoolHistoryParams is parameters of getStakingPoolHistory operation.
+type GetStakingPoolHistoryParams struct {
+	// Account ID.
+	AccountID string
+}
+
+func unpackGetStakingPoolHistoryParams(packed middleware.Parameters) (params GetStakingPoolHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
+		}
+		params.AccountID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetStakingPoolHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStakingPoolHistoryParams, _ error) {
+	// Decode path: account_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if params.Currencies == nil {
-					return errors.New("nil is invalid value")
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
-				if err := (validate.Array{
-					MinLength:    0,
-					MinLengthSet: false,
-					MaxLength:    50,
-					MaxLengthSet: true,
-				}).ValidateLength(len(params.Currencies)); err != nil {
-					return errors.Wrap(err, "array")
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.AccountID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8906,23 +13274,22 @@ func decodeGetRatesParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "currencies",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetRawAccountStateParams is parameters of getRawAccountState operation.
-type GetRawAccountStateParams struct {
+// GetStakingPoolInfoParams is parameters of getStakingPoolInfo operation.
+type GetStakingPoolInfoParams struct {
 	// Account ID.
-	AccountID string
-	// Target block: (workchain,shard,seqno,root_hash,file_hash).
-	TargetBlock OptString
+	AccountID      string
+	AcceptLanguage OptString
 }
 
-func unpackGetRawAccountStateParams(packed middleware.Parameters) (params GetRawAccountStateParams) {
+func unpackGetStakingPoolInfoParams(packed middleware.Parameters) (params GetStakingPoolInfoParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -8932,18 +13299,18 @@ func unpackGetRawAccountStateParams(packed middleware.Parameters) (params GetRaw
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "target_block",
-			In:   "query",
+			Name: "Accept-Language",
+			In:   "header",
 		}
 		if v, ok := packed[key]; ok {
-			params.TargetBlock = v.(OptString)
+			params.AcceptLanguage = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetRawAccountStateParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawAccountStateParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeGetStakingPoolInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStakingPoolInfoParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -8989,17 +13356,20 @@ func decodeGetRawAccountStateParams(args [1]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
-	// Decode query: target_block.
+	// Set default value for header: Accept-Language.
+	{
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
+	}
+	// Decode header: Accept-Language.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "target_block",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTargetBlockVal string
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -9011,12 +13381,12 @@ func decodeGetRawAccountStateParams(args [1]string, argsEscaped bool, r *http.Re
 						return err
 					}
 
-					paramsDotTargetBlockVal = c
+					paramsDotAcceptLanguageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.TargetBlock.SetTo(paramsDotTargetBlockVal)
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
 				return nil
 			}); err != nil {
 				return err
@@ -9025,100 +13395,68 @@ func decodeGetRawAccountStateParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "target_block",
-			In:   "query",
+			Name: "Accept-Language",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetRawBlockProofParams is parameters of getRawBlockProof operation.
-type GetRawBlockProofParams struct {
-	// Known block: (workchain,shard,seqno,root_hash,file_hash).
-	KnownBlock string
-	// Target block: (workchain,shard,seqno,root_hash,file_hash).
-	TargetBlock OptString
-	// Mode.
-	Mode int32
+// GetStakingPoolsParams is parameters of getStakingPools operation.
+type GetStakingPoolsParams struct {
+	// Account ID.
+	AvailableFor OptString
+	// Return also pools not from white list - just compatible by interfaces (maybe dangerous!).
+	IncludeUnverified OptBool
+	AcceptLanguage    OptString
 }
 
-func unpackGetRawBlockProofParams(packed middleware.Parameters) (params GetRawBlockProofParams) {
+func unpackGetStakingPoolsParams(packed middleware.Parameters) (params GetStakingPoolsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "known_block",
+			Name: "available_for",
 			In:   "query",
 		}
-		params.KnownBlock = packed[key].(string)
+		if v, ok := packed[key]; ok {
+			params.AvailableFor = v.(OptString)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "target_block",
+			Name: "include_unverified",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.TargetBlock = v.(OptString)
+			params.IncludeUnverified = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "mode",
-			In:   "query",
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
 		}
-		params.Mode = packed[key].(int32)
 	}
 	return params
 }
 
-func decodeGetRawBlockProofParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRawBlockProofParams, _ error) {
+func decodeGetStakingPoolsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetStakingPoolsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: known_block.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "known_block",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.KnownBlock = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "known_block",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: target_block.
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode query: available_for.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "target_block",
+			Name:    "available_for",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTargetBlockVal string
+				var paramsDotAvailableForVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -9130,12 +13468,12 @@ func decodeGetRawBlockProofParams(args [0]string, argsEscaped bool, r *http.Requ
 						return err
 					}
 
-					paramsDotTargetBlockVal = c
+					paramsDotAvailableForVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.TargetBlock.SetTo(paramsDotTargetBlockVal)
+				params.AvailableFor.SetTo(paramsDotAvailableForVal)
 				return nil
 			}); err != nil {
 				return err
@@ -9144,465 +13482,557 @@ func decodeGetRawBlockProofParams(args [0]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "target_block",
+			Name: "available_for",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: mode.
+	// Decode query: include_unverified.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mode",
+			Name:    "include_unverified",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotIncludeUnverifiedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeUnverifiedVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Mode = c
+				params.IncludeUnverified.SetTo(paramsDotIncludeUnverifiedVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mode",
+			Name: "include_unverified",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetRawBlockchainBlockParams is parameters of getRawBlockchainBlock operation.
-type GetRawBlockchainBlockParams struct {
-	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
-	BlockID string
-}
-
-func unpackGetRawBlockchainBlockParams(packed middleware.Parameters) (params GetRawBlockchainBlockParams) {
+	// Set default value for header: Accept-Language.
 	{
-		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
-		}
-		params.BlockID = packed[key].(string)
+		val := string("en")
+		params.AcceptLanguage.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetRawBlockchainBlockParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawBlockchainBlockParams, _ error) {
-	// Decode path: block_id.
+	// Decode header: Accept-Language.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.BlockID = c
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
-			In:   "path",
+			Name: "Accept-Language",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetRawBlockchainBlockHeaderParams is parameters of getRawBlockchainBlockHeader operation.
-type GetRawBlockchainBlockHeaderParams struct {
-	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
-	BlockID string
-	// Mode.
-	Mode int32
+// GetTopAccountsParams is parameters of getTopAccounts operation.
+type GetTopAccountsParams struct {
+	Order OptGetTopAccountsOrder
+	// Exclude accounts with a label in the address book.
+	ExcludeLabeled OptBool
+	Limit          OptInt32
+	Offset         OptInt32
 }
 
-func unpackGetRawBlockchainBlockHeaderParams(packed middleware.Parameters) (params GetRawBlockchainBlockHeaderParams) {
+func unpackGetTopAccountsParams(packed middleware.Parameters) (params GetTopAccountsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
+			Name: "order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Order = v.(OptGetTopAccountsOrder)
 		}
-		params.BlockID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "mode",
+			Name: "exclude_labeled",
 			In:   "query",
 		}
-		params.Mode = packed[key].(int32)
+		if v, ok := packed[key]; ok {
+			params.ExcludeLabeled = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
 	}
 	return params
 }
 
-func decodeGetRawBlockchainBlockHeaderParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawBlockchainBlockHeaderParams, _ error) {
+func decodeGetTopAccountsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTopAccountsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: block_id.
+	// Set default value for query: order.
+	{
+		val := GetTopAccountsOrder("balance")
+		params.Order.SetTo(val)
+	}
+	// Decode query: order.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderVal GetTopAccountsOrder
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderVal = GetTopAccountsOrder(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.BlockID = c
+				params.Order.SetTo(paramsDotOrderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Order.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
-			In:   "path",
+			Name: "order",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: mode.
+	// Set default value for query: exclude_labeled.
+	{
+		val := bool(false)
+		params.ExcludeLabeled.SetTo(val)
+	}
+	// Decode query: exclude_labeled.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mode",
+			Name:    "exclude_labeled",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotExcludeLabeledVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotExcludeLabeledVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Mode = c
+				params.ExcludeLabeled.SetTo(paramsDotExcludeLabeledVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mode",
+			Name: "exclude_labeled",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetRawBlockchainBlockStateParams is parameters of getRawBlockchainBlockState operation.
-type GetRawBlockchainBlockStateParams struct {
-	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
-	BlockID string
-}
-
-func unpackGetRawBlockchainBlockStateParams(packed middleware.Parameters) (params GetRawBlockchainBlockStateParams) {
+	// Set default value for query: limit.
 	{
-		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
-		}
-		params.BlockID = packed[key].(string)
+		val := int32(100)
+		params.Limit.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetRawBlockchainBlockStateParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawBlockchainBlockStateParams, _ error) {
-	// Decode path: block_id.
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.BlockID = c
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetRawBlockchainConfigFromBlockParams is parameters of getRawBlockchainConfigFromBlock operation.
-type GetRawBlockchainConfigFromBlockParams struct {
-	// Masterchain block seqno.
-	MasterchainSeqno int32
-}
-
-func unpackGetRawBlockchainConfigFromBlockParams(packed middleware.Parameters) (params GetRawBlockchainConfigFromBlockParams) {
+	// Set default value for query: offset.
 	{
-		key := middleware.ParameterKey{
-			Name: "masterchain_seqno",
-			In:   "path",
-		}
-		params.MasterchainSeqno = packed[key].(int32)
+		val := int32(0)
+		params.Offset.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetRawBlockchainConfigFromBlockParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawBlockchainConfigFromBlockParams, _ error) {
-	// Decode path: masterchain_seqno.
+	// Decode query: offset.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "masterchain_seqno",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.MasterchainSeqno = c
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "masterchain_seqno",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetRawConfigParams is parameters of getRawConfig operation.
-type GetRawConfigParams struct {
-	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
-	BlockID string
-	// Mode.
-	Mode int32
+// GetTopJettonsByVolumeParams is parameters of getTopJettonsByVolume operation.
+type GetTopJettonsByVolumeParams struct {
+	Period OptGetTopJettonsByVolumePeriod
+	Limit  OptInt32
 }
 
-func unpackGetRawConfigParams(packed middleware.Parameters) (params GetRawConfigParams) {
+func unpackGetTopJettonsByVolumeParams(packed middleware.Parameters) (params GetTopJettonsByVolumeParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
+			Name: "period",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Period = v.(OptGetTopJettonsByVolumePeriod)
 		}
-		params.BlockID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "mode",
+			Name: "limit",
 			In:   "query",
 		}
-		params.Mode = packed[key].(int32)
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
 	}
 	return params
 }
 
-func decodeGetRawConfigParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawConfigParams, _ error) {
+func decodeGetTopJettonsByVolumeParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTopJettonsByVolumeParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: block_id.
+	// Set default value for query: period.
+	{
+		val := GetTopJettonsByVolumePeriod("24h")
+		params.Period.SetTo(val)
+	}
+	// Decode query: period.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPeriodVal GetTopJettonsByVolumePeriod
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPeriodVal = GetTopJettonsByVolumePeriod(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.BlockID = c
+				params.Period.SetTo(paramsDotPeriodVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Period.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
-			In:   "path",
+			Name: "period",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: mode.
+	// Set default value for query: limit.
+	{
+		val := int32(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mode",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Mode = c
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mode",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
@@ -9610,66 +14040,61 @@ func decodeGetRawConfigParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetRawListBlockTransactionsParams is parameters of getRawListBlockTransactions operation.
-type GetRawListBlockTransactionsParams struct {
-	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
-	BlockID string
-	// Mode.
-	Mode int32
-	// Count.
-	Count int32
-	// Account ID.
-	AccountID OptString
-	// Lt.
-	Lt OptInt64
+// GetTraceParams is parameters of getTrace operation.
+type GetTraceParams struct {
+	// Trace ID or transaction hash in hex (without 0x) or base64url format.
+	TraceID string
+	// Json returns the trace tree, other formats render the tree of messages as text: dot is a Graphviz
+	// digraph, mermaid is a Mermaid flowchart, flat is a tab-separated list of edges with values.
+	Format OptGetTraceFormat
+	// Attach a gas profile to every node of the trace, only supported by the json format.
+	GasProfile OptBool
+	// Emulate messages of an in-progress trace which haven't been delivered yet and attach the predicted
+	// nodes marked as emulated.
+	EmulatePending OptBool
 }
 
-func unpackGetRawListBlockTransactionsParams(packed middleware.Parameters) (params GetRawListBlockTransactionsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
-		}
-		params.BlockID = packed[key].(string)
-	}
+func unpackGetTraceParams(packed middleware.Parameters) (params GetTraceParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "mode",
-			In:   "query",
+			Name: "trace_id",
+			In:   "path",
 		}
-		params.Mode = packed[key].(int32)
+		params.TraceID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "count",
+			Name: "format",
 			In:   "query",
 		}
-		params.Count = packed[key].(int32)
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptGetTraceFormat)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "gas_profile",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.AccountID = v.(OptString)
+			params.GasProfile = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "lt",
+			Name: "emulate_pending",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Lt = v.(OptInt64)
+			params.EmulatePending = v.(OptBool)
 		}
 	}
 	return params
 }
 
-func decodeGetRawListBlockTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawListBlockTransactionsParams, _ error) {
+func decodeGetTraceParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTraceParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: block_id.
+	// Decode path: trace_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -9681,7 +14106,7 @@ func decodeGetRawListBlockTransactionsParams(args [1]string, argsEscaped bool, r
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
+				Param:   "trace_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -9698,7 +14123,7 @@ func decodeGetRawListBlockTransactionsParams(args [1]string, argsEscaped bool, r
 					return err
 				}
 
-				params.BlockID = c
+				params.TraceID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -9709,94 +14134,27 @@ func decodeGetRawListBlockTransactionsParams(args [1]string, argsEscaped bool, r
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
+			Name: "trace_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: mode.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mode",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToInt32(val)
-				if err != nil {
-					return err
-				}
-
-				params.Mode = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "mode",
-			In:   "query",
-			Err:  err,
-		}
+	// Set default value for query: format.
+	{
+		val := GetTraceFormat("json")
+		params.Format.SetTo(val)
 	}
-	// Decode query: count.
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "count",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToInt32(val)
-				if err != nil {
-					return err
-				}
-
-				params.Count = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "count",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: account_id.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "account_id",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAccountIDVal string
+				var paramsDotFormatVal GetTraceFormat
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -9808,53 +14166,73 @@ func decodeGetRawListBlockTransactionsParams(args [1]string, argsEscaped bool, r
 						return err
 					}
 
-					paramsDotAccountIDVal = c
+					paramsDotFormatVal = GetTraceFormat(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AccountID.SetTo(paramsDotAccountIDVal)
+				params.Format.SetTo(paramsDotFormatVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "format",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: lt.
+	// Set default value for query: gas_profile.
+	{
+		val := bool(false)
+		params.GasProfile.SetTo(val)
+	}
+	// Decode query: gas_profile.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "lt",
+			Name:    "gas_profile",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLtVal int64
+				var paramsDotGasProfileVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt64(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLtVal = c
+					paramsDotGasProfileVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Lt.SetTo(paramsDotLtVal)
+				params.GasProfile.SetTo(paramsDotGasProfileVal)
 				return nil
 			}); err != nil {
 				return err
@@ -9863,65 +14241,53 @@ func decodeGetRawListBlockTransactionsParams(args [1]string, argsEscaped bool, r
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "lt",
+			Name: "gas_profile",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetRawMasterchainInfoExtParams is parameters of getRawMasterchainInfoExt operation.
-type GetRawMasterchainInfoExtParams struct {
-	// Mode.
-	Mode int32
-}
-
-func unpackGetRawMasterchainInfoExtParams(packed middleware.Parameters) (params GetRawMasterchainInfoExtParams) {
+	// Set default value for query: emulate_pending.
 	{
-		key := middleware.ParameterKey{
-			Name: "mode",
-			In:   "query",
-		}
-		params.Mode = packed[key].(int32)
+		val := bool(false)
+		params.EmulatePending.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetRawMasterchainInfoExtParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRawMasterchainInfoExtParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: mode.
+	// Decode query: emulate_pending.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mode",
+			Name:    "emulate_pending",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotEmulatePendingVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEmulatePendingVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Mode = c
+				params.EmulatePending.SetTo(paramsDotEmulatePendingVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mode",
+			Name: "emulate_pending",
 			In:   "query",
 			Err:  err,
 		}
@@ -9929,43 +14295,32 @@ func decodeGetRawMasterchainInfoExtParams(args [0]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// GetRawShardBlockProofParams is parameters of getRawShardBlockProof operation.
-type GetRawShardBlockProofParams struct {
-	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
-	BlockID string
+// GetWalletBackupParams is parameters of getWalletBackup operation.
+type GetWalletBackupParams struct {
+	XTonConnectAuth string
 }
 
-func unpackGetRawShardBlockProofParams(packed middleware.Parameters) (params GetRawShardBlockProofParams) {
+func unpackGetWalletBackupParams(packed middleware.Parameters) (params GetWalletBackupParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
+			Name: "X-TonConnect-Auth",
+			In:   "header",
 		}
-		params.BlockID = packed[key].(string)
+		params.XTonConnectAuth = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetRawShardBlockProofParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawShardBlockProofParams, _ error) {
-	// Decode path: block_id.
+func decodeGetWalletBackupParams(args [0]string, argsEscaped bool, r *http.Request) (params GetWalletBackupParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: X-TonConnect-Auth.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-TonConnect-Auth",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -9976,72 +14331,43 @@ func decodeGetRawShardBlockProofParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.BlockID = c
+				params.XTonConnectAuth = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
 			return validate.ErrFieldRequired
 		}
 		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetRawShardInfoParams is parameters of getRawShardInfo operation.
-type GetRawShardInfoParams struct {
-	// Block ID: (workchain,shard,seqno,root_hash,file_hash).
-	BlockID string
-	// Workchain.
-	Workchain int32
-	// Shard.
-	Shard int64
-	// Exact.
-	Exact bool
-}
-
-func unpackGetRawShardInfoParams(packed middleware.Parameters) (params GetRawShardInfoParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "block_id",
-			In:   "path",
-		}
-		params.BlockID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "workchain",
-			In:   "query",
-		}
-		params.Workchain = packed[key].(int32)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "shard",
-			In:   "query",
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-TonConnect-Auth",
+			In:   "header",
+			Err:  err,
 		}
-		params.Shard = packed[key].(int64)
 	}
+	return params, nil
+}
+
+// GetWalletsByPublicKeyParams is parameters of getWalletsByPublicKey operation.
+type GetWalletsByPublicKeyParams struct {
+	PublicKey string
+}
+
+func unpackGetWalletsByPublicKeyParams(packed middleware.Parameters) (params GetWalletsByPublicKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "exact",
-			In:   "query",
+			Name: "public_key",
+			In:   "path",
 		}
-		params.Exact = packed[key].(bool)
+		params.PublicKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetRawShardInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawShardInfoParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: block_id.
+func decodeGetWalletsByPublicKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWalletsByPublicKeyParams, _ error) {
+	// Decode path: public_key.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -10053,7 +14379,7 @@ func decodeGetRawShardInfoParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "block_id",
+				Param:   "public_key",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -10070,7 +14396,7 @@ func decodeGetRawShardInfoParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.BlockID = c
+				params.PublicKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -10081,115 +14407,77 @@ func decodeGetRawShardInfoParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "block_id",
+			Name: "public_key",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: workchain.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "workchain",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+	return params, nil
+}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
-					return err
-				}
+// ParseStateInitParams is parameters of parseStateInit operation.
+type ParseStateInitParams struct {
+	// Workchain used to compute the address.
+	Workchain OptInt32
+}
 
-				params.Workchain = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
+func unpackParseStateInitParams(packed middleware.Parameters) (params ParseStateInitParams) {
+	{
+		key := middleware.ParameterKey{
 			Name: "workchain",
 			In:   "query",
-			Err:  err,
 		}
-	}
-	// Decode query: shard.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "shard",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		if v, ok := packed[key]; ok {
+			params.Workchain = v.(OptInt32)
 		}
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToInt64(val)
-				if err != nil {
-					return err
-				}
-
-				params.Shard = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "shard",
-			In:   "query",
-			Err:  err,
-		}
+func decodeParseStateInitParams(args [0]string, argsEscaped bool, r *http.Request) (params ParseStateInitParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: workchain.
+	{
+		val := int32(0)
+		params.Workchain.SetTo(val)
 	}
-	// Decode query: exact.
+	// Decode query: workchain.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "exact",
+			Name:    "workchain",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotWorkchainVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToBool(val)
-				if err != nil {
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotWorkchainVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Exact = c
+				params.Workchain.SetTo(paramsDotWorkchainVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "exact",
+			Name: "workchain",
 			In:   "query",
 			Err:  err,
 		}
@@ -10197,53 +14485,24 @@ func decodeGetRawShardInfoParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetRawTransactionsParams is parameters of getRawTransactions operation.
-type GetRawTransactionsParams struct {
-	// Account ID.
-	AccountID string
-	// Count.
-	Count int32
-	// Lt.
-	Lt int64
-	// Hash.
-	Hash string
+// RecoverWalletsParams is parameters of recoverWallets operation.
+type RecoverWalletsParams struct {
+	PublicKey string
 }
 
-func unpackGetRawTransactionsParams(packed middleware.Parameters) (params GetRawTransactionsParams) {
+func unpackRecoverWalletsParams(packed middleware.Parameters) (params RecoverWalletsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
+			Name: "public_key",
 			In:   "path",
 		}
-		params.AccountID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "count",
-			In:   "query",
-		}
-		params.Count = packed[key].(int32)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "lt",
-			In:   "query",
-		}
-		params.Lt = packed[key].(int64)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "hash",
-			In:   "query",
-		}
-		params.Hash = packed[key].(string)
+		params.PublicKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetRawTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRawTransactionsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: account_id.
+func decodeRecoverWalletsParams(args [1]string, argsEscaped bool, r *http.Request) (params RecoverWalletsParams, _ error) {
+	// Decode path: public_key.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -10255,7 +14514,7 @@ func decodeGetRawTransactionsParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
+				Param:   "public_key",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -10272,7 +14531,7 @@ func decodeGetRawTransactionsParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.AccountID = c
+				params.PublicKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -10283,70 +14542,64 @@ func decodeGetRawTransactionsParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
+			Name: "public_key",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: count.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+	return params, nil
+}
 
-				c, err := conv.ToInt32(val)
-				if err != nil {
-					return err
-				}
+// ReindexAccountParams is parameters of reindexAccount operation.
+type ReindexAccountParams struct {
+	// Account ID.
+	AccountID string
+}
 
-				params.Count = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "count",
-			In:   "query",
-			Err:  err,
+func unpackReindexAccountParams(packed middleware.Parameters) (params ReindexAccountParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "path",
 		}
+		params.AccountID = packed[key].(string)
 	}
-	// Decode query: lt.
+	return params
+}
+
+func decodeReindexAccountParams(args [1]string, argsEscaped bool, r *http.Request) (params ReindexAccountParams, _ error) {
+	// Decode path: account_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "lt",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToInt64(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.Lt = c
+				params.AccountID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -10355,21 +14608,51 @@ func decodeGetRawTransactionsParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "lt",
-			In:   "query",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: hash.
+	return params, nil
+}
+
+// ResolveMessageHashParams is parameters of resolveMessageHash operation.
+type ResolveMessageHashParams struct {
+	// Message ID.
+	MsgID string
+}
+
+func unpackResolveMessageHashParams(packed middleware.Parameters) (params ResolveMessageHashParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "msg_id",
+			In:   "path",
+		}
+		params.MsgID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeResolveMessageHashParams(args [1]string, argsEscaped bool, r *http.Request) (params ResolveMessageHashParams, _ error) {
+	// Decode path: msg_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "hash",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "msg_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -10380,9 +14663,9 @@ func decodeGetRawTransactionsParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.Hash = c
+				params.MsgID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -10391,63 +14674,75 @@ func decodeGetRawTransactionsParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "hash",
-			In:   "query",
+			Name: "msg_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetReducedBlockchainBlocksParams is parameters of getReducedBlockchainBlocks operation.
-type GetReducedBlockchainBlocksParams struct {
-	From int64
-	To   int64
+// RotateWebhookSecretParams is parameters of rotateWebhookSecret operation.
+type RotateWebhookSecretParams struct {
+	ID string
+	// Seconds the previous secret remains valid.
+	GracePeriod OptInt64
 }
 
-func unpackGetReducedBlockchainBlocksParams(packed middleware.Parameters) (params GetReducedBlockchainBlocksParams) {
+func unpackRotateWebhookSecretParams(packed middleware.Parameters) (params RotateWebhookSecretParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "from",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 		}
-		params.From = packed[key].(int64)
+		params.ID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "to",
+			Name: "grace_period",
 			In:   "query",
 		}
-		params.To = packed[key].(int64)
+		if v, ok := packed[key]; ok {
+			params.GracePeriod = v.(OptInt64)
+		}
 	}
 	return params
 }
 
-func decodeGetReducedBlockchainBlocksParams(args [0]string, argsEscaped bool, r *http.Request) (params GetReducedBlockchainBlocksParams, _ error) {
+func decodeRotateWebhookSecretParams(args [1]string, argsEscaped bool, r *http.Request) (params RotateWebhookSecretParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: from.
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "from",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToInt64(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.From = c
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -10456,43 +14751,77 @@ func decodeGetReducedBlockchainBlocksParams(args [0]string, argsEscaped bool, r
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "from",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: to.
+	// Set default value for query: grace_period.
+	{
+		val := int64(86400)
+		params.GracePeriod.SetTo(val)
+	}
+	// Decode query: grace_period.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "to",
+			Name:    "grace_period",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotGracePeriodVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt64(val)
-				if err != nil {
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotGracePeriodVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.To = c
+				params.GracePeriod.SetTo(paramsDotGracePeriodVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
+			if err := func() error {
+				if value, ok := params.GracePeriod.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           604800,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "to",
+			Name: "grace_period",
 			In:   "query",
 			Err:  err,
 		}
@@ -10500,43 +14829,34 @@ func decodeGetReducedBlockchainBlocksParams(args [0]string, argsEscaped bool, r
 	return params, nil
 }
 
-// GetStakingPoolHistoryParams is parameters of getStakingPoolHistory operation.
-type GetStakingPoolHistoryParams struct {
-	// Account ID.
-	AccountID string
+// SearchAccountsParams is parameters of searchAccounts operation.
+type SearchAccountsParams struct {
+	Name string
 }
 
-func unpackGetStakingPoolHistoryParams(packed middleware.Parameters) (params GetStakingPoolHistoryParams) {
+func unpackSearchAccountsParams(packed middleware.Parameters) (params SearchAccountsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
+			Name: "name",
+			In:   "query",
 		}
-		params.AccountID = packed[key].(string)
+		params.Name = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetStakingPoolHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStakingPoolHistoryParams, _ error) {
-	// Decode path: account_id.
+func decodeSearchAccountsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchAccountsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: name.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -10547,7 +14867,23 @@ func decodeGetStakingPoolHistoryParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.AccountID = c
+				params.Name = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    3,
+					MinLengthSet: true,
+					MaxLength:    15,
+					MaxLengthSet: true,
+					Email:        false,
+					Hostname:     false,
+					Regex:        nil,
+				}).Validate(string(params.Name)); err != nil {
+					return errors.Wrap(err, "string")
+				}
 				return nil
 			}(); err != nil {
 				return err
@@ -10555,105 +14891,153 @@ func decodeGetStakingPoolHistoryParams(args [1]string, argsEscaped bool, r *http
 		} else {
 			return validate.ErrFieldRequired
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
-			Err:  err,
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchMessagesParams is parameters of searchMessages operation.
+type SearchMessagesParams struct {
+	// A case-insensitive substring of a text comment.
+	Comment OptString
+	// A hash of a message body or a whole message in hex.
+	PayloadHash OptString
+	// Limit the search to a single tracked account.
+	AccountID OptString
+	StartDate OptInt64
+	EndDate   OptInt64
+	// Omit this parameter to get last transactions.
+	BeforeLt OptInt64
+	Limit    OptInt32
+}
+
+func unpackSearchMessagesParams(packed middleware.Parameters) (params SearchMessagesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "comment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Comment = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "payload_hash",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PayloadHash = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "account_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AccountID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDate = v.(OptInt64)
 		}
 	}
-	return params, nil
-}
-
-// GetStakingPoolInfoParams is parameters of getStakingPoolInfo operation.
-type GetStakingPoolInfoParams struct {
-	// Account ID.
-	AccountID      string
-	AcceptLanguage OptString
-}
-
-func unpackGetStakingPoolInfoParams(packed middleware.Parameters) (params GetStakingPoolInfoParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "account_id",
-			In:   "path",
+			Name: "end_date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndDate = v.(OptInt64)
 		}
-		params.AccountID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "before_lt",
+			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
+			params.BeforeLt = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
 		}
 	}
 	return params
 }
 
-func decodeGetStakingPoolInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStakingPoolInfoParams, _ error) {
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode path: account_id.
+func decodeSearchMessagesParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchMessagesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: comment.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "comment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "account_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCommentVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCommentVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.AccountID = c
+				params.Comment.SetTo(paramsDotCommentVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "account_id",
-			In:   "path",
+			Name: "comment",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
+	// Decode query: payload_hash.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "payload_hash",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPayloadHashVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -10665,12 +15049,12 @@ func decodeGetStakingPoolInfoParams(args [1]string, argsEscaped bool, r *http.Re
 						return err
 					}
 
-					paramsDotAcceptLanguageVal = c
+					paramsDotPayloadHashVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				params.PayloadHash.SetTo(paramsDotPayloadHashVal)
 				return nil
 			}); err != nil {
 				return err
@@ -10679,68 +15063,22 @@ func decodeGetStakingPoolInfoParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetStakingPoolsParams is parameters of getStakingPools operation.
-type GetStakingPoolsParams struct {
-	// Account ID.
-	AvailableFor OptString
-	// Return also pools not from white list - just compatible by interfaces (maybe dangerous!).
-	IncludeUnverified OptBool
-	AcceptLanguage    OptString
-}
-
-func unpackGetStakingPoolsParams(packed middleware.Parameters) (params GetStakingPoolsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "available_for",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.AvailableFor = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "include_unverified",
+			Name: "payload_hash",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IncludeUnverified = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeGetStakingPoolsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetStakingPoolsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode query: available_for.
+	// Decode query: account_id.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "available_for",
+			Name:    "account_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAvailableForVal string
+				var paramsDotAccountIDVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -10752,12 +15090,12 @@ func decodeGetStakingPoolsParams(args [0]string, argsEscaped bool, r *http.Reque
 						return err
 					}
 
-					paramsDotAvailableForVal = c
+					paramsDotAccountIDVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AvailableFor.SetTo(paramsDotAvailableForVal)
+				params.AccountID.SetTo(paramsDotAccountIDVal)
 				return nil
 			}); err != nil {
 				return err
@@ -10766,39 +15104,39 @@ func decodeGetStakingPoolsParams(args [0]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "available_for",
+			Name: "account_id",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: include_unverified.
+	// Decode query: start_date.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "include_unverified",
+			Name:    "start_date",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIncludeUnverifiedVal bool
+				var paramsDotStartDateVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToInt64(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotIncludeUnverifiedVal = c
+					paramsDotStartDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.IncludeUnverified.SetTo(paramsDotIncludeUnverifiedVal)
+				params.StartDate.SetTo(paramsDotStartDateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -10807,42 +15145,39 @@ func decodeGetStakingPoolsParams(args [0]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "include_unverified",
+			Name: "start_date",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for header: Accept-Language.
-	{
-		val := string("en")
-		params.AcceptLanguage.SetTo(val)
-	}
-	// Decode header: Accept-Language.
+	// Decode query: end_date.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndDateVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt64(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotAcceptLanguageVal = c
+					paramsDotEndDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				params.EndDate.SetTo(paramsDotEndDateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -10851,153 +15186,144 @@ func decodeGetStakingPoolsParams(args [0]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "end_date",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetTraceParams is parameters of getTrace operation.
-type GetTraceParams struct {
-	// Trace ID or transaction hash in hex (without 0x) or base64url format.
-	TraceID string
-}
-
-func unpackGetTraceParams(packed middleware.Parameters) (params GetTraceParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "trace_id",
-			In:   "path",
-		}
-		params.TraceID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetTraceParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTraceParams, _ error) {
-	// Decode path: trace_id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+	// Decode query: before_lt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "before_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "trace_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBeforeLtVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBeforeLtVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.TraceID = c
+				params.BeforeLt.SetTo(paramsDotBeforeLtVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "trace_id",
-			In:   "path",
+			Name: "before_lt",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetWalletBackupParams is parameters of getWalletBackup operation.
-type GetWalletBackupParams struct {
-	XTonConnectAuth string
-}
-
-func unpackGetWalletBackupParams(packed middleware.Parameters) (params GetWalletBackupParams) {
+	// Set default value for query: limit.
 	{
-		key := middleware.ParameterKey{
-			Name: "X-TonConnect-Auth",
-			In:   "header",
-		}
-		params.XTonConnectAuth = packed[key].(string)
+		val := int32(100)
+		params.Limit.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetWalletBackupParams(args [0]string, argsEscaped bool, r *http.Request) (params GetWalletBackupParams, _ error) {
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode header: X-TonConnect-Auth.
+	// Decode query: limit.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "X-TonConnect-Auth",
-			Explode: false,
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.XTonConnectAuth = c
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "X-TonConnect-Auth",
-			In:   "header",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetWalletsByPublicKeyParams is parameters of getWalletsByPublicKey operation.
-type GetWalletsByPublicKeyParams struct {
-	PublicKey string
+// SetEventAnnotationParams is parameters of setEventAnnotation operation.
+type SetEventAnnotationParams struct {
+	// Event ID or transaction hash in hex (without 0x) or base64url format.
+	EventID string
 }
 
-func unpackGetWalletsByPublicKeyParams(packed middleware.Parameters) (params GetWalletsByPublicKeyParams) {
+func unpackSetEventAnnotationParams(packed middleware.Parameters) (params SetEventAnnotationParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "public_key",
+			Name: "event_id",
 			In:   "path",
 		}
-		params.PublicKey = packed[key].(string)
+		params.EventID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetWalletsByPublicKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWalletsByPublicKeyParams, _ error) {
-	// Decode path: public_key.
+func decodeSetEventAnnotationParams(args [1]string, argsEscaped bool, r *http.Request) (params SetEventAnnotationParams, _ error) {
+	// Decode path: event_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -11009,7 +15335,7 @@ func decodeGetWalletsByPublicKeyParams(args [1]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "public_key",
+				Param:   "event_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -11026,7 +15352,7 @@ func decodeGetWalletsByPublicKeyParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.PublicKey = c
+				params.EventID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -11037,7 +15363,7 @@ func decodeGetWalletsByPublicKeyParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "public_key",
+			Name: "event_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -11045,13 +15371,13 @@ func decodeGetWalletsByPublicKeyParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// ReindexAccountParams is parameters of reindexAccount operation.
-type ReindexAccountParams struct {
+// SetPrivateLabelParams is parameters of setPrivateLabel operation.
+type SetPrivateLabelParams struct {
 	// Account ID.
 	AccountID string
 }
 
-func unpackReindexAccountParams(packed middleware.Parameters) (params ReindexAccountParams) {
+func unpackSetPrivateLabelParams(packed middleware.Parameters) (params SetPrivateLabelParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "account_id",
@@ -11062,7 +15388,7 @@ func unpackReindexAccountParams(packed middleware.Parameters) (params ReindexAcc
 	return params
 }
 
-func decodeReindexAccountParams(args [1]string, argsEscaped bool, r *http.Request) (params ReindexAccountParams, _ error) {
+func decodeSetPrivateLabelParams(args [1]string, argsEscaped bool, r *http.Request) (params SetPrivateLabelParams, _ error) {
 	// Decode path: account_id.
 	if err := func() error {
 		param := args[0]
@@ -11111,34 +15437,32 @@ func decodeReindexAccountParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// SearchAccountsParams is parameters of searchAccounts operation.
-type SearchAccountsParams struct {
-	Name string
+// SetWalletBackupParams is parameters of setWalletBackup operation.
+type SetWalletBackupParams struct {
+	XTonConnectAuth string
 }
 
-func unpackSearchAccountsParams(packed middleware.Parameters) (params SearchAccountsParams) {
+func unpackSetWalletBackupParams(packed middleware.Parameters) (params SetWalletBackupParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "name",
-			In:   "query",
+			Name: "X-TonConnect-Auth",
+			In:   "header",
 		}
-		params.Name = packed[key].(string)
+		params.XTonConnectAuth = packed[key].(string)
 	}
 	return params
 }
 
-func decodeSearchAccountsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchAccountsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: name.
+func decodeSetWalletBackupParams(args [0]string, argsEscaped bool, r *http.Request) (params SetWalletBackupParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: X-TonConnect-Auth.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-TonConnect-Auth",
+			Explode: false,
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -11149,67 +15473,62 @@ func decodeSearchAccountsParams(args [0]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.Name = c
+				params.XTonConnectAuth = c
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:    3,
-					MinLengthSet: true,
-					MaxLength:    15,
-					MaxLengthSet: true,
-					Email:        false,
-					Hostname:     false,
-					Regex:        nil,
-				}).Validate(string(params.Name)); err != nil {
-					return errors.Wrap(err, "string")
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		} else {
 			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "name",
-			In:   "query",
+			Name: "X-TonConnect-Auth",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SetWalletBackupParams is parameters of setWalletBackup operation.
-type SetWalletBackupParams struct {
-	XTonConnectAuth string
+// SimulateAccountTransactionParams is parameters of simulateAccountTransaction operation.
+type SimulateAccountTransactionParams struct {
+	// Account ID.
+	AccountID string
 }
 
-func unpackSetWalletBackupParams(packed middleware.Parameters) (params SetWalletBackupParams) {
+func unpackSimulateAccountTransactionParams(packed middleware.Parameters) (params SimulateAccountTransactionParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "X-TonConnect-Auth",
-			In:   "header",
+			Name: "account_id",
+			In:   "path",
 		}
-		params.XTonConnectAuth = packed[key].(string)
+		params.AccountID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeSetWalletBackupParams(args [0]string, argsEscaped bool, r *http.Request) (params SetWalletBackupParams, _ error) {
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode header: X-TonConnect-Auth.
+func decodeSimulateAccountTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params SimulateAccountTransactionParams, _ error) {
+	// Decode path: account_id.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "X-TonConnect-Auth",
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "account_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -11220,9 +15539,9 @@ func decodeSetWalletBackupParams(args [0]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.XTonConnectAuth = c
+				params.AccountID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -11231,8 +15550,8 @@ func decodeSetWalletBackupParams(args [0]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "X-TonConnect-Auth",
-			In:   "header",
+			Name: "account_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
@@ -3,6 +3,7 @@
 package oas
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/go-faster/errors"
@@ -27,6 +28,34 @@ func encodeAccountDnsBackResolveResponse(response *DomainNames, w http.ResponseW
 	return nil
 }
 
+func encodeAddExpectedDepositResponse(response *ExpectedDeposit, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeAddWebhookResponse(response *Webhook, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
 func encodeAddressParseResponse(response *AddressParseOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
@@ -55,6 +84,34 @@ func encodeBlockchainAccountInspectResponse(response *BlockchainAccountInspect,
 	return nil
 }
 
+func encodeBuildWalletTransferResponse(response *UnsignedWalletTransfer, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeCheckAccountBounceResponse(response *BouncePrediction, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
 func encodeDecodeMessageResponse(response *DecodedMessage, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
@@ -69,6 +126,34 @@ func encodeDecodeMessageResponse(response *DecodedMessage, w http.ResponseWriter
 	return nil
 }
 
+func encodeDeleteEventAnnotationResponse(response *DeleteEventAnnotationOK, w http.ResponseWriter, span trace.Span) error {
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	return nil
+}
+
+func encodeDeleteExpectedDepositResponse(response *DeleteExpectedDepositOK, w http.ResponseWriter, span trace.Span) error {
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	return nil
+}
+
+func encodeDeletePrivateLabelResponse(response *DeletePrivateLabelOK, w http.ResponseWriter, span trace.Span) error {
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	return nil
+}
+
+func encodeDeleteWebhookResponse(response *DeleteWebhookOK, w http.ResponseWriter, span trace.Span) error {
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	return nil
+}
+
 func encodeDnsResolveResponse(response *DnsRecord, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
@@ -202,6 +287,48 @@ func encodeGetAccountResponse(response *Account, w http.ResponseWriter, span tra
 	return nil
 }
 
+func encodeGetAccountActivityResponse(response *AccountActivity, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetAccountActivityHeatmapResponse(response *AccountActivityHeatmap, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetAccountCodeHistoryResponse(response *AccountCodeHistory, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
 func encodeGetAccountDiffResponse(response *GetAccountDiffOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
@@ -258,6 +385,20 @@ func encodeGetAccountEventsResponse(response *AccountEvents, w http.ResponseWrit
 	return nil
 }
 
+func encodeGetAccountEventsDeltaResponse(response *AccountEventsDelta, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
 func encodeGetAccountInfoByStateInitResponse(response *AccountInfoByStateInit, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
@@ -454,7 +595,7 @@ func encodeGetAccountSeqnoResponse(response *Seqno, w http.ResponseWriter, span
 	return nil
 }
 
-func encodeGetAccountSubscriptionsResponse(response *Subscriptions, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAccountStateDiffResponse(response *AccountStateDiff, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -468,7 +609,7 @@ func encodeGetAccountSubscriptionsResponse(response *Subscriptions, w http.Respo
 	return nil
 }
 
-func encodeGetAccountTracesResponse(response *TraceIDs, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAccountStatsResponse(response *AccountStats, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -482,7 +623,7 @@ func encodeGetAccountTracesResponse(response *TraceIDs, w http.ResponseWriter, s
 	return nil
 }
 
-func encodeGetAccountsResponse(response *Accounts, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAccountStorageRentResponse(response *AccountStorageRent, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -496,7 +637,7 @@ func encodeGetAccountsResponse(response *Accounts, w http.ResponseWriter, span t
 	return nil
 }
 
-func encodeGetAllAuctionsResponse(response *Auctions, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAccountSubscriptionsResponse(response *Subscriptions, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -510,7 +651,7 @@ func encodeGetAllAuctionsResponse(response *Auctions, w http.ResponseWriter, spa
 	return nil
 }
 
-func encodeGetAllRawShardsInfoResponse(response *GetAllRawShardsInfoOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAccountTracesResponse(response *TraceIDs, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -524,7 +665,7 @@ func encodeGetAllRawShardsInfoResponse(response *GetAllRawShardsInfoOK, w http.R
 	return nil
 }
 
-func encodeGetBlockchainAccountTransactionsResponse(response *Transactions, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAccountsResponse(response *Accounts, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -538,7 +679,7 @@ func encodeGetBlockchainAccountTransactionsResponse(response *Transactions, w ht
 	return nil
 }
 
-func encodeGetBlockchainBlockResponse(response *BlockchainBlock, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAllAuctionsResponse(response *Auctions, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -552,7 +693,7 @@ func encodeGetBlockchainBlockResponse(response *BlockchainBlock, w http.Response
 	return nil
 }
 
-func encodeGetBlockchainBlockTransactionsResponse(response *Transactions, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAllRawShardsInfoResponse(response *GetAllRawShardsInfoOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -566,7 +707,7 @@ func encodeGetBlockchainBlockTransactionsResponse(response *Transactions, w http
 	return nil
 }
 
-func encodeGetBlockchainConfigResponse(response *BlockchainConfig, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainAccountLogsResponse(response *AccountLogs, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -580,7 +721,7 @@ func encodeGetBlockchainConfigResponse(response *BlockchainConfig, w http.Respon
 	return nil
 }
 
-func encodeGetBlockchainConfigFromBlockResponse(response *BlockchainConfig, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainAccountTransactionsResponse(response *Transactions, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -594,7 +735,7 @@ func encodeGetBlockchainConfigFromBlockResponse(response *BlockchainConfig, w ht
 	return nil
 }
 
-func encodeGetBlockchainMasterchainBlocksResponse(response *BlockchainBlocks, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainBlockResponse(response *BlockchainBlock, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -608,7 +749,7 @@ func encodeGetBlockchainMasterchainBlocksResponse(response *BlockchainBlocks, w
 	return nil
 }
 
-func encodeGetBlockchainMasterchainHeadResponse(response *BlockchainBlock, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainBlockTransactionsResponse(response *Transactions, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -622,7 +763,7 @@ func encodeGetBlockchainMasterchainHeadResponse(response *BlockchainBlock, w htt
 	return nil
 }
 
-func encodeGetBlockchainMasterchainShardsResponse(response *BlockchainBlockShards, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainConfigResponse(response *BlockchainConfig, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -636,7 +777,7 @@ func encodeGetBlockchainMasterchainShardsResponse(response *BlockchainBlockShard
 	return nil
 }
 
-func encodeGetBlockchainMasterchainTransactionsResponse(response *Transactions, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainConfigContractResponse(response *ConfigContractState, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -650,7 +791,7 @@ func encodeGetBlockchainMasterchainTransactionsResponse(response *Transactions,
 	return nil
 }
 
-func encodeGetBlockchainRawAccountResponse(response *BlockchainRawAccount, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainConfigFromBlockResponse(response *BlockchainConfig, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -664,7 +805,7 @@ func encodeGetBlockchainRawAccountResponse(response *BlockchainRawAccount, w htt
 	return nil
 }
 
-func encodeGetBlockchainTransactionResponse(response *Transaction, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainElectorResponse(response *ElectorState, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -678,7 +819,7 @@ func encodeGetBlockchainTransactionResponse(response *Transaction, w http.Respon
 	return nil
 }
 
-func encodeGetBlockchainTransactionByMessageHashResponse(response *Transaction, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainFeesResponse(response *BlockchainFees, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -692,7 +833,7 @@ func encodeGetBlockchainTransactionByMessageHashResponse(response *Transaction,
 	return nil
 }
 
-func encodeGetBlockchainValidatorsResponse(response *Validators, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainMasterchainBlocksResponse(response *BlockchainBlocks, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -706,7 +847,7 @@ func encodeGetBlockchainValidatorsResponse(response *Validators, w http.Response
 	return nil
 }
 
-func encodeGetChartRatesResponse(response *GetChartRatesOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainMasterchainHeadResponse(response *BlockchainBlock, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -720,7 +861,7 @@ func encodeGetChartRatesResponse(response *GetChartRatesOK, w http.ResponseWrite
 	return nil
 }
 
-func encodeGetDnsInfoResponse(response *DomainInfo, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainMasterchainShardsResponse(response *BlockchainBlockShards, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -734,7 +875,7 @@ func encodeGetDnsInfoResponse(response *DomainInfo, w http.ResponseWriter, span
 	return nil
 }
 
-func encodeGetDomainBidsResponse(response *DomainBids, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainMasterchainTransactionsResponse(response *Transactions, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -748,7 +889,7 @@ func encodeGetDomainBidsResponse(response *DomainBids, w http.ResponseWriter, sp
 	return nil
 }
 
-func encodeGetEventResponse(response *Event, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainMinterResponse(response *MinterState, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -762,7 +903,7 @@ func encodeGetEventResponse(response *Event, w http.ResponseWriter, span trace.S
 	return nil
 }
 
-func encodeGetInscriptionOpTemplateResponse(response *GetInscriptionOpTemplateOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainRawAccountResponse(response *BlockchainRawAccount, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -776,7 +917,7 @@ func encodeGetInscriptionOpTemplateResponse(response *GetInscriptionOpTemplateOK
 	return nil
 }
 
-func encodeGetItemsFromCollectionResponse(response *NftItems, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainTransactionResponse(response *Transaction, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -790,7 +931,7 @@ func encodeGetItemsFromCollectionResponse(response *NftItems, w http.ResponseWri
 	return nil
 }
 
-func encodeGetJettonHoldersResponse(response *JettonHolders, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainTransactionByMessageHashResponse(response *Transaction, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -804,7 +945,7 @@ func encodeGetJettonHoldersResponse(response *JettonHolders, w http.ResponseWrit
 	return nil
 }
 
-func encodeGetJettonInfoResponse(response *JettonInfo, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBlockchainValidatorsResponse(response *Validators, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -818,7 +959,7 @@ func encodeGetJettonInfoResponse(response *JettonInfo, w http.ResponseWriter, sp
 	return nil
 }
 
-func encodeGetJettonTransferPayloadResponse(response *JettonTransferPayload, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBocHashResponse(response *BocHashes, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -832,7 +973,7 @@ func encodeGetJettonTransferPayloadResponse(response *JettonTransferPayload, w h
 	return nil
 }
 
-func encodeGetJettonsResponse(response *Jettons, w http.ResponseWriter, span trace.Span) error {
+func encodeGetChainCalendarResponse(response *ChainCalendar, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -846,7 +987,7 @@ func encodeGetJettonsResponse(response *Jettons, w http.ResponseWriter, span tra
 	return nil
 }
 
-func encodeGetJettonsEventsResponse(response *Event, w http.ResponseWriter, span trace.Span) error {
+func encodeGetChartRatesResponse(response *GetChartRatesOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -860,7 +1001,7 @@ func encodeGetJettonsEventsResponse(response *Event, w http.ResponseWriter, span
 	return nil
 }
 
-func encodeGetMarketsRatesResponse(response *GetMarketsRatesOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetConfigProposalsResponse(response *ConfigProposals, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -874,7 +1015,7 @@ func encodeGetMarketsRatesResponse(response *GetMarketsRatesOK, w http.ResponseW
 	return nil
 }
 
-func encodeGetMultisigAccountResponse(response *Multisig, w http.ResponseWriter, span trace.Span) error {
+func encodeGetDnsInfoResponse(response *DomainInfo, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -888,7 +1029,7 @@ func encodeGetMultisigAccountResponse(response *Multisig, w http.ResponseWriter,
 	return nil
 }
 
-func encodeGetNftCollectionResponse(response *NftCollection, w http.ResponseWriter, span trace.Span) error {
+func encodeGetDomainBidsResponse(response *DomainBids, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -902,7 +1043,7 @@ func encodeGetNftCollectionResponse(response *NftCollection, w http.ResponseWrit
 	return nil
 }
 
-func encodeGetNftCollectionsResponse(response *NftCollections, w http.ResponseWriter, span trace.Span) error {
+func encodeGetEventResponse(response *Event, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -916,7 +1057,7 @@ func encodeGetNftCollectionsResponse(response *NftCollections, w http.ResponseWr
 	return nil
 }
 
-func encodeGetNftHistoryByIDResponse(response *AccountEvents, w http.ResponseWriter, span trace.Span) error {
+func encodeGetEventAnnotationResponse(response *EventAnnotation, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -930,7 +1071,7 @@ func encodeGetNftHistoryByIDResponse(response *AccountEvents, w http.ResponseWri
 	return nil
 }
 
-func encodeGetNftItemByAddressResponse(response *NftItem, w http.ResponseWriter, span trace.Span) error {
+func encodeGetExpectedDepositsResponse(response *ExpectedDeposits, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -944,7 +1085,7 @@ func encodeGetNftItemByAddressResponse(response *NftItem, w http.ResponseWriter,
 	return nil
 }
 
-func encodeGetNftItemsByAddressesResponse(response *NftItems, w http.ResponseWriter, span trace.Span) error {
+func encodeGetInscriptionOpTemplateResponse(response *GetInscriptionOpTemplateOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -958,7 +1099,7 @@ func encodeGetNftItemsByAddressesResponse(response *NftItems, w http.ResponseWri
 	return nil
 }
 
-func encodeGetOutMsgQueueSizesResponse(response *GetOutMsgQueueSizesOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetItemsFromCollectionResponse(response *NftItems, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -972,7 +1113,7 @@ func encodeGetOutMsgQueueSizesResponse(response *GetOutMsgQueueSizesOK, w http.R
 	return nil
 }
 
-func encodeGetRatesResponse(response *GetRatesOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetJettonHoldersResponse(response *JettonHolders, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -986,7 +1127,7 @@ func encodeGetRatesResponse(response *GetRatesOK, w http.ResponseWriter, span tr
 	return nil
 }
 
-func encodeGetRawAccountStateResponse(response *GetRawAccountStateOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetJettonInfoResponse(response *JettonInfo, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1000,7 +1141,7 @@ func encodeGetRawAccountStateResponse(response *GetRawAccountStateOK, w http.Res
 	return nil
 }
 
-func encodeGetRawBlockProofResponse(response *GetRawBlockProofOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetJettonTransferPayloadResponse(response *JettonTransferPayload, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1014,7 +1155,7 @@ func encodeGetRawBlockProofResponse(response *GetRawBlockProofOK, w http.Respons
 	return nil
 }
 
-func encodeGetRawBlockchainBlockResponse(response *GetRawBlockchainBlockOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetJettonsResponse(response *Jettons, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1028,7 +1169,7 @@ func encodeGetRawBlockchainBlockResponse(response *GetRawBlockchainBlockOK, w ht
 	return nil
 }
 
-func encodeGetRawBlockchainBlockHeaderResponse(response *GetRawBlockchainBlockHeaderOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetJettonsEventsResponse(response *Event, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1042,7 +1183,7 @@ func encodeGetRawBlockchainBlockHeaderResponse(response *GetRawBlockchainBlockHe
 	return nil
 }
 
-func encodeGetRawBlockchainBlockStateResponse(response *GetRawBlockchainBlockStateOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetMarketsRatesResponse(response *GetMarketsRatesOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1056,7 +1197,7 @@ func encodeGetRawBlockchainBlockStateResponse(response *GetRawBlockchainBlockSta
 	return nil
 }
 
-func encodeGetRawBlockchainConfigResponse(response *RawBlockchainConfig, w http.ResponseWriter, span trace.Span) error {
+func encodeGetMasterchainEtaResponse(response *MasterchainEta, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1070,7 +1211,7 @@ func encodeGetRawBlockchainConfigResponse(response *RawBlockchainConfig, w http.
 	return nil
 }
 
-func encodeGetRawBlockchainConfigFromBlockResponse(response *RawBlockchainConfig, w http.ResponseWriter, span trace.Span) error {
+func encodeGetMultisigAccountResponse(response *Multisig, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1084,7 +1225,7 @@ func encodeGetRawBlockchainConfigFromBlockResponse(response *RawBlockchainConfig
 	return nil
 }
 
-func encodeGetRawConfigResponse(response *GetRawConfigOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetNetworkStatsResponse(response *NetworkStats, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1098,7 +1239,7 @@ func encodeGetRawConfigResponse(response *GetRawConfigOK, w http.ResponseWriter,
 	return nil
 }
 
-func encodeGetRawListBlockTransactionsResponse(response *GetRawListBlockTransactionsOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetNftCollectionResponse(response *NftCollection, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1112,7 +1253,7 @@ func encodeGetRawListBlockTransactionsResponse(response *GetRawListBlockTransact
 	return nil
 }
 
-func encodeGetRawMasterchainInfoResponse(response *GetRawMasterchainInfoOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetNftCollectionsResponse(response *NftCollections, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1126,7 +1267,7 @@ func encodeGetRawMasterchainInfoResponse(response *GetRawMasterchainInfoOK, w ht
 	return nil
 }
 
-func encodeGetRawMasterchainInfoExtResponse(response *GetRawMasterchainInfoExtOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetNftHistoryByIDResponse(response *AccountEvents, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1140,7 +1281,7 @@ func encodeGetRawMasterchainInfoExtResponse(response *GetRawMasterchainInfoExtOK
 	return nil
 }
 
-func encodeGetRawShardBlockProofResponse(response *GetRawShardBlockProofOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetNftItemBidsResponse(response *NftBids, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1154,7 +1295,7 @@ func encodeGetRawShardBlockProofResponse(response *GetRawShardBlockProofOK, w ht
 	return nil
 }
 
-func encodeGetRawShardInfoResponse(response *GetRawShardInfoOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetNftItemByAddressResponse(response *NftItem, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1168,7 +1309,7 @@ func encodeGetRawShardInfoResponse(response *GetRawShardInfoOK, w http.ResponseW
 	return nil
 }
 
-func encodeGetRawTimeResponse(response *GetRawTimeOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetNftItemsByAddressesResponse(response *NftItems, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1182,7 +1323,7 @@ func encodeGetRawTimeResponse(response *GetRawTimeOK, w http.ResponseWriter, spa
 	return nil
 }
 
-func encodeGetRawTransactionsResponse(response *GetRawTransactionsOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetOutMsgQueueSizesResponse(response *GetOutMsgQueueSizesOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1196,7 +1337,7 @@ func encodeGetRawTransactionsResponse(response *GetRawTransactionsOK, w http.Res
 	return nil
 }
 
-func encodeGetReducedBlockchainBlocksResponse(response *ReducedBlocks, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPrivateLabelResponse(response *PrivateLabel, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1210,7 +1351,7 @@ func encodeGetReducedBlockchainBlocksResponse(response *ReducedBlocks, w http.Re
 	return nil
 }
 
-func encodeGetStakingPoolHistoryResponse(response *GetStakingPoolHistoryOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPrivateLabelsResponse(response *PrivateLabels, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1224,7 +1365,7 @@ func encodeGetStakingPoolHistoryResponse(response *GetStakingPoolHistoryOK, w ht
 	return nil
 }
 
-func encodeGetStakingPoolInfoResponse(response *GetStakingPoolInfoOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRatesResponse(response *GetRatesOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1238,7 +1379,7 @@ func encodeGetStakingPoolInfoResponse(response *GetStakingPoolInfoOK, w http.Res
 	return nil
 }
 
-func encodeGetStakingPoolsResponse(response *GetStakingPoolsOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawAccountStateResponse(response *GetRawAccountStateOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1252,7 +1393,7 @@ func encodeGetStakingPoolsResponse(response *GetStakingPoolsOK, w http.ResponseW
 	return nil
 }
 
-func encodeGetStorageProvidersResponse(response *GetStorageProvidersOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawBlockProofResponse(response *GetRawBlockProofOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1266,7 +1407,7 @@ func encodeGetStorageProvidersResponse(response *GetStorageProvidersOK, w http.R
 	return nil
 }
 
-func encodeGetTonConnectPayloadResponse(response *GetTonConnectPayloadOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawBlockchainBlockResponse(response *GetRawBlockchainBlockOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1280,7 +1421,7 @@ func encodeGetTonConnectPayloadResponse(response *GetTonConnectPayloadOK, w http
 	return nil
 }
 
-func encodeGetTraceResponse(response *Trace, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawBlockchainBlockHeaderResponse(response *GetRawBlockchainBlockHeaderOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1294,7 +1435,7 @@ func encodeGetTraceResponse(response *Trace, w http.ResponseWriter, span trace.S
 	return nil
 }
 
-func encodeGetWalletBackupResponse(response *GetWalletBackupOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawBlockchainBlockStateResponse(response *GetRawBlockchainBlockStateOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1308,7 +1449,7 @@ func encodeGetWalletBackupResponse(response *GetWalletBackupOK, w http.ResponseW
 	return nil
 }
 
-func encodeGetWalletsByPublicKeyResponse(response *Accounts, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawBlockchainConfigResponse(response *RawBlockchainConfig, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1322,14 +1463,21 @@ func encodeGetWalletsByPublicKeyResponse(response *Accounts, w http.ResponseWrit
 	return nil
 }
 
-func encodeReindexAccountResponse(response *ReindexAccountOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawBlockchainConfigFromBlockResponse(response *RawBlockchainConfig, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
 
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
 	return nil
 }
 
-func encodeSearchAccountsResponse(response *FoundAccounts, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawConfigResponse(response *GetRawConfigOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1343,14 +1491,21 @@ func encodeSearchAccountsResponse(response *FoundAccounts, w http.ResponseWriter
 	return nil
 }
 
-func encodeSendBlockchainMessageResponse(response *SendBlockchainMessageOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawListBlockTransactionsResponse(response *GetRawListBlockTransactionsOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
 
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
 	return nil
 }
 
-func encodeSendRawMessageResponse(response *SendRawMessageOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawMasterchainInfoResponse(response *GetRawMasterchainInfoOK, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1364,10 +1519,462 @@ func encodeSendRawMessageResponse(response *SendRawMessageOK, w http.ResponseWri
 	return nil
 }
 
-func encodeSetWalletBackupResponse(response *SetWalletBackupOK, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRawMasterchainInfoExtResponse(response *GetRawMasterchainInfoExtOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetRawShardBlockProofResponse(response *GetRawShardBlockProofOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetRawShardInfoResponse(response *GetRawShardInfoOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetRawTimeResponse(response *GetRawTimeOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetRawTransactionsResponse(response *GetRawTransactionsOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetReducedBlockchainBlocksResponse(response *ReducedBlocks, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetStakingPoolHistoryResponse(response *GetStakingPoolHistoryOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetStakingPoolInfoResponse(response *GetStakingPoolInfoOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetStakingPoolsResponse(response *GetStakingPoolsOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetStorageProvidersResponse(response *GetStorageProvidersOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetTonConnectPayloadResponse(response *GetTonConnectPayloadOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetTopAccountsResponse(response *GetTopAccountsOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetTopJettonsByVolumeResponse(response *GetTopJettonsByVolumeOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetTraceResponse(response GetTraceRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *Trace:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetTraceOKTextPlain:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetUsageResponse(response *Usage, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetWalletBackupResponse(response *GetWalletBackupOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetWalletsByPublicKeyResponse(response *Accounts, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetWebhooksResponse(response *Webhooks, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeInspectBocResponse(response *BocInspection, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeInspectContractCodeResponse(response *ContractCodeInspection, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeParseStateInitResponse(response *StateInitComponents, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeRecoverWalletsResponse(response *WalletRecovery, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeReindexAccountResponse(response *ReindexAccountOK, w http.ResponseWriter, span trace.Span) error {
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	return nil
+}
+
+func encodeResolveMessageHashResponse(response *MessageHashResolution, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeRotateWebhookSecretResponse(response *Webhook, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeSearchAccountsResponse(response *FoundAccounts, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeSearchMessagesResponse(response *Transactions, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeSendBlockchainMessageResponse(response *SendBlockchainMessageOK, w http.ResponseWriter, span trace.Span) error {
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	return nil
+}
+
+func encodeSendRawMessageResponse(response *SendRawMessageOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
 
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeSetEventAnnotationResponse(response *EventAnnotation, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeSetPrivateLabelResponse(response *PrivateLabel, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeSetWalletBackupResponse(response *SetWalletBackupOK, w http.ResponseWriter, span trace.Span) error {
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	return nil
+}
+
+func encodeSimulateAccountTransactionResponse(response *SimulatedTransaction, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
 	return nil
 }
 
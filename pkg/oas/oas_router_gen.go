@@ -126,6 +126,27 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
+						elem = origElem
+					case 't': // Prefix: "top"
+						origElem := elem
+						if l := len("top"); len(elem) >= l && elem[0:l] == "top" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetTopAccountsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
 						elem = origElem
 					}
 					// Param: "account_id"
@@ -162,6 +183,75 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
+						case 'a': // Prefix: "activity"
+							origElem := elem
+							if l := len("activity"); len(elem) >= l && elem[0:l] == "activity" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetAccountActivityRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'b': // Prefix: "bounce-check"
+							origElem := elem
+							if l := len("bounce-check"); len(elem) >= l && elem[0:l] == "bounce-check" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCheckAccountBounceRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'c': // Prefix: "code-history"
+							origElem := elem
+							if l := len("code-history"); len(elem) >= l && elem[0:l] == "code-history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetAccountCodeHistoryRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
 						case 'd': // Prefix: "d"
 							origElem := elem
 							if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
@@ -294,6 +384,29 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
+								case 'd': // Prefix: "delta"
+									origElem := elem
+									if l := len("delta"); len(elem) >= l && elem[0:l] == "delta" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetAccountEventsDeltaRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+									elem = origElem
 								case 'e': // Prefix: "emulate"
 									origElem := elem
 									if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
@@ -341,6 +454,29 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								elem = origElem
 							}
 
+							elem = origElem
+						case 'h': // Prefix: "heatmap"
+							origElem := elem
+							if l := len("heatmap"); len(elem) >= l && elem[0:l] == "heatmap" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetAccountActivityHeatmapRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
 							elem = origElem
 						case 'j': // Prefix: "jettons"
 							origElem := elem
@@ -568,57 +704,209 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						case 's': // Prefix: "subscriptions"
+						case 's': // Prefix: "s"
 							origElem := elem
-							if l := len("subscriptions"); len(elem) >= l && elem[0:l] == "subscriptions" {
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetAccountSubscriptionsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+								break
+							}
+							switch elem[0] {
+							case 't': // Prefix: "t"
+								origElem := elem
+								if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+									elem = elem[l:]
+								} else {
+									break
 								}
 
-								return
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "at"
+									origElem := elem
+									if l := len("at"); len(elem) >= l && elem[0:l] == "at" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'e': // Prefix: "e-diff"
+										origElem := elem
+										if l := len("e-diff"); len(elem) >= l && elem[0:l] == "e-diff" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetAccountStateDiffRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+										elem = origElem
+									case 's': // Prefix: "s"
+										origElem := elem
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetAccountStatsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								case 'o': // Prefix: "orage-rent"
+									origElem := elem
+									if l := len("orage-rent"); len(elem) >= l && elem[0:l] == "orage-rent" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetAccountStorageRentRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'u': // Prefix: "ubscriptions"
+								origElem := elem
+								if l := len("ubscriptions"); len(elem) >= l && elem[0:l] == "ubscriptions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetAccountSubscriptionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
 							}
 
 							elem = origElem
-						case 't': // Prefix: "traces"
+						case 't': // Prefix: "tra"
 							origElem := elem
-							if l := len("traces"); len(elem) >= l && elem[0:l] == "traces" {
+							if l := len("tra"); len(elem) >= l && elem[0:l] == "tra" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetAccountTracesRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
+								break
 							}
+							switch elem[0] {
+							case 'c': // Prefix: "ces"
+								origElem := elem
+								if l := len("ces"); len(elem) >= l && elem[0:l] == "ces" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetAccountTracesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'n': // Prefix: "nsactions/simulate"
+								origElem := elem
+								if l := len("nsactions/simulate"); len(elem) >= l && elem[0:l] == "nsactions/simulate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleSimulateAccountTransactionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
 
-							elem = origElem
-						}
-
-						elem = origElem
-					}
-
 					elem = origElem
 				case 'd': // Prefix: "ddress/"
 					origElem := elem
@@ -670,9 +958,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				elem = origElem
-			case 'b': // Prefix: "blockchain/"
+			case 'b': // Prefix: "b"
 				origElem := elem
-				if l := len("blockchain/"); len(elem) >= l && elem[0:l] == "blockchain/" {
+				if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
 					elem = elem[l:]
 				} else {
 					break
@@ -682,51 +970,197 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'a': // Prefix: "accounts/"
+				case 'l': // Prefix: "lockchain/"
 					origElem := elem
-					if l := len("accounts/"); len(elem) >= l && elem[0:l] == "accounts/" {
+					if l := len("lockchain/"); len(elem) >= l && elem[0:l] == "lockchain/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "account_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
 					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetBlockchainRawAccountRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
+						break
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'a': // Prefix: "accounts/"
 						origElem := elem
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("accounts/"); len(elem) >= l && elem[0:l] == "accounts/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "account_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetBlockchainRawAccountRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'i': // Prefix: "inspect"
+								origElem := elem
+								if l := len("inspect"); len(elem) >= l && elem[0:l] == "inspect" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleBlockchainAccountInspectRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'l': // Prefix: "logs"
+								origElem := elem
+								if l := len("logs"); len(elem) >= l && elem[0:l] == "logs" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetBlockchainAccountLogsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'm': // Prefix: "methods/"
+								origElem := elem
+								if l := len("methods/"); len(elem) >= l && elem[0:l] == "methods/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "method_name"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleExecGetMethodForBlockchainAccountRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 't': // Prefix: "transactions"
+								origElem := elem
+								if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetBlockchainAccountTransactionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 'b': // Prefix: "blocks/"
+						origElem := elem
+						if l := len("blocks/"); len(elem) >= l && elem[0:l] == "blocks/" {
+							elem = elem[l:]
+						} else {
 							break
 						}
+
+						// Param: "block_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetBlockchainBlockRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
 						switch elem[0] {
-						case 'i': // Prefix: "inspect"
+						case '/': // Prefix: "/transactions"
 							origElem := elem
-							if l := len("inspect"); len(elem) >= l && elem[0:l] == "inspect" {
+							if l := len("/transactions"); len(elem) >= l && elem[0:l] == "/transactions" {
 								elem = elem[l:]
 							} else {
 								break
@@ -736,7 +1170,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleBlockchainAccountInspectRequest([1]string{
+									s.handleGetBlockchainBlockTransactionsRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
@@ -747,27 +1181,34 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						case 'm': // Prefix: "methods/"
+						}
+
+						elem = origElem
+					case 'c': // Prefix: "c"
+						origElem := elem
+						if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "alendar"
 							origElem := elem
-							if l := len("methods/"); len(elem) >= l && elem[0:l] == "methods/" {
+							if l := len("alendar"); len(elem) >= l && elem[0:l] == "alendar" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "method_name"
-							// Leaf parameter
-							args[1] = elem
-							elem = ""
-
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleExecGetMethodForBlockchainAccountRequest([2]string{
-										args[0],
-										args[1],
-									}, elemIsEscaped, w, r)
+									s.handleGetChainCalendarRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "GET")
 								}
@@ -776,113 +1217,91 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						case 't': // Prefix: "transactions"
+						case 'o': // Prefix: "onfig"
 							origElem := elem
-							if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
+							if l := len("onfig"); len(elem) >= l && elem[0:l] == "onfig" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetBlockchainAccountTransactionsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+									s.handleGetBlockchainConfigRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							elem = origElem
-						}
-
-						elem = origElem
-					}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "proposals"
+									origElem := elem
+									if l := len("proposals"); len(elem) >= l && elem[0:l] == "proposals" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					elem = origElem
-				case 'b': // Prefix: "blocks/"
-					origElem := elem
-					if l := len("blocks/"); len(elem) >= l && elem[0:l] == "blocks/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "block_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetConfigProposalsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetBlockchainBlockRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+										return
+									}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/transactions"
-						origElem := elem
-						if l := len("/transactions"); len(elem) >= l && elem[0:l] == "/transactions" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									elem = origElem
+								case 'r': // Prefix: "raw"
+									origElem := elem
+									if l := len("raw"); len(elem) >= l && elem[0:l] == "raw" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetBlockchainBlockTransactionsRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetRawBlockchainConfigRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-							return
-						}
+										return
+									}
 
-						elem = origElem
-					}
+									elem = origElem
+								}
 
-					elem = origElem
-				case 'c': // Prefix: "config"
-					origElem := elem
-					if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								elem = origElem
+							}
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetBlockchainConfigRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
+							elem = origElem
 						}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/raw"
+						elem = origElem
+					case 'f': // Prefix: "fees"
 						origElem := elem
-						if l := len("/raw"); len(elem) >= l && elem[0:l] == "/raw" {
+						if l := len("fees"); len(elem) >= l && elem[0:l] == "fees" {
 							elem = elem[l:]
 						} else {
 							break
@@ -892,7 +1311,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleGetRawBlockchainConfigRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleGetBlockchainFeesRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "GET")
 							}
@@ -901,24 +1320,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						elem = origElem
-					}
-
-					elem = origElem
-				case 'm': // Prefix: "m"
-					origElem := elem
-					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "asterchain"
+					case 'm': // Prefix: "m"
 						origElem := elem
-						if l := len("asterchain"); len(elem) >= l && elem[0:l] == "asterchain" {
+						if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
 							elem = elem[l:]
 						} else {
 							break
@@ -928,48 +1332,39 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case '-': // Prefix: "-head"
+						case 'a': // Prefix: "asterchain"
 							origElem := elem
-							if l := len("-head"); len(elem) >= l && elem[0:l] == "-head" {
+							if l := len("asterchain"); len(elem) >= l && elem[0:l] == "asterchain" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetBlockchainMasterchainHeadRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-							elem = origElem
-						case '/': // Prefix: "/"
-							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
 								break
 							}
+							switch elem[0] {
+							case '-': // Prefix: "-head"
+								origElem := elem
+								if l := len("-head"); len(elem) >= l && elem[0:l] == "-head" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							// Param: "masterchain_seqno"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetBlockchainMasterchainHeadRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
+									return
+								}
+
+								elem = origElem
 							case '/': // Prefix: "/"
 								origElem := elem
 								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
@@ -982,9 +1377,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'b': // Prefix: "blocks"
+								case 'e': // Prefix: "eta"
 									origElem := elem
-									if l := len("blocks"); len(elem) >= l && elem[0:l] == "blocks" {
+									if l := len("eta"); len(elem) >= l && elem[0:l] == "eta" {
 										elem = elem[l:]
 									} else {
 										break
@@ -994,9 +1389,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetBlockchainMasterchainBlocksRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
+											s.handleGetMasterchainEtaRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "GET")
 										}
@@ -1005,30 +1398,35 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									}
 
 									elem = origElem
-								case 'c': // Prefix: "config"
+								}
+								// Param: "masterchain_seqno"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 									origElem := elem
-									if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										switch r.Method {
-										case "GET":
-											s.handleGetBlockchainConfigFromBlockRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
+										break
 									}
 									switch elem[0] {
-									case '/': // Prefix: "/raw"
+									case 'b': // Prefix: "blocks"
 										origElem := elem
-										if l := len("/raw"); len(elem) >= l && elem[0:l] == "/raw" {
+										if l := len("blocks"); len(elem) >= l && elem[0:l] == "blocks" {
 											elem = elem[l:]
 										} else {
 											break
@@ -1038,7 +1436,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 											// Leaf node.
 											switch r.Method {
 											case "GET":
-												s.handleGetRawBlockchainConfigFromBlockRequest([1]string{
+												s.handleGetBlockchainMasterchainBlocksRequest([1]string{
 													args[0],
 												}, elemIsEscaped, w, r)
 											default:
@@ -1049,280 +1447,221 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										}
 
 										elem = origElem
-									}
+									case 'c': // Prefix: "config"
+										origElem := elem
+										if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-									elem = origElem
-								case 's': // Prefix: "shards"
-									origElem := elem
-									if l := len("shards"); len(elem) >= l && elem[0:l] == "shards" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleGetBlockchainConfigFromBlockRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetBlockchainMasterchainShardsRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
+											return
 										}
+										switch elem[0] {
+										case '/': // Prefix: "/raw"
+											origElem := elem
+											if l := len("/raw"); len(elem) >= l && elem[0:l] == "/raw" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-										return
-									}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetRawBlockchainConfigFromBlockRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
 
-									elem = origElem
-								case 't': // Prefix: "transactions"
-									origElem := elem
-									if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+												return
+											}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetBlockchainMasterchainTransactionsRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
+											elem = origElem
 										}
 
-										return
-									}
-
-									elem = origElem
-								}
+										elem = origElem
+									case 's': // Prefix: "shards"
+										origElem := elem
+										if l := len("shards"); len(elem) >= l && elem[0:l] == "shards" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-								elem = origElem
-							}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetBlockchainMasterchainShardsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-							elem = origElem
-						}
+											return
+										}
 
-						elem = origElem
-					case 'e': // Prefix: "essage"
-						origElem := elem
-						if l := len("essage"); len(elem) >= l && elem[0:l] == "essage" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										elem = origElem
+									case 't': // Prefix: "transactions"
+										origElem := elem
+										if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-						if len(elem) == 0 {
-							switch r.Method {
-							case "POST":
-								s.handleSendBlockchainMessageRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetBlockchainMasterchainTransactionsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
 							}
 
-							return
-						}
-						switch elem[0] {
-						case 's': // Prefix: "s/"
+							elem = origElem
+						case 'e': // Prefix: "essage"
 							origElem := elem
-							if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
+							if l := len("essage"); len(elem) >= l && elem[0:l] == "essage" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "msg_id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
-
 							if len(elem) == 0 {
-								break
+								switch r.Method {
+								case "POST":
+									s.handleSendBlockchainMessageRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/transaction"
+							case 's': // Prefix: "s/"
 								origElem := elem
-								if l := len("/transaction"); len(elem) >= l && elem[0:l] == "/transaction" {
+								if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetBlockchainTransactionByMessageHashRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
-
-									return
+								// Param: "msg_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
 								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
-								elem = origElem
-							}
-
-							elem = origElem
-						}
-
-						elem = origElem
-					}
-
-					elem = origElem
-				case 'r': // Prefix: "reduced/blocks"
-					origElem := elem
-					if l := len("reduced/blocks"); len(elem) >= l && elem[0:l] == "reduced/blocks" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetReducedBlockchainBlocksRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
-					}
-
-					elem = origElem
-				case 't': // Prefix: "transactions/"
-					origElem := elem
-					if l := len("transactions/"); len(elem) >= l && elem[0:l] == "transactions/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					// Param: "transaction_id"
-					// Leaf parameter
-					args[0] = elem
-					elem = ""
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'r': // Prefix: "resolve"
+										origElem := elem
+										if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetBlockchainTransactionRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleResolveMessageHashRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-						return
-					}
+											return
+										}
 
-					elem = origElem
-				case 'v': // Prefix: "validators"
-					origElem := elem
-					if l := len("validators"); len(elem) >= l && elem[0:l] == "validators" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										elem = origElem
+									case 't': // Prefix: "transaction"
+										origElem := elem
+										if l := len("transaction"); len(elem) >= l && elem[0:l] == "transaction" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetBlockchainValidatorsRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetBlockchainTransactionByMessageHashRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-						return
-					}
+											return
+										}
 
-					elem = origElem
-				}
+										elem = origElem
+									}
 
-				elem = origElem
-			case 'd': // Prefix: "dns/"
-				origElem := elem
-				if l := len("dns/"); len(elem) >= l && elem[0:l] == "dns/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+									elem = origElem
+								}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "auctions"
-					origElem := elem
-					if l := len("auctions"); len(elem) >= l && elem[0:l] == "auctions" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								elem = origElem
+							}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetAllAuctionsRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
+							elem = origElem
 						}
 
-						return
-					}
-
-					elem = origElem
-				}
-				// Param: "domain_name"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
-				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleGetDnsInfoRequest([1]string{
-							args[0],
-						}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
-
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
-					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'b': // Prefix: "bids"
+						elem = origElem
+					case 'r': // Prefix: "reduced/blocks"
 						origElem := elem
-						if l := len("bids"); len(elem) >= l && elem[0:l] == "bids" {
+						if l := len("reduced/blocks"); len(elem) >= l && elem[0:l] == "reduced/blocks" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1332,9 +1671,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleGetDomainBidsRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+								s.handleGetReducedBlockchainBlocksRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "GET")
 							}
@@ -1343,50 +1680,139 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						elem = origElem
-					case 'r': // Prefix: "resolve"
+					case 's': // Prefix: "system/"
 						origElem := elem
-						if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+						if l := len("system/"); len(elem) >= l && elem[0:l] == "system/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleDnsResolveRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "config"
+							origElem := elem
+							if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
-						}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetBlockchainConfigContractRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'e': // Prefix: "elector"
+							origElem := elem
+							if l := len("elector"); len(elem) >= l && elem[0:l] == "elector" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetBlockchainElectorRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'm': // Prefix: "minter"
+							origElem := elem
+							if l := len("minter"); len(elem) >= l && elem[0:l] == "minter" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetBlockchainMinterRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
 
 						elem = origElem
-					}
+					case 't': // Prefix: "transactions/"
+						origElem := elem
+						if l := len("transactions/"); len(elem) >= l && elem[0:l] == "transactions/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					elem = origElem
-				}
+						// Param: "transaction_id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
 
-				elem = origElem
-			case 'e': // Prefix: "e"
-				origElem := elem
-				if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetBlockchainTransactionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'v': // Prefix: "vents/"
+							return
+						}
+
+						elem = origElem
+					case 'v': // Prefix: "validators"
+						origElem := elem
+						if l := len("validators"); len(elem) >= l && elem[0:l] == "validators" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetBlockchainValidatorsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'o': // Prefix: "oc/"
 					origElem := elem
-					if l := len("vents/"); len(elem) >= l && elem[0:l] == "vents/" {
+					if l := len("oc/"); len(elem) >= l && elem[0:l] == "oc/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1396,9 +1822,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'e': // Prefix: "emulate"
+					case 'c': // Prefix: "contract"
 						origElem := elem
-						if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
+						if l := len("contract"); len(elem) >= l && elem[0:l] == "contract" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1408,7 +1834,70 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleEmulateMessageToEventRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleInspectContractCodeRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 'h': // Prefix: "hash"
+						origElem := elem
+						if l := len("hash"); len(elem) >= l && elem[0:l] == "hash" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleGetBocHashRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 'i': // Prefix: "inspect"
+						origElem := elem
+						if l := len("inspect"); len(elem) >= l && elem[0:l] == "inspect" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleInspectBocRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 's': // Prefix: "stateinit"
+						origElem := elem
+						if l := len("stateinit"); len(elem) >= l && elem[0:l] == "stateinit" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleParseStateInitRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
@@ -1418,45 +1907,66 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						elem = origElem
 					}
-					// Param: "event_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'd': // Prefix: "d"
+				origElem := elem
+				if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "eposits"
+					origElem := elem
+					if l := len("eposits"); len(elem) >= l && elem[0:l] == "eposits" {
+						elem = elem[l:]
+					} else {
+						break
 					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
 
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleGetEventRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleGetExpectedDepositsRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleAddExpectedDepositRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "GET,POST")
 						}
 
 						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/jettons"
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("/jettons"); len(elem) >= l && elem[0:l] == "/jettons" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
 						if len(elem) == 0 {
 							// Leaf node.
 							switch r.Method {
-							case "GET":
-								s.handleGetJettonsEventsRequest([1]string{
+							case "DELETE":
+								s.handleDeleteExpectedDepositRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, "DELETE")
 							}
 
 							return
@@ -1466,9 +1976,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					elem = origElem
-				case 'x': // Prefix: "xperimental/"
+				case 'n': // Prefix: "ns/"
 					origElem := elem
-					if l := len("xperimental/"); len(elem) >= l && elem[0:l] == "xperimental/" {
+					if l := len("ns/"); len(elem) >= l && elem[0:l] == "ns/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1478,22 +1988,285 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'a': // Prefix: "accounts/"
+					case 'a': // Prefix: "auctions"
 						origElem := elem
-						if l := len("accounts/"); len(elem) >= l && elem[0:l] == "accounts/" {
+						if l := len("auctions"); len(elem) >= l && elem[0:l] == "auctions" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "account_id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetAllAuctionsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+					// Param: "domain_name"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetDnsInfoRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
 						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'b': // Prefix: "bids"
+							origElem := elem
+							if l := len("bids"); len(elem) >= l && elem[0:l] == "bids" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetDomainBidsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "resolve"
+							origElem := elem
+							if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleDnsResolveRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'e': // Prefix: "e"
+				origElem := elem
+				if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'v': // Prefix: "vents/"
+					origElem := elem
+					if l := len("vents/"); len(elem) >= l && elem[0:l] == "vents/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "emulate"
+						origElem := elem
+						if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleEmulateMessageToEventRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+					// Param: "event_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetEventRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "annotation"
+							origElem := elem
+							if l := len("annotation"); len(elem) >= l && elem[0:l] == "annotation" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteEventAnnotationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleGetEventAnnotationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleSetEventAnnotationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'j': // Prefix: "jettons"
+							origElem := elem
+							if l := len("jettons"); len(elem) >= l && elem[0:l] == "jettons" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetJettonsEventsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'x': // Prefix: "xperimental/"
+					origElem := elem
+					if l := len("xperimental/"); len(elem) >= l && elem[0:l] == "xperimental/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "accounts/"
+						origElem := elem
+						if l := len("accounts/"); len(elem) >= l && elem[0:l] == "accounts/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "account_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
 						if len(elem) == 0 {
 							break
@@ -1741,6 +2514,32 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 't': // Prefix: "top"
+						origElem := elem
+						if l := len("top"); len(elem) >= l && elem[0:l] == "top" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetTopJettonsByVolumeRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
 					// Param: "account_id"
 					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
@@ -1855,9 +2654,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				elem = origElem
-			case 'l': // Prefix: "liteserver/"
+			case 'l': // Prefix: "l"
 				origElem := elem
-				if l := len("liteserver/"); len(elem) >= l && elem[0:l] == "liteserver/" {
+				if l := len("l"); len(elem) >= l && elem[0:l] == "l" {
 					elem = elem[l:]
 				} else {
 					break
@@ -1867,92 +2666,79 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'g': // Prefix: "get_"
+				case 'a': // Prefix: "abels"
 					origElem := elem
-					if l := len("get_"); len(elem) >= l && elem[0:l] == "get_" {
+					if l := len("abels"); len(elem) >= l && elem[0:l] == "abels" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						break
+						switch r.Method {
+						case "GET":
+							s.handleGetPrivateLabelsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
 					}
 					switch elem[0] {
-					case 'a': // Prefix: "a"
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "account_id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
 						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'c': // Prefix: "ccount_state/"
-							origElem := elem
-							if l := len("ccount_state/"); len(elem) >= l && elem[0:l] == "ccount_state/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "account_id"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetRawAccountStateRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-							elem = origElem
-						case 'l': // Prefix: "ll_shards_info/"
-							origElem := elem
-							if l := len("ll_shards_info/"); len(elem) >= l && elem[0:l] == "ll_shards_info/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "block_id"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetAllRawShardsInfoRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeletePrivateLabelRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetPrivateLabelRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleSetPrivateLabelRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
 							}
 
-							elem = origElem
+							return
 						}
 
 						elem = origElem
-					case 'b': // Prefix: "block"
+					}
+
+					elem = origElem
+				case 'i': // Prefix: "iteserver/"
+					origElem := elem
+					if l := len("iteserver/"); len(elem) >= l && elem[0:l] == "iteserver/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'g': // Prefix: "get_"
 						origElem := elem
-						if l := len("block"); len(elem) >= l && elem[0:l] == "block" {
+						if l := len("get_"); len(elem) >= l && elem[0:l] == "get_" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1962,37 +2748,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
-							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "block_id"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetRawBlockchainBlockRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-							elem = origElem
-						case '_': // Prefix: "_"
+						case 'a': // Prefix: "a"
 							origElem := elem
-							if l := len("_"); len(elem) >= l && elem[0:l] == "_" {
+							if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2002,15 +2760,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'h': // Prefix: "header/"
+							case 'c': // Prefix: "ccount_state/"
 								origElem := elem
-								if l := len("header/"); len(elem) >= l && elem[0:l] == "header/" {
+								if l := len("ccount_state/"); len(elem) >= l && elem[0:l] == "ccount_state/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "block_id"
+								// Param: "account_id"
 								// Leaf parameter
 								args[0] = elem
 								elem = ""
@@ -2019,7 +2777,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetRawBlockchainBlockHeaderRequest([1]string{
+										s.handleGetRawAccountStateRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
@@ -2030,19 +2788,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								}
 
 								elem = origElem
-							case 'p': // Prefix: "proof"
+							case 'l': // Prefix: "ll_shards_info/"
 								origElem := elem
-								if l := len("proof"); len(elem) >= l && elem[0:l] == "proof" {
+								if l := len("ll_shards_info/"); len(elem) >= l && elem[0:l] == "ll_shards_info/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
+								// Param: "block_id"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
+
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetRawBlockProofRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleGetAllRawShardsInfoRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "GET")
 									}
@@ -2054,116 +2819,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						}
-
-						elem = origElem
-					case 'c': // Prefix: "config_all/"
-						origElem := elem
-						if l := len("config_all/"); len(elem) >= l && elem[0:l] == "config_all/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "block_id"
-						// Leaf parameter
-						args[0] = elem
-						elem = ""
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetRawConfigRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
-
-						elem = origElem
-					case 'm': // Prefix: "masterchain_info"
-						origElem := elem
-						if l := len("masterchain_info"); len(elem) >= l && elem[0:l] == "masterchain_info" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleGetRawMasterchainInfoRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
-						switch elem[0] {
-						case '_': // Prefix: "_ext"
-							origElem := elem
-							if l := len("_ext"); len(elem) >= l && elem[0:l] == "_ext" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetRawMasterchainInfoExtRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-							elem = origElem
-						}
-
-						elem = origElem
-					case 'o': // Prefix: "out_msg_queue_sizes"
-						origElem := elem
-						if l := len("out_msg_queue_sizes"); len(elem) >= l && elem[0:l] == "out_msg_queue_sizes" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetOutMsgQueueSizesRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
-
-						elem = origElem
-					case 's': // Prefix: "s"
-						origElem := elem
-						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'h': // Prefix: "hard_"
+						case 'b': // Prefix: "block"
 							origElem := elem
-							if l := len("hard_"); len(elem) >= l && elem[0:l] == "hard_" {
+							if l := len("block"); len(elem) >= l && elem[0:l] == "block" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2173,9 +2831,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'b': // Prefix: "block_proof/"
+							case '/': // Prefix: "/"
 								origElem := elem
-								if l := len("block_proof/"); len(elem) >= l && elem[0:l] == "block_proof/" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
@@ -2190,7 +2848,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetRawShardBlockProofRequest([1]string{
+										s.handleGetRawBlockchainBlockRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
@@ -2201,40 +2859,76 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								}
 
 								elem = origElem
-							case 'i': // Prefix: "info/"
+							case '_': // Prefix: "_"
 								origElem := elem
-								if l := len("info/"); len(elem) >= l && elem[0:l] == "info/" {
+								if l := len("_"); len(elem) >= l && elem[0:l] == "_" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "block_id"
-								// Leaf parameter
-								args[0] = elem
-								elem = ""
-
 								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetRawShardInfoRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+									break
+								}
+								switch elem[0] {
+								case 'h': // Prefix: "header/"
+									origElem := elem
+									if l := len("header/"); len(elem) >= l && elem[0:l] == "header/" {
+										elem = elem[l:]
+									} else {
+										break
 									}
 
-									return
+									// Param: "block_id"
+									// Leaf parameter
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetRawBlockchainBlockHeaderRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 'p': // Prefix: "proof"
+									origElem := elem
+									if l := len("proof"); len(elem) >= l && elem[0:l] == "proof" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetRawBlockProofRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+									elem = origElem
 								}
 
 								elem = origElem
 							}
 
 							elem = origElem
-						case 't': // Prefix: "tate/"
+						case 'c': // Prefix: "config_all/"
 							origElem := elem
-							if l := len("tate/"); len(elem) >= l && elem[0:l] == "tate/" {
+							if l := len("config_all/"); len(elem) >= l && elem[0:l] == "config_all/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2249,7 +2943,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetRawBlockchainBlockStateRequest([1]string{
+									s.handleGetRawConfigRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
@@ -2260,62 +2954,62 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						}
-
-						elem = origElem
-					case 't': // Prefix: "t"
-						origElem := elem
-						if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'i': // Prefix: "ime"
+						case 'm': // Prefix: "masterchain_info"
 							origElem := elem
-							if l := len("ime"); len(elem) >= l && elem[0:l] == "ime" {
+							if l := len("masterchain_info"); len(elem) >= l && elem[0:l] == "masterchain_info" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetRawTimeRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleGetRawMasterchainInfoRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
+							switch elem[0] {
+							case '_': // Prefix: "_ext"
+								origElem := elem
+								if l := len("_ext"); len(elem) >= l && elem[0:l] == "_ext" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRawMasterchainInfoExtRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
 
 							elem = origElem
-						case 'r': // Prefix: "ransactions/"
+						case 'o': // Prefix: "out_msg_queue_sizes"
 							origElem := elem
-							if l := len("ransactions/"); len(elem) >= l && elem[0:l] == "ransactions/" {
+							if l := len("out_msg_queue_sizes"); len(elem) >= l && elem[0:l] == "out_msg_queue_sizes" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "account_id"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
-
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetRawTransactionsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+									s.handleGetOutMsgQueueSizesRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "GET")
 								}
@@ -2324,151 +3018,365 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						}
-
-						elem = origElem
-					}
+						case 's': // Prefix: "s"
+							origElem := elem
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					elem = origElem
-				case 'l': // Prefix: "list_block_transactions/"
-					origElem := elem
-					if l := len("list_block_transactions/"); len(elem) >= l && elem[0:l] == "list_block_transactions/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'h': // Prefix: "hard_"
+								origElem := elem
+								if l := len("hard_"); len(elem) >= l && elem[0:l] == "hard_" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					// Param: "block_id"
-					// Leaf parameter
-					args[0] = elem
-					elem = ""
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "block_proof/"
+									origElem := elem
+									if l := len("block_proof/"); len(elem) >= l && elem[0:l] == "block_proof/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetRawListBlockTransactionsRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+									// Param: "block_id"
+									// Leaf parameter
+									args[0] = elem
+									elem = ""
 
-						return
-					}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetRawShardBlockProofRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-					elem = origElem
-				case 's': // Prefix: "send_message"
-					origElem := elem
-					if l := len("send_message"); len(elem) >= l && elem[0:l] == "send_message" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										return
+									}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleSendRawMessageRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
-						}
+									elem = origElem
+								case 'i': // Prefix: "info/"
+									origElem := elem
+									if l := len("info/"); len(elem) >= l && elem[0:l] == "info/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						return
-					}
+									// Param: "block_id"
+									// Leaf parameter
+									args[0] = elem
+									elem = ""
 
-					elem = origElem
-				}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetRawShardInfoRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-				elem = origElem
-			case 'm': // Prefix: "m"
-				origElem := elem
-				if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										return
+									}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'e': // Prefix: "essage/decode"
-					origElem := elem
-					if l := len("essage/decode"); len(elem) >= l && elem[0:l] == "essage/decode" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									elem = origElem
+								}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleDecodeMessageRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
-						}
+								elem = origElem
+							case 't': // Prefix: "tate/"
+								origElem := elem
+								if l := len("tate/"); len(elem) >= l && elem[0:l] == "tate/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						return
-					}
+								// Param: "block_id"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
 
-					elem = origElem
-				case 'u': // Prefix: "ultisig/"
-					origElem := elem
-					if l := len("ultisig/"); len(elem) >= l && elem[0:l] == "ultisig/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRawBlockchainBlockStateRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-					// Param: "account_id"
-					// Leaf parameter
-					args[0] = elem
-					elem = ""
+									return
+								}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetMultisigAccountRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+								elem = origElem
+							}
 
-						return
-					}
+							elem = origElem
+						case 't': // Prefix: "t"
+							origElem := elem
+							if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					elem = origElem
-				}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'i': // Prefix: "ime"
+								origElem := elem
+								if l := len("ime"); len(elem) >= l && elem[0:l] == "ime" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-				elem = origElem
-			case 'n': // Prefix: "nfts/"
-				origElem := elem
-				if l := len("nfts/"); len(elem) >= l && elem[0:l] == "nfts/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRawTimeRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case '_': // Prefix: "_bulk"
-					origElem := elem
-					if l := len("_bulk"); len(elem) >= l && elem[0:l] == "_bulk" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									return
+								}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
+								elem = origElem
+							case 'r': // Prefix: "ransactions/"
+								origElem := elem
+								if l := len("ransactions/"); len(elem) >= l && elem[0:l] == "ransactions/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "account_id"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRawTransactionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 'l': // Prefix: "list_block_transactions/"
+						origElem := elem
+						if l := len("list_block_transactions/"); len(elem) >= l && elem[0:l] == "list_block_transactions/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "block_id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetRawListBlockTransactionsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 's': // Prefix: "send_message"
+						origElem := elem
+						if l := len("send_message"); len(elem) >= l && elem[0:l] == "send_message" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleSendRawMessageRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'm': // Prefix: "m"
+				origElem := elem
+				if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "essage"
+					origElem := elem
+					if l := len("essage"); len(elem) >= l && elem[0:l] == "essage" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/decode"
+						origElem := elem
+						if l := len("/decode"); len(elem) >= l && elem[0:l] == "/decode" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleDecodeMessageRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 's': // Prefix: "s/search"
+						origElem := elem
+						if l := len("s/search"); len(elem) >= l && elem[0:l] == "s/search" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleSearchMessagesRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'u': // Prefix: "ultisig/"
+					origElem := elem
+					if l := len("ultisig/"); len(elem) >= l && elem[0:l] == "ultisig/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "account_id"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetMultisigAccountRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'n': // Prefix: "nfts/"
+				origElem := elem
+				if l := len("nfts/"); len(elem) >= l && elem[0:l] == "nfts/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '_': // Prefix: "_bulk"
+					origElem := elem
+					if l := len("_bulk"); len(elem) >= l && elem[0:l] == "_bulk" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
 						case "POST":
 							s.handleGetNftItemsByAddressesRequest([0]string{}, elemIsEscaped, w, r)
 						default:
@@ -2580,26 +3488,64 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					return
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/history"
+				case '/': // Prefix: "/"
 					origElem := elem
-					if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetNftHistoryByIDRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
+						break
+					}
+					switch elem[0] {
+					case 'b': // Prefix: "bids"
+						origElem := elem
+						if l := len("bids"); len(elem) >= l && elem[0:l] == "bids" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetNftItemBidsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 'h': // Prefix: "history"
+						origElem := elem
+						if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetNftHistoryByIDRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
 					}
 
 					elem = origElem
@@ -2636,7 +3582,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "GET":
 							s.handleGetWalletsByPublicKeyRequest([1]string{
@@ -2648,6 +3593,31 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/recover"
+						origElem := elem
+						if l := len("/recover"); len(elem) >= l && elem[0:l] == "/recover" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleRecoverWalletsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
 
 					elem = origElem
 				}
@@ -2910,24 +3880,60 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						elem = origElem
-					case 't': // Prefix: "tus"
+					case 't': // Prefix: "t"
 						origElem := elem
-						if l := len("tus"); len(elem) >= l && elem[0:l] == "tus" {
+						if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleStatusRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+							break
+						}
+						switch elem[0] {
+						case 's': // Prefix: "s/network"
+							origElem := elem
+							if l := len("s/network"); len(elem) >= l && elem[0:l] == "s/network" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetNetworkStatsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'u': // Prefix: "us"
+							origElem := elem
+							if l := len("us"); len(elem) >= l && elem[0:l] == "us" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleStatusRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
 						}
 
 						elem = origElem
@@ -3084,9 +4090,30 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				elem = origElem
-			case 'w': // Prefix: "wallet/"
+			case 'u': // Prefix: "usage"
+				origElem := elem
+				if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetUsageRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+				elem = origElem
+			case 'w': // Prefix: "w"
 				origElem := elem
-				if l := len("wallet/"); len(elem) >= l && elem[0:l] == "wallet/" {
+				if l := len("w"); len(elem) >= l && elem[0:l] == "w" {
 					elem = elem[l:]
 				} else {
 					break
@@ -3096,114 +4123,247 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'a': // Prefix: "auth/proof"
+				case 'a': // Prefix: "allet/"
 					origElem := elem
-					if l := len("auth/proof"); len(elem) >= l && elem[0:l] == "auth/proof" {
+					if l := len("allet/"); len(elem) >= l && elem[0:l] == "allet/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleTonConnectProofRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "auth/proof"
+						origElem := elem
+						if l := len("auth/proof"); len(elem) >= l && elem[0:l] == "auth/proof" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleTonConnectProofRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
 
-					elem = origElem
-				case 'b': // Prefix: "backup"
-					origElem := elem
-					if l := len("backup"); len(elem) >= l && elem[0:l] == "backup" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							return
+						}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetWalletBackupRequest([0]string{}, elemIsEscaped, w, r)
-						case "PUT":
-							s.handleSetWalletBackupRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET,PUT")
+						elem = origElem
+					case 'b': // Prefix: "backup"
+						origElem := elem
+						if l := len("backup"); len(elem) >= l && elem[0:l] == "backup" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetWalletBackupRequest([0]string{}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleSetWalletBackupRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 'e': // Prefix: "emulate"
+						origElem := elem
+						if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleEmulateMessageToWalletRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+					// Param: "account_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
 					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
-					elem = origElem
-				case 'e': // Prefix: "emulate"
-					origElem := elem
-					if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
-						elem = elem[l:]
-					} else {
+					if len(elem) == 0 {
 						break
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleEmulateMessageToWalletRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
+						if len(elem) == 0 {
+							break
 						}
+						switch elem[0] {
+						case 's': // Prefix: "seqno"
+							origElem := elem
+							if l := len("seqno"); len(elem) >= l && elem[0:l] == "seqno" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-						return
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetAccountSeqnoRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 't': // Prefix: "transfer/build"
+							origElem := elem
+							if l := len("transfer/build"); len(elem) >= l && elem[0:l] == "transfer/build" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleBuildWalletTransferRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
 					}
 
 					elem = origElem
-				}
-				// Param: "account_id"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/seqno"
+				case 'e': // Prefix: "ebhooks"
 					origElem := elem
-					if l := len("/seqno"); len(elem) >= l && elem[0:l] == "/seqno" {
+					if l := len("ebhooks"); len(elem) >= l && elem[0:l] == "ebhooks" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "GET":
-							s.handleGetAccountSeqnoRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleGetWebhooksRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleAddWebhookRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "GET,POST")
 						}
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					elem = origElem
-				}
-
-				elem = origElem
-			}
-
-			elem = origElem
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteWebhookRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/rotate-secret"
+							origElem := elem
+							if l := len("/rotate-secret"); len(elem) >= l && elem[0:l] == "/rotate-secret" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRotateWebhookSecretRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			}
+
+			elem = origElem
 		}
 	}
 	s.notFound(w, r)
@@ -3369,6 +4529,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+						elem = origElem
+					case 't': // Prefix: "top"
+						origElem := elem
+						if l := len("top"); len(elem) >= l && elem[0:l] == "top" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetTopAccounts
+								r.name = "GetTopAccounts"
+								r.summary = ""
+								r.operationID = "getTopAccounts"
+								r.pathPattern = "/v2/accounts/top"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 						elem = origElem
 					}
 					// Param: "account_id"
@@ -3395,21 +4580,903 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "activity"
+							origElem := elem
+							if l := len("activity"); len(elem) >= l && elem[0:l] == "activity" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetAccountActivity
+									r.name = "GetAccountActivity"
+									r.summary = ""
+									r.operationID = "getAccountActivity"
+									r.pathPattern = "/v2/accounts/{account_id}/activity"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'b': // Prefix: "bounce-check"
+							origElem := elem
+							if l := len("bounce-check"); len(elem) >= l && elem[0:l] == "bounce-check" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: CheckAccountBounce
+									r.name = "CheckAccountBounce"
+									r.summary = ""
+									r.operationID = "checkAccountBounce"
+									r.pathPattern = "/v2/accounts/{account_id}/bounce-check"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'c': // Prefix: "code-history"
+							origElem := elem
+							if l := len("code-history"); len(elem) >= l && elem[0:l] == "code-history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetAccountCodeHistory
+									r.name = "GetAccountCodeHistory"
+									r.summary = ""
+									r.operationID = "getAccountCodeHistory"
+									r.pathPattern = "/v2/accounts/{account_id}/code-history"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'd': // Prefix: "d"
+							origElem := elem
+							if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'i': // Prefix: "iff"
+								origElem := elem
+								if l := len("iff"); len(elem) >= l && elem[0:l] == "iff" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetAccountDiff
+										r.name = "GetAccountDiff"
+										r.summary = ""
+										r.operationID = "getAccountDiff"
+										r.pathPattern = "/v2/accounts/{account_id}/diff"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'n': // Prefix: "ns/"
+								origElem := elem
+								if l := len("ns/"); len(elem) >= l && elem[0:l] == "ns/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "backresolve"
+									origElem := elem
+									if l := len("backresolve"); len(elem) >= l && elem[0:l] == "backresolve" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: AccountDnsBackResolve
+											r.name = "AccountDnsBackResolve"
+											r.summary = ""
+											r.operationID = "accountDnsBackResolve"
+											r.pathPattern = "/v2/accounts/{account_id}/dns/backresolve"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'e': // Prefix: "expiring"
+									origElem := elem
+									if l := len("expiring"); len(elem) >= l && elem[0:l] == "expiring" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetAccountDnsExpiring
+											r.name = "GetAccountDnsExpiring"
+											r.summary = ""
+											r.operationID = "getAccountDnsExpiring"
+											r.pathPattern = "/v2/accounts/{account_id}/dns/expiring"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'e': // Prefix: "events"
+							origElem := elem
+							if l := len("events"); len(elem) >= l && elem[0:l] == "events" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = "GetAccountEvents"
+									r.summary = ""
+									r.operationID = "getAccountEvents"
+									r.pathPattern = "/v2/accounts/{account_id}/events"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'd': // Prefix: "delta"
+									origElem := elem
+									if l := len("delta"); len(elem) >= l && elem[0:l] == "delta" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetAccountEventsDelta
+											r.name = "GetAccountEventsDelta"
+											r.summary = ""
+											r.operationID = "getAccountEventsDelta"
+											r.pathPattern = "/v2/accounts/{account_id}/events/delta"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'e': // Prefix: "emulate"
+									origElem := elem
+									if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											// Leaf: EmulateMessageToAccountEvent
+											r.name = "EmulateMessageToAccountEvent"
+											r.summary = ""
+											r.operationID = "emulateMessageToAccountEvent"
+											r.pathPattern = "/v2/accounts/{account_id}/events/emulate"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+								// Param: "event_id"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetAccountEvent
+										r.name = "GetAccountEvent"
+										r.summary = ""
+										r.operationID = "getAccountEvent"
+										r.pathPattern = "/v2/accounts/{account_id}/events/{event_id}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'h': // Prefix: "heatmap"
+							origElem := elem
+							if l := len("heatmap"); len(elem) >= l && elem[0:l] == "heatmap" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetAccountActivityHeatmap
+									r.name = "GetAccountActivityHeatmap"
+									r.summary = ""
+									r.operationID = "getAccountActivityHeatmap"
+									r.pathPattern = "/v2/accounts/{account_id}/heatmap"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'j': // Prefix: "jettons"
+							origElem := elem
+							if l := len("jettons"); len(elem) >= l && elem[0:l] == "jettons" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = "GetAccountJettonsBalances"
+									r.summary = ""
+									r.operationID = "getAccountJettonsBalances"
+									r.pathPattern = "/v2/accounts/{account_id}/jettons"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'h': // Prefix: "history"
+									origElem := elem
+									if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetAccountJettonsHistory
+											r.name = "GetAccountJettonsHistory"
+											r.summary = ""
+											r.operationID = "getAccountJettonsHistory"
+											r.pathPattern = "/v2/accounts/{account_id}/jettons/history"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+								// Param: "jetton_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = "GetAccountJettonBalance"
+										r.summary = ""
+										r.operationID = "getAccountJettonBalance"
+										r.pathPattern = "/v2/accounts/{account_id}/jettons/{jetton_id}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/history"
+									origElem := elem
+									if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetAccountJettonHistoryByID
+											r.name = "GetAccountJettonHistoryByID"
+											r.summary = ""
+											r.operationID = "getAccountJettonHistoryByID"
+											r.pathPattern = "/v2/accounts/{account_id}/jettons/{jetton_id}/history"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'm': // Prefix: "multisigs"
+							origElem := elem
+							if l := len("multisigs"); len(elem) >= l && elem[0:l] == "multisigs" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetAccountMultisigs
+									r.name = "GetAccountMultisigs"
+									r.summary = ""
+									r.operationID = "getAccountMultisigs"
+									r.pathPattern = "/v2/accounts/{account_id}/multisigs"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'n': // Prefix: "nfts"
+							origElem := elem
+							if l := len("nfts"); len(elem) >= l && elem[0:l] == "nfts" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = "GetAccountNftItems"
+									r.summary = ""
+									r.operationID = "getAccountNftItems"
+									r.pathPattern = "/v2/accounts/{account_id}/nfts"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/history"
+								origElem := elem
+								if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetAccountNftHistory
+										r.name = "GetAccountNftHistory"
+										r.summary = ""
+										r.operationID = "getAccountNftHistory"
+										r.pathPattern = "/v2/accounts/{account_id}/nfts/history"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'p': // Prefix: "publickey"
+							origElem := elem
+							if l := len("publickey"); len(elem) >= l && elem[0:l] == "publickey" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetAccountPublicKey
+									r.name = "GetAccountPublicKey"
+									r.summary = ""
+									r.operationID = "getAccountPublicKey"
+									r.pathPattern = "/v2/accounts/{account_id}/publickey"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "reindex"
+							origElem := elem
+							if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: ReindexAccount
+									r.name = "ReindexAccount"
+									r.summary = ""
+									r.operationID = "reindexAccount"
+									r.pathPattern = "/v2/accounts/{account_id}/reindex"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 's': // Prefix: "s"
+							origElem := elem
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 't': // Prefix: "t"
+								origElem := elem
+								if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "at"
+									origElem := elem
+									if l := len("at"); len(elem) >= l && elem[0:l] == "at" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'e': // Prefix: "e-diff"
+										origElem := elem
+										if l := len("e-diff"); len(elem) >= l && elem[0:l] == "e-diff" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												// Leaf: GetAccountStateDiff
+												r.name = "GetAccountStateDiff"
+												r.summary = ""
+												r.operationID = "getAccountStateDiff"
+												r.pathPattern = "/v2/accounts/{account_id}/state-diff"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									case 's': // Prefix: "s"
+										origElem := elem
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												// Leaf: GetAccountStats
+												r.name = "GetAccountStats"
+												r.summary = ""
+												r.operationID = "getAccountStats"
+												r.pathPattern = "/v2/accounts/{account_id}/stats"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								case 'o': // Prefix: "orage-rent"
+									origElem := elem
+									if l := len("orage-rent"); len(elem) >= l && elem[0:l] == "orage-rent" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetAccountStorageRent
+											r.name = "GetAccountStorageRent"
+											r.summary = ""
+											r.operationID = "getAccountStorageRent"
+											r.pathPattern = "/v2/accounts/{account_id}/storage-rent"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'u': // Prefix: "ubscriptions"
+								origElem := elem
+								if l := len("ubscriptions"); len(elem) >= l && elem[0:l] == "ubscriptions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetAccountSubscriptions
+										r.name = "GetAccountSubscriptions"
+										r.summary = ""
+										r.operationID = "getAccountSubscriptions"
+										r.pathPattern = "/v2/accounts/{account_id}/subscriptions"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 't': // Prefix: "tra"
+							origElem := elem
+							if l := len("tra"); len(elem) >= l && elem[0:l] == "tra" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "ces"
+								origElem := elem
+								if l := len("ces"); len(elem) >= l && elem[0:l] == "ces" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetAccountTraces
+										r.name = "GetAccountTraces"
+										r.summary = ""
+										r.operationID = "getAccountTraces"
+										r.pathPattern = "/v2/accounts/{account_id}/traces"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'n': // Prefix: "nsactions/simulate"
+								origElem := elem
+								if l := len("nsactions/simulate"); len(elem) >= l && elem[0:l] == "nsactions/simulate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										// Leaf: SimulateAccountTransaction
+										r.name = "SimulateAccountTransaction"
+										r.summary = ""
+										r.operationID = "simulateAccountTransaction"
+										r.pathPattern = "/v2/accounts/{account_id}/transactions/simulate"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'd': // Prefix: "ddress/"
+					origElem := elem
+					if l := len("ddress/"); len(elem) >= l && elem[0:l] == "ddress/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "account_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/parse"
+						origElem := elem
+						if l := len("/parse"); len(elem) >= l && elem[0:l] == "/parse" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: AddressParse
+								r.name = "AddressParse"
+								r.summary = ""
+								r.operationID = "addressParse"
+								r.pathPattern = "/v2/address/{account_id}/parse"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'b': // Prefix: "b"
+				origElem := elem
+				if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'l': // Prefix: "lockchain/"
+					origElem := elem
+					if l := len("lockchain/"); len(elem) >= l && elem[0:l] == "lockchain/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "accounts/"
 						origElem := elem
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("accounts/"); len(elem) >= l && elem[0:l] == "accounts/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "account_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							break
+							switch method {
+							case "GET":
+								r.name = "GetBlockchainRawAccount"
+								r.summary = ""
+								r.operationID = "getBlockchainRawAccount"
+								r.pathPattern = "/v2/blockchain/accounts/{account_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
 						switch elem[0] {
-						case 'd': // Prefix: "d"
+						case '/': // Prefix: "/"
 							origElem := elem
-							if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -3419,9 +5486,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'i': // Prefix: "iff"
+							case 'i': // Prefix: "inspect"
 								origElem := elem
-								if l := len("iff"); len(elem) >= l && elem[0:l] == "iff" {
+								if l := len("inspect"); len(elem) >= l && elem[0:l] == "inspect" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3430,11 +5497,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										// Leaf: GetAccountDiff
-										r.name = "GetAccountDiff"
+										// Leaf: BlockchainAccountInspect
+										r.name = "BlockchainAccountInspect"
 										r.summary = ""
-										r.operationID = "getAccountDiff"
-										r.pathPattern = "/v2/accounts/{account_id}/diff"
+										r.operationID = "blockchainAccountInspect"
+										r.pathPattern = "/v2/blockchain/accounts/{account_id}/inspect"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -3444,77 +5511,127 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 
 								elem = origElem
-							case 'n': // Prefix: "ns/"
+							case 'l': // Prefix: "logs"
 								origElem := elem
-								if l := len("ns/"); len(elem) >= l && elem[0:l] == "ns/" {
+								if l := len("logs"); len(elem) >= l && elem[0:l] == "logs" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetBlockchainAccountLogs
+										r.name = "GetBlockchainAccountLogs"
+										r.summary = ""
+										r.operationID = "getBlockchainAccountLogs"
+										r.pathPattern = "/v2/blockchain/accounts/{account_id}/logs"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'm': // Prefix: "methods/"
+								origElem := elem
+								if l := len("methods/"); len(elem) >= l && elem[0:l] == "methods/" {
+									elem = elem[l:]
+								} else {
 									break
 								}
-								switch elem[0] {
-								case 'b': // Prefix: "backresolve"
-									origElem := elem
-									if l := len("backresolve"); len(elem) >= l && elem[0:l] == "backresolve" {
-										elem = elem[l:]
-									} else {
-										break
-									}
 
-									if len(elem) == 0 {
-										switch method {
-										case "GET":
-											// Leaf: AccountDnsBackResolve
-											r.name = "AccountDnsBackResolve"
-											r.summary = ""
-											r.operationID = "accountDnsBackResolve"
-											r.pathPattern = "/v2/accounts/{account_id}/dns/backresolve"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
+								// Param: "method_name"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
 
-									elem = origElem
-								case 'e': // Prefix: "expiring"
-									origElem := elem
-									if l := len("expiring"); len(elem) >= l && elem[0:l] == "expiring" {
-										elem = elem[l:]
-									} else {
-										break
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: ExecGetMethodForBlockchainAccount
+										r.name = "ExecGetMethodForBlockchainAccount"
+										r.summary = ""
+										r.operationID = "execGetMethodForBlockchainAccount"
+										r.pathPattern = "/v2/blockchain/accounts/{account_id}/methods/{method_name}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
 									}
+								}
 
-									if len(elem) == 0 {
-										switch method {
-										case "GET":
-											// Leaf: GetAccountDnsExpiring
-											r.name = "GetAccountDnsExpiring"
-											r.summary = ""
-											r.operationID = "getAccountDnsExpiring"
-											r.pathPattern = "/v2/accounts/{account_id}/dns/expiring"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
+								elem = origElem
+							case 't': // Prefix: "transactions"
+								origElem := elem
+								if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-									elem = origElem
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetBlockchainAccountTransactions
+										r.name = "GetBlockchainAccountTransactions"
+										r.summary = ""
+										r.operationID = "getBlockchainAccountTransactions"
+										r.pathPattern = "/v2/blockchain/accounts/{account_id}/transactions"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
 								}
 
 								elem = origElem
 							}
 
 							elem = origElem
-						case 'e': // Prefix: "events"
+						}
+
+						elem = origElem
+					case 'b': // Prefix: "blocks/"
+						origElem := elem
+						if l := len("blocks/"); len(elem) >= l && elem[0:l] == "blocks/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "block_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = "GetBlockchainBlock"
+								r.summary = ""
+								r.operationID = "getBlockchainBlock"
+								r.pathPattern = "/v2/blockchain/blocks/{block_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/transactions"
 							origElem := elem
-							if l := len("events"); len(elem) >= l && elem[0:l] == "events" {
+							if l := len("/transactions"); len(elem) >= l && elem[0:l] == "/transactions" {
 								elem = elem[l:]
 							} else {
 								break
@@ -3523,10 +5640,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									r.name = "GetAccountEvents"
+									// Leaf: GetBlockchainBlockTransactions
+									r.name = "GetBlockchainBlockTransactions"
 									r.summary = ""
-									r.operationID = "getAccountEvents"
-									r.pathPattern = "/v2/accounts/{account_id}/events"
+									r.operationID = "getBlockchainBlockTransactions"
+									r.pathPattern = "/v2/blockchain/blocks/{block_id}/transactions"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -3534,73 +5652,51 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									return
 								}
 							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-								origElem := elem
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
 
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'e': // Prefix: "emulate"
-									origElem := elem
-									if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							elem = origElem
+						}
 
-									if len(elem) == 0 {
-										switch method {
-										case "POST":
-											// Leaf: EmulateMessageToAccountEvent
-											r.name = "EmulateMessageToAccountEvent"
-											r.summary = ""
-											r.operationID = "emulateMessageToAccountEvent"
-											r.pathPattern = "/v2/accounts/{account_id}/events/emulate"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
+						elem = origElem
+					case 'c': // Prefix: "c"
+						origElem := elem
+						if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-									elem = origElem
-								}
-								// Param: "event_id"
-								// Leaf parameter
-								args[1] = elem
-								elem = ""
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "alendar"
+							origElem := elem
+							if l := len("alendar"); len(elem) >= l && elem[0:l] == "alendar" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										// Leaf: GetAccountEvent
-										r.name = "GetAccountEvent"
-										r.summary = ""
-										r.operationID = "getAccountEvent"
-										r.pathPattern = "/v2/accounts/{account_id}/events/{event_id}"
-										r.args = args
-										r.count = 2
-										return r, true
-									default:
-										return
-									}
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetChainCalendar
+									r.name = "GetChainCalendar"
+									r.summary = ""
+									r.operationID = "getChainCalendar"
+									r.pathPattern = "/v2/blockchain/calendar"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
 								}
-
-								elem = origElem
 							}
 
 							elem = origElem
-						case 'j': // Prefix: "jettons"
+						case 'o': // Prefix: "onfig"
 							origElem := elem
-							if l := len("jettons"); len(elem) >= l && elem[0:l] == "jettons" {
+							if l := len("onfig"); len(elem) >= l && elem[0:l] == "onfig" {
 								elem = elem[l:]
 							} else {
 								break
@@ -3609,12 +5705,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									r.name = "GetAccountJettonsBalances"
+									r.name = "GetBlockchainConfig"
 									r.summary = ""
-									r.operationID = "getAccountJettonsBalances"
-									r.pathPattern = "/v2/accounts/{account_id}/jettons"
+									r.operationID = "getBlockchainConfig"
+									r.pathPattern = "/v2/blockchain/config"
 									r.args = args
-									r.count = 1
+									r.count = 0
 									return r, true
 								default:
 									return
@@ -3633,9 +5729,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
-								case 'h': // Prefix: "history"
+								case 'p': // Prefix: "proposals"
 									origElem := elem
-									if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									if l := len("proposals"); len(elem) >= l && elem[0:l] == "proposals" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3644,13 +5740,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										switch method {
 										case "GET":
-											// Leaf: GetAccountJettonsHistory
-											r.name = "GetAccountJettonsHistory"
+											// Leaf: GetConfigProposals
+											r.name = "GetConfigProposals"
 											r.summary = ""
-											r.operationID = "getAccountJettonsHistory"
-											r.pathPattern = "/v2/accounts/{account_id}/jettons/history"
+											r.operationID = "getConfigProposals"
+											r.pathPattern = "/v2/blockchain/config/proposals"
 											r.args = args
-											r.count = 1
+											r.count = 0
 											return r, true
 										default:
 											return
@@ -3658,34 +5754,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 
 									elem = origElem
-								}
-								// Param: "jetton_id"
-								// Match until "/"
-								idx := strings.IndexByte(elem, '/')
-								if idx < 0 {
-									idx = len(elem)
-								}
-								args[1] = elem[:idx]
-								elem = elem[idx:]
-
-								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										r.name = "GetAccountJettonBalance"
-										r.summary = ""
-										r.operationID = "getAccountJettonBalance"
-										r.pathPattern = "/v2/accounts/{account_id}/jettons/{jetton_id}"
-										r.args = args
-										r.count = 2
-										return r, true
-									default:
-										return
-									}
-								}
-								switch elem[0] {
-								case '/': // Prefix: "/history"
+								case 'r': // Prefix: "raw"
 									origElem := elem
-									if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+									if l := len("raw"); len(elem) >= l && elem[0:l] == "raw" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3694,13 +5765,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										switch method {
 										case "GET":
-											// Leaf: GetAccountJettonHistoryByID
-											r.name = "GetAccountJettonHistoryByID"
+											// Leaf: GetRawBlockchainConfig
+											r.name = "GetRawBlockchainConfig"
 											r.summary = ""
-											r.operationID = "getAccountJettonHistoryByID"
-											r.pathPattern = "/v2/accounts/{account_id}/jettons/{jetton_id}/history"
+											r.operationID = "getRawBlockchainConfig"
+											r.pathPattern = "/v2/blockchain/config/raw"
 											r.args = args
-											r.count = 2
+											r.count = 0
 											return r, true
 										default:
 											return
@@ -3714,85 +5785,284 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						case 'm': // Prefix: "multisigs"
-							origElem := elem
-							if l := len("multisigs"); len(elem) >= l && elem[0:l] == "multisigs" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+						}
 
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetAccountMultisigs
-									r.name = "GetAccountMultisigs"
-									r.summary = ""
-									r.operationID = "getAccountMultisigs"
-									r.pathPattern = "/v2/accounts/{account_id}/multisigs"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
+						elem = origElem
+					case 'f': // Prefix: "fees"
+						origElem := elem
+						if l := len("fees"); len(elem) >= l && elem[0:l] == "fees" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetBlockchainFees
+								r.name = "GetBlockchainFees"
+								r.summary = ""
+								r.operationID = "getBlockchainFees"
+								r.pathPattern = "/v2/blockchain/fees"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
 							}
+						}
 
-							elem = origElem
-						case 'n': // Prefix: "nfts"
+						elem = origElem
+					case 'm': // Prefix: "m"
+						origElem := elem
+						if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "asterchain"
 							origElem := elem
-							if l := len("nfts"); len(elem) >= l && elem[0:l] == "nfts" {
+							if l := len("asterchain"); len(elem) >= l && elem[0:l] == "asterchain" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = "GetAccountNftItems"
-									r.summary = ""
-									r.operationID = "getAccountNftItems"
-									r.pathPattern = "/v2/accounts/{account_id}/nfts"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/history"
+							case '-': // Prefix: "-head"
 								origElem := elem
-								if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+								if l := len("-head"); len(elem) >= l && elem[0:l] == "-head" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetBlockchainMasterchainHead
+										r.name = "GetBlockchainMasterchainHead"
+										r.summary = ""
+										r.operationID = "getBlockchainMasterchainHead"
+										r.pathPattern = "/v2/blockchain/masterchain-head"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										// Leaf: GetAccountNftHistory
-										r.name = "GetAccountNftHistory"
-										r.summary = ""
-										r.operationID = "getAccountNftHistory"
-										r.pathPattern = "/v2/accounts/{account_id}/nfts/history"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "eta"
+									origElem := elem
+									if l := len("eta"); len(elem) >= l && elem[0:l] == "eta" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetMasterchainEta
+											r.name = "GetMasterchainEta"
+											r.summary = ""
+											r.operationID = "getMasterchainEta"
+											r.pathPattern = "/v2/blockchain/masterchain/eta"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+								// Param: "masterchain_seqno"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'b': // Prefix: "blocks"
+										origElem := elem
+										if l := len("blocks"); len(elem) >= l && elem[0:l] == "blocks" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												// Leaf: GetBlockchainMasterchainBlocks
+												r.name = "GetBlockchainMasterchainBlocks"
+												r.summary = ""
+												r.operationID = "getBlockchainMasterchainBlocks"
+												r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/blocks"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									case 'c': // Prefix: "config"
+										origElem := elem
+										if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = "GetBlockchainConfigFromBlock"
+												r.summary = ""
+												r.operationID = "getBlockchainConfigFromBlock"
+												r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/config"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/raw"
+											origElem := elem
+											if l := len("/raw"); len(elem) >= l && elem[0:l] == "/raw" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													// Leaf: GetRawBlockchainConfigFromBlock
+													r.name = "GetRawBlockchainConfigFromBlock"
+													r.summary = ""
+													r.operationID = "getRawBlockchainConfigFromBlock"
+													r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/config/raw"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 's': // Prefix: "shards"
+										origElem := elem
+										if l := len("shards"); len(elem) >= l && elem[0:l] == "shards" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												// Leaf: GetBlockchainMasterchainShards
+												r.name = "GetBlockchainMasterchainShards"
+												r.summary = ""
+												r.operationID = "getBlockchainMasterchainShards"
+												r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/shards"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									case 't': // Prefix: "transactions"
+										origElem := elem
+										if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												// Leaf: GetBlockchainMasterchainTransactions
+												r.name = "GetBlockchainMasterchainTransactions"
+												r.summary = ""
+												r.operationID = "getBlockchainMasterchainTransactions"
+												r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/transactions"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
 									}
+
+									elem = origElem
 								}
 
 								elem = origElem
 							}
 
 							elem = origElem
-						case 'p': // Prefix: "publickey"
+						case 'e': // Prefix: "essage"
 							origElem := elem
-							if l := len("publickey"); len(elem) >= l && elem[0:l] == "publickey" {
+							if l := len("essage"); len(elem) >= l && elem[0:l] == "essage" {
 								elem = elem[l:]
 							} else {
 								break
@@ -3800,126 +6070,117 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							if len(elem) == 0 {
 								switch method {
-								case "GET":
-									// Leaf: GetAccountPublicKey
-									r.name = "GetAccountPublicKey"
+								case "POST":
+									r.name = "SendBlockchainMessage"
 									r.summary = ""
-									r.operationID = "getAccountPublicKey"
-									r.pathPattern = "/v2/accounts/{account_id}/publickey"
+									r.operationID = "sendBlockchainMessage"
+									r.pathPattern = "/v2/blockchain/message"
 									r.args = args
-									r.count = 1
+									r.count = 0
 									return r, true
 								default:
 									return
 								}
 							}
+							switch elem[0] {
+							case 's': // Prefix: "s/"
+								origElem := elem
+								if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							elem = origElem
-						case 'r': // Prefix: "reindex"
-							origElem := elem
-							if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								// Param: "msg_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
-							if len(elem) == 0 {
-								switch method {
-								case "POST":
-									// Leaf: ReindexAccount
-									r.name = "ReindexAccount"
-									r.summary = ""
-									r.operationID = "reindexAccount"
-									r.pathPattern = "/v2/accounts/{account_id}/reindex"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+								if len(elem) == 0 {
+									break
 								}
-							}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-							elem = origElem
-						case 's': // Prefix: "subscriptions"
-							origElem := elem
-							if l := len("subscriptions"); len(elem) >= l && elem[0:l] == "subscriptions" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'r': // Prefix: "resolve"
+										origElem := elem
+										if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetAccountSubscriptions
-									r.name = "GetAccountSubscriptions"
-									r.summary = ""
-									r.operationID = "getAccountSubscriptions"
-									r.pathPattern = "/v2/accounts/{account_id}/subscriptions"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
-							}
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												// Leaf: ResolveMessageHash
+												r.name = "ResolveMessageHash"
+												r.summary = ""
+												r.operationID = "resolveMessageHash"
+												r.pathPattern = "/v2/blockchain/messages/{msg_id}/resolve"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
 
-							elem = origElem
-						case 't': // Prefix: "traces"
-							origElem := elem
-							if l := len("traces"); len(elem) >= l && elem[0:l] == "traces" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+										elem = origElem
+									case 't': // Prefix: "transaction"
+										origElem := elem
+										if l := len("transaction"); len(elem) >= l && elem[0:l] == "transaction" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetAccountTraces
-									r.name = "GetAccountTraces"
-									r.summary = ""
-									r.operationID = "getAccountTraces"
-									r.pathPattern = "/v2/accounts/{account_id}/traces"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												// Leaf: GetBlockchainTransactionByMessageHash
+												r.name = "GetBlockchainTransactionByMessageHash"
+												r.summary = ""
+												r.operationID = "getBlockchainTransactionByMessageHash"
+												r.pathPattern = "/v2/blockchain/messages/{msg_id}/transaction"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
 								}
+
+								elem = origElem
 							}
 
 							elem = origElem
 						}
 
 						elem = origElem
-					}
-
-					elem = origElem
-				case 'd': // Prefix: "ddress/"
-					origElem := elem
-					if l := len("ddress/"); len(elem) >= l && elem[0:l] == "ddress/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "account_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/parse"
+					case 'r': // Prefix: "reduced/blocks"
 						origElem := elem
-						if l := len("/parse"); len(elem) >= l && elem[0:l] == "/parse" {
+						if l := len("reduced/blocks"); len(elem) >= l && elem[0:l] == "reduced/blocks" {
 							elem = elem[l:]
 						} else {
 							break
@@ -3928,13 +6189,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								// Leaf: AddressParse
-								r.name = "AddressParse"
+								// Leaf: GetReducedBlockchainBlocks
+								r.name = "GetReducedBlockchainBlocks"
 								r.summary = ""
-								r.operationID = "addressParse"
-								r.pathPattern = "/v2/address/{account_id}/parse"
+								r.operationID = "getReducedBlockchainBlocks"
+								r.pathPattern = "/v2/blockchain/reduced/blocks"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
@@ -3942,59 +6203,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						elem = origElem
-					}
-
-					elem = origElem
-				}
-
-				elem = origElem
-			case 'b': // Prefix: "blockchain/"
-				origElem := elem
-				if l := len("blockchain/"); len(elem) >= l && elem[0:l] == "blockchain/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "accounts/"
-					origElem := elem
-					if l := len("accounts/"); len(elem) >= l && elem[0:l] == "accounts/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "account_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = "GetBlockchainRawAccount"
-							r.summary = ""
-							r.operationID = "getBlockchainRawAccount"
-							r.pathPattern = "/v2/blockchain/accounts/{account_id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 's': // Prefix: "system/"
 						origElem := elem
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("system/"); len(elem) >= l && elem[0:l] == "system/" {
 							elem = elem[l:]
 						} else {
 							break
@@ -4004,9 +6215,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
-						case 'i': // Prefix: "inspect"
+						case 'c': // Prefix: "config"
 							origElem := elem
-							if l := len("inspect"); len(elem) >= l && elem[0:l] == "inspect" {
+							if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
 								elem = elem[l:]
 							} else {
 								break
@@ -4015,13 +6226,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									// Leaf: BlockchainAccountInspect
-									r.name = "BlockchainAccountInspect"
+									// Leaf: GetBlockchainConfigContract
+									r.name = "GetBlockchainConfigContract"
 									r.summary = ""
-									r.operationID = "blockchainAccountInspect"
-									r.pathPattern = "/v2/blockchain/accounts/{account_id}/inspect"
+									r.operationID = "getBlockchainConfigContract"
+									r.pathPattern = "/v2/blockchain/system/config"
 									r.args = args
-									r.count = 1
+									r.count = 0
 									return r, true
 								default:
 									return
@@ -4029,29 +6240,24 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						case 'm': // Prefix: "methods/"
+						case 'e': // Prefix: "elector"
 							origElem := elem
-							if l := len("methods/"); len(elem) >= l && elem[0:l] == "methods/" {
+							if l := len("elector"); len(elem) >= l && elem[0:l] == "elector" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "method_name"
-							// Leaf parameter
-							args[1] = elem
-							elem = ""
-
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									// Leaf: ExecGetMethodForBlockchainAccount
-									r.name = "ExecGetMethodForBlockchainAccount"
+									// Leaf: GetBlockchainElector
+									r.name = "GetBlockchainElector"
 									r.summary = ""
-									r.operationID = "execGetMethodForBlockchainAccount"
-									r.pathPattern = "/v2/blockchain/accounts/{account_id}/methods/{method_name}"
+									r.operationID = "getBlockchainElector"
+									r.pathPattern = "/v2/blockchain/system/elector"
 									r.args = args
-									r.count = 2
+									r.count = 0
 									return r, true
 								default:
 									return
@@ -4059,9 +6265,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						case 't': // Prefix: "transactions"
+						case 'm': // Prefix: "minter"
 							origElem := elem
-							if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
+							if l := len("minter"); len(elem) >= l && elem[0:l] == "minter" {
 								elem = elem[l:]
 							} else {
 								break
@@ -4070,74 +6276,44 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									// Leaf: GetBlockchainAccountTransactions
-									r.name = "GetBlockchainAccountTransactions"
+									// Leaf: GetBlockchainMinter
+									r.name = "GetBlockchainMinter"
 									r.summary = ""
-									r.operationID = "getBlockchainAccountTransactions"
-									r.pathPattern = "/v2/blockchain/accounts/{account_id}/transactions"
+									r.operationID = "getBlockchainMinter"
+									r.pathPattern = "/v2/blockchain/system/minter"
 									r.args = args
-									r.count = 1
+									r.count = 0
 									return r, true
 								default:
-									return
-								}
-							}
-
-							elem = origElem
-						}
-
-						elem = origElem
-					}
-
-					elem = origElem
-				case 'b': // Prefix: "blocks/"
-					origElem := elem
-					if l := len("blocks/"); len(elem) >= l && elem[0:l] == "blocks/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "block_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+									return
+								}
+							}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = "GetBlockchainBlock"
-							r.summary = ""
-							r.operationID = "getBlockchainBlock"
-							r.pathPattern = "/v2/blockchain/blocks/{block_id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
+							elem = origElem
 						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/transactions"
+
+						elem = origElem
+					case 't': // Prefix: "transactions/"
 						origElem := elem
-						if l := len("/transactions"); len(elem) >= l && elem[0:l] == "/transactions" {
+						if l := len("transactions/"); len(elem) >= l && elem[0:l] == "transactions/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "transaction_id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								// Leaf: GetBlockchainBlockTransactions
-								r.name = "GetBlockchainBlockTransactions"
+								// Leaf: GetBlockchainTransaction
+								r.name = "GetBlockchainTransaction"
 								r.summary = ""
-								r.operationID = "getBlockchainBlockTransactions"
-								r.pathPattern = "/v2/blockchain/blocks/{block_id}/transactions"
+								r.operationID = "getBlockchainTransaction"
+								r.pathPattern = "/v2/blockchain/transactions/{transaction_id}"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -4147,35 +6323,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						elem = origElem
-					}
-
-					elem = origElem
-				case 'c': // Prefix: "config"
-					origElem := elem
-					if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = "GetBlockchainConfig"
-							r.summary = ""
-							r.operationID = "getBlockchainConfig"
-							r.pathPattern = "/v2/blockchain/config"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/raw"
+					case 'v': // Prefix: "validators"
 						origElem := elem
-						if l := len("/raw"); len(elem) >= l && elem[0:l] == "/raw" {
+						if l := len("validators"); len(elem) >= l && elem[0:l] == "validators" {
 							elem = elem[l:]
 						} else {
 							break
@@ -4184,11 +6334,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								// Leaf: GetRawBlockchainConfig
-								r.name = "GetRawBlockchainConfig"
+								// Leaf: GetBlockchainValidators
+								r.name = "GetBlockchainValidators"
 								r.summary = ""
-								r.operationID = "getRawBlockchainConfig"
-								r.pathPattern = "/v2/blockchain/config/raw"
+								r.operationID = "getBlockchainValidators"
+								r.pathPattern = "/v2/blockchain/validators"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -4201,9 +6351,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					elem = origElem
-				case 'm': // Prefix: "m"
+				case 'o': // Prefix: "oc/"
 					origElem := elem
-					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+					if l := len("oc/"); len(elem) >= l && elem[0:l] == "oc/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -4213,214 +6363,34 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'a': // Prefix: "asterchain"
+					case 'c': // Prefix: "contract"
 						origElem := elem
-						if l := len("asterchain"); len(elem) >= l && elem[0:l] == "asterchain" {
+						if l := len("contract"); len(elem) >= l && elem[0:l] == "contract" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '-': // Prefix: "-head"
-							origElem := elem
-							if l := len("-head"); len(elem) >= l && elem[0:l] == "-head" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetBlockchainMasterchainHead
-									r.name = "GetBlockchainMasterchainHead"
-									r.summary = ""
-									r.operationID = "getBlockchainMasterchainHead"
-									r.pathPattern = "/v2/blockchain/masterchain-head"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-							elem = origElem
-						case '/': // Prefix: "/"
-							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "masterchain_seqno"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
-
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-								origElem := elem
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'b': // Prefix: "blocks"
-									origElem := elem
-									if l := len("blocks"); len(elem) >= l && elem[0:l] == "blocks" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										switch method {
-										case "GET":
-											// Leaf: GetBlockchainMasterchainBlocks
-											r.name = "GetBlockchainMasterchainBlocks"
-											r.summary = ""
-											r.operationID = "getBlockchainMasterchainBlocks"
-											r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/blocks"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-
-									elem = origElem
-								case 'c': // Prefix: "config"
-									origElem := elem
-									if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										switch method {
-										case "GET":
-											r.name = "GetBlockchainConfigFromBlock"
-											r.summary = ""
-											r.operationID = "getBlockchainConfigFromBlock"
-											r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/config"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-									switch elem[0] {
-									case '/': // Prefix: "/raw"
-										origElem := elem
-										if l := len("/raw"); len(elem) >= l && elem[0:l] == "/raw" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											switch method {
-											case "GET":
-												// Leaf: GetRawBlockchainConfigFromBlock
-												r.name = "GetRawBlockchainConfigFromBlock"
-												r.summary = ""
-												r.operationID = "getRawBlockchainConfigFromBlock"
-												r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/config/raw"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
-											}
-										}
-
-										elem = origElem
-									}
-
-									elem = origElem
-								case 's': // Prefix: "shards"
-									origElem := elem
-									if l := len("shards"); len(elem) >= l && elem[0:l] == "shards" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										switch method {
-										case "GET":
-											// Leaf: GetBlockchainMasterchainShards
-											r.name = "GetBlockchainMasterchainShards"
-											r.summary = ""
-											r.operationID = "getBlockchainMasterchainShards"
-											r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/shards"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-
-									elem = origElem
-								case 't': // Prefix: "transactions"
-									origElem := elem
-									if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										switch method {
-										case "GET":
-											// Leaf: GetBlockchainMasterchainTransactions
-											r.name = "GetBlockchainMasterchainTransactions"
-											r.summary = ""
-											r.operationID = "getBlockchainMasterchainTransactions"
-											r.pathPattern = "/v2/blockchain/masterchain/{masterchain_seqno}/transactions"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-
-									elem = origElem
-								}
-
-								elem = origElem
+							switch method {
+							case "POST":
+								// Leaf: InspectContractCode
+								r.name = "InspectContractCode"
+								r.summary = ""
+								r.operationID = "inspectContractCode"
+								r.pathPattern = "/v2/boc/contract"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
 							}
-
-							elem = origElem
 						}
 
 						elem = origElem
-					case 'e': // Prefix: "essage"
+					case 'h': // Prefix: "hash"
 						origElem := elem
-						if l := len("essage"); len(elem) >= l && elem[0:l] == "essage" {
+						if l := len("hash"); len(elem) >= l && elem[0:l] == "hash" {
 							elem = elem[l:]
 						} else {
 							break
@@ -4429,10 +6399,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "POST":
-								r.name = "SendBlockchainMessage"
+								// Leaf: GetBocHash
+								r.name = "GetBocHash"
 								r.summary = ""
-								r.operationID = "sendBlockchainMessage"
-								r.pathPattern = "/v2/blockchain/message"
+								r.operationID = "getBocHash"
+								r.pathPattern = "/v2/boc/hash"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -4440,148 +6411,67 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
-						switch elem[0] {
-						case 's': // Prefix: "s/"
-							origElem := elem
-							if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "msg_id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
-
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/transaction"
-								origElem := elem
-								if l := len("/transaction"); len(elem) >= l && elem[0:l] == "/transaction" {
-									elem = elem[l:]
-								} else {
-									break
-								}
 
-								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										// Leaf: GetBlockchainTransactionByMessageHash
-										r.name = "GetBlockchainTransactionByMessageHash"
-										r.summary = ""
-										r.operationID = "getBlockchainTransactionByMessageHash"
-										r.pathPattern = "/v2/blockchain/messages/{msg_id}/transaction"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
+						elem = origElem
+					case 'i': // Prefix: "inspect"
+						origElem := elem
+						if l := len("inspect"); len(elem) >= l && elem[0:l] == "inspect" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-								elem = origElem
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: InspectBoc
+								r.name = "InspectBoc"
+								r.summary = ""
+								r.operationID = "inspectBoc"
+								r.pathPattern = "/v2/boc/inspect"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
 							}
-
-							elem = origElem
 						}
 
 						elem = origElem
-					}
-
-					elem = origElem
-				case 'r': // Prefix: "reduced/blocks"
-					origElem := elem
-					if l := len("reduced/blocks"); len(elem) >= l && elem[0:l] == "reduced/blocks" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							// Leaf: GetReducedBlockchainBlocks
-							r.name = "GetReducedBlockchainBlocks"
-							r.summary = ""
-							r.operationID = "getReducedBlockchainBlocks"
-							r.pathPattern = "/v2/blockchain/reduced/blocks"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+					case 's': // Prefix: "stateinit"
+						origElem := elem
+						if l := len("stateinit"); len(elem) >= l && elem[0:l] == "stateinit" {
+							elem = elem[l:]
+						} else {
+							break
 						}
-					}
-
-					elem = origElem
-				case 't': // Prefix: "transactions/"
-					origElem := elem
-					if l := len("transactions/"); len(elem) >= l && elem[0:l] == "transactions/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "transaction_id"
-					// Leaf parameter
-					args[0] = elem
-					elem = ""
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							// Leaf: GetBlockchainTransaction
-							r.name = "GetBlockchainTransaction"
-							r.summary = ""
-							r.operationID = "getBlockchainTransaction"
-							r.pathPattern = "/v2/blockchain/transactions/{transaction_id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: ParseStateInit
+								r.name = "ParseStateInit"
+								r.summary = ""
+								r.operationID = "parseStateInit"
+								r.pathPattern = "/v2/boc/stateinit"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
-					}
-
-					elem = origElem
-				case 'v': // Prefix: "validators"
-					origElem := elem
-					if l := len("validators"); len(elem) >= l && elem[0:l] == "validators" {
-						elem = elem[l:]
-					} else {
-						break
-					}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							// Leaf: GetBlockchainValidators
-							r.name = "GetBlockchainValidators"
-							r.summary = ""
-							r.operationID = "getBlockchainValidators"
-							r.pathPattern = "/v2/blockchain/validators"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
+						elem = origElem
 					}
 
 					elem = origElem
 				}
 
 				elem = origElem
-			case 'd': // Prefix: "dns/"
+			case 'd': // Prefix: "d"
 				origElem := elem
-				if l := len("dns/"); len(elem) >= l && elem[0:l] == "dns/" {
+				if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
 					elem = elem[l:]
 				} else {
 					break
@@ -4591,9 +6481,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'a': // Prefix: "auctions"
+				case 'e': // Prefix: "eposits"
 					origElem := elem
-					if l := len("auctions"); len(elem) >= l && elem[0:l] == "auctions" {
+					if l := len("eposits"); len(elem) >= l && elem[0:l] == "eposits" {
 						elem = elem[l:]
 					} else {
 						break
@@ -4602,11 +6492,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							// Leaf: GetAllAuctions
-							r.name = "GetAllAuctions"
+							r.name = "GetExpectedDeposits"
+							r.summary = ""
+							r.operationID = "getExpectedDeposits"
+							r.pathPattern = "/v2/deposits"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = "AddExpectedDeposit"
 							r.summary = ""
-							r.operationID = "getAllAuctions"
-							r.pathPattern = "/v2/dns/auctions"
+							r.operationID = "addExpectedDeposit"
+							r.pathPattern = "/v2/deposits"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -4614,36 +6511,43 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					elem = origElem
-				}
-				// Param: "domain_name"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
+						// Param: "id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
 
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = "GetDnsInfo"
-						r.summary = ""
-						r.operationID = "getDnsInfo"
-						r.pathPattern = "/v2/dns/{domain_name}"
-						r.args = args
-						r.count = 1
-						return r, true
-					default:
-						return
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								// Leaf: DeleteExpectedDeposit
+								r.name = "DeleteExpectedDeposit"
+								r.summary = ""
+								r.operationID = "deleteExpectedDeposit"
+								r.pathPattern = "/v2/deposits/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
 					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+
+					elem = origElem
+				case 'n': // Prefix: "ns/"
 					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("ns/"); len(elem) >= l && elem[0:l] == "ns/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -4653,9 +6557,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'b': // Prefix: "bids"
+					case 'a': // Prefix: "auctions"
 						origElem := elem
-						if l := len("bids"); len(elem) >= l && elem[0:l] == "bids" {
+						if l := len("auctions"); len(elem) >= l && elem[0:l] == "auctions" {
 							elem = elem[l:]
 						} else {
 							break
@@ -4664,13 +6568,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								// Leaf: GetDomainBids
-								r.name = "GetDomainBids"
+								// Leaf: GetAllAuctions
+								r.name = "GetAllAuctions"
 								r.summary = ""
-								r.operationID = "getDomainBids"
-								r.pathPattern = "/v2/dns/{domain_name}/bids"
+								r.operationID = "getAllAuctions"
+								r.pathPattern = "/v2/dns/auctions"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
@@ -4678,28 +6582,93 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						elem = origElem
-					case 'r': // Prefix: "resolve"
+					}
+					// Param: "domain_name"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = "GetDnsInfo"
+							r.summary = ""
+							r.operationID = "getDnsInfo"
+							r.pathPattern = "/v2/dns/{domain_name}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								// Leaf: DnsResolve
-								r.name = "DnsResolve"
-								r.summary = ""
-								r.operationID = "dnsResolve"
-								r.pathPattern = "/v2/dns/{domain_name}/resolve"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 'b': // Prefix: "bids"
+							origElem := elem
+							if l := len("bids"); len(elem) >= l && elem[0:l] == "bids" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetDomainBids
+									r.name = "GetDomainBids"
+									r.summary = ""
+									r.operationID = "getDomainBids"
+									r.pathPattern = "/v2/dns/{domain_name}/bids"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "resolve"
+							origElem := elem
+							if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+								elem = elem[l:]
+							} else {
+								break
 							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: DnsResolve
+									r.name = "DnsResolve"
+									r.summary = ""
+									r.operationID = "dnsResolve"
+									r.pathPattern = "/v2/dns/{domain_name}/resolve"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
 						}
 
 						elem = origElem
@@ -4783,28 +6752,86 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/jettons"
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("/jettons"); len(elem) >= l && elem[0:l] == "/jettons" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								// Leaf: GetJettonsEvents
-								r.name = "GetJettonsEvents"
-								r.summary = ""
-								r.operationID = "getJettonsEvents"
-								r.pathPattern = "/v2/events/{event_id}/jettons"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "annotation"
+							origElem := elem
+							if l := len("annotation"); len(elem) >= l && elem[0:l] == "annotation" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									// Leaf: DeleteEventAnnotation
+									r.name = "DeleteEventAnnotation"
+									r.summary = ""
+									r.operationID = "deleteEventAnnotation"
+									r.pathPattern = "/v2/events/{event_id}/annotation"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									// Leaf: GetEventAnnotation
+									r.name = "GetEventAnnotation"
+									r.summary = ""
+									r.operationID = "getEventAnnotation"
+									r.pathPattern = "/v2/events/{event_id}/annotation"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									// Leaf: SetEventAnnotation
+									r.name = "SetEventAnnotation"
+									r.summary = ""
+									r.operationID = "setEventAnnotation"
+									r.pathPattern = "/v2/events/{event_id}/annotation"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'j': // Prefix: "jettons"
+							origElem := elem
+							if l := len("jettons"); len(elem) >= l && elem[0:l] == "jettons" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetJettonsEvents
+									r.name = "GetJettonsEvents"
+									r.summary = ""
+									r.operationID = "getJettonsEvents"
+									r.pathPattern = "/v2/events/{event_id}/jettons"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
 							}
+
+							elem = origElem
 						}
 
 						elem = origElem
@@ -5109,6 +7136,36 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 't': // Prefix: "top"
+						origElem := elem
+						if l := len("top"); len(elem) >= l && elem[0:l] == "top" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetTopJettonsByVolume
+								r.name = "GetTopJettonsByVolume"
+								r.summary = ""
+								r.operationID = "getTopJettonsByVolume"
+								r.pathPattern = "/v2/jettons/top"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
 					// Param: "account_id"
 					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
@@ -5228,9 +7285,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			case 'l': // Prefix: "liteserver/"
+			case 'l': // Prefix: "l"
 				origElem := elem
-				if l := len("liteserver/"); len(elem) >= l && elem[0:l] == "liteserver/" {
+				if l := len("l"); len(elem) >= l && elem[0:l] == "l" {
 					elem = elem[l:]
 				} else {
 					break
@@ -5240,9 +7297,83 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'g': // Prefix: "get_"
+				case 'a': // Prefix: "abels"
+					origElem := elem
+					if l := len("abels"); len(elem) >= l && elem[0:l] == "abels" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = "GetPrivateLabels"
+							r.summary = ""
+							r.operationID = "getPrivateLabels"
+							r.pathPattern = "/v2/labels"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "account_id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								// Leaf: DeletePrivateLabel
+								r.name = "DeletePrivateLabel"
+								r.summary = ""
+								r.operationID = "deletePrivateLabel"
+								r.pathPattern = "/v2/labels/{account_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								// Leaf: GetPrivateLabel
+								r.name = "GetPrivateLabel"
+								r.summary = ""
+								r.operationID = "getPrivateLabel"
+								r.pathPattern = "/v2/labels/{account_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								// Leaf: SetPrivateLabel
+								r.name = "SetPrivateLabel"
+								r.summary = ""
+								r.operationID = "setPrivateLabel"
+								r.pathPattern = "/v2/labels/{account_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'i': // Prefix: "iteserver/"
 					origElem := elem
-					if l := len("get_"); len(elem) >= l && elem[0:l] == "get_" {
+					if l := len("iteserver/"); len(elem) >= l && elem[0:l] == "iteserver/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -5252,9 +7383,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'a': // Prefix: "a"
+					case 'g': // Prefix: "get_"
 						origElem := elem
-						if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+						if l := len("get_"); len(elem) >= l && elem[0:l] == "get_" {
 							elem = elem[l:]
 						} else {
 							break
@@ -5264,84 +7395,199 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
-						case 'c': // Prefix: "ccount_state/"
+						case 'a': // Prefix: "a"
 							origElem := elem
-							if l := len("ccount_state/"); len(elem) >= l && elem[0:l] == "ccount_state/" {
+							if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "account_id"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
-
 							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetRawAccountState
-									r.name = "GetRawAccountState"
-									r.summary = ""
-									r.operationID = "getRawAccountState"
-									r.pathPattern = "/v2/liteserver/get_account_state/{account_id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "ccount_state/"
+								origElem := elem
+								if l := len("ccount_state/"); len(elem) >= l && elem[0:l] == "ccount_state/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "account_id"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetRawAccountState
+										r.name = "GetRawAccountState"
+										r.summary = ""
+										r.operationID = "getRawAccountState"
+										r.pathPattern = "/v2/liteserver/get_account_state/{account_id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'l': // Prefix: "ll_shards_info/"
+								origElem := elem
+								if l := len("ll_shards_info/"); len(elem) >= l && elem[0:l] == "ll_shards_info/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "block_id"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetAllRawShardsInfo
+										r.name = "GetAllRawShardsInfo"
+										r.summary = ""
+										r.operationID = "getAllRawShardsInfo"
+										r.pathPattern = "/v2/liteserver/get_all_shards_info/{block_id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
 								}
+
+								elem = origElem
 							}
 
 							elem = origElem
-						case 'l': // Prefix: "ll_shards_info/"
+						case 'b': // Prefix: "block"
 							origElem := elem
-							if l := len("ll_shards_info/"); len(elem) >= l && elem[0:l] == "ll_shards_info/" {
+							if l := len("block"); len(elem) >= l && elem[0:l] == "block" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "block_id"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
-
 							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetAllRawShardsInfo
-									r.name = "GetAllRawShardsInfo"
-									r.summary = ""
-									r.operationID = "getAllRawShardsInfo"
-									r.pathPattern = "/v2/liteserver/get_all_shards_info/{block_id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
+								break
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							elem = origElem
-						}
+								// Param: "block_id"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
 
-						elem = origElem
-					case 'b': // Prefix: "block"
-						origElem := elem
-						if l := len("block"); len(elem) >= l && elem[0:l] == "block" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetRawBlockchainBlock
+										r.name = "GetRawBlockchainBlock"
+										r.summary = ""
+										r.operationID = "getRawBlockchainBlock"
+										r.pathPattern = "/v2/liteserver/get_block/{block_id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+								elem = origElem
+							case '_': // Prefix: "_"
+								origElem := elem
+								if l := len("_"); len(elem) >= l && elem[0:l] == "_" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'h': // Prefix: "header/"
+									origElem := elem
+									if l := len("header/"); len(elem) >= l && elem[0:l] == "header/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "block_id"
+									// Leaf parameter
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetRawBlockchainBlockHeader
+											r.name = "GetRawBlockchainBlockHeader"
+											r.summary = ""
+											r.operationID = "getRawBlockchainBlockHeader"
+											r.pathPattern = "/v2/liteserver/get_block_header/{block_id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'p': // Prefix: "proof"
+									origElem := elem
+									if l := len("proof"); len(elem) >= l && elem[0:l] == "proof" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetRawBlockProof
+											r.name = "GetRawBlockProof"
+											r.summary = ""
+											r.operationID = "getRawBlockProof"
+											r.pathPattern = "/v2/liteserver/get_block_proof"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'c': // Prefix: "config_all/"
 							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("config_all/"); len(elem) >= l && elem[0:l] == "config_all/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -5355,11 +7601,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									// Leaf: GetRawBlockchainBlock
-									r.name = "GetRawBlockchainBlock"
+									// Leaf: GetRawConfig
+									r.name = "GetRawConfig"
 									r.summary = ""
-									r.operationID = "getRawBlockchainBlock"
-									r.pathPattern = "/v2/liteserver/get_block/{block_id}"
+									r.operationID = "getRawConfig"
+									r.pathPattern = "/v2/liteserver/get_config_all/{block_id}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -5369,51 +7615,32 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						case '_': // Prefix: "_"
+						case 'm': // Prefix: "masterchain_info"
 							origElem := elem
-							if l := len("_"); len(elem) >= l && elem[0:l] == "_" {
+							if l := len("masterchain_info"); len(elem) >= l && elem[0:l] == "masterchain_info" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								break
+								switch method {
+								case "GET":
+									r.name = "GetRawMasterchainInfo"
+									r.summary = ""
+									r.operationID = "getRawMasterchainInfo"
+									r.pathPattern = "/v2/liteserver/get_masterchain_info"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
 							switch elem[0] {
-							case 'h': // Prefix: "header/"
-								origElem := elem
-								if l := len("header/"); len(elem) >= l && elem[0:l] == "header/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								// Param: "block_id"
-								// Leaf parameter
-								args[0] = elem
-								elem = ""
-
-								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										// Leaf: GetRawBlockchainBlockHeader
-										r.name = "GetRawBlockchainBlockHeader"
-										r.summary = ""
-										r.operationID = "getRawBlockchainBlockHeader"
-										r.pathPattern = "/v2/liteserver/get_block_header/{block_id}"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
-								elem = origElem
-							case 'p': // Prefix: "proof"
+							case '_': // Prefix: "_ext"
 								origElem := elem
-								if l := len("proof"); len(elem) >= l && elem[0:l] == "proof" {
+								if l := len("_ext"); len(elem) >= l && elem[0:l] == "_ext" {
 									elem = elem[l:]
 								} else {
 									break
@@ -5422,11 +7649,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										// Leaf: GetRawBlockProof
-										r.name = "GetRawBlockProof"
+										// Leaf: GetRawMasterchainInfoExt
+										r.name = "GetRawMasterchainInfoExt"
 										r.summary = ""
-										r.operationID = "getRawBlockProof"
-										r.pathPattern = "/v2/liteserver/get_block_proof"
+										r.operationID = "getRawMasterchainInfoExt"
+										r.pathPattern = "/v2/liteserver/get_masterchain_info_ext"
 										r.args = args
 										r.count = 0
 										return r, true
@@ -5439,65 +7666,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						}
-
-						elem = origElem
-					case 'c': // Prefix: "config_all/"
-						origElem := elem
-						if l := len("config_all/"); len(elem) >= l && elem[0:l] == "config_all/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "block_id"
-						// Leaf parameter
-						args[0] = elem
-						elem = ""
-
-						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								// Leaf: GetRawConfig
-								r.name = "GetRawConfig"
-								r.summary = ""
-								r.operationID = "getRawConfig"
-								r.pathPattern = "/v2/liteserver/get_config_all/{block_id}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
-
-						elem = origElem
-					case 'm': // Prefix: "masterchain_info"
-						origElem := elem
-						if l := len("masterchain_info"); len(elem) >= l && elem[0:l] == "masterchain_info" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								r.name = "GetRawMasterchainInfo"
-								r.summary = ""
-								r.operationID = "getRawMasterchainInfo"
-								r.pathPattern = "/v2/liteserver/get_masterchain_info"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-						switch elem[0] {
-						case '_': // Prefix: "_ext"
+						case 'o': // Prefix: "out_msg_queue_sizes"
 							origElem := elem
-							if l := len("_ext"); len(elem) >= l && elem[0:l] == "_ext" {
+							if l := len("out_msg_queue_sizes"); len(elem) >= l && elem[0:l] == "out_msg_queue_sizes" {
 								elem = elem[l:]
 							} else {
 								break
@@ -5506,11 +7677,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									// Leaf: GetRawMasterchainInfoExt
-									r.name = "GetRawMasterchainInfoExt"
+									// Leaf: GetOutMsgQueueSizes
+									r.name = "GetOutMsgQueueSizes"
 									r.summary = ""
-									r.operationID = "getRawMasterchainInfoExt"
-									r.pathPattern = "/v2/liteserver/get_masterchain_info_ext"
+									r.operationID = "getOutMsgQueueSizes"
+									r.pathPattern = "/v2/liteserver/get_out_msg_queue_sizes"
 									r.args = args
 									r.count = 0
 									return r, true
@@ -5520,49 +7691,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						}
-
-						elem = origElem
-					case 'o': // Prefix: "out_msg_queue_sizes"
-						origElem := elem
-						if l := len("out_msg_queue_sizes"); len(elem) >= l && elem[0:l] == "out_msg_queue_sizes" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								// Leaf: GetOutMsgQueueSizes
-								r.name = "GetOutMsgQueueSizes"
-								r.summary = ""
-								r.operationID = "getOutMsgQueueSizes"
-								r.pathPattern = "/v2/liteserver/get_out_msg_queue_sizes"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-
-						elem = origElem
-					case 's': // Prefix: "s"
-						origElem := elem
-						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'h': // Prefix: "hard_"
+						case 's': // Prefix: "s"
 							origElem := elem
-							if l := len("hard_"); len(elem) >= l && elem[0:l] == "hard_" {
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 								elem = elem[l:]
 							} else {
 								break
@@ -5572,39 +7703,84 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'b': // Prefix: "block_proof/"
+							case 'h': // Prefix: "hard_"
 								origElem := elem
-								if l := len("block_proof/"); len(elem) >= l && elem[0:l] == "block_proof/" {
+								if l := len("hard_"); len(elem) >= l && elem[0:l] == "hard_" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "block_id"
-								// Leaf parameter
-								args[0] = elem
-								elem = ""
-
 								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										// Leaf: GetRawShardBlockProof
-										r.name = "GetRawShardBlockProof"
-										r.summary = ""
-										r.operationID = "getRawShardBlockProof"
-										r.pathPattern = "/v2/liteserver/get_shard_block_proof/{block_id}"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "block_proof/"
+									origElem := elem
+									if l := len("block_proof/"); len(elem) >= l && elem[0:l] == "block_proof/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "block_id"
+									// Leaf parameter
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetRawShardBlockProof
+											r.name = "GetRawShardBlockProof"
+											r.summary = ""
+											r.operationID = "getRawShardBlockProof"
+											r.pathPattern = "/v2/liteserver/get_shard_block_proof/{block_id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'i': // Prefix: "info/"
+									origElem := elem
+									if l := len("info/"); len(elem) >= l && elem[0:l] == "info/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "block_id"
+									// Leaf parameter
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: GetRawShardInfo
+											r.name = "GetRawShardInfo"
+											r.summary = ""
+											r.operationID = "getRawShardInfo"
+											r.pathPattern = "/v2/liteserver/get_shard_info/{block_id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
 									}
+
+									elem = origElem
 								}
 
 								elem = origElem
-							case 'i': // Prefix: "info/"
+							case 't': // Prefix: "tate/"
 								origElem := elem
-								if l := len("info/"); len(elem) >= l && elem[0:l] == "info/" {
+								if l := len("tate/"); len(elem) >= l && elem[0:l] == "tate/" {
 									elem = elem[l:]
 								} else {
 									break
@@ -5618,11 +7794,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										// Leaf: GetRawShardInfo
-										r.name = "GetRawShardInfo"
+										// Leaf: GetRawBlockchainBlockState
+										r.name = "GetRawBlockchainBlockState"
 										r.summary = ""
-										r.operationID = "getRawShardInfo"
-										r.pathPattern = "/v2/liteserver/get_shard_info/{block_id}"
+										r.operationID = "getRawBlockchainBlockState"
+										r.pathPattern = "/v2/liteserver/get_state/{block_id}"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -5635,164 +7811,134 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						case 't': // Prefix: "tate/"
+						case 't': // Prefix: "t"
 							origElem := elem
-							if l := len("tate/"); len(elem) >= l && elem[0:l] == "tate/" {
+							if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "block_id"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
-
 							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetRawBlockchainBlockState
-									r.name = "GetRawBlockchainBlockState"
-									r.summary = ""
-									r.operationID = "getRawBlockchainBlockState"
-									r.pathPattern = "/v2/liteserver/get_state/{block_id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
-							}
-
-							elem = origElem
-						}
-
-						elem = origElem
-					case 't': // Prefix: "t"
-						origElem := elem
-						if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'i': // Prefix: "ime"
-							origElem := elem
-							if l := len("ime"); len(elem) >= l && elem[0:l] == "ime" {
-								elem = elem[l:]
-							} else {
 								break
 							}
-
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetRawTime
-									r.name = "GetRawTime"
-									r.summary = ""
-									r.operationID = "getRawTime"
-									r.pathPattern = "/v2/liteserver/get_time"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
+							switch elem[0] {
+							case 'i': // Prefix: "ime"
+								origElem := elem
+								if l := len("ime"); len(elem) >= l && elem[0:l] == "ime" {
+									elem = elem[l:]
+								} else {
+									break
 								}
-							}
-
-							elem = origElem
-						case 'r': // Prefix: "ransactions/"
-							origElem := elem
-							if l := len("ransactions/"); len(elem) >= l && elem[0:l] == "ransactions/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
 
-							// Param: "account_id"
-							// Leaf parameter
-							args[0] = elem
-							elem = ""
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetRawTime
+										r.name = "GetRawTime"
+										r.summary = ""
+										r.operationID = "getRawTime"
+										r.pathPattern = "/v2/liteserver/get_time"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
 
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									// Leaf: GetRawTransactions
-									r.name = "GetRawTransactions"
-									r.summary = ""
-									r.operationID = "getRawTransactions"
-									r.pathPattern = "/v2/liteserver/get_transactions/{account_id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+								elem = origElem
+							case 'r': // Prefix: "ransactions/"
+								origElem := elem
+								if l := len("ransactions/"); len(elem) >= l && elem[0:l] == "ransactions/" {
+									elem = elem[l:]
+								} else {
+									break
 								}
+
+								// Param: "account_id"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetRawTransactions
+										r.name = "GetRawTransactions"
+										r.summary = ""
+										r.operationID = "getRawTransactions"
+										r.pathPattern = "/v2/liteserver/get_transactions/{account_id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
 							}
 
 							elem = origElem
 						}
 
 						elem = origElem
-					}
-
-					elem = origElem
-				case 'l': // Prefix: "list_block_transactions/"
-					origElem := elem
-					if l := len("list_block_transactions/"); len(elem) >= l && elem[0:l] == "list_block_transactions/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+					case 'l': // Prefix: "list_block_transactions/"
+						origElem := elem
+						if l := len("list_block_transactions/"); len(elem) >= l && elem[0:l] == "list_block_transactions/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					// Param: "block_id"
-					// Leaf parameter
-					args[0] = elem
-					elem = ""
+						// Param: "block_id"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							// Leaf: GetRawListBlockTransactions
-							r.name = "GetRawListBlockTransactions"
-							r.summary = ""
-							r.operationID = "getRawListBlockTransactions"
-							r.pathPattern = "/v2/liteserver/list_block_transactions/{block_id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetRawListBlockTransactions
+								r.name = "GetRawListBlockTransactions"
+								r.summary = ""
+								r.operationID = "getRawListBlockTransactions"
+								r.pathPattern = "/v2/liteserver/list_block_transactions/{block_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
-					}
 
-					elem = origElem
-				case 's': // Prefix: "send_message"
-					origElem := elem
-					if l := len("send_message"); len(elem) >= l && elem[0:l] == "send_message" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+						elem = origElem
+					case 's': // Prefix: "send_message"
+						origElem := elem
+						if l := len("send_message"); len(elem) >= l && elem[0:l] == "send_message" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					if len(elem) == 0 {
-						switch method {
-						case "POST":
-							// Leaf: SendRawMessage
-							r.name = "SendRawMessage"
-							r.summary = ""
-							r.operationID = "sendRawMessage"
-							r.pathPattern = "/v2/liteserver/send_message"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: SendRawMessage
+								r.name = "SendRawMessage"
+								r.summary = ""
+								r.operationID = "sendRawMessage"
+								r.pathPattern = "/v2/liteserver/send_message"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
+
+						elem = origElem
 					}
 
 					elem = origElem
@@ -5811,28 +7957,68 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'e': // Prefix: "essage/decode"
+				case 'e': // Prefix: "essage"
 					origElem := elem
-					if l := len("essage/decode"); len(elem) >= l && elem[0:l] == "essage/decode" {
+					if l := len("essage"); len(elem) >= l && elem[0:l] == "essage" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						switch method {
-						case "POST":
-							// Leaf: DecodeMessage
-							r.name = "DecodeMessage"
-							r.summary = ""
-							r.operationID = "decodeMessage"
-							r.pathPattern = "/v2/message/decode"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/decode"
+						origElem := elem
+						if l := len("/decode"); len(elem) >= l && elem[0:l] == "/decode" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: DecodeMessage
+								r.name = "DecodeMessage"
+								r.summary = ""
+								r.operationID = "decodeMessage"
+								r.pathPattern = "/v2/message/decode"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 's': // Prefix: "s/search"
+						origElem := elem
+						if l := len("s/search"); len(elem) >= l && elem[0:l] == "s/search" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: SearchMessages
+								r.name = "SearchMessages"
+								r.summary = ""
+								r.operationID = "searchMessages"
+								r.pathPattern = "/v2/messages/search"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
+
+						elem = origElem
 					}
 
 					elem = origElem
@@ -6017,28 +8203,68 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/history"
+				case '/': // Prefix: "/"
 					origElem := elem
-					if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							// Leaf: GetNftHistoryByID
-							r.name = "GetNftHistoryByID"
-							r.summary = ""
-							r.operationID = "getNftHistoryByID"
-							r.pathPattern = "/v2/nfts/{account_id}/history"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
+						break
+					}
+					switch elem[0] {
+					case 'b': // Prefix: "bids"
+						origElem := elem
+						if l := len("bids"); len(elem) >= l && elem[0:l] == "bids" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetNftItemBids
+								r.name = "GetNftItemBids"
+								r.summary = ""
+								r.operationID = "getNftItemBids"
+								r.pathPattern = "/v2/nfts/{account_id}/bids"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'h': // Prefix: "history"
+						origElem := elem
+						if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetNftHistoryByID
+								r.name = "GetNftHistoryByID"
+								r.summary = ""
+								r.operationID = "getNftHistoryByID"
+								r.pathPattern = "/v2/nfts/{account_id}/history"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
+
+						elem = origElem
 					}
 
 					elem = origElem
@@ -6077,7 +8303,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							// Leaf: GetWalletsByPublicKey
 							r.name = "GetWalletsByPublicKey"
 							r.summary = ""
 							r.operationID = "getWalletsByPublicKey"
@@ -6089,6 +8314,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/recover"
+						origElem := elem
+						if l := len("/recover"); len(elem) >= l && elem[0:l] == "/recover" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: RecoverWallets
+								r.name = "RecoverWallets"
+								r.summary = ""
+								r.operationID = "recoverWallets"
+								r.pathPattern = "/v2/pubkeys/{public_key}/wallets/recover"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
 
 					elem = origElem
 				}
@@ -6373,28 +8625,68 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						elem = origElem
-					case 't': // Prefix: "tus"
+					case 't': // Prefix: "t"
 						origElem := elem
-						if l := len("tus"); len(elem) >= l && elem[0:l] == "tus" {
+						if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								// Leaf: Status
-								r.name = "Status"
-								r.summary = ""
-								r.operationID = "status"
-								r.pathPattern = "/v2/status"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 's': // Prefix: "s/network"
+							origElem := elem
+							if l := len("s/network"); len(elem) >= l && elem[0:l] == "s/network" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetNetworkStats
+									r.name = "GetNetworkStats"
+									r.summary = ""
+									r.operationID = "getNetworkStats"
+									r.pathPattern = "/v2/stats/network"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'u': // Prefix: "us"
+							origElem := elem
+							if l := len("us"); len(elem) >= l && elem[0:l] == "us" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: Status
+									r.name = "Status"
+									r.summary = ""
+									r.operationID = "status"
+									r.pathPattern = "/v2/status"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
+
+							elem = origElem
 						}
 
 						elem = origElem
@@ -6569,9 +8861,34 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			case 'w': // Prefix: "wallet/"
+			case 'u': // Prefix: "usage"
+				origElem := elem
+				if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						// Leaf: GetUsage
+						r.name = "GetUsage"
+						r.summary = ""
+						r.operationID = "getUsage"
+						r.pathPattern = "/v2/usage"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+				elem = origElem
+			case 'w': // Prefix: "w"
 				origElem := elem
-				if l := len("wallet/"); len(elem) >= l && elem[0:l] == "wallet/" {
+				if l := len("w"); len(elem) >= l && elem[0:l] == "w" {
 					elem = elem[l:]
 				} else {
 					break
@@ -6581,68 +8898,187 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'a': // Prefix: "auth/proof"
+				case 'a': // Prefix: "allet/"
 					origElem := elem
-					if l := len("auth/proof"); len(elem) >= l && elem[0:l] == "auth/proof" {
+					if l := len("allet/"); len(elem) >= l && elem[0:l] == "allet/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						switch method {
-						case "POST":
-							// Leaf: TonConnectProof
-							r.name = "TonConnectProof"
-							r.summary = ""
-							r.operationID = "tonConnectProof"
-							r.pathPattern = "/v2/wallet/auth/proof"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "auth/proof"
+						origElem := elem
+						if l := len("auth/proof"); len(elem) >= l && elem[0:l] == "auth/proof" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: TonConnectProof
+								r.name = "TonConnectProof"
+								r.summary = ""
+								r.operationID = "tonConnectProof"
+								r.pathPattern = "/v2/wallet/auth/proof"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'b': // Prefix: "backup"
+						origElem := elem
+						if l := len("backup"); len(elem) >= l && elem[0:l] == "backup" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetWalletBackup
+								r.name = "GetWalletBackup"
+								r.summary = ""
+								r.operationID = "getWalletBackup"
+								r.pathPattern = "/v2/wallet/backup"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "PUT":
+								// Leaf: SetWalletBackup
+								r.name = "SetWalletBackup"
+								r.summary = ""
+								r.operationID = "setWalletBackup"
+								r.pathPattern = "/v2/wallet/backup"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'e': // Prefix: "emulate"
+						origElem := elem
+						if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: EmulateMessageToWallet
+								r.name = "EmulateMessageToWallet"
+								r.summary = ""
+								r.operationID = "emulateMessageToWallet"
+								r.pathPattern = "/v2/wallet/emulate"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
+
+						elem = origElem
+					}
+					// Param: "account_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
 					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
-					elem = origElem
-				case 'b': // Prefix: "backup"
-					origElem := elem
-					if l := len("backup"); len(elem) >= l && elem[0:l] == "backup" {
-						elem = elem[l:]
-					} else {
+					if len(elem) == 0 {
 						break
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							// Leaf: GetWalletBackup
-							r.name = "GetWalletBackup"
-							r.summary = ""
-							r.operationID = "getWalletBackup"
-							r.pathPattern = "/v2/wallet/backup"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "PUT":
-							// Leaf: SetWalletBackup
-							r.name = "SetWalletBackup"
-							r.summary = ""
-							r.operationID = "setWalletBackup"
-							r.pathPattern = "/v2/wallet/backup"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 's': // Prefix: "seqno"
+							origElem := elem
+							if l := len("seqno"); len(elem) >= l && elem[0:l] == "seqno" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetAccountSeqno
+									r.name = "GetAccountSeqno"
+									r.summary = ""
+									r.operationID = "getAccountSeqno"
+									r.pathPattern = "/v2/wallet/{account_id}/seqno"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 't': // Prefix: "transfer/build"
+							origElem := elem
+							if l := len("transfer/build"); len(elem) >= l && elem[0:l] == "transfer/build" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: BuildWalletTransfer
+									r.name = "BuildWalletTransfer"
+									r.summary = ""
+									r.operationID = "buildWalletTransfer"
+									r.pathPattern = "/v2/wallet/{account_id}/transfer/build"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
 						}
+
+						elem = origElem
 					}
 
 					elem = origElem
-				case 'e': // Prefix: "emulate"
+				case 'e': // Prefix: "ebhooks"
 					origElem := elem
-					if l := len("emulate"); len(elem) >= l && elem[0:l] == "emulate" {
+					if l := len("ebhooks"); len(elem) >= l && elem[0:l] == "ebhooks" {
 						elem = elem[l:]
 					} else {
 						break
@@ -6650,12 +9086,19 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					if len(elem) == 0 {
 						switch method {
+						case "GET":
+							r.name = "GetWebhooks"
+							r.summary = ""
+							r.operationID = "getWebhooks"
+							r.pathPattern = "/v2/webhooks"
+							r.args = args
+							r.count = 0
+							return r, true
 						case "POST":
-							// Leaf: EmulateMessageToWallet
-							r.name = "EmulateMessageToWallet"
+							r.name = "AddWebhook"
 							r.summary = ""
-							r.operationID = "emulateMessageToWallet"
-							r.pathPattern = "/v2/wallet/emulate"
+							r.operationID = "addWebhook"
+							r.pathPattern = "/v2/webhooks"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -6663,44 +9106,67 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					elem = origElem
-				}
-				// Param: "account_id"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/seqno"
-					origElem := elem
-					if l := len("/seqno"); len(elem) >= l && elem[0:l] == "/seqno" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = "DeleteWebhook"
+								r.summary = ""
+								r.operationID = "deleteWebhook"
+								r.pathPattern = "/v2/webhooks/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/rotate-secret"
+							origElem := elem
+							if l := len("/rotate-secret"); len(elem) >= l && elem[0:l] == "/rotate-secret" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							// Leaf: GetAccountSeqno
-							r.name = "GetAccountSeqno"
-							r.summary = ""
-							r.operationID = "getAccountSeqno"
-							r.pathPattern = "/v2/wallet/{account_id}/seqno"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: RotateWebhookSecret
+									r.name = "RotateWebhookSecret"
+									r.summary = ""
+									r.operationID = "rotateWebhookSecret"
+									r.pathPattern = "/v2/webhooks/{id}/rotate-secret"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
 						}
+
+						elem = origElem
 					}
 
 					elem = origElem
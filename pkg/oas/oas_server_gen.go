@@ -14,6 +14,24 @@ type Handler interface {
 	//
 	// GET /v2/accounts/{account_id}/dns/backresolve
 	AccountDnsBackResolve(ctx context.Context, params AccountDnsBackResolveParams) (*DomainNames, error)
+	// AddExpectedDeposit implements addExpectedDeposit operation.
+	//
+	// Register an expected deposit. Incoming transfers to the account are classified as matched or
+	// unmatched and streamed via /v2/sse/deposits. The account must be tracked by the instance. Requires
+	// a tenant token or a token with the admin scope.
+	//
+	// POST /v2/deposits
+	AddExpectedDeposit(ctx context.Context, req *AddExpectedDepositReq) (*ExpectedDeposit, error)
+	// AddWebhook implements addWebhook operation.
+	//
+	// Register a callback URL receiving notifications about transactions and traces of the accounts for
+	// backend services that can't hold a streaming connection. Every notification is POSTed to the URL,
+	// failed deliveries are retried with an exponential backoff. Notifications are signed with a secret
+	// returned only in this response. Requires a tenant token or a token with the admin scope. A tenant
+	// can register up to 100 webhooks.
+	//
+	// POST /v2/webhooks
+	AddWebhook(ctx context.Context, req *AddWebhookReq) (*Webhook, error)
 	// AddressParse implements addressParse operation.
 	//
 	// Parse address and display in all formats.
@@ -26,12 +44,54 @@ type Handler interface {
 	//
 	// GET /v2/blockchain/accounts/{account_id}/inspect
 	BlockchainAccountInspect(ctx context.Context, params BlockchainAccountInspectParams) (*BlockchainAccountInspect, error)
+	// BuildWalletTransfer implements buildWalletTransfer operation.
+	//
+	// Construct an unsigned body of an external message to a wallet transferring TON, jettons or NFTs.
+	// The hash of the body is signed externally, the signature is written before (wallets v3 and v4) or
+	// after (wallet v5) the bits of the body, and the result is sent in an external message to the
+	// wallet.
+	//
+	// POST /v2/wallet/{account_id}/transfer/build
+	BuildWalletTransfer(ctx context.Context, req *BuildWalletTransferReq, params BuildWalletTransferParams) (*UnsignedWalletTransfer, error)
+	// CheckAccountBounce implements checkAccountBounce operation.
+	//
+	// Predict whether a transfer to the account will bounce, so a wallet can warn a user before sending.
+	// The prediction is based on the current state of the account and an emulation of the transfer
+	// against it, so it doesn't take into account transactions happening before the transfer is
+	// delivered.
+	//
+	// POST /v2/accounts/{account_id}/bounce-check
+	CheckAccountBounce(ctx context.Context, req *CheckAccountBounceReq, params CheckAccountBounceParams) (*BouncePrediction, error)
 	// DecodeMessage implements decodeMessage operation.
 	//
 	// Decode a given message. Only external incoming messages can be decoded currently.
 	//
 	// POST /v2/message/decode
 	DecodeMessage(ctx context.Context, req *DecodeMessageReq) (*DecodedMessage, error)
+	// DeleteEventAnnotation implements deleteEventAnnotation operation.
+	//
+	// Remove a private annotation attached to the event.
+	//
+	// DELETE /v2/events/{event_id}/annotation
+	DeleteEventAnnotation(ctx context.Context, params DeleteEventAnnotationParams) error
+	// DeleteExpectedDeposit implements deleteExpectedDeposit operation.
+	//
+	// Remove an expected deposit.
+	//
+	// DELETE /v2/deposits/{id}
+	DeleteExpectedDeposit(ctx context.Context, params DeleteExpectedDepositParams) error
+	// DeletePrivateLabel implements deletePrivateLabel operation.
+	//
+	// Remove a private label of an account. Requires a token with the admin scope.
+	//
+	// DELETE /v2/labels/{account_id}
+	DeletePrivateLabel(ctx context.Context, params DeletePrivateLabelParams) error
+	// DeleteWebhook implements deleteWebhook operation.
+	//
+	// Remove a webhook, its pending notifications are dropped.
+	//
+	// DELETE /v2/webhooks/{id}
+	DeleteWebhook(ctx context.Context, params DeleteWebhookParams) error
 	// DnsResolve implements dnsResolve operation.
 	//
 	// DNS resolve for domain name.
@@ -92,6 +152,29 @@ type Handler interface {
 	//
 	// GET /v2/accounts/{account_id}
 	GetAccount(ctx context.Context, params GetAccountParams) (*Account, error)
+	// GetAccountActivity implements getAccountActivity operation.
+	//
+	// Get an activity feed of an account as wallets render it on the home screen. The feed merges
+	// confirmed events, traces that are still being executed and pending messages from the mempool into
+	// one list ordered from the newest item to the oldest one. Every item has an explicit status.
+	// Pending items are returned only on the first page, that is when before_lt is omitted.
+	//
+	// GET /v2/accounts/{account_id}/activity
+	GetAccountActivity(ctx context.Context, params GetAccountActivityParams) (*AccountActivity, error)
+	// GetAccountActivityHeatmap implements getAccountActivityHeatmap operation.
+	//
+	// Get numbers of account's transactions bucketed by hours or days, so an activity heatmap can be
+	// rendered without downloading the transaction history.
+	// Buckets are in UTC and include empty ones. Available only for accounts tracked by the indexer.
+	//
+	// GET /v2/accounts/{account_id}/heatmap
+	GetAccountActivityHeatmap(ctx context.Context, params GetAccountActivityHeatmapParams) (*AccountActivityHeatmap, error)
+	// GetAccountCodeHistory implements getAccountCodeHistory operation.
+	//
+	// Get the history of code upgrades of an account. Available only for accounts tracked by the indexer.
+	//
+	// GET /v2/accounts/{account_id}/code-history
+	GetAccountCodeHistory(ctx context.Context, params GetAccountCodeHistoryParams) (*AccountCodeHistory, error)
 	// GetAccountDiff implements getAccountDiff operation.
 	//
 	// Get account's balance change.
@@ -120,6 +203,17 @@ type Handler interface {
 	//
 	// GET /v2/accounts/{account_id}/events
 	GetAccountEvents(ctx context.Context, params GetAccountEventsParams) (*AccountEvents, error)
+	// GetAccountEventsDelta implements getAccountEventsDelta operation.
+	//
+	// Get changes of an account's events since a cursor, it is a poll-friendly alternative to streaming
+	// for clients that can't keep a connection open.
+	// A change is a new event or a new status of an event returned before, e.g. a pending event that has
+	// been confirmed or dropped from the mempool.
+	// The first request without since_cursor returns the latest events as changes, every response
+	// contains a cursor for the next request.
+	//
+	// GET /v2/accounts/{account_id}/events/delta
+	GetAccountEventsDelta(ctx context.Context, params GetAccountEventsDeltaParams) (*AccountEventsDelta, error)
 	// GetAccountInfoByStateInit implements getAccountInfoByStateInit operation.
 	//
 	// Get account info by state init.
@@ -202,10 +296,32 @@ type Handler interface {
 	GetAccountPublicKey(ctx context.Context, params GetAccountPublicKeyParams) (*GetAccountPublicKeyOK, error)
 	// GetAccountSeqno implements getAccountSeqno operation.
 	//
-	// Get account seqno.
+	// Get account seqno. Highload wallet v3 has no seqno, so its query ID capacity and timeout are
+	// returned instead.
 	//
 	// GET /v2/wallet/{account_id}/seqno
 	GetAccountSeqno(ctx context.Context, params GetAccountSeqnoParams) (*Seqno, error)
+	// GetAccountStateDiff implements getAccountStateDiff operation.
+	//
+	// Get the difference of account's state between two masterchain blocks, useful for audits and
+	// debugging of an unexpected balance drift.
+	//
+	// GET /v2/accounts/{account_id}/state-diff
+	GetAccountStateDiff(ctx context.Context, params GetAccountStateDiffParams) (*AccountStateDiff, error)
+	// GetAccountStats implements getAccountStats operation.
+	//
+	// Get aggregated statistics of account's transactions. Available only for accounts tracked by the
+	// indexer.
+	//
+	// GET /v2/accounts/{account_id}/stats
+	GetAccountStats(ctx context.Context, params GetAccountStatsParams) (*AccountStats, error)
+	// GetAccountStorageRent implements getAccountStorageRent operation.
+	//
+	// Get account's storage fee debt, rent per day at the current storage prices and projected dates
+	// when the account is frozen and deleted if its balance isn't topped up.
+	//
+	// GET /v2/accounts/{account_id}/storage-rent
+	GetAccountStorageRent(ctx context.Context, params GetAccountStorageRentParams) (*AccountStorageRent, error)
 	// GetAccountSubscriptions implements getAccountSubscriptions operation.
 	//
 	// Get all subscriptions by wallet address.
@@ -236,6 +352,13 @@ type Handler interface {
 	//
 	// GET /v2/liteserver/get_all_shards_info/{block_id}
 	GetAllRawShardsInfo(ctx context.Context, params GetAllRawShardsInfoParams) (*GetAllRawShardsInfoOK, error)
+	// GetBlockchainAccountLogs implements getBlockchainAccountLogs operation.
+	//
+	// Get external outbound messages emitted by the account, contracts often use such messages as event
+	// logs.
+	//
+	// GET /v2/blockchain/accounts/{account_id}/logs
+	GetBlockchainAccountLogs(ctx context.Context, params GetBlockchainAccountLogsParams) (*AccountLogs, error)
 	// GetBlockchainAccountTransactions implements getBlockchainAccountTransactions operation.
 	//
 	// Get account transactions.
@@ -260,12 +383,31 @@ type Handler interface {
 	//
 	// GET /v2/blockchain/config
 	GetBlockchainConfig(ctx context.Context) (*BlockchainConfig, error)
+	// GetBlockchainConfigContract implements getBlockchainConfigContract operation.
+	//
+	// Get the state of the config contract with pending proposals to change the blockchain config.
+	//
+	// GET /v2/blockchain/system/config
+	GetBlockchainConfigContract(ctx context.Context) (*ConfigContractState, error)
 	// GetBlockchainConfigFromBlock implements getBlockchainConfigFromBlock operation.
 	//
 	// Get blockchain config from a specific block, if present.
 	//
 	// GET /v2/blockchain/masterchain/{masterchain_seqno}/config
 	GetBlockchainConfigFromBlock(ctx context.Context, params GetBlockchainConfigFromBlockParams) (*BlockchainConfig, error)
+	// GetBlockchainElector implements getBlockchainElector operation.
+	//
+	// Get the state of the elector contract with the stakes of the current elections.
+	//
+	// GET /v2/blockchain/system/elector
+	GetBlockchainElector(ctx context.Context) (*ElectorState, error)
+	// GetBlockchainFees implements getBlockchainFees operation.
+	//
+	// Get current gas, forward and storage prices of the blockchain config along with estimated costs of
+	// typical operations.
+	//
+	// GET /v2/blockchain/fees
+	GetBlockchainFees(ctx context.Context) (*BlockchainFees, error)
 	// GetBlockchainMasterchainBlocks implements getBlockchainMasterchainBlocks operation.
 	//
 	// Get all blocks in all shards and workchains between target and previous masterchain block
@@ -294,6 +436,12 @@ type Handler interface {
 	//
 	// GET /v2/blockchain/masterchain/{masterchain_seqno}/transactions
 	GetBlockchainMasterchainTransactions(ctx context.Context, params GetBlockchainMasterchainTransactionsParams) (*Transactions, error)
+	// GetBlockchainMinter implements getBlockchainMinter operation.
+	//
+	// Get the state of the minter contract along with the total supply of TON.
+	//
+	// GET /v2/blockchain/system/minter
+	GetBlockchainMinter(ctx context.Context) (*MinterState, error)
 	// GetBlockchainRawAccount implements getBlockchainRawAccount operation.
 	//
 	// Get low-level information about an account taken directly from the blockchain.
@@ -318,12 +466,31 @@ type Handler interface {
 	//
 	// GET /v2/blockchain/validators
 	GetBlockchainValidators(ctx context.Context) (*Validators, error)
+	// GetBocHash implements getBocHash operation.
+	//
+	// Compute representation hashes of all roots of a bag-of-cells.
+	//
+	// POST /v2/boc/hash
+	GetBocHash(ctx context.Context, req *GetBocHashReq) (*BocHashes, error)
+	// GetChainCalendar implements getChainCalendar operation.
+	//
+	// Get upcoming events of the blockchain the server can compute in advance, like elections,
+	// validation round ends, stake unfreezes and vesting unlocks of tracked lockup wallets.
+	//
+	// GET /v2/blockchain/calendar
+	GetChainCalendar(ctx context.Context, params GetChainCalendarParams) (*ChainCalendar, error)
 	// GetChartRates implements getChartRates operation.
 	//
 	// Get chart by token.
 	//
 	// GET /v2/rates/chart
 	GetChartRates(ctx context.Context, params GetChartRatesParams) (*GetChartRatesOK, error)
+	// GetConfigProposals implements getConfigProposals operation.
+	//
+	// Get active proposals to change the blockchain config along with the voting status.
+	//
+	// GET /v2/blockchain/config/proposals
+	GetConfigProposals(ctx context.Context) (*ConfigProposals, error)
 	// GetDnsInfo implements getDnsInfo operation.
 	//
 	// Get full information about domain name.
@@ -347,6 +514,20 @@ type Handler interface {
 	//
 	// GET /v2/events/{event_id}
 	GetEvent(ctx context.Context, params GetEventParams) (*Event, error)
+	// GetEventAnnotation implements getEventAnnotation operation.
+	//
+	// Get a private annotation attached to the event. A tenant gets its own annotation, a token with the
+	// admin scope gets the operator's one.
+	//
+	// GET /v2/events/{event_id}/annotation
+	GetEventAnnotation(ctx context.Context, params GetEventAnnotationParams) (*EventAnnotation, error)
+	// GetExpectedDeposits implements getExpectedDeposits operation.
+	//
+	// Get expected deposits. A tenant gets its own expectations, a token with the admin scope gets all
+	// of them.
+	//
+	// GET /v2/deposits
+	GetExpectedDeposits(ctx context.Context, params GetExpectedDepositsParams) (*ExpectedDeposits, error)
 	// GetInscriptionOpTemplate implements getInscriptionOpTemplate operation.
 	//
 	// Return comment for making operation with inscription. please don't use it if you don't know what
@@ -396,12 +577,26 @@ type Handler interface {
 	//
 	// GET /v2/rates/markets
 	GetMarketsRates(ctx context.Context) (*GetMarketsRatesOK, error)
+	// GetMasterchainEta implements getMasterchainEta operation.
+	//
+	// Estimate when a future masterchain block is generated or when the next validation deadline comes.
+	// Estimations are based on the average masterchain block time over the last hour, so countdowns stay
+	// consistent between clients.
+	//
+	// GET /v2/blockchain/masterchain/eta
+	GetMasterchainEta(ctx context.Context, params GetMasterchainEtaParams) (*MasterchainEta, error)
 	// GetMultisigAccount implements getMultisigAccount operation.
 	//
 	// Get multisig account info.
 	//
 	// GET /v2/multisig/{account_id}
 	GetMultisigAccount(ctx context.Context, params GetMultisigAccountParams) (*Multisig, error)
+	// GetNetworkStats implements getNetworkStats operation.
+	//
+	// Get chain-wide statistics observed by the indexer.
+	//
+	// GET /v2/stats/network
+	GetNetworkStats(ctx context.Context) (*NetworkStats, error)
 	// GetNftCollection implements getNftCollection operation.
 	//
 	// Get NFT collection by collection address.
@@ -420,6 +615,13 @@ type Handler interface {
 	//
 	// GET /v2/nfts/{account_id}/history
 	GetNftHistoryByID(ctx context.Context, params GetNftHistoryByIDParams) (*AccountEvents, error)
+	// GetNftItemBids implements getNftItemBids operation.
+	//
+	// Get the history of bids on an NFT item or on an auction contract observed by this instance, the
+	// latest go first.
+	//
+	// GET /v2/nfts/{account_id}/bids
+	GetNftItemBids(ctx context.Context, params GetNftItemBidsParams) (*NftBids, error)
 	// GetNftItemByAddress implements getNftItemByAddress operation.
 	//
 	// Get NFT item by its address.
@@ -431,13 +633,26 @@ type Handler interface {
 	// Get NFT items by their addresses.
 	//
 	// POST /v2/nfts/_bulk
-	GetNftItemsByAddresses(ctx context.Context, req OptGetNftItemsByAddressesReq) (*NftItems, error)
+	GetNftItemsByAddresses(ctx context.Context, req OptGetNftItemsByAddressesReq, params GetNftItemsByAddressesParams) (*NftItems, error)
 	// GetOutMsgQueueSizes implements getOutMsgQueueSizes operation.
 	//
 	// Get out msg queue sizes.
 	//
 	// GET /v2/liteserver/get_out_msg_queue_sizes
 	GetOutMsgQueueSizes(ctx context.Context) (*GetOutMsgQueueSizesOK, error)
+	// GetPrivateLabel implements getPrivateLabel operation.
+	//
+	// Get a private label of an account. Requires a token with the admin scope.
+	//
+	// GET /v2/labels/{account_id}
+	GetPrivateLabel(ctx context.Context, params GetPrivateLabelParams) (*PrivateLabel, error)
+	// GetPrivateLabels implements getPrivateLabels operation.
+	//
+	// Get private labels and memos attached to accounts by the operator of the instance. Requires a
+	// token with the admin scope.
+	//
+	// GET /v2/labels
+	GetPrivateLabels(ctx context.Context) (*PrivateLabels, error)
 	// GetRates implements getRates operation.
 	//
 	// Get the token price in the chosen currency for display only. Don’t use this for financial
@@ -571,12 +786,33 @@ type Handler interface {
 	//
 	// GET /v2/tonconnect/payload
 	GetTonConnectPayload(ctx context.Context) (*GetTonConnectPayloadOK, error)
+	// GetTopAccounts implements getTopAccounts operation.
+	//
+	// Get top accounts by TON balance or activity among accounts tracked by the indexer. The list is
+	// refreshed periodically.
+	//
+	// GET /v2/accounts/top
+	GetTopAccounts(ctx context.Context, params GetTopAccountsParams) (*GetTopAccountsOK, error)
+	// GetTopJettonsByVolume implements getTopJettonsByVolume operation.
+	//
+	// Get jettons with the highest number of transfers observed by the indexer during the given period.
+	//
+	// GET /v2/jettons/top
+	GetTopJettonsByVolume(ctx context.Context, params GetTopJettonsByVolumeParams) (*GetTopJettonsByVolumeOK, error)
 	// GetTrace implements getTrace operation.
 	//
-	// Get the trace by trace ID or hash of any transaction in trace.
+	// Get the trace by trace ID, hash of any transaction in trace or any form of a hash of its inbound
+	// message. The root node points to the matched transaction.
 	//
 	// GET /v2/traces/{trace_id}
-	GetTrace(ctx context.Context, params GetTraceParams) (*Trace, error)
+	GetTrace(ctx context.Context, params GetTraceParams) (GetTraceRes, error)
+	// GetUsage implements getUsage operation.
+	//
+	// Get upstream resources consumed by API tokens since the start of the instance for internal
+	// chargeback. A tenant gets its own usage, a token with the admin scope gets usage of all tokens.
+	//
+	// GET /v2/usage
+	GetUsage(ctx context.Context) (*Usage, error)
 	// GetWalletBackup implements getWalletBackup operation.
 	//
 	// Get backup info.
@@ -589,18 +825,83 @@ type Handler interface {
 	//
 	// GET /v2/pubkeys/{public_key}/wallets
 	GetWalletsByPublicKey(ctx context.Context, params GetWalletsByPublicKeyParams) (*Accounts, error)
+	// GetWebhooks implements getWebhooks operation.
+	//
+	// Get webhooks along with their delivery statuses. A tenant gets its own webhooks, a token with the
+	// admin scope gets all of them.
+	//
+	// GET /v2/webhooks
+	GetWebhooks(ctx context.Context) (*Webhooks, error)
+	// InspectBoc implements inspectBoc operation.
+	//
+	// Inspect a bag-of-cells and return its cells with depths and references along with a printable tree.
+	//
+	// POST /v2/boc/inspect
+	InspectBoc(ctx context.Context, req *InspectBocReq) (*BocInspection, error)
+	// InspectContractCode implements inspectContractCode operation.
+	//
+	// Detect interfaces and get methods of a contract by its code or state init, the contract doesn't
+	// have to be deployed.
+	//
+	// POST /v2/boc/contract
+	InspectContractCode(ctx context.Context, req *InspectContractCodeReq) (*ContractCodeInspection, error)
+	// ParseStateInit implements parseStateInit operation.
+	//
+	// Extract code, data and other components of a state init.
+	//
+	// POST /v2/boc/stateinit
+	ParseStateInit(ctx context.Context, req *ParseStateInitReq, params ParseStateInitParams) (*StateInitComponents, error)
+	// RecoverWallets implements recoverWallets operation.
+	//
+	// Scan wallets of the given versions and subwallet IDs derived from the public key and summarize the
+	// deployed ones.
+	//
+	// POST /v2/pubkeys/{public_key}/wallets/recover
+	RecoverWallets(ctx context.Context, req OptRecoverWalletsReq, params RecoverWalletsParams) (*WalletRecovery, error)
 	// ReindexAccount implements reindexAccount operation.
 	//
 	// Update internal cache for a particular account.
 	//
 	// POST /v2/accounts/{account_id}/reindex
 	ReindexAccount(ctx context.Context, params ReindexAccountParams) error
+	// ResolveMessageHash implements resolveMessageHash operation.
+	//
+	// Resolve any form of a message hash to the transaction created by the message and its trace.
+	// External inbound messages are indexed by the following hashes:
+	// * full - the hash of the message cell exactly as it is included in a block;
+	// * normalized - the hash of the message normalized according to TEP-467:
+	// src is replaced with addr_none, import_fee with 0, init is dropped and body is stored in a
+	// reference.
+	// It doesn't depend on how the message was serialized, so it's recommended to track sent messages by
+	// this hash;
+	// * body - the hash of the message body;
+	// * signed_payload - the hash of the message body without its leading 512-bit signature, this is
+	// what a wallet signs.
+	// Internal messages are indexed by the full hash only.
+	//
+	// GET /v2/blockchain/messages/{msg_id}/resolve
+	ResolveMessageHash(ctx context.Context, params ResolveMessageHashParams) (*MessageHashResolution, error)
+	// RotateWebhookSecret implements rotateWebhookSecret operation.
+	//
+	// Replace a secret signing notifications to a webhook, the new secret is returned only in this
+	// response. During the grace period notifications are signed with both the new and the previous
+	// secrets, so a receiver can switch to the new one without failing deliveries.
+	//
+	// POST /v2/webhooks/{id}/rotate-secret
+	RotateWebhookSecret(ctx context.Context, params RotateWebhookSecretParams) (*Webhook, error)
 	// SearchAccounts implements searchAccounts operation.
 	//
 	// Search by account domain name.
 	//
 	// GET /v2/accounts/search
 	SearchAccounts(ctx context.Context, params SearchAccountsParams) (*FoundAccounts, error)
+	// SearchMessages implements searchMessages operation.
+	//
+	// Search indexed transactions of accounts tracked by the indexer by a text comment or a payload hash
+	// of their inbound or outbound messages. The latest transactions go first.
+	//
+	// GET /v2/messages/search
+	SearchMessages(ctx context.Context, params SearchMessagesParams) (*Transactions, error)
 	// SendBlockchainMessage implements sendBlockchainMessage operation.
 	//
 	// Send message to blockchain.
@@ -613,12 +914,37 @@ type Handler interface {
 	//
 	// POST /v2/liteserver/send_message
 	SendRawMessage(ctx context.Context, req *SendRawMessageReq) (*SendRawMessageOK, error)
+	// SetEventAnnotation implements setEventAnnotation operation.
+	//
+	// Attach a private annotation to the event replacing the previous one. The annotation is returned
+	// along with the event to the same tenant only. Requires a tenant token or a token with the admin
+	// scope.
+	//
+	// PUT /v2/events/{event_id}/annotation
+	SetEventAnnotation(ctx context.Context, req *SetEventAnnotationReq, params SetEventAnnotationParams) (*EventAnnotation, error)
+	// SetPrivateLabel implements setPrivateLabel operation.
+	//
+	// Attach a private label and a memo to an account, they are returned along with the account to
+	// tokens with the admin scope only and never published in the public address book. Requires a token
+	// with the admin scope.
+	//
+	// PUT /v2/labels/{account_id}
+	SetPrivateLabel(ctx context.Context, req *SetPrivateLabelReq, params SetPrivateLabelParams) (*PrivateLabel, error)
 	// SetWalletBackup implements setWalletBackup operation.
 	//
 	// Set backup info.
 	//
 	// PUT /v2/wallet/backup
 	SetWalletBackup(ctx context.Context, req SetWalletBackupReq, params SetWalletBackupParams) error
+	// SimulateAccountTransaction implements simulateAccountTransaction operation.
+	//
+	// Inject a synthetic transaction of the account into streaming subscriptions (SSE and websocket), so
+	// deposit processing can be tested end-to-end without real transfers. The transaction doesn't exist
+	// on-chain, notifications about it are flagged with "simulated". The endpoint is available only on
+	// testnet instances and requires a token with admin scope.
+	//
+	// POST /v2/accounts/{account_id}/transactions/simulate
+	SimulateAccountTransaction(ctx context.Context, req OptSimulateAccountTransactionReq, params SimulateAccountTransactionParams) (*SimulatedTransaction, error)
 	// Status implements status operation.
 	//
 	// Status.
@@ -22,6 +22,30 @@ func (UnimplementedHandler) AccountDnsBackResolve(ctx context.Context, params Ac
 	return r, ht.ErrNotImplemented
 }
 
+// AddExpectedDeposit implements addExpectedDeposit operation.
+//
+// Register an expected deposit. Incoming transfers to the account are classified as matched or
+// unmatched and streamed via /v2/sse/deposits. The account must be tracked by the instance. Requires
+// a tenant token or a token with the admin scope.
+//
+// POST /v2/deposits
+func (UnimplementedHandler) AddExpectedDeposit(ctx context.Context, req *AddExpectedDepositReq) (r *ExpectedDeposit, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddWebhook implements addWebhook operation.
+//
+// Register a callback URL receiving notifications about transactions and traces of the accounts for
+// backend services that can't hold a streaming connection. Every notification is POSTed to the URL,
+// failed deliveries are retried with an exponential backoff. Notifications are signed with a secret
+// returned only in this response. Requires a tenant token or a token with the admin scope. A tenant
+// can register up to 100 webhooks.
+//
+// POST /v2/webhooks
+func (UnimplementedHandler) AddWebhook(ctx context.Context, req *AddWebhookReq) (r *Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AddressParse implements addressParse operation.
 //
 // Parse address and display in all formats.
@@ -40,6 +64,30 @@ func (UnimplementedHandler) BlockchainAccountInspect(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// BuildWalletTransfer implements buildWalletTransfer operation.
+//
+// Construct an unsigned body of an external message to a wallet transferring TON, jettons or NFTs.
+// The hash of the body is signed externally, the signature is written before (wallets v3 and v4) or
+// after (wallet v5) the bits of the body, and the result is sent in an external message to the
+// wallet.
+//
+// POST /v2/wallet/{account_id}/transfer/build
+func (UnimplementedHandler) BuildWalletTransfer(ctx context.Context, req *BuildWalletTransferReq, params BuildWalletTransferParams) (r *UnsignedWalletTransfer, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CheckAccountBounce implements checkAccountBounce operation.
+//
+// Predict whether a transfer to the account will bounce, so a wallet can warn a user before sending.
+// The prediction is based on the current state of the account and an emulation of the transfer
+// against it, so it doesn't take into account transactions happening before the transfer is
+// delivered.
+//
+// POST /v2/accounts/{account_id}/bounce-check
+func (UnimplementedHandler) CheckAccountBounce(ctx context.Context, req *CheckAccountBounceReq, params CheckAccountBounceParams) (r *BouncePrediction, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DecodeMessage implements decodeMessage operation.
 //
 // Decode a given message. Only external incoming messages can be decoded currently.
@@ -49,6 +97,42 @@ func (UnimplementedHandler) DecodeMessage(ctx context.Context, req *DecodeMessag
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteEventAnnotation implements deleteEventAnnotation operation.
+//
+// Remove a private annotation attached to the event.
+//
+// DELETE /v2/events/{event_id}/annotation
+func (UnimplementedHandler) DeleteEventAnnotation(ctx context.Context, params DeleteEventAnnotationParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeleteExpectedDeposit implements deleteExpectedDeposit operation.
+//
+// Remove an expected deposit.
+//
+// DELETE /v2/deposits/{id}
+func (UnimplementedHandler) DeleteExpectedDeposit(ctx context.Context, params DeleteExpectedDepositParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeletePrivateLabel implements deletePrivateLabel operation.
+//
+// Remove a private label of an account. Requires a token with the admin scope.
+//
+// DELETE /v2/labels/{account_id}
+func (UnimplementedHandler) DeletePrivateLabel(ctx context.Context, params DeletePrivateLabelParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeleteWebhook implements deleteWebhook operation.
+//
+// Remove a webhook, its pending notifications are dropped.
+//
+// DELETE /v2/webhooks/{id}
+func (UnimplementedHandler) DeleteWebhook(ctx context.Context, params DeleteWebhookParams) error {
+	return ht.ErrNotImplemented
+}
+
 // DnsResolve implements dnsResolve operation.
 //
 // DNS resolve for domain name.
@@ -139,6 +223,38 @@ func (UnimplementedHandler) GetAccount(ctx context.Context, params GetAccountPar
 	return r, ht.ErrNotImplemented
 }
 
+// GetAccountActivity implements getAccountActivity operation.
+//
+// Get an activity feed of an account as wallets render it on the home screen. The feed merges
+// confirmed events, traces that are still being executed and pending messages from the mempool into
+// one list ordered from the newest item to the oldest one. Every item has an explicit status.
+// Pending items are returned only on the first page, that is when before_lt is omitted.
+//
+// GET /v2/accounts/{account_id}/activity
+func (UnimplementedHandler) GetAccountActivity(ctx context.Context, params GetAccountActivityParams) (r *AccountActivity, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAccountActivityHeatmap implements getAccountActivityHeatmap operation.
+//
+// Get numbers of account's transactions bucketed by hours or days, so an activity heatmap can be
+// rendered without downloading the transaction history.
+// Buckets are in UTC and include empty ones. Available only for accounts tracked by the indexer.
+//
+// GET /v2/accounts/{account_id}/heatmap
+func (UnimplementedHandler) GetAccountActivityHeatmap(ctx context.Context, params GetAccountActivityHeatmapParams) (r *AccountActivityHeatmap, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAccountCodeHistory implements getAccountCodeHistory operation.
+//
+// Get the history of code upgrades of an account. Available only for accounts tracked by the indexer.
+//
+// GET /v2/accounts/{account_id}/code-history
+func (UnimplementedHandler) GetAccountCodeHistory(ctx context.Context, params GetAccountCodeHistoryParams) (r *AccountCodeHistory, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetAccountDiff implements getAccountDiff operation.
 //
 // Get account's balance change.
@@ -179,6 +295,20 @@ func (UnimplementedHandler) GetAccountEvents(ctx context.Context, params GetAcco
 	return r, ht.ErrNotImplemented
 }
 
+// GetAccountEventsDelta implements getAccountEventsDelta operation.
+//
+// Get changes of an account's events since a cursor, it is a poll-friendly alternative to streaming
+// for clients that can't keep a connection open.
+// A change is a new event or a new status of an event returned before, e.g. a pending event that has
+// been confirmed or dropped from the mempool.
+// The first request without since_cursor returns the latest events as changes, every response
+// contains a cursor for the next request.
+//
+// GET /v2/accounts/{account_id}/events/delta
+func (UnimplementedHandler) GetAccountEventsDelta(ctx context.Context, params GetAccountEventsDeltaParams) (r *AccountEventsDelta, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetAccountInfoByStateInit implements getAccountInfoByStateInit operation.
 //
 // Get account info by state init.
@@ -300,13 +430,44 @@ func (UnimplementedHandler) GetAccountPublicKey(ctx context.Context, params GetA
 
 // GetAccountSeqno implements getAccountSeqno operation.
 //
-// Get account seqno.
+// Get account seqno. Highload wallet v3 has no seqno, so its query ID capacity and timeout are
+// returned instead.
 //
 // GET /v2/wallet/{account_id}/seqno
 func (UnimplementedHandler) GetAccountSeqno(ctx context.Context, params GetAccountSeqnoParams) (r *Seqno, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetAccountStateDiff implements getAccountStateDiff operation.
+//
+// Get the difference of account's state between two masterchain blocks, useful for audits and
+// debugging of an unexpected balance drift.
+//
+// GET /v2/accounts/{account_id}/state-diff
+func (UnimplementedHandler) GetAccountStateDiff(ctx context.Context, params GetAccountStateDiffParams) (r *AccountStateDiff, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAccountStats implements getAccountStats operation.
+//
+// Get aggregated statistics of account's transactions. Available only for accounts tracked by the
+// indexer.
+//
+// GET /v2/accounts/{account_id}/stats
+func (UnimplementedHandler) GetAccountStats(ctx context.Context, params GetAccountStatsParams) (r *AccountStats, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAccountStorageRent implements getAccountStorageRent operation.
+//
+// Get account's storage fee debt, rent per day at the current storage prices and projected dates
+// when the account is frozen and deleted if its balance isn't topped up.
+//
+// GET /v2/accounts/{account_id}/storage-rent
+func (UnimplementedHandler) GetAccountStorageRent(ctx context.Context, params GetAccountStorageRentParams) (r *AccountStorageRent, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetAccountSubscriptions implements getAccountSubscriptions operation.
 //
 // Get all subscriptions by wallet address.
@@ -352,6 +513,16 @@ func (UnimplementedHandler) GetAllRawShardsInfo(ctx context.Context, params GetA
 	return r, ht.ErrNotImplemented
 }
 
+// GetBlockchainAccountLogs implements getBlockchainAccountLogs operation.
+//
+// Get external outbound messages emitted by the account, contracts often use such messages as event
+// logs.
+//
+// GET /v2/blockchain/accounts/{account_id}/logs
+func (UnimplementedHandler) GetBlockchainAccountLogs(ctx context.Context, params GetBlockchainAccountLogsParams) (r *AccountLogs, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetBlockchainAccountTransactions implements getBlockchainAccountTransactions operation.
 //
 // Get account transactions.
@@ -388,6 +559,15 @@ func (UnimplementedHandler) GetBlockchainConfig(ctx context.Context) (r *Blockch
 	return r, ht.ErrNotImplemented
 }
 
+// GetBlockchainConfigContract implements getBlockchainConfigContract operation.
+//
+// Get the state of the config contract with pending proposals to change the blockchain config.
+//
+// GET /v2/blockchain/system/config
+func (UnimplementedHandler) GetBlockchainConfigContract(ctx context.Context) (r *ConfigContractState, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetBlockchainConfigFromBlock implements getBlockchainConfigFromBlock operation.
 //
 // Get blockchain config from a specific block, if present.
@@ -397,6 +577,25 @@ func (UnimplementedHandler) GetBlockchainConfigFromBlock(ctx context.Context, pa
 	return r, ht.ErrNotImplemented
 }
 
+// GetBlockchainElector implements getBlockchainElector operation.
+//
+// Get the state of the elector contract with the stakes of the current elections.
+//
+// GET /v2/blockchain/system/elector
+func (UnimplementedHandler) GetBlockchainElector(ctx context.Context) (r *ElectorState, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBlockchainFees implements getBlockchainFees operation.
+//
+// Get current gas, forward and storage prices of the blockchain config along with estimated costs of
+// typical operations.
+//
+// GET /v2/blockchain/fees
+func (UnimplementedHandler) GetBlockchainFees(ctx context.Context) (r *BlockchainFees, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetBlockchainMasterchainBlocks implements getBlockchainMasterchainBlocks operation.
 //
 // Get all blocks in all shards and workchains between target and previous masterchain block
@@ -437,6 +636,15 @@ func (UnimplementedHandler) GetBlockchainMasterchainTransactions(ctx context.Con
 	return r, ht.ErrNotImplemented
 }
 
+// GetBlockchainMinter implements getBlockchainMinter operation.
+//
+// Get the state of the minter contract along with the total supply of TON.
+//
+// GET /v2/blockchain/system/minter
+func (UnimplementedHandler) GetBlockchainMinter(ctx context.Context) (r *MinterState, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetBlockchainRawAccount implements getBlockchainRawAccount operation.
 //
 // Get low-level information about an account taken directly from the blockchain.
@@ -473,6 +681,25 @@ func (UnimplementedHandler) GetBlockchainValidators(ctx context.Context) (r *Val
 	return r, ht.ErrNotImplemented
 }
 
+// GetBocHash implements getBocHash operation.
+//
+// Compute representation hashes of all roots of a bag-of-cells.
+//
+// POST /v2/boc/hash
+func (UnimplementedHandler) GetBocHash(ctx context.Context, req *GetBocHashReq) (r *BocHashes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetChainCalendar implements getChainCalendar operation.
+//
+// Get upcoming events of the blockchain the server can compute in advance, like elections,
+// validation round ends, stake unfreezes and vesting unlocks of tracked lockup wallets.
+//
+// GET /v2/blockchain/calendar
+func (UnimplementedHandler) GetChainCalendar(ctx context.Context, params GetChainCalendarParams) (r *ChainCalendar, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetChartRates implements getChartRates operation.
 //
 // Get chart by token.
@@ -482,6 +709,15 @@ func (UnimplementedHandler) GetChartRates(ctx context.Context, params GetChartRa
 	return r, ht.ErrNotImplemented
 }
 
+// GetConfigProposals implements getConfigProposals operation.
+//
+// Get active proposals to change the blockchain config along with the voting status.
+//
+// GET /v2/blockchain/config/proposals
+func (UnimplementedHandler) GetConfigProposals(ctx context.Context) (r *ConfigProposals, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetDnsInfo implements getDnsInfo operation.
 //
 // Get full information about domain name.
@@ -514,6 +750,26 @@ func (UnimplementedHandler) GetEvent(ctx context.Context, params GetEventParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetEventAnnotation implements getEventAnnotation operation.
+//
+// Get a private annotation attached to the event. A tenant gets its own annotation, a token with the
+// admin scope gets the operator's one.
+//
+// GET /v2/events/{event_id}/annotation
+func (UnimplementedHandler) GetEventAnnotation(ctx context.Context, params GetEventAnnotationParams) (r *EventAnnotation, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetExpectedDeposits implements getExpectedDeposits operation.
+//
+// Get expected deposits. A tenant gets its own expectations, a token with the admin scope gets all
+// of them.
+//
+// GET /v2/deposits
+func (UnimplementedHandler) GetExpectedDeposits(ctx context.Context, params GetExpectedDepositsParams) (r *ExpectedDeposits, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetInscriptionOpTemplate implements getInscriptionOpTemplate operation.
 //
 // Return comment for making operation with inscription. please don't use it if you don't know what
@@ -587,6 +843,17 @@ func (UnimplementedHandler) GetMarketsRates(ctx context.Context) (r *GetMarketsR
 	return r, ht.ErrNotImplemented
 }
 
+// GetMasterchainEta implements getMasterchainEta operation.
+//
+// Estimate when a future masterchain block is generated or when the next validation deadline comes.
+// Estimations are based on the average masterchain block time over the last hour, so countdowns stay
+// consistent between clients.
+//
+// GET /v2/blockchain/masterchain/eta
+func (UnimplementedHandler) GetMasterchainEta(ctx context.Context, params GetMasterchainEtaParams) (r *MasterchainEta, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetMultisigAccount implements getMultisigAccount operation.
 //
 // Get multisig account info.
@@ -596,6 +863,15 @@ func (UnimplementedHandler) GetMultisigAccount(ctx context.Context, params GetMu
 	return r, ht.ErrNotImplemented
 }
 
+// GetNetworkStats implements getNetworkStats operation.
+//
+// Get chain-wide statistics observed by the indexer.
+//
+// GET /v2/stats/network
+func (UnimplementedHandler) GetNetworkStats(ctx context.Context) (r *NetworkStats, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetNftCollection implements getNftCollection operation.
 //
 // Get NFT collection by collection address.
@@ -623,6 +899,16 @@ func (UnimplementedHandler) GetNftHistoryByID(ctx context.Context, params GetNft
 	return r, ht.ErrNotImplemented
 }
 
+// GetNftItemBids implements getNftItemBids operation.
+//
+// Get the history of bids on an NFT item or on an auction contract observed by this instance, the
+// latest go first.
+//
+// GET /v2/nfts/{account_id}/bids
+func (UnimplementedHandler) GetNftItemBids(ctx context.Context, params GetNftItemBidsParams) (r *NftBids, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetNftItemByAddress implements getNftItemByAddress operation.
 //
 // Get NFT item by its address.
@@ -637,7 +923,7 @@ func (UnimplementedHandler) GetNftItemByAddress(ctx context.Context, params GetN
 // Get NFT items by their addresses.
 //
 // POST /v2/nfts/_bulk
-func (UnimplementedHandler) GetNftItemsByAddresses(ctx context.Context, req OptGetNftItemsByAddressesReq) (r *NftItems, _ error) {
+func (UnimplementedHandler) GetNftItemsByAddresses(ctx context.Context, req OptGetNftItemsByAddressesReq, params GetNftItemsByAddressesParams) (r *NftItems, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -650,6 +936,25 @@ func (UnimplementedHandler) GetOutMsgQueueSizes(ctx context.Context) (r *GetOutM
 	return r, ht.ErrNotImplemented
 }
 
+// GetPrivateLabel implements getPrivateLabel operation.
+//
+// Get a private label of an account. Requires a token with the admin scope.
+//
+// GET /v2/labels/{account_id}
+func (UnimplementedHandler) GetPrivateLabel(ctx context.Context, params GetPrivateLabelParams) (r *PrivateLabel, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPrivateLabels implements getPrivateLabels operation.
+//
+// Get private labels and memos attached to accounts by the operator of the instance. Requires a
+// token with the admin scope.
+//
+// GET /v2/labels
+func (UnimplementedHandler) GetPrivateLabels(ctx context.Context) (r *PrivateLabels, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetRates implements getRates operation.
 //
 // Get the token price in the chosen currency for display only. Don’t use this for financial
@@ -849,12 +1154,42 @@ func (UnimplementedHandler) GetTonConnectPayload(ctx context.Context) (r *GetTon
 	return r, ht.ErrNotImplemented
 }
 
+// GetTopAccounts implements getTopAccounts operation.
+//
+// Get top accounts by TON balance or activity among accounts tracked by the indexer. The list is
+// refreshed periodically.
+//
+// GET /v2/accounts/top
+func (UnimplementedHandler) GetTopAccounts(ctx context.Context, params GetTopAccountsParams) (r *GetTopAccountsOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetTopJettonsByVolume implements getTopJettonsByVolume operation.
+//
+// Get jettons with the highest number of transfers observed by the indexer during the given period.
+//
+// GET /v2/jettons/top
+func (UnimplementedHandler) GetTopJettonsByVolume(ctx context.Context, params GetTopJettonsByVolumeParams) (r *GetTopJettonsByVolumeOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTrace implements getTrace operation.
 //
-// Get the trace by trace ID or hash of any transaction in trace.
+// Get the trace by trace ID, hash of any transaction in trace or any form of a hash of its inbound
+// message. The root node points to the matched transaction.
 //
 // GET /v2/traces/{trace_id}
-func (UnimplementedHandler) GetTrace(ctx context.Context, params GetTraceParams) (r *Trace, _ error) {
+func (UnimplementedHandler) GetTrace(ctx context.Context, params GetTraceParams) (r GetTraceRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetUsage implements getUsage operation.
+//
+// Get upstream resources consumed by API tokens since the start of the instance for internal
+// chargeback. A tenant gets its own usage, a token with the admin scope gets usage of all tokens.
+//
+// GET /v2/usage
+func (UnimplementedHandler) GetUsage(ctx context.Context) (r *Usage, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -876,6 +1211,54 @@ func (UnimplementedHandler) GetWalletsByPublicKey(ctx context.Context, params Ge
 	return r, ht.ErrNotImplemented
 }
 
+// GetWebhooks implements getWebhooks operation.
+//
+// Get webhooks along with their delivery statuses. A tenant gets its own webhooks, a token with the
+// admin scope gets all of them.
+//
+// GET /v2/webhooks
+func (UnimplementedHandler) GetWebhooks(ctx context.Context) (r *Webhooks, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// InspectBoc implements inspectBoc operation.
+//
+// Inspect a bag-of-cells and return its cells with depths and references along with a printable tree.
+//
+// POST /v2/boc/inspect
+func (UnimplementedHandler) InspectBoc(ctx context.Context, req *InspectBocReq) (r *BocInspection, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// InspectContractCode implements inspectContractCode operation.
+//
+// Detect interfaces and get methods of a contract by its code or state init, the contract doesn't
+// have to be deployed.
+//
+// POST /v2/boc/contract
+func (UnimplementedHandler) InspectContractCode(ctx context.Context, req *InspectContractCodeReq) (r *ContractCodeInspection, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ParseStateInit implements parseStateInit operation.
+//
+// Extract code, data and other components of a state init.
+//
+// POST /v2/boc/stateinit
+func (UnimplementedHandler) ParseStateInit(ctx context.Context, req *ParseStateInitReq, params ParseStateInitParams) (r *StateInitComponents, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RecoverWallets implements recoverWallets operation.
+//
+// Scan wallets of the given versions and subwallet IDs derived from the public key and summarize the
+// deployed ones.
+//
+// POST /v2/pubkeys/{public_key}/wallets/recover
+func (UnimplementedHandler) RecoverWallets(ctx context.Context, req OptRecoverWalletsReq, params RecoverWalletsParams) (r *WalletRecovery, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ReindexAccount implements reindexAccount operation.
 //
 // Update internal cache for a particular account.
@@ -885,6 +1268,37 @@ func (UnimplementedHandler) ReindexAccount(ctx context.Context, params ReindexAc
 	return ht.ErrNotImplemented
 }
 
+// ResolveMessageHash implements resolveMessageHash operation.
+//
+// Resolve any form of a message hash to the transaction created by the message and its trace.
+// External inbound messages are indexed by the following hashes:
+// * full - the hash of the message cell exactly as it is included in a block;
+// * normalized - the hash of the message normalized according to TEP-467:
+// src is replaced with addr_none, import_fee with 0, init is dropped and body is stored in a
+// reference.
+// It doesn't depend on how the message was serialized, so it's recommended to track sent messages by
+// this hash;
+// * body - the hash of the message body;
+// * signed_payload - the hash of the message body without its leading 512-bit signature, this is
+// what a wallet signs.
+// Internal messages are indexed by the full hash only.
+//
+// GET /v2/blockchain/messages/{msg_id}/resolve
+func (UnimplementedHandler) ResolveMessageHash(ctx context.Context, params ResolveMessageHashParams) (r *MessageHashResolution, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RotateWebhookSecret implements rotateWebhookSecret operation.
+//
+// Replace a secret signing notifications to a webhook, the new secret is returned only in this
+// response. During the grace period notifications are signed with both the new and the previous
+// secrets, so a receiver can switch to the new one without failing deliveries.
+//
+// POST /v2/webhooks/{id}/rotate-secret
+func (UnimplementedHandler) RotateWebhookSecret(ctx context.Context, params RotateWebhookSecretParams) (r *Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SearchAccounts implements searchAccounts operation.
 //
 // Search by account domain name.
@@ -894,6 +1308,16 @@ func (UnimplementedHandler) SearchAccounts(ctx context.Context, params SearchAcc
 	return r, ht.ErrNotImplemented
 }
 
+// SearchMessages implements searchMessages operation.
+//
+// Search indexed transactions of accounts tracked by the indexer by a text comment or a payload hash
+// of their inbound or outbound messages. The latest transactions go first.
+//
+// GET /v2/messages/search
+func (UnimplementedHandler) SearchMessages(ctx context.Context, params SearchMessagesParams) (r *Transactions, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SendBlockchainMessage implements sendBlockchainMessage operation.
 //
 // Send message to blockchain.
@@ -912,6 +1336,28 @@ func (UnimplementedHandler) SendRawMessage(ctx context.Context, req *SendRawMess
 	return r, ht.ErrNotImplemented
 }
 
+// SetEventAnnotation implements setEventAnnotation operation.
+//
+// Attach a private annotation to the event replacing the previous one. The annotation is returned
+// along with the event to the same tenant only. Requires a tenant token or a token with the admin
+// scope.
+//
+// PUT /v2/events/{event_id}/annotation
+func (UnimplementedHandler) SetEventAnnotation(ctx context.Context, req *SetEventAnnotationReq, params SetEventAnnotationParams) (r *EventAnnotation, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SetPrivateLabel implements setPrivateLabel operation.
+//
+// Attach a private label and a memo to an account, they are returned along with the account to
+// tokens with the admin scope only and never published in the public address book. Requires a token
+// with the admin scope.
+//
+// PUT /v2/labels/{account_id}
+func (UnimplementedHandler) SetPrivateLabel(ctx context.Context, req *SetPrivateLabelReq, params SetPrivateLabelParams) (r *PrivateLabel, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SetWalletBackup implements setWalletBackup operation.
 //
 // Set backup info.
@@ -921,6 +1367,18 @@ func (UnimplementedHandler) SetWalletBackup(ctx context.Context, req SetWalletBa
 	return ht.ErrNotImplemented
 }
 
+// SimulateAccountTransaction implements simulateAccountTransaction operation.
+//
+// Inject a synthetic transaction of the account into streaming subscriptions (SSE and websocket), so
+// deposit processing can be tested end-to-end without real transfers. The transaction doesn't exist
+// on-chain, notifications about it are flagged with "simulated". The endpoint is available only on
+// testnet instances and requires a token with admin scope.
+//
+// POST /v2/accounts/{account_id}/transactions/simulate
+func (UnimplementedHandler) SimulateAccountTransaction(ctx context.Context, req OptSimulateAccountTransactionReq, params SimulateAccountTransactionParams) (r *SimulatedTransaction, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Status implements status operation.
 //
 // Status.
@@ -0,0 +1,172 @@
+// Package profiling exposes pprof endpoints and continuously captures profiles of a running instance,
+// so profiles taken before and after a release can be compared.
+package profiling
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	runtimepprof "runtime/pprof"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Handler serves the standard pprof endpoints under /debug/pprof/.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
+
+// Kinds of captured profiles.
+const (
+	KindCPU       = "cpu"
+	KindHeap      = "heap"
+	KindGoroutine = "goroutine"
+)
+
+// Profile is a profile captured by Capturer.
+type Profile struct {
+	Name string    `json:"name"`
+	Kind string    `json:"kind"`
+	Time time.Time `json:"time"`
+	Size int       `json:"size"`
+	data []byte
+}
+
+// Options configures continuous capturing of profiles.
+type Options struct {
+	// Interval is a period between two captures.
+	Interval time.Duration
+	// CPUDuration is how long a CPU profile is recorded, it must be shorter than Interval.
+	CPUDuration time.Duration
+	// Keep is a number of the latest captures kept in memory.
+	Keep int
+}
+
+// Capturer captures CPU, heap and goroutine profiles every Options.Interval
+// and keeps profiles of the latest Options.Keep captures.
+type Capturer struct {
+	logger  *zap.Logger
+	options Options
+
+	// mu protects profiles.
+	mu sync.RWMutex
+	// profiles are ordered from the oldest to the latest.
+	profiles []Profile
+}
+
+func NewCapturer(logger *zap.Logger, options Options) *Capturer {
+	if options.CPUDuration <= 0 || options.CPUDuration >= options.Interval {
+		options.CPUDuration = options.Interval / 2
+	}
+	if options.Keep <= 0 {
+		options.Keep = 1
+	}
+	return &Capturer{logger: logger, options: options}
+}
+
+func (c *Capturer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.options.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.capture(ctx)
+		}
+	}
+}
+
+func (c *Capturer) capture(ctx context.Context) {
+	now := time.Now()
+	if profile, err := captureCPU(ctx, c.options.CPUDuration); err != nil {
+		// a CPU profile requested at /debug/pprof/profile is being recorded at the moment.
+		c.logger.Warn("failed to capture cpu profile", zap.Error(err))
+	} else {
+		c.add(KindCPU, now, profile)
+	}
+	for _, kind := range []string{KindHeap, KindGoroutine} {
+		var buf bytes.Buffer
+		if err := runtimepprof.Lookup(kind).WriteTo(&buf, 0); err != nil {
+			c.logger.Warn("failed to capture profile", zap.String("kind", kind), zap.Error(err))
+			continue
+		}
+		c.add(kind, now, buf.Bytes())
+	}
+}
+
+func captureCPU(ctx context.Context, duration time.Duration) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := runtimepprof.StartCPUProfile(&buf); err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(duration):
+	}
+	runtimepprof.StopCPUProfile()
+	return buf.Bytes(), nil
+}
+
+func (c *Capturer) add(kind string, t time.Time, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.profiles = append(c.profiles, Profile{
+		Name: fmt.Sprintf("%s-%d.pb.gz", kind, t.Unix()),
+		Kind: kind,
+		Time: t,
+		Size: len(data),
+		data: data,
+	})
+	// every capture produces a profile of each kind.
+	if limit := c.options.Keep * 3; len(c.profiles) > limit {
+		c.profiles = append([]Profile{}, c.profiles[len(c.profiles)-limit:]...)
+	}
+}
+
+// Profiles returns captured profiles ordered from the oldest to the latest.
+func (c *Capturer) Profiles() []Profile {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	profiles := make([]Profile, len(c.profiles))
+	copy(profiles, c.profiles)
+	return profiles
+}
+
+// Handler lists captured profiles at the prefix and serves a profile at the prefix followed by its name,
+// a profile is served in the pprof format, e.g. <prefix>/cpu-1700000000.pb.gz.
+func (c *Capturer) Handler(prefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		name := strings.TrimPrefix(r.URL.Path, prefix)
+		if name == "" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(c.Profiles())
+			return
+		}
+		for _, profile := range c.Profiles() {
+			if profile.Name == name {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+				_, _ = w.Write(profile.data)
+				return
+			}
+		}
+		http.Error(w, "profile not found", http.StatusNotFound)
+	})
+}
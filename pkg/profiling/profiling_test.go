@@ -0,0 +1,51 @@
+package profiling
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCapturer_add(t *testing.T) {
+	c := NewCapturer(zap.L(), Options{Interval: time.Minute, Keep: 2})
+	start := time.Unix(1700000000, 0)
+	for i := 0; i < 3; i++ {
+		for _, kind := range []string{KindCPU, KindHeap, KindGoroutine} {
+			c.add(kind, start.Add(time.Duration(i)*time.Minute), []byte{byte(i)})
+		}
+	}
+	profiles := c.Profiles()
+	require.Len(t, profiles, 6)
+	require.Equal(t, "cpu-1700000060.pb.gz", profiles[0].Name)
+	require.Equal(t, "goroutine-1700000120.pb.gz", profiles[5].Name)
+}
+
+func TestCapturer_Handler(t *testing.T) {
+	c := NewCapturer(zap.L(), Options{Interval: time.Second, CPUDuration: 100 * time.Millisecond, Keep: 1})
+	c.capture(context.Background())
+	handler := c.Handler("/admin/profiles/")
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/profiles/", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var profiles []Profile
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &profiles))
+	require.Len(t, profiles, 3)
+
+	for _, profile := range profiles {
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/profiles/"+profile.Name, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, profile.Size, w.Body.Len())
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/profiles/cpu-1.pb.gz", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
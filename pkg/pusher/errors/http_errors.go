@@ -3,11 +3,14 @@ package errors
 import (
 	"errors"
 	"net/http"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
 )
 
 type HTTPError struct {
-	Code    int    `json:"-"`
-	Message string `json:"error"`
+	Code      int          `json:"-"`
+	Message   string       `json:"error"`
+	ErrorCode errcode.Code `json:"error_code"`
 }
 
 func IsHTTPError(err error) bool {
@@ -25,20 +28,40 @@ func (e HTTPError) Is(err error) bool {
 
 func InternalServerError(msg string) HTTPError {
 	return HTTPError{
-		Code:    http.StatusInternalServerError,
-		Message: msg,
+		Code:      http.StatusInternalServerError,
+		Message:   msg,
+		ErrorCode: errcode.Internal,
 	}
 }
 func BadRequest(msg string) HTTPError {
 	return HTTPError{
-		Code:    http.StatusBadRequest,
-		Message: msg,
+		Code:      http.StatusBadRequest,
+		Message:   msg,
+		ErrorCode: errcode.BadRequest,
+	}
+}
+
+func Forbidden(msg string) HTTPError {
+	return HTTPError{
+		Code:      http.StatusForbidden,
+		Message:   msg,
+		ErrorCode: errcode.Forbidden,
+	}
+}
+
+// SubscriptionLimitExceeded is returned when a subscription request exceeds utils.Limits.
+func SubscriptionLimitExceeded(msg string) HTTPError {
+	return HTTPError{
+		Code:      http.StatusBadRequest,
+		Message:   msg,
+		ErrorCode: errcode.SubscriptionLimitExceeded,
 	}
 }
 
 func NotImplemented() HTTPError {
 	return HTTPError{
-		Code:    http.StatusNotImplemented,
-		Message: "method not implemented",
+		Code:      http.StatusNotImplemented,
+		Message:   "method not implemented",
+		ErrorCode: errcode.NotImplemented,
 	}
 }
@@ -5,12 +5,27 @@ package events
 type Name string
 
 const (
-	PingEvent       Name = "ping"
-	AccountTxEvent  Name = "account-tx"
-	TraceEvent      Name = "trace"
-	BlockEvent      Name = "block"
-	BlockchainEvent Name = "blockchain"
-	MempoolEvent    Name = "mempool"
+	PingEvent          Name = "ping"
+	AccountTxEvent     Name = "account-tx"
+	AccountStatusEvent Name = "account-status"
+	JettonStatusEvent  Name = "jetton-status"
+	AccountLogEvent    Name = "account-log"
+	TraceEvent         Name = "trace"
+	BlockEvent         Name = "block"
+	BlockchainEvent    Name = "blockchain"
+	ConfigEvent        Name = "config"
+	// JettonTransferEvent tells a client about a jetton transfer sent or received by an account.
+	JettonTransferEvent Name = "jetton-transfer"
+	// ConfigProposalEvent tells a client that a config proposal has passed and changed the config.
+	ConfigProposalEvent Name = "config-proposal"
+	MempoolEvent        Name = "mempool"
+	DepositEvent        Name = "deposit"
+	NftBidEvent         Name = "nft-bid"
+	NftTransferEvent    Name = "nft-transfer"
+	// GapEvent tells a client resuming a stream that some events following its Last-Event-ID are lost.
+	GapEvent Name = "gap"
+	// ShutdownEvent tells a client that the instance is draining and the client should reconnect elsewhere.
+	ShutdownEvent Name = "server_shutting_down"
 )
 
 func (n Name) String() string {
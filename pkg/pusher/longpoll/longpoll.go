@@ -0,0 +1,241 @@
+// Package longpoll provides a long-polling fallback of the Streaming API
+// for clients that can't keep SSE or websocket connections, e.g. behind corporate proxies.
+//
+// A Poller subscribes to all transactions once and keeps the latest notifications in a buffer.
+// A client passes a cursor returned by the previous poll, the request is held open
+// until there are new notifications for the requested accounts or the wait expires.
+package longpoll
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/errors"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+)
+
+const (
+	defaultWait = 25 * time.Second
+	maxWait     = 60 * time.Second
+	// bufferSize is a number of the latest notifications kept for clients catching up with a cursor.
+	bufferSize = 10_000
+	// maxEventsPerResponse caps a response, a client gets the rest with the next poll.
+	maxEventsPerResponse = 1000
+)
+
+// Response is returned by a poll.
+type Response struct {
+	// Events are notifications in the same format as the SSE stream of transactions.
+	Events []json.RawMessage `json:"events"`
+	// Cursor has to be passed to the next poll.
+	Cursor string `json:"cursor"`
+	// Truncated is set when some notifications after the cursor have been dropped from the buffer
+	// or the cursor was issued before a restart, so the client has to resync its state.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+type bufferedEvent struct {
+	seq     uint64
+	account tongo.AccountID
+	data    []byte
+}
+
+// Poller keeps the latest transaction notifications and serves long polls.
+type Poller struct {
+	// epoch distinguishes cursors issued by this instance from cursors issued before a restart.
+	epoch int64
+
+	// mu protects "events", "lastSeq" and "notify" fields.
+	mu      sync.Mutex
+	events  []bufferedEvent
+	lastSeq uint64
+	// notify is closed and replaced once a new notification arrives.
+	notify chan struct{}
+}
+
+// NewPoller subscribes to all transactions of the source until the context is canceled.
+func NewPoller(ctx context.Context, txSource sources.TransactionSource) *Poller {
+	p := &Poller{
+		epoch:  time.Now().UnixNano(),
+		notify: make(chan struct{}),
+	}
+	cancel := txSource.SubscribeToTransactions(ctx, p.add, sources.SubscribeToTransactionsOptions{AllAccounts: true, AllOperations: true})
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+	return p
+}
+
+func (p *Poller) add(eventData []byte) {
+	var event sources.TransactionEventData
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastSeq += 1
+	p.events = append(p.events, bufferedEvent{seq: p.lastSeq, account: event.AccountID, data: eventData})
+	if len(p.events) > bufferSize {
+		p.events = p.events[len(p.events)-bufferSize:]
+	}
+	close(p.notify)
+	p.notify = make(chan struct{})
+}
+
+func (p *Poller) encodeCursor(seq uint64) string {
+	return fmt.Sprintf("%s-%d", strconv.FormatInt(p.epoch, 36), seq)
+}
+
+// decodeCursor returns a sequence number of the last notification seen by a client.
+// An empty cursor starts from the current moment.
+func (p *Poller) decodeCursor(cursor string) (seq uint64, truncated bool, err error) {
+	if cursor == "" {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.lastSeq, false, nil
+	}
+	epochStr, seqStr, ok := strings.Cut(cursor, "-")
+	if !ok {
+		return 0, false, fmt.Errorf("invalid cursor")
+	}
+	epoch, err := strconv.ParseInt(epochStr, 36, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid cursor")
+	}
+	if seq, err = strconv.ParseUint(seqStr, 10, 64); err != nil {
+		return 0, false, fmt.Errorf("invalid cursor")
+	}
+	if epoch != p.epoch {
+		// the cursor was issued before a restart, so we start over with all notifications we have.
+		return 0, true, nil
+	}
+	return seq, false, nil
+}
+
+// collect returns notifications of the accounts after the given sequence number,
+// the sequence number to continue from and a channel to wait for new notifications.
+func (p *Poller) collect(accounts map[tongo.AccountID]struct{}, after uint64) ([]json.RawMessage, uint64, bool, <-chan struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	truncated := len(p.events) > 0 && after+1 < p.events[0].seq
+	events := []json.RawMessage{}
+	last := p.lastSeq
+	start := sort.Search(len(p.events), func(i int) bool {
+		return p.events[i].seq > after
+	})
+	for _, event := range p.events[start:] {
+		if len(events) == maxEventsPerResponse {
+			last = event.seq - 1
+			break
+		}
+		if _, ok := accounts[event.account]; ok || accounts == nil {
+			events = append(events, event.data)
+		}
+	}
+	return events, last, truncated, p.notify
+}
+
+// Poll waits for notifications of the accounts after the cursor.
+// Nil accounts mean all accounts.
+func (p *Poller) Poll(ctx context.Context, accounts []tongo.AccountID, cursor string, wait time.Duration) (*Response, error) {
+	after, truncated, err := p.decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	var set map[tongo.AccountID]struct{}
+	if accounts != nil {
+		set = make(map[tongo.AccountID]struct{}, len(accounts))
+		for _, account := range accounts {
+			set[account] = struct{}{}
+		}
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		events, last, dropped, notify := p.collect(set, after)
+		after = last
+		if len(events) > 0 || truncated || dropped {
+			return &Response{Events: events, Cursor: p.encodeCursor(after), Truncated: truncated || dropped}, nil
+		}
+		select {
+		case <-notify:
+		case <-timer.C:
+			return &Response{Events: events, Cursor: p.encodeCursor(after)}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Handler serves GET /v2/poll/accounts/transactions?accounts=...&wait=25s&cursor=...,
+// it can be registered with api.Server as an async handler.
+func (p *Poller) Handler(writer http.ResponseWriter, request *http.Request, connectionType int, allowTokenInQuery bool) error {
+	if err := p.handle(writer, request); err != nil {
+		if httpErr, ok := err.(errors.HTTPError); ok {
+			errcode.Write(writer, httpErr.Code, httpErr.ErrorCode, httpErr.Message)
+		}
+		return err
+	}
+	return nil
+}
+
+func (p *Poller) handle(writer http.ResponseWriter, request *http.Request) error {
+	query := request.URL.Query()
+	accountsStr := query.Get("accounts")
+	if accountsStr == "" {
+		return errors.BadRequest("'accounts' parameter is required")
+	}
+	var accounts []tongo.AccountID
+	all := strings.ToUpper(accountsStr) == "ALL"
+	if !all {
+		for _, account := range strings.Split(accountsStr, ",") {
+			address, err := tongo.ParseAddress(account)
+			if err != nil {
+				return errors.BadRequest(fmt.Sprintf("failed to parse 'accounts' parameter in query: %v", err))
+			}
+			accounts = append(accounts, address.ID)
+		}
+	}
+	if err := utils.LimitsFromContext(request.Context()).CheckAccounts(len(accounts)); err != nil {
+		return errors.SubscriptionLimitExceeded(err.Error())
+	}
+	accounts, all, err := utils.ScopeAccounts(request.Context(), accounts, all)
+	if err != nil {
+		return errors.Forbidden(err.Error())
+	}
+	if all {
+		accounts = nil
+	}
+	wait := defaultWait
+	if waitStr := query.Get("wait"); waitStr != "" {
+		if wait, err = time.ParseDuration(waitStr); err != nil || wait < 0 {
+			return errors.BadRequest("failed to parse 'wait' parameter in query")
+		}
+		if wait > maxWait {
+			wait = maxWait
+		}
+	}
+	response, err := p.Poll(request.Context(), accounts, query.Get("cursor"), wait)
+	if err != nil {
+		if request.Context().Err() != nil {
+			// the client is gone, nobody reads the response.
+			return err
+		}
+		return errors.BadRequest(err.Error())
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	return json.NewEncoder(writer).Encode(response)
+}
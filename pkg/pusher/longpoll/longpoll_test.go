@@ -0,0 +1,101 @@
+package longpoll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+)
+
+type mockTxSource struct {
+	deliveryFn sources.DeliveryFn
+}
+
+func (m *mockTxSource) SubscribeToTransactions(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTransactionsOptions) sources.CancelFn {
+	m.deliveryFn = deliveryFn
+	return func() {}
+}
+
+func (m *mockTxSource) deliver(t *testing.T, account tongo.AccountID, lt uint64) {
+	data, err := json.Marshal(sources.TransactionEventData{AccountID: account, Lt: lt, TxHash: "abc"})
+	require.Nil(t, err)
+	m.deliveryFn(data)
+}
+
+func TestPoller_Poll(t *testing.T) {
+	alice := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	bob := tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+	source := &mockTxSource{}
+	p := NewPoller(context.Background(), source)
+	source.deliver(t, alice, 1)
+
+	// a poll without a cursor starts from the current moment.
+	resp, err := p.Poll(context.Background(), []tongo.AccountID{alice}, "", 10*time.Millisecond)
+	require.Nil(t, err)
+	require.Empty(t, resp.Events)
+	cursor := resp.Cursor
+
+	source.deliver(t, bob, 2)
+	source.deliver(t, alice, 3)
+	resp, err = p.Poll(context.Background(), []tongo.AccountID{alice}, cursor, time.Second)
+	require.Nil(t, err)
+	require.Len(t, resp.Events, 1)
+	require.Contains(t, string(resp.Events[0]), `"lt":3`)
+	require.False(t, resp.Truncated)
+
+	// the request is held open until a new notification arrives.
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		source.deliver(t, alice, 4)
+	}()
+	resp, err = p.Poll(context.Background(), []tongo.AccountID{alice}, resp.Cursor, time.Second)
+	require.Nil(t, err)
+	require.Len(t, resp.Events, 1)
+	require.Contains(t, string(resp.Events[0]), `"lt":4`)
+
+	// a cursor of a previous instance requires a resync.
+	resp, err = p.Poll(context.Background(), nil, "abc-10", time.Second)
+	require.Nil(t, err)
+	require.True(t, resp.Truncated)
+	require.Len(t, resp.Events, 4)
+
+	_, err = p.Poll(context.Background(), nil, "not-a-cursor", time.Second)
+	require.NotNil(t, err)
+}
+
+func TestPoller_Handler(t *testing.T) {
+	source := &mockTxSource{}
+	p := NewPoller(context.Background(), source)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+	}{
+		{name: "all accounts", query: "accounts=ALL&wait=1ms", wantCode: http.StatusOK},
+		{name: "no accounts", query: "wait=1ms", wantCode: http.StatusBadRequest},
+		{name: "invalid account", query: "accounts=abc&wait=1ms", wantCode: http.StatusBadRequest},
+		{name: "invalid wait", query: "accounts=ALL&wait=soon", wantCode: http.StatusBadRequest},
+		{name: "invalid cursor", query: "accounts=ALL&wait=1ms&cursor=abc", wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/v2/poll/accounts/transactions?"+tt.query, nil)
+			_ = p.Handler(rec, req, 0, true)
+			require.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantCode == http.StatusOK {
+				var resp Response
+				require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				require.NotEmpty(t, resp.Cursor)
+			}
+		})
+	}
+}
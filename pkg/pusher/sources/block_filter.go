@@ -0,0 +1,128 @@
+package sources
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+)
+
+// FilterBlocks returns a DeliveryFn that applies the filters of the given options to blockchain slices
+// before passing them to deliveryFn.
+// It works on top of any BlockSource, a slice already filtered by a source stays the same.
+func FilterBlocks(deliveryFn DeliveryFn, opts SubscribeToBlocksOptions) DeliveryFn {
+	if opts.Workchain == nil && opts.Shard == nil && !opts.OnlyMasterchain && opts.Transactions == "" {
+		return deliveryFn
+	}
+	return func(eventData []byte) {
+		var slice BlockchainSliceEvent
+		if err := json.Unmarshal(eventData, &slice); err != nil {
+			deliveryFn(eventData)
+			return
+		}
+		blocks := make([]Block, 0, len(slice.Blocks))
+		for _, block := range slice.Blocks {
+			if !opts.matchBlock(block) {
+				continue
+			}
+			if opts.Transactions != "" && len(block.Raw) > 0 {
+				// a block we fail to decode is delivered as is, so a subscriber doesn't miss it.
+				if transactions, err := blockTransactions(block, opts.Transactions); err == nil {
+					block.Raw = nil
+					block.Transactions = transactions
+				}
+			}
+			blocks = append(blocks, block)
+		}
+		slice.Blocks = blocks
+		data, err := json.Marshal(slice)
+		if err != nil {
+			deliveryFn(eventData)
+			return
+		}
+		deliveryFn(data)
+	}
+}
+
+func (opts SubscribeToBlocksOptions) matchBlock(block Block) bool {
+	if opts.OnlyMasterchain && block.Workchain != -1 {
+		return false
+	}
+	if opts.Workchain != nil && *opts.Workchain != block.Workchain {
+		return false
+	}
+	if opts.Shard != nil {
+		shard, err := strconv.ParseUint(block.Shard, 16, 64)
+		if err != nil {
+			return false
+		}
+		return opts.Shard.MatchBlockID(ton.BlockID{Workchain: block.Workchain, Shard: shard})
+	}
+	return true
+}
+
+func blockTransactions(block Block, mode BlockTransactionsMode) ([]BlockTransaction, error) {
+	cells, err := boc.DeserializeBoc(block.Raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(cells) != 1 {
+		return nil, fmt.Errorf("block contains multiple root cells")
+	}
+	var decoded tlb.Block
+	if err := tlb.Unmarshal(cells[0], &decoded); err != nil {
+		return nil, err
+	}
+	var transactions []BlockTransaction
+	for _, tx := range decoded.AllTransactions() {
+		transaction := BlockTransaction{
+			AccountID: *ton.NewAccountID(block.Workchain, tx.AccountAddr),
+			Lt:        tx.Lt,
+			TxHash:    tx.Hash().Hex(),
+		}
+		if mode == BlockTransactionsFull {
+			transaction.DecodedTransaction = decodeTransaction(tx)
+		}
+		transactions = append(transactions, transaction)
+	}
+	return transactions, nil
+}
+
+func decodeTransaction(tx *tlb.Transaction) *DecodedTransaction {
+	decoded := &DecodedTransaction{
+		Utime:      tx.Now,
+		Success:    tx.IsSuccess(),
+		OrigStatus: tx.OrigStatus,
+		EndStatus:  tx.EndStatus,
+		TotalFees:  int64(tx.TotalFees.Grams),
+		OutMsgs:    []BlockMessage{},
+	}
+	if tx.Msgs.InMsg.Exists {
+		msg := decodeBlockMessage(tx.Msgs.InMsg.Value.Value)
+		decoded.InMsg = &msg
+	}
+	for _, msg := range tx.Msgs.OutMsgs.Values() {
+		decoded.OutMsgs = append(decoded.OutMsgs, decodeBlockMessage(msg.Value))
+	}
+	return decoded
+}
+
+func decodeBlockMessage(msg tlb.Message) BlockMessage {
+	var result BlockMessage
+	switch {
+	case msg.Info.IntMsgInfo != nil:
+		result.Source, _ = ton.AccountIDFromTlb(msg.Info.IntMsgInfo.Src)
+		result.Destination, _ = ton.AccountIDFromTlb(msg.Info.IntMsgInfo.Dest)
+		result.Value = int64(msg.Info.IntMsgInfo.Value.Grams)
+	case msg.Info.ExtInMsgInfo != nil:
+		result.Destination, _ = ton.AccountIDFromTlb(msg.Info.ExtInMsgInfo.Dest)
+	case msg.Info.ExtOutMsgInfo != nil:
+		result.Source, _ = ton.AccountIDFromTlb(msg.Info.ExtOutMsgInfo.Src)
+	}
+	cell := boc.Cell(msg.Body.Value)
+	result.OpCode, result.OpName = msgOpCodeAndName(msg, &cell)
+	return result
+}
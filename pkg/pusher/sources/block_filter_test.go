@@ -0,0 +1,87 @@
+package sources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/wallet"
+
+	"github.com/tonkeeper/opentonapi/internal/g"
+)
+
+func TestFilterBlocks(t *testing.T) {
+	slice := BlockchainSliceEvent{
+		MasterchainSeqno: 100,
+		Blocks: []Block{
+			{Workchain: -1, Shard: "8000000000000000", Seqno: 100, Raw: []byte("master")},
+			{Workchain: 0, Shard: "4000000000000000", Seqno: 10, Raw: []byte("left")},
+			{Workchain: 0, Shard: "c000000000000000", Seqno: 11, Raw: []byte("right")},
+		},
+	}
+	eventData, err := json.Marshal(slice)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name       string
+		opts       SubscribeToBlocksOptions
+		wantSeqnos []uint32
+	}{
+		{name: "no filters", wantSeqnos: []uint32{100, 10, 11}},
+		{name: "only masterchain", opts: SubscribeToBlocksOptions{OnlyMasterchain: true}, wantSeqnos: []uint32{100}},
+		{name: "basechain", opts: SubscribeToBlocksOptions{Workchain: g.Pointer(int32(0))}, wantSeqnos: []uint32{10, 11}},
+		{
+			name:       "shard prefix",
+			opts:       SubscribeToBlocksOptions{Workchain: g.Pointer(int32(0)), Shard: g.Pointer(ton.MustParseShardID(-0x2000000000000000))},
+			wantSeqnos: []uint32{11},
+		},
+		{name: "nothing matches", opts: SubscribeToBlocksOptions{OnlyMasterchain: true, Workchain: g.Pointer(int32(0))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var delivered BlockchainSliceEvent
+			FilterBlocks(func(data []byte) {
+				require.Nil(t, json.Unmarshal(data, &delivered))
+			}, tt.opts)(eventData)
+			require.Equal(t, uint32(100), delivered.MasterchainSeqno)
+			var seqnos []uint32
+			for _, block := range delivered.Blocks {
+				seqnos = append(seqnos, block.Seqno)
+			}
+			require.Equal(t, tt.wantSeqnos, seqnos)
+		})
+	}
+}
+
+func TestFilterBlocks_UndecodableBlock(t *testing.T) {
+	eventData, err := json.Marshal(BlockchainSliceEvent{Blocks: []Block{{Workchain: 0, Shard: "8000000000000000", Raw: []byte("not a boc")}}})
+	require.Nil(t, err)
+	var delivered BlockchainSliceEvent
+	FilterBlocks(func(data []byte) {
+		require.Nil(t, json.Unmarshal(data, &delivered))
+	}, SubscribeToBlocksOptions{Transactions: BlockTransactionsFull})(eventData)
+	require.Len(t, delivered.Blocks, 1)
+	require.Equal(t, []byte("not a boc"), delivered.Blocks[0].Raw)
+	require.Nil(t, delivered.Blocks[0].Transactions)
+}
+
+func Test_decodeBlockMessage(t *testing.T) {
+	destination := ton.MustParseAccountID("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c")
+	msg, _, err := wallet.SimpleTransfer{Amount: 1_000_000_000, Address: destination, Comment: "hello"}.ToInternal()
+	require.Nil(t, err)
+	// a message has to be serialized to get a body cell ready for reading.
+	cell := boc.NewCell()
+	require.Nil(t, tlb.Marshal(cell, msg))
+	var decoded tlb.Message
+	require.Nil(t, tlb.Unmarshal(cell, &decoded))
+
+	got := decodeBlockMessage(decoded)
+	require.Nil(t, got.Source)
+	require.Equal(t, &destination, got.Destination)
+	require.Equal(t, int64(1_000_000_000), got.Value)
+	require.Equal(t, g.Pointer(abi.TextCommentMsgOp), got.OpName)
+}
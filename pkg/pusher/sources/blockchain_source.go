@@ -2,54 +2,124 @@ package sources
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"sort"
 
+	"github.com/tonkeeper/opentonapi/internal/g"
 	"github.com/tonkeeper/opentonapi/pkg/blockchain/indexer"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/abi"
 	"github.com/tonkeeper/tongo/boc"
 	"github.com/tonkeeper/tongo/liteapi"
 	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/utils"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 )
 
 // BlockchainSource notifies about transactions in the TON blockchain.
 type BlockchainSource struct {
-	txDispatcher    txDispatcher
-	blockDispatcher blockDispatcher
-	client          *liteapi.Client
-	logger          *zap.Logger
+	txDispatcher     txDispatcher
+	blockDispatcher  blockDispatcher
+	configDispatcher configDispatcher
+	// proposalDispatcher is driven by the same key blocks as configDispatcher.
+	proposalDispatcher configDispatcher
+	client             *liteapi.Client
+	logger             *zap.Logger
+	// simulations receives synthetic transactions injected with SimulateTransaction.
+	simulations chan TransactionEvent
+	// priority are accounts whose transactions are dispatched ahead of transactions of other accounts.
+	priority map[tongo.AccountID]struct{}
+	// history is used to replay transactions to subscribers catching up with the stream.
+	history TransactionHistory
 }
 
+type BlockchainSourceOption func(b *BlockchainSource)
+
+// WithPriorityAccounts makes the source dispatch transactions of the given accounts from a dedicated queue,
+// so notifications about them don't lag behind a backlog of transactions of other accounts.
+func WithPriorityAccounts(accounts ...tongo.AccountID) BlockchainSourceOption {
+	return func(b *BlockchainSource) {
+		for _, account := range accounts {
+			b.priority[account] = struct{}{}
+		}
+	}
+}
+
+// WithTransactionHistory lets subscribers catch up with the stream by replaying transactions from the history,
+// see SubscribeToTransactionsOptions.FromLt.
+func WithTransactionHistory(history TransactionHistory) BlockchainSourceOption {
+	return func(b *BlockchainSource) {
+		b.history = history
+	}
+}
+
+// simulationsQueueSize is a number of synthetic transactions waiting to be dispatched.
+const simulationsQueueSize = 100
+
+// ErrSimulationQueueFull is returned by SimulateTransaction when subscribers can't keep up with synthetic transactions.
+var ErrSimulationQueueFull = errors.New("too many simulated transactions")
+
 type txDispatcher interface {
 	RegisterSubscriber(fn DeliveryFn, options SubscribeToTransactionsOptions) CancelFn
+	RegisterCatchingUpSubscriber(fn DeliveryFn, options SubscribeToTransactionsOptions) (*catchUp, CancelFn)
 	Run(ctx context.Context) chan TransactionEvent
 }
 type blockDispatcher interface {
 	RegisterSubscriber(fn DeliveryFn, options SubscribeToBlockHeadersOptions) CancelFn
 	Run(ctx context.Context) chan BlockEvent
 }
+type configDispatcher interface {
+	RegisterSubscriber(fn DeliveryFn, options SubscribeToConfigChangesOptions) CancelFn
+	Run(ctx context.Context) chan ConfigEvent
+}
 
-func NewBlockchainSource(logger *zap.Logger, cli *liteapi.Client) *BlockchainSource {
-	return &BlockchainSource{
-		txDispatcher:    NewTransactionDispatcher(logger),
-		blockDispatcher: NewBlockDispatcher(logger),
-		client:          cli,
-		logger:          logger,
+func NewBlockchainSource(logger *zap.Logger, cli *liteapi.Client, opts ...BlockchainSourceOption) *BlockchainSource {
+	source := &BlockchainSource{
+		blockDispatcher:  NewBlockDispatcher(logger),
+		configDispatcher: NewConfigDispatcher(logger),
+		client:           cli,
+		logger:           logger,
+		simulations:      make(chan TransactionEvent, simulationsQueueSize),
+		priority:         map[tongo.AccountID]struct{}{},
+	}
+	for _, o := range opts {
+		o(source)
+	}
+	source.txDispatcher = NewTransactionDispatcher(logger, maps.Keys(source.priority)...)
+	var load proposalsLoader
+	if cli != nil {
+		load = source.listConfigProposals
 	}
+	source.proposalDispatcher = NewProposalDispatcher(logger, load)
+	return source
 }
 
 var _ BlockHeadersSource = (*BlockchainSource)(nil)
 var _ TransactionSource = (*BlockchainSource)(nil)
+var _ ConfigChangesSource = (*BlockchainSource)(nil)
 
 func (b *BlockchainSource) SubscribeToTransactions(ctx context.Context, deliveryFn DeliveryFn, opts SubscribeToTransactionsOptions) CancelFn {
 	b.logger.Debug("subscribe to transactions",
 		zap.Bool("all-accounts", opts.AllAccounts),
 		zap.Bool("all-operations", opts.AllOperations),
 		zap.Stringers("accounts", opts.Accounts),
-		zap.Strings("operations", opts.Operations))
+		zap.Strings("operations", opts.Operations),
+		zap.Uint64("from-lt", opts.FromLt),
+		zap.Uint32("from-seqno", opts.FromSeqno))
 
-	return b.txDispatcher.RegisterSubscriber(deliveryFn, opts)
+	if b.history == nil || !opts.catchingUp() {
+		return b.txDispatcher.RegisterSubscriber(deliveryFn, opts)
+	}
+	c, cancel := b.txDispatcher.RegisterCatchingUpSubscriber(deliveryFn, opts)
+	go b.replay(ctx, c, opts)
+	return cancel
 }
 
 func (b *BlockchainSource) SubscribeToBlockHeaders(ctx context.Context, deliveryFn DeliveryFn, opts SubscribeToBlockHeadersOptions) CancelFn {
@@ -59,6 +129,32 @@ func (b *BlockchainSource) SubscribeToBlockHeaders(ctx context.Context, delivery
 	return b.blockDispatcher.RegisterSubscriber(deliveryFn, opts)
 }
 
+func (b *BlockchainSource) SubscribeToConfigChanges(ctx context.Context, deliveryFn DeliveryFn, opts SubscribeToConfigChangesOptions) CancelFn {
+	b.logger.Debug("subscribe to config changes",
+		zap.Int32s("params", opts.Params))
+
+	return b.configDispatcher.RegisterSubscriber(deliveryFn, opts)
+}
+
+func (b *BlockchainSource) SubscribeToConfigProposals(ctx context.Context, deliveryFn DeliveryFn, opts SubscribeToConfigChangesOptions) CancelFn {
+	b.logger.Debug("subscribe to config proposals",
+		zap.Int32s("params", opts.Params))
+
+	return b.proposalDispatcher.RegisterSubscriber(deliveryFn, opts)
+}
+
+// listConfigProposals runs list_proposals of the config contract.
+func (b *BlockchainSource) listConfigProposals(ctx context.Context, configAddr ton.AccountID) ([]core.ConfigProposal, error) {
+	exitCode, stack, err := b.client.RunSmcMethodByID(ctx, configAddr, utils.MethodIdFromName("list_proposals"), tlb.VmStack{})
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 && exitCode != 1 {
+		return nil, fmt.Errorf("list_proposals failed with exit code %d", exitCode)
+	}
+	return core.DecodeConfigProposals(stack)
+}
+
 func msgOpCodeAndName(msg tlb.Message, cell *boc.Cell) (opCode *uint32, opName *abi.MsgOpName) {
 	if msg.Info.IntMsgInfo != nil {
 		tag, name, _, _ := abi.InternalMessageDecoder(cell, nil)
@@ -75,17 +171,174 @@ func msgOpCodeAndName(msg tlb.Message, cell *boc.Cell) (opCode *uint32, opName *
 	return nil, nil
 }
 
+// outMsgOps returns operations of messages sent by the given transaction.
+func outMsgOps(tx *tlb.Transaction) []MsgOp {
+	var ops []MsgOp
+	for _, msg := range tx.Msgs.OutMsgs.Values() {
+		cell := boc.Cell(msg.Value.Body.Value)
+		code, name := msgOpCodeAndName(msg.Value, &cell)
+		if code == nil && name == nil {
+			continue
+		}
+		ops = append(ops, MsgOp{Name: name, Code: code})
+	}
+	return ops
+}
+
+// extOutMessages returns external outbound messages emitted by the given transaction,
+// their bodies are decoded with the ABI registry when the operation is known.
+func extOutMessages(account ton.AccountID, tx *tlb.Transaction) []ExtOutMessageEventData {
+	var msgs []ExtOutMessageEventData
+	for _, msg := range tx.Msgs.OutMsgs.Values() {
+		info := msg.Value.Info.ExtOutMsgInfo
+		if info == nil {
+			continue
+		}
+		cell := boc.Cell(msg.Value.Body.Value)
+		body, err := cell.ToBoc()
+		if err != nil {
+			continue
+		}
+		data := ExtOutMessageEventData{
+			AccountID: account,
+			Lt:        tx.Lt,
+			TxHash:    tx.Hash().Hex(),
+			Body:      hex.EncodeToString(body),
+		}
+		if info.Dest.SumType == "AddrExtern" {
+			data.Destination = &info.Dest.AddrExtern.ExternalAddress
+		}
+		cell.ResetCounters()
+		code, name, value, err := abi.ExtOutMessageDecoder(&cell, nil, info.Dest)
+		data.OpCode, data.OpName = code, name
+		if err == nil && name != nil {
+			data.Decoded, _ = json.Marshal(value)
+		}
+		msgs = append(msgs, data)
+	}
+	return msgs
+}
+
+// jettonTransfers returns jetton transfers the account sends to its jetton wallets with outbound messages
+// and receives with a notification from its jetton wallet.
+func jettonTransfers(account ton.AccountID, tx *tlb.Transaction) []JettonTransferEventData {
+	var transfers []JettonTransferEventData
+	if tx.Msgs.InMsg.Exists {
+		msg := tx.Msgs.InMsg.Value.Value
+		if body, ok := decodeInternalMessage[abi.JettonNotifyMsgBody](msg, abi.JettonNotifyMsgOp); ok {
+			if wallet, err := ton.AccountIDFromTlb(msg.Info.IntMsgInfo.Src); err == nil && wallet != nil {
+				sender, _ := ton.AccountIDFromTlb(body.Sender)
+				transfers = append(transfers, JettonTransferEventData{
+					AccountID:    account,
+					Lt:           tx.Lt,
+					TxHash:       tx.Hash().Hex(),
+					Direction:    JettonTransferIn,
+					JettonWallet: *wallet,
+					Sender:       sender,
+					Recipient:    &account,
+					Amount:       g.Pointer(big.Int(body.Amount)).String(),
+					QueryID:      body.QueryId,
+					Comment:      jettonComment(body.ForwardPayload.Value),
+				})
+			}
+		}
+	}
+	for _, msg := range tx.Msgs.OutMsgs.Values() {
+		body, ok := decodeInternalMessage[abi.JettonTransferMsgBody](msg.Value, abi.JettonTransferMsgOp)
+		if !ok {
+			continue
+		}
+		if wallet, err := ton.AccountIDFromTlb(msg.Value.Info.IntMsgInfo.Dest); err == nil && wallet != nil {
+			recipient, _ := ton.AccountIDFromTlb(body.Destination)
+			transfers = append(transfers, JettonTransferEventData{
+				AccountID:    account,
+				Lt:           tx.Lt,
+				TxHash:       tx.Hash().Hex(),
+				Direction:    JettonTransferOut,
+				JettonWallet: *wallet,
+				Sender:       &account,
+				Recipient:    recipient,
+				Amount:       g.Pointer(big.Int(body.Amount)).String(),
+				QueryID:      body.QueryId,
+				Comment:      jettonComment(body.ForwardPayload.Value),
+			})
+		}
+	}
+	return transfers
+}
+
+// decodeInternalMessage returns a decoded body of the internal message if the message has the given operation.
+func decodeInternalMessage[T any](msg tlb.Message, op abi.MsgOpName) (T, bool) {
+	var body T
+	if msg.Info.IntMsgInfo == nil {
+		return body, false
+	}
+	cell := boc.Cell(msg.Body.Value)
+	_, name, value, err := abi.InternalMessageDecoder(&cell, nil)
+	if err != nil || name == nil || *name != op {
+		return body, false
+	}
+	body, ok := value.(T)
+	return body, ok
+}
+
+func jettonComment(payload abi.JettonPayload) *string {
+	if comment, ok := payload.Value.(abi.TextCommentJettonPayload); ok {
+		text := string(comment.Text)
+		return &text
+	}
+	return nil
+}
+
+// jettonStatus returns a lock status of a jetton wallet set by the transaction's inbound message.
+func jettonStatus(tx *tlb.Transaction) *core.JettonWalletStatus {
+	if !tx.Msgs.InMsg.Exists || tx.Msgs.InMsg.Value.Value.Info.IntMsgInfo == nil || !tx.IsSuccess() {
+		return nil
+	}
+	cell := boc.Cell(tx.Msgs.InMsg.Value.Value.Body.Value)
+	_, name, value, err := abi.InternalMessageDecoder(&cell, nil)
+	if err != nil || name == nil || *name != abi.JettonSetStatusMsgOp {
+		return nil
+	}
+	body, ok := value.(abi.JettonSetStatusMsgBody)
+	if !ok {
+		return nil
+	}
+	status := core.JettonWalletStatus(body.Status)
+	return &status
+}
+
 func (b *BlockchainSource) Run(ctx context.Context) chan indexer.IDandBlock {
 	newBlockCh := make(chan indexer.IDandBlock)
 	go func() {
 		ch := b.txDispatcher.Run(ctx)
 		blockCh := b.blockDispatcher.Run(ctx)
+		configCh := b.configDispatcher.Run(ctx)
+		proposalCh := b.proposalDispatcher.Run(ctx)
+		if b.client != nil {
+			// the current config is a baseline to detect changes made by the next key block.
+			params, err := b.client.GetConfigAll(ctx, 0)
+			if err != nil {
+				b.logger.Warn("failed to get blockchain config, the next key block becomes a baseline", zap.Error(err))
+			} else {
+				configCh <- ConfigEvent{Params: params}
+				proposalCh <- ConfigEvent{Params: params}
+			}
+		}
 
 		for {
 			select {
 			case <-ctx.Done():
 				return
+			case event := <-b.simulations:
+				ch <- event
 			case block := <-newBlockCh:
+				if block.Orphaned {
+					// subscribers to block headers are only interested in the canonical chain,
+					// while transaction subscribers have to void transactions of the orphaned block.
+					b.revertTransactions(ch, block)
+					continue
+				}
 				blockCh <- BlockEvent{
 					Workchain: block.ID.Workchain,
 					Shard:     fmt.Sprintf("%x", block.ID.Shard),
@@ -93,24 +346,72 @@ func (b *BlockchainSource) Run(ctx context.Context) chan indexer.IDandBlock {
 					RootHash:  block.ID.RootHash.Hex(),
 					FileHash:  block.ID.FileHash.Hex(),
 				}
-				transactions := block.Block.AllTransactions()
+				if extra := block.Block.Extra.Custom; block.ID.Workchain == -1 && extra.Exists && extra.Value.Value.KeyBlock {
+					configCh <- ConfigEvent{Seqno: block.ID.Seqno, Params: extra.Value.Value.Config}
+					proposalCh <- ConfigEvent{Seqno: block.ID.Seqno, Params: extra.Value.Value.Config}
+				}
+				transactions := b.prioritize(block.ID.Workchain, block.Block.AllTransactions())
 				for _, tx := range transactions {
-					var msgOpCode *uint32
-					var msgOpName *abi.MsgOpName
-					if tx.Msgs.InMsg.Exists {
-						cell := boc.Cell(tx.Msgs.InMsg.Value.Value.Body.Value)
-						msgOpCode, msgOpName = msgOpCodeAndName(tx.Msgs.InMsg.Value.Value, &cell)
-					}
-					ch <- TransactionEvent{
-						AccountID: *ton.NewAccountID(block.ID.Workchain, tx.AccountAddr),
-						Lt:        tx.Lt,
-						TxHash:    tx.Hash().Hex(),
-						MsgOpName: msgOpName,
-						MsgOpCode: msgOpCode,
-					}
+					ch <- newTransactionEvent(*ton.NewAccountID(block.ID.Workchain, tx.AccountAddr), tx)
 				}
 			}
 		}
 	}()
 	return newBlockCh
 }
+
+// prioritize moves transactions of priority accounts to the beginning keeping the order of transactions otherwise.
+func (b *BlockchainSource) prioritize(workchain int32, transactions []*tlb.Transaction) []*tlb.Transaction {
+	if len(b.priority) == 0 {
+		return transactions
+	}
+	sort.SliceStable(transactions, func(i, j int) bool {
+		_, iPriority := b.priority[*ton.NewAccountID(workchain, transactions[i].AccountAddr)]
+		_, jPriority := b.priority[*ton.NewAccountID(workchain, transactions[j].AccountAddr)]
+		return iPriority && !jPriority
+	})
+	return transactions
+}
+
+// SimulateTransaction delivers a synthetic transaction to subscribers of the account.
+// The notification is flagged as simulated, so subscribers can tell it from real transactions.
+func (b *BlockchainSource) SimulateTransaction(event TransactionEvent) error {
+	event.Simulated = true
+	select {
+	case b.simulations <- event:
+		return nil
+	default:
+		return ErrSimulationQueueFull
+	}
+}
+
+// revertTransactions notifies transaction subscribers that transactions of the given block have been dropped.
+func (b *BlockchainSource) revertTransactions(ch chan<- TransactionEvent, block indexer.IDandBlock) {
+	for _, tx := range block.Block.AllTransactions() {
+		event := newTransactionEvent(*ton.NewAccountID(block.ID.Workchain, tx.AccountAddr), tx)
+		event.Reverted = true
+		ch <- event
+	}
+}
+
+// newTransactionEvent describes the given transaction of the account for the dispatcher.
+func newTransactionEvent(account ton.AccountID, tx *tlb.Transaction) TransactionEvent {
+	var msgOpCode *uint32
+	var msgOpName *abi.MsgOpName
+	if tx.Msgs.InMsg.Exists {
+		cell := boc.Cell(tx.Msgs.InMsg.Value.Value.Body.Value)
+		msgOpCode, msgOpName = msgOpCodeAndName(tx.Msgs.InMsg.Value.Value, &cell)
+	}
+	return TransactionEvent{
+		AccountID:    account,
+		Lt:           tx.Lt,
+		TxHash:       tx.Hash().Hex(),
+		MsgOpName:    msgOpName,
+		MsgOpCode:    msgOpCode,
+		OutMsgOps:    outMsgOps(tx),
+		OrigStatus:   tx.OrigStatus,
+		EndStatus:    tx.EndStatus,
+		JettonStatus: jettonStatus(tx),
+		tx:           tx,
+	}
+}
@@ -2,12 +2,18 @@ package sources
 
 import (
 	"context"
+	"encoding/json"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tonkeeper/opentonapi/internal/g"
 	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
 	"github.com/tonkeeper/tongo/liteapi"
+	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
 	"go.uber.org/zap"
 
@@ -21,6 +27,9 @@ type mockTxDispatcher struct {
 func (m *mockTxDispatcher) RegisterSubscriber(fn DeliveryFn, options SubscribeToTransactionsOptions) CancelFn {
 	panic("implement me")
 }
+func (m *mockTxDispatcher) RegisterCatchingUpSubscriber(fn DeliveryFn, options SubscribeToTransactionsOptions) (*catchUp, CancelFn) {
+	panic("implement me")
+}
 func (m *mockTxDispatcher) Run(ctx context.Context) chan TransactionEvent {
 	return m.ch
 }
@@ -124,9 +133,10 @@ func TestBlockchainSource_Run(t *testing.T) {
 				ch: make(chan BlockEvent, 10),
 			}
 			b := &BlockchainSource{
-				logger:          zap.L(),
-				txDispatcher:    mockDisp,
-				blockDispatcher: blockDisp,
+				logger:           zap.L(),
+				txDispatcher:     mockDisp,
+				blockDispatcher:  blockDisp,
+				configDispatcher: NewConfigDispatcher(zap.L()),
 			}
 			blockCh := b.Run(context.Background())
 			extID, _, err := cli.LookupBlock(context.Background(), blockID, 1, nil, nil)
@@ -144,6 +154,7 @@ func TestBlockchainSource_Run(t *testing.T) {
 			var events []TransactionEvent
 			for i := 0; i < txCounts; i++ {
 				event := <-mockDisp.ch
+				event.tx = nil
 				events = append(events, event)
 			}
 			require.Equal(t, tt.wantTxEvents, events)
@@ -153,3 +164,126 @@ func TestBlockchainSource_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockchainSource_SimulateTransaction(t *testing.T) {
+	account := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	source := NewBlockchainSource(zap.L(), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source.Run(ctx)
+
+	events := make(chan TransactionEventData, 1)
+	cancelFn := source.SubscribeToTransactions(ctx, func(eventData []byte) {
+		var tx TransactionEventData
+		require.Nil(t, json.Unmarshal(eventData, &tx))
+		events <- tx
+	}, SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{account}, AllOperations: true})
+	defer cancelFn()
+
+	require.Nil(t, source.SimulateTransaction(TransactionEvent{AccountID: account, Lt: 100, TxHash: "abc"}))
+	select {
+	case tx := <-events:
+		require.Equal(t, TransactionEventData{AccountID: account, Lt: 100, TxHash: "abc", Simulated: true}, tx)
+	case <-time.After(time.Second):
+		t.Fatal("simulated transaction has not been delivered")
+	}
+}
+
+func TestBlockchainSource_prioritize(t *testing.T) {
+	priority := ton.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+	bulk := ton.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580352")
+	source := NewBlockchainSource(zap.L(), nil, WithPriorityAccounts(priority))
+	transactions := []*tlb.Transaction{
+		{AccountAddr: bulk.Address, Lt: 1},
+		{AccountAddr: priority.Address, Lt: 2},
+		{AccountAddr: bulk.Address, Lt: 3},
+		{AccountAddr: priority.Address, Lt: 4},
+	}
+	var lts []uint64
+	for _, tx := range source.prioritize(0, transactions) {
+		lts = append(lts, tx.Lt)
+	}
+	require.Equal(t, []uint64{2, 4, 1, 3}, lts)
+}
+
+// internalMessage returns an internal message from src to dest with the given operation.
+func internalMessage(t *testing.T, src, dest ton.AccountID, opCode uint32, body any) tlb.Message {
+	cell := boc.NewCell()
+	require.Nil(t, cell.WriteUint(uint64(opCode), 32))
+	require.Nil(t, tlb.Marshal(cell, body))
+	var msg tlb.Message
+	msg.Info.SumType = "IntMsgInfo"
+	msg.Info.IntMsgInfo = &struct {
+		IhrDisabled bool
+		Bounce      bool
+		Bounced     bool
+		Src         tlb.MsgAddress
+		Dest        tlb.MsgAddress
+		Value       tlb.CurrencyCollection
+		IhrFee      tlb.Grams
+		FwdFee      tlb.Grams
+		CreatedLt   uint64
+		CreatedAt   uint32
+	}{Src: src.ToMsgAddress(), Dest: dest.ToMsgAddress()}
+	msg.Body.IsRight = true
+	msg.Body.Value = tlb.Any(*cell)
+	return msg
+}
+
+func Test_jettonTransfers(t *testing.T) {
+	owner := tongo.MustParseAccountID("0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e")
+	wallet := tongo.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	peer := tongo.MustParseAccountID("0:a3935861f79daf59a13d6d182e1640210c02f98e3df18fda74b8f5ab141abf18")
+	amount := func(v int64) tlb.VarUInteger16 {
+		return tlb.VarUInteger16(*big.NewInt(v))
+	}
+	comment := abi.JettonPayload{SumType: abi.TextCommentJettonOp, Value: abi.TextCommentJettonPayload{Text: "thanks"}}
+
+	var tx tlb.Transaction
+	tx.Lt = 100
+	tx.Msgs.InMsg.Exists = true
+	tx.Msgs.InMsg.Value.Value = internalMessage(t, wallet, owner, 0x7362d09c, abi.JettonNotifyMsgBody{
+		QueryId:        1,
+		Amount:         amount(1_000_000),
+		Sender:         peer.ToMsgAddress(),
+		ForwardPayload: tlb.EitherRef[abi.JettonPayload]{Value: comment},
+	})
+	tx.Msgs.OutMsgs = tlb.NewHashmapE([]tlb.Uint15{0, 1}, []tlb.Ref[tlb.Message]{
+		{Value: internalMessage(t, owner, wallet, 0x0f8a7ea5, abi.JettonTransferMsgBody{
+			QueryId:             2,
+			Amount:              amount(500),
+			Destination:         peer.ToMsgAddress(),
+			ResponseDestination: owner.ToMsgAddress(),
+			ForwardTonAmount:    amount(1),
+		})},
+		// a comment sent to the peer is not a jetton transfer.
+		{Value: internalMessage(t, owner, peer, 0, abi.TextCommentMsgBody{Text: "hi"})},
+	})
+
+	require.Equal(t, []JettonTransferEventData{
+		{
+			AccountID:    owner,
+			Lt:           100,
+			TxHash:       tx.Hash().Hex(),
+			Direction:    JettonTransferIn,
+			JettonWallet: wallet,
+			Sender:       &peer,
+			Recipient:    &owner,
+			Amount:       "1000000",
+			QueryID:      1,
+			Comment:      g.Pointer("thanks"),
+		},
+		{
+			AccountID:    owner,
+			Lt:           100,
+			TxHash:       tx.Hash().Hex(),
+			Direction:    JettonTransferOut,
+			JettonWallet: wallet,
+			Sender:       &owner,
+			Recipient:    &peer,
+			Amount:       "500",
+			QueryID:      2,
+		},
+	}, jettonTransfers(owner, &tx))
+}
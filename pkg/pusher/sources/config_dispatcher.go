@@ -0,0 +1,186 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"go.uber.org/zap"
+)
+
+// ConfigEvent carries the blockchain config of a new key block.
+type ConfigEvent struct {
+	Seqno  uint32
+	Params tlb.ConfigParams
+}
+
+// configParam is a value of a config parameter known to ConfigDispatcher.
+type configParam struct {
+	hash  [32]byte
+	value json.RawMessage
+}
+
+// ConfigDispatcher tracks all subscribers and works as a fan-out queue:
+// on receiving a new key block, ConfigDispatcher compares its config with the previous one
+// and sends a notification about every changed parameter to all interested subscribers.
+type ConfigDispatcher struct {
+	logger *zap.Logger
+
+	// params is a baseline to compare new configs with, it is only accessed by the Run loop.
+	params map[uint32]configParam
+
+	// mu protects "subscribes" and "currentID" fields.
+	mu         sync.RWMutex
+	currentID  subscriberID
+	subscribes map[subscriberID]configDeliveryFn
+}
+
+type configDeliveryFn func(eventData []byte, param int32)
+
+func NewConfigDispatcher(logger *zap.Logger) *ConfigDispatcher {
+	return &ConfigDispatcher{
+		logger:     logger,
+		currentID:  1,
+		subscribes: map[subscriberID]configDeliveryFn{},
+	}
+}
+
+func (disp *ConfigDispatcher) Run(ctx context.Context) chan ConfigEvent {
+	ch := make(chan ConfigEvent, 10)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-ch:
+				disp.logger.Debug("handling config of key block",
+					zap.Uint32("seqno", event.Seqno))
+				for _, change := range disp.update(event) {
+					disp.dispatch(&change)
+				}
+			}
+		}
+	}()
+	return ch
+}
+
+// update replaces the baseline with the given config and returns changes between them.
+// The very first config only becomes the baseline.
+func (disp *ConfigDispatcher) update(event ConfigEvent) []ConfigChangeEventData {
+	items := event.Params.Config.Items()
+	params := make(map[uint32]configParam, len(items))
+	var conf *ton.BlockchainConfig
+	var changes []ConfigChangeEventData
+	for _, item := range items {
+		cell := item.Value.Value
+		hash, err := cell.Hash256()
+		if err != nil {
+			disp.logger.Error("failed to calculate hash of config param",
+				zap.Uint32("param", uint32(item.Key)), zap.Error(err))
+			continue
+		}
+		prev, ok := disp.params[uint32(item.Key)]
+		if ok && prev.hash == hash {
+			params[uint32(item.Key)] = prev
+			continue
+		}
+		if conf == nil {
+			// broken params are reported as raw cells, so we don't lose a change we can't decode.
+			conf, _, err = ton.ConvertBlockchainConfig(event.Params, true)
+			if err != nil {
+				disp.logger.Error("failed to convert blockchain config", zap.Error(err))
+				return nil
+			}
+		}
+		param := configParam{hash: hash, value: decodeConfigParam(conf, int32(item.Key), &cell)}
+		params[uint32(item.Key)] = param
+		if disp.params != nil {
+			changes = append(changes, ConfigChangeEventData{
+				Seqno:  event.Seqno,
+				Param:  int32(item.Key),
+				Before: prev.value,
+				After:  param.value,
+			})
+		}
+	}
+	for key, prev := range disp.params {
+		if _, ok := params[key]; !ok {
+			changes = append(changes, ConfigChangeEventData{Seqno: event.Seqno, Param: int32(key), Before: prev.value})
+		}
+	}
+	disp.params = params
+	return changes
+}
+
+// decodeConfigParam returns a JSON representation of the config parameter,
+// a parameter unknown to tongo is represented as a hex-encoded BOC.
+func decodeConfigParam(conf *ton.BlockchainConfig, key int32, cell *boc.Cell) json.RawMessage {
+	name := fmt.Sprintf("ConfigParam%d", key)
+	if key < 0 {
+		name = fmt.Sprintf("ConfigParamNegative%d", -key)
+	}
+	var value any = cell
+	if field := reflect.ValueOf(conf).Elem().FieldByName(name); field.IsValid() && !field.IsNil() {
+		value = field.Interface()
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func (disp *ConfigDispatcher) dispatch(change *ConfigChangeEventData) {
+	eventData, err := json.Marshal(change)
+	if err != nil {
+		disp.logger.Error("json.Marshal() failed: %v", zap.Error(err))
+		return
+	}
+	disp.mu.RLock()
+	defer disp.mu.RUnlock()
+
+	for _, deliveryFn := range disp.subscribes {
+		deliveryFn(eventData, change.Param)
+	}
+}
+
+func (disp *ConfigDispatcher) RegisterSubscriber(fn DeliveryFn, opts SubscribeToConfigChangesOptions) CancelFn {
+	disp.mu.Lock()
+	defer disp.mu.Unlock()
+
+	id := disp.currentID
+	disp.currentID += 1
+
+	disp.subscribes[id] = createConfigDeliveryFnBasedOnOptions(fn, opts)
+	return func() {
+		disp.unsubscribe(id)
+	}
+}
+
+func createConfigDeliveryFnBasedOnOptions(fn DeliveryFn, options SubscribeToConfigChangesOptions) configDeliveryFn {
+	if len(options.Params) == 0 {
+		return func(eventData []byte, param int32) {
+			fn(eventData)
+		}
+	}
+	params := make(map[int32]struct{}, len(options.Params))
+	for _, param := range options.Params {
+		params[param] = struct{}{}
+	}
+	return func(eventData []byte, param int32) {
+		if _, ok := params[param]; ok {
+			fn(eventData)
+		}
+	}
+}
+
+func (disp *ConfigDispatcher) unsubscribe(id subscriberID) {
+	disp.mu.Lock()
+	defer disp.mu.Unlock()
+	delete(disp.subscribes, id)
+}
@@ -0,0 +1,93 @@
+package sources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"go.uber.org/zap"
+)
+
+func configParams(t *testing.T, values map[uint32]byte) tlb.ConfigParams {
+	var keys []tlb.Uint32
+	var cells []tlb.Ref[boc.Cell]
+	for key, value := range values {
+		cell := boc.NewCell()
+		for i := 0; i < 32; i++ {
+			require.Nil(t, cell.WriteUint(uint64(value), 8))
+		}
+		keys = append(keys, tlb.Uint32(key))
+		cells = append(cells, tlb.Ref[boc.Cell]{Value: *cell})
+	}
+	return tlb.ConfigParams{Config: tlb.NewHashmap(keys, cells)}
+}
+
+func TestConfigDispatcher_update(t *testing.T) {
+	disp := NewConfigDispatcher(zap.L())
+
+	changes := disp.update(ConfigEvent{Seqno: 1, Params: configParams(t, map[uint32]byte{1: 0x11, 1000: 0x22})})
+	require.Empty(t, changes)
+
+	changes = disp.update(ConfigEvent{Seqno: 2, Params: configParams(t, map[uint32]byte{1: 0x11, 1000: 0x22})})
+	require.Empty(t, changes)
+
+	changes = disp.update(ConfigEvent{Seqno: 3, Params: configParams(t, map[uint32]byte{1: 0x33})})
+	require.Len(t, changes, 2)
+	byParam := map[int32]ConfigChangeEventData{}
+	for _, change := range changes {
+		require.Equal(t, uint32(3), change.Seqno)
+		byParam[change.Param] = change
+	}
+
+	elector := byParam[1]
+	var before, after tlb.ConfigParam1
+	require.Nil(t, json.Unmarshal(elector.Before, &before))
+	require.Nil(t, json.Unmarshal(elector.After, &after))
+	require.Equal(t, byte(0x11), before.ElectorAddr[0])
+	require.Equal(t, byte(0x33), after.ElectorAddr[0])
+
+	// tongo doesn't know param 1000, so it is reported as a raw cell.
+	removed := byParam[1000]
+	require.Nil(t, removed.After)
+	var raw string
+	require.Nil(t, json.Unmarshal(removed.Before, &raw))
+	require.NotEmpty(t, raw)
+}
+
+func Test_createConfigDeliveryFnBasedOnOptions(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    SubscribeToConfigChangesOptions
+		param      int32
+		wantCalled bool
+	}{
+		{
+			name:       "subscribe to all params",
+			param:      18,
+			wantCalled: true,
+		},
+		{
+			name:       "subscribe to gas prices",
+			options:    SubscribeToConfigChangesOptions{Params: []int32{20, 21}},
+			param:      21,
+			wantCalled: true,
+		},
+		{
+			name:    "subscribe to gas prices, storage prices changed",
+			options: SubscribeToConfigChangesOptions{Params: []int32{20, 21}},
+			param:   18,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			fn := createConfigDeliveryFnBasedOnOptions(func(eventData []byte) {
+				called = true
+			}, tt.options)
+			fn([]byte("data"), tt.param)
+			require.Equal(t, tt.wantCalled, called)
+		})
+	}
+}
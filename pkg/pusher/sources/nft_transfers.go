@@ -0,0 +1,241 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+var nftTransferNumber = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "streaming_api_nft_transfers",
+	Help: "Number of NFT transfers found in traces",
+}, []string{"type"})
+
+// SubscribeToNftTransfersOptions configures a subscription to NFT transfers.
+type SubscribeToNftTransfersOptions struct {
+	AllAccounts bool
+	// Accounts are previous or new owners, NFT items or collections.
+	Accounts []tongo.AccountID
+}
+
+// NftTransferSource provides ownership changes of NFT items.
+type NftTransferSource interface {
+	SubscribeToNftTransfers(ctx context.Context, deliveryFn DeliveryFn, opts SubscribeToNftTransfersOptions) CancelFn
+}
+
+// NftTransferEventData represents a notification about an ownership change of an NFT item.
+// This is part of our API contract with subscribers.
+type NftTransferEventData struct {
+	Nft        tongo.AccountID  `json:"nft"`
+	Collection *tongo.AccountID `json:"collection,omitempty"`
+	// Sender has sent the nft_transfer message: the previous owner or a sale contract.
+	Sender *tongo.AccountID `json:"sender,omitempty"`
+	// PreviousOwner is the seller if the item has been sold, otherwise it is the sender.
+	PreviousOwner *tongo.AccountID `json:"previous_owner,omitempty"`
+	NewOwner      *tongo.AccountID `json:"new_owner,omitempty"`
+	// Body is the decoded nft_transfer message body.
+	Body json.RawMessage `json:"body"`
+	// Sale is set if the nft_transfer message has been sent by a sale or an auction contract.
+	Sale    *NftSaleEventData `json:"sale,omitempty"`
+	TxHash  string            `json:"tx_hash"`
+	Lt      uint64            `json:"lt"`
+	TraceID string            `json:"trace_id"`
+	Utime   int64             `json:"utime"`
+}
+
+// NftSaleEventData describes a sale contract that has transferred an NFT item, see core.NftSaleContract.
+type NftSaleEventData struct {
+	Contract tongo.AccountID `json:"contract"`
+	// Price is in nanotons.
+	Price  int64            `json:"price"`
+	Seller *tongo.AccountID `json:"seller,omitempty"`
+}
+
+// accounts returns accounts whose subscribers receive the transfer.
+func (e *NftTransferEventData) accounts() []tongo.AccountID {
+	accounts := []tongo.AccountID{e.Nft}
+	for _, account := range []*tongo.AccountID{e.Collection, e.Sender, e.PreviousOwner, e.NewOwner} {
+		if account != nil {
+			accounts = append(accounts, *account)
+		}
+	}
+	return accounts
+}
+
+type nftStorage interface {
+	core.InformationSource
+	GetTrace(ctx context.Context, hash tongo.Bits256) (*core.Trace, error)
+	GetNFTs(ctx context.Context, accounts []tongo.AccountID) ([]core.NftItem, error)
+}
+
+// NftTransfers finds successful transfers of NFT items in traces delivered by a trace source
+// and delivers them to subscribers.
+type NftTransfers struct {
+	logger  *zap.Logger
+	storage nftStorage
+	source  TraceSource
+	// fan-out of transfers by accounts is the same as of traces.
+	dispatcher *TraceDispatcher
+}
+
+var _ NftTransferSource = (*NftTransfers)(nil)
+
+func NewNftTransfers(logger *zap.Logger, storage nftStorage, source TraceSource) *NftTransfers {
+	return &NftTransfers{
+		logger:     logger,
+		storage:    storage,
+		source:     source,
+		dispatcher: NewTraceDispatcher(logger),
+	}
+}
+
+// SubscribeToNftTransfers delivers transfers of the given items, of items of the given collections
+// and of items the given accounts have sent or received.
+func (n *NftTransfers) SubscribeToNftTransfers(ctx context.Context, deliveryFn DeliveryFn, opts SubscribeToNftTransfersOptions) CancelFn {
+	return n.dispatcher.RegisterSubscriber(deliveryFn, SubscribeToTraceOptions{
+		AllAccounts: opts.AllAccounts,
+		Accounts:    opts.Accounts,
+	})
+}
+
+func (n *NftTransfers) Run(ctx context.Context) {
+	hashCh := make(chan tongo.Bits256, 1000)
+	cancelFn := n.source.SubscribeToTraces(ctx, func(eventData []byte) {
+		var event TraceEventData
+		if err := json.Unmarshal(eventData, &event); err != nil {
+			n.logger.Error("json.Unmarshal() failed", zap.Error(err))
+			return
+		}
+		if event.Simulated {
+			return
+		}
+		var hash tongo.Bits256
+		if err := hash.FromHex(event.Hash); err != nil {
+			n.logger.Error("hash.FromHex() failed", zap.Error(err))
+			return
+		}
+		select {
+		case hashCh <- hash:
+		default:
+			nftTransferNumber.With(map[string]string{"type": "dropped-trace"}).Inc()
+		}
+	}, SubscribeToTraceOptions{AllAccounts: true})
+	defer cancelFn()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case hash := <-hashCh:
+			n.process(ctx, hash)
+		}
+	}
+}
+
+func (n *NftTransfers) process(ctx context.Context, hash tongo.Bits256) {
+	trace, err := n.storage.GetTrace(ctx, hash)
+	if err != nil {
+		n.logger.Debug("failed to get trace", zap.Error(err))
+		return
+	}
+	if err := core.CollectAdditionalInfo(ctx, n.storage, trace); err != nil {
+		// transfers are still delivered, though without sale contracts.
+		n.logger.Debug("failed to collect additional info", zap.Error(err))
+	}
+	for _, transfer := range findNftTransfers(trace) {
+		transfer.Collection = n.collection(ctx, transfer.Nft)
+		n.dispatch(transfer)
+	}
+}
+
+func (n *NftTransfers) collection(ctx context.Context, nft tongo.AccountID) *tongo.AccountID {
+	items, err := n.storage.GetNFTs(ctx, []tongo.AccountID{nft})
+	if err != nil || len(items) == 0 {
+		return nil
+	}
+	return items[0].CollectionAddress
+}
+
+func (n *NftTransfers) dispatch(transfer NftTransferEventData) {
+	eventJSON, err := json.Marshal(transfer)
+	if err != nil {
+		n.logger.Error("json.Marshal() failed", zap.Error(err))
+		return
+	}
+	if transfer.Sale != nil {
+		nftTransferNumber.With(map[string]string{"type": "sale"}).Inc()
+	} else {
+		nftTransferNumber.With(map[string]string{"type": "transfer"}).Inc()
+	}
+	n.dispatcher.Dispatch(transfer.accounts(), eventJSON)
+}
+
+// findNftTransfers returns successful nft_transfer transactions of NFT items in the trace.
+// A sale contract sending nft_transfer is the parent of the item's transaction.
+func findNftTransfers(trace *core.Trace) []NftTransferEventData {
+	var transfers []NftTransferEventData
+	var visit func(trace *core.Trace, parent *core.Trace)
+	visit = func(tr *core.Trace, parent *core.Trace) {
+		if transfer, ok := nftTransfer(tr, parent); ok {
+			transfer.TraceID = trace.Hash.Hex()
+			transfers = append(transfers, transfer)
+		}
+		for _, child := range tr.Children {
+			visit(child, tr)
+		}
+	}
+	visit(trace, nil)
+	return transfers
+}
+
+func nftTransfer(tr *core.Trace, parent *core.Trace) (NftTransferEventData, bool) {
+	if !tr.Success || tr.InMsg == nil || tr.InMsg.DecodedBody == nil || !implements(tr.AccountInterfaces, abi.NftItem) {
+		return NftTransferEventData{}, false
+	}
+	body, ok := tr.InMsg.DecodedBody.Value.(abi.NftTransferMsgBody)
+	if !ok {
+		return NftTransferEventData{}, false
+	}
+	// DecodedBody.Value is a simple struct, there shouldn't be any issue with it.
+	value, _ := json.Marshal(body)
+	transfer := NftTransferEventData{
+		Nft:           tr.Account,
+		Sender:        tr.InMsg.Source,
+		PreviousOwner: tr.InMsg.Source,
+		Body:          value,
+		TxHash:        tr.Hash.Hex(),
+		Lt:            tr.Lt,
+		Utime:         tr.Utime,
+	}
+	transfer.NewOwner, _ = tongo.AccountIDFromTlb(body.NewOwner)
+	if parent == nil || tr.InMsg.Source == nil || parent.Account != *tr.InMsg.Source {
+		return transfer, true
+	}
+	if info := parent.AdditionalInfo(); info != nil && info.NftSaleContract != nil && info.NftSaleContract.Item == tr.Account {
+		transfer.Sale = &NftSaleEventData{
+			Contract: parent.Account,
+			Price:    info.NftSaleContract.NftPrice,
+			Seller:   info.NftSaleContract.Owner,
+		}
+		if info.NftSaleContract.Owner != nil {
+			transfer.PreviousOwner = info.NftSaleContract.Owner
+		}
+	}
+	return transfer, true
+}
+
+func implements(interfaces []abi.ContractInterface, name abi.ContractInterface) bool {
+	for _, iface := range interfaces {
+		if iface.Implements(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+var (
+	nftItem       = tongo.MustParseAddress("0:b1f2d7d3a1d2cd7f8b64ef3bb0cd42cbbd03d1ae2beb6f9f0f1bf4e2bd3a3a2d").ID
+	nftCollection = tongo.MustParseAddress("0:80d78a35f955a14b679faa887ff4cd5bfc0f43b4a4eea2a7e6927f3701b273c2").ID
+	nftSeller     = tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+	nftBuyer      = tongo.MustParseAddress("0:a3935861f79daf59a13d6d182e1640210c02f98e3df18fda74b8f5ab141abf18").ID
+	saleContract  = tongo.MustParseAddress("0:9c3c2ab7ef8efc6a8bd1bbd11bd5ad16d40f2fd0a4dc6e2e3e24be1ea52ae1cc").ID
+)
+
+// nftTransferTrace returns a transaction of the NFT item processing nft_transfer from the sender to the new owner.
+func nftTransferTrace(sender tongo.AccountID, newOwner tongo.AccountID, success bool) *core.Trace {
+	return &core.Trace{
+		Transaction: core.Transaction{
+			TransactionID: core.TransactionID{Hash: tongo.Bits256{2}, Lt: 20, Account: nftItem},
+			Success:       success,
+			Utime:         1700000000,
+			InMsg: &core.Message{
+				MessageID: core.MessageID{Source: &sender, Destination: &nftItem},
+				DecodedBody: &core.DecodedMessageBody{
+					Operation: abi.NftTransferMsgOp,
+					Value:     abi.NftTransferMsgBody{QueryId: 7, NewOwner: newOwner.ToMsgAddress(), ResponseDestination: newOwner.ToMsgAddress()},
+				},
+			},
+		},
+		AccountInterfaces: []abi.ContractInterface{abi.NftItem},
+	}
+}
+
+func Test_findNftTransfers(t *testing.T) {
+	sale := &core.Trace{
+		Transaction: core.Transaction{
+			TransactionID: core.TransactionID{Hash: tongo.Bits256{1}, Lt: 10, Account: saleContract},
+			Success:       true,
+		},
+		AccountInterfaces: []abi.ContractInterface{abi.NftSaleV2},
+		Children:          []*core.Trace{nftTransferTrace(saleContract, nftBuyer, true)},
+	}
+	sale.SetAdditionalInfo(&core.TraceAdditionalInfo{
+		NftSaleContract: &core.NftSaleContract{NftPrice: 5_000_000_000, Owner: &nftSeller, Item: nftItem},
+	})
+
+	tests := []struct {
+		name  string
+		trace *core.Trace
+		want  []NftTransferEventData
+	}{
+		{
+			name:  "transfer",
+			trace: nftTransferTrace(nftSeller, nftBuyer, true),
+			want: []NftTransferEventData{
+				{
+					Nft:           nftItem,
+					Sender:        &nftSeller,
+					PreviousOwner: &nftSeller,
+					NewOwner:      &nftBuyer,
+					TxHash:        tongo.Bits256{2}.Hex(),
+					Lt:            20,
+					TraceID:       tongo.Bits256{2}.Hex(),
+					Utime:         1700000000,
+				},
+			},
+		},
+		{
+			name:  "sale",
+			trace: sale,
+			want: []NftTransferEventData{
+				{
+					Nft:           nftItem,
+					Sender:        &saleContract,
+					PreviousOwner: &nftSeller,
+					NewOwner:      &nftBuyer,
+					Sale:          &NftSaleEventData{Contract: saleContract, Price: 5_000_000_000, Seller: &nftSeller},
+					TxHash:        tongo.Bits256{2}.Hex(),
+					Lt:            20,
+					TraceID:       tongo.Bits256{1}.Hex(),
+					Utime:         1700000000,
+				},
+			},
+		},
+		{
+			name:  "failed transfer",
+			trace: nftTransferTrace(nftBuyer, nftBuyer, false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfers := findNftTransfers(tt.trace)
+			for i := range transfers {
+				var body abi.NftTransferMsgBody
+				require.Nil(t, json.Unmarshal(transfers[i].Body, &body))
+				require.Equal(t, uint64(7), body.QueryId)
+				transfers[i].Body = nil
+			}
+			require.Equal(t, tt.want, transfers)
+		})
+	}
+}
+
+func TestNftTransfers_SubscribeToNftTransfers(t *testing.T) {
+	tests := []struct {
+		name string
+		opts SubscribeToNftTransfersOptions
+		want int
+	}{
+		{name: "collection", opts: SubscribeToNftTransfersOptions{Accounts: []tongo.AccountID{nftCollection}}, want: 1},
+		{name: "item", opts: SubscribeToNftTransfersOptions{Accounts: []tongo.AccountID{nftItem}}, want: 1},
+		{name: "previous owner", opts: SubscribeToNftTransfersOptions{Accounts: []tongo.AccountID{nftSeller}}, want: 1},
+		{name: "new owner", opts: SubscribeToNftTransfersOptions{Accounts: []tongo.AccountID{nftBuyer}}, want: 1},
+		{name: "all", opts: SubscribeToNftTransfersOptions{AllAccounts: true}, want: 1},
+		{name: "other", opts: SubscribeToNftTransfersOptions{Accounts: []tongo.AccountID{saleContract}}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfers := NewNftTransfers(zap.L(), nil, nil)
+			var delivered []NftTransferEventData
+			cancel := transfers.SubscribeToNftTransfers(context.Background(), func(data []byte) {
+				var transfer NftTransferEventData
+				require.Nil(t, json.Unmarshal(data, &transfer))
+				delivered = append(delivered, transfer)
+			}, tt.opts)
+			defer cancel()
+
+			transfer := NftTransferEventData{
+				Nft:           nftItem,
+				Collection:    &nftCollection,
+				Sender:        &nftSeller,
+				PreviousOwner: &nftSeller,
+				NewOwner:      &nftBuyer,
+				Body:          json.RawMessage(`{}`),
+			}
+			transfers.dispatch(transfer)
+			require.Len(t, delivered, tt.want)
+			if tt.want > 0 {
+				require.Equal(t, transfer, delivered[0])
+			}
+		})
+	}
+}
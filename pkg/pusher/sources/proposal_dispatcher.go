@@ -0,0 +1,159 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/ton"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// proposalsRefreshInterval defines how often ProposalDispatcher reloads active proposals between key blocks.
+// A proposal is removed from the config contract in the key block that applies it,
+// so ProposalDispatcher has to know the proposal before that.
+const proposalsRefreshInterval = time.Minute
+
+// proposalsLoader returns active proposals of the given config contract.
+type proposalsLoader func(ctx context.Context, configAddr ton.AccountID) ([]core.ConfigProposal, error)
+
+// ProposalDispatcher tracks active config proposals and notifies subscribers
+// when a key block applies a change of the config suggested by a proposal.
+type ProposalDispatcher struct {
+	logger *zap.Logger
+	load   proposalsLoader
+
+	// the fields below are only accessed by the Run loop.
+	configAddr *ton.AccountID
+	// params contains hashes of config params to find params changed by a key block.
+	params    map[uint32][32]byte
+	proposals []core.ConfigProposal
+
+	// mu protects "subscribes" and "currentID" fields.
+	mu         sync.RWMutex
+	currentID  subscriberID
+	subscribes map[subscriberID]configDeliveryFn
+}
+
+func NewProposalDispatcher(logger *zap.Logger, load proposalsLoader) *ProposalDispatcher {
+	return &ProposalDispatcher{
+		logger:     logger,
+		load:       load,
+		currentID:  1,
+		subscribes: map[subscriberID]configDeliveryFn{},
+	}
+}
+
+func (disp *ProposalDispatcher) Run(ctx context.Context) chan ConfigEvent {
+	ch := make(chan ConfigEvent, 10)
+	go func() {
+		ticker := time.NewTicker(proposalsRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				disp.refresh(ctx)
+			case event := <-ch:
+				for _, passed := range disp.update(event) {
+					disp.dispatch(&passed)
+				}
+				disp.refresh(ctx)
+			}
+		}
+	}()
+	return ch
+}
+
+// update finds proposals applied by the given config and replaces the baseline of config params with it.
+func (disp *ProposalDispatcher) update(event ConfigEvent) []ConfigProposalEventData {
+	configAddr := ton.AccountID{Workchain: -1, Address: event.Params.ConfigAddr}
+	disp.configAddr = &configAddr
+
+	items := event.Params.Config.Items()
+	params := make(map[uint32][32]byte, len(items))
+	values := make(map[int32]*boc.Cell, len(items))
+	for _, item := range items {
+		cell := item.Value.Value
+		hash, err := cell.Hash256()
+		if err != nil {
+			disp.logger.Error("failed to calculate hash of config param",
+				zap.Uint32("param", uint32(item.Key)), zap.Error(err))
+			continue
+		}
+		params[uint32(item.Key)] = hash
+		values[int32(item.Key)] = &cell
+	}
+	var passed []ConfigProposalEventData
+	var pending []core.ConfigProposal
+	for _, proposal := range disp.proposals {
+		prev, existed := disp.params[uint32(proposal.ParamID)]
+		current, exists := params[uint32(proposal.ParamID)]
+		changed := existed != exists || prev != current
+		if disp.params != nil && changed && proposal.Passed(proposal.ParamID, values[proposal.ParamID]) {
+			passed = append(passed, ConfigProposalEventData{
+				Seqno:    event.Seqno,
+				Hash:     proposal.Hash.Hex(),
+				Param:    proposal.ParamID,
+				Critical: proposal.Critical,
+			})
+			continue
+		}
+		pending = append(pending, proposal)
+	}
+	disp.params = params
+	// passed proposals are dropped, so they aren't reported twice if the proposals can't be reloaded.
+	disp.proposals = pending
+	return passed
+}
+
+// refresh reloads active proposals of the config contract.
+func (disp *ProposalDispatcher) refresh(ctx context.Context) {
+	if disp.load == nil || disp.configAddr == nil {
+		return
+	}
+	proposals, err := disp.load(ctx, *disp.configAddr)
+	if err != nil {
+		disp.logger.Warn("failed to load config proposals", zap.Error(err))
+		return
+	}
+	disp.proposals = proposals
+}
+
+func (disp *ProposalDispatcher) dispatch(passed *ConfigProposalEventData) {
+	eventData, err := json.Marshal(passed)
+	if err != nil {
+		disp.logger.Error("json.Marshal() failed: %v", zap.Error(err))
+		return
+	}
+	disp.mu.RLock()
+	defer disp.mu.RUnlock()
+
+	for _, deliveryFn := range disp.subscribes {
+		deliveryFn(eventData, passed.Param)
+	}
+}
+
+func (disp *ProposalDispatcher) RegisterSubscriber(fn DeliveryFn, opts SubscribeToConfigChangesOptions) CancelFn {
+	disp.mu.Lock()
+	defer disp.mu.Unlock()
+
+	id := disp.currentID
+	disp.currentID += 1
+
+	disp.subscribes[id] = createConfigDeliveryFnBasedOnOptions(fn, opts)
+	return func() {
+		disp.unsubscribe(id)
+	}
+}
+
+func (disp *ProposalDispatcher) unsubscribe(id subscriberID) {
+	disp.mu.Lock()
+	defer disp.mu.Unlock()
+	delete(disp.subscribes, id)
+}
@@ -0,0 +1,41 @@
+package sources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// configParamValue returns a cell of a config param filled with the given byte.
+func configParamValue(t *testing.T, value byte) *boc.Cell {
+	cell, ok := configParams(t, map[uint32]byte{0: value}).Config.Get(0)
+	require.True(t, ok)
+	return &cell.Value
+}
+
+func TestProposalDispatcher_update(t *testing.T) {
+	disp := NewProposalDispatcher(zap.L(), nil)
+
+	passed := disp.update(ConfigEvent{Seqno: 1, Params: configParams(t, map[uint32]byte{1: 0x11, 17: 0x22, 1000: 0x33})})
+	require.Empty(t, passed)
+
+	disp.proposals = []core.ConfigProposal{
+		{Hash: [32]byte{1}, ParamID: 17, ParamValue: configParamValue(t, 0x44), Critical: true},
+		// removes param 1000.
+		{Hash: [32]byte{2}, ParamID: 1000},
+		// param 1 already has the proposed value, but it hasn't been changed by the key block.
+		{Hash: [32]byte{3}, ParamID: 1, ParamValue: configParamValue(t, 0x11)},
+		// param 17 has got another value.
+		{Hash: [32]byte{4}, ParamID: 17, ParamValue: configParamValue(t, 0x55)},
+	}
+	passed = disp.update(ConfigEvent{Seqno: 2, Params: configParams(t, map[uint32]byte{1: 0x11, 17: 0x44})})
+	require.Equal(t, []ConfigProposalEventData{
+		{Seqno: 2, Hash: "0100000000000000000000000000000000000000000000000000000000000000", Param: 17, Critical: true},
+		{Seqno: 2, Hash: "0200000000000000000000000000000000000000000000000000000000000000", Param: 1000},
+	}, passed)
+	require.Len(t, disp.proposals, 2)
+}
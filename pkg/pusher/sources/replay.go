@@ -0,0 +1,168 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// TransactionHistory provides transactions indexed locally,
+// they are replayed to subscribers catching up with the stream.
+// IndexedAccountTransactions is expected to be served from an index of the account sorted by lt,
+// so replaying a quiet account doesn't scan transactions of busy ones.
+type TransactionHistory interface {
+	IndexedAccountTransactions(ctx context.Context, account tongo.AccountID, afterLt uint64, limit int) ([]*core.Transaction, error)
+	GetBlockHeader(ctx context.Context, id tongo.BlockID) (*core.BlockHeader, error)
+}
+
+// replayPageSize is a number of transactions read from the history at once.
+const replayPageSize = 1000
+
+// catchUp wraps a delivery function of a subscriber catching up with the stream.
+// While transactions from the history are replayed, live transactions are held back,
+// once the replay is done, they are delivered except for those already replayed.
+type catchUp struct {
+	deliver txDeliveryFn
+
+	// mu protects all fields below.
+	mu        sync.Mutex
+	replaying bool
+	held      []heldTransaction
+	// replayed is the lt of the latest replayed transaction of every account.
+	replayed map[tongo.AccountID]uint64
+}
+
+type heldTransaction struct {
+	eventData []byte
+	event     TransactionEvent
+}
+
+func newCatchUp(deliver txDeliveryFn) *catchUp {
+	return &catchUp{
+		deliver:   deliver,
+		replaying: true,
+		replayed:  map[tongo.AccountID]uint64{},
+	}
+}
+
+// live is called by the dispatcher for every live transaction of the subscriber.
+func (c *catchUp) live(eventData []byte, event *TransactionEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.replaying {
+		c.held = append(c.held, heldTransaction{eventData: eventData, event: *event})
+		return
+	}
+	c.deliverLive(eventData, event)
+}
+
+func (c *catchUp) deliverLive(eventData []byte, event *TransactionEvent) {
+	// a reverted transaction has been replayed as a regular one, so the subscriber has to learn it is gone.
+	if !event.Reverted && !event.Simulated && event.Lt <= c.replayed[event.AccountID] {
+		return
+	}
+	c.deliver(eventData, event)
+}
+
+// replay delivers a transaction from the history.
+func (c *catchUp) replay(event *TransactionEvent) error {
+	eventData, err := json.Marshal(event.eventData())
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.replayed[event.AccountID] = event.Lt
+	c.mu.Unlock()
+	c.deliver(eventData, event)
+	return nil
+}
+
+// finish delivers held transactions and lets live ones through.
+func (c *catchUp) finish() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range c.held {
+		c.deliverLive(c.held[i].eventData, &c.held[i].event)
+	}
+	c.held = nil
+	c.replaying = false
+}
+
+// replay delivers transactions of the subscription's accounts from the history and then switches to live ones.
+// If the history is unavailable, the subscriber gets live transactions only.
+func (b *BlockchainSource) replay(ctx context.Context, c *catchUp, opts SubscribeToTransactionsOptions) {
+	defer c.finish()
+	if err := b.replayHistory(ctx, c, opts); err != nil {
+		b.logger.Warn("failed to replay transactions", zap.Error(err))
+	}
+}
+
+func (b *BlockchainSource) replayHistory(ctx context.Context, c *catchUp, opts SubscribeToTransactionsOptions) error {
+	fromLt, err := b.replayStartLt(ctx, opts)
+	if err != nil {
+		return err
+	}
+	for _, account := range opts.Accounts {
+		afterLt := fromLt - 1
+		for {
+			txs, err := b.history.IndexedAccountTransactions(ctx, account, afterLt, replayPageSize)
+			if err != nil {
+				return err
+			}
+			for _, tx := range txs {
+				event, err := historyTransactionEvent(tx)
+				if err != nil {
+					return err
+				}
+				event.decode(opts)
+				if err := c.replay(&event); err != nil {
+					return err
+				}
+			}
+			if len(txs) < replayPageSize {
+				break
+			}
+			afterLt = txs[len(txs)-1].Lt
+		}
+	}
+	return nil
+}
+
+// replayStartLt returns the logical time of the first transaction to replay.
+func (b *BlockchainSource) replayStartLt(ctx context.Context, opts SubscribeToTransactionsOptions) (uint64, error) {
+	if opts.FromSeqno == 0 {
+		return opts.FromLt, nil
+	}
+	header, err := b.history.GetBlockHeader(ctx, tongo.BlockID{Workchain: -1, Shard: 0x8000000000000000, Seqno: opts.FromSeqno})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get masterchain block %v: %w", opts.FromSeqno, err)
+	}
+	if header.StartLt <= 0 {
+		return 1, nil
+	}
+	return uint64(header.StartLt), nil
+}
+
+// historyTransactionEvent describes a transaction from the history the same way live transactions are described.
+func historyTransactionEvent(transaction *core.Transaction) (TransactionEvent, error) {
+	cells, err := boc.DeserializeBoc(transaction.Raw)
+	if err != nil {
+		return TransactionEvent{}, err
+	}
+	if len(cells) != 1 {
+		return TransactionEvent{}, fmt.Errorf("transaction %v must contain a single root cell", transaction.Hash.Hex())
+	}
+	var tx tlb.Transaction
+	if err := tlb.Unmarshal(cells[0], &tx); err != nil {
+		return TransactionEvent{}, err
+	}
+	return newTransactionEvent(transaction.Account, &tx), nil
+}
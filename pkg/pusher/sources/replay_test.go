@@ -0,0 +1,114 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+func Test_catchUp(t *testing.T) {
+	account := tongo.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+	var delivered []uint64
+	c := newCatchUp(func(eventData []byte, event *TransactionEvent) {
+		delivered = append(delivered, event.Lt)
+	})
+
+	// live transactions arrive while the history is replayed.
+	c.live(nil, &TransactionEvent{AccountID: account, Lt: 20})
+	c.live(nil, &TransactionEvent{AccountID: account, Lt: 30})
+	require.Empty(t, delivered)
+
+	require.Nil(t, c.replay(&TransactionEvent{AccountID: account, Lt: 10}))
+	require.Nil(t, c.replay(&TransactionEvent{AccountID: account, Lt: 20}))
+	require.Equal(t, []uint64{10, 20}, delivered)
+
+	c.finish()
+	require.Equal(t, []uint64{10, 20, 30}, delivered)
+
+	c.live(nil, &TransactionEvent{AccountID: account, Lt: 20, Reverted: true})
+	c.live(nil, &TransactionEvent{AccountID: account, Lt: 40})
+	require.Equal(t, []uint64{10, 20, 30, 20, 40}, delivered)
+}
+
+type mockTransactionHistory struct {
+	transactions []*core.Transaction
+	header       *core.BlockHeader
+}
+
+func (m *mockTransactionHistory) IndexedAccountTransactions(ctx context.Context, account tongo.AccountID, afterLt uint64, limit int) ([]*core.Transaction, error) {
+	var result []*core.Transaction
+	for _, tx := range m.transactions {
+		if tx.Account == account && tx.Lt > afterLt {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTransactionHistory) GetBlockHeader(ctx context.Context, id tongo.BlockID) (*core.BlockHeader, error) {
+	return m.header, nil
+}
+
+func TestBlockchainSource_SubscribeToTransactions_catchUp(t *testing.T) {
+	txBoc, err := os.ReadFile("testdata/transaction.boc")
+	require.Nil(t, err)
+	account := tongo.MustParseAccountID("0:6ccd325a858c379693fae2bcaab1c2906831a4e10a6c3bb44ee8b615bca1d220")
+	transaction := &core.Transaction{Raw: txBoc}
+	transaction.Account = account
+	event, err := historyTransactionEvent(transaction)
+	require.Nil(t, err)
+	require.Equal(t, "c5ca880c8e667af78d193ac5d2f1437c2bcec08d16436f6579f3493e556b4f48", event.TxHash)
+	transaction.Lt = event.Lt
+
+	tests := []struct {
+		name    string
+		options SubscribeToTransactionsOptions
+		header  *core.BlockHeader
+		want    []uint64
+	}{
+		{
+			name:    "from lt",
+			options: SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{account}, AllOperations: true, FromLt: event.Lt},
+			want:    []uint64{event.Lt},
+		},
+		{
+			name:    "from a later lt",
+			options: SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{account}, AllOperations: true, FromLt: event.Lt + 1},
+		},
+		{
+			name:    "from seqno",
+			options: SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{account}, AllOperations: true, FromSeqno: 100},
+			header:  &core.BlockHeader{StartLt: int64(event.Lt) - 10},
+			want:    []uint64{event.Lt},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := NewBlockchainSource(zap.L(), nil, WithTransactionHistory(&mockTransactionHistory{
+				transactions: []*core.Transaction{transaction},
+				header:       tt.header,
+			}))
+			replayed := make(chan TransactionEventData, 10)
+			c, cancel := source.txDispatcher.RegisterCatchingUpSubscriber(func(eventData []byte) {
+				var data TransactionEventData
+				require.Nil(t, json.Unmarshal(eventData, &data))
+				replayed <- data
+			}, tt.options)
+			defer cancel()
+			source.replay(context.Background(), c, tt.options)
+			close(replayed)
+			var lts []uint64
+			for data := range replayed {
+				lts = append(lts, data.Lt)
+			}
+			require.Equal(t, tt.want, lts)
+		})
+	}
+}
@@ -2,9 +2,14 @@ package sources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
 )
 
 type SubscribeToTransactionsOptions struct {
@@ -12,6 +17,27 @@ type SubscribeToTransactionsOptions struct {
 	AllAccounts   bool
 	Operations    []string
 	AllOperations bool
+	// StatusChangesOnly narrows a subscription down to transactions changing the status of an account.
+	StatusChangesOnly bool
+	// JettonStatusChangesOnly narrows a subscription down to transactions locking or unlocking a jetton wallet.
+	JettonStatusChangesOnly bool
+	// ExtOutMessagesOnly narrows a subscription down to external outbound messages of transactions,
+	// a subscriber gets an ExtOutMessageEventData per message and Operations are matched against the messages.
+	ExtOutMessagesOnly bool
+	// JettonTransfersOnly narrows a subscription down to jetton transfers sent or received by accounts,
+	// a subscriber gets a JettonTransferEventData per transfer and Operations are ignored.
+	JettonTransfersOnly bool
+	// FromLt, if set, makes a source replay indexed transactions of Accounts starting with this logical time
+	// before live ones, so there is no gap between a REST snapshot and the stream.
+	// Transactions of every account are replayed in order, but transactions of different accounts are not interleaved.
+	FromLt uint64
+	// FromSeqno is the same as FromLt but the replay starts with the logical time of the masterchain block with this seqno.
+	FromSeqno uint32
+}
+
+// catchingUp returns true if a subscriber wants to catch up with the stream before getting live transactions.
+func (opts SubscribeToTransactionsOptions) catchingUp() bool {
+	return !opts.AllAccounts && (opts.FromLt > 0 || opts.FromSeqno > 0)
 }
 
 // SubscribeToMempoolOptions configures subscription to mempool events.
@@ -33,6 +59,82 @@ type TransactionEventData struct {
 	AccountID tongo.AccountID `json:"account_id"`
 	Lt        uint64          `json:"lt"`
 	TxHash    string          `json:"tx_hash"`
+	// Reverted is set when a previously announced transaction has been dropped by a chain reorganization.
+	// Consumers must void everything they did based on the original notification, e.g. credited deposits.
+	Reverted bool `json:"reverted,omitempty"`
+	// Simulated is set for synthetic transactions injected in the testnet to test deposit processing.
+	// Such transactions don't exist on-chain and must never be credited.
+	Simulated bool `json:"simulated,omitempty"`
+	// StatusChange is set when the transaction changes the status of the account,
+	// e.g. nonexist -> active on deployment, active -> frozen on storage debt or active -> nonexist on deletion.
+	StatusChange *AccountStatusChange `json:"status_change,omitempty"`
+	// JettonStatus is set when the transaction sets a new lock status of a jetton wallet,
+	// it happens to wallets of jettons which can be locked by their admin, e.g. regulated stablecoins.
+	JettonStatus *JettonWalletStatus `json:"jetton_status,omitempty"`
+}
+
+// ExtOutMessageEventData represents a notification about an external outbound message emitted by a contract,
+// many contracts emit such messages as event logs.
+// This is part of our API contract with subscribers.
+type ExtOutMessageEventData struct {
+	AccountID tongo.AccountID `json:"account_id"`
+	Lt        uint64          `json:"lt"`
+	TxHash    string          `json:"tx_hash"`
+	// Destination is an external address of the message, some contracts use it as a topic of the log.
+	Destination *boc.BitString `json:"destination,omitempty"`
+	// Body is a hex-encoded BoC of the message body.
+	Body   string         `json:"body"`
+	OpCode *uint32        `json:"op_code,omitempty"`
+	OpName *abi.MsgOpName `json:"op_name,omitempty"`
+	// Decoded is the body decoded with the ABI registry, it is omitted if the operation is unknown.
+	Decoded json.RawMessage `json:"decoded,omitempty"`
+	// Reverted is set when the transaction emitting the message has been dropped by a chain reorganization.
+	Reverted bool `json:"reverted,omitempty"`
+}
+
+// JettonTransferDirection tells whether an account sends or receives jettons.
+type JettonTransferDirection string
+
+const (
+	JettonTransferIn  JettonTransferDirection = "in"
+	JettonTransferOut JettonTransferDirection = "out"
+)
+
+// JettonTransferEventData represents a notification about a jetton transfer sent or received by an account.
+// An outgoing transfer is taken from a jetton_transfer message the account sends to its jetton wallet,
+// it may still bounce if the wallet doesn't have enough jettons.
+// An incoming transfer is taken from a transfer_notification message sent by a jetton wallet of the account,
+// a jetton wallet sends it only if the sender attaches a forward amount.
+// This is part of our API contract with subscribers.
+type JettonTransferEventData struct {
+	AccountID tongo.AccountID         `json:"account_id"`
+	Lt        uint64                  `json:"lt"`
+	TxHash    string                  `json:"tx_hash"`
+	Direction JettonTransferDirection `json:"direction"`
+	// JettonWallet is a jetton wallet of the account.
+	// Anyone can send a transfer_notification, so a client must check that the wallet belongs to a known jetton.
+	JettonWallet tongo.AccountID  `json:"jetton_wallet"`
+	Sender       *tongo.AccountID `json:"sender,omitempty"`
+	Recipient    *tongo.AccountID `json:"recipient,omitempty"`
+	// Amount is a decimal amount of jettons in the smallest units.
+	Amount  string  `json:"amount"`
+	QueryID uint64  `json:"query_id"`
+	Comment *string `json:"comment,omitempty"`
+	// Reverted is set when the transaction has been dropped by a chain reorganization.
+	Reverted bool `json:"reverted,omitempty"`
+}
+
+// AccountStatusChange describes a transition of an account from one status to another.
+type AccountStatusChange struct {
+	From tlb.AccountStatus `json:"from"`
+	To   tlb.AccountStatus `json:"to"`
+}
+
+// JettonWalletStatus describes a lock status of a jetton wallet.
+type JettonWalletStatus struct {
+	Code           uint8 `json:"code"`
+	OutgoingLocked bool  `json:"outgoing_locked"`
+	IncomingLocked bool  `json:"incoming_locked"`
 }
 
 // TransactionSource provides a method to subscribe to notifications about new transactions from the blockchain.
@@ -91,15 +193,66 @@ type SubscribeToBlocksOptions struct {
 	MasterchainSeqno uint32 `json:"masterchain_seqno,omitempty"`
 	// RateLimit defines the rate limit (KB/sec) for the block streaming.
 	RateLimit int
+	// Workchain, if set, opentonapi will filter out blocks that are not from the specified workchain.
+	Workchain *int32 `json:"workchain,omitempty"`
+	// Shard, if set, opentonapi will filter out blocks of shards that don't intersect with the specified one.
+	Shard *ton.ShardID `json:"-"`
+	// OnlyMasterchain, if set, opentonapi will deliver masterchain blocks only.
+	OnlyMasterchain bool `json:"only_masterchain,omitempty"`
+	// Transactions, if set, opentonapi will replace raw blocks with their transactions.
+	Transactions BlockTransactionsMode `json:"transactions,omitempty"`
 }
 
+// BlockTransactionsMode defines how transactions of a block are delivered to a subscriber.
+type BlockTransactionsMode string
+
+const (
+	// BlockTransactionsIDs delivers identifiers of transactions only.
+	BlockTransactionsIDs BlockTransactionsMode = "ids"
+	// BlockTransactionsFull delivers decoded transactions.
+	BlockTransactionsFull BlockTransactionsMode = "full"
+)
+
 type Block struct {
 	Workchain int32  `json:"workchain"`
 	Shard     string `json:"shard"`
 	Seqno     uint32 `json:"seqno"`
 	RootHash  string `json:"root_hash"`
 	FileHash  string `json:"file_hash"`
-	Raw       []byte `json:"raw"`
+	Raw       []byte `json:"raw,omitempty"`
+	// Transactions is set instead of Raw when a subscriber asks for transactions of blocks,
+	// see SubscribeToBlocksOptions.Transactions.
+	Transactions []BlockTransaction `json:"transactions,omitempty"`
+}
+
+// BlockTransaction is a transaction of a block.
+// Only an identifier of the transaction is set unless a subscriber asks for decoded transactions.
+type BlockTransaction struct {
+	AccountID tongo.AccountID `json:"account_id"`
+	Lt        uint64          `json:"lt"`
+	TxHash    string          `json:"tx_hash"`
+
+	*DecodedTransaction
+}
+
+// DecodedTransaction contains the details of a transaction delivered with BlockTransactionsFull.
+type DecodedTransaction struct {
+	Utime      uint32            `json:"utime"`
+	Success    bool              `json:"success"`
+	OrigStatus tlb.AccountStatus `json:"orig_status"`
+	EndStatus  tlb.AccountStatus `json:"end_status"`
+	TotalFees  int64             `json:"total_fees"`
+	InMsg      *BlockMessage     `json:"in_msg,omitempty"`
+	OutMsgs    []BlockMessage    `json:"out_msgs"`
+}
+
+// BlockMessage is a message of a decoded transaction.
+type BlockMessage struct {
+	Source      *tongo.AccountID `json:"source,omitempty"`
+	Destination *tongo.AccountID `json:"destination,omitempty"`
+	Value       int64            `json:"value"`
+	OpCode      *uint32          `json:"op_code,omitempty"`
+	OpName      *abi.MsgOpName   `json:"op_name,omitempty"`
 }
 
 // BlockchainSliceEvent represents a notification about a new bunch of blocks in the blockchain.
@@ -118,4 +271,42 @@ type BlockSource interface {
 type TraceEventData struct {
 	AccountIDs []tongo.AccountID `json:"accounts"`
 	Hash       string            `json:"hash"`
+	// Simulated is set for a trace of a synthetic transaction, see TransactionEventData.Simulated.
+	Simulated bool `json:"simulated,omitempty"`
+}
+
+// SubscribeToConfigChangesOptions configures subscription to blockchain config changes.
+type SubscribeToConfigChangesOptions struct {
+	// Params, if set, opentonapi will filter out changes of other config parameters.
+	Params []int32 `json:"params,omitempty"`
+}
+
+// ConfigChangeEventData represents a notification about a changed blockchain config parameter.
+// This is part of our API contract with subscribers.
+type ConfigChangeEventData struct {
+	// Seqno is a seqno of the key block that has changed the parameter.
+	Seqno uint32 `json:"seqno"`
+	Param int32  `json:"param"`
+	// Before is a decoded value of the parameter before the change, it is empty for a new parameter.
+	Before json.RawMessage `json:"before,omitempty"`
+	// After is a decoded value of the parameter after the change, it is empty for a removed parameter.
+	After json.RawMessage `json:"after,omitempty"`
+}
+
+// ConfigProposalEventData represents a notification about a config proposal applied by a key block.
+// This is part of our API contract with subscribers.
+type ConfigProposalEventData struct {
+	// Seqno is a seqno of the key block that has applied the proposal.
+	Seqno    uint32 `json:"seqno"`
+	Hash     string `json:"hash"`
+	Param    int32  `json:"param"`
+	Critical bool   `json:"critical"`
+}
+
+// ConfigChangesSource provides methods to subscribe to notifications about blockchain config changes.
+type ConfigChangesSource interface {
+	SubscribeToConfigChanges(ctx context.Context, deliveryFn DeliveryFn, opts SubscribeToConfigChangesOptions) CancelFn
+	// SubscribeToConfigProposals notifies about proposals that have passed voting and changed the config,
+	// SubscribeToConfigChangesOptions.Params filters proposals by the param they change.
+	SubscribeToConfigProposals(ctx context.Context, deliveryFn DeliveryFn, opts SubscribeToConfigChangesOptions) CancelFn
 }
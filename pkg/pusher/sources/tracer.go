@@ -40,11 +40,19 @@ type dispatcher interface {
 	RegisterSubscriber(fn DeliveryFn, options SubscribeToTraceOptions) CancelFn
 }
 
+// catchUpIndicator reports whether the indexer is catching up with the network head.
+type catchUpIndicator interface {
+	CatchingUp() bool
+}
+
 type Tracer struct {
 	logger     *zap.Logger
 	storage    storage
 	dispatcher dispatcher
 	source     TransactionSource
+	// catchUp, if set, is used to skip building traces while the indexer is catching up,
+	// because building a trace is expensive and traces of old transactions are of little value to subscribers.
+	catchUp catchUpIndicator
 
 	// mu protects traceCache.
 	// Tracer usually receives multiple tx hashes that are parts of the same trace,
@@ -56,14 +64,27 @@ type Tracer struct {
 	traceCache cache.Cache[string, struct{}]
 }
 
-func NewTracer(logger *zap.Logger, storage storage, source TransactionSource) *Tracer {
-	return &Tracer{
+type TracerOption func(t *Tracer)
+
+// WithCatchUpIndicator configures the tracer to skip building traces while the indexer is catching up.
+func WithCatchUpIndicator(indicator catchUpIndicator) TracerOption {
+	return func(t *Tracer) {
+		t.catchUp = indicator
+	}
+}
+
+func NewTracer(logger *zap.Logger, storage storage, source TransactionSource, opts ...TracerOption) *Tracer {
+	t := &Tracer{
 		logger:     logger,
 		storage:    storage,
 		source:     source,
 		dispatcher: NewTraceDispatcher(logger),
 		traceCache: cache.NewLRUCache[string, struct{}](10000, "tracer_trace_cache"),
 	}
+	for _, o := range opts {
+		o(t)
+	}
+	return t
 }
 
 var _ TraceSource = (*Tracer)(nil)
@@ -84,6 +105,9 @@ func (t *Tracer) Run(ctx context.Context) {
 			t.logger.Error("json.Unmarshal() failed", zap.Error(err))
 			return
 		}
+		if tx.Reverted {
+			return
+		}
 		txCh <- tx
 	}, SubscribeToTransactionsOptions{AllAccounts: true, AllOperations: true})
 
@@ -96,6 +120,15 @@ func (t *Tracer) Run(ctx context.Context) {
 		if ctx.Err() != nil {
 			return
 		}
+		if txEvent.Simulated {
+			// there is no trace to load, the synthetic transaction is a trace on its own.
+			t.dispatchSimulated(txEvent)
+			continue
+		}
+		if t.catchUp != nil && t.catchUp.CatchingUp() {
+			traceNumber.With(map[string]string{"type": "skipped-catch-up"}).Inc()
+			continue
+		}
 		if allow, _ := limiter.ShouldAllow(1); !allow {
 			traceNumber.With(map[string]string{"type": "dropped"}).Inc()
 			continue
@@ -162,3 +195,18 @@ func (t *Tracer) dispatch(trace *core.Trace) {
 
 	t.dispatcher.Dispatch(accounts, eventJSON)
 }
+
+func (t *Tracer) dispatchSimulated(tx TransactionEventData) {
+	traceNumber.With(map[string]string{"type": "simulated"}).Inc()
+	accounts := []tongo.AccountID{tx.AccountID}
+	eventJSON, err := json.Marshal(&TraceEventData{
+		AccountIDs: accounts,
+		Hash:       tx.TxHash,
+		Simulated:  true,
+	})
+	if err != nil {
+		t.logger.Error("json.Marshal() failed: %v", zap.Error(err))
+		return
+	}
+	t.dispatcher.Dispatch(accounts, eventJSON)
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
+	"github.com/tonkeeper/opentonapi/pkg/core"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
 	"go.uber.org/zap"
 )
 
@@ -22,15 +26,103 @@ type TransactionEvent struct {
 	MsgOpName *abi.MsgOpName
 	// MsgOpCode is an operation code taken from the first 4 bytes of tx.InMsg.Body.
 	MsgOpCode *uint32
+	// OutMsgOps are operations of tx.OutMsgs, a subscriber to an operation is notified
+	// about transactions sending a message with the operation as well.
+	OutMsgOps []MsgOp
+	// OrigStatus and EndStatus are statuses of the account before and after the transaction.
+	OrigStatus tlb.AccountStatus
+	EndStatus  tlb.AccountStatus
+	// JettonStatus is a new lock status of a jetton wallet set by the transaction.
+	JettonStatus *core.JettonWalletStatus
+	// ExtOutMsgs are external outbound messages emitted by the transaction.
+	ExtOutMsgs []ExtOutMessageEventData
+	// JettonTransfers are jetton transfers sent or received by the transaction.
+	JettonTransfers []JettonTransferEventData
+	// Reverted is set when the transaction belongs to an orphaned block.
+	Reverted bool
+	// Simulated is set when the transaction is synthetic, see BlockchainSource.SimulateTransaction.
+	Simulated bool
+
+	// tx is the transaction itself, ExtOutMsgs and JettonTransfers are decoded from it
+	// only when the event is delivered to a subscription wanting them, see decode.
+	tx                     *tlb.Transaction
+	extOutMsgsDecoded      bool
+	jettonTransfersDecoded bool
+}
+
+// MsgOp is an operation of a message taken from the first 4 bytes of its body.
+type MsgOp struct {
+	Name *abi.MsgOpName
+	Code *uint32
+}
+
+// ops returns operations of the inbound message and of the outbound messages of the transaction.
+func (e *TransactionEvent) ops() []MsgOp {
+	ops := make([]MsgOp, 0, len(e.OutMsgOps)+1)
+	ops = append(ops, MsgOp{Name: e.MsgOpName, Code: e.MsgOpCode})
+	return append(ops, e.OutMsgOps...)
+}
+
+// statusChange returns a transition of the account's status made by the transaction, if any.
+func (e *TransactionEvent) statusChange() *AccountStatusChange {
+	if e.OrigStatus == "" || e.EndStatus == "" || e.OrigStatus == e.EndStatus {
+		return nil
+	}
+	return &AccountStatusChange{From: e.OrigStatus, To: e.EndStatus}
+}
+
+// jettonStatus returns a lock status of a jetton wallet set by the transaction, if any.
+func (e *TransactionEvent) jettonStatus() *JettonWalletStatus {
+	if e.JettonStatus == nil {
+		return nil
+	}
+	return &JettonWalletStatus{
+		Code:           uint8(*e.JettonStatus),
+		OutgoingLocked: e.JettonStatus.OutgoingLocked(),
+		IncomingLocked: e.JettonStatus.IncomingLocked(),
+	}
+}
+
+// decode fills in details of the transaction wanted by a subscription with the given options.
+// Every detail is decoded at most once, so the cost is paid only if somebody is interested in it.
+func (e *TransactionEvent) decode(options SubscribeToTransactionsOptions) {
+	if e.tx == nil {
+		return
+	}
+	if options.ExtOutMessagesOnly && !e.extOutMsgsDecoded {
+		e.ExtOutMsgs = extOutMessages(e.AccountID, e.tx)
+		e.extOutMsgsDecoded = true
+	}
+	if options.JettonTransfersOnly && !e.jettonTransfersDecoded {
+		e.JettonTransfers = jettonTransfers(e.AccountID, e.tx)
+		e.jettonTransfersDecoded = true
+	}
+}
+
+// eventData returns a notification about the transaction sent to subscribers.
+func (e *TransactionEvent) eventData() TransactionEventData {
+	return TransactionEventData{
+		AccountID:    e.AccountID,
+		Lt:           e.Lt,
+		TxHash:       e.TxHash,
+		Reverted:     e.Reverted,
+		Simulated:    e.Simulated,
+		StatusChange: e.statusChange(),
+		JettonStatus: e.jettonStatus(),
+	}
 }
 
-type txDeliveryFn func(eventData []byte, msgOpName *abi.MsgOpName, msgOpCode *uint32)
+type txDeliveryFn func(eventData []byte, event *TransactionEvent)
 
 // TransactionDispatcher implements the fan-out pattern reading a TransactionEvent from a single channel
 // and delivering it to multiple subscribers.
 type TransactionDispatcher struct {
 	logger *zap.Logger
 
+	// priority are accounts whose transactions are dispatched from a dedicated queue,
+	// so they are not delayed by a backlog of transactions of other accounts.
+	priority map[tongo.AccountID]struct{}
+
 	mu          sync.RWMutex
 	accounts    map[tongo.AccountID]map[subscriberID]txDeliveryFn
 	allAccounts map[subscriberID]txDeliveryFn
@@ -38,9 +130,21 @@ type TransactionDispatcher struct {
 	currentID   subscriberID
 }
 
-func NewTransactionDispatcher(logger *zap.Logger) *TransactionDispatcher {
+const (
+	// priorityQueueSize and bulkQueueSize are numbers of transactions waiting to be dispatched
+	// when there are priority accounts.
+	priorityQueueSize = 1_000
+	bulkQueueSize     = 100_000
+)
+
+func NewTransactionDispatcher(logger *zap.Logger, priorityAccounts ...tongo.AccountID) *TransactionDispatcher {
+	priority := make(map[tongo.AccountID]struct{}, len(priorityAccounts))
+	for _, account := range priorityAccounts {
+		priority[account] = struct{}{}
+	}
 	return &TransactionDispatcher{
 		logger:      logger,
+		priority:    priority,
 		accounts:    map[tongo.AccountID]map[subscriberID]txDeliveryFn{},
 		allAccounts: map[subscriberID]txDeliveryFn{},
 		options:     map[subscriberID]SubscribeToTransactionsOptions{},
@@ -48,31 +152,62 @@ func NewTransactionDispatcher(logger *zap.Logger) *TransactionDispatcher {
 	}
 }
 
+// IsPriority returns true if transactions of the account are dispatched from the priority queue.
+func (disp *TransactionDispatcher) IsPriority(account tongo.AccountID) bool {
+	_, ok := disp.priority[account]
+	return ok
+}
+
 // Run runs a dispatching loop in a dedicated goroutine and returns a channel to be used to communicate with this dispatcher.
+// With priority accounts, there are two loops: transactions of priority accounts are dispatched by their own loop
+// while transactions of other accounts are buffered in a bulk queue.
 func (disp *TransactionDispatcher) Run(ctx context.Context) chan TransactionEvent {
 	ch := make(chan TransactionEvent)
+	if len(disp.priority) == 0 {
+		go disp.loop(ctx, ch)
+		return ch
+	}
+	priorityCh := make(chan TransactionEvent, priorityQueueSize)
+	bulkCh := make(chan TransactionEvent, bulkQueueSize)
+	go disp.loop(ctx, priorityCh)
+	go disp.loop(ctx, bulkCh)
 	go func() {
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case event := <-ch:
-				disp.logger.Debug("handling transaction",
-					zap.String("account", event.AccountID.ToRaw()),
-					zap.Uint64("lt", event.Lt))
-				tx := TransactionEventData{
-					AccountID: event.AccountID,
-					Lt:        event.Lt,
-					TxHash:    event.TxHash,
+				queue := bulkCh
+				if disp.IsPriority(event.AccountID) {
+					queue = priorityCh
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case queue <- event:
 				}
-				disp.dispatch(&tx, event.MsgOpName, event.MsgOpCode)
 			}
 		}
 	}()
 	return ch
 }
 
-func (disp *TransactionDispatcher) dispatch(tx *TransactionEventData, msgOpName *abi.MsgOpName, msgOpCode *uint32) {
+func (disp *TransactionDispatcher) loop(ctx context.Context, ch chan TransactionEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-ch:
+			disp.logger.Debug("handling transaction",
+				zap.String("account", event.AccountID.ToRaw()),
+				zap.Uint64("lt", event.Lt))
+			tx := event.eventData()
+			disp.dispatch(&tx, &event)
+		}
+	}
+}
+
+func (disp *TransactionDispatcher) dispatch(tx *TransactionEventData, event *TransactionEvent) {
 	eventData, err := json.Marshal(tx)
 	if err != nil {
 		disp.logger.Error("json.Marshal() failed: %v", zap.Error(err))
@@ -81,42 +216,153 @@ func (disp *TransactionDispatcher) dispatch(tx *TransactionEventData, msgOpName
 	disp.mu.RLock()
 	defer disp.mu.RUnlock()
 
-	for _, deliveryFn := range disp.allAccounts {
-		deliveryFn(eventData, msgOpName, msgOpCode)
+	for id, deliveryFn := range disp.allAccounts {
+		event.decode(disp.options[id])
+		deliveryFn(eventData, event)
 	}
 	subscribers := disp.accounts[tx.AccountID]
-	for _, deliveryFn := range subscribers {
-		deliveryFn(eventData, msgOpName, msgOpCode)
+	for id, deliveryFn := range subscribers {
+		event.decode(disp.options[id])
+		deliveryFn(eventData, event)
+	}
+}
+
+// ParseOperation validates an operation a client wants to subscribe to.
+// An operation is either a name of an ABI operation like "JettonTransfer"
+// or a 32-bit opcode in hex like "0x0f8a7ea5", the opcode is returned in the canonical form.
+func ParseOperation(op string) (string, error) {
+	if !strings.HasPrefix(strings.ToLower(op), "0x") {
+		if op == "" {
+			return "", fmt.Errorf("empty operation")
+		}
+		return op, nil
 	}
+	code, err := strconv.ParseUint(op[2:], 16, 32)
+	if err != nil {
+		return "", fmt.Errorf("invalid opcode '%v'", op)
+	}
+	return opCodeString(uint32(code)), nil
+}
+
+func opCodeString(code uint32) string {
+	return fmt.Sprintf("0x%08x", code)
 }
 
 func createTxDeliveryFnBasedOnOptions(fn DeliveryFn, options SubscribeToTransactionsOptions) txDeliveryFn {
+	if options.ExtOutMessagesOnly {
+		return createExtOutMsgDeliveryFn(fn, options)
+	}
+	if options.JettonTransfersOnly {
+		return createJettonTransferDeliveryFn(fn)
+	}
+	deliveryFn := createTxOpsDeliveryFn(fn, options)
+	switch {
+	case options.StatusChangesOnly:
+		return func(eventData []byte, event *TransactionEvent) {
+			if event.statusChange() != nil {
+				deliveryFn(eventData, event)
+			}
+		}
+	case options.JettonStatusChangesOnly:
+		return func(eventData []byte, event *TransactionEvent) {
+			if event.JettonStatus != nil {
+				deliveryFn(eventData, event)
+			}
+		}
+	}
+	return deliveryFn
+}
+
+func createTxOpsDeliveryFn(fn DeliveryFn, options SubscribeToTransactionsOptions) txDeliveryFn {
 	if options.AllOperations {
-		return func(eventData []byte, msgOpName *abi.MsgOpName, msgOpCode *uint32) {
+		return func(eventData []byte, event *TransactionEvent) {
 			fn(eventData)
 		}
 	}
-	ops := make(map[abi.MsgOpName]struct{}, len(options.Operations))
-	for _, op := range options.Operations {
-		ops[op] = struct{}{}
-	}
-	return func(eventData []byte, msgOpName *abi.MsgOpName, msgOpCode *uint32) {
-		if msgOpName != nil {
-			if _, ok := ops[*msgOpName]; ok {
+	wanted := newOperationSet(options.Operations)
+	return func(eventData []byte, event *TransactionEvent) {
+		for _, op := range event.ops() {
+			if wanted.contains(op) {
 				fn(eventData)
 				return
 			}
 		}
-		if msgOpCode != nil {
-			if _, ok := ops[fmt.Sprintf("0x%08x", *msgOpCode)]; ok {
-				fn(eventData)
-				return
+	}
+}
+
+// createExtOutMsgDeliveryFn delivers external outbound messages of a transaction one by one,
+// so a subscriber to an operation gets only messages with the operation.
+func createExtOutMsgDeliveryFn(fn DeliveryFn, options SubscribeToTransactionsOptions) txDeliveryFn {
+	wanted := newOperationSet(options.Operations)
+	return func(_ []byte, event *TransactionEvent) {
+		for _, msg := range event.ExtOutMsgs {
+			if !options.AllOperations && !wanted.contains(MsgOp{Name: msg.OpName, Code: msg.OpCode}) {
+				continue
+			}
+			msg.Reverted = event.Reverted
+			eventData, err := json.Marshal(msg)
+			if err != nil {
+				continue
 			}
+			fn(eventData)
 		}
 	}
 }
 
+// createJettonTransferDeliveryFn delivers jetton transfers of a transaction one by one.
+func createJettonTransferDeliveryFn(fn DeliveryFn) txDeliveryFn {
+	return func(_ []byte, event *TransactionEvent) {
+		for _, transfer := range event.JettonTransfers {
+			transfer.Reverted = event.Reverted
+			eventData, err := json.Marshal(transfer)
+			if err != nil {
+				continue
+			}
+			fn(eventData)
+		}
+	}
+}
+
+// operationSet contains operations of a subscription, opcodes are kept in the canonical form.
+type operationSet map[string]struct{}
+
+func newOperationSet(operations []string) operationSet {
+	set := make(operationSet, len(operations))
+	for _, op := range operations {
+		if normalized, err := ParseOperation(op); err == nil {
+			op = normalized
+		}
+		set[op] = struct{}{}
+	}
+	return set
+}
+
+func (set operationSet) contains(op MsgOp) bool {
+	if op.Name != nil {
+		if _, ok := set[*op.Name]; ok {
+			return true
+		}
+	}
+	if op.Code != nil {
+		if _, ok := set[opCodeString(*op.Code)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (disp *TransactionDispatcher) RegisterSubscriber(fn DeliveryFn, options SubscribeToTransactionsOptions) CancelFn {
+	return disp.register(createTxDeliveryFnBasedOnOptions(fn, options), options)
+}
+
+// RegisterCatchingUpSubscriber registers a subscriber whose live transactions are held back
+// until the returned catchUp is done with replaying transactions from the history.
+func (disp *TransactionDispatcher) RegisterCatchingUpSubscriber(fn DeliveryFn, options SubscribeToTransactionsOptions) (*catchUp, CancelFn) {
+	c := newCatchUp(createTxDeliveryFnBasedOnOptions(fn, options))
+	return c, disp.register(c.live, options)
+}
+
+func (disp *TransactionDispatcher) register(deliveryFn txDeliveryFn, options SubscribeToTransactionsOptions) CancelFn {
 	disp.mu.Lock()
 	defer disp.mu.Unlock()
 
@@ -125,7 +371,7 @@ func (disp *TransactionDispatcher) RegisterSubscriber(fn DeliveryFn, options Sub
 	disp.options[id] = options
 
 	if options.AllAccounts {
-		disp.allAccounts[id] = createTxDeliveryFnBasedOnOptions(fn, options)
+		disp.allAccounts[id] = deliveryFn
 		return func() { disp.unsubscribe(id) }
 	}
 
@@ -135,7 +381,7 @@ func (disp *TransactionDispatcher) RegisterSubscriber(fn DeliveryFn, options Sub
 			subscribers = make(map[subscriberID]txDeliveryFn, 1)
 			disp.accounts[account] = subscribers
 		}
-		subscribers[id] = createTxDeliveryFnBasedOnOptions(fn, options)
+		subscribers[id] = deliveryFn
 	}
 	return func() { disp.unsubscribe(id) }
 }
@@ -1,12 +1,17 @@
 package sources
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tonkeeper/opentonapi/internal/g"
+	"github.com/tonkeeper/opentonapi/pkg/core"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
 	"go.uber.org/zap"
 )
@@ -100,6 +105,7 @@ func Test_createDeliveryFnBasedOnOptions(t *testing.T) {
 		options    SubscribeToTransactionsOptions
 		msgOpName  *abi.MsgOpName
 		msgOpCode  *uint32
+		outMsgOps  []MsgOp
 		wantCalled bool
 	}{
 		{
@@ -154,6 +160,55 @@ func Test_createDeliveryFnBasedOnOptions(t *testing.T) {
 			msgOpCode:  g.Pointer(uint32(0x00112244)),
 			wantCalled: false,
 		},
+		{
+			name: "op code in non-canonical form - should be called",
+			options: SubscribeToTransactionsOptions{
+				Operations: []string{
+					"0X112233",
+				},
+			},
+			msgOpCode:  g.Pointer(uint32(0x00112233)),
+			wantCalled: true,
+		},
+		{
+			name: "out message op name match - should be called",
+			options: SubscribeToTransactionsOptions{
+				Operations: []string{
+					"JettonNotify",
+				},
+			},
+			msgOpName: g.Pointer("JettonTransfer"),
+			msgOpCode: g.Pointer(uint32(0x0f8a7ea5)),
+			outMsgOps: []MsgOp{
+				{Name: g.Pointer("JettonInternalTransfer"), Code: g.Pointer(uint32(0x178d4519))},
+				{Name: g.Pointer("JettonNotify"), Code: g.Pointer(uint32(0x7362d09c))},
+			},
+			wantCalled: true,
+		},
+		{
+			name: "out message op code match - should be called",
+			options: SubscribeToTransactionsOptions{
+				Operations: []string{
+					"0xdeadbeef",
+				},
+			},
+			outMsgOps: []MsgOp{
+				{Code: g.Pointer(uint32(0xdeadbeef))},
+			},
+			wantCalled: true,
+		},
+		{
+			name: "out message no match",
+			options: SubscribeToTransactionsOptions{
+				Operations: []string{
+					"0xdeadbeef",
+				},
+			},
+			outMsgOps: []MsgOp{
+				{Name: g.Pointer("JettonNotify"), Code: g.Pointer(uint32(0x7362d09c))},
+			},
+			wantCalled: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -162,9 +217,265 @@ func Test_createDeliveryFnBasedOnOptions(t *testing.T) {
 				isCalled = true
 			}, tt.options)
 
-			deliveryFn([]byte{}, tt.msgOpName, tt.msgOpCode)
+			deliveryFn([]byte{}, &TransactionEvent{MsgOpName: tt.msgOpName, MsgOpCode: tt.msgOpCode, OutMsgOps: tt.outMsgOps})
+
+			require.Equal(t, tt.wantCalled, isCalled)
+		})
+	}
+}
+
+func TestParseOperation(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      string
+		want    string
+		wantErr string
+	}{
+		{name: "op name", op: "JettonTransfer", want: "JettonTransfer"},
+		{name: "op code", op: "0x0f8a7ea5", want: "0x0f8a7ea5"},
+		{name: "op code without leading zeros", op: "0XF8A7EA5", want: "0x0f8a7ea5"},
+		{name: "op code is too long", op: "0x1f8a7ea500", wantErr: "invalid opcode '0x1f8a7ea500'"},
+		{name: "not a hex", op: "0xjetton", wantErr: "invalid opcode '0xjetton'"},
+		{name: "empty", op: "", wantErr: "empty operation"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, err := ParseOperation(tt.op)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.want, op)
+		})
+	}
+}
+
+func Test_createDeliveryFnBasedOnOptions_statusChangesOnly(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      TransactionEvent
+		wantCalled bool
+	}{
+		{
+			name:       "deployment",
+			event:      TransactionEvent{OrigStatus: tlb.AccountNone, EndStatus: tlb.AccountActive},
+			wantCalled: true,
+		},
+		{
+			name:       "freezing",
+			event:      TransactionEvent{OrigStatus: tlb.AccountActive, EndStatus: tlb.AccountFrozen},
+			wantCalled: true,
+		},
+		{
+			name:  "no change",
+			event: TransactionEvent{OrigStatus: tlb.AccountActive, EndStatus: tlb.AccountActive},
+		},
+		{
+			name:  "unknown statuses",
+			event: TransactionEvent{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isCalled := false
+			deliveryFn := createTxDeliveryFnBasedOnOptions(func(eventData []byte) {
+				isCalled = true
+			}, SubscribeToTransactionsOptions{AllOperations: true, StatusChangesOnly: true})
+
+			deliveryFn([]byte{}, &tt.event)
+
+			require.Equal(t, tt.wantCalled, isCalled)
+		})
+	}
+}
+
+func Test_createDeliveryFnBasedOnOptions_jettonStatusChangesOnly(t *testing.T) {
+	locked := core.JettonWalletOutgoingLocked | core.JettonWalletIncomingLocked
+	unlocked := core.JettonWalletStatus(0)
+	tests := []struct {
+		name       string
+		event      TransactionEvent
+		wantCalled bool
+		want       *JettonWalletStatus
+	}{
+		{
+			name:       "locked",
+			event:      TransactionEvent{JettonStatus: &locked},
+			wantCalled: true,
+			want:       &JettonWalletStatus{Code: 3, OutgoingLocked: true, IncomingLocked: true},
+		},
+		{
+			name:       "unlocked",
+			event:      TransactionEvent{JettonStatus: &unlocked},
+			wantCalled: true,
+			want:       &JettonWalletStatus{},
+		},
+		{
+			name:  "regular transaction",
+			event: TransactionEvent{OrigStatus: tlb.AccountNone, EndStatus: tlb.AccountActive},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isCalled := false
+			deliveryFn := createTxDeliveryFnBasedOnOptions(func(eventData []byte) {
+				isCalled = true
+			}, SubscribeToTransactionsOptions{AllOperations: true, JettonStatusChangesOnly: true})
+
+			deliveryFn([]byte{}, &tt.event)
 
 			require.Equal(t, tt.wantCalled, isCalled)
+			require.Equal(t, tt.want, tt.event.jettonStatus())
 		})
 	}
 }
+
+func Test_createDeliveryFnBasedOnOptions_extOutMessagesOnly(t *testing.T) {
+	log := ExtOutMessageEventData{OpCode: g.Pointer(uint32(0x1234)), Body: "b5ee9c72"}
+	named := ExtOutMessageEventData{OpCode: g.Pointer(uint32(0x5678)), OpName: g.Pointer("SomeLog"), Body: "b5ee9c72"}
+	tests := []struct {
+		name       string
+		options    SubscribeToTransactionsOptions
+		event      TransactionEvent
+		wantEvents []ExtOutMessageEventData
+	}{
+		{
+			name:       "all operations",
+			options:    SubscribeToTransactionsOptions{AllOperations: true, ExtOutMessagesOnly: true},
+			event:      TransactionEvent{ExtOutMsgs: []ExtOutMessageEventData{log, named}},
+			wantEvents: []ExtOutMessageEventData{log, named},
+		},
+		{
+			name:       "operation by opcode",
+			options:    SubscribeToTransactionsOptions{Operations: []string{"0x1234"}, ExtOutMessagesOnly: true},
+			event:      TransactionEvent{ExtOutMsgs: []ExtOutMessageEventData{log, named}},
+			wantEvents: []ExtOutMessageEventData{log},
+		},
+		{
+			name:       "operation by name",
+			options:    SubscribeToTransactionsOptions{Operations: []string{"SomeLog"}, ExtOutMessagesOnly: true},
+			event:      TransactionEvent{ExtOutMsgs: []ExtOutMessageEventData{log, named}},
+			wantEvents: []ExtOutMessageEventData{named},
+		},
+		{
+			name:       "reverted",
+			options:    SubscribeToTransactionsOptions{AllOperations: true, ExtOutMessagesOnly: true},
+			event:      TransactionEvent{ExtOutMsgs: []ExtOutMessageEventData{log}, Reverted: true},
+			wantEvents: []ExtOutMessageEventData{{OpCode: log.OpCode, Body: log.Body, Reverted: true}},
+		},
+		{
+			name:    "no messages",
+			options: SubscribeToTransactionsOptions{AllOperations: true, ExtOutMessagesOnly: true},
+			event:   TransactionEvent{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var delivered []ExtOutMessageEventData
+			deliveryFn := createTxDeliveryFnBasedOnOptions(func(eventData []byte) {
+				var msg ExtOutMessageEventData
+				require.Nil(t, json.Unmarshal(eventData, &msg))
+				delivered = append(delivered, msg)
+			}, tt.options)
+
+			deliveryFn([]byte{}, &tt.event)
+
+			require.Equal(t, tt.wantEvents, delivered)
+		})
+	}
+}
+
+func Test_createDeliveryFnBasedOnOptions_jettonTransfersOnly(t *testing.T) {
+	in := JettonTransferEventData{Lt: 10, Direction: JettonTransferIn, Amount: "100"}
+	out := JettonTransferEventData{Lt: 10, Direction: JettonTransferOut, Amount: "5"}
+	tests := []struct {
+		name       string
+		event      TransactionEvent
+		wantEvents []JettonTransferEventData
+	}{
+		{
+			name:       "transfers",
+			event:      TransactionEvent{JettonTransfers: []JettonTransferEventData{in, out}},
+			wantEvents: []JettonTransferEventData{in, out},
+		},
+		{
+			name:       "reverted",
+			event:      TransactionEvent{JettonTransfers: []JettonTransferEventData{in}, Reverted: true},
+			wantEvents: []JettonTransferEventData{{Lt: 10, Direction: JettonTransferIn, Amount: "100", Reverted: true}},
+		},
+		{
+			name:  "no transfers",
+			event: TransactionEvent{MsgOpName: g.Pointer(abi.JettonTransferMsgOp)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var delivered []JettonTransferEventData
+			deliveryFn := createTxDeliveryFnBasedOnOptions(func(eventData []byte) {
+				var transfer JettonTransferEventData
+				require.Nil(t, json.Unmarshal(eventData, &transfer))
+				delivered = append(delivered, transfer)
+			}, SubscribeToTransactionsOptions{AllOperations: true, JettonTransfersOnly: true})
+
+			deliveryFn([]byte{}, &tt.event)
+
+			require.Equal(t, tt.wantEvents, delivered)
+		})
+	}
+}
+
+func TestTransactionDispatcher_priorityAccounts(t *testing.T) {
+	priority := tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+	bulk := tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580352")
+	disp := NewTransactionDispatcher(zap.L(), priority)
+	require.True(t, disp.IsPriority(priority))
+	require.False(t, disp.IsPriority(bulk))
+
+	blocked := make(chan struct{})
+	defer close(blocked)
+	disp.RegisterSubscriber(func(eventData []byte) {
+		// a slow subscriber of a bulk account builds up a backlog.
+		<-blocked
+	}, SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{bulk}, AllOperations: true})
+	delivered := make(chan []byte, 1)
+	disp.RegisterSubscriber(func(eventData []byte) {
+		delivered <- eventData
+	}, SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{priority}, AllOperations: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := disp.Run(ctx)
+	for i := 0; i < 10; i++ {
+		ch <- TransactionEvent{AccountID: bulk, Lt: uint64(i)}
+	}
+	ch <- TransactionEvent{AccountID: priority, Lt: 100}
+
+	select {
+	case eventData := <-delivered:
+		require.Contains(t, string(eventData), `"lt":100`)
+	case <-time.After(time.Second):
+		t.Fatal("transaction of a priority account is stuck behind bulk transactions")
+	}
+}
+
+func TestTransactionDispatcher_dispatch_decodesOnDemand(t *testing.T) {
+	account := tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+	disp := NewTransactionDispatcher(zap.L())
+	disp.RegisterSubscriber(func(eventData []byte) {}, SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{account}, AllOperations: true})
+
+	event := TransactionEvent{AccountID: account, tx: &tlb.Transaction{}}
+	tx := event.eventData()
+	disp.dispatch(&tx, &event)
+	require.False(t, event.extOutMsgsDecoded)
+	require.False(t, event.jettonTransfersDecoded)
+
+	disp.RegisterSubscriber(func(eventData []byte) {}, SubscribeToTransactionsOptions{AllAccounts: true, ExtOutMessagesOnly: true})
+	disp.dispatch(&tx, &event)
+	require.True(t, event.extOutMsgsDecoded)
+	require.False(t, event.jettonTransfersDecoded)
+
+	disp.RegisterSubscriber(func(eventData []byte) {}, SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{account}, JettonTransfersOnly: true})
+	disp.dispatch(&tx, &event)
+	require.True(t, event.jettonTransfersDecoded)
+}
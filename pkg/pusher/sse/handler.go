@@ -13,7 +13,9 @@ import (
 	"github.com/tonkeeper/opentonapi/pkg/pusher/errors"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/events"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
 	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/ton"
 )
 
 // Handler handles http methods for sse.
@@ -23,6 +25,7 @@ type Handler struct {
 	blockHeadersSource sources.BlockHeadersSource
 	traceSource        sources.TraceSource
 	memPool            sources.MemPoolSource
+	configSource       sources.ConfigChangesSource
 	currentEventID     int64
 }
 
@@ -34,15 +37,29 @@ var accountsPerRequestHistogramVec = promauto.NewHistogramVec(
 	[]string{"method"},
 )
 
-type handlerFunc func(session *session, request *http.Request) error
+// Session is a streaming connection to a client.
+// Events are queued and sent to the client by a dedicated loop,
+// if the client is too slow and the queue is full, new events are dropped.
+type Session interface {
+	// SendEvent queues the given event to be sent to the client.
+	SendEvent(event Event)
+	// SetCancelFn sets a function to be called once the client disconnects.
+	// Usually, it is a CancelFn returned by a source.
+	SetCancelFn(cancel sources.CancelFn)
+}
+
+// HandlerFunc parses a request and subscribes the given session to events.
+// It is supposed to return quickly, events are delivered asynchronously via Session.SendEvent.
+type HandlerFunc func(session Session, request *http.Request) error
 
-func NewHandler(blockSource sources.BlockSource, blockHeadersSource sources.BlockHeadersSource, txSource sources.TransactionSource, traceSource sources.TraceSource, memPool sources.MemPoolSource) *Handler {
+func NewHandler(blockSource sources.BlockSource, blockHeadersSource sources.BlockHeadersSource, txSource sources.TransactionSource, traceSource sources.TraceSource, memPool sources.MemPoolSource, configSource sources.ConfigChangesSource) *Handler {
 	h := Handler{
 		txSource:           txSource,
 		blockSource:        blockSource,
 		blockHeadersSource: blockHeadersSource,
 		traceSource:        traceSource,
 		memPool:            memPool,
+		configSource:       configSource,
 		currentEventID:     time.Now().UnixNano(),
 	}
 	return &h
@@ -67,7 +84,13 @@ func parseQueryStrings(accountsStr string, operationsStr string) (*sources.Subsc
 	allOps := len(operationsStr) == 0
 	var operations []string
 	if len(operationsStr) > 0 {
-		operations = strings.Split(operationsStr, ",")
+		for _, op := range strings.Split(operationsStr, ",") {
+			op, err := sources.ParseOperation(op)
+			if err != nil {
+				return nil, err
+			}
+			operations = append(operations, op)
+		}
 	}
 	options := sources.SubscribeToTransactionsOptions{
 		Accounts:      accounts,
@@ -78,7 +101,7 @@ func parseQueryStrings(accountsStr string, operationsStr string) (*sources.Subsc
 	return &options, nil
 }
 
-func (h *Handler) SubscribeToTransactions(session *session, request *http.Request) error {
+func (h *Handler) SubscribeToTransactions(session Session, request *http.Request) error {
 	if h.txSource == nil {
 		return errors.BadRequest("trace source is not configured")
 	}
@@ -87,22 +110,140 @@ func (h *Handler) SubscribeToTransactions(session *session, request *http.Reques
 	if err != nil {
 		return errors.BadRequest(fmt.Sprintf("failed to parse query parameters: %v", err))
 	}
+	if err := checkAccountsLimit(request, len(options.Accounts)); err != nil {
+		return err
+	}
+	options.Accounts, options.AllAccounts, err = utils.ScopeAccounts(request.Context(), options.Accounts, options.AllAccounts)
+	if err != nil {
+		return errors.Forbidden(err.Error())
+	}
+	if err := parseCatchUp(request, options); err != nil {
+		return err
+	}
 	if !options.AllAccounts {
 		accountsPerRequestHistogramVec.WithLabelValues("transactions").Observe(float64(len(options.Accounts)))
 	}
-	cancelFn := h.txSource.SubscribeToTransactions(request.Context(), func(data []byte) {
-		event := Event{
-			Name:    events.AccountTxEvent,
-			EventID: h.nextID(),
-			Data:    data,
-		}
-		session.SendEvent(event)
-	}, *options)
+	cancelFn := h.txSource.SubscribeToTransactions(request.Context(), h.Deliver(session, events.AccountTxEvent), *options)
+	session.SetCancelFn(cancelFn)
+	return nil
+}
+
+// SubscribeToAccountStatuses streams transactions changing the status of the given accounts.
+func (h *Handler) SubscribeToAccountStatuses(session Session, request *http.Request) error {
+	if h.txSource == nil {
+		return errors.BadRequest("transaction source is not configured")
+	}
+	options, err := parseQueryStrings(request.URL.Query().Get("accounts"), "")
+	if err != nil {
+		return errors.BadRequest(fmt.Sprintf("failed to parse query parameters: %v", err))
+	}
+	if err := checkAccountsLimit(request, len(options.Accounts)); err != nil {
+		return err
+	}
+	options.Accounts, options.AllAccounts, err = utils.ScopeAccounts(request.Context(), options.Accounts, options.AllAccounts)
+	if err != nil {
+		return errors.Forbidden(err.Error())
+	}
+	if err := parseCatchUp(request, options); err != nil {
+		return err
+	}
+	if !options.AllAccounts {
+		accountsPerRequestHistogramVec.WithLabelValues("statuses").Observe(float64(len(options.Accounts)))
+	}
+	options.StatusChangesOnly = true
+	cancelFn := h.txSource.SubscribeToTransactions(request.Context(), h.Deliver(session, events.AccountStatusEvent), *options)
+	session.SetCancelFn(cancelFn)
+	return nil
+}
+
+// SubscribeToJettonStatuses streams transactions locking or unlocking the given jetton wallets.
+func (h *Handler) SubscribeToJettonStatuses(session Session, request *http.Request) error {
+	if h.txSource == nil {
+		return errors.BadRequest("transaction source is not configured")
+	}
+	options, err := parseQueryStrings(request.URL.Query().Get("accounts"), "")
+	if err != nil {
+		return errors.BadRequest(fmt.Sprintf("failed to parse query parameters: %v", err))
+	}
+	if err := checkAccountsLimit(request, len(options.Accounts)); err != nil {
+		return err
+	}
+	options.Accounts, options.AllAccounts, err = utils.ScopeAccounts(request.Context(), options.Accounts, options.AllAccounts)
+	if err != nil {
+		return errors.Forbidden(err.Error())
+	}
+	if err := parseCatchUp(request, options); err != nil {
+		return err
+	}
+	if !options.AllAccounts {
+		accountsPerRequestHistogramVec.WithLabelValues("jetton_statuses").Observe(float64(len(options.Accounts)))
+	}
+	options.JettonStatusChangesOnly = true
+	cancelFn := h.txSource.SubscribeToTransactions(request.Context(), h.Deliver(session, events.JettonStatusEvent), *options)
 	session.SetCancelFn(cancelFn)
 	return nil
 }
 
-func (h *Handler) SubscribeToMessages(session *session, request *http.Request) error {
+// SubscribeToJettonTransfers streams jetton transfers sent or received by the given accounts,
+// so a wallet doesn't have to look for them in every transaction.
+func (h *Handler) SubscribeToJettonTransfers(session Session, request *http.Request) error {
+	if h.txSource == nil {
+		return errors.BadRequest("transaction source is not configured")
+	}
+	options, err := parseQueryStrings(request.URL.Query().Get("accounts"), "")
+	if err != nil {
+		return errors.BadRequest(fmt.Sprintf("failed to parse query parameters: %v", err))
+	}
+	if err := checkAccountsLimit(request, len(options.Accounts)); err != nil {
+		return err
+	}
+	options.Accounts, options.AllAccounts, err = utils.ScopeAccounts(request.Context(), options.Accounts, options.AllAccounts)
+	if err != nil {
+		return errors.Forbidden(err.Error())
+	}
+	if err := parseCatchUp(request, options); err != nil {
+		return err
+	}
+	if !options.AllAccounts {
+		accountsPerRequestHistogramVec.WithLabelValues("jetton_transfers").Observe(float64(len(options.Accounts)))
+	}
+	options.JettonTransfersOnly = true
+	cancelFn := h.txSource.SubscribeToTransactions(request.Context(), h.Deliver(session, events.JettonTransferEvent), *options)
+	session.SetCancelFn(cancelFn)
+	return nil
+}
+
+// SubscribeToLogs streams external outbound messages emitted by the given accounts,
+// contracts often use such messages as event logs.
+func (h *Handler) SubscribeToLogs(session Session, request *http.Request) error {
+	if h.txSource == nil {
+		return errors.BadRequest("transaction source is not configured")
+	}
+	query := request.URL.Query()
+	options, err := parseQueryStrings(query.Get("accounts"), query.Get("operations"))
+	if err != nil {
+		return errors.BadRequest(fmt.Sprintf("failed to parse query parameters: %v", err))
+	}
+	if err := checkAccountsLimit(request, len(options.Accounts)); err != nil {
+		return err
+	}
+	options.Accounts, options.AllAccounts, err = utils.ScopeAccounts(request.Context(), options.Accounts, options.AllAccounts)
+	if err != nil {
+		return errors.Forbidden(err.Error())
+	}
+	if err := parseCatchUp(request, options); err != nil {
+		return err
+	}
+	if !options.AllAccounts {
+		accountsPerRequestHistogramVec.WithLabelValues("logs").Observe(float64(len(options.Accounts)))
+	}
+	options.ExtOutMessagesOnly = true
+	cancelFn := h.txSource.SubscribeToTransactions(request.Context(), h.Deliver(session, events.AccountLogEvent), *options)
+	session.SetCancelFn(cancelFn)
+	return nil
+}
+
+func (h *Handler) SubscribeToMessages(session Session, request *http.Request) error {
 	if h.memPool == nil {
 		return errors.BadRequest("mempool source is not configured")
 	}
@@ -119,14 +260,14 @@ func (h *Handler) SubscribeToMessages(session *session, request *http.Request) e
 			accounts = append(accounts, accountID.ID)
 		}
 	}
-	cancelFn, err := h.memPool.SubscribeToMessages(request.Context(), func(data []byte) {
-		event := Event{
-			Name:    events.MempoolEvent,
-			EventID: h.nextID(),
-			Data:    data,
-		}
-		session.SendEvent(event)
-	}, sources.SubscribeToMempoolOptions{Accounts: accounts})
+	if err := checkAccountsLimit(request, len(accounts)); err != nil {
+		return err
+	}
+	accounts, _, err := utils.ScopeAccounts(request.Context(), accounts, len(accounts) == 0)
+	if err != nil {
+		return errors.Forbidden(err.Error())
+	}
+	cancelFn, err := h.memPool.SubscribeToMessages(request.Context(), h.Deliver(session, events.MempoolEvent), sources.SubscribeToMempoolOptions{Accounts: accounts})
 	if err != nil {
 		return err
 	}
@@ -134,6 +275,44 @@ func (h *Handler) SubscribeToMessages(session *session, request *http.Request) e
 	return nil
 }
 
+// parseCatchUp parses "from_lt" and "from_seqno" query parameters asking to replay indexed transactions
+// before live ones, see sources.SubscribeToTransactionsOptions.FromLt.
+func parseCatchUp(request *http.Request, options *sources.SubscribeToTransactionsOptions) error {
+	query := request.URL.Query()
+	fromLt, fromSeqno := query.Get("from_lt"), query.Get("from_seqno")
+	if len(fromLt) == 0 && len(fromSeqno) == 0 {
+		return nil
+	}
+	if len(fromLt) > 0 && len(fromSeqno) > 0 {
+		return errors.BadRequest("'from_lt' and 'from_seqno' parameters are mutually exclusive")
+	}
+	if options.AllAccounts {
+		return errors.BadRequest("catching up is only supported for a list of accounts")
+	}
+	if len(fromLt) > 0 {
+		value, err := strconv.ParseUint(fromLt, 10, 64)
+		if err != nil || value == 0 {
+			return errors.BadRequest("failed to parse 'from_lt' parameter in query")
+		}
+		options.FromLt = value
+		return nil
+	}
+	value, err := strconv.ParseUint(fromSeqno, 10, 32)
+	if err != nil || value == 0 {
+		return errors.BadRequest("failed to parse 'from_seqno' parameter in query")
+	}
+	options.FromSeqno = uint32(value)
+	return nil
+}
+
+// checkAccountsLimit rejects subscriptions listing more accounts than allowed by utils.Limits of the request.
+func checkAccountsLimit(request *http.Request, accounts int) error {
+	if err := utils.LimitsFromContext(request.Context()).CheckAccounts(accounts); err != nil {
+		return errors.SubscriptionLimitExceeded(err.Error())
+	}
+	return nil
+}
+
 func parseAccountsToTraceOptions(str string) (*sources.SubscribeToTraceOptions, error) {
 	if strings.ToUpper(str) == "ALL" {
 		return &sources.SubscribeToTraceOptions{AllAccounts: true}, nil
@@ -150,7 +329,7 @@ func parseAccountsToTraceOptions(str string) (*sources.SubscribeToTraceOptions,
 	return &sources.SubscribeToTraceOptions{Accounts: accounts}, nil
 }
 
-func (h *Handler) SubscribeToTraces(session *session, request *http.Request) error {
+func (h *Handler) SubscribeToTraces(session Session, request *http.Request) error {
 	if h.traceSource == nil {
 		return errors.BadRequest("trace source is not configured")
 	}
@@ -159,22 +338,26 @@ func (h *Handler) SubscribeToTraces(session *session, request *http.Request) err
 	if err != nil {
 		return errors.BadRequest("failed to parse 'accounts' parameter in query")
 	}
-	cancelFn := h.traceSource.SubscribeToTraces(request.Context(), func(data []byte) {
-		event := Event{
-			Name:    events.TraceEvent,
-			EventID: h.nextID(),
-			Data:    data,
-		}
-		session.SendEvent(event)
-	}, *options)
+	if err := checkAccountsLimit(request, len(options.Accounts)); err != nil {
+		return err
+	}
+	options.Accounts, options.AllAccounts, err = utils.ScopeAccounts(request.Context(), options.Accounts, options.AllAccounts)
+	if err != nil {
+		return errors.Forbidden(err.Error())
+	}
+	cancelFn := h.traceSource.SubscribeToTraces(request.Context(), h.Deliver(session, events.TraceEvent), *options)
 	session.SetCancelFn(cancelFn)
 	return nil
 }
 
-func (h *Handler) SubscribeToBlockHeaders(session *session, request *http.Request) error {
+func (h *Handler) SubscribeToBlockHeaders(session Session, request *http.Request) error {
 	if h.blockHeadersSource == nil {
 		return errors.BadRequest("block headers source is not configured")
 	}
+	// the local index keeps transactions only, so there is nothing to replay blocks from.
+	if query := request.URL.Query(); query.Has("from_lt") || query.Has("from_seqno") {
+		return errors.BadRequest("catching up is supported by transaction streams only")
+	}
 	workchain := request.URL.Query().Get("workchain")
 	opts := sources.SubscribeToBlockHeadersOptions{}
 	if len(workchain) > 0 {
@@ -187,19 +370,53 @@ func (h *Handler) SubscribeToBlockHeaders(session *session, request *http.Reques
 		}
 		opts.Workchain = &value
 	}
-	cancelFn := h.blockHeadersSource.SubscribeToBlockHeaders(request.Context(), func(data []byte) {
-		event := Event{
-			Name:    events.BlockEvent,
-			EventID: h.nextID(),
-			Data:    data,
-		}
-		session.SendEvent(event)
-	}, opts)
+	cancelFn := h.blockHeadersSource.SubscribeToBlockHeaders(request.Context(), h.Deliver(session, events.BlockEvent), opts)
 	session.SetCancelFn(cancelFn)
 	return nil
 }
 
-func (h *Handler) SubscribeToBlocks(session *session, request *http.Request) error {
+func (h *Handler) SubscribeToConfigChanges(session Session, request *http.Request) error {
+	if h.configSource == nil {
+		return errors.BadRequest("config source is not configured")
+	}
+	opts, err := configChangesOptions(request)
+	if err != nil {
+		return err
+	}
+	cancelFn := h.configSource.SubscribeToConfigChanges(request.Context(), h.Deliver(session, events.ConfigEvent), opts)
+	session.SetCancelFn(cancelFn)
+	return nil
+}
+
+func (h *Handler) SubscribeToConfigProposals(session Session, request *http.Request) error {
+	if h.configSource == nil {
+		return errors.BadRequest("config source is not configured")
+	}
+	opts, err := configChangesOptions(request)
+	if err != nil {
+		return err
+	}
+	cancelFn := h.configSource.SubscribeToConfigProposals(request.Context(), h.Deliver(session, events.ConfigProposalEvent), opts)
+	session.SetCancelFn(cancelFn)
+	return nil
+}
+
+// configChangesOptions parses a comma-separated list of config params to subscribe to.
+func configChangesOptions(request *http.Request) (sources.SubscribeToConfigChangesOptions, error) {
+	opts := sources.SubscribeToConfigChangesOptions{}
+	if params := request.URL.Query().Get("params"); len(params) > 0 {
+		for _, param := range strings.Split(params, ",") {
+			value, err := strconv.ParseInt(param, 10, 32)
+			if err != nil {
+				return opts, errors.BadRequest("failed to parse 'params' parameter in query")
+			}
+			opts.Params = append(opts.Params, int32(value))
+		}
+	}
+	return opts, nil
+}
+
+func (h *Handler) SubscribeToBlocks(session Session, request *http.Request) error {
 	if h.blockSource == nil {
 		return errors.BadRequest("block source is not configured")
 	}
@@ -214,14 +431,44 @@ func (h *Handler) SubscribeToBlocks(session *session, request *http.Request) err
 		}
 		opts.MasterchainSeqno = uint32(value)
 	}
-	cancelFn, err := h.blockSource.SubscribeToBlocks(request.Context(), func(data []byte) {
-		event := Event{
-			Name:    events.BlockchainEvent,
-			EventID: h.nextID(),
-			Data:    data,
+	query := request.URL.Query()
+	if workchain := query.Get("workchain"); len(workchain) > 0 {
+		value, err := strconv.Atoi(workchain)
+		if err != nil {
+			return errors.BadRequest("failed to parse 'workchain' parameter in query")
+		}
+		if value != -1 && value != 0 {
+			return errors.BadRequest("invalid 'workchain' parameter in query")
+		}
+		workchain := int32(value)
+		opts.Workchain = &workchain
+	}
+	if shard := query.Get("shard"); len(shard) > 0 {
+		value, err := strconv.ParseUint(shard, 16, 64)
+		if err != nil {
+			return errors.BadRequest("failed to parse 'shard' parameter in query")
+		}
+		shardID, err := ton.ParseShardID(int64(value))
+		if err != nil {
+			return errors.BadRequest("invalid 'shard' parameter in query")
 		}
-		session.SendEvent(event)
-	}, opts)
+		opts.Shard = &shardID
+	}
+	if onlyMasterchain := query.Get("only_masterchain"); len(onlyMasterchain) > 0 {
+		value, err := strconv.ParseBool(onlyMasterchain)
+		if err != nil {
+			return errors.BadRequest("failed to parse 'only_masterchain' parameter in query")
+		}
+		opts.OnlyMasterchain = value
+	}
+	switch mode := sources.BlockTransactionsMode(query.Get("transactions")); mode {
+	case "", sources.BlockTransactionsIDs, sources.BlockTransactionsFull:
+		opts.Transactions = mode
+	default:
+		return errors.BadRequest("invalid 'transactions' parameter in query, expected 'ids' or 'full'")
+	}
+	deliveryFn := sources.FilterBlocks(h.Deliver(session, events.BlockchainEvent), opts)
+	cancelFn, err := h.blockSource.SubscribeToBlocks(request.Context(), deliveryFn, opts)
 	if err != nil {
 		return err
 	}
@@ -229,6 +476,19 @@ func (h *Handler) SubscribeToBlocks(session *session, request *http.Request) err
 	return nil
 }
 
+// Deliver returns a DeliveryFn that wraps event data into an Event with the given name and
+// sends it to the session.
+// Event IDs are unique across all sessions served by this handler.
+func (h *Handler) Deliver(session Session, name events.Name) sources.DeliveryFn {
+	return func(data []byte) {
+		session.SendEvent(Event{
+			Name:    name,
+			EventID: h.nextID(),
+			Data:    data,
+		})
+	}
+}
+
 func (h *Handler) nextID() int64 {
 	return atomic.AddInt64(&h.currentEventID, 1)
 }
@@ -8,7 +8,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tonkeeper/opentonapi/internal/g"
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/errors"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/events"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/ton"
 )
@@ -81,6 +85,43 @@ func TestHandler_SubscribeToTransactions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "catch up from lt",
+			url:  "/transactions?accounts=0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e&from_lt=47000000000001",
+			wantOptions: sources.SubscribeToTransactionsOptions{
+				Accounts: []tongo.AccountID{
+					tongo.MustParseAddress("0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e").ID,
+				},
+				AllOperations: true,
+				FromLt:        47000000000001,
+			},
+		},
+		{
+			name: "catch up from seqno",
+			url:  "/transactions?accounts=0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e&from_seqno=38000000",
+			wantOptions: sources.SubscribeToTransactionsOptions{
+				Accounts: []tongo.AccountID{
+					tongo.MustParseAddress("0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e").ID,
+				},
+				AllOperations: true,
+				FromSeqno:     38000000,
+			},
+		},
+		{
+			name:    "catch up for all accounts",
+			url:     "/transactions?accounts=all&from_lt=47000000000001",
+			wantErr: true,
+		},
+		{
+			name:    "both lt and seqno",
+			url:     "/transactions?accounts=0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e&from_lt=1&from_seqno=1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid seqno",
+			url:     "/transactions?accounts=0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e&from_seqno=-1",
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -90,12 +131,98 @@ func TestHandler_SubscribeToTransactions(t *testing.T) {
 			}
 			request := httptest.NewRequest(http.MethodGet, tt.url, nil)
 			err := h.SubscribeToTransactions(&session{}, request)
+			if tt.wantErr {
+				var httpErr errors.HTTPError
+				require.ErrorAs(t, err, &httpErr)
+				require.Equal(t, http.StatusBadRequest, httpErr.Code)
+				return
+			}
 			require.Nil(t, err)
 			require.Equal(t, tt.wantOptions, source.options)
 		})
 	}
 }
 
+func TestHandler_SubscribeToTransactions_limits(t *testing.T) {
+	accounts := "0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e,0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351"
+	tests := []struct {
+		name    string
+		url     string
+		limits  utils.Limits
+		wantErr bool
+	}{
+		{
+			name:   "within the limit",
+			url:    "/transactions?accounts=" + accounts,
+			limits: utils.Limits{MaxAccountsPerSubscription: 2},
+		},
+		{
+			name:    "too many accounts",
+			url:     "/transactions?accounts=" + accounts,
+			limits:  utils.Limits{MaxAccountsPerSubscription: 1},
+			wantErr: true,
+		},
+		{
+			name:   "all accounts are not counted",
+			url:    "/transactions?accounts=all",
+			limits: utils.Limits{MaxAccountsPerSubscription: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				txSource: &mockTxSource{},
+			}
+			request := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			request = request.WithContext(context.WithValue(request.Context(), utils.LimitsKey, tt.limits))
+			err := h.SubscribeToTransactions(&session{}, request)
+			if !tt.wantErr {
+				require.Nil(t, err)
+				return
+			}
+			var httpErr errors.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			require.Equal(t, errcode.SubscriptionLimitExceeded, httpErr.ErrorCode)
+		})
+	}
+}
+
+func TestHandler_SubscribeToAccountStatuses(t *testing.T) {
+	source := &mockTxSource{}
+	h := &Handler{
+		txSource: source,
+	}
+	request := httptest.NewRequest(http.MethodGet, "/status?accounts=0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e", nil)
+	err := h.SubscribeToAccountStatuses(&session{}, request)
+	require.Nil(t, err)
+	want := sources.SubscribeToTransactionsOptions{
+		Accounts: []tongo.AccountID{
+			tongo.MustParseAddress("0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e").ID,
+		},
+		AllOperations:     true,
+		StatusChangesOnly: true,
+	}
+	require.Equal(t, want, source.options)
+}
+
+func TestHandler_SubscribeToJettonStatuses(t *testing.T) {
+	source := &mockTxSource{}
+	h := &Handler{
+		txSource: source,
+	}
+	request := httptest.NewRequest(http.MethodGet, "/status?accounts=0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e", nil)
+	err := h.SubscribeToJettonStatuses(&session{}, request)
+	require.Nil(t, err)
+	want := sources.SubscribeToTransactionsOptions{
+		Accounts: []tongo.AccountID{
+			tongo.MustParseAddress("0:779dcc815138d9500e449c5291e7f12738c23d575b5310000f6a253bd607384e").ID,
+		},
+		AllOperations:           true,
+		JettonStatusChangesOnly: true,
+	}
+	require.Equal(t, want, source.options)
+}
+
 func TestHandler_SubscribeToMessages(t *testing.T) {
 	var testAccount1 = ton.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
 	var testAccount2 = ton.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580352")
@@ -173,6 +300,11 @@ func TestHandler_SubscribeToBlockHeaders(t *testing.T) {
 			url:         "/blocks",
 			wantOptions: sources.SubscribeToBlockHeadersOptions{},
 		},
+		{
+			name:    "catching up",
+			url:     "/blocks?from_seqno=38000000",
+			wantErr: `catching up is supported by transaction streams only`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -224,6 +356,33 @@ func TestHandler_SubscribeToBlocks(t *testing.T) {
 			url:     "/blockchain/full?masterchain_seqno=xxx",
 			wantErr: `failed to parse 'masterchain_seqno' parameter in query`,
 		},
+		{
+			name: "filters and transactions",
+			url:  "/blockchain/full?workchain=0&shard=c000000000000000&transactions=full",
+			wantOptions: sources.SubscribeToBlocksOptions{
+				Workchain:    g.Pointer(int32(0)),
+				Shard:        g.Pointer(ton.MustParseShardID(-0x4000000000000000)),
+				Transactions: sources.BlockTransactionsFull,
+			},
+		},
+		{
+			name: "only masterchain",
+			url:  "/blockchain/full?only_masterchain=true&transactions=ids",
+			wantOptions: sources.SubscribeToBlocksOptions{
+				OnlyMasterchain: true,
+				Transactions:    sources.BlockTransactionsIDs,
+			},
+		},
+		{
+			name:    "error - bad shard",
+			url:     "/blockchain/full?shard=0",
+			wantErr: `invalid 'shard' parameter in query`,
+		},
+		{
+			name:    "error - bad transactions",
+			url:     "/blockchain/full?transactions=raw",
+			wantErr: `invalid 'transactions' parameter in query, expected 'ids' or 'full'`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -243,3 +402,14 @@ func TestHandler_SubscribeToBlocks(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_Deliver(t *testing.T) {
+	h := &Handler{currentEventID: 100}
+	s := &session{eventCh: make(chan Event, 10)}
+	deliveryFn := h.Deliver(s, events.Name("custom"))
+	deliveryFn([]byte("first"))
+	deliveryFn([]byte("second"))
+
+	require.Equal(t, Event{Name: "custom", EventID: 101, Data: []byte("first")}, <-s.eventCh)
+	require.Equal(t, Event{Name: "custom", EventID: 102, Data: []byte("second")}, <-s.eventCh)
+}
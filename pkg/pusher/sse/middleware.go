@@ -3,6 +3,7 @@ package sse
 import (
 	"net/http"
 
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/errors"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/metrics"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
@@ -12,15 +13,15 @@ import (
 func writeError(writer http.ResponseWriter, err error) {
 	if errors.IsHTTPError(err) {
 		httpErr := err.(errors.HTTPError)
-		writer.WriteHeader(httpErr.Code)
-		writer.Write([]byte(httpErr.Message))
+		errcode.Write(writer, httpErr.Code, httpErr.ErrorCode, httpErr.Message)
 		return
 	}
-	writer.WriteHeader(http.StatusInternalServerError)
-	writer.Write([]byte(err.Error()))
+	errcode.Write(writer, http.StatusInternalServerError, errcode.Internal, err.Error())
 }
 
-func Stream(logger *zap.Logger, handler handlerFunc) func(http.ResponseWriter, *http.Request, int, bool) error {
+// Stream converts the given HandlerFunc to an async handler that can be registered with api.Server.
+// It takes care of SSE headers, heartbeats, queueing and connection metrics.
+func Stream(logger *zap.Logger, handler HandlerFunc) func(http.ResponseWriter, *http.Request, int, bool) error {
 	return func(writer http.ResponseWriter, request *http.Request, connectionType int, allowTokenInQuery bool) error {
 		_, ok := writer.(http.Flusher)
 		if !ok {
@@ -37,12 +38,42 @@ func Stream(logger *zap.Logger, handler handlerFunc) func(http.ResponseWriter, *
 		metrics.OpenSseConnection(utils.TokenNameFromContext(request.Context()))
 		defer metrics.CloseSseConnection(utils.TokenNameFromContext(request.Context()))
 
-		// TODO: last-event-id
-		session := newSession(logger)
-		if err := handler(session, request); err != nil {
+		coalesceWindow, err := parseCoalesceWindow(request.URL.Query().Get("coalesce"))
+		if err != nil {
+			err = errors.BadRequest(err.Error())
 			writeError(writer, err)
 			return err
 		}
+
+		resumer := ResumerFromContext(request.Context())
+		var session *session
+		// a client resuming a stream which can't be resumed has lost events following its Last-Event-ID.
+		var gap bool
+		if resumer != nil {
+			if nonce, lastID, ok := lastEventID(request); ok {
+				session, _ = resumer.resume(streamKey(request, nonce), lastID)
+			}
+			gap = session == nil && request.Header.Get("Last-Event-ID") != ""
+		}
+		if session == nil {
+			session = newSession(logger)
+			session.coalesceWindow = coalesceWindow
+			session.gap = gap
+			if resumer != nil {
+				session.history = newRing(resumer.bufferSize)
+				session.nonce = newNonce()
+			}
+			if err := handler(session, request); err != nil {
+				writeError(writer, err)
+				return err
+			}
+		}
+		if resumer != nil {
+			token, key := utils.TokenNameFromContext(request.Context()), streamKey(request, session.nonce)
+			session.release = func() {
+				resumer.detach(token, key, session)
+			}
+		}
 		if err := session.StreamEvents(request.Context(), writer); err != nil {
 			writeError(writer, err)
 			return err
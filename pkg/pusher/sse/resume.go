@@ -0,0 +1,238 @@
+package sse
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
+)
+
+// ResumerKey is a context key of a Resumer.
+const ResumerKey = "sse-resumer-key"
+
+// ResumerFromContext returns a resumer from a request context or nil if streams are not resumable.
+// Can be added by a middleware.
+func ResumerFromContext(ctx context.Context) *Resumer {
+	resumer, _ := ctx.Value(ResumerKey).(*Resumer)
+	return resumer
+}
+
+// Resumer keeps subscriptions of disconnected clients alive for a while
+// along with a ring buffer of their latest events,
+// so a client reconnecting with the Last-Event-ID header gets events it has missed
+// instead of silently losing them.
+// A stream is identified by the token name, the URL of its request and a random nonce
+// prefixing IDs of its events, so a client must reconnect to the same URL as EventSource does
+// and other clients subscribed to the same URL can't take the stream over.
+// If some of the missed events are no longer kept, the client gets a gap event first.
+// Numbers of detached sessions are capped per token and in total, the oldest ones are canceled first.
+type Resumer struct {
+	bufferSize int
+	retention  time.Duration
+	// maxDetached and maxDetachedPerToken limit numbers of kept detached sessions.
+	maxDetached         int
+	maxDetachedPerToken int
+
+	mu       sync.Mutex
+	detached map[string]*detachedSession
+	// seq orders detached sessions by the moment they were detached.
+	seq uint64
+}
+
+type detachedSession struct {
+	session *session
+	timer   *time.Timer
+	token   string
+	seq     uint64
+}
+
+const (
+	// defaultMaxDetached and defaultMaxDetachedPerToken limit subscriptions kept alive for disconnected clients,
+	// so clients that never come back can't exhaust the instance.
+	defaultMaxDetached         = 10_000
+	defaultMaxDetachedPerToken = 100
+)
+
+// NewResumer returns a resumer keeping up to bufferSize latest events of a stream
+// and a subscription of a disconnected client for the retention period.
+func NewResumer(bufferSize int, retention time.Duration) *Resumer {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	return &Resumer{
+		bufferSize:          bufferSize,
+		retention:           retention,
+		maxDetached:         defaultMaxDetached,
+		maxDetachedPerToken: defaultMaxDetachedPerToken,
+		detached:            map[string]*detachedSession{},
+	}
+}
+
+func streamKey(request *http.Request, nonce string) string {
+	return utils.TokenNameFromContext(request.Context()) + " " + nonce + " " + request.URL.Path + "?" + request.URL.RawQuery
+}
+
+// newNonce returns a random prefix of event IDs of a resumable stream.
+func newNonce() string {
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+	return hex.EncodeToString(nonce)
+}
+
+// formatEventID returns an ID of an event as it is sent to a client.
+func formatEventID(nonce string, eventID int64) string {
+	if nonce == "" {
+		return strconv.FormatInt(eventID, 10)
+	}
+	return nonce + ":" + strconv.FormatInt(eventID, 10)
+}
+
+// lastEventID returns the nonce of a stream and an ID of the last event a reconnecting client has got.
+func lastEventID(request *http.Request) (string, int64, bool) {
+	nonce, id, found := strings.Cut(request.Header.Get("Last-Event-ID"), ":")
+	if !found || nonce == "" {
+		return "", 0, false
+	}
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return nonce, eventID, true
+}
+
+// resume returns a detached session of the stream with the given key
+// prepared to replay events following the last one the client has got.
+// The session reports a gap if some of these events are no longer kept.
+func (r *Resumer) resume(key string, lastEventID int64) (*session, bool) {
+	r.mu.Lock()
+	detached, ok := r.detached[key]
+	if !ok || !detached.timer.Stop() {
+		r.mu.Unlock()
+		return nil, false
+	}
+	delete(r.detached, key)
+	r.mu.Unlock()
+
+	s := detached.session
+	s.replay = s.history.since(lastEventID)
+	s.skipUpTo = lastEventID
+	s.gap = s.history.lost(lastEventID)
+	if len(s.replay) > 0 {
+		s.skipUpTo = s.replay[len(s.replay)-1].EventID
+	}
+	return s, true
+}
+
+// detach keeps the session subscribed for the retention period, so the client can resume the stream.
+// A session detached earlier with the same key is canceled.
+func (r *Resumer) detach(token, key string, s *session) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if previous, ok := r.detached[key]; ok {
+		r.cancel(key, previous)
+	}
+	r.evict(token)
+	r.seq++
+	detached := &detachedSession{session: s, token: token, seq: r.seq}
+	detached.timer = time.AfterFunc(r.retention, func() {
+		r.mu.Lock()
+		if r.detached[key] == detached {
+			delete(r.detached, key)
+		}
+		r.mu.Unlock()
+		s.cancel()
+	})
+	r.detached[key] = detached
+}
+
+// evict cancels the oldest detached sessions until there is room for one more session of the token.
+func (r *Resumer) evict(token string) {
+	for {
+		perToken := 0
+		var oldestKey, oldestOfTokenKey string
+		var oldest, oldestOfToken *detachedSession
+		for key, d := range r.detached {
+			if oldest == nil || d.seq < oldest.seq {
+				oldestKey, oldest = key, d
+			}
+			if d.token != token {
+				continue
+			}
+			perToken++
+			if oldestOfToken == nil || d.seq < oldestOfToken.seq {
+				oldestOfTokenKey, oldestOfToken = key, d
+			}
+		}
+		switch {
+		case perToken >= r.maxDetachedPerToken:
+			r.cancel(oldestOfTokenKey, oldestOfToken)
+		case len(r.detached) >= r.maxDetached:
+			r.cancel(oldestKey, oldest)
+		default:
+			return
+		}
+	}
+}
+
+// cancel removes the detached session and cancels its subscription unless it has expired already.
+func (r *Resumer) cancel(key string, d *detachedSession) {
+	delete(r.detached, key)
+	if d.timer.Stop() {
+		d.session.cancel()
+	}
+}
+
+// ring keeps the latest events of a session.
+type ring struct {
+	mu     sync.Mutex
+	events []Event
+	// next is an index in events to overwrite once the ring is full.
+	next int
+	size int
+	// overwritten is an ID of the latest event pushed out of the ring.
+	overwritten int64
+}
+
+func newRing(size int) *ring {
+	return &ring{size: size}
+}
+
+func (r *ring) add(event Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.events) < r.size {
+		r.events = append(r.events, event)
+		r.next = len(r.events) % r.size
+		return
+	}
+	r.overwritten = r.events[r.next].EventID
+	r.events[r.next] = event
+	r.next = (r.next + 1) % r.size
+}
+
+// since returns kept events following the event with the given ID from the oldest to the newest one.
+func (r *ring) since(eventID int64) []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var events []Event
+	for i := 0; i < len(r.events); i++ {
+		event := r.events[(r.next+i)%len(r.events)]
+		if event.EventID > eventID {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// lost reports whether some events following the event with the given ID have been pushed out of the ring.
+func (r *ring) lost(eventID int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.overwritten > eventID
+}
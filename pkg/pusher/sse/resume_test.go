@@ -0,0 +1,158 @@
+package sse
+
+import (
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ring_since(t *testing.T) {
+	tests := []struct {
+		name        string
+		size        int
+		eventCount  int
+		lastEventID int64
+		wantIDs     []int64
+		wantLost    bool
+	}{
+		{
+			name:        "not full",
+			size:        5,
+			eventCount:  3,
+			lastEventID: 1,
+			wantIDs:     []int64{2, 3},
+		},
+		{
+			name:        "overwritten",
+			size:        3,
+			eventCount:  7,
+			lastEventID: 2,
+			wantIDs:     []int64{5, 6, 7},
+			wantLost:    true,
+		},
+		{
+			name:        "overwritten up to the last event",
+			size:        3,
+			eventCount:  7,
+			lastEventID: 4,
+			wantIDs:     []int64{5, 6, 7},
+		},
+		{
+			name:        "up to date",
+			size:        3,
+			eventCount:  7,
+			lastEventID: 7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRing(tt.size)
+			for i := 1; i <= tt.eventCount; i++ {
+				r.add(Event{EventID: int64(i)})
+			}
+			var ids []int64
+			for _, event := range r.since(tt.lastEventID) {
+				ids = append(ids, event.EventID)
+			}
+			require.Equal(t, tt.wantIDs, ids)
+			require.Equal(t, tt.wantLost, r.lost(tt.lastEventID))
+		})
+	}
+}
+
+func TestResumer(t *testing.T) {
+	resumer := NewResumer(2, time.Hour)
+	s := newSession(nil)
+	s.history = newRing(resumer.bufferSize)
+	s.cancel = func() {}
+	for i := 1; i <= 3; i++ {
+		s.SendEvent(Event{EventID: int64(i)})
+	}
+	resumer.detach("token", "key", s)
+
+	_, ok := resumer.resume("another-key", 1)
+	require.False(t, ok)
+
+	resumed, ok := resumer.resume("key", 1)
+	require.True(t, ok)
+	require.Equal(t, s, resumed)
+	require.Equal(t, []Event{{EventID: 2}, {EventID: 3}}, resumed.replay)
+	require.Equal(t, int64(3), resumed.skipUpTo)
+
+	_, ok = resumer.resume("key", 1)
+	require.False(t, ok)
+}
+
+func TestResumer_expired(t *testing.T) {
+	resumer := NewResumer(10, 10*time.Millisecond)
+	canceled := atomic.Bool{}
+	s := newSession(nil)
+	s.history = newRing(resumer.bufferSize)
+	s.cancel = func() {
+		canceled.Store(true)
+	}
+	resumer.detach("token", "key", s)
+	require.Eventually(t, canceled.Load, time.Second, 5*time.Millisecond)
+
+	_, ok := resumer.resume("key", 0)
+	require.False(t, ok)
+}
+
+func TestResumer_limits(t *testing.T) {
+	resumer := NewResumer(10, time.Hour)
+	resumer.maxDetached = 3
+	resumer.maxDetachedPerToken = 2
+	canceled := map[string]bool{}
+	detach := func(token, key string) {
+		s := newSession(nil)
+		s.history = newRing(resumer.bufferSize)
+		s.cancel = func() {
+			canceled[key] = true
+		}
+		resumer.detach(token, key, s)
+	}
+	detach("a", "a-1")
+	detach("a", "a-2")
+	detach("a", "a-3")
+	// the oldest session of the token is canceled.
+	require.Equal(t, map[string]bool{"a-1": true}, canceled)
+
+	detach("b", "b-1")
+	detach("b", "b-2")
+	// the oldest session of the instance is canceled.
+	require.Equal(t, map[string]bool{"a-1": true, "a-2": true}, canceled)
+	for _, key := range []string{"a-3", "b-1", "b-2"} {
+		_, ok := resumer.resume(key, 0)
+		require.True(t, ok, key)
+	}
+}
+
+func Test_lastEventID(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    string
+		wantNonce string
+		wantID    int64
+		wantOk    bool
+	}{
+		{name: "missing"},
+		{name: "without nonce", header: "15"},
+		{name: "malformed", header: "abc:def"},
+		{name: "ok", header: "abc:15", wantNonce: "abc", wantID: 15, wantOk: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "/v2/sse/accounts/transactions", nil)
+			if tt.header != "" {
+				request.Header.Set("Last-Event-ID", tt.header)
+			}
+			nonce, id, ok := lastEventID(request)
+			require.Equal(t, tt.wantNonce, nonce)
+			require.Equal(t, tt.wantID, id)
+			require.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package sse
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
@@ -21,11 +25,33 @@ type session struct {
 	eventCh      chan Event
 	cancel       sources.CancelFn
 	pingInterval time.Duration
+	// coalesceWindow, if positive, batches events arriving within the window into a single frame.
+	coalesceWindow time.Duration
+	// history, if set, keeps the latest events to replay them to a client resuming the stream.
+	history *ring
+	// replay holds events to be sent before queued ones once a client resumes the stream.
+	// Queued events with IDs up to skipUpTo have been replayed already.
+	replay   []Event
+	skipUpTo int64
+	// nonce prefixes IDs of events of a resumable stream, so only its client can resume it.
+	nonce string
+	// gap tells the client that some events following its Last-Event-ID are lost.
+	gap bool
+	// release, if set, is called instead of cancel once the client disconnects.
+	release func()
 
 	droppedEvents int
 	totalEvents   int
 }
 
+const (
+	// maxCoalesceWindow caps the coalesce window requested by a client,
+	// so a subscription doesn't turn into a polling with a huge delay.
+	maxCoalesceWindow = 5 * time.Second
+	// maxCoalescedEvents limits the size of a single frame.
+	maxCoalescedEvents = 1000
+)
+
 func newSession(logger *zap.Logger) *session {
 	return &session{
 		logger:       logger,
@@ -36,6 +62,9 @@ func newSession(logger *zap.Logger) *session {
 
 func (s *session) SendEvent(event Event) {
 	metrics.SseQueueLength(event.Name, len(s.eventCh))
+	if s.history != nil {
+		s.history.add(event)
+	}
 	select {
 	case s.eventCh <- event:
 	default:
@@ -51,7 +80,7 @@ func (s *session) SetCancelFn(cancel sources.CancelFn) {
 }
 
 func (s *session) StreamEvents(ctx context.Context, writer http.ResponseWriter) error {
-	defer s.cancel()
+	defer s.close()
 
 	flusher := writer.(http.Flusher)
 	// sending this first event to quickly respond to the client with a 200 OK
@@ -60,16 +89,47 @@ func (s *session) StreamEvents(ctx context.Context, writer http.ResponseWriter)
 		return err
 	}
 	flusher.Flush()
+	if s.gap {
+		s.gap = false
+		metrics.SseEventSent(events.GapEvent, utils.TokenNameFromContext(ctx))
+		if _, err := fmt.Fprintf(writer, "event: %v\ndata: {}\n\n", events.GapEvent); err != nil {
+			return err
+		}
+		flusher.Flush()
+	}
+	if len(s.replay) > 0 {
+		if err := s.writeReplay(ctx, writer); err != nil {
+			return err
+		}
+		flusher.Flush()
+	}
+	draining := utils.DrainingFromContext(ctx)
 	for {
 		var err error
 		select {
 		case <-ctx.Done():
 			return nil
+		case <-draining:
+			// the notice is sent once, the stream goes on until the client reconnects elsewhere.
+			draining = nil
+			err = writeShutdownNotice(writer, utils.ShutdownNoticeFromContext(ctx))
+			metrics.SseEventSent(events.ShutdownEvent, utils.TokenNameFromContext(ctx))
 		case msg, open := <-s.eventCh:
 			if !open {
 				return nil
 			}
-			_, err = fmt.Fprintf(writer, "event: message\nid: %v\ndata: %v\n\n", msg.EventID, string(msg.Data))
+			if msg.EventID <= s.skipUpTo {
+				continue
+			}
+			if s.coalesceWindow > 0 {
+				batch := s.collectBatch(ctx, msg)
+				err = writeBatch(writer, s.nonce, batch)
+				for _, msg := range batch {
+					metrics.SseEventSent(msg.Name, utils.TokenNameFromContext(ctx))
+				}
+				break
+			}
+			_, err = fmt.Fprintf(writer, "event: message\nid: %v\ndata: %v\n\n", formatEventID(s.nonce, msg.EventID), string(msg.Data))
 			metrics.SseEventSent(msg.Name, utils.TokenNameFromContext(ctx))
 		case <-time.After(s.pingInterval):
 			metrics.SseEventSent(events.PingEvent, utils.TokenNameFromContext(ctx))
@@ -82,3 +142,102 @@ func (s *session) StreamEvents(ctx context.Context, writer http.ResponseWriter)
 		flusher.Flush()
 	}
 }
+
+func (s *session) close() {
+	if s.release != nil {
+		s.release()
+		return
+	}
+	s.cancel()
+}
+
+// writeReplay sends events missed by a client resuming the stream.
+func (s *session) writeReplay(ctx context.Context, writer io.Writer) error {
+	replay := s.replay
+	s.replay = nil
+	if s.coalesceWindow > 0 {
+		for len(replay) > 0 {
+			n := min(len(replay), maxCoalescedEvents)
+			if err := writeBatch(writer, s.nonce, replay[:n]); err != nil {
+				return err
+			}
+			for _, msg := range replay[:n] {
+				metrics.SseEventSent(msg.Name, utils.TokenNameFromContext(ctx))
+			}
+			replay = replay[n:]
+		}
+		return nil
+	}
+	for _, msg := range replay {
+		if _, err := fmt.Fprintf(writer, "event: message\nid: %v\ndata: %v\n\n", formatEventID(s.nonce, msg.EventID), string(msg.Data)); err != nil {
+			return err
+		}
+		metrics.SseEventSent(msg.Name, utils.TokenNameFromContext(ctx))
+	}
+	return nil
+}
+
+// collectBatch waits for events following the first one until the coalesce window closes or the batch is full.
+func (s *session) collectBatch(ctx context.Context, first Event) []Event {
+	batch := []Event{first}
+	timer := time.NewTimer(s.coalesceWindow)
+	defer timer.Stop()
+	for len(batch) < maxCoalescedEvents {
+		select {
+		case <-ctx.Done():
+			return batch
+		case <-timer.C:
+			return batch
+		case msg, open := <-s.eventCh:
+			if !open {
+				return batch
+			}
+			if msg.EventID <= s.skipUpTo {
+				continue
+			}
+			batch = append(batch, msg)
+		}
+	}
+	return batch
+}
+
+// writeBatch sends events as a single frame with a JSON array of their data and the ID of the last event.
+func writeBatch(writer io.Writer, nonce string, batch []Event) error {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, msg := range batch {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(msg.Data)
+	}
+	buf.WriteByte(']')
+	_, err := fmt.Fprintf(writer, "event: message\nid: %v\ndata: %v\n\n", formatEventID(nonce, batch[len(batch)-1].EventID), buf.String())
+	return err
+}
+
+// writeShutdownNotice sends a server_shutting_down event with a suggested failover delay.
+func writeShutdownNotice(writer io.Writer, notice utils.ShutdownNotice) error {
+	data, err := json.Marshal(notice)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(writer, "event: %v\ndata: %v\n\n", events.ShutdownEvent, string(data))
+	return err
+}
+
+// parseCoalesceWindow parses the "coalesce" query parameter with a window in milliseconds.
+func parseCoalesceWindow(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || ms < 0 {
+		return 0, fmt.Errorf("coalesce must be a non-negative number of milliseconds")
+	}
+	window := time.Duration(ms) * time.Millisecond
+	if window > maxCoalesceWindow {
+		return 0, fmt.Errorf("coalesce window must not exceed %v", maxCoalesceWindow)
+	}
+	return window, nil
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
 )
 
 func Test_session_Stream(t *testing.T) {
@@ -99,3 +101,155 @@ func Test_session_SendEvent(t *testing.T) {
 		})
 	}
 }
+
+func Test_session_StreamCoalesced(t *testing.T) {
+	s := &session{
+		eventCh:        make(chan Event, 10),
+		cancel:         func() {},
+		pingInterval:   time.Second * 1,
+		coalesceWindow: 200 * time.Millisecond,
+	}
+	s.eventCh <- Event{EventID: 1, Data: []byte(`{"lt":1}`)}
+	s.eventCh <- Event{EventID: 2, Data: []byte(`{"lt":2}`)}
+	s.eventCh <- Event{EventID: 3, Data: []byte(`{"lt":3}`)}
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	rec := httptest.NewRecorder()
+	err := s.StreamEvents(ctx, rec)
+	require.Nil(t, err)
+	expectedBody := `event: heartbeat
+
+event: message
+id: 3
+data: [{"lt":1},{"lt":2},{"lt":3}]
+
+`
+	require.Equal(t, expectedBody, rec.Body.String())
+}
+
+func Test_parseCoalesceWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "disabled", value: "", want: 0},
+		{name: "zero", value: "0", want: 0},
+		{name: "window", value: "250", want: 250 * time.Millisecond},
+		{name: "max window", value: "5000", want: maxCoalesceWindow},
+		{name: "too long", value: "5001", wantErr: true},
+		{name: "negative", value: "-1", wantErr: true},
+		{name: "not a number", value: "1s", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCoalesceWindow(tt.value)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type drain struct {
+	ch chan struct{}
+}
+
+func (d drain) Draining() <-chan struct{} {
+	return d.ch
+}
+
+func (d drain) FailoverDelay() time.Duration {
+	return 5 * time.Second
+}
+
+func Test_session_StreamShutdownNotice(t *testing.T) {
+	s := &session{
+		eventCh:      make(chan Event, 10),
+		cancel:       func() {},
+		pingInterval: time.Hour,
+	}
+	d := drain{ch: make(chan struct{})}
+	close(d.ch)
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), utils.DrainKey, utils.Drain(d)), 200*time.Millisecond)
+	defer cancel()
+
+	rec := httptest.NewRecorder()
+	require.Nil(t, s.StreamEvents(ctx, rec))
+	expectedBody := `event: heartbeat
+
+event: server_shutting_down
+data: {"failover_delay_ms":5000}
+
+`
+	require.Equal(t, expectedBody, rec.Body.String())
+}
+
+func Test_session_StreamEvents_replay(t *testing.T) {
+	s := &session{
+		eventCh:      make(chan Event, 10),
+		cancel:       func() {},
+		pingInterval: time.Hour,
+		replay:       []Event{{EventID: 2, Data: []byte("two")}, {EventID: 3, Data: []byte("three")}},
+		skipUpTo:     3,
+	}
+	// the event 3 was queued before the client resumed the stream and has been replayed already.
+	s.eventCh <- Event{EventID: 3, Data: []byte("three")}
+	s.eventCh <- Event{EventID: 4, Data: []byte("four")}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	rec := httptest.NewRecorder()
+	err := s.StreamEvents(ctx, rec)
+	require.Nil(t, err)
+	expectedBody := `event: heartbeat
+
+event: message
+id: 2
+data: two
+
+event: message
+id: 3
+data: three
+
+event: message
+id: 4
+data: four
+
+`
+	require.Equal(t, expectedBody, rec.Body.String())
+}
+
+func Test_session_StreamEvents_gap(t *testing.T) {
+	s := &session{
+		eventCh:      make(chan Event, 10),
+		cancel:       func() {},
+		pingInterval: time.Hour,
+		nonce:        "abc",
+		gap:          true,
+		replay:       []Event{{EventID: 5, Data: []byte("five")}},
+		skipUpTo:     5,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	rec := httptest.NewRecorder()
+	err := s.StreamEvents(ctx, rec)
+	require.Nil(t, err)
+	expectedBody := `event: heartbeat
+
+event: gap
+data: {}
+
+event: message
+id: abc:5
+data: five
+
+`
+	require.Equal(t, expectedBody, rec.Body.String())
+}
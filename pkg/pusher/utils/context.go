@@ -2,13 +2,57 @@ package utils
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/tonkeeper/tongo"
 )
 
 const TokenNameKey = "token-name-key"
 
+// AccountScopeKey is a context key of an AccountScope.
+const AccountScopeKey = "account-scope-key"
+
 // TokenNameFromContext returns a token name from a request context.
 // Can be added by auth middleware.
 func TokenNameFromContext(ctx context.Context) string {
 	name, _ := ctx.Value(TokenNameKey).(string)
 	return name
 }
+
+// AccountScope limits accounts a client is allowed to subscribe to.
+// Can be added by auth middleware to isolate tenants sharing one instance.
+type AccountScope interface {
+	// Watches reports whether the client is allowed to subscribe to the given account.
+	Watches(account tongo.AccountID) bool
+	// WatchedAccounts returns all accounts the client is allowed to subscribe to.
+	WatchedAccounts() []tongo.AccountID
+}
+
+// AccountScopeFromContext returns an account scope from a request context or nil if there is no scope.
+func AccountScopeFromContext(ctx context.Context) AccountScope {
+	scope, _ := ctx.Value(AccountScopeKey).(AccountScope)
+	return scope
+}
+
+// ScopeAccounts checks the requested accounts against the account scope of the context.
+// A subscription to all accounts is narrowed down to the accounts of the scope.
+// If there is no scope, the requested accounts are returned as is.
+func ScopeAccounts(ctx context.Context, accounts []tongo.AccountID, all bool) ([]tongo.AccountID, bool, error) {
+	scope := AccountScopeFromContext(ctx)
+	if scope == nil {
+		return accounts, all, nil
+	}
+	if all {
+		watched := scope.WatchedAccounts()
+		if len(watched) == 0 {
+			return nil, false, fmt.Errorf("there are no watched accounts")
+		}
+		return watched, false, nil
+	}
+	for _, account := range accounts {
+		if !scope.Watches(account) {
+			return nil, false, fmt.Errorf("account %v is not watched", account.ToRaw())
+		}
+	}
+	return accounts, false, nil
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"context"
+	"time"
+)
+
+// DrainKey is a context key of a Drain.
+const DrainKey = "drain-key"
+
+// Drain signals streaming connections that the instance is going away,
+// so clients can reconnect to another instance before the connection is closed.
+type Drain interface {
+	// Draining returns a channel that is closed once the instance starts draining.
+	// A new channel is returned after draining is canceled.
+	Draining() <-chan struct{}
+	// FailoverDelay returns a suggested delay before a client reconnects to another instance.
+	FailoverDelay() time.Duration
+}
+
+// DrainFromContext returns a drain from a request context or nil if there is none.
+// Can be added by a middleware.
+func DrainFromContext(ctx context.Context) Drain {
+	drain, _ := ctx.Value(DrainKey).(Drain)
+	return drain
+}
+
+// DrainingFromContext returns Drain.Draining of a request context.
+// If there is no drain, it returns a nil channel which never fires in a select statement.
+func DrainingFromContext(ctx context.Context) <-chan struct{} {
+	if drain := DrainFromContext(ctx); drain != nil {
+		return drain.Draining()
+	}
+	return nil
+}
+
+// ShutdownNotice is the payload of a server_shutting_down event.
+type ShutdownNotice struct {
+	// FailoverDelayMs is a suggested delay in milliseconds before reconnecting to another instance.
+	FailoverDelayMs int64 `json:"failover_delay_ms"`
+}
+
+// ShutdownNoticeFromContext returns a payload of a server_shutting_down event for the drain of a request context.
+func ShutdownNoticeFromContext(ctx context.Context) ShutdownNotice {
+	if drain := DrainFromContext(ctx); drain != nil {
+		return ShutdownNotice{FailoverDelayMs: drain.FailoverDelay().Milliseconds()}
+	}
+	return ShutdownNotice{}
+}
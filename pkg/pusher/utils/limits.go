@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+)
+
+// LimitsKey is a context key of Limits.
+const LimitsKey = "limits-key"
+
+// Limits protects dispatchers from subscriptions too large to be served efficiently.
+// Zero values mean no limit.
+type Limits struct {
+	// MaxAccountsPerSubscription caps a number of accounts listed in a single subscription request.
+	MaxAccountsPerSubscription int
+	// MaxSubscriptionsPerConnection caps a number of subscriptions of a single websocket connection,
+	// every subscribed account counts as a subscription.
+	MaxSubscriptionsPerConnection int
+}
+
+// LimitsFromContext returns limits from a request context or no limits if there are none.
+// Can be added by a middleware.
+func LimitsFromContext(ctx context.Context) Limits {
+	limits, _ := ctx.Value(LimitsKey).(Limits)
+	return limits
+}
+
+// CheckAccounts returns an error if a subscription request lists too many accounts.
+func (l Limits) CheckAccounts(accounts int) error {
+	if l.MaxAccountsPerSubscription > 0 && accounts > l.MaxAccountsPerSubscription {
+		return fmt.Errorf("a subscription is limited to %v accounts, got %v", l.MaxAccountsPerSubscription, accounts)
+	}
+	return nil
+}
+
+// CheckSubscriptions returns an error if a connection with the given number of subscriptions can't add new ones.
+func (l Limits) CheckSubscriptions(current, added int) error {
+	if l.MaxSubscriptionsPerConnection > 0 && current+added > l.MaxSubscriptionsPerConnection {
+		return fmt.Errorf("a connection is limited to %v subscriptions, it has %v and requests %v more", l.MaxSubscriptionsPerConnection, current, added)
+	}
+	return nil
+}
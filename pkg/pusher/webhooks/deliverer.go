@@ -0,0 +1,338 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+)
+
+var deliveryNumber = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "webhook_deliveries",
+	Help: "Number of attempts to deliver notifications to webhooks",
+}, []string{"result"})
+
+const (
+	// queueSize is a number of notifications of a single webhook waiting to be delivered,
+	// new notifications are dropped when the queue is full.
+	queueSize = 1000
+	// requestTimeout limits a single attempt to deliver a notification.
+	requestTimeout = 10 * time.Second
+
+	defaultMaxAttempts    = 8
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 5 * time.Minute
+)
+
+const (
+	// TimestampHeader is a header with a unix timestamp of a delivery attempt.
+	TimestampHeader = "X-Webhook-Timestamp"
+	// SignatureHeader is a header with comma-separated "v1=<hex>" HMAC-SHA256 signatures of "<timestamp>.<body>",
+	// one per secret the webhook has at the moment, a receiver accepts a notification if any of them matches.
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// Signature returns a value of SignatureHeader for the body sent at the timestamp.
+func Signature(secrets []string, timestamp string, body []byte) string {
+	signatures := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp))
+		mac.Write([]byte("."))
+		mac.Write(body)
+		signatures = append(signatures, "v1="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return strings.Join(signatures, ",")
+}
+
+// Notification is a body POSTed to a webhook.
+// This is part of our API contract with webhook receivers.
+type Notification struct {
+	WebhookID string    `json:"webhook_id"`
+	Event     EventType `json:"event"`
+	// Data is the same payload as streamed via SSE and websocket for the event.
+	Data json.RawMessage `json:"data"`
+}
+
+// Deliverer POSTs notifications about transactions and traces to registered webhooks.
+// Every webhook has its own queue, so a slow or an unavailable webhook doesn't delay others,
+// and notifications to a webhook are delivered in order.
+type Deliverer struct {
+	logger      *zap.Logger
+	registry    *Registry
+	txSource    sources.TransactionSource
+	traceSource sources.TraceSource
+	client      *http.Client
+
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	// mu protects queues.
+	mu     sync.Mutex
+	queues map[string]*worker
+}
+
+// worker delivers notifications of a single webhook from its queue.
+type worker struct {
+	queue  chan Notification
+	cancel context.CancelFunc
+}
+
+type Option func(d *Deliverer)
+
+// WithHTTPClient sets a client used to POST notifications.
+// Unlike the default client, the given one is not restricted to public addresses.
+func WithHTTPClient(client *http.Client) Option {
+	return func(d *Deliverer) {
+		d.client = client
+	}
+}
+
+// WithRetries sets a number of attempts to deliver a notification
+// and a backoff between attempts doubled after every failed attempt up to maxBackoff.
+func WithRetries(maxAttempts int, initialBackoff, maxBackoff time.Duration) Option {
+	return func(d *Deliverer) {
+		d.maxAttempts = maxAttempts
+		d.initialBackoff = initialBackoff
+		d.maxBackoff = maxBackoff
+	}
+}
+
+// NewDeliverer returns a deliverer of notifications to webhooks of the registry, traceSource is optional.
+func NewDeliverer(logger *zap.Logger, registry *Registry, txSource sources.TransactionSource, traceSource sources.TraceSource, opts ...Option) *Deliverer {
+	d := &Deliverer{
+		logger:         logger,
+		registry:       registry,
+		txSource:       txSource,
+		traceSource:    traceSource,
+		client:         newPublicClient(),
+		maxAttempts:    defaultMaxAttempts,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		queues:         map[string]*worker{},
+	}
+	for _, o := range opts {
+		o(d)
+	}
+	registry.OnRemove(d.stopWorker)
+	return d
+}
+
+// newPublicClient returns a client refusing to connect to loopback, private, link-local and other non-public addresses,
+// so a webhook can't reach the admin endpoints of the instance, cloud metadata or other internal services.
+// Addresses are checked once resolved, so a hostname resolving to an internal address is refused as well.
+func newPublicClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !isPublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("webhook address %v is not public", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would be dialed instead of a webhook, so its address would be checked instead of the webhook's one.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: requestTimeout, Transport: transport}
+}
+
+// sharedAddressSpace is 100.64.0.0/10 used by carrier-grade NATs and some cloud providers internally.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+func (d *Deliverer) Run(ctx context.Context) {
+	cancelFn := d.txSource.SubscribeToTransactions(ctx, func(eventData []byte) {
+		var tx sources.TransactionEventData
+		if err := json.Unmarshal(eventData, &tx); err != nil {
+			d.logger.Error("json.Unmarshal() failed", zap.Error(err))
+			return
+		}
+		d.enqueue(ctx, EventAccountTransaction, eventData, tx.AccountID)
+	}, sources.SubscribeToTransactionsOptions{AllAccounts: true, AllOperations: true})
+	defer cancelFn()
+	if d.traceSource != nil {
+		cancelFn := d.traceSource.SubscribeToTraces(ctx, func(eventData []byte) {
+			var trace sources.TraceEventData
+			if err := json.Unmarshal(eventData, &trace); err != nil {
+				d.logger.Error("json.Unmarshal() failed", zap.Error(err))
+				return
+			}
+			d.enqueue(ctx, EventTrace, eventData, trace.AccountIDs...)
+		}, sources.SubscribeToTraceOptions{AllAccounts: true})
+		defer cancelFn()
+	}
+	<-ctx.Done()
+}
+
+// enqueue puts a notification into queues of webhooks subscribed to the accounts.
+func (d *Deliverer) enqueue(ctx context.Context, event EventType, eventData []byte, accounts ...tongo.AccountID) {
+	for _, w := range d.registry.Subscribers(event, accounts...) {
+		notification := Notification{WebhookID: w.ID, Event: event, Data: eventData}
+		select {
+		case d.queue(ctx, w.ID) <- notification:
+			d.registry.updateStatus(w.ID, func(status *Status) {
+				status.Pending += 1
+			})
+		default:
+			deliveryNumber.With(map[string]string{"result": "dropped"}).Inc()
+			d.registry.updateStatus(w.ID, func(status *Status) {
+				status.Failed += 1
+				status.LastFailureAt = time.Now().Unix()
+				status.LastError = "too many pending notifications"
+			})
+		}
+	}
+}
+
+// queue returns a queue of the webhook starting a worker delivering its notifications if there is none.
+func (d *Deliverer) queue(ctx context.Context, id string) chan Notification {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	wr, ok := d.queues[id]
+	if !ok {
+		ctx, cancel := context.WithCancel(ctx)
+		wr = &worker{queue: make(chan Notification, queueSize), cancel: cancel}
+		d.queues[id] = wr
+		go d.work(ctx, id, wr)
+	}
+	return wr.queue
+}
+
+// stopWorker stops a worker of a removed webhook dropping its pending notifications.
+func (d *Deliverer) stopWorker(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if wr, ok := d.queues[id]; ok {
+		wr.cancel()
+		delete(d.queues, id)
+	}
+}
+
+func (d *Deliverer) work(ctx context.Context, id string, wr *worker) {
+	defer wr.cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification := <-wr.queue:
+			w, ok := d.registry.Get(id)
+			if !ok {
+				// the webhook has been deleted after the notification was queued.
+				d.mu.Lock()
+				if d.queues[id] == wr {
+					delete(d.queues, id)
+				}
+				d.mu.Unlock()
+				return
+			}
+			d.deliver(ctx, w, notification)
+		}
+	}
+}
+
+// deliver POSTs the notification to the webhook retrying failed attempts with an exponential backoff.
+func (d *Deliverer) deliver(ctx context.Context, w Webhook, notification Notification) {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		d.logger.Error("json.Marshal() failed", zap.Error(err))
+		return
+	}
+	backoff := d.initialBackoff
+	for attempt := 1; ; attempt++ {
+		err := d.post(ctx, w, body)
+		if err == nil {
+			deliveryNumber.With(map[string]string{"result": "delivered"}).Inc()
+			d.registry.updateStatus(w.ID, func(status *Status) {
+				status.Delivered += 1
+				status.Pending -= 1
+				status.LastDeliveryAt = time.Now().Unix()
+			})
+			return
+		}
+		final := attempt >= d.maxAttempts
+		d.registry.updateStatus(w.ID, func(status *Status) {
+			status.LastFailureAt = time.Now().Unix()
+			status.LastError = err.Error()
+			if final {
+				status.Failed += 1
+				status.Pending -= 1
+			}
+		})
+		if final {
+			deliveryNumber.With(map[string]string{"result": "failed"}).Inc()
+			d.logger.Warn("failed to deliver notification to webhook",
+				zap.String("webhook", w.ID),
+				zap.Int("attempts", attempt),
+				zap.Error(err))
+			return
+		}
+		deliveryNumber.With(map[string]string{"result": "retried"}).Inc()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, d.maxBackoff)
+	}
+}
+
+func (d *Deliverer) post(ctx context.Context, w Webhook, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "opentonapi-webhooks")
+	now := time.Now()
+	if secrets := w.Secrets(now); len(secrets) > 0 {
+		timestamp := strconv.FormatInt(now.Unix(), 10)
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, Signature(secrets, timestamp, body))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %v", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,525 @@
+// Package webhooks delivers notifications about transactions and traces of accounts to callback URLs.
+//
+// Streaming via SSE or websocket requires a long-lived connection, which many backend services can't hold.
+// Instead, a client registers a webhook: a callback URL along with accounts and events it is interested in,
+// and every notification is POSTed to the URL, failed deliveries are retried with an exponential backoff.
+// Webhooks are kept in a local JSON file or in a repository, so they survive restarts,
+// while delivery statuses are kept in memory.
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+const (
+	maxURLLength = 2048
+	// maxAccounts is a maximal number of accounts of a single webhook.
+	maxAccounts = 1000
+	// maxWebhooks is a maximal number of webhooks of the instance and maxTenantWebhooks is of a single tenant,
+	// every webhook has its own delivery queue, so registrations can't grow without a limit.
+	maxWebhooks       = 10_000
+	maxTenantWebhooks = 100
+
+	// Collection is a name of the repository collection with webhooks.
+	Collection = "webhooks"
+	// repositoryTimeout limits a single change written to a repository.
+	repositoryTimeout = 5 * time.Second
+	// secretSize is a size of a signing secret in bytes.
+	secretSize = 32
+)
+
+var (
+	// ErrInvalidWebhook is returned by Registry.Add when a webhook can't be registered as is.
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	// ErrNotFound is returned when there is no webhook with the given ID.
+	ErrNotFound = errors.New("webhook not found")
+	// ErrLimitReached is returned by Registry.Add when the instance or the tenant has too many webhooks.
+	ErrLimitReached = errors.New("too many webhooks")
+)
+
+// EventType is a kind of notifications delivered to a webhook.
+type EventType string
+
+const (
+	// EventAccountTransaction is a notification about a transaction, its payload is sources.TransactionEventData.
+	EventAccountTransaction EventType = "account_transaction"
+	// EventTrace is a notification about a completed trace, its payload is sources.TraceEventData.
+	EventTrace EventType = "trace"
+)
+
+// Webhook is a callback URL receiving notifications about accounts.
+type Webhook struct {
+	ID       string            `json:"id"`
+	URL      string            `json:"url"`
+	Accounts []tongo.AccountID `json:"accounts"`
+	Events   []EventType       `json:"events"`
+	// Tenant is a name of a tenant owning the webhook, empty for webhooks registered by an operator.
+	Tenant    string `json:"tenant,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+	// Secret is a key of HMAC-SHA256 signatures of notifications,
+	// webhooks registered before notifications were signed have no secret until it is rotated.
+	Secret string `json:"secret,omitempty"`
+	// PreviousSecret signs notifications along with Secret until PreviousSecretExpiresAt,
+	// so a receiver can switch to a rotated secret without failing deliveries.
+	PreviousSecret          string `json:"previous_secret,omitempty"`
+	PreviousSecretExpiresAt int64  `json:"previous_secret_expires_at,omitempty"`
+}
+
+// Secrets returns keys notifications to the webhook are signed with at the given moment.
+func (w *Webhook) Secrets(now time.Time) []string {
+	var secrets []string
+	if w.Secret != "" {
+		secrets = append(secrets, w.Secret)
+	}
+	if w.PreviousSecret != "" && now.Unix() < w.PreviousSecretExpiresAt {
+		secrets = append(secrets, w.PreviousSecret)
+	}
+	return secrets
+}
+
+// Subscribed reports whether the webhook receives events of the given type.
+func (w *Webhook) Subscribed(event EventType) bool {
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Status describes deliveries to a webhook since the start of the instance.
+type Status struct {
+	Delivered int64 `json:"delivered"`
+	// Failed is a number of notifications dropped after all attempts to deliver them have failed.
+	Failed int64 `json:"failed"`
+	// Pending is a number of notifications waiting to be delivered.
+	Pending        int    `json:"pending"`
+	LastDeliveryAt int64  `json:"last_delivery_at,omitempty"`
+	LastFailureAt  int64  `json:"last_failure_at,omitempty"`
+	LastError      string `json:"last_error,omitempty"`
+}
+
+// Registry keeps webhooks in memory and persists every change to a file or to a repository.
+type Registry struct {
+	// path is a file with webhooks, an empty path keeps webhooks in memory only.
+	path string
+	// repo, if set, is used instead of the file.
+	repo repository.Repository
+
+	// mu protects webhooks, byAccount and writes to the file.
+	mu        sync.RWMutex
+	webhooks  map[string]Webhook
+	byAccount map[tongo.AccountID][]string
+	// generation is incremented by every change, so Reload can tell its records are outdated.
+	generation uint64
+
+	// statusMu protects statuses.
+	statusMu sync.Mutex
+	statuses map[string]Status
+
+	// removeMu protects onRemove.
+	removeMu sync.Mutex
+	onRemove []func(id string)
+}
+
+// NewRegistry returns a registry backed by the given file, the file is created with the first change.
+func NewRegistry(path string) (*Registry, error) {
+	r := newRegistry()
+	r.path = path
+	if path == "" {
+		return r, nil
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var webhooks []Webhook
+	if err := json.Unmarshal(content, &webhooks); err != nil {
+		return nil, fmt.Errorf("failed to decode %v: %w", path, err)
+	}
+	for _, w := range webhooks {
+		r.add(w)
+	}
+	return r, nil
+}
+
+// NewRepositoryRegistry returns a registry backed by the repository.
+func NewRepositoryRegistry(ctx context.Context, repo repository.Repository) (*Registry, error) {
+	r := newRegistry()
+	r.repo = repo
+	if err := r.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func newRegistry() *Registry {
+	return &Registry{
+		webhooks:  map[string]Webhook{},
+		byAccount: map[tongo.AccountID][]string{},
+		statuses:  map[string]Status{},
+	}
+}
+
+// Reload replaces webhooks with the ones from the repository, so changes made by other replicas become visible.
+// It does nothing for a registry backed by a file.
+// If the registry is changed while the repository is being read, the records are discarded
+// as they might miss the change, the next reload picks it up.
+func (r *Registry) Reload(ctx context.Context) error {
+	if r.repo == nil {
+		return nil
+	}
+	r.mu.RLock()
+	generation := r.generation
+	r.mu.RUnlock()
+	records, err := r.repo.List(ctx, Collection)
+	if err != nil {
+		return err
+	}
+	webhooks := make([]Webhook, 0, len(records))
+	for _, record := range records {
+		var w Webhook
+		if err := json.Unmarshal(record.Value, &w); err != nil {
+			return fmt.Errorf("failed to decode webhook %v: %w", record.Key, err)
+		}
+		webhooks = append(webhooks, w)
+	}
+	r.mu.Lock()
+	if r.generation != generation {
+		r.mu.Unlock()
+		return nil
+	}
+	previous := r.webhooks
+	r.webhooks = make(map[string]Webhook, len(webhooks))
+	r.byAccount = map[tongo.AccountID][]string{}
+	for _, w := range webhooks {
+		r.add(w)
+	}
+	var removed []string
+	for id := range previous {
+		if _, ok := r.webhooks[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	r.mu.Unlock()
+	for _, id := range removed {
+		r.removed(id)
+	}
+	return nil
+}
+
+// OnRemove registers a function called once a webhook is deleted or disappears from the repository on Reload.
+func (r *Registry) OnRemove(fn func(id string)) {
+	r.removeMu.Lock()
+	defer r.removeMu.Unlock()
+	r.onRemove = append(r.onRemove, fn)
+}
+
+func (r *Registry) removed(id string) {
+	r.statusMu.Lock()
+	delete(r.statuses, id)
+	r.statusMu.Unlock()
+	r.removeMu.Lock()
+	callbacks := r.onRemove
+	r.removeMu.Unlock()
+	for _, fn := range callbacks {
+		fn(id)
+	}
+}
+
+// Add registers a webhook and returns it with a generated ID.
+func (r *Registry) Add(callbackURL string, accounts []tongo.AccountID, events []EventType, tenant string) (Webhook, error) {
+	if err := validateURL(callbackURL); err != nil {
+		return Webhook{}, err
+	}
+	if len(accounts) == 0 {
+		return Webhook{}, fmt.Errorf("%w: no accounts", ErrInvalidWebhook)
+	}
+	if len(accounts) > maxAccounts {
+		return Webhook{}, fmt.Errorf("%w: more than %v accounts", ErrInvalidWebhook, maxAccounts)
+	}
+	if len(events) == 0 {
+		events = []EventType{EventAccountTransaction}
+	}
+	for _, event := range events {
+		if event != EventAccountTransaction && event != EventTrace {
+			return Webhook{}, fmt.Errorf("%w: unknown event %q", ErrInvalidWebhook, event)
+		}
+	}
+	id, err := randomHex(8)
+	if err != nil {
+		return Webhook{}, err
+	}
+	secret, err := randomHex(secretSize)
+	if err != nil {
+		return Webhook{}, err
+	}
+	w := Webhook{
+		ID:        id,
+		URL:       callbackURL,
+		Accounts:  uniqueAccounts(accounts),
+		Events:    events,
+		Tenant:    tenant,
+		CreatedAt: time.Now().Unix(),
+		Secret:    secret,
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.webhooks) >= maxWebhooks {
+		return Webhook{}, fmt.Errorf("%w: the instance has %v webhooks", ErrLimitReached, maxWebhooks)
+	}
+	if tenant != "" && r.count(tenant) >= maxTenantWebhooks {
+		return Webhook{}, fmt.Errorf("%w: tenant %v has %v webhooks", ErrLimitReached, tenant, maxTenantWebhooks)
+	}
+	r.generation++
+	r.add(w)
+	if err := r.persist(w.ID, &w); err != nil {
+		r.remove(w.ID)
+		return Webhook{}, err
+	}
+	return w, nil
+}
+
+// RotateSecret replaces a secret of the webhook with a new one,
+// the previous secret keeps signing notifications for the grace period.
+func (r *Registry) RotateSecret(id string, gracePeriod time.Duration) (Webhook, error) {
+	secret, err := randomHex(secretSize)
+	if err != nil {
+		return Webhook{}, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	w, ok := r.webhooks[id]
+	if !ok {
+		return Webhook{}, ErrNotFound
+	}
+	rotated := w
+	rotated.PreviousSecret = w.Secret
+	rotated.PreviousSecretExpiresAt = time.Now().Add(gracePeriod).Unix()
+	rotated.Secret = secret
+	r.generation++
+	r.webhooks[id] = rotated
+	if err := r.persist(id, &rotated); err != nil {
+		r.webhooks[id] = w
+		return Webhook{}, err
+	}
+	return rotated, nil
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func validateURL(callbackURL string) error {
+	if len(callbackURL) > maxURLLength {
+		return fmt.Errorf("%w: url is longer than %v bytes", ErrInvalidWebhook, maxURLLength)
+	}
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https url", ErrInvalidWebhook)
+	}
+	return nil
+}
+
+func uniqueAccounts(accounts []tongo.AccountID) []tongo.AccountID {
+	seen := make(map[tongo.AccountID]struct{}, len(accounts))
+	result := make([]tongo.AccountID, 0, len(accounts))
+	for _, account := range accounts {
+		if _, ok := seen[account]; ok {
+			continue
+		}
+		seen[account] = struct{}{}
+		result = append(result, account)
+	}
+	return result
+}
+
+// count returns a number of webhooks of the tenant.
+func (r *Registry) count(tenant string) int {
+	n := 0
+	for _, w := range r.webhooks {
+		if w.Tenant == tenant {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Registry) add(w Webhook) {
+	r.webhooks[w.ID] = w
+	for _, account := range w.Accounts {
+		r.byAccount[account] = append(r.byAccount[account], w.ID)
+	}
+}
+
+func (r *Registry) remove(id string) {
+	w := r.webhooks[id]
+	delete(r.webhooks, id)
+	for _, account := range w.Accounts {
+		ids := r.byAccount[account]
+		for i := range ids {
+			if ids[i] == id {
+				ids = append(ids[:i], ids[i+1:]...)
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(r.byAccount, account)
+			continue
+		}
+		r.byAccount[account] = ids
+	}
+}
+
+// Get returns a webhook by its ID.
+func (r *Registry) Get(id string) (Webhook, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	w, ok := r.webhooks[id]
+	return w, ok
+}
+
+// List returns webhooks accepted by the filter ordered by creation time, nil filter accepts all.
+func (r *Registry) List(filter func(w Webhook) bool) []Webhook {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.list(filter)
+}
+
+func (r *Registry) list(filter func(w Webhook) bool) []Webhook {
+	webhooks := make([]Webhook, 0, len(r.webhooks))
+	for _, w := range r.webhooks {
+		if filter == nil || filter(w) {
+			webhooks = append(webhooks, w)
+		}
+	}
+	sort.Slice(webhooks, func(i, j int) bool {
+		if webhooks[i].CreatedAt != webhooks[j].CreatedAt {
+			return webhooks[i].CreatedAt < webhooks[j].CreatedAt
+		}
+		return webhooks[i].ID < webhooks[j].ID
+	})
+	return webhooks
+}
+
+// Delete removes a webhook and reports whether it existed.
+func (r *Registry) Delete(id string) (bool, error) {
+	r.mu.Lock()
+	w, ok := r.webhooks[id]
+	if !ok {
+		r.mu.Unlock()
+		return false, nil
+	}
+	r.generation++
+	r.remove(id)
+	if err := r.persist(id, nil); err != nil {
+		r.add(w)
+		r.mu.Unlock()
+		return false, err
+	}
+	r.mu.Unlock()
+	r.removed(id)
+	return true, nil
+}
+
+// Subscribers returns webhooks receiving events of the given type about any of the accounts.
+func (r *Registry) Subscribers(event EventType, accounts ...tongo.AccountID) []Webhook {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var webhooks []Webhook
+	seen := map[string]struct{}{}
+	for _, account := range accounts {
+		for _, id := range r.byAccount[account] {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			if w := r.webhooks[id]; w.Subscribed(event) {
+				webhooks = append(webhooks, w)
+			}
+		}
+	}
+	return webhooks
+}
+
+// Status returns a delivery status of the webhook.
+func (r *Registry) Status(id string) Status {
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	return r.statuses[id]
+}
+
+// updateStatus applies the change to a delivery status of the webhook.
+func (r *Registry) updateStatus(id string, change func(status *Status)) {
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	status := r.statuses[id]
+	change(&status)
+	r.statuses[id] = status
+}
+
+// persist stores a change of the webhook, a nil webhook means it has been deleted.
+func (r *Registry) persist(id string, w *Webhook) error {
+	if r.repo == nil {
+		return r.save()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), repositoryTimeout)
+	defer cancel()
+	if w == nil {
+		return r.repo.Delete(ctx, Collection, id)
+	}
+	value, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	return r.repo.Put(ctx, Collection, repository.Record{Key: id, Value: value})
+}
+
+// save writes webhooks to a temporary file and renames it, so the file is never left half-written.
+func (r *Registry) save() error {
+	if r.path == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(r.list(nil), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), r.path)
+}
@@ -0,0 +1,341 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"go.uber.org/zap"
+
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+var (
+	exchange = tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	user     = tongo.MustParseAddress("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb").ID
+)
+
+func TestRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+
+	registry, err := NewRegistry(path)
+	require.Nil(t, err)
+	tests := []struct {
+		name     string
+		url      string
+		accounts []tongo.AccountID
+		events   []EventType
+	}{
+		{name: "relative url", url: "/callback", accounts: []tongo.AccountID{exchange}},
+		{name: "unsupported scheme", url: "ftp://example.com/callback", accounts: []tongo.AccountID{exchange}},
+		{name: "no accounts", url: "https://example.com/callback"},
+		{name: "unknown event", url: "https://example.com/callback", accounts: []tongo.AccountID{exchange}, events: []EventType{"block"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := registry.Add(tt.url, tt.accounts, tt.events, "")
+			require.ErrorIs(t, err, ErrInvalidWebhook)
+		})
+	}
+
+	w, err := registry.Add("https://example.com/callback", []tongo.AccountID{exchange, exchange}, nil, "exchange")
+	require.Nil(t, err)
+	require.Equal(t, []tongo.AccountID{exchange}, w.Accounts)
+	require.Equal(t, []EventType{EventAccountTransaction}, w.Events)
+	require.Len(t, registry.Subscribers(EventAccountTransaction, user, exchange), 1)
+	require.Empty(t, registry.Subscribers(EventTrace, exchange))
+
+	// webhooks survive a restart.
+	registry, err = NewRegistry(path)
+	require.Nil(t, err)
+	got, ok := registry.Get(w.ID)
+	require.True(t, ok)
+	require.Equal(t, w, got)
+	require.Len(t, registry.List(func(w Webhook) bool { return w.Tenant == "exchange" }), 1)
+
+	deleted, err := registry.Delete(w.ID)
+	require.Nil(t, err)
+	require.True(t, deleted)
+	require.Empty(t, registry.Subscribers(EventAccountTransaction, exchange))
+
+	registry, err = NewRegistry(path)
+	require.Nil(t, err)
+	require.Empty(t, registry.List(nil))
+}
+
+func TestRegistry_tenantLimit(t *testing.T) {
+	registry, err := NewRegistry("")
+	require.Nil(t, err)
+	for i := 0; i < maxTenantWebhooks; i++ {
+		_, err := registry.Add("https://example.com/callback", []tongo.AccountID{exchange}, nil, "exchange")
+		require.Nil(t, err)
+	}
+	_, err = registry.Add("https://example.com/callback", []tongo.AccountID{exchange}, nil, "exchange")
+	require.ErrorIs(t, err, ErrLimitReached)
+	// other tenants and the operator have their own limits.
+	_, err = registry.Add("https://example.com/callback", []tongo.AccountID{exchange}, nil, "wallet")
+	require.Nil(t, err)
+	_, err = registry.Add("https://example.com/callback", []tongo.AccountID{exchange}, nil, "")
+	require.Nil(t, err)
+}
+
+// changingRepository runs onList after reading records, like a change made while a reload is in flight.
+type changingRepository struct {
+	repository.Repository
+	onList func()
+}
+
+func (r *changingRepository) List(ctx context.Context, collection string) ([]repository.Record, error) {
+	records, err := r.Repository.List(ctx, Collection)
+	if r.onList != nil {
+		r.onList()
+	}
+	return records, err
+}
+
+func TestRegistry_Reload_concurrentChange(t *testing.T) {
+	repo := &changingRepository{Repository: repository.NewMemory()}
+	registry, err := NewRepositoryRegistry(context.Background(), repo)
+	require.Nil(t, err)
+	var removed []string
+	registry.OnRemove(func(id string) {
+		removed = append(removed, id)
+	})
+
+	var w Webhook
+	repo.onList = func() {
+		w, err = registry.Add("https://example.com/callback", []tongo.AccountID{exchange}, nil, "exchange")
+		require.Nil(t, err)
+	}
+	require.Nil(t, registry.Reload(context.Background()))
+	_, ok := registry.Get(w.ID)
+	require.True(t, ok)
+	require.Empty(t, removed)
+
+	repo.onList = nil
+	require.Nil(t, registry.Reload(context.Background()))
+	_, ok = registry.Get(w.ID)
+	require.True(t, ok)
+	require.Empty(t, removed)
+}
+
+type mockTxSource struct {
+	mu         sync.Mutex
+	deliveryFn sources.DeliveryFn
+}
+
+func (m *mockTxSource) SubscribeToTransactions(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTransactionsOptions) sources.CancelFn {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deliveryFn = deliveryFn
+	return func() {}
+}
+
+func (m *mockTxSource) deliver(t *testing.T, tx sources.TransactionEventData) {
+	eventData, err := json.Marshal(tx)
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return m.deliveryFn != nil
+	}, time.Second, time.Millisecond)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deliveryFn(eventData)
+}
+
+func TestDeliverer(t *testing.T) {
+	var mu sync.Mutex
+	var received []Notification
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts += 1
+		// the first attempt fails to check retries.
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		var notification Notification
+		require.Nil(t, json.Unmarshal(body, &notification))
+		received = append(received, notification)
+	}))
+	defer server.Close()
+
+	registry, err := NewRegistry("")
+	require.Nil(t, err)
+	w, err := registry.Add(server.URL, []tongo.AccountID{exchange}, []EventType{EventAccountTransaction}, "")
+	require.Nil(t, err)
+	failing, err := registry.Add("http://127.0.0.1:1/callback", []tongo.AccountID{exchange}, nil, "")
+	require.Nil(t, err)
+
+	source := &mockTxSource{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the test server listens on a loopback address refused by the default client.
+	deliverer := NewDeliverer(zap.L(), registry, source, nil,
+		WithRetries(2, time.Millisecond, time.Millisecond),
+		WithHTTPClient(&http.Client{Timeout: time.Second}))
+	go deliverer.Run(ctx)
+
+	source.deliver(t, sources.TransactionEventData{AccountID: user, Lt: 1})
+	source.deliver(t, sources.TransactionEventData{AccountID: exchange, Lt: 2, TxHash: "aa"})
+	source.deliver(t, sources.TransactionEventData{AccountID: exchange, Lt: 3, TxHash: "bb"})
+
+	require.Eventually(t, func() bool {
+		return registry.Status(w.ID).Delivered == 2 && registry.Status(failing.ID).Failed == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 3, attempts)
+	require.Len(t, received, 2)
+	for i, lt := range []uint64{2, 3} {
+		require.Equal(t, w.ID, received[i].WebhookID)
+		require.Equal(t, EventAccountTransaction, received[i].Event)
+		var tx sources.TransactionEventData
+		require.Nil(t, json.Unmarshal(received[i].Data, &tx))
+		require.Equal(t, lt, tx.Lt)
+	}
+	status := registry.Status(w.ID)
+	require.Equal(t, 0, status.Pending)
+	require.Equal(t, "webhook responded with status 503", status.LastError)
+	require.NotEmpty(t, registry.Status(failing.ID).LastError)
+}
+
+func Test_isPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "8.8.8.8", want: true},
+		{addr: "2a00:1450:4001:82a::200e", want: true},
+		{addr: "127.0.0.1"},
+		{addr: "::1"},
+		{addr: "10.1.2.3"},
+		{addr: "172.16.0.1"},
+		{addr: "192.168.1.1"},
+		{addr: "169.254.169.254"},
+		{addr: "100.100.100.200"},
+		{addr: "0.0.0.0"},
+		{addr: "fd00::1"},
+		{addr: "fe80::1"},
+		{addr: "::ffff:127.0.0.1"},
+		{addr: "224.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			require.Equal(t, tt.want, isPublicAddr(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
+
+func TestDeliverer_internalAddress(t *testing.T) {
+	var requested atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested.Store(true)
+	}))
+	defer server.Close()
+
+	registry, err := NewRegistry("")
+	require.Nil(t, err)
+	w, err := registry.Add(server.URL, []tongo.AccountID{exchange}, nil, "")
+	require.Nil(t, err)
+
+	source := &mockTxSource{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deliverer := NewDeliverer(zap.L(), registry, source, nil, WithRetries(1, time.Millisecond, time.Millisecond))
+	go deliverer.Run(ctx)
+
+	source.deliver(t, sources.TransactionEventData{AccountID: exchange, Lt: 1})
+	require.Eventually(t, func() bool {
+		return registry.Status(w.ID).Failed == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.False(t, requested.Load())
+	require.Contains(t, registry.Status(w.ID).LastError, "is not public")
+}
+
+func TestDeliverer_deletedWebhook(t *testing.T) {
+	registry, err := NewRegistry("")
+	require.Nil(t, err)
+	w, err := registry.Add("https://example.com/callback", []tongo.AccountID{exchange}, nil, "")
+	require.Nil(t, err)
+
+	deliverer := NewDeliverer(zap.L(), registry, &mockTxSource{}, nil)
+	queue := deliverer.queue(context.Background(), w.ID)
+	require.NotNil(t, queue)
+
+	deleted, err := registry.Delete(w.ID)
+	require.Nil(t, err)
+	require.True(t, deleted)
+	deliverer.mu.Lock()
+	defer deliverer.mu.Unlock()
+	require.Empty(t, deliverer.queues)
+}
+
+func TestDeliverer_signature(t *testing.T) {
+	type request struct {
+		timestamp string
+		signature string
+		body      []byte
+	}
+	requests := make(chan request, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		requests <- request{timestamp: r.Header.Get(TimestampHeader), signature: r.Header.Get(SignatureHeader), body: body}
+	}))
+	defer server.Close()
+
+	registry, err := NewRegistry("")
+	require.Nil(t, err)
+	w, err := registry.Add(server.URL, []tongo.AccountID{exchange}, nil, "")
+	require.Nil(t, err)
+	require.Len(t, w.Secret, 2*secretSize)
+
+	source := &mockTxSource{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deliverer := NewDeliverer(zap.L(), registry, source, nil, WithHTTPClient(&http.Client{Timeout: time.Second}))
+	go deliverer.Run(ctx)
+
+	source.deliver(t, sources.TransactionEventData{AccountID: exchange, Lt: 1})
+	req := <-requests
+	require.NotEmpty(t, req.timestamp)
+	require.Equal(t, Signature([]string{w.Secret}, req.timestamp, req.body), req.signature)
+
+	// both secrets sign notifications during the grace period.
+	rotated, err := registry.RotateSecret(w.ID, time.Hour)
+	require.Nil(t, err)
+	require.NotEqual(t, w.Secret, rotated.Secret)
+	source.deliver(t, sources.TransactionEventData{AccountID: exchange, Lt: 2})
+	req = <-requests
+	require.Equal(t, Signature([]string{rotated.Secret, w.Secret}, req.timestamp, req.body), req.signature)
+	require.Len(t, strings.Split(req.signature, ","), 2)
+
+	// the previous secret expires.
+	_, err = registry.RotateSecret(w.ID, 0)
+	require.Nil(t, err)
+	source.deliver(t, sources.TransactionEventData{AccountID: exchange, Lt: 3})
+	req = <-requests
+	require.Len(t, strings.Split(req.signature, ","), 1)
+
+	_, err = registry.RotateSecret("unknown", time.Hour)
+	require.ErrorIs(t, err, ErrNotFound)
+}
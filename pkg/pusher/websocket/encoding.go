@@ -0,0 +1,113 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// subprotocolJSON is the default encoding, messages are sent as JSON text frames.
+	subprotocolJSON = "json"
+	// subprotocolCBOR is negotiated by clients that prefer CBOR binary frames,
+	// which are smaller and cheaper to parse for high-frequency consumers.
+	subprotocolCBOR = "cbor"
+)
+
+// cborResponse mirrors JsonRPCResponse with a result and params re-encoded as CBOR,
+// so a client decodes a whole message with a single CBOR decoder.
+type cborResponse struct {
+	ID      uint64          `cbor:"id,omitempty"`
+	JSONRPC string          `cbor:"jsonrpc,omitempty"`
+	Method  string          `cbor:"method,omitempty"`
+	Result  cbor.RawMessage `cbor:"result,omitempty"`
+	Params  cbor.RawMessage `cbor:"params,omitempty"`
+	Error   *JsonRPCError   `cbor:"error,omitempty"`
+}
+
+// writeMessage sends the response encoded according to a subprotocol negotiated by the client.
+func writeMessage(conn *websocket.Conn, response JsonRPCResponse) error {
+	if conn.Subprotocol() != subprotocolCBOR {
+		return conn.WriteJSON(response)
+	}
+	msg, err := encodeCBOR(response)
+	if err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.BinaryMessage, msg)
+}
+
+// readRequest decodes a request from a CBOR binary frame or a JSON text frame,
+// clients negotiating CBOR are free to send requests in either encoding.
+func readRequest(msgType int, msg []byte) (JsonRPCRequest, error) {
+	var request JsonRPCRequest
+	if msgType == websocket.BinaryMessage {
+		err := cbor.Unmarshal(msg, &request)
+		return request, err
+	}
+	err := json.Unmarshal(msg, &request)
+	return request, err
+}
+
+func encodeCBOR(response JsonRPCResponse) ([]byte, error) {
+	result, err := jsonToCBOR(response.Result)
+	if err != nil {
+		return nil, err
+	}
+	params, err := jsonToCBOR(response.Params)
+	if err != nil {
+		return nil, err
+	}
+	return cbor.Marshal(cborResponse{
+		ID:      response.ID,
+		JSONRPC: response.JSONRPC,
+		Method:  response.Method,
+		Result:  result,
+		Params:  params,
+		Error:   response.Error,
+	})
+}
+
+// jsonToCBOR re-encodes a JSON value as CBOR.
+// Integers keep their precision, only numbers with a fraction or an exponent become floats.
+func jsonToCBOR(raw json.RawMessage) (cbor.RawMessage, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return cbor.Marshal(convertNumbers(value))
+}
+
+func convertNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = convertNumbers(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = convertNumbers(item)
+		}
+		return v
+	case json.Number:
+		if i, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return u
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return value
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/gorilla/websocket"
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/metrics"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
 	"go.uber.org/zap"
@@ -14,7 +15,9 @@ import (
 )
 
 var (
-	upgrader websocket.Upgrader // use default options
+	// upgrader prefers CBOR when a client accepts it,
+	// a client requesting no subprotocol gets JSON.
+	upgrader = websocket.Upgrader{Subprotocols: []string{subprotocolCBOR, subprotocolJSON}}
 )
 
 type JsonRPCRequest struct {
@@ -30,6 +33,15 @@ type JsonRPCResponse struct {
 	Method  string          `json:"method,omitempty"`
 	Result  json.RawMessage `json:"result,omitempty"`
 	Params  json.RawMessage `json:"params,omitempty"`
+	Error   *JsonRPCError   `json:"error,omitempty"`
+}
+
+// JsonRPCError is returned instead of a result when a request is rejected.
+// Data carries the same error envelope as HTTP endpoints, so clients can branch on its error code.
+type JsonRPCError struct {
+	Code    int              `json:"code"`
+	Message string           `json:"message"`
+	Data    errcode.Response `json:"data"`
 }
 
 func Handler(logger *zap.Logger, txSource sources.TransactionSource, traceSource sources.TraceSource, mempool sources.MemPoolSource, blockSource sources.BlockHeadersSource) func(http.ResponseWriter, *http.Request, int, bool) error {
@@ -49,15 +61,15 @@ func Handler(logger *zap.Logger, txSource sources.TransactionSource, traceSource
 		session := newSession(logger, txSource, traceSource, mempool, blockSource, conn)
 		requestCh := session.Run(ctx)
 		for {
-			_, msg, err := conn.ReadMessage()
+			msgType, msg, err := conn.ReadMessage()
 			if err != nil {
 				if websocket.IsCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 					return nil
 				}
 				return err
 			}
-			var request JsonRPCRequest
-			if err = json.Unmarshal(msg, &request); err != nil {
+			request, err := readRequest(msgType, msg)
+			if err != nil {
 				logger.Error("request unmarshalling error", zap.Error(err))
 				return err
 			}
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -331,3 +332,63 @@ func TestHandler_UnsubscribeMethods(t *testing.T) {
 	require.True(t, traceUnsubscribed.Load())
 	require.True(t, blockUnsubscribed.Load())
 }
+
+func TestHandler_CBORSubprotocol(t *testing.T) {
+	deliveryCh := make(chan sources.DeliveryFn, 1)
+	source := &mockTxSource{
+		OnSubscribeToTransactions: func(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTransactionsOptions) sources.CancelFn {
+			deliveryCh <- deliveryFn
+			return func() {}
+		},
+	}
+	logger, _ := zap.NewDevelopment()
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		handler := Handler(logger, source, nil, nil, nil)
+		err := handler(writer, request, 0, false)
+		require.Nil(t, err)
+	}))
+	defer server.Close()
+
+	url := strings.Replace(server.URL, "http", "ws", -1)
+	dialer := websocket.Dialer{Subprotocols: []string{"cbor", "json"}}
+	conn, _, err := dialer.Dial(url, nil)
+	require.Nil(t, err)
+	defer conn.Close()
+	require.Equal(t, "cbor", conn.Subprotocol())
+
+	request, err := cbor.Marshal(JsonRPCRequest{
+		ID:      1,
+		JSONRPC: "2.0",
+		Method:  "subscribe_account",
+		Params:  []string{"0:5555555555555555555555555555555555555555555555555555555555555555"},
+	})
+	require.Nil(t, err)
+	require.Nil(t, conn.WriteMessage(websocket.BinaryMessage, request))
+
+	type response struct {
+		ID     uint64         `cbor:"id"`
+		Method string         `cbor:"method"`
+		Result string         `cbor:"result"`
+		Params map[string]any `cbor:"params"`
+	}
+	msgType, msg, err := conn.ReadMessage()
+	require.Nil(t, err)
+	require.Equal(t, websocket.BinaryMessage, msgType)
+	var resp response
+	require.Nil(t, cbor.Unmarshal(msg, &resp))
+	require.Equal(t, response{ID: 1, Method: "subscribe_account", Result: "success! 1 new subscriptions created"}, resp)
+
+	deliveryFn := <-deliveryCh
+	deliveryFn([]byte(`{"account_id":"0:5555555555555555555555555555555555555555555555555555555555555555","lt":48312740000001,"tx_hash":"abc"}`))
+	msgType, msg, err = conn.ReadMessage()
+	require.Nil(t, err)
+	require.Equal(t, websocket.BinaryMessage, msgType)
+	resp = response{}
+	require.Nil(t, cbor.Unmarshal(msg, &resp))
+	require.Equal(t, "account_transaction", resp.Method)
+	require.Equal(t, map[string]any{
+		"account_id": "0:5555555555555555555555555555555555555555555555555555555555555555",
+		"lt":         uint64(48312740000001),
+		"tx_hash":    "abc",
+	}, resp.Params)
+}
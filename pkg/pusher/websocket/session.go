@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -13,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/tonkeeper/opentonapi/internal/g"
+	"github.com/tonkeeper/opentonapi/pkg/errcode"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/events"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/metrics"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
@@ -21,6 +23,9 @@ import (
 
 const subscriptionLimit = 1000000 // limitation of subscription by connection
 
+// errorCodeLimitExceeded is a JSON-RPC error code of requests rejected by utils.Limits.
+const errorCodeLimitExceeded = -32001
+
 // session is a light-weight implementation of JSON-RPC protocol over an HTTP connection from a client.
 type session struct {
 	logger              *zap.Logger
@@ -31,11 +36,16 @@ type session struct {
 	blockSource         sources.BlockHeadersSource
 	eventCh             chan event
 	txSubscriptions     map[tongo.AccountID]sources.CancelFn
+	opSubscription      sources.CancelFn
+	statusSubscriptions map[tongo.AccountID]sources.CancelFn
 	traceSubscriptions  map[tongo.AccountID]sources.CancelFn
+	logSubscriptions    map[tongo.AccountID]sources.CancelFn
 	mempoolSubscription sources.CancelFn
 	blockSubscription   sources.CancelFn
 	pingInterval        time.Duration
 	subscriptionLimit   int
+	// swaps drops events delivered by both subscriptions of an account while applyDelta replaces one with another.
+	swaps swapFilter
 
 	droppedEvents int
 	totalEvents   int
@@ -49,17 +59,19 @@ type event struct {
 
 func newSession(logger *zap.Logger, txSource sources.TransactionSource, traceSource sources.TraceSource, mempool sources.MemPoolSource, blockSource sources.BlockHeadersSource, conn *websocket.Conn) *session {
 	return &session{
-		logger:             logger,
-		eventCh:            make(chan event, 2000),
-		conn:               conn,
-		mempool:            mempool,
-		txSource:           txSource,
-		blockSource:        blockSource,
-		txSubscriptions:    map[tongo.AccountID]sources.CancelFn{},
-		traceSource:        traceSource,
-		traceSubscriptions: map[tongo.AccountID]sources.CancelFn{},
-		pingInterval:       5 * time.Second,
-		subscriptionLimit:  subscriptionLimit,
+		logger:              logger,
+		eventCh:             make(chan event, 2000),
+		conn:                conn,
+		mempool:             mempool,
+		txSource:            txSource,
+		blockSource:         blockSource,
+		txSubscriptions:     map[tongo.AccountID]sources.CancelFn{},
+		statusSubscriptions: map[tongo.AccountID]sources.CancelFn{},
+		traceSource:         traceSource,
+		traceSubscriptions:  map[tongo.AccountID]sources.CancelFn{},
+		logSubscriptions:    map[tongo.AccountID]sources.CancelFn{},
+		pingInterval:        5 * time.Second,
+		subscriptionLimit:   subscriptionLimit,
 	}
 }
 
@@ -70,6 +82,15 @@ func (s *session) cancel() {
 	for _, cancelFn := range s.traceSubscriptions {
 		cancelFn()
 	}
+	if s.opSubscription != nil {
+		s.opSubscription()
+	}
+	for _, cancelFn := range s.statusSubscriptions {
+		cancelFn()
+	}
+	for _, cancelFn := range s.logSubscriptions {
+		cancelFn()
+	}
 	if s.mempoolSubscription != nil {
 		s.mempoolSubscription()
 	}
@@ -80,11 +101,22 @@ func (s *session) Run(ctx context.Context) chan JsonRPCRequest {
 	go func() {
 		defer s.cancel()
 
+		draining := utils.DrainingFromContext(ctx)
 		for {
 			var err error
 			select {
 			case <-ctx.Done():
 				return
+			case <-draining:
+				// the notice is sent once, the connection goes on until the client reconnects elsewhere.
+				draining = nil
+				params, _ := json.Marshal(utils.ShutdownNoticeFromContext(ctx))
+				metrics.WebsocketEventSent(events.ShutdownEvent, utils.TokenNameFromContext(ctx))
+				err = writeMessage(s.conn, JsonRPCResponse{
+					JSONRPC: "2.0",
+					Method:  events.ShutdownEvent.String(),
+					Params:  params,
+				})
 			case e := <-s.eventCh:
 				response := JsonRPCResponse{
 					JSONRPC: "2.0",
@@ -92,8 +124,12 @@ func (s *session) Run(ctx context.Context) chan JsonRPCRequest {
 					Params:  e.Params,
 				}
 				metrics.WebsocketEventSent(e.Name, utils.TokenNameFromContext(ctx))
-				err = s.conn.WriteJSON(response)
+				err = writeMessage(s.conn, response)
 			case request := <-requestCh:
+				if limitErr := s.checkLimits(ctx, request); limitErr != nil {
+					err = s.writeError(limitErr, request)
+					break
+				}
 				var response string
 				switch request.Method {
 				// handle transaction subscriptions
@@ -101,6 +137,20 @@ func (s *session) Run(ctx context.Context) chan JsonRPCRequest {
 					response = s.subscribeToTransactions(ctx, request.Params)
 				case "unsubscribe_account":
 					response = s.unsubscribeFromTransactions(request.Params)
+				case "update_account":
+					response = s.updateTransactions(ctx, request.Params)
+				case "subscribe_operation":
+					response = s.subscribeToOperations(ctx, request.Params)
+				case "unsubscribe_operation":
+					response = s.unsubscribeFromOperations()
+				case "subscribe_account_status":
+					response = s.subscribeToAccountStatuses(ctx, request.Params)
+				case "unsubscribe_account_status":
+					response = s.unsubscribeFromAccountStatuses(request.Params)
+				case "subscribe_account_log":
+					response = s.subscribeToLogs(ctx, request.Params)
+				case "unsubscribe_account_log":
+					response = s.unsubscribeFromLogs(request.Params)
 
 				// handle mempool subscriptions
 				case "subscribe_mempool":
@@ -113,6 +163,8 @@ func (s *session) Run(ctx context.Context) chan JsonRPCRequest {
 					response = s.subscribeToTraces(ctx, request.Params)
 				case "unsubscribe_trace":
 					response = s.unsubscribeFromTraces(request.Params)
+				case "update_trace":
+					response = s.updateTraces(ctx, request.Params)
 
 				// handle block subscriptions
 				case "subscribe_block":
@@ -147,51 +199,280 @@ func (s *session) sendEvent(e event) {
 	metrics.WebsocketDroppedEvents(e.Name, float64(s.droppedEvents)/float64(s.totalEvents)*100)
 }
 
+// subscriptions returns a number of subscriptions of the session,
+// every subscribed account counts as a separate subscription.
+func (s *session) subscriptions() int {
+	count := len(s.txSubscriptions) + len(s.statusSubscriptions) + len(s.traceSubscriptions) + len(s.logSubscriptions)
+	for _, cancelFn := range []sources.CancelFn{s.opSubscription, s.mempoolSubscription, s.blockSubscription} {
+		if cancelFn != nil {
+			count += 1
+		}
+	}
+	return count
+}
+
+// newAccounts returns a number of accounts in params without a subscription yet.
+// Invalid params are counted as well, they are reported by a subscribe method later.
+func newAccounts(params []string, subscriptions map[tongo.AccountID]sources.CancelFn) int {
+	var count int
+	for _, param := range params {
+		account, _, _ := strings.Cut(param, ";")
+		address, err := tongo.ParseAddress(account)
+		if err != nil {
+			count += 1
+			continue
+		}
+		if _, ok := subscriptions[address.ID]; !ok {
+			count += 1
+		}
+	}
+	return count
+}
+
+// checkLimits rejects a subscribe request exceeding utils.Limits of the connection.
+func (s *session) checkLimits(ctx context.Context, request JsonRPCRequest) error {
+	limits := utils.LimitsFromContext(ctx)
+	var accounts, added int
+	switch request.Method {
+	case "subscribe_account":
+		accounts, added = len(request.Params), newAccounts(request.Params, s.txSubscriptions)
+	case "subscribe_account_status":
+		accounts, added = len(request.Params), newAccounts(request.Params, s.statusSubscriptions)
+	case "subscribe_trace":
+		accounts, added = len(request.Params), newAccounts(request.Params, s.traceSubscriptions)
+	case "subscribe_account_log":
+		accounts, added = len(request.Params), newAccounts(request.Params, s.logSubscriptions)
+	case "update_account", "update_trace":
+		subscriptions := s.txSubscriptions
+		if request.Method == "update_trace" {
+			subscriptions = s.traceSubscriptions
+		}
+		delta, err := parseAccountDelta(request.Params, request.Method == "update_account")
+		if err != nil {
+			// the error is reported by the update method.
+			return nil
+		}
+		accounts = len(delta.add)
+		for account := range delta.add {
+			if _, ok := subscriptions[account]; !ok {
+				added += 1
+			}
+		}
+		// removed accounts free their slots within the same request.
+		for account := range delta.remove {
+			if _, ok := subscriptions[account]; ok {
+				added -= 1
+			}
+		}
+	case "subscribe_mempool":
+		if options, err := mempoolParamsToOptions(request.Params); err == nil {
+			accounts = len(options.Accounts)
+		}
+		if s.mempoolSubscription == nil {
+			added = 1
+		}
+	case "subscribe_operation":
+		if s.opSubscription == nil {
+			added = 1
+		}
+	case "subscribe_block":
+		if s.blockSubscription == nil {
+			added = 1
+		}
+	default:
+		return nil
+	}
+	if err := limits.CheckAccounts(accounts); err != nil {
+		return err
+	}
+	return limits.CheckSubscriptions(s.subscriptions(), added)
+}
+
+// accountDelta is a change of account subscriptions requested by update_account and update_trace.
+type accountDelta struct {
+	add    map[tongo.AccountID]accountOptions
+	remove map[tongo.AccountID]struct{}
+}
+
+// parseAccountDelta parses params of an update method: "+<account>" adds an account and "-<account>" removes it.
+// If operations are allowed, an added account can be followed by ";operations=<op1>,<op2>,..." as in subscribe_account.
+func parseAccountDelta(params []string, operations bool) (*accountDelta, error) {
+	delta := accountDelta{
+		add:    map[tongo.AccountID]accountOptions{},
+		remove: map[tongo.AccountID]struct{}{},
+	}
+	for _, param := range params {
+		if len(param) == 0 || (param[0] != '+' && param[0] != '-') {
+			return nil, fmt.Errorf("failed to process '%v' account: it must start with '+' or '-'", param)
+		}
+		if param[0] == '-' {
+			account, err := tongo.ParseAddress(param[1:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to process '%v' account: %v", param, err)
+			}
+			delta.remove[account.ID] = struct{}{}
+			continue
+		}
+		if !operations {
+			account, err := tongo.ParseAddress(param[1:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to process '%v' account: %v", param, err)
+			}
+			delta.add[account.ID] = accountOptions{Account: account.ID}
+			continue
+		}
+		options, err := processAccountTxParam(param[1:])
+		if err != nil {
+			return nil, err
+		}
+		delta.add[options.Account] = *options
+	}
+	for account := range delta.add {
+		if _, ok := delta.remove[account]; ok {
+			return nil, fmt.Errorf("account '%v' is both added and removed", account.ToRaw())
+		}
+	}
+	return &delta, nil
+}
+
+// applyDelta checks the delta against the tenant scope and the subscription limit,
+// and only then replaces subscriptions of removed and added accounts,
+// so either the whole delta is applied or the subscriptions stay as they were.
+// An added account that is already subscribed gets its subscription replaced, e.g. with new operations,
+// the new subscription is created before the old one is canceled, so no event is missed in between,
+// and events delivered by both of them are sent once, see swapFilter.
+func (s *session) applyDelta(ctx context.Context, delta *accountDelta, subscriptions map[tongo.AccountID]sources.CancelFn, subscribe func(options accountOptions) sources.CancelFn) string {
+	added := make([]tongo.AccountID, 0, len(delta.add))
+	for account := range delta.add {
+		added = append(added, account)
+	}
+	if _, _, err := utils.ScopeAccounts(ctx, added, false); err != nil {
+		return err.Error()
+	}
+	total := len(subscriptions)
+	for account := range delta.add {
+		if _, ok := subscriptions[account]; !ok {
+			total += 1
+		}
+	}
+	for account := range delta.remove {
+		if _, ok := subscriptions[account]; ok {
+			total -= 1
+		}
+	}
+	if total > s.subscriptionLimit {
+		return fmt.Sprintf("you have reached the limit of %v subscriptions", s.subscriptionLimit)
+	}
+	var removedCounter int
+	for account := range delta.remove {
+		if cancelFn, ok := subscriptions[account]; ok {
+			cancelFn()
+			delete(subscriptions, account)
+			removedCounter += 1
+		}
+	}
+	s.swaps.begin()
+	defer s.swaps.end()
+	for account, options := range delta.add {
+		cancel := subscribe(options)
+		if cancelFn, ok := subscriptions[account]; ok {
+			cancelFn()
+		}
+		subscriptions[account] = cancel
+	}
+	return fmt.Sprintf("success! %v subscription(s) added or updated, %v removed", len(delta.add), removedCounter)
+}
+
+// swapFilter remembers events delivered while subscriptions are being replaced,
+// so an event of a transaction or a trace delivered by both the old and the new subscription is sent once.
+// A source delivers the same payload to all subscriptions matching an event, so the payload identifies the event.
+type swapFilter struct {
+	mu     sync.Mutex
+	active int
+	seen   map[string]struct{}
+}
+
+func (f *swapFilter) begin() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.active += 1
+	if f.seen == nil {
+		f.seen = map[string]struct{}{}
+	}
+}
+
+func (f *swapFilter) end() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.active -= 1
+	if f.active == 0 {
+		f.seen = nil
+	}
+}
+
+// firstDelivery reports whether the event hasn't been delivered during the ongoing replacement yet.
+func (f *swapFilter) firstDelivery(eventData []byte) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.active == 0 {
+		return true
+	}
+	if _, ok := f.seen[string(eventData)]; ok {
+		return false
+	}
+	f.seen[string(eventData)] = struct{}{}
+	return true
+}
+
 type accountOptions struct {
 	Account    tongo.AccountID
 	Operations []string
+	// FromLt asks to replay indexed transactions starting with this logical time before live ones.
+	FromLt uint64
 }
 
 func (opts *accountOptions) AllOperations() bool {
 	return len(opts.Operations) == 0
 }
 
+// processAccountTxParam parses "<accountID>" optionally followed by ";operations=<op1>,<op2>,..." and ";from_lt=<lt>".
 func processAccountTxParam(param string) (*accountOptions, error) {
 	parts := strings.Split(param, ";")
 	if len(parts) == 0 {
 		return nil, fmt.Errorf("invalid format: '%v'", param)
 	}
-	if len(parts) == 1 {
-		account, err := tongo.ParseAddress(param)
-		if err != nil {
-			return nil, fmt.Errorf("failed to process '%v' account: %v", param, err)
-		}
-		return &accountOptions{Account: account.ID}, nil
-	}
-	if len(parts) > 2 {
-		return nil, fmt.Errorf("failed to process '%v' account: invalid format", param)
-	}
 	account, err := tongo.ParseAddress(parts[0])
 	if err != nil {
 		return nil, fmt.Errorf("failed to process '%v' account: %v", param, err)
 	}
-	opParts := strings.Split(parts[1], "=")
-	if len(opParts) != 2 {
-		return nil, fmt.Errorf("failed to process '%v' account: invalid format", param)
-	}
-	if strings.ToLower(opParts[0]) != "operations" {
-		return nil, fmt.Errorf("failed to process '%v' account: invalid format", param)
-	}
-	operations := strings.Split(opParts[1], ",")
-	if len(operations) == 0 || len(opParts[1]) == 0 {
-		return &accountOptions{Account: account.ID}, nil
+	options := accountOptions{Account: account.ID}
+	for _, part := range parts[1:] {
+		keyValue := strings.Split(part, "=")
+		if len(keyValue) != 2 {
+			return nil, fmt.Errorf("failed to process '%v' account: invalid format", param)
+		}
+		switch strings.ToLower(keyValue[0]) {
+		case "operations":
+			if len(keyValue[1]) > 0 {
+				options.Operations = strings.Split(keyValue[1], ",")
+			}
+		case "from_lt":
+			fromLt, err := strconv.ParseUint(keyValue[1], 10, 64)
+			if err != nil || fromLt == 0 {
+				return nil, fmt.Errorf("failed to process '%v' account: invalid from_lt", param)
+			}
+			options.FromLt = fromLt
+		default:
+			return nil, fmt.Errorf("failed to process '%v' account: invalid format", param)
+		}
 	}
-	return &accountOptions{Account: account.ID, Operations: operations}, nil
+	return &options, nil
 }
 
 // subscribeToTransactions subscribes to transactions for the specified accounts.
 // Each param should be in the following format: "<accountID>;operations=<op1>,<op2>,..."
 // if there is no ";operations=" part, a given account will be subscribed to all operations.
+// With ";from_lt=<lt>", indexed transactions of the account starting with the lt are replayed before live ones.
 func (s *session) subscribeToTransactions(ctx context.Context, params []string) string {
 	if s.txSource == nil {
 		return fmt.Sprintf("transactions source is not configured")
@@ -204,6 +485,11 @@ func (s *session) subscribeToTransactions(ctx context.Context, params []string)
 		}
 		accounts[options.Account] = *options
 	}
+	for account := range accounts {
+		if _, _, err := utils.ScopeAccounts(ctx, []tongo.AccountID{account}, false); err != nil {
+			return err.Error()
+		}
+	}
 	if len(s.txSubscriptions)+len(accounts) > s.subscriptionLimit {
 		return fmt.Sprintf("you have reached the limit of %v subscriptions", s.subscriptionLimit)
 	}
@@ -216,6 +502,7 @@ func (s *session) subscribeToTransactions(ctx context.Context, params []string)
 			Accounts:      []tongo.AccountID{account},
 			Operations:    accountOptions.Operations,
 			AllOperations: accountOptions.AllOperations(),
+			FromLt:        accountOptions.FromLt,
 		}
 		cancel := s.txSource.SubscribeToTransactions(ctx, func(eventData []byte) {
 			s.sendEvent(event{
@@ -246,6 +533,207 @@ func (s *session) unsubscribeFromTransactions(params []string) string {
 	return fmt.Sprintf("success! %v subscription(s) removed", counter)
 }
 
+// updateTransactions adds and removes accounts of transaction subscriptions in a single request,
+// so a client with a growing list of accounts doesn't have to resubscribe to the whole list.
+// Each param is either "+<accountID>;operations=<op1>,<op2>,..." with an optional ";operations=" part or "-<accountID>".
+func (s *session) updateTransactions(ctx context.Context, params []string) string {
+	if s.txSource == nil {
+		return fmt.Sprintf("transactions source is not configured")
+	}
+	delta, err := parseAccountDelta(params, true)
+	if err != nil {
+		return err.Error()
+	}
+	return s.applyDelta(ctx, delta, s.txSubscriptions, func(accountOptions accountOptions) sources.CancelFn {
+		options := sources.SubscribeToTransactionsOptions{
+			Accounts:      []tongo.AccountID{accountOptions.Account},
+			Operations:    accountOptions.Operations,
+			AllOperations: accountOptions.AllOperations(),
+			FromLt:        accountOptions.FromLt,
+		}
+		return s.txSource.SubscribeToTransactions(ctx, func(eventData []byte) {
+			if !s.swaps.firstDelivery(eventData) {
+				return
+			}
+			s.sendEvent(event{
+				Name:   events.AccountTxEvent,
+				Method: "account_transaction",
+				Params: eventData,
+			})
+		}, options)
+	})
+}
+
+// subscribeToOperations subscribes to transactions of all accounts
+// whose inbound or outbound message carries one of the given operations.
+// Each param is either an operation name like "JettonTransfer" or an opcode like "0x0f8a7ea5".
+// A new call replaces the previous list of operations.
+func (s *session) subscribeToOperations(ctx context.Context, params []string) string {
+	if s.txSource == nil {
+		return fmt.Sprintf("transactions source is not configured")
+	}
+	if len(params) == 0 {
+		return fmt.Sprintf("at least one operation is required")
+	}
+	operations := make([]string, 0, len(params))
+	for _, param := range params {
+		op, err := sources.ParseOperation(param)
+		if err != nil {
+			return fmt.Sprintf("failed to process '%v' operation: %v", param, err)
+		}
+		operations = append(operations, op)
+	}
+	// a tenant gets transactions of its watched accounts only.
+	accounts, allAccounts, err := utils.ScopeAccounts(ctx, nil, true)
+	if err != nil {
+		return err.Error()
+	}
+	if s.opSubscription != nil {
+		s.opSubscription()
+	}
+	s.opSubscription = s.txSource.SubscribeToTransactions(ctx, func(eventData []byte) {
+		s.sendEvent(event{
+			Name:   events.AccountTxEvent,
+			Method: "account_transaction",
+			Params: eventData,
+		})
+	}, sources.SubscribeToTransactionsOptions{
+		Accounts:    accounts,
+		AllAccounts: allAccounts,
+		Operations:  operations,
+	})
+	return fmt.Sprintf("success! you have subscribed to %v operation(s)", len(operations))
+}
+
+func (s *session) unsubscribeFromOperations() string {
+	if s.opSubscription == nil {
+		return fmt.Sprintf("you are not subscribed to operations")
+	}
+	s.opSubscription()
+	s.opSubscription = nil
+	return fmt.Sprintf("success! you have unsubscribed from operations")
+}
+
+// subscribeToAccountStatuses subscribes to transactions changing the status of the specified accounts.
+func (s *session) subscribeToAccountStatuses(ctx context.Context, params []string) string {
+	if s.txSource == nil {
+		return fmt.Sprintf("transactions source is not configured")
+	}
+	accounts := make([]tongo.AccountID, 0, len(params))
+	for _, a := range params {
+		account, err := tongo.ParseAddress(a)
+		if err != nil {
+			return fmt.Sprintf("failed to process '%v' account: %v", a, err)
+		}
+		accounts = append(accounts, account.ID)
+	}
+	if _, _, err := utils.ScopeAccounts(ctx, accounts, false); err != nil {
+		return err.Error()
+	}
+	if len(s.statusSubscriptions)+len(accounts) > s.subscriptionLimit {
+		return fmt.Sprintf("you have reached the limit of %v subscriptions", s.subscriptionLimit)
+	}
+	var counter int
+	for _, account := range accounts {
+		if _, ok := s.statusSubscriptions[account]; ok {
+			continue
+		}
+		options := sources.SubscribeToTransactionsOptions{
+			Accounts:          []tongo.AccountID{account},
+			AllOperations:     true,
+			StatusChangesOnly: true,
+		}
+		cancel := s.txSource.SubscribeToTransactions(ctx, func(eventData []byte) {
+			s.sendEvent(event{
+				Name:   events.AccountStatusEvent,
+				Method: "account_status",
+				Params: eventData,
+			})
+		}, options)
+		s.statusSubscriptions[account] = cancel
+		counter += 1
+	}
+	return fmt.Sprintf("success! %v new subscriptions created", counter)
+}
+
+func (s *session) unsubscribeFromAccountStatuses(params []string) string {
+	var counter int
+	for _, a := range params {
+		account, err := tongo.ParseAddress(a)
+		if err != nil {
+			return fmt.Sprintf("failed to process '%v' account: %v", a, err)
+		}
+		if cancelFn, ok := s.statusSubscriptions[account.ID]; ok {
+			cancelFn()
+			delete(s.statusSubscriptions, account.ID)
+			counter += 1
+		}
+	}
+	return fmt.Sprintf("success! %v subscription(s) removed", counter)
+}
+
+// subscribeToLogs subscribes to external outbound messages emitted by accounts,
+// as in subscribe_account an account can be followed by ";operations=<op1>,<op2>,...".
+func (s *session) subscribeToLogs(ctx context.Context, params []string) string {
+	if s.txSource == nil {
+		return fmt.Sprintf("transactions source is not configured")
+	}
+	accounts := make(map[tongo.AccountID]accountOptions, len(params))
+	for _, param := range params {
+		options, err := processAccountTxParam(param)
+		if err != nil {
+			return err.Error()
+		}
+		accounts[options.Account] = *options
+	}
+	for account := range accounts {
+		if _, _, err := utils.ScopeAccounts(ctx, []tongo.AccountID{account}, false); err != nil {
+			return err.Error()
+		}
+	}
+	if len(s.logSubscriptions)+len(accounts) > s.subscriptionLimit {
+		return fmt.Sprintf("you have reached the limit of %v subscriptions", s.subscriptionLimit)
+	}
+	var counter int
+	for account, accountOptions := range accounts {
+		if _, ok := s.logSubscriptions[account]; ok {
+			continue
+		}
+		options := sources.SubscribeToTransactionsOptions{
+			Accounts:           []tongo.AccountID{account},
+			Operations:         accountOptions.Operations,
+			AllOperations:      accountOptions.AllOperations(),
+			ExtOutMessagesOnly: true,
+		}
+		cancel := s.txSource.SubscribeToTransactions(ctx, func(eventData []byte) {
+			s.sendEvent(event{
+				Name:   events.AccountLogEvent,
+				Method: "account_log",
+				Params: eventData,
+			})
+		}, options)
+		s.logSubscriptions[account] = cancel
+		counter += 1
+	}
+	return fmt.Sprintf("success! %v new subscriptions created", counter)
+}
+
+func (s *session) unsubscribeFromLogs(params []string) string {
+	var counter int
+	for _, a := range params {
+		account, err := tongo.ParseAddress(a)
+		if err != nil {
+			return fmt.Sprintf("failed to process '%v' account: %v", a, err)
+		}
+		if cancelFn, ok := s.logSubscriptions[account.ID]; ok {
+			cancelFn()
+			delete(s.logSubscriptions, account.ID)
+			counter += 1
+		}
+	}
+	return fmt.Sprintf("success! %v subscription(s) removed", counter)
+}
+
 func (s *session) subscribeToTraces(ctx context.Context, params []string) string {
 	if s.traceSource == nil {
 		return fmt.Sprintf("trace source is not configured")
@@ -258,6 +746,9 @@ func (s *session) subscribeToTraces(ctx context.Context, params []string) string
 		}
 		accounts = append(accounts, account.ID)
 	}
+	if _, _, err := utils.ScopeAccounts(ctx, accounts, false); err != nil {
+		return err.Error()
+	}
 	if len(s.traceSubscriptions)+len(accounts) > s.subscriptionLimit {
 		return fmt.Sprintf("you have reached the limit of %v subscriptions", s.subscriptionLimit)
 	}
@@ -298,6 +789,33 @@ func (s *session) unsubscribeFromTraces(params []string) string {
 	return fmt.Sprintf("success! %v subscription(s) removed", counter)
 }
 
+// updateTraces adds and removes accounts of trace subscriptions in a single request.
+// Each param is either "+<accountID>" or "-<accountID>".
+func (s *session) updateTraces(ctx context.Context, params []string) string {
+	if s.traceSource == nil {
+		return fmt.Sprintf("trace source is not configured")
+	}
+	delta, err := parseAccountDelta(params, false)
+	if err != nil {
+		return err.Error()
+	}
+	return s.applyDelta(ctx, delta, s.traceSubscriptions, func(accountOptions accountOptions) sources.CancelFn {
+		options := sources.SubscribeToTraceOptions{
+			Accounts: []tongo.AccountID{accountOptions.Account},
+		}
+		return s.traceSource.SubscribeToTraces(ctx, func(eventData []byte) {
+			if !s.swaps.firstDelivery(eventData) {
+				return
+			}
+			s.sendEvent(event{
+				Name:   events.TraceEvent,
+				Method: "trace",
+				Params: eventData,
+			})
+		}, options)
+	})
+}
+
 func mempoolParamsToOptions(params []string) (*sources.SubscribeToMempoolOptions, error) {
 	if len(params) == 0 {
 		return &sources.SubscribeToMempoolOptions{}, nil
@@ -335,6 +853,10 @@ func (s *session) subscribeToMempool(ctx context.Context, params []string) strin
 	if err != nil {
 		return err.Error()
 	}
+	options.Accounts, _, err = utils.ScopeAccounts(ctx, options.Accounts, len(options.Accounts) == 0)
+	if err != nil {
+		return err.Error()
+	}
 	cancelFn, err := s.mempool.SubscribeToMessages(ctx, func(eventData []byte) {
 		s.sendEvent(event{Method: "mempool_message", Params: eventData, Name: events.MempoolEvent})
 	}, *options)
@@ -422,10 +944,24 @@ func jsonRPCResponseMessage(message string, id uint64, jsonrpc, method string) (
 	return resp, nil
 }
 
+// writeError rejects the request with a structured error.
+func (s *session) writeError(limitErr error, request JsonRPCRequest) error {
+	return writeMessage(s.conn, JsonRPCResponse{
+		ID:      request.ID,
+		JSONRPC: request.JSONRPC,
+		Method:  request.Method,
+		Error: &JsonRPCError{
+			Code:    errorCodeLimitExceeded,
+			Message: limitErr.Error(),
+			Data:    errcode.Response{Error: limitErr.Error(), ErrorCode: errcode.SubscriptionLimitExceeded},
+		},
+	})
+}
+
 func (s *session) writeResponse(message string, request JsonRPCRequest) error {
 	resp, err := jsonRPCResponseMessage(message, request.ID, request.JSONRPC, request.Method)
 	if err != nil {
 		return err
 	}
-	return s.conn.WriteJSON(resp)
+	return writeMessage(s.conn, resp)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/utils"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/ton"
 	"go.uber.org/zap"
@@ -100,6 +101,119 @@ func Test_session_subscribeToTransactions(t *testing.T) {
 	}
 }
 
+func Test_session_checkLimits(t *testing.T) {
+	account := "0:5555555555555555555555555555555555555555555555555555555555555555"
+	subscribed := tongo.MustParseAccountID("-1:5555555555555555555555555555555555555555555555555555555555555555")
+	tests := []struct {
+		name    string
+		limits  utils.Limits
+		request JsonRPCRequest
+		wantErr bool
+	}{
+		{
+			name:    "no limits",
+			request: JsonRPCRequest{Method: "subscribe_account", Params: []string{account, account}},
+		},
+		{
+			name:    "too many accounts",
+			limits:  utils.Limits{MaxAccountsPerSubscription: 1},
+			request: JsonRPCRequest{Method: "subscribe_trace", Params: []string{account, account}},
+			wantErr: true,
+		},
+		{
+			name:    "too many subscriptions",
+			limits:  utils.Limits{MaxSubscriptionsPerConnection: 2},
+			request: JsonRPCRequest{Method: "subscribe_account_status", Params: []string{account}},
+			wantErr: true,
+		},
+		{
+			name:    "already subscribed accounts are not counted",
+			limits:  utils.Limits{MaxSubscriptionsPerConnection: 2},
+			request: JsonRPCRequest{Method: "subscribe_account", Params: []string{subscribed.ToRaw() + ";operations=JettonMint"}},
+		},
+		{
+			name:    "mempool accounts",
+			limits:  utils.Limits{MaxAccountsPerSubscription: 1},
+			request: JsonRPCRequest{Method: "subscribe_mempool", Params: []string{"accounts=" + account + "," + subscribed.ToRaw()}},
+			wantErr: true,
+		},
+		{
+			name:    "update frees slots of removed accounts",
+			limits:  utils.Limits{MaxSubscriptionsPerConnection: 2},
+			request: JsonRPCRequest{Method: "update_account", Params: []string{"+" + account, "-" + subscribed.ToRaw()}},
+		},
+		{
+			name:    "update exceeds limit",
+			limits:  utils.Limits{MaxSubscriptionsPerConnection: 2},
+			request: JsonRPCRequest{Method: "update_trace", Params: []string{"+" + account, "+" + subscribed.ToRaw()}},
+			wantErr: true,
+		},
+		{
+			name:    "unsubscribe is always allowed",
+			limits:  utils.Limits{MaxSubscriptionsPerConnection: 1},
+			request: JsonRPCRequest{Method: "unsubscribe_account", Params: []string{subscribed.ToRaw()}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &session{
+				txSubscriptions:     map[tongo.AccountID]sources.CancelFn{subscribed: func() {}},
+				statusSubscriptions: map[tongo.AccountID]sources.CancelFn{},
+				traceSubscriptions:  map[tongo.AccountID]sources.CancelFn{},
+				blockSubscription:   func() {},
+			}
+			ctx := context.WithValue(context.Background(), utils.LimitsKey, tt.limits)
+			err := s.checkLimits(ctx, tt.request)
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_session_subscribeToOperations(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      []string
+		want        string
+		wantOptions []sources.SubscribeToTransactionsOptions
+	}{
+		{
+			name:   "all good",
+			params: []string{"JettonNotify", "0X7362D09C"},
+			want:   `success! you have subscribed to 2 operation(s)`,
+			wantOptions: []sources.SubscribeToTransactionsOptions{
+				{AllAccounts: true, Operations: []string{"JettonNotify", "0x7362d09c"}},
+			},
+		},
+		{
+			name:   "invalid opcode",
+			params: []string{"0x7362d09c00"},
+			want:   `failed to process '0x7362d09c00' operation: invalid opcode '0x7362d09c00'`,
+		},
+		{
+			name: "no operations",
+			want: `at least one operation is required`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var options []sources.SubscribeToTransactionsOptions
+			s := &session{
+				eventCh: make(chan event, 10),
+				txSource: &mockTxSource{
+					OnSubscribeToTransactions: func(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTransactionsOptions) sources.CancelFn {
+						options = append(options, opts)
+						return func() {}
+					},
+				},
+			}
+			msg := s.subscribeToOperations(context.Background(), tt.params)
+			require.Equal(t, tt.want, msg)
+			require.Equal(t, tt.wantOptions, options)
+			require.Equal(t, tt.wantOptions != nil, s.opSubscription != nil)
+		})
+	}
+}
+
 func Test_session_sendEvent(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -175,6 +289,20 @@ func Test_processParam(t *testing.T) {
 				Account: ton.MustParseAccountID("-1:5555555555555555555555555555555555555555555555555555555555555555"),
 			},
 		},
+		{
+			name:  "param contains an account with operations and lt to catch up from",
+			param: "-1:5555555555555555555555555555555555555555555555555555555555555555;operations=JettonBurn;from_lt=47000000000001",
+			want: &accountOptions{
+				Account:    ton.MustParseAccountID("-1:5555555555555555555555555555555555555555555555555555555555555555"),
+				Operations: []string{"JettonBurn"},
+				FromLt:     47000000000001,
+			},
+		},
+		{
+			name:    "param contains an account with malformed lt",
+			param:   "-1:5555555555555555555555555555555555555555555555555555555555555555;from_lt=latest",
+			wantErr: true,
+		},
 		{
 			name:    "param contains an account with malformed operations",
 			param:   "-1:5555555555555555555555555555555555555555555555555555555555555555;ops=JettonBurn,0x00112233,JettonMint",
@@ -287,3 +415,149 @@ func Test_session_subscribeToBlocks(t *testing.T) {
 		})
 	}
 }
+
+func Test_session_updateTransactions(t *testing.T) {
+	first := tongo.MustParseAccountID("-1:5555555555555555555555555555555555555555555555555555555555555555")
+	second := tongo.MustParseAccountID("0:5555555555555555555555555555555555555555555555555555555555555555")
+	third := tongo.MustParseAccountID("0:3333333333333333333333333333333333333333333333333333333333333333")
+	tests := []struct {
+		name              string
+		subscriptionLimit int
+		params            []string
+		want              string
+		wantSubscriptions []tongo.AccountID
+		wantCanceled      []tongo.AccountID
+		wantOptions       []sources.SubscribeToTransactionsOptions
+	}{
+		{
+			name:              "add and remove",
+			subscriptionLimit: 2,
+			params:            []string{"+" + third.ToRaw(), "-" + first.ToRaw()},
+			want:              "success! 1 subscription(s) added or updated, 1 removed",
+			wantSubscriptions: []tongo.AccountID{second, third},
+			wantCanceled:      []tongo.AccountID{first},
+			wantOptions: []sources.SubscribeToTransactionsOptions{
+				{Accounts: []tongo.AccountID{third}, AllOperations: true},
+			},
+		},
+		{
+			name:              "update operations",
+			subscriptionLimit: 2,
+			params:            []string{"+" + second.ToRaw() + ";operations=JettonMint"},
+			want:              "success! 1 subscription(s) added or updated, 0 removed",
+			wantSubscriptions: []tongo.AccountID{first, second},
+			wantCanceled:      []tongo.AccountID{second},
+			wantOptions: []sources.SubscribeToTransactionsOptions{
+				{Accounts: []tongo.AccountID{second}, Operations: []string{"JettonMint"}},
+			},
+		},
+		{
+			name:              "limit is checked before anything is removed",
+			subscriptionLimit: 2,
+			params:            []string{"-" + first.ToRaw(), "+" + third.ToRaw(), "+0:4444444444444444444444444444444444444444444444444444444444444444"},
+			want:              "you have reached the limit of 2 subscriptions",
+			wantSubscriptions: []tongo.AccountID{first, second},
+		},
+		{
+			name:              "invalid param",
+			subscriptionLimit: 2,
+			params:            []string{"-" + first.ToRaw(), third.ToRaw()},
+			want:              "failed to process '0:3333333333333333333333333333333333333333333333333333333333333333' account: it must start with '+' or '-'",
+			wantSubscriptions: []tongo.AccountID{first, second},
+		},
+		{
+			name:              "added and removed",
+			subscriptionLimit: 2,
+			params:            []string{"-" + third.ToRaw(), "+" + third.ToRaw()},
+			want:              "account '0:3333333333333333333333333333333333333333333333333333333333333333' is both added and removed",
+			wantSubscriptions: []tongo.AccountID{first, second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var options []sources.SubscribeToTransactionsOptions
+			var canceled []tongo.AccountID
+			cancelFn := func(account tongo.AccountID) sources.CancelFn {
+				return func() { canceled = append(canceled, account) }
+			}
+			s := &session{
+				eventCh: make(chan event, 10),
+				txSubscriptions: map[tongo.AccountID]sources.CancelFn{
+					first:  cancelFn(first),
+					second: cancelFn(second),
+				},
+				subscriptionLimit: tt.subscriptionLimit,
+				txSource: &mockTxSource{
+					OnSubscribeToTransactions: func(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTransactionsOptions) sources.CancelFn {
+						options = append(options, opts)
+						return func() {}
+					},
+				},
+			}
+			msg := s.updateTransactions(context.Background(), tt.params)
+			require.Equal(t, tt.want, msg)
+			var subs []tongo.AccountID
+			for sub := range s.txSubscriptions {
+				subs = append(subs, sub)
+			}
+			require.ElementsMatch(t, tt.wantSubscriptions, subs)
+			require.ElementsMatch(t, tt.wantCanceled, canceled)
+			require.Equal(t, tt.wantOptions, options)
+		})
+	}
+}
+
+func Test_session_updateTraces(t *testing.T) {
+	first := tongo.MustParseAccountID("-1:5555555555555555555555555555555555555555555555555555555555555555")
+	second := tongo.MustParseAccountID("0:5555555555555555555555555555555555555555555555555555555555555555")
+	var options []sources.SubscribeToTraceOptions
+	s := &session{
+		eventCh:            make(chan event, 10),
+		traceSubscriptions: map[tongo.AccountID]sources.CancelFn{first: func() {}},
+		subscriptionLimit:  10,
+		traceSource: &mockTraceSource{
+			OnSubscribeToTraces: func(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTraceOptions) sources.CancelFn {
+				options = append(options, opts)
+				return func() {}
+			},
+		},
+	}
+	msg := s.updateTraces(context.Background(), []string{"+" + second.ToRaw() + ";operations=JettonMint"})
+	require.Contains(t, msg, "failed to process")
+	require.Empty(t, options)
+
+	msg = s.updateTraces(context.Background(), []string{"+" + second.ToRaw(), "-" + first.ToRaw()})
+	require.Equal(t, "success! 1 subscription(s) added or updated, 1 removed", msg)
+	require.Equal(t, []sources.SubscribeToTraceOptions{{Accounts: []tongo.AccountID{second}}}, options)
+	require.Len(t, s.traceSubscriptions, 1)
+	require.NotNil(t, s.traceSubscriptions[second])
+}
+
+func Test_session_updateTransactions_swap(t *testing.T) {
+	account := tongo.MustParseAccountID("0:5555555555555555555555555555555555555555555555555555555555555555")
+	var deliveryFns []sources.DeliveryFn
+	s := &session{
+		eventCh:           make(chan event, 10),
+		txSubscriptions:   map[tongo.AccountID]sources.CancelFn{},
+		subscriptionLimit: 10,
+	}
+	s.txSource = &mockTxSource{
+		OnSubscribeToTransactions: func(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTransactionsOptions) sources.CancelFn {
+			deliveryFns = append(deliveryFns, deliveryFn)
+			if len(deliveryFns) == 2 {
+				// both the old and the new subscription get the transaction before the old one is canceled.
+				deliveryFns[0]([]byte(`{"lt":1}`))
+				deliveryFns[1]([]byte(`{"lt":1}`))
+			}
+			return func() {}
+		},
+	}
+	msg := s.updateTransactions(context.Background(), []string{"+" + account.ToRaw()})
+	require.Equal(t, "success! 1 subscription(s) added or updated, 0 removed", msg)
+	msg = s.updateTransactions(context.Background(), []string{"+" + account.ToRaw() + ";operations=JettonMint"})
+	require.Equal(t, "success! 1 subscription(s) added or updated, 0 removed", msg)
+	require.Len(t, s.eventCh, 1)
+
+	deliveryFns[1]([]byte(`{"lt":1}`))
+	require.Len(t, s.eventCh, 2)
+}
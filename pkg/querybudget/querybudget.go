@@ -0,0 +1,73 @@
+// Package querybudget caps a number of lite server round trips a single API request may trigger.
+//
+// A request handler attaches a Budget to the request context with NewContext,
+// storage methods call Spend before every lite server query walking the blockchain,
+// and stop walking once the budget is exhausted, so a pathological request ends up with a partial result
+// instead of flooding lite servers.
+package querybudget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrExhausted is returned by Spend when the request has used up its budget.
+var ErrExhausted = errors.New("lite server query budget exhausted")
+
+// Budget counts lite server queries of a single request.
+type Budget struct {
+	limit    int64
+	used     atomic.Int64
+	exceeded atomic.Bool
+}
+
+// Used returns a number of queries spent so far.
+func (b *Budget) Used() int {
+	return int(b.used.Load())
+}
+
+// Exceeded reports whether the request has been denied a query, so its result is partial.
+func (b *Budget) Exceeded() bool {
+	return b.exceeded.Load()
+}
+
+type contextKey struct{}
+
+// NewContext returns a context limited to the given number of lite server queries, a non-positive limit means no limit.
+// If the context has a budget already, its budget is returned, so a request has a single budget.
+func NewContext(ctx context.Context, limit int) (context.Context, *Budget) {
+	if b, ok := FromContext(ctx); ok {
+		return ctx, b
+	}
+	b := &Budget{limit: int64(limit)}
+	return context.WithValue(ctx, contextKey{}, b), b
+}
+
+// FromContext returns a budget of the request, if any.
+func FromContext(ctx context.Context) (*Budget, bool) {
+	b, ok := ctx.Value(contextKey{}).(*Budget)
+	return b, ok
+}
+
+// Spend takes a single query from the budget of the given context.
+// It returns ErrExhausted if there is nothing left, a context without a budget is never limited.
+func Spend(ctx context.Context) error {
+	b, ok := FromContext(ctx)
+	if !ok || b.limit <= 0 {
+		return nil
+	}
+	if b.used.Add(1) > b.limit {
+		b.used.Add(-1)
+		b.exceeded.Store(true)
+		return fmt.Errorf("%w: a request is limited to %v queries", ErrExhausted, b.limit)
+	}
+	return nil
+}
+
+// Exceeded reports whether the request of the given context has exhausted its budget.
+func Exceeded(ctx context.Context) bool {
+	b, ok := FromContext(ctx)
+	return ok && b.Exceeded()
+}
@@ -0,0 +1,53 @@
+package querybudget
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpend(t *testing.T) {
+	tests := []struct {
+		name         string
+		limit        int
+		queries      int
+		wantUsed     int
+		wantExceeded bool
+	}{
+		{name: "within budget", limit: 3, queries: 3, wantUsed: 3},
+		{name: "over budget", limit: 3, queries: 5, wantUsed: 3, wantExceeded: true},
+		{name: "no limit", limit: 0, queries: 5, wantUsed: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, budget := NewContext(context.Background(), tt.limit)
+			var failed int
+			for i := 0; i < tt.queries; i++ {
+				if err := Spend(ctx); err != nil {
+					require.ErrorIs(t, err, ErrExhausted)
+					failed++
+				}
+			}
+			require.Equal(t, tt.wantUsed, budget.Used())
+			require.Equal(t, tt.wantExceeded, budget.Exceeded())
+			require.Equal(t, tt.wantExceeded, Exceeded(ctx))
+			if tt.limit > 0 {
+				require.Equal(t, tt.queries-tt.wantUsed, failed)
+			}
+		})
+	}
+}
+
+func TestNewContext(t *testing.T) {
+	ctx, budget := NewContext(context.Background(), 1)
+	// a nested request shares the budget of the outer one.
+	nested, nestedBudget := NewContext(ctx, 100)
+	require.Same(t, budget, nestedBudget)
+	require.Nil(t, Spend(nested))
+	require.ErrorIs(t, Spend(ctx), ErrExhausted)
+
+	// a context without a budget is never limited.
+	require.Nil(t, Spend(context.Background()))
+	require.False(t, Exceeded(context.Background()))
+}
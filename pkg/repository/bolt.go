@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Bolt is a repository backed by a local bbolt file, every collection is a bucket.
+type Bolt struct {
+	db *bolt.DB
+}
+
+var _ Repository = (*Bolt)(nil)
+
+// OpenBolt opens the file creating it if it doesn't exist.
+func OpenBolt(path string) (*Bolt, error) {
+	// a second instance using the same file fails instead of waiting forever.
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &Bolt{db: db}, nil
+}
+
+func (b *Bolt) List(ctx context.Context, collection string) ([]Record, error) {
+	var records []Record
+	err := b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(collection))
+		if bucket == nil {
+			return nil
+		}
+		// keys are iterated in byte-sorted order.
+		return bucket.ForEach(func(k, v []byte) error {
+			// slices returned by bbolt are only valid during the transaction.
+			records = append(records, Record{Key: string(k), Value: append([]byte(nil), v...)})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (b *Bolt) Put(ctx context.Context, collection string, record Record) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(collection))
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(record.Key), record.Value)
+	})
+}
+
+func (b *Bolt) Delete(ctx context.Context, collection string, key string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(collection))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.Delete([]byte(key))
+	})
+}
+
+func (b *Bolt) Close() error {
+	return b.db.Close()
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// encryptedPrefix marks an encrypted value, the value is stored as a JSON string,
+// so it is still accepted by a PostgreSQL repository.
+const encryptedPrefix = "enc:v1:"
+
+// ErrDecryption is returned when a record can't be decrypted with any of the keys.
+var ErrDecryption = errors.New("failed to decrypt record")
+
+// ErrPlaintext is returned by a repository rejecting plaintext records when a record is not encrypted.
+var ErrPlaintext = errors.New("record is not encrypted")
+
+// Encrypted encrypts values of records with AES-256-GCM before they reach the underlying repository.
+// Keys of records and names of collections are kept in plain text.
+//
+// The first key encrypts new records, other keys only decrypt existing ones, so a key can be rotated
+// by putting a new key first and re-saving records with Reencrypt. Records saved before encryption was enabled are read as is
+// and get encrypted once they are saved again, unless RejectPlaintext is called.
+type Encrypted struct {
+	repo  Repository
+	aeads []cipher.AEAD
+	// strict rejects records saved before encryption was enabled.
+	strict bool
+}
+
+var _ Repository = (*Encrypted)(nil)
+
+// NewEncrypted wraps the repository, every key must be 32 bytes long.
+func NewEncrypted(repo Repository, keys ...[]byte) (*Encrypted, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one encryption key is required")
+	}
+	e := &Encrypted{repo: repo}
+	for i, key := range keys {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("encryption key #%d must be 32 bytes long, got %d", i+1, len(key))
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		e.aeads = append(e.aeads, aead)
+	}
+	return e, nil
+}
+
+// RejectPlaintext makes the repository fail to read records saved before encryption was enabled.
+// It is supposed to be called once all such records are re-saved,
+// so a record written in plain text bypassing the repository is never trusted.
+func (e *Encrypted) RejectPlaintext() {
+	e.strict = true
+}
+
+// ParseKeys parses a list of 32-byte keys encoded in hex or base64 and separated by commas or whitespaces.
+func ParseKeys(s string) ([][]byte, error) {
+	var keys [][]byte
+	items := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	for _, item := range items {
+		var key []byte
+		var err error
+		if len(item) == 64 {
+			key, err = hex.DecodeString(item)
+		} else {
+			key, err = base64.StdEncoding.DecodeString(item)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("encryption key must be encoded in hex or base64")
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no encryption keys found")
+	}
+	return keys, nil
+}
+
+func (e *Encrypted) List(ctx context.Context, collection string) ([]Record, error) {
+	records, err := e.repo.List(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	for i, record := range records {
+		value, err := e.decrypt(collection, record)
+		if err != nil {
+			return nil, err
+		}
+		records[i].Value = value
+	}
+	return records, nil
+}
+
+func (e *Encrypted) Put(ctx context.Context, collection string, record Record) error {
+	value, err := e.encrypt(collection, record)
+	if err != nil {
+		return err
+	}
+	return e.repo.Put(ctx, collection, Record{Key: record.Key, Value: value})
+}
+
+func (e *Encrypted) Delete(ctx context.Context, collection string, key string) error {
+	return e.repo.Delete(ctx, collection, key)
+}
+
+func (e *Encrypted) Close() error {
+	return e.repo.Close()
+}
+
+// additionalData binds an encrypted value to its collection and key,
+// so a value copied to another record fails to decrypt.
+func additionalData(collection string, key string) []byte {
+	return []byte(collection + "/" + key)
+}
+
+// seal encrypts the value with the first key, the result starts with a nonce.
+func (e *Encrypted) seal(value, additionalData []byte) ([]byte, error) {
+	aead := e.aeads[0]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, value, additionalData), nil
+}
+
+// open decrypts the value sealed with any of the keys and returns an index of the key.
+func (e *Encrypted) open(sealed, additionalData []byte) ([]byte, int, bool) {
+	for i, aead := range e.aeads {
+		if len(sealed) < aead.NonceSize() {
+			break
+		}
+		nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+		if plain, err := aead.Open(nil, nonce, ciphertext, additionalData); err == nil {
+			return plain, i, true
+		}
+	}
+	return nil, 0, false
+}
+
+func (e *Encrypted) encrypt(collection string, record Record) (json.RawMessage, error) {
+	sealed, err := e.seal(record.Value, additionalData(collection, record.Key))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(encryptedPrefix + base64.StdEncoding.EncodeToString(sealed))
+}
+
+func (e *Encrypted) decrypt(collection string, record Record) (json.RawMessage, error) {
+	value, keyIndex, err := e.unseal(collection, record)
+	if err != nil {
+		return nil, err
+	}
+	if keyIndex < 0 && e.strict {
+		return nil, fmt.Errorf("%w: %v of %v", ErrPlaintext, record.Key, collection)
+	}
+	return value, nil
+}
+
+// unseal returns a value of the record along with an index of the key it is encrypted with,
+// the index is -1 for a record saved before encryption was enabled.
+func (e *Encrypted) unseal(collection string, record Record) (json.RawMessage, int, error) {
+	var value string
+	if err := json.Unmarshal(record.Value, &value); err != nil || !strings.HasPrefix(value, encryptedPrefix) {
+		return record.Value, -1, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedPrefix))
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w %v of %v: %v", ErrDecryption, record.Key, collection, err)
+	}
+	plain, keyIndex, ok := e.open(sealed, additionalData(collection, record.Key))
+	if !ok {
+		return nil, 0, fmt.Errorf("%w %v of %v: no key matches", ErrDecryption, record.Key, collection)
+	}
+	return plain, keyIndex, nil
+}
+
+// Reencrypt saves again records of the collections that are in plain text or encrypted with other keys than the first one,
+// so old keys can be dropped after a rotation and RejectPlaintext can be enabled.
+// It returns a number of saved records.
+// A record changed by another replica in the meantime gets its previous value back,
+// so it is supposed to be triggered while records aren't being changed.
+func (e *Encrypted) Reencrypt(ctx context.Context, collections ...string) (int, error) {
+	saved := 0
+	for _, collection := range collections {
+		records, err := e.repo.List(ctx, collection)
+		if err != nil {
+			return saved, err
+		}
+		for _, record := range records {
+			value, keyIndex, err := e.unseal(collection, record)
+			if err != nil {
+				return saved, err
+			}
+			if keyIndex == 0 {
+				continue
+			}
+			if err := e.Put(ctx, collection, Record{Key: record.Key, Value: value}); err != nil {
+				return saved, err
+			}
+			saved++
+		}
+	}
+	return saved, nil
+}
+
+// Seal encrypts a blob kept outside of the repository with the first key, e.g. a snapshot of the local index.
+// The name is authenticated along with the blob, so a blob sealed as one kind of data fails to open as another.
+func (e *Encrypted) Seal(name string, blob []byte) ([]byte, error) {
+	sealed, err := e.seal(blob, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(encryptedPrefix), sealed...), nil
+}
+
+// Open decrypts a blob sealed by Seal with any of the keys.
+// A blob written before encryption was enabled is returned as is, unless RejectPlaintext is called.
+func (e *Encrypted) Open(name string, blob []byte) ([]byte, error) {
+	sealed, ok := bytes.CutPrefix(blob, []byte(encryptedPrefix))
+	if !ok {
+		if e.strict {
+			return nil, fmt.Errorf("%w: %v", ErrPlaintext, name)
+		}
+		return blob, nil
+	}
+	plain, _, ok := e.open(sealed, []byte(name))
+	if !ok {
+		return nil, fmt.Errorf("%w %v: no key matches", ErrDecryption, name)
+	}
+	return plain, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// Memory is a repository that keeps records in memory, it is meant for tests.
+type Memory struct {
+	mu          sync.RWMutex
+	collections map[string]map[string]Record
+}
+
+var _ Repository = (*Memory)(nil)
+
+func NewMemory() *Memory {
+	return &Memory{collections: map[string]map[string]Record{}}
+}
+
+func (m *Memory) List(ctx context.Context, collection string) ([]Record, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	records := make([]Record, 0, len(m.collections[collection]))
+	for _, record := range m.collections[collection] {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Key < records[j].Key
+	})
+	return records, nil
+}
+
+func (m *Memory) Put(ctx context.Context, collection string, record Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	records, ok := m.collections[collection]
+	if !ok {
+		records = map[string]Record{}
+		m.collections[collection] = records
+	}
+	records[record.Key] = record
+	return nil
+}
+
+func (m *Memory) Delete(ctx context.Context, collection string, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.collections[collection], key)
+	return nil
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const createRecordsTable = `
+CREATE TABLE IF NOT EXISTS opentonapi_records (
+	collection TEXT NOT NULL,
+	key        TEXT NOT NULL,
+	value      JSONB NOT NULL,
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (collection, key)
+)`
+
+// Postgres is a repository backed by a PostgreSQL table, it can be shared by several replicas.
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+var _ Repository = (*Postgres)(nil)
+
+// OpenPostgres connects to the database and creates the table with records if it doesn't exist.
+func OpenPostgres(ctx context.Context, dsn string) (*Postgres, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pool.Exec(ctx, createRecordsTable); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return &Postgres{pool: pool}, nil
+}
+
+func (p *Postgres) List(ctx context.Context, collection string) ([]Record, error) {
+	rows, err := p.pool.Query(ctx, `SELECT key, value FROM opentonapi_records WHERE collection = $1 ORDER BY key`, collection)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var records []Record
+	for rows.Next() {
+		var record Record
+		if err := rows.Scan(&record.Key, &record.Value); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+func (p *Postgres) Put(ctx context.Context, collection string, record Record) error {
+	_, err := p.pool.Exec(ctx, `
+		INSERT INTO opentonapi_records (collection, key, value) VALUES ($1, $2, $3)
+		ON CONFLICT (collection, key) DO UPDATE SET value = excluded.value, updated_at = now()`,
+		collection, record.Key, []byte(record.Value))
+	return err
+}
+
+func (p *Postgres) Delete(ctx context.Context, collection string, key string) error {
+	_, err := p.pool.Exec(ctx, `DELETE FROM opentonapi_records WHERE collection = $1 AND key = $2`, collection, key)
+	return err
+}
+
+func (p *Postgres) Close() error {
+	p.pool.Close()
+	return nil
+}
@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository(t *testing.T) {
+	tests := []struct {
+		name string
+		open func(t *testing.T) Repository
+	}{
+		{
+			name: "memory",
+			open: func(t *testing.T) Repository {
+				return NewMemory()
+			},
+		},
+		{
+			name: "bbolt",
+			open: func(t *testing.T) Repository {
+				repo, err := Open(context.Background(), "bbolt://"+filepath.Join(t.TempDir(), "state.db"))
+				require.Nil(t, err)
+				return repo
+			},
+		},
+		{
+			name: "encrypted bbolt",
+			open: func(t *testing.T) Repository {
+				repo, err := Open(context.Background(), "bbolt://"+filepath.Join(t.TempDir(), "state.db"))
+				require.Nil(t, err)
+				encrypted, err := NewEncrypted(repo, bytes.Repeat([]byte{1}, 32))
+				require.Nil(t, err)
+				return encrypted
+			},
+		},
+		{
+			name: "postgres",
+			open: func(t *testing.T) Repository {
+				dsn := os.Getenv("TEST_POSTGRES_DSN")
+				if dsn == "" {
+					t.Skip("TEST_POSTGRES_DSN is not set")
+				}
+				repo, err := Open(context.Background(), dsn)
+				require.Nil(t, err)
+				// the table may be left by a previous run.
+				records, err := repo.List(context.Background(), "test")
+				require.Nil(t, err)
+				for _, record := range records {
+					require.Nil(t, repo.Delete(context.Background(), "test", record.Key))
+				}
+				return repo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := tt.open(t)
+			defer repo.Close()
+
+			records, err := repo.List(ctx, "test")
+			require.Nil(t, err)
+			require.Empty(t, records)
+			require.Nil(t, repo.Delete(ctx, "test", "missing"))
+
+			require.Nil(t, repo.Put(ctx, "test", Record{Key: "b", Value: []byte(`{"name":"bob"}`)}))
+			require.Nil(t, repo.Put(ctx, "test", Record{Key: "a", Value: []byte(`{"name":"alice"}`)}))
+			require.Nil(t, repo.Put(ctx, "test", Record{Key: "a", Value: []byte(`{"name":"alice","memo":"x"}`)}))
+			require.Nil(t, repo.Put(ctx, "other", Record{Key: "c", Value: []byte(`{}`)}))
+
+			records, err = repo.List(ctx, "test")
+			require.Nil(t, err)
+			require.Len(t, records, 2)
+			require.Equal(t, "a", records[0].Key)
+			require.JSONEq(t, `{"name":"alice","memo":"x"}`, string(records[0].Value))
+			require.Equal(t, "b", records[1].Key)
+
+			require.Nil(t, repo.Delete(ctx, "test", "a"))
+			records, err = repo.List(ctx, "test")
+			require.Nil(t, err)
+			require.Len(t, records, 1)
+			require.Equal(t, "b", records[0].Key)
+		})
+	}
+}
+
+func TestOpen_unknownScheme(t *testing.T) {
+	for _, dsn := range []string{"", "/var/lib/state.db", "mysql://localhost/db"} {
+		_, err := Open(context.Background(), dsn)
+		require.ErrorIs(t, err, ErrUnknownScheme)
+	}
+}
+
+func TestEncrypted(t *testing.T) {
+	ctx := context.Background()
+	oldKey, newKey := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+	memory := NewMemory()
+	require.Nil(t, memory.Put(ctx, "test", Record{Key: "plain", Value: []byte(`{"name":"alice"}`)}))
+
+	repo, err := NewEncrypted(memory, oldKey)
+	require.Nil(t, err)
+	require.Nil(t, repo.Put(ctx, "test", Record{Key: "secret", Value: []byte(`{"name":"bob"}`)}))
+
+	stored, err := memory.List(ctx, "test")
+	require.Nil(t, err)
+	require.NotContains(t, string(stored[1].Value), "bob")
+
+	// records saved before encryption was enabled are read as is.
+	records, err := repo.List(ctx, "test")
+	require.Nil(t, err)
+	require.JSONEq(t, `{"name":"alice"}`, string(records[0].Value))
+	require.JSONEq(t, `{"name":"bob"}`, string(records[1].Value))
+
+	// the old key still decrypts records after a rotation.
+	rotated, err := NewEncrypted(memory, newKey, oldKey)
+	require.Nil(t, err)
+	records, err = rotated.List(ctx, "test")
+	require.Nil(t, err)
+	require.JSONEq(t, `{"name":"bob"}`, string(records[1].Value))
+
+	wrongKey, err := NewEncrypted(memory, newKey)
+	require.Nil(t, err)
+	_, err = wrongKey.List(ctx, "test")
+	require.ErrorIs(t, err, ErrDecryption)
+
+	// a value copied to another record doesn't decrypt.
+	require.Nil(t, memory.Put(ctx, "test", Record{Key: "copy", Value: stored[1].Value}))
+	_, err = repo.List(ctx, "test")
+	require.ErrorIs(t, err, ErrDecryption)
+}
+
+func TestEncrypted_RejectPlaintext(t *testing.T) {
+	ctx := context.Background()
+	memory := NewMemory()
+	repo, err := NewEncrypted(memory, bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+	repo.RejectPlaintext()
+	require.Nil(t, repo.Put(ctx, "test", Record{Key: "secret", Value: []byte(`{"name":"bob"}`)}))
+	records, err := repo.List(ctx, "test")
+	require.Nil(t, err)
+	require.JSONEq(t, `{"name":"bob"}`, string(records[0].Value))
+
+	require.Nil(t, memory.Put(ctx, "test", Record{Key: "plain", Value: []byte(`{"name":"alice"}`)}))
+	_, err = repo.List(ctx, "test")
+	require.ErrorIs(t, err, ErrPlaintext)
+}
+
+func TestEncrypted_Reencrypt(t *testing.T) {
+	ctx := context.Background()
+	oldKey, newKey := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+	memory := NewMemory()
+	require.Nil(t, memory.Put(ctx, "test", Record{Key: "plain", Value: []byte(`{"name":"alice"}`)}))
+	repo, err := NewEncrypted(memory, oldKey)
+	require.Nil(t, err)
+	require.Nil(t, repo.Put(ctx, "test", Record{Key: "secret", Value: []byte(`{"name":"bob"}`)}))
+
+	rotated, err := NewEncrypted(memory, newKey, oldKey)
+	require.Nil(t, err)
+	saved, err := rotated.Reencrypt(ctx, "test")
+	require.Nil(t, err)
+	require.Equal(t, 2, saved)
+	// everything is encrypted with the new key now, so the old one can be dropped.
+	saved, err = rotated.Reencrypt(ctx, "test")
+	require.Nil(t, err)
+	require.Zero(t, saved)
+
+	newOnly, err := NewEncrypted(memory, newKey)
+	require.Nil(t, err)
+	newOnly.RejectPlaintext()
+	records, err := newOnly.List(ctx, "test")
+	require.Nil(t, err)
+	require.JSONEq(t, `{"name":"alice"}`, string(records[0].Value))
+	require.JSONEq(t, `{"name":"bob"}`, string(records[1].Value))
+}
+
+func TestEncrypted_Seal(t *testing.T) {
+	oldKey, newKey := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+	repo, err := NewEncrypted(NewMemory(), oldKey)
+	require.Nil(t, err)
+	sealed, err := repo.Seal("snapshot", []byte("index"))
+	require.Nil(t, err)
+	require.NotContains(t, string(sealed), "index")
+
+	rotated, err := NewEncrypted(NewMemory(), newKey, oldKey)
+	require.Nil(t, err)
+	blob, err := rotated.Open("snapshot", sealed)
+	require.Nil(t, err)
+	require.Equal(t, "index", string(blob))
+
+	_, err = rotated.Open("backup", sealed)
+	require.ErrorIs(t, err, ErrDecryption)
+
+	// a blob written before encryption was enabled is read as is, unless plaintext is rejected.
+	blob, err = rotated.Open("snapshot", []byte("index"))
+	require.Nil(t, err)
+	require.Equal(t, "index", string(blob))
+	rotated.RejectPlaintext()
+	_, err = rotated.Open("snapshot", []byte("index"))
+	require.ErrorIs(t, err, ErrPlaintext)
+}
+
+func TestParseKeys(t *testing.T) {
+	hexKey := strings.Repeat("01", 32)
+	base64Key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32))
+
+	keys, err := ParseKeys(hexKey + ", " + base64Key + "\n")
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)}, keys)
+
+	_, err = ParseKeys("")
+	require.NotNil(t, err)
+	_, err = ParseKeys("not a key")
+	require.NotNil(t, err)
+	_, err = NewEncrypted(NewMemory(), []byte("short"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,235 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var jobRunsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "scheduler_job_runs",
+	Help: "Number of runs of background jobs",
+}, []string{"job", "status"})
+
+// ErrUnknownJob is returned when a job with the given name hasn't been added to the Scheduler.
+var ErrUnknownJob = errors.New("unknown job")
+
+// Job is a background task run by the Scheduler periodically.
+type Job struct {
+	Name string
+	// Interval between two runs. 0 disables periodic runs, the job can still be triggered manually.
+	Interval time.Duration
+	// Immediate runs the job once it is scheduled instead of waiting for the first interval.
+	Immediate bool
+	Run       func(ctx context.Context) error
+}
+
+// Status describes runs of a job.
+type Status struct {
+	Name           string     `json:"name"`
+	IntervalMs     int64      `json:"interval_ms"`
+	Running        bool       `json:"running"`
+	Runs           int        `json:"runs"`
+	Failures       int        `json:"failures"`
+	LastStartedAt  *time.Time `json:"last_started_at,omitempty"`
+	LastDurationMs int64      `json:"last_duration_ms"`
+	LastError      string     `json:"last_error,omitempty"`
+}
+
+type scheduledJob struct {
+	job     Job
+	trigger chan struct{}
+
+	mu     sync.Mutex
+	status Status
+}
+
+// Scheduler runs background jobs of other components,
+// so they are configured, observed and triggered in one place.
+// A job never overlaps with itself: a run triggered while the job is running starts after it.
+type Scheduler struct {
+	logger    *zap.Logger
+	intervals map[string]time.Duration
+
+	mu   sync.Mutex
+	ctx  context.Context
+	jobs []*scheduledJob
+}
+
+// Option configures a Scheduler.
+type Option func(s *Scheduler)
+
+// WithIntervals overrides intervals of jobs by their names.
+func WithIntervals(intervals map[string]time.Duration) Option {
+	return func(s *Scheduler) {
+		s.intervals = intervals
+	}
+}
+
+func New(logger *zap.Logger, opts ...Option) *Scheduler {
+	s := &Scheduler{logger: logger}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// RunJob runs the job on its schedule until the context is done.
+// Components created without a Scheduler run their jobs with it.
+func RunJob(ctx context.Context, logger *zap.Logger, job Job) {
+	s := &Scheduler{logger: logger}
+	s.loop(ctx, newScheduledJob(job))
+}
+
+func newScheduledJob(job Job) *scheduledJob {
+	return &scheduledJob{
+		job:     job,
+		trigger: make(chan struct{}, 1),
+		status:  Status{Name: job.Name, IntervalMs: job.Interval.Milliseconds()},
+	}
+}
+
+// Add schedules the job. Jobs added before Run start along with it, the other ones start right away.
+func (s *Scheduler) Add(job Job) {
+	if interval, ok := s.intervals[job.Name]; ok {
+		job.Interval = interval
+	}
+	j := newScheduledJob(job)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.find(job.Name) != nil {
+		s.logger.Warn("job is already scheduled", zap.String("job", job.Name))
+		return
+	}
+	s.jobs = append(s.jobs, j)
+	if s.ctx != nil {
+		go s.loop(s.ctx, j)
+	}
+}
+
+// Run starts scheduled jobs and blocks until the context is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.ctx = ctx
+	for _, j := range s.jobs {
+		go s.loop(ctx, j)
+	}
+	s.mu.Unlock()
+	<-ctx.Done()
+}
+
+// Trigger runs the job out of its schedule.
+func (s *Scheduler) Trigger(name string) error {
+	s.mu.Lock()
+	j := s.find(name)
+	s.mu.Unlock()
+	if j == nil {
+		return ErrUnknownJob
+	}
+	select {
+	case j.trigger <- struct{}{}:
+	default:
+		// the job has been triggered already.
+	}
+	return nil
+}
+
+// Statuses returns statuses of all jobs in the order they have been added.
+func (s *Scheduler) Statuses() []Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]Status, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		j.mu.Lock()
+		statuses = append(statuses, j.status)
+		j.mu.Unlock()
+	}
+	return statuses
+}
+
+// AdminHandler returns an http.Handler to observe and trigger jobs.
+// It is supposed to be exposed on an internal port only:
+//
+//	GET  <prefix>       lists jobs and their last runs
+//	POST <prefix><job>  runs the job out of its schedule
+func (s *Scheduler) AdminHandler(prefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		name := strings.TrimPrefix(r.URL.Path, prefix)
+		switch {
+		case name == "" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(s.Statuses())
+		case name != "" && r.Method == http.MethodPost:
+			if err := s.Trigger(name); err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (s *Scheduler) find(name string) *scheduledJob {
+	for _, j := range s.jobs {
+		if j.job.Name == name {
+			return j
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) loop(ctx context.Context, j *scheduledJob) {
+	var tick <-chan time.Time
+	if j.job.Interval > 0 {
+		ticker := time.NewTicker(j.job.Interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	if j.job.Immediate {
+		s.run(ctx, j)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+		case <-j.trigger:
+		}
+		s.run(ctx, j)
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, j *scheduledJob) {
+	started := time.Now()
+	j.mu.Lock()
+	j.status.Running = true
+	j.status.LastStartedAt = &started
+	j.mu.Unlock()
+
+	err := j.job.Run(ctx)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status.Running = false
+	j.status.Runs++
+	j.status.LastDurationMs = time.Since(started).Milliseconds()
+	j.status.LastError = ""
+	if err != nil {
+		j.status.Failures++
+		j.status.LastError = err.Error()
+		jobRunsCounter.WithLabelValues(j.job.Name, "error").Inc()
+		s.logger.Error("job failed", zap.String("job", j.job.Name), zap.Error(err))
+		return
+	}
+	jobRunsCounter.WithLabelValues(j.job.Name, "success").Inc()
+}
@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestScheduler(t *testing.T) {
+	var runs, failures atomic.Int32
+	s := New(zap.NewNop(), WithIntervals(map[string]time.Duration{"disabled": 0}))
+	s.Add(Job{
+		Name:      "immediate",
+		Interval:  time.Hour,
+		Immediate: true,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+	s.Add(Job{
+		Name:     "disabled",
+		Interval: time.Millisecond,
+		Run: func(ctx context.Context) error {
+			failures.Add(1)
+			return errors.New("failed")
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	require.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, time.Millisecond)
+	statuses := s.Statuses()
+	require.Len(t, statuses, 2)
+	require.Equal(t, "immediate", statuses[0].Name)
+	require.Equal(t, time.Hour.Milliseconds(), statuses[0].IntervalMs)
+	require.Equal(t, int64(0), statuses[1].IntervalMs)
+	require.Zero(t, failures.Load())
+
+	admin := s.AdminHandler("/admin/jobs/")
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		admin.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+	require.Equal(t, http.StatusAccepted, serve(http.MethodPost, "/admin/jobs/disabled").Code)
+	require.Eventually(t, func() bool {
+		status := s.Statuses()[1]
+		return status.Runs == 1 && !status.Running
+	}, time.Second, time.Millisecond)
+	status := s.Statuses()[1]
+	require.Equal(t, 1, status.Failures)
+	require.Equal(t, "failed", status.LastError)
+	require.NotNil(t, status.LastStartedAt)
+
+	require.Equal(t, http.StatusNotFound, serve(http.MethodPost, "/admin/jobs/unknown").Code)
+	require.Equal(t, http.StatusMethodNotAllowed, serve(http.MethodDelete, "/admin/jobs/").Code)
+	rec := serve(http.MethodGet, "/admin/jobs/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"name":"immediate"`)
+
+	// a job added after Run starts right away.
+	s.Add(Job{
+		Name:      "late",
+		Immediate: true,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+	require.Eventually(t, func() bool { return runs.Load() == 2 }, time.Second, time.Millisecond)
+	require.ErrorIs(t, s.Trigger("unknown"), ErrUnknownJob)
+}
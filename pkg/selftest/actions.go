@@ -0,0 +1,138 @@
+package selftest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/tonkeeper/opentonapi/pkg/bath"
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+var (
+	alice = ton.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	bob   = ton.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000001")
+	item  = ton.MustParseAccountID("0:0000000000000000000000000000000000000000000000000000000000000002")
+)
+
+// traceFixture is a trace along with actions it must be turned into.
+type traceFixture struct {
+	name  string
+	trace func() (*core.Trace, error)
+	want  []bath.ActionType
+}
+
+var traceFixtures = []traceFixture{
+	{
+		name: "ton_transfer",
+		trace: func() (*core.Trace, error) {
+			return walletTrace(internalTx(bob, 1_000_000_000, nil, nil)), nil
+		},
+		want: []bath.ActionType{bath.TonTransfer},
+	},
+	{
+		name: "nft_transfer",
+		trace: func() (*core.Trace, error) {
+			opCode, body, err := decodeFixtureByName("nft_transfer")
+			if err != nil {
+				return nil, err
+			}
+			transfer := internalTx(item, 50_000_000, opCode, body)
+			transfer.AccountInterfaces = []abi.ContractInterface{abi.NftItem}
+			return walletTrace(transfer), nil
+		},
+		want: []bath.ActionType{bath.NftItemTransfer},
+	},
+}
+
+// walletTrace returns a trace of a wallet sending a message to start the given child trace.
+func walletTrace(child *core.Trace) *core.Trace {
+	return &core.Trace{
+		Transaction: core.Transaction{
+			TransactionID: core.TransactionID{Account: alice},
+			Success:       true,
+			InMsg:         &core.Message{MsgType: core.ExtInMsg},
+		},
+		AccountInterfaces: []abi.ContractInterface{abi.WalletV4R2},
+		Children:          []*core.Trace{child},
+	}
+}
+
+func internalTx(account ton.AccountID, value int64, opCode *uint32, body *core.DecodedMessageBody) *core.Trace {
+	return &core.Trace{Transaction: core.Transaction{
+		TransactionID: core.TransactionID{Account: account},
+		Success:       true,
+		InMsg: &core.Message{
+			MessageID:   core.MessageID{Source: &alice, Destination: &account},
+			MsgType:     core.IntMsg,
+			Value:       value,
+			OpCode:      opCode,
+			DecodedBody: body,
+		},
+	}}
+}
+
+// actionChecks runs every straw alone against every trace, so a straw panicking on unexpected data is found,
+// and then the whole set of straws to compare found actions.
+func actionChecks() []check {
+	var checks []check
+	for i, straw := range bath.DefaultStraws {
+		straw := straw
+		checks = append(checks, check{
+			component: ComponentActions,
+			name:      fmt.Sprintf("straw #%d %v", i, strawName(straw)),
+			fn: func(ctx context.Context) error {
+				for _, fixture := range traceFixtures {
+					trace, err := fixture.trace()
+					if err != nil {
+						return err
+					}
+					if _, err := bath.FindActions(ctx, trace, bath.WithStraws([]bath.Merger{straw})); err != nil {
+						return fmt.Errorf("%v: %w", fixture.name, err)
+					}
+				}
+				return nil
+			},
+		})
+	}
+	for _, fixture := range traceFixtures {
+		fixture := fixture
+		checks = append(checks, check{
+			component: ComponentActions,
+			name:      fixture.name,
+			fn: func(ctx context.Context) error {
+				trace, err := fixture.trace()
+				if err != nil {
+					return err
+				}
+				actions, err := bath.FindActions(ctx, trace)
+				if err != nil {
+					return err
+				}
+				var types []bath.ActionType
+				for _, action := range actions.Actions {
+					types = append(types, action.Type)
+				}
+				if !slices.Equal(types, fixture.want) {
+					return fmt.Errorf("actions are found as %v, want %v", types, fixture.want)
+				}
+				return nil
+			},
+		})
+	}
+	return checks
+}
+
+// strawName returns a short name of a straw's type, e.g. "Straw[BubbleNftTransfer]".
+func strawName(straw bath.Merger) string {
+	name := fmt.Sprintf("%T", straw)
+	name = strings.TrimPrefix(name, "bath.")
+	if start, end := strings.Index(name, "["), strings.LastIndex(name, "."); start >= 0 && end > start {
+		name = name[:start+1] + name[end+1:]
+	}
+	return name
+}
@@ -0,0 +1,101 @@
+package selftest
+
+import (
+	"context"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// messageFixture is a body of an internal message along with its operation.
+type messageFixture struct {
+	Name   string `json:"name"`
+	Body   string `json:"body"`
+	OpName string `json:"op_name"`
+}
+
+//go:embed fixtures/messages.json
+var messagesJSON []byte
+
+func messageFixtures() ([]messageFixture, error) {
+	var fixtures []messageFixture
+	if err := json.Unmarshal(messagesJSON, &fixtures); err != nil {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+func decodingChecks() []check {
+	fixtures, err := messageFixtures()
+	if err != nil {
+		return []check{{
+			component: ComponentDecoding,
+			name:      "fixtures",
+			fn:        func(ctx context.Context) error { return err },
+		}}
+	}
+	checks := make([]check, 0, len(fixtures))
+	for _, fixture := range fixtures {
+		fixture := fixture
+		checks = append(checks, check{
+			component: ComponentDecoding,
+			name:      fixture.Name,
+			fn: func(ctx context.Context) error {
+				_, _, err := decodeFixture(fixture)
+				return err
+			},
+		})
+	}
+	return checks
+}
+
+// decodeFixture decodes the body of the fixture the same way litestorage decodes bodies of messages.
+func decodeFixture(fixture messageFixture) (*uint32, *core.DecodedMessageBody, error) {
+	data, err := hex.DecodeString(fixture.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	cells, err := boc.DeserializeBoc(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(cells) != 1 {
+		return nil, nil, fmt.Errorf("body must contain a single root cell")
+	}
+	opCode, opName, value, err := abi.InternalMessageDecoder(cells[0], nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if opName == nil {
+		return nil, nil, fmt.Errorf("operation is not recognized, want %v", fixture.OpName)
+	}
+	if string(*opName) != fixture.OpName {
+		return nil, nil, fmt.Errorf("operation is decoded as %v, want %v", *opName, fixture.OpName)
+	}
+	var code *uint32
+	if opCode != nil {
+		c := uint32(*opCode)
+		code = &c
+	}
+	return code, &core.DecodedMessageBody{Operation: string(*opName), Value: value}, nil
+}
+
+// decodeFixtureByName is used to build traces of action checks.
+func decodeFixtureByName(name string) (*uint32, *core.DecodedMessageBody, error) {
+	fixtures, err := messageFixtures()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, fixture := range fixtures {
+		if fixture.Name == name {
+			return decodeFixture(fixture)
+		}
+	}
+	return nil, nil, fmt.Errorf("message fixture %v is not found", name)
+}
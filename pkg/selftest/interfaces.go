@@ -0,0 +1,65 @@
+package selftest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"slices"
+
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/tvm"
+	"github.com/tonkeeper/tongo/wallet"
+)
+
+// interfaceFixtures are contracts generated by the wallet package along with interfaces they must be detected with.
+var interfaceFixtures = []struct {
+	version wallet.Version
+	want    abi.ContractInterface
+}{
+	{version: wallet.V3R2, want: abi.WalletV3R2},
+	{version: wallet.V4R2, want: abi.WalletV4R2},
+}
+
+func interfaceChecks() []check {
+	checks := make([]check, 0, len(interfaceFixtures))
+	for _, fixture := range interfaceFixtures {
+		fixture := fixture
+		checks = append(checks, check{
+			component: ComponentInterfaces,
+			name:      fixture.want.String(),
+			fn: func(ctx context.Context) error {
+				return inspectWallet(ctx, fixture.version, fixture.want)
+			},
+		})
+	}
+	return checks
+}
+
+func inspectWallet(ctx context.Context, version wallet.Version, want abi.ContractInterface) error {
+	state, err := wallet.GenerateStateInit(make(ed25519.PublicKey, ed25519.PublicKeySize), version, nil, 0, nil)
+	if err != nil {
+		return err
+	}
+	if !state.Code.Exists || !state.Data.Exists {
+		return fmt.Errorf("wallet state init is empty")
+	}
+	code, data := state.Code.Value.Value, state.Data.Value.Value
+	codeBoc, err := code.ToBoc()
+	if err != nil {
+		return err
+	}
+	emulator, err := tvm.NewEmulator(&code, &data, nil)
+	if err != nil {
+		return err
+	}
+	account := ton.AccountID{Workchain: 0}
+	description, err := abi.NewContractInspector().InspectContract(ctx, codeBoc, emulator, account)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(description.ContractInterfaces, want) {
+		return fmt.Errorf("interfaces are detected as %v, want %v", description.ContractInterfaces, want)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Package selftest checks components built on top of the ABI registry of tongo against embedded fixtures:
+// decoding of messages, detection of contract interfaces and straws finding actions in traces.
+// It is run on startup, so an instance built with a new version of tongo
+// refuses to serve or disables affected features instead of returning wrong data.
+package selftest
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Component is a part of opentonapi checked by the self-test.
+type Component string
+
+const (
+	// ComponentDecoding decodes message bodies with the ABI registry.
+	ComponentDecoding Component = "decoding"
+	// ComponentInterfaces detects interfaces of contracts by their code and get methods.
+	ComponentInterfaces Component = "interfaces"
+	// ComponentActions finds actions in traces with straws of the bath package.
+	ComponentActions Component = "actions"
+)
+
+// Check is a result of a single check.
+type Check struct {
+	Component Component     `json:"component"`
+	Name      string        `json:"name"`
+	Error     string        `json:"error,omitempty"`
+	Duration  time.Duration `json:"duration_ns"`
+}
+
+func (c Check) OK() bool {
+	return c.Error == ""
+}
+
+// Report contains results of all checks of the self-test.
+type Report struct {
+	Checks   []Check       `json:"checks"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// Failed returns failed checks.
+func (r Report) Failed() []Check {
+	var failed []Check
+	for _, check := range r.Checks {
+		if !check.OK() {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// FailedComponents returns components with at least one failed check.
+func (r Report) FailedComponents() []Component {
+	var components []Component
+	seen := map[Component]struct{}{}
+	for _, check := range r.Failed() {
+		if _, ok := seen[check.Component]; ok {
+			continue
+		}
+		seen[check.Component] = struct{}{}
+		components = append(components, check.Component)
+	}
+	return components
+}
+
+// Run runs all checks, a check panicking is reported as failed.
+func Run(ctx context.Context) Report {
+	started := time.Now()
+	var report Report
+	for _, c := range checks() {
+		report.Checks = append(report.Checks, run(ctx, c))
+	}
+	report.Duration = time.Since(started)
+	return report
+}
+
+type check struct {
+	component Component
+	name      string
+	fn        func(ctx context.Context) error
+}
+
+func checks() []check {
+	var all []check
+	all = append(all, decodingChecks()...)
+	all = append(all, interfaceChecks()...)
+	all = append(all, actionChecks()...)
+	return all
+}
+
+func run(ctx context.Context, c check) (result Check) {
+	started := time.Now()
+	result = Check{Component: c.component, Name: c.name}
+	defer func() {
+		if r := recover(); r != nil {
+			result.Error = fmt.Sprintf("panic: %v", r)
+		}
+		result.Duration = time.Since(started)
+	}()
+	if err := c.fn(ctx); err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
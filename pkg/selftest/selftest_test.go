@@ -0,0 +1,73 @@
+package selftest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	report := Run(context.Background())
+	require.Empty(t, report.Failed())
+	require.Empty(t, report.FailedComponents())
+
+	components := map[Component]int{}
+	for _, check := range report.Checks {
+		components[check.Component]++
+	}
+	require.Len(t, components, 3)
+}
+
+func Test_run(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func(ctx context.Context) error
+		wantError string
+	}{
+		{
+			name: "success",
+			fn:   func(ctx context.Context) error { return nil },
+		},
+		{
+			name:      "error",
+			fn:        func(ctx context.Context) error { return fmt.Errorf("unknown operation") },
+			wantError: "unknown operation",
+		},
+		{
+			name:      "panic",
+			fn:        func(ctx context.Context) error { panic("interface conversion") },
+			wantError: "panic: interface conversion",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := run(context.Background(), check{component: ComponentActions, name: tt.name, fn: tt.fn})
+			require.Equal(t, tt.wantError, result.Error)
+			require.Equal(t, tt.wantError == "", result.OK())
+			require.Equal(t, ComponentActions, result.Component)
+		})
+	}
+}
+
+func TestReport_FailedComponents(t *testing.T) {
+	report := Report{Checks: []Check{
+		{Component: ComponentDecoding, Name: "text_comment"},
+		{Component: ComponentActions, Name: "ton_transfer", Error: "no actions"},
+		{Component: ComponentActions, Name: "nft_transfer", Error: "no actions"},
+		{Component: ComponentInterfaces, Name: "wallet_v4r2", Error: "no interfaces"},
+	}}
+	require.Len(t, report.Failed(), 3)
+	require.Equal(t, []Component{ComponentActions, ComponentInterfaces}, report.FailedComponents())
+}
+
+func Test_decodeFixture(t *testing.T) {
+	_, _, err := decodeFixture(messageFixture{Name: "text_comment", Body: "b5ee9c7201010101000b0000120000000068656c6c6f", OpName: "JettonTransfer"})
+	require.EqualError(t, err, "operation is decoded as TextComment, want JettonTransfer")
+
+	opCode, body, err := decodeFixtureByName("nft_transfer")
+	require.Nil(t, err)
+	require.Equal(t, uint32(0x5fcc3d14), *opCode)
+	require.Equal(t, "NftTransfer", body.Operation)
+}
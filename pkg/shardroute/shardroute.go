@@ -0,0 +1,441 @@
+// Package shardroute routes account-scoped lite server queries to lite servers
+// that have been serving the shard of the account well.
+//
+// A Router follows the shard configuration in new blocks and keeps per-shard stats of every lite server.
+// A request handler attaches a Trace to the request context with NewContext,
+// Query reports its routing decisions to the trace, so they can be exposed in debug headers.
+//
+// With hedging enabled, a query that hasn't been answered by the picked lite server within a delay
+// is sent to a second one as well, and the first successful response wins.
+//
+// With verification enabled, a fraction of successful queries is run once more in the background
+// against a second lite server picked the same way, and mismatching results are logged and counted,
+// so a misbehaving lite server is detected before clients notice inconsistent balances.
+// Results reflecting different states of an account, e.g. one server has already seen a new transaction,
+// are counted as lagging rather than mismatching.
+package shardroute
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tonkeeper/tongo/liteapi"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"go.uber.org/zap"
+)
+
+const (
+	// ReasonTracking means the lite server has recently served the shard with the lowest latency.
+	ReasonTracking = "tracking"
+	// ReasonFallback means no lite server is known to serve the shard, so we have picked the least failing one.
+	ReasonFallback = "fallback"
+	// ReasonHedge means the query has been sent to a second lite server because the first one is too slow.
+	ReasonHedge = "hedge"
+	// ReasonVerify means the query has been sent to a second lite server to verify a response of the first one.
+	ReasonVerify = "verify"
+)
+
+var queriesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "shardroute_queries_total",
+	Help: "Queries routed among lite servers by their outcome: single, hedged_primary_won or hedged_backup_won",
+}, []string{"outcome"})
+
+var verificationsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "shardroute_verifications_total",
+	Help: "Queries verified against a second lite server by their result: match, mismatch, lagging or error",
+}, []string{"result"})
+
+// staleAfter is a period after which a successful response doesn't prove that a lite server tracks a shard anymore.
+const staleAfter = 5 * time.Minute
+
+// verifyTimeout limits a verification query, it runs after a client has got its response.
+const verifyTimeout = 10 * time.Second
+
+// Server is a single lite server available for routing.
+type Server struct {
+	Name   string
+	Client *liteapi.Client
+}
+
+// Decision describes where a query of an account has been sent.
+type Decision struct {
+	Shard  string
+	Server string
+	Reason string
+}
+
+func (d Decision) String() string {
+	return fmt.Sprintf("shard=%s; server=%s; reason=%s", d.Shard, d.Server, d.Reason)
+}
+
+type shardKey struct {
+	workchain int32
+	shard     ton.ShardID
+}
+
+func (k shardKey) String() string {
+	return fmt.Sprintf("%d:%016x", k.workchain, uint64(k.shard.Encode()))
+}
+
+// serverStats describes how a lite server has been serving a shard.
+type serverStats struct {
+	// latency is an exponential moving average of response times.
+	latency     time.Duration
+	failures    int
+	lastSuccess time.Time
+}
+
+// Router picks a lite server for an account query.
+type Router struct {
+	servers []Server
+
+	mu sync.Mutex
+	// shards is the current shard configuration per workchain.
+	shards map[int32][]ton.ShardID
+	// stats contains per-shard stats of each server, it has the same length as servers.
+	stats []map[shardKey]*serverStats
+	// next is used to spread fallback queries among servers.
+	next int
+	// hedgeDelay, if positive, is a delay after which a query is sent to a second server.
+	hedgeDelay time.Duration
+	// verifyFraction is a fraction of successful queries verified against a second server.
+	verifyFraction float64
+	logger         *zap.Logger
+}
+
+type Option func(r *Router)
+
+// WithHedging sends a query to a second lite server if the first one hasn't responded within the given delay,
+// the first successful response wins. A good delay is around the p95 latency of lite servers.
+func WithHedging(delay time.Duration) Option {
+	return func(r *Router) {
+		r.hedgeDelay = delay
+	}
+}
+
+// WithVerification runs the given fraction of successful queries against a second lite server in the background
+// and compares results, mismatches are logged and counted in the shardroute_verifications_total metric.
+// A lite server lagging behind a few blocks produces mismatches as well, so only a steady rate of them is alarming.
+func WithVerification(fraction float64, logger *zap.Logger) Option {
+	return func(r *Router) {
+		r.verifyFraction = fraction
+		r.logger = logger
+	}
+}
+
+// NewRouter returns a router among the given lite servers.
+func NewRouter(servers []Server, opts ...Option) *Router {
+	stats := make([]map[shardKey]*serverStats, len(servers))
+	for i := range stats {
+		stats[i] = map[shardKey]*serverStats{}
+	}
+	r := &Router{
+		servers: servers,
+		shards:  map[int32][]ton.ShardID{},
+		stats:   stats,
+		logger:  zap.NewNop(),
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// ObserveBlock updates the shard configuration with a new block.
+// After a split or a merge a new shard inherits stats of the old one,
+// a lite server tracking the parent shard usually tracks its children as well.
+func (r *Router) ObserveBlock(id ton.BlockIDExt) {
+	shard, err := ton.ParseShardID(int64(id.Shard))
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var shards []ton.ShardID
+	for _, current := range r.shards[id.Workchain] {
+		if current == shard {
+			return
+		}
+		if !current.MatchBlockID(id.BlockID) {
+			shards = append(shards, current)
+		}
+	}
+	r.shards[id.Workchain] = append(shards, shard)
+
+	key := shardKey{workchain: id.Workchain, shard: shard}
+	now := time.Now()
+	for _, stats := range r.stats {
+		for old, s := range stats {
+			if old.workchain != id.Workchain || !old.shard.MatchBlockID(id.BlockID) {
+				continue
+			}
+			if _, ok := stats[key]; !ok {
+				copied := *s
+				stats[key] = &copied
+			}
+		}
+		// stats of old shards are kept for a while, so all shards appearing after a split can inherit them.
+		for old, s := range stats {
+			if !r.isCurrent(old) && now.Sub(s.lastSuccess) > staleAfter {
+				delete(stats, old)
+			}
+		}
+	}
+}
+
+func (r *Router) isCurrent(key shardKey) bool {
+	for _, shard := range r.shards[key.workchain] {
+		if shard == key.shard {
+			return true
+		}
+	}
+	return false
+}
+
+// shardOf returns the current shard of the account,
+// a workchain without known shards is considered to be a single shard.
+func (r *Router) shardOf(account ton.AccountID) shardKey {
+	for _, shard := range r.shards[account.Workchain] {
+		if shard.MatchAccountID(account) {
+			return shardKey{workchain: account.Workchain, shard: shard}
+		}
+	}
+	return shardKey{workchain: account.Workchain, shard: ton.MustParseShardID(-1 << 63)}
+}
+
+// route returns an index of a server to query the account, the excluded server is never picked.
+// Pass -1 to consider all servers.
+func (r *Router) route(account ton.AccountID, exclude int, now time.Time) (int, Decision) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := r.shardOf(account)
+	best := -1
+	for i, stats := range r.stats {
+		if i == exclude {
+			continue
+		}
+		s, ok := stats[key]
+		if !ok || s.failures > 0 || now.Sub(s.lastSuccess) > staleAfter {
+			continue
+		}
+		if best == -1 || s.latency < r.stats[best][key].latency {
+			best = i
+		}
+	}
+	if best != -1 {
+		return best, Decision{Shard: key.String(), Server: r.servers[best].Name, Reason: ReasonTracking}
+	}
+	// nobody is known to serve the shard, so we go around servers skipping the most failing ones.
+	minFailures := -1
+	for i := range r.servers {
+		idx := (r.next + i) % len(r.servers)
+		if idx == exclude {
+			continue
+		}
+		failures := 0
+		if s, ok := r.stats[idx][key]; ok {
+			failures = s.failures
+		}
+		if minFailures == -1 || failures < minFailures {
+			best, minFailures = idx, failures
+		}
+	}
+	r.next = (best + 1) % len(r.servers)
+	return best, Decision{Shard: key.String(), Server: r.servers[best].Name, Reason: ReasonFallback}
+}
+
+// observe updates stats of the server with a result of a query.
+func (r *Router) observe(server int, account ton.AccountID, latency time.Duration, err error, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := r.shardOf(account)
+	s, ok := r.stats[server][key]
+	if !ok {
+		s = &serverStats{latency: latency}
+		r.stats[server][key] = s
+	}
+	if err != nil {
+		s.failures += 1
+		return
+	}
+	s.failures = 0
+	s.lastSuccess = now
+	s.latency = (s.latency*3 + latency) / 4
+}
+
+// observeSlow updates the latency of the server whose query has been canceled because another server responded first.
+// It is not a failure, but the server shouldn't look faster than it is.
+func (r *Router) observeSlow(server int, account ton.AccountID, latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.stats[server][r.shardOf(account)]; ok {
+		s.latency = (s.latency*3 + latency) / 4
+	}
+}
+
+type result[T any] struct {
+	value  T
+	err    error
+	server int
+}
+
+// Query runs a query of the account against a lite server picked by the router.
+// With hedging, the query can run against two servers concurrently,
+// so it must not share mutable state and should respect the given context, it is canceled for the loser.
+// With verification, the query can run once more after Query has returned.
+func Query[T any](ctx context.Context, r *Router, account ton.AccountID, query func(ctx context.Context, client *liteapi.Client) (T, error)) (T, error) {
+	res := run(ctx, r, account, query)
+	if res.err == nil && r.sampleVerification() {
+		verify(ctx, r, account, query, res)
+	}
+	return res.value, res.err
+}
+
+func run[T any](ctx context.Context, r *Router, account ton.AccountID, query func(ctx context.Context, client *liteapi.Client) (T, error)) result[T] {
+	primary, decision := r.route(account, -1, time.Now())
+	record(ctx, decision)
+	if r.hedgeDelay <= 0 || len(r.servers) < 2 {
+		queriesMetric.WithLabelValues("single").Inc()
+		started := time.Now()
+		value, err := query(ctx, r.servers[primary].Client)
+		r.observe(primary, account, time.Since(started), err, time.Now())
+		return result[T]{value: value, err: err, server: primary}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan result[T], 2)
+	launch := func(server int) {
+		go func() {
+			started := time.Now()
+			value, err := query(ctx, r.servers[server].Client)
+			if err != nil && ctx.Err() != nil {
+				r.observeSlow(server, account, time.Since(started))
+			} else {
+				r.observe(server, account, time.Since(started), err, time.Now())
+			}
+			results <- result[T]{value: value, err: err, server: server}
+		}()
+	}
+	launch(primary)
+
+	timer := time.NewTimer(r.hedgeDelay)
+	defer timer.Stop()
+	select {
+	case res := <-results:
+		queriesMetric.WithLabelValues("single").Inc()
+		return res
+	case <-timer.C:
+	}
+	backup, decision := r.route(account, primary, time.Now())
+	decision.Reason = ReasonHedge
+	record(ctx, decision)
+	launch(backup)
+
+	var res result[T]
+	for i := 0; i < 2; i++ {
+		res = <-results
+		if res.err == nil {
+			break
+		}
+	}
+	if res.server == primary {
+		queriesMetric.WithLabelValues("hedged_primary_won").Inc()
+	} else {
+		queriesMetric.WithLabelValues("hedged_backup_won").Inc()
+	}
+	return res
+}
+
+func (r *Router) sampleVerification() bool {
+	return r.verifyFraction > 0 && len(r.servers) > 1 && rand.Float64() < r.verifyFraction
+}
+
+// verify runs the query against a server other than the one that has answered and compares results.
+// It doesn't delay the response, the query runs in the background with a context detached from the request.
+// A mismatch isn't counted as a failure of either server, we can't tell which one is wrong.
+func verify[T any](ctx context.Context, r *Router, account ton.AccountID, query func(ctx context.Context, client *liteapi.Client) (T, error), answered result[T]) {
+	server, decision := r.route(account, answered.server, time.Now())
+	decision.Reason = ReasonVerify
+	record(ctx, decision)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), verifyTimeout)
+		defer cancel()
+		started := time.Now()
+		value, err := query(ctx, r.servers[server].Client)
+		r.observe(server, account, time.Since(started), err, time.Now())
+		switch {
+		case err != nil:
+			verificationsMetric.WithLabelValues("error").Inc()
+		case reflect.DeepEqual(value, answered.value):
+			verificationsMetric.WithLabelValues("match").Inc()
+		case !sameState(value, answered.value):
+			verificationsMetric.WithLabelValues("lagging").Inc()
+		default:
+			verificationsMetric.WithLabelValues("mismatch").Inc()
+			r.logger.Warn("lite servers returned different results",
+				zap.Stringer("account", account),
+				zap.String("shard", decision.Shard),
+				zap.String("answered", r.servers[answered.server].Name),
+				zap.String("verifier", r.servers[server].Name))
+		}
+	}()
+}
+
+// sameState reports whether both results reflect the same state of an account,
+// so a difference between them can't be explained by one of the servers lagging behind.
+// Results of unknown types are assumed to reflect the same state.
+func sameState(a, b any) bool {
+	switch a := a.(type) {
+	case tlb.ShardAccount:
+		if b, ok := b.(tlb.ShardAccount); ok {
+			return a.LastTransLt == b.LastTransLt
+		}
+	}
+	return true
+}
+
+// Trace collects routing decisions of a single request.
+type Trace struct {
+	mu        sync.Mutex
+	decisions []Decision
+}
+
+// maxDecisions caps a number of decisions kept for a single request, so debug headers stay small.
+const maxDecisions = 10
+
+// Decisions returns the collected decisions in the order they were made.
+func (t *Trace) Decisions() []Decision {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	decisions := make([]Decision, len(t.decisions))
+	copy(decisions, t.decisions)
+	return decisions
+}
+
+type contextKey struct{}
+
+// NewContext returns a context collecting routing decisions into a new trace.
+func NewContext(ctx context.Context) (context.Context, *Trace) {
+	t := &Trace{}
+	return context.WithValue(ctx, contextKey{}, t), t
+}
+
+func record(ctx context.Context, decision Decision) {
+	t, ok := ctx.Value(contextKey{}).(*Trace)
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.decisions) < maxDecisions {
+		t.decisions = append(t.decisions, decision)
+	}
+}
@@ -0,0 +1,230 @@
+package shardroute
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/liteapi"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"go.uber.org/zap"
+)
+
+func shardBlock(shard uint64) ton.BlockIDExt {
+	return ton.BlockIDExt{BlockID: ton.BlockID{Workchain: 0, Shard: shard, Seqno: 1}}
+}
+
+func TestRouter_route(t *testing.T) {
+	// the first account is in the left half of the basechain, the second one is in the right half.
+	left := ton.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	right := ton.MustParseAccountID("0:a3935861f79daf59a13d6d182e1640210c02f98e3df18fda74b8f5ab141abf18")
+	now := time.Now()
+
+	r := NewRouter([]Server{{Name: "ls-1"}, {Name: "ls-2"}, {Name: "ls-3"}})
+	r.ObserveBlock(shardBlock(0x8000000000000000))
+
+	server, decision := r.route(left, -1, now)
+	require.Equal(t, 0, server)
+	require.Equal(t, Decision{Shard: "0:8000000000000000", Server: "ls-1", Reason: ReasonFallback}, decision)
+	server, _ = r.route(left, -1, now)
+	require.Equal(t, 1, server)
+
+	r.observe(0, left, 300*time.Millisecond, nil, now)
+	r.observe(1, left, 100*time.Millisecond, nil, now)
+	r.observe(2, left, 10*time.Millisecond, errors.New("timeout"), now)
+	server, decision = r.route(right, -1, now)
+	require.Equal(t, 1, server)
+	require.Equal(t, Decision{Shard: "0:8000000000000000", Server: "ls-2", Reason: ReasonTracking}, decision)
+
+	// after the split, stats of the parent shard are carried over to both children.
+	r.ObserveBlock(shardBlock(0x4000000000000000))
+	r.ObserveBlock(shardBlock(0xc000000000000000))
+	require.Len(t, r.shards[0], 2)
+	server, decision = r.route(left, -1, now)
+	require.Equal(t, 1, server)
+	require.Equal(t, Decision{Shard: "0:4000000000000000", Server: "ls-2", Reason: ReasonTracking}, decision)
+
+	r.observe(1, right, 10*time.Millisecond, errors.New("timeout"), now)
+	server, decision = r.route(right, -1, now)
+	require.Equal(t, 0, server)
+	require.Equal(t, Decision{Shard: "0:c000000000000000", Server: "ls-1", Reason: ReasonTracking}, decision)
+
+	// a successful response becomes stale after a while.
+	server, decision = r.route(right, -1, now.Add(staleAfter+time.Second))
+	require.Equal(t, ReasonFallback, decision.Reason)
+	require.NotEqual(t, 1, server)
+
+	// after the merge, there is a single shard again.
+	r.ObserveBlock(shardBlock(0x8000000000000000))
+	require.Len(t, r.shards[0], 1)
+}
+
+func TestQuery(t *testing.T) {
+	account := ton.MustParseAccountID("-1:5555555555555555555555555555555555555555555555555555555555555555")
+	r := NewRouter([]Server{{Name: "ls-1"}})
+
+	ctx, trace := NewContext(context.Background())
+	_, err := Query(ctx, r, account, func(ctx context.Context, client *liteapi.Client) (int, error) {
+		return 0, nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, []Decision{{Shard: "-1:8000000000000000", Server: "ls-1", Reason: ReasonFallback}}, trace.Decisions())
+
+	_, err = Query(ctx, r, account, func(ctx context.Context, client *liteapi.Client) (int, error) {
+		return 0, nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, ReasonTracking, trace.Decisions()[1].Reason)
+}
+
+func TestQuery_hedging(t *testing.T) {
+	account := ton.MustParseAccountID("-1:5555555555555555555555555555555555555555555555555555555555555555")
+	slow, fast := &liteapi.Client{}, &liteapi.Client{}
+
+	tests := []struct {
+		name      string
+		slowErr   error
+		fastErr   error
+		want      string
+		wantErr   bool
+		decisions []string
+	}{
+		{
+			name:      "backup wins",
+			want:      "fast",
+			decisions: []string{ReasonFallback, ReasonHedge},
+		},
+		{
+			name:      "backup fails",
+			fastErr:   errors.New("timeout"),
+			want:      "slow",
+			decisions: []string{ReasonFallback, ReasonHedge},
+		},
+		{
+			name:      "both fail",
+			slowErr:   errors.New("timeout"),
+			fastErr:   errors.New("timeout"),
+			wantErr:   true,
+			decisions: []string{ReasonFallback, ReasonHedge},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRouter([]Server{{Name: "ls-1", Client: slow}, {Name: "ls-2", Client: fast}}, WithHedging(10*time.Millisecond))
+			ctx, trace := NewContext(context.Background())
+			value, err := Query(ctx, r, account, func(ctx context.Context, client *liteapi.Client) (string, error) {
+				if client == fast {
+					return "fast", tt.fastErr
+				}
+				if tt.fastErr == nil {
+					// the slow server is canceled once the fast one responds.
+					<-ctx.Done()
+					return "", ctx.Err()
+				}
+				time.Sleep(50 * time.Millisecond)
+				return "slow", tt.slowErr
+			})
+			if tt.wantErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.want, value)
+			}
+			var reasons []string
+			for _, d := range trace.Decisions() {
+				reasons = append(reasons, d.Reason)
+			}
+			require.Equal(t, tt.decisions, reasons)
+			require.Equal(t, []string{"ls-1", "ls-2"}, []string{trace.Decisions()[0].Server, trace.Decisions()[1].Server})
+		})
+	}
+
+	// a fast response doesn't trigger hedging.
+	r := NewRouter([]Server{{Name: "ls-1", Client: slow}, {Name: "ls-2", Client: fast}}, WithHedging(time.Second))
+	ctx, trace := NewContext(context.Background())
+	value, err := Query(ctx, r, account, func(ctx context.Context, client *liteapi.Client) (string, error) {
+		return "ok", nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, "ok", value)
+	require.Len(t, trace.Decisions(), 1)
+}
+
+func TestQuery_verification(t *testing.T) {
+	account := ton.MustParseAccountID("-1:5555555555555555555555555555555555555555555555555555555555555555")
+	first, second := &liteapi.Client{}, &liteapi.Client{}
+
+	tests := []struct {
+		name        string
+		secondValue string
+		secondErr   error
+		result      string
+	}{
+		{name: "match", secondValue: "balance=10", result: "match"},
+		{name: "mismatch", secondValue: "balance=20", result: "mismatch"},
+		{name: "verifier fails", secondErr: errors.New("timeout"), result: "error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRouter([]Server{{Name: "ls-1", Client: first}, {Name: "ls-2", Client: second}}, WithVerification(1, zap.NewNop()))
+			before := testutil.ToFloat64(verificationsMetric.WithLabelValues(tt.result))
+			verified := make(chan struct{})
+			ctx, trace := NewContext(context.Background())
+			value, err := Query(ctx, r, account, func(ctx context.Context, client *liteapi.Client) (string, error) {
+				if client == first {
+					return "balance=10", nil
+				}
+				defer close(verified)
+				return tt.secondValue, tt.secondErr
+			})
+			// the client gets the response of the first server regardless of the verification.
+			require.Nil(t, err)
+			require.Equal(t, "balance=10", value)
+			require.Equal(t, []Decision{
+				{Shard: "-1:8000000000000000", Server: "ls-1", Reason: ReasonFallback},
+				{Shard: "-1:8000000000000000", Server: "ls-2", Reason: ReasonVerify},
+			}, trace.Decisions())
+			<-verified
+			require.Eventually(t, func() bool {
+				return testutil.ToFloat64(verificationsMetric.WithLabelValues(tt.result)) == before+1
+			}, time.Second, time.Millisecond)
+		})
+	}
+
+	// accounts are compared only if both servers have seen the same last transaction.
+	for name, tt := range map[string]struct {
+		second tlb.ShardAccount
+		result string
+	}{
+		"same transaction": {second: tlb.ShardAccount{LastTransLt: 10, LastTransHash: tlb.Bits256{2}}, result: "mismatch"},
+		"new transaction":  {second: tlb.ShardAccount{LastTransLt: 20, LastTransHash: tlb.Bits256{2}}, result: "lagging"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := NewRouter([]Server{{Name: "ls-1", Client: first}, {Name: "ls-2", Client: second}}, WithVerification(1, zap.NewNop()))
+			before := testutil.ToFloat64(verificationsMetric.WithLabelValues(tt.result))
+			_, err := Query(context.Background(), r, account, func(ctx context.Context, client *liteapi.Client) (tlb.ShardAccount, error) {
+				if client == first {
+					return tlb.ShardAccount{LastTransLt: 10, LastTransHash: tlb.Bits256{1}}, nil
+				}
+				return tt.second, nil
+			})
+			require.Nil(t, err)
+			require.Eventually(t, func() bool {
+				return testutil.ToFloat64(verificationsMetric.WithLabelValues(tt.result)) == before+1
+			}, time.Second, time.Millisecond)
+		})
+	}
+
+	// failed queries are not verified.
+	r := NewRouter([]Server{{Name: "ls-1", Client: first}, {Name: "ls-2", Client: second}}, WithVerification(1, zap.NewNop()))
+	ctx, trace := NewContext(context.Background())
+	_, err := Query(ctx, r, account, func(ctx context.Context, client *liteapi.Client) (string, error) {
+		return "", errors.New("timeout")
+	})
+	require.NotNil(t, err)
+	require.Len(t, trace.Decisions(), 1)
+}
@@ -0,0 +1,178 @@
+// Package slowlog keeps track of slow requests along with a breakdown of time spent in upstream calls.
+//
+// A request handler attaches a Breakdown to the request context with NewContext,
+// storage methods report their timings with Record,
+// and the request ends up in a Log if it has taken longer than a threshold.
+package slowlog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Kinds of upstream calls.
+const (
+	KindLiteServer    = "liteserver"
+	KindCache         = "cache"
+	KindEmulation     = "emulation"
+	KindSerialization = "serialization"
+)
+
+// Kinds lists kinds of upstream calls in the order they are reported.
+var Kinds = []string{KindLiteServer, KindCache, KindEmulation, KindSerialization}
+
+// Span is time spent in a single upstream call.
+type Span struct {
+	Kind     string        `json:"kind"`
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// Total is time spent in all upstream calls of the same kind.
+type Total struct {
+	Kind     string
+	Duration time.Duration
+	Count    int
+}
+
+// Breakdown collects spans of a single request.
+type Breakdown struct {
+	mu    sync.Mutex
+	spans []Span
+	// totals sum up all spans by kind, including ones beyond maxSpans, so usage is metered in full.
+	totals map[string]Total
+}
+
+// maxSpans caps a number of spans of a single request, so a request walking a long history doesn't eat the memory.
+const maxSpans = 1000
+
+// Spans returns the collected spans in the order they were recorded.
+func (b *Breakdown) Spans() []Span {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	spans := make([]Span, len(b.spans))
+	copy(spans, b.spans)
+	return spans
+}
+
+// Totals sums up all recorded spans by kind, even ones beyond the cap of Spans,
+// every kind of Kinds is reported even if there are no spans of it.
+func (b *Breakdown) Totals() []Total {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	totals := make([]Total, 0, len(Kinds))
+	for _, kind := range Kinds {
+		total := b.totals[kind]
+		total.Kind = kind
+		totals = append(totals, total)
+	}
+	return totals
+}
+
+func (b *Breakdown) add(span Span) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.totals == nil {
+		b.totals = make(map[string]Total, len(Kinds))
+	}
+	total := b.totals[span.Kind]
+	total.Duration += span.Duration
+	total.Count += 1
+	b.totals[span.Kind] = total
+	if len(b.spans) < maxSpans {
+		b.spans = append(b.spans, span)
+	}
+}
+
+type contextKey struct{}
+
+// NewContext returns a context collecting spans into a new breakdown.
+// If the context collects spans already, its breakdown is returned, so a request has a single breakdown.
+func NewContext(ctx context.Context) (context.Context, *Breakdown) {
+	if b, ok := ctx.Value(contextKey{}).(*Breakdown); ok {
+		return ctx, b
+	}
+	b := &Breakdown{}
+	return context.WithValue(ctx, contextKey{}, b), b
+}
+
+// Record adds a span of a storage call to the breakdown of the given context, if any.
+// Storage calls end up in lite servers.
+func Record(ctx context.Context, name string, duration time.Duration) {
+	RecordKind(ctx, KindLiteServer, name, duration)
+}
+
+// RecordKind adds a span of the given kind to the breakdown of the given context, if any.
+func RecordKind(ctx context.Context, kind, name string, duration time.Duration) {
+	if b, ok := ctx.Value(contextKey{}).(*Breakdown); ok {
+		b.add(Span{Kind: kind, Name: name, Duration: duration})
+	}
+}
+
+// Entry describes a slow request.
+type Entry struct {
+	Time      time.Time         `json:"time"`
+	Operation string            `json:"operation"`
+	Path      string            `json:"path"`
+	Params    map[string]string `json:"params,omitempty"`
+	Duration  time.Duration     `json:"duration_ns"`
+	Error     string            `json:"error,omitempty"`
+	Spans     []Span            `json:"spans,omitempty"`
+}
+
+// Log keeps the latest slow requests.
+type Log struct {
+	mu      sync.Mutex
+	entries []Entry
+	// next is an index in entries to overwrite once the log is full.
+	next int
+	size int
+}
+
+// NewLog returns a log keeping up to size latest entries.
+func NewLog(size int) *Log {
+	return &Log{size: size}
+}
+
+func (l *Log) Add(e Entry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.size == 0 {
+		return
+	}
+	if len(l.entries) < l.size {
+		l.entries = append(l.entries, e)
+		l.next = len(l.entries) % l.size
+		return
+	}
+	l.entries[l.next] = e
+	l.next = (l.next + 1) % l.size
+}
+
+// Entries returns the kept entries from the newest to the oldest one.
+func (l *Log) Entries() []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entries := make([]Entry, 0, len(l.entries))
+	for i := 0; i < len(l.entries); i++ {
+		idx := (l.next - 1 - i + 2*len(l.entries)) % len(l.entries)
+		entries = append(entries, l.entries[idx])
+	}
+	return entries
+}
+
+// Handler returns an http.Handler listing the kept entries.
+// It is supposed to be exposed on an internal port only.
+func (l *Log) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(l.Entries())
+	})
+}
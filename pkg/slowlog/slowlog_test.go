@@ -0,0 +1,75 @@
+package slowlog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	// no breakdown attached, nothing happens.
+	Record(context.Background(), "get_account", time.Second)
+
+	ctx, breakdown := NewContext(context.Background())
+	Record(ctx, "get_account", time.Second)
+	Record(ctx, "get_trace", 2*time.Second)
+	require.Equal(t, []Span{
+		{Kind: KindLiteServer, Name: "get_account", Duration: time.Second},
+		{Kind: KindLiteServer, Name: "get_trace", Duration: 2 * time.Second},
+	}, breakdown.Spans())
+
+	// a nested context keeps collecting spans into the same breakdown.
+	nested, same := NewContext(ctx)
+	require.Same(t, breakdown, same)
+	RecordKind(nested, KindEmulation, "emulate_trace", 3*time.Second)
+	require.Len(t, breakdown.Spans(), 3)
+}
+
+func TestBreakdown_Totals(t *testing.T) {
+	ctx, breakdown := NewContext(context.Background())
+	Record(ctx, "get_account", time.Second)
+	Record(ctx, "get_trace", 2*time.Second)
+	RecordKind(ctx, KindSerialization, "encode_response", time.Millisecond)
+	require.Equal(t, []Total{
+		{Kind: KindLiteServer, Duration: 3 * time.Second, Count: 2},
+		{Kind: KindCache},
+		{Kind: KindEmulation},
+		{Kind: KindSerialization, Duration: time.Millisecond, Count: 1},
+	}, breakdown.Totals())
+}
+
+func TestBreakdown_Totals_beyondMaxSpans(t *testing.T) {
+	ctx, breakdown := NewContext(context.Background())
+	for i := 0; i < maxSpans+500; i++ {
+		Record(ctx, "get_account", time.Millisecond)
+	}
+	require.Len(t, breakdown.Spans(), maxSpans)
+	require.Equal(t, Total{Kind: KindLiteServer, Duration: (maxSpans + 500) * time.Millisecond, Count: maxSpans + 500}, breakdown.Totals()[0])
+}
+
+func TestLog(t *testing.T) {
+	operations := func(entries []Entry) []string {
+		var ops []string
+		for _, e := range entries {
+			ops = append(ops, e.Operation)
+		}
+		return ops
+	}
+	log := NewLog(3)
+	require.Empty(t, log.Entries())
+
+	log.Add(Entry{Operation: "a"})
+	log.Add(Entry{Operation: "b"})
+	require.Equal(t, []string{"b", "a"}, operations(log.Entries()))
+
+	log.Add(Entry{Operation: "c"})
+	log.Add(Entry{Operation: "d"})
+	log.Add(Entry{Operation: "e"})
+	require.Equal(t, []string{"e", "d", "c"}, operations(log.Entries()))
+
+	disabled := NewLog(0)
+	disabled.Add(Entry{Operation: "a"})
+	require.Empty(t, disabled.Entries())
+}
@@ -0,0 +1,218 @@
+// Package tenant isolates several products served by one opentonapi instance.
+//
+// Each tenant has its own API tokens, a set of watched accounts and usage quotas.
+// A tenant can subscribe only to its own accounts,
+// and endpoints built on top of watched accounts return only the tenant's data.
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Narasimha1997/ratelimiter"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+// Quota limits the usage of an instance by a tenant, zero values mean no limit.
+type Quota struct {
+	// RequestsPerSecond limits REST requests.
+	RequestsPerSecond int `json:"requests_per_second"`
+	// MaxConnections limits simultaneously open streaming connections (SSE and websocket).
+	MaxConnections int `json:"max_connections"`
+}
+
+// Config describes a tenant.
+type Config struct {
+	Name     string            `json:"name"`
+	Tokens   []string          `json:"tokens"`
+	Accounts []tongo.AccountID `json:"accounts"`
+	Quota    Quota             `json:"quota"`
+}
+
+// Tenant is a namespace of a product sharing the instance with others.
+type Tenant struct {
+	name     string
+	quota    Quota
+	accounts map[tongo.AccountID]struct{}
+	limiter  *ratelimiter.DefaultLimiter
+
+	// mu protects connections.
+	mu          sync.Mutex
+	connections int
+}
+
+func newTenant(cfg Config) *Tenant {
+	t := &Tenant{
+		name:     cfg.Name,
+		quota:    cfg.Quota,
+		accounts: make(map[tongo.AccountID]struct{}, len(cfg.Accounts)),
+	}
+	for _, account := range cfg.Accounts {
+		t.accounts[account] = struct{}{}
+	}
+	if cfg.Quota.RequestsPerSecond > 0 {
+		t.limiter = ratelimiter.NewDefaultLimiter(uint64(cfg.Quota.RequestsPerSecond), time.Second)
+	}
+	return t
+}
+
+func (t *Tenant) Name() string {
+	return t.name
+}
+
+// Watches reports whether the account belongs to the tenant's watched accounts.
+func (t *Tenant) Watches(account tongo.AccountID) bool {
+	_, ok := t.accounts[account]
+	return ok
+}
+
+// WatchedAccounts returns the tenant's watched accounts.
+func (t *Tenant) WatchedAccounts() []tongo.AccountID {
+	accounts := make([]tongo.AccountID, 0, len(t.accounts))
+	for account := range t.accounts {
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// AllowRequest reports whether the tenant is within its requests per second quota.
+func (t *Tenant) AllowRequest() bool {
+	if t.limiter == nil {
+		return true
+	}
+	allow, _ := t.limiter.ShouldAllow(1)
+	return allow
+}
+
+// OpenConnection reserves a streaming connection and reports whether the tenant is within its quota.
+// Every successful call must be followed by CloseConnection.
+func (t *Tenant) OpenConnection() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.quota.MaxConnections > 0 && t.connections >= t.quota.MaxConnections {
+		return false
+	}
+	t.connections++
+	return true
+}
+
+// CloseConnection releases a connection reserved with OpenConnection.
+func (t *Tenant) CloseConnection() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.connections--
+}
+
+// Registry resolves API tokens to tenants.
+type Registry struct {
+	tenants []*Tenant
+	byToken map[string]*Tenant
+}
+
+// NewRegistry returns a registry of the given tenants.
+// Names and tokens must be unique across tenants.
+func NewRegistry(configs ...Config) (*Registry, error) {
+	r := &Registry{byToken: map[string]*Tenant{}}
+	names := map[string]struct{}{}
+	for _, cfg := range configs {
+		if cfg.Name == "" {
+			return nil, fmt.Errorf("tenant name is required")
+		}
+		if _, ok := names[cfg.Name]; ok {
+			return nil, fmt.Errorf("duplicate tenant %v", cfg.Name)
+		}
+		names[cfg.Name] = struct{}{}
+		t := newTenant(cfg)
+		for _, token := range cfg.Tokens {
+			if _, ok := r.byToken[token]; ok {
+				return nil, fmt.Errorf("token of tenant %v is already used by another tenant", cfg.Name)
+			}
+			r.byToken[token] = t
+		}
+		r.tenants = append(r.tenants, t)
+	}
+	return r, nil
+}
+
+// LoadFile reads a JSON list of Config from the given file and returns a registry.
+func LoadFile(path string) (*Registry, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var configs []Config
+	if err := json.Unmarshal(content, &configs); err != nil {
+		return nil, fmt.Errorf("failed to decode %v: %w", path, err)
+	}
+	return NewRegistry(configs...)
+}
+
+// Collection is a name of the repository collection with tenants, a record is a Config keyed by the tenant name.
+const Collection = "tenants"
+
+// LoadRepository reads tenants from the repository and returns a registry.
+// Tenants are managed in the repository directly, for example, to share them by several replicas.
+func LoadRepository(ctx context.Context, repo repository.Repository) (*Registry, error) {
+	records, err := repo.List(ctx, Collection)
+	if err != nil {
+		return nil, err
+	}
+	configs := make([]Config, 0, len(records))
+	for _, record := range records {
+		var cfg Config
+		if err := json.Unmarshal(record.Value, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to decode tenant %v: %w", record.Key, err)
+		}
+		configs = append(configs, cfg)
+	}
+	return NewRegistry(configs...)
+}
+
+// Len returns a number of tenants.
+func (r *Registry) Len() int {
+	return len(r.tenants)
+}
+
+// Authenticate returns a tenant owning the given token.
+func (r *Registry) Authenticate(token string) (*Tenant, bool) {
+	if token == "" {
+		return nil, false
+	}
+	t, ok := r.byToken[token]
+	return t, ok
+}
+
+// Accounts returns watched accounts of all tenants, the indexer has to track all of them.
+func (r *Registry) Accounts() []tongo.AccountID {
+	seen := map[tongo.AccountID]struct{}{}
+	var accounts []tongo.AccountID
+	for _, t := range r.tenants {
+		for account := range t.accounts {
+			if _, ok := seen[account]; ok {
+				continue
+			}
+			seen[account] = struct{}{}
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the given tenant.
+func NewContext(ctx context.Context, t *Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns a tenant of a request, if tenants are configured.
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	t, ok := ctx.Value(contextKey{}).(*Tenant)
+	return t, ok
+}
@@ -0,0 +1,128 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/repository"
+)
+
+func TestNewRegistry(t *testing.T) {
+	tests := []struct {
+		name    string
+		configs []Config
+		wantErr string
+	}{
+		{
+			name: "all good",
+			configs: []Config{
+				{Name: "wallet", Tokens: []string{"a", "b"}},
+				{Name: "exchange", Tokens: []string{"c"}},
+			},
+		},
+		{
+			name:    "no name",
+			configs: []Config{{Tokens: []string{"a"}}},
+			wantErr: "tenant name is required",
+		},
+		{
+			name:    "duplicate name",
+			configs: []Config{{Name: "wallet"}, {Name: "wallet"}},
+			wantErr: "duplicate tenant wallet",
+		},
+		{
+			name: "shared token",
+			configs: []Config{
+				{Name: "wallet", Tokens: []string{"a"}},
+				{Name: "exchange", Tokens: []string{"a"}},
+			},
+			wantErr: "token of tenant exchange is already used by another tenant",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRegistry(tt.configs...)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+		})
+	}
+}
+
+func TestRegistry_Authenticate(t *testing.T) {
+	first := tongo.MustParseAddress("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c").ID
+	second := tongo.MustParseAddress("0:408da3b28b6c065a593e10391269baaa9c5f8caebc0c69d9f0aabbab2a99256b").ID
+
+	registry, err := NewRegistry(
+		Config{Name: "wallet", Tokens: []string{"wallet-token"}, Accounts: []tongo.AccountID{first}},
+		Config{Name: "exchange", Tokens: []string{"exchange-token"}, Accounts: []tongo.AccountID{first, second}},
+	)
+	require.Nil(t, err)
+
+	_, ok := registry.Authenticate("")
+	require.False(t, ok)
+	_, ok = registry.Authenticate("unknown")
+	require.False(t, ok)
+
+	wallet, ok := registry.Authenticate("wallet-token")
+	require.True(t, ok)
+	require.Equal(t, "wallet", wallet.Name())
+	require.True(t, wallet.Watches(first))
+	require.False(t, wallet.Watches(second))
+
+	exchange, ok := registry.Authenticate("exchange-token")
+	require.True(t, ok)
+	require.ElementsMatch(t, []tongo.AccountID{first, second}, exchange.WatchedAccounts())
+
+	require.ElementsMatch(t, []tongo.AccountID{first, second}, registry.Accounts())
+
+	fromCtx, ok := FromContext(NewContext(context.Background(), wallet))
+	require.True(t, ok)
+	require.Equal(t, wallet, fromCtx)
+	_, ok = FromContext(context.Background())
+	require.False(t, ok)
+}
+
+func TestTenant_quotas(t *testing.T) {
+	tenant := newTenant(Config{Name: "wallet", Quota: Quota{RequestsPerSecond: 2, MaxConnections: 1}})
+
+	require.True(t, tenant.AllowRequest())
+	require.True(t, tenant.AllowRequest())
+	require.False(t, tenant.AllowRequest())
+
+	require.True(t, tenant.OpenConnection())
+	require.False(t, tenant.OpenConnection())
+	tenant.CloseConnection()
+	require.True(t, tenant.OpenConnection())
+
+	unlimited := newTenant(Config{Name: "exchange"})
+	for i := 0; i < 100; i++ {
+		require.True(t, unlimited.AllowRequest())
+		require.True(t, unlimited.OpenConnection())
+	}
+}
+
+func TestLoadRepository(t *testing.T) {
+	repo := repository.NewMemory()
+	registry, err := LoadRepository(context.Background(), repo)
+	require.Nil(t, err)
+	require.Equal(t, 0, registry.Len())
+
+	require.Nil(t, repo.Put(context.Background(), Collection, repository.Record{Key: "wallet", Value: []byte(`{"name":"wallet","tokens":["a"]}`)}))
+	require.Nil(t, repo.Put(context.Background(), Collection, repository.Record{Key: "exchange", Value: []byte(`{"name":"exchange","tokens":["b"]}`)}))
+	registry, err = LoadRepository(context.Background(), repo)
+	require.Nil(t, err)
+	require.Equal(t, 2, registry.Len())
+	tenant, ok := registry.Authenticate("b")
+	require.True(t, ok)
+	require.Equal(t, "exchange", tenant.Name())
+
+	require.Nil(t, repo.Put(context.Background(), Collection, repository.Record{Key: "broken", Value: []byte(`[]`)}))
+	_, err = LoadRepository(context.Background(), repo)
+	require.ErrorContains(t, err, "failed to decode tenant broken")
+}
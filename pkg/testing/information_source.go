@@ -0,0 +1,101 @@
+package testing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// FakeInformationSource is a scriptable implementation of core.InformationSource.
+// It answers from the configured maps and records all requested accounts,
+// so a test can check what core.CollectAdditionalInfo asked for.
+type FakeInformationSource struct {
+	JettonMasters map[tongo.AccountID]tongo.AccountID
+	NftSales      map[tongo.AccountID]core.NftSaleContract
+	Pools         map[tongo.AccountID]core.STONfiPool
+	// DNSRecords are returned by DNSRecordsBefore regardless of the requested block.
+	DNSRecords map[tongo.AccountID]map[tlb.Bits256]tlb.DNSRecord
+
+	// JettonMastersErr, NftSaleContractsErr and STONfiPoolsErr, if set, are returned by the corresponding methods.
+	JettonMastersErr    error
+	NftSaleContractsErr error
+	STONfiPoolsErr      error
+
+	// mu protects the fields below.
+	mu                     sync.Mutex
+	RequestedJettonWallets []tongo.AccountID
+	RequestedSaleContracts []tongo.AccountID
+	RequestedPools         []tongo.AccountID
+	RequestedDNSItems      []tongo.AccountID
+}
+
+var _ core.InformationSource = (*FakeInformationSource)(nil)
+var _ core.DNSRecordsSource = (*FakeInformationSource)(nil)
+
+func NewFakeInformationSource() *FakeInformationSource {
+	return &FakeInformationSource{
+		JettonMasters: map[tongo.AccountID]tongo.AccountID{},
+		NftSales:      map[tongo.AccountID]core.NftSaleContract{},
+		Pools:         map[tongo.AccountID]core.STONfiPool{},
+		DNSRecords:    map[tongo.AccountID]map[tlb.Bits256]tlb.DNSRecord{},
+	}
+}
+
+func (s *FakeInformationSource) JettonMastersForWallets(ctx context.Context, wallets []tongo.AccountID) (map[tongo.AccountID]tongo.AccountID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.RequestedJettonWallets = append(s.RequestedJettonWallets, wallets...)
+	if s.JettonMastersErr != nil {
+		return nil, s.JettonMastersErr
+	}
+	result := make(map[tongo.AccountID]tongo.AccountID, len(wallets))
+	for _, wallet := range wallets {
+		if master, ok := s.JettonMasters[wallet]; ok {
+			result[wallet] = master
+		}
+	}
+	return result, nil
+}
+
+func (s *FakeInformationSource) NftSaleContracts(ctx context.Context, contracts []tongo.AccountID) (map[tongo.AccountID]core.NftSaleContract, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.RequestedSaleContracts = append(s.RequestedSaleContracts, contracts...)
+	if s.NftSaleContractsErr != nil {
+		return nil, s.NftSaleContractsErr
+	}
+	result := make(map[tongo.AccountID]core.NftSaleContract, len(contracts))
+	for _, contract := range contracts {
+		if sale, ok := s.NftSales[contract]; ok {
+			result[contract] = sale
+		}
+	}
+	return result, nil
+}
+
+func (s *FakeInformationSource) STONfiPools(ctx context.Context, poolIDs []tongo.AccountID) (map[tongo.AccountID]core.STONfiPool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.RequestedPools = append(s.RequestedPools, poolIDs...)
+	if s.STONfiPoolsErr != nil {
+		return nil, s.STONfiPoolsErr
+	}
+	result := make(map[tongo.AccountID]core.STONfiPool, len(poolIDs))
+	for _, id := range poolIDs {
+		if pool, ok := s.Pools[id]; ok {
+			result[id] = pool
+		}
+	}
+	return result, nil
+}
+
+func (s *FakeInformationSource) DNSRecordsBefore(ctx context.Context, item tongo.AccountID, block tongo.BlockID) (map[tlb.Bits256]tlb.DNSRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.RequestedDNSItems = append(s.RequestedDNSItems, item)
+	return s.DNSRecords[item], nil
+}
@@ -0,0 +1,330 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/tonkeeper/tongo"
+
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+)
+
+// FakeTransactionSource is an in-memory implementation of sources.TransactionSource.
+// Events published with Publish are delivered synchronously to matching subscribers.
+type FakeTransactionSource struct {
+	mu          sync.Mutex
+	currentID   int
+	subscribers map[int]fakeTxSubscriber
+}
+
+type fakeTxSubscriber struct {
+	fn   sources.DeliveryFn
+	opts sources.SubscribeToTransactionsOptions
+}
+
+var _ sources.TransactionSource = (*FakeTransactionSource)(nil)
+
+func NewFakeTransactionSource() *FakeTransactionSource {
+	return &FakeTransactionSource{subscribers: map[int]fakeTxSubscriber{}}
+}
+
+func (s *FakeTransactionSource) SubscribeToTransactions(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTransactionsOptions) sources.CancelFn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.currentID += 1
+	id := s.currentID
+	s.subscribers[id] = fakeTxSubscriber{fn: deliveryFn, opts: opts}
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers, id)
+	}
+}
+
+// Subscriptions returns options of all active subscriptions.
+func (s *FakeTransactionSource) Subscriptions() []sources.SubscribeToTransactionsOptions {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	opts := make([]sources.SubscribeToTransactionsOptions, 0, len(s.subscribers))
+	for _, sub := range s.subscribers {
+		opts = append(opts, sub.opts)
+	}
+	return opts
+}
+
+// Publish delivers a notification about a transaction to subscribers.
+// opName is optional and is matched against SubscribeToTransactionsOptions.Operations along with opCode.
+func (s *FakeTransactionSource) Publish(tx sources.TransactionEventData, opName string, opCode *uint32) error {
+	eventData, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	var fns []sources.DeliveryFn
+	s.mu.Lock()
+	for _, sub := range s.subscribers {
+		if !sub.opts.AllAccounts && !containsAccount(sub.opts.Accounts, tx.AccountID) {
+			continue
+		}
+		if !sub.opts.AllOperations && !matchOperation(sub.opts.Operations, opName, opCode) {
+			continue
+		}
+		fns = append(fns, sub.fn)
+	}
+	s.mu.Unlock()
+	deliver(fns, eventData)
+	return nil
+}
+
+// deliver calls delivery functions without holding a lock of a source,
+// so a subscriber can cancel its subscription from inside its delivery function.
+func deliver(fns []sources.DeliveryFn, eventData []byte) {
+	for _, fn := range fns {
+		fn(eventData)
+	}
+}
+
+func matchOperation(operations []string, opName string, opCode *uint32) bool {
+	for _, op := range operations {
+		if opName != "" && op == opName {
+			return true
+		}
+		if opCode != nil && op == fmt.Sprintf("0x%08x", *opCode) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAccount(accounts []tongo.AccountID, account tongo.AccountID) bool {
+	for _, a := range accounts {
+		if a == account {
+			return true
+		}
+	}
+	return false
+}
+
+// FakeTraceSource is an in-memory implementation of sources.TraceSource.
+type FakeTraceSource struct {
+	mu          sync.Mutex
+	currentID   int
+	subscribers map[int]fakeTraceSubscriber
+}
+
+type fakeTraceSubscriber struct {
+	fn   sources.DeliveryFn
+	opts sources.SubscribeToTraceOptions
+}
+
+var _ sources.TraceSource = (*FakeTraceSource)(nil)
+
+func NewFakeTraceSource() *FakeTraceSource {
+	return &FakeTraceSource{subscribers: map[int]fakeTraceSubscriber{}}
+}
+
+func (s *FakeTraceSource) SubscribeToTraces(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToTraceOptions) sources.CancelFn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.currentID += 1
+	id := s.currentID
+	s.subscribers[id] = fakeTraceSubscriber{fn: deliveryFn, opts: opts}
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers, id)
+	}
+}
+
+// Publish delivers a notification about a completed trace to subscribers
+// interested in at least one of the trace's accounts.
+func (s *FakeTraceSource) Publish(trace sources.TraceEventData) error {
+	eventData, err := json.Marshal(trace)
+	if err != nil {
+		return err
+	}
+	var fns []sources.DeliveryFn
+	s.mu.Lock()
+	for _, sub := range s.subscribers {
+		if sub.opts.AllAccounts {
+			fns = append(fns, sub.fn)
+			continue
+		}
+		for _, account := range trace.AccountIDs {
+			if containsAccount(sub.opts.Accounts, account) {
+				fns = append(fns, sub.fn)
+				break
+			}
+		}
+	}
+	s.mu.Unlock()
+	deliver(fns, eventData)
+	return nil
+}
+
+// FakeMemPoolSource is an in-memory implementation of sources.MemPoolSource.
+type FakeMemPoolSource struct {
+	mu          sync.Mutex
+	currentID   int
+	subscribers map[int]fakeMemPoolSubscriber
+	// Err, if set, is returned by SubscribeToMessages.
+	Err error
+}
+
+type fakeMemPoolSubscriber struct {
+	fn   sources.DeliveryFn
+	opts sources.SubscribeToMempoolOptions
+}
+
+var _ sources.MemPoolSource = (*FakeMemPoolSource)(nil)
+
+func NewFakeMemPoolSource() *FakeMemPoolSource {
+	return &FakeMemPoolSource{subscribers: map[int]fakeMemPoolSubscriber{}}
+}
+
+func (s *FakeMemPoolSource) SubscribeToMessages(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToMempoolOptions) (sources.CancelFn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	s.currentID += 1
+	id := s.currentID
+	s.subscribers[id] = fakeMemPoolSubscriber{fn: deliveryFn, opts: opts}
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers, id)
+	}, nil
+}
+
+// Publish delivers a pending message to subscribers.
+// Regular subscribers receive MessageEventData,
+// subscribers with accounts receive EmulationMessageEventData if involvedAccounts contains any of their accounts.
+func (s *FakeMemPoolSource) Publish(boc []byte, involvedAccounts []tongo.AccountID) error {
+	regular, err := json.Marshal(sources.MessageEventData{BOC: boc})
+	if err != nil {
+		return err
+	}
+	emulation, err := json.Marshal(sources.EmulationMessageEventData{BOC: boc, InvolvedAccounts: involvedAccounts})
+	if err != nil {
+		return err
+	}
+	var regularFns, emulationFns []sources.DeliveryFn
+	s.mu.Lock()
+	for _, sub := range s.subscribers {
+		if len(sub.opts.Accounts) == 0 {
+			regularFns = append(regularFns, sub.fn)
+			continue
+		}
+		for _, account := range involvedAccounts {
+			if containsAccount(sub.opts.Accounts, account) {
+				emulationFns = append(emulationFns, sub.fn)
+				break
+			}
+		}
+	}
+	s.mu.Unlock()
+	deliver(regularFns, regular)
+	deliver(emulationFns, emulation)
+	return nil
+}
+
+// FakeBlockSource is an in-memory implementation of both sources.BlockSource and sources.BlockHeadersSource.
+type FakeBlockSource struct {
+	mu                sync.Mutex
+	currentID         int
+	headerSubscribers map[int]fakeBlockHeadersSubscriber
+	blockSubscribers  map[int]fakeBlockSubscriber
+	// Err, if set, is returned by SubscribeToBlocks.
+	Err error
+}
+
+type fakeBlockHeadersSubscriber struct {
+	fn   sources.DeliveryFn
+	opts sources.SubscribeToBlockHeadersOptions
+}
+
+type fakeBlockSubscriber struct {
+	fn   sources.DeliveryFn
+	opts sources.SubscribeToBlocksOptions
+}
+
+var _ sources.BlockSource = (*FakeBlockSource)(nil)
+var _ sources.BlockHeadersSource = (*FakeBlockSource)(nil)
+
+func NewFakeBlockSource() *FakeBlockSource {
+	return &FakeBlockSource{
+		headerSubscribers: map[int]fakeBlockHeadersSubscriber{},
+		blockSubscribers:  map[int]fakeBlockSubscriber{},
+	}
+}
+
+func (s *FakeBlockSource) SubscribeToBlockHeaders(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToBlockHeadersOptions) sources.CancelFn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.currentID += 1
+	id := s.currentID
+	s.headerSubscribers[id] = fakeBlockHeadersSubscriber{fn: deliveryFn, opts: opts}
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.headerSubscribers, id)
+	}
+}
+
+func (s *FakeBlockSource) SubscribeToBlocks(ctx context.Context, deliveryFn sources.DeliveryFn, opts sources.SubscribeToBlocksOptions) (sources.CancelFn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	s.currentID += 1
+	id := s.currentID
+	s.blockSubscribers[id] = fakeBlockSubscriber{fn: deliveryFn, opts: opts}
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.blockSubscribers, id)
+	}, nil
+}
+
+// PublishHeader delivers a notification about a new block to subscribers of the block's workchain.
+func (s *FakeBlockSource) PublishHeader(block sources.BlockEvent) error {
+	eventData, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+	var fns []sources.DeliveryFn
+	s.mu.Lock()
+	for _, sub := range s.headerSubscribers {
+		if sub.opts.Workchain != nil && *sub.opts.Workchain != int(block.Workchain) {
+			continue
+		}
+		fns = append(fns, sub.fn)
+	}
+	s.mu.Unlock()
+	deliver(fns, eventData)
+	return nil
+}
+
+// PublishSlice delivers a blockchain slice to subscribers
+// which asked to start from a masterchain seqno not greater than the slice's one.
+func (s *FakeBlockSource) PublishSlice(slice sources.BlockchainSliceEvent) error {
+	eventData, err := json.Marshal(slice)
+	if err != nil {
+		return err
+	}
+	var fns []sources.DeliveryFn
+	s.mu.Lock()
+	for _, sub := range s.blockSubscribers {
+		if sub.opts.MasterchainSeqno > slice.MasterchainSeqno {
+			continue
+		}
+		fns = append(fns, sub.fn)
+	}
+	s.mu.Unlock()
+	deliver(fns, eventData)
+	return nil
+}
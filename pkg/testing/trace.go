@@ -0,0 +1,160 @@
+package testing
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+)
+
+// lastLt is used to assign unique logical times to synthesized transactions.
+var lastLt uint64 = 1_000_000
+
+// TraceOption configures a node of a trace created by NewTrace.
+type TraceOption func(trace *core.Trace)
+
+// NewTrace synthesizes a successful transaction of the given account and wraps it into a trace node.
+// Unless overridden by options, the node gets a unique lt and a hash derived from the account and lt.
+// Children without an inbound message get an internal message sent by this node's account.
+func NewTrace(account tongo.AccountID, opts ...TraceOption) *core.Trace {
+	lt := atomic.AddUint64(&lastLt, 1)
+	trace := &core.Trace{
+		Transaction: core.Transaction{
+			TransactionID: core.TransactionID{
+				Hash:    tongo.Bits256(sha256.Sum256([]byte(fmt.Sprintf("%v/%d", account.ToRaw(), lt)))),
+				Lt:      lt,
+				Account: account,
+			},
+			Type:       core.OrdinaryTx,
+			Success:    true,
+			OrigStatus: tlb.AccountActive,
+			EndStatus:  tlb.AccountActive,
+		},
+	}
+	for _, o := range opts {
+		o(trace)
+	}
+	return trace
+}
+
+func WithHash(hash tongo.Bits256) TraceOption {
+	return func(trace *core.Trace) {
+		trace.Hash = hash
+	}
+}
+
+func WithLt(lt uint64) TraceOption {
+	return func(trace *core.Trace) {
+		trace.Lt = lt
+	}
+}
+
+func WithUtime(utime int64) TraceOption {
+	return func(trace *core.Trace) {
+		trace.Utime = utime
+	}
+}
+
+func WithTotalFee(fee int64) TraceOption {
+	return func(trace *core.Trace) {
+		trace.TotalFee = fee
+	}
+}
+
+// WithFailure marks the transaction as failed with the given compute phase exit code.
+func WithFailure(exitCode int32) TraceOption {
+	return func(trace *core.Trace) {
+		trace.Success = false
+		trace.ComputePhase = &core.TxComputePhase{ExitCode: exitCode}
+	}
+}
+
+func WithInterfaces(interfaces ...abi.ContractInterface) TraceOption {
+	return func(trace *core.Trace) {
+		trace.AccountInterfaces = interfaces
+	}
+}
+
+func WithAdditionalInfo(info *core.TraceAdditionalInfo) TraceOption {
+	return func(trace *core.Trace) {
+		trace.SetAdditionalInfo(info)
+	}
+}
+
+// WithInMsg sets an inbound message of the transaction.
+// The message's destination is set to the transaction's account.
+func WithInMsg(msg core.Message) TraceOption {
+	return func(trace *core.Trace) {
+		msg.Destination = &trace.Account
+		if msg.CreatedLt == 0 && trace.Lt > 0 {
+			msg.CreatedLt = trace.Lt - 1
+		}
+		trace.InMsg = &msg
+	}
+}
+
+// WithExternalInMsg makes the transaction a root of a trace triggered by an external message.
+func WithExternalInMsg() TraceOption {
+	return WithInMsg(core.Message{MsgType: core.ExtInMsg})
+}
+
+// WithInternalMsg sets an internal inbound message from the given account carrying the given value.
+func WithInternalMsg(source tongo.AccountID, value int64) TraceOption {
+	return WithInMsg(core.Message{
+		MessageID: core.MessageID{Source: &source},
+		MsgType:   core.IntMsg,
+		Value:     value,
+		Bounce:    true,
+	})
+}
+
+// WithOperation sets an operation code and a decoded body of the inbound message.
+// It creates an internal message if the transaction doesn't have an inbound message yet.
+func WithOperation(operation string, opCode uint32, value any) TraceOption {
+	return func(trace *core.Trace) {
+		if trace.InMsg == nil {
+			WithInMsg(core.Message{MsgType: core.IntMsg})(trace)
+		}
+		trace.InMsg.OpCode = &opCode
+		trace.InMsg.DecodedBody = &core.DecodedMessageBody{
+			Operation: operation,
+			Value:     value,
+		}
+	}
+}
+
+// WithChildren attaches the given nodes as children.
+// Each child without an inbound message gets an internal message from this node's account,
+// each child with an internal message without a source gets this node's account as the source.
+func WithChildren(children ...*core.Trace) TraceOption {
+	return func(trace *core.Trace) {
+		for _, child := range children {
+			if child.InMsg == nil {
+				WithInMsg(core.Message{MsgType: core.IntMsg, Bounce: true})(child)
+			}
+			if child.InMsg.MsgType == core.IntMsg && child.InMsg.Source == nil {
+				source := trace.Account
+				child.InMsg.Source = &source
+			}
+			trace.Children = append(trace.Children, child)
+		}
+	}
+}
+
+// WithPendingOutMsg adds an outbound internal message to the given account
+// which hasn't been processed yet, so the trace is considered in progress.
+func WithPendingOutMsg(destination tongo.AccountID, value int64) TraceOption {
+	return func(trace *core.Trace) {
+		source := trace.Account
+		trace.OutMsgs = append(trace.OutMsgs, core.Message{
+			MessageID: core.MessageID{CreatedLt: trace.Lt + 1, Source: &source, Destination: &destination},
+			MsgType:   core.IntMsg,
+			Value:     value,
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/tlb"
+
+	"github.com/tonkeeper/opentonapi/pkg/core"
+	"github.com/tonkeeper/opentonapi/pkg/pusher/sources"
+)
+
+var (
+	wallet       = tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580351")
+	jettonWallet = tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580352")
+	jettonMaster = tongo.MustParseAccountID("0:0a95e1d4ebe7860d051f8b861730dbdee1440fd11180211914e0089146580353")
+)
+
+func TestNewTrace(t *testing.T) {
+	trace := NewTrace(wallet,
+		WithExternalInMsg(),
+		WithChildren(
+			NewTrace(jettonWallet, WithOperation(abi.JettonTransferMsgOp, 0x0f8a7ea5, nil)),
+		),
+	)
+	require.Len(t, trace.Children, 1)
+	child := trace.Children[0]
+	require.Equal(t, wallet, *child.InMsg.Source)
+	require.Equal(t, jettonWallet, *child.InMsg.Destination)
+	require.NotEqual(t, trace.Hash, child.Hash)
+	require.False(t, trace.InProgress())
+
+	info := NewFakeInformationSource()
+	info.JettonMasters[jettonWallet] = jettonMaster
+	err := core.CollectAdditionalInfo(context.Background(), info, trace)
+	require.Nil(t, err)
+	require.Equal(t, []tongo.AccountID{jettonWallet}, info.RequestedJettonWallets)
+	master, ok := child.AdditionalInfo().JettonMaster(jettonWallet)
+	require.True(t, ok)
+	require.Equal(t, jettonMaster, master)
+
+	pending := NewTrace(wallet, WithPendingOutMsg(jettonWallet, 100))
+	require.True(t, pending.InProgress())
+}
+
+func TestCollectAdditionalInfo_dnsRecords(t *testing.T) {
+	record := tlb.DNSRecord{SumType: "DNSStorageAddress", DNSStorageAddress: tlb.Bits256{1}}
+	trace := NewTrace(wallet,
+		WithExternalInMsg(),
+		WithChildren(
+			NewTrace(jettonWallet, WithOperation(abi.ChangeDnsRecordMsgOp, 0x4eb1f0f9, abi.ChangeDnsRecordMsgBody{Key: tlb.Bits256{2}})),
+			// a renewal doesn't change records.
+			NewTrace(jettonMaster, WithOperation(abi.DeleteDnsRecordMsgOp, 0x4eb1f0f9, abi.DeleteDnsRecordMsgBody{})),
+		),
+	)
+	info := NewFakeInformationSource()
+	info.DNSRecords[jettonWallet] = map[tlb.Bits256]tlb.DNSRecord{{2}: record}
+	err := core.CollectAdditionalInfo(context.Background(), info, trace)
+	require.Nil(t, err)
+	require.Equal(t, []tongo.AccountID{jettonWallet}, info.RequestedDNSItems)
+	require.Equal(t, record, trace.Children[0].AdditionalInfo().DNSRecords[tlb.Bits256{2}])
+	require.Nil(t, trace.Children[1].AdditionalInfo().DNSRecords)
+}
+
+func TestCollectAdditionalInfo_failingSource(t *testing.T) {
+	trace := NewTrace(wallet,
+		WithExternalInMsg(),
+		WithChildren(
+			NewTrace(jettonWallet, WithOperation(abi.JettonTransferMsgOp, 0x0f8a7ea5, nil)),
+		),
+	)
+	info := NewFakeInformationSource()
+	info.JettonMasters[jettonWallet] = jettonMaster
+	info.JettonMastersErr = errors.New("lite server timeout")
+	err := core.CollectAdditionalInfo(context.Background(), info, trace)
+	require.ErrorIs(t, err, info.JettonMastersErr)
+	// the wallet doesn't rely on the failed source, so its info is complete.
+	require.False(t, trace.AdditionalInfo().Incomplete)
+	require.True(t, trace.Children[0].AdditionalInfo().Incomplete)
+
+	// an incomplete trace is inspected again once the source recovers.
+	info.JettonMastersErr = nil
+	err = core.CollectAdditionalInfo(context.Background(), info, trace)
+	require.Nil(t, err)
+	require.False(t, trace.Children[0].AdditionalInfo().Incomplete)
+	master, ok := trace.Children[0].AdditionalInfo().JettonMaster(jettonWallet)
+	require.True(t, ok)
+	require.Equal(t, jettonMaster, master)
+}
+
+func TestFakeTransactionSource(t *testing.T) {
+	source := NewFakeTransactionSource()
+	var all, filtered [][]byte
+	source.SubscribeToTransactions(context.Background(), func(data []byte) {
+		all = append(all, data)
+	}, sources.SubscribeToTransactionsOptions{AllAccounts: true, AllOperations: true})
+	cancel := source.SubscribeToTransactions(context.Background(), func(data []byte) {
+		filtered = append(filtered, data)
+	}, sources.SubscribeToTransactionsOptions{Accounts: []tongo.AccountID{wallet}, Operations: []string{"JettonTransfer"}})
+
+	require.Nil(t, source.Publish(sources.TransactionEventData{AccountID: wallet, Lt: 1}, "JettonTransfer", nil))
+	require.Nil(t, source.Publish(sources.TransactionEventData{AccountID: wallet, Lt: 2}, "TextComment", nil))
+	require.Nil(t, source.Publish(sources.TransactionEventData{AccountID: jettonWallet, Lt: 3}, "JettonTransfer", nil))
+	cancel()
+	require.Nil(t, source.Publish(sources.TransactionEventData{AccountID: wallet, Lt: 4}, "JettonTransfer", nil))
+
+	require.Len(t, all, 4)
+	require.Len(t, filtered, 1)
+	var tx sources.TransactionEventData
+	require.Nil(t, json.Unmarshal(filtered[0], &tx))
+	require.Equal(t, uint64(1), tx.Lt)
+}
+
+func TestFakeTransactionSource_cancelFromDelivery(t *testing.T) {
+	source := NewFakeTransactionSource()
+	delivered := 0
+	var cancel sources.CancelFn
+	cancel = source.SubscribeToTransactions(context.Background(), func(data []byte) {
+		delivered++
+		cancel()
+	}, sources.SubscribeToTransactionsOptions{AllAccounts: true, AllOperations: true})
+
+	require.Nil(t, source.Publish(sources.TransactionEventData{AccountID: wallet, Lt: 1}, "", nil))
+	require.Nil(t, source.Publish(sources.TransactionEventData{AccountID: wallet, Lt: 2}, "", nil))
+	require.Equal(t, 1, delivered)
+	require.Empty(t, source.Subscriptions())
+}
@@ -0,0 +1,90 @@
+// Package unresolved keeps track of parts of a response that couldn't be resolved,
+// so a request returns its core data instead of failing as a whole.
+//
+// A request handler attaches a Collector to the request context with NewContext,
+// code enriching the response reports what it has failed to resolve with Record,
+// and the handler exposes the collected fields to a client along with a hint to retry later.
+package unresolved
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Kinds of enrichment that may fail.
+const (
+	// KindJettonMetadata means metadata of a jetton master is missing, so the jetton is shown as an unknown token.
+	KindJettonMetadata = "jetton_metadata"
+	// KindCollectionMetadata means metadata of an NFT collection is missing.
+	KindCollectionMetadata = "collection_metadata"
+	// KindTraceInfo means additional information about accounts of a trace is incomplete,
+	// so some actions are less specific, e.g. an NFT purchase looks like an NFT transfer.
+	KindTraceInfo = "trace_info"
+)
+
+var degradationsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "degraded_enrichments_total",
+	Help: "Number of times a response has been returned without a part that couldn't be resolved by kind",
+}, []string{"kind"})
+
+// Field is a part of a response that couldn't be resolved.
+type Field struct {
+	Kind string
+	// Subject is an account or a trace the field belongs to.
+	Subject string
+	Err     error
+}
+
+// Collector collects unresolved fields of a single request.
+type Collector struct {
+	mu     sync.Mutex
+	fields []Field
+}
+
+// maxFields caps a number of fields of a single request, a long history may reference the same broken jetton many times.
+const maxFields = 100
+
+// Fields returns the collected fields in the order they were recorded.
+func (c *Collector) Fields() []Field {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fields := make([]Field, len(c.fields))
+	copy(fields, c.fields)
+	return fields
+}
+
+func (c *Collector) add(field Field) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, f := range c.fields {
+		if f.Kind == field.Kind && f.Subject == field.Subject {
+			return
+		}
+	}
+	if len(c.fields) < maxFields {
+		c.fields = append(c.fields, field)
+	}
+}
+
+type contextKey struct{}
+
+// NewContext returns a context collecting unresolved fields into a new collector.
+// If the context collects fields already, its collector is returned, so a request has a single collector.
+func NewContext(ctx context.Context) (context.Context, *Collector) {
+	if c, ok := ctx.Value(contextKey{}).(*Collector); ok {
+		return ctx, c
+	}
+	c := &Collector{}
+	return context.WithValue(ctx, contextKey{}, c), c
+}
+
+// Record counts a degradation and adds the field to the collector of the given context, if any.
+func Record(ctx context.Context, kind, subject string, err error) {
+	degradationsMetric.WithLabelValues(kind).Inc()
+	if c, ok := ctx.Value(contextKey{}).(*Collector); ok {
+		c.add(Field{Kind: kind, Subject: subject, Err: err})
+	}
+}
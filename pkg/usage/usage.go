@@ -0,0 +1,119 @@
+// Package usage attributes upstream resources consumed by clients to their API tokens,
+// so teams sharing one instance can be charged back fairly.
+//
+// Usage is attributed to a token name: the name of a tenant a token belongs to,
+// requests without a tenant token are attributed to the empty name.
+// Counters are kept in memory since the start of the instance and exported as prometheus metrics.
+package usage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	requestsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "usage_requests_total",
+		Help: "Number of REST requests by token name",
+	}, []string{"token_name"})
+	liteServerCallsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "usage_liteserver_calls_total",
+		Help: "Number of storage calls ending up in lite servers by token name",
+	}, []string{"token_name"})
+	emulationMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "usage_emulation_milliseconds_total",
+		Help: "Milliseconds spent in the emulator by token name",
+	}, []string{"token_name"})
+	streamedBytesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "usage_streamed_bytes_total",
+		Help: "Bytes sent to SSE, websocket and long polling clients by token name",
+	}, []string{"token_name"})
+)
+
+// Usage is a sum of resources consumed with tokens of the same name.
+type Usage struct {
+	TokenName       string
+	Requests        int64
+	LiteServerCalls int64
+	// Emulation is time spent in the emulator, it runs on the CPU of the instance.
+	Emulation     time.Duration
+	StreamedBytes int64
+}
+
+// Request describes upstream resources consumed by a single REST request.
+type Request struct {
+	LiteServerCalls int
+	Emulation       time.Duration
+}
+
+// Meter sums up usage by token names.
+type Meter struct {
+	since time.Time
+
+	mu    sync.Mutex
+	usage map[string]*Usage
+}
+
+func NewMeter() *Meter {
+	return &Meter{
+		since: time.Now(),
+		usage: map[string]*Usage{},
+	}
+}
+
+// Since returns the moment counting has started.
+func (m *Meter) Since() time.Time {
+	return m.since
+}
+
+// AddRequest attributes a REST request to the token name.
+func (m *Meter) AddRequest(tokenName string, req Request) {
+	requestsMetric.WithLabelValues(tokenName).Inc()
+	liteServerCallsMetric.WithLabelValues(tokenName).Add(float64(req.LiteServerCalls))
+	emulationMetric.WithLabelValues(tokenName).Add(float64(req.Emulation) / float64(time.Millisecond))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u := m.get(tokenName)
+	u.Requests += 1
+	u.LiteServerCalls += int64(req.LiteServerCalls)
+	u.Emulation += req.Emulation
+}
+
+// AddStreamed attributes bytes sent to a streaming client to the token name.
+func (m *Meter) AddStreamed(tokenName string, bytes int) {
+	streamedBytesMetric.WithLabelValues(tokenName).Add(float64(bytes))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.get(tokenName).StreamedBytes += int64(bytes)
+}
+
+func (m *Meter) get(tokenName string) *Usage {
+	u, ok := m.usage[tokenName]
+	if !ok {
+		u = &Usage{TokenName: tokenName}
+		m.usage[tokenName] = u
+	}
+	return u
+}
+
+// Usage returns usage of token names accepted by the filter ordered by name, nil filter accepts all.
+func (m *Meter) Usage(filter func(tokenName string) bool) []Usage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make([]Usage, 0, len(m.usage))
+	for name, u := range m.usage {
+		if filter == nil || filter(name) {
+			result = append(result, *u)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].TokenName < result[j].TokenName
+	})
+	return result
+}
@@ -0,0 +1,28 @@
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeter(t *testing.T) {
+	m := NewMeter()
+	m.AddRequest("team-a", Request{LiteServerCalls: 3})
+	m.AddRequest("team-a", Request{LiteServerCalls: 1, Emulation: 40 * time.Millisecond})
+	m.AddStreamed("team-a", 100)
+	m.AddRequest("", Request{LiteServerCalls: 2})
+	m.AddStreamed("team-b", 1500)
+	m.AddStreamed("team-b", 500)
+
+	require.Equal(t, []Usage{
+		{TokenName: "", Requests: 1, LiteServerCalls: 2},
+		{TokenName: "team-a", Requests: 2, LiteServerCalls: 4, Emulation: 40 * time.Millisecond, StreamedBytes: 100},
+		{TokenName: "team-b", StreamedBytes: 2000},
+	}, m.Usage(nil))
+
+	require.Equal(t, []Usage{
+		{TokenName: "team-b", StreamedBytes: 2000},
+	}, m.Usage(func(tokenName string) bool { return tokenName == "team-b" }))
+}
@@ -0,0 +1,155 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+)
+
+// tongo's wallet.Version doesn't know highload wallet v3, so it is recognized by its code hash.
+var highloadV3CodeHash = ton.MustParseHash("11acad7955844090f283bf238bc1449871f783e7cc0979408d3f4859483e8525")
+
+const (
+	// HighloadV3InternalTransferOpCode is an op code of a message highload wallet v3 sends to itself to process a batch of transfers.
+	HighloadV3InternalTransferOpCode = 0xae42e5a4
+	// highloadV3BitsPerShift is a number of query IDs sharing the same shift, every shift is a cell of 1023 bits.
+	highloadV3BitsPerShift = 1023
+	// HighloadV3QueryIDCapacity is a number of query IDs: 13 bits of a shift and a bit number below 1023.
+	HighloadV3QueryIDCapacity = (1 << 13) * highloadV3BitsPerShift
+)
+
+// IsHighloadV3 reports whether the code is the code of highload wallet v3.
+func IsHighloadV3(code []byte) bool {
+	cells, err := boc.DeserializeBoc(code)
+	if err != nil || len(cells) != 1 {
+		return false
+	}
+	hash, err := cells[0].Hash256()
+	return err == nil && ton.Bits256(hash) == highloadV3CodeHash
+}
+
+// HighloadV3Message is an external message to highload wallet v3.
+// Instead of a seqno, the wallet protects itself from replays with a query ID
+// that can't be reused until it is cleaned up after the timeout.
+type HighloadV3Message struct {
+	SubwalletID uint32
+	QueryID     uint32
+	CreatedAt   uint64
+	Timeout     uint32
+}
+
+// DecodeHighloadV3Message decodes the body of an external message to highload wallet v3.
+// The layout is checked strictly, so bodies of other wallets are not mistaken for it.
+func DecodeHighloadV3Message(body *boc.Cell) (*HighloadV3Message, error) {
+	if body.BitSize() != 512 || body.RefsSize() != 1 {
+		return nil, fmt.Errorf("not a highload wallet v3 message")
+	}
+	// subwallet_id:uint32 send_mode:uint8 query_id:uint23 created_at:uint64 timeout:uint22 and a ref to a message.
+	inner := body.Refs()[0]
+	if inner.BitSize() != 32+8+23+64+22 || inner.RefsSize() != 1 {
+		return nil, fmt.Errorf("not a highload wallet v3 message")
+	}
+	inner.ResetCounters()
+	subwalletID, err := inner.ReadUint(32)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := inner.ReadUint(8); err != nil {
+		return nil, err
+	}
+	queryID, err := inner.ReadUint(23)
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := inner.ReadUint(64)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := inner.ReadUint(22)
+	if err != nil {
+		return nil, err
+	}
+	return &HighloadV3Message{
+		SubwalletID: uint32(subwalletID),
+		QueryID:     uint32(queryID),
+		CreatedAt:   createdAt,
+		Timeout:     uint32(timeout),
+	}, nil
+}
+
+type highloadV3Data struct {
+	PublicKey     tlb.Bits256
+	SubwalletID   uint32
+	OldQueries    tlb.HashmapE[tlb.Uint13, tlb.Ref[boc.Cell]]
+	Queries       tlb.HashmapE[tlb.Uint13, tlb.Ref[boc.Cell]]
+	LastCleanTime uint64
+	Timeout       tlb.Uint22
+}
+
+// HighloadV3State describes the replay protection of highload wallet v3.
+type HighloadV3State struct {
+	SubwalletID uint32
+	// Timeout is a lifetime of an external message in seconds, a query ID is blocked for up to two timeouts.
+	Timeout       uint32
+	LastCleanTime uint64
+	// UsedQueryIDs is a number of query IDs that can't be used at the moment.
+	UsedQueryIDs int
+	// RemainingQueryIDs is a number of query IDs available for new messages.
+	RemainingQueryIDs int
+}
+
+// ParseHighloadV3State decodes the data of highload wallet v3.
+// The wallet cleans up query IDs lazily when it processes a message,
+// so the state is reported as it is going to be at the given time.
+func ParseHighloadV3State(data []byte, now int64) (HighloadV3State, error) {
+	cells, err := boc.DeserializeBoc(data)
+	if err != nil {
+		return HighloadV3State{}, err
+	}
+	if len(cells) != 1 {
+		return HighloadV3State{}, fmt.Errorf("wallet data contains multiple root cells")
+	}
+	var d highloadV3Data
+	if err := tlb.Unmarshal(cells[0], &d); err != nil {
+		return HighloadV3State{}, err
+	}
+	state := HighloadV3State{
+		SubwalletID:   d.SubwalletID,
+		Timeout:       uint32(d.Timeout),
+		LastCleanTime: d.LastCleanTime,
+	}
+	oldQueries, queries := d.OldQueries, d.Queries
+	timeout := int64(d.Timeout)
+	if int64(d.LastCleanTime) < now-timeout {
+		oldQueries, queries = queries, tlb.HashmapE[tlb.Uint13, tlb.Ref[boc.Cell]]{}
+		if int64(d.LastCleanTime) < now-2*timeout {
+			oldQueries = tlb.HashmapE[tlb.Uint13, tlb.Ref[boc.Cell]]{}
+		}
+	}
+	for _, bitset := range append(oldQueries.Values(), queries.Values()...) {
+		used, err := countSetBits(&bitset.Value)
+		if err != nil {
+			return HighloadV3State{}, err
+		}
+		state.UsedQueryIDs += used
+	}
+	state.RemainingQueryIDs = HighloadV3QueryIDCapacity - state.UsedQueryIDs
+	return state, nil
+}
+
+func countSetBits(cell *boc.Cell) (int, error) {
+	cell.ResetCounters()
+	count := 0
+	for cell.BitsAvailableForRead() > 0 {
+		bit, err := cell.ReadBit()
+		if err != nil {
+			return 0, err
+		}
+		if bit {
+			count++
+		}
+	}
+	return count, nil
+}
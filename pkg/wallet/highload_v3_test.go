@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+)
+
+func TestDecodeHighloadV3Message(t *testing.T) {
+	inner := boc.NewCell()
+	require.Nil(t, inner.WriteUint(0x10ad, 32))
+	require.Nil(t, inner.WriteUint(3, 8))
+	require.Nil(t, inner.WriteUint(5<<10|7, 23))
+	require.Nil(t, inner.WriteUint(1720860269, 64))
+	require.Nil(t, inner.WriteUint(3600, 22))
+	require.Nil(t, inner.AddRef(boc.NewCell()))
+	body := boc.NewCell()
+	require.Nil(t, body.WriteUint(0, 512))
+	require.Nil(t, body.AddRef(inner))
+	msg, err := DecodeHighloadV3Message(body)
+	require.Nil(t, err)
+	require.Equal(t, &HighloadV3Message{SubwalletID: 0x10ad, QueryID: 5<<10 | 7, CreatedAt: 1720860269, Timeout: 3600}, msg)
+
+	// a body of wallet v4 has a subwallet ID, valid until and seqno next to the signature.
+	v4 := boc.NewCell()
+	require.Nil(t, v4.WriteUint(0, 512))
+	require.Nil(t, v4.WriteUint(0x10ad, 32))
+	require.Nil(t, v4.AddRef(boc.NewCell()))
+	_, err = DecodeHighloadV3Message(v4)
+	require.NotNil(t, err)
+}
+
+func TestParseHighloadV3State(t *testing.T) {
+	bitset := func(bits ...int) tlb.Ref[boc.Cell] {
+		cell := boc.NewCell()
+		for i := 0; i < highloadV3BitsPerShift; i++ {
+			set := false
+			for _, b := range bits {
+				set = set || b == i
+			}
+			require.Nil(t, cell.WriteBit(set))
+		}
+		return tlb.Ref[boc.Cell]{Value: *cell}
+	}
+	data := highloadV3Data{
+		SubwalletID:   0x10ad,
+		OldQueries:    tlb.NewHashmapE([]tlb.Uint13{0}, []tlb.Ref[boc.Cell]{bitset(1, 2)}),
+		Queries:       tlb.NewHashmapE([]tlb.Uint13{0, 1}, []tlb.Ref[boc.Cell]{bitset(3), bitset(0, 1022)}),
+		LastCleanTime: 1000,
+		Timeout:       100,
+	}
+	cell := boc.NewCell()
+	require.Nil(t, tlb.Marshal(cell, data))
+	raw, err := cell.ToBoc()
+	require.Nil(t, err)
+
+	tests := []struct {
+		name string
+		now  int64
+		used int
+	}{
+		{name: "before cleanup", now: 1050, used: 5},
+		{name: "queries become old", now: 1101, used: 3},
+		{name: "everything is cleaned up", now: 1201, used: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := ParseHighloadV3State(raw, tt.now)
+			require.Nil(t, err)
+			require.Equal(t, uint32(0x10ad), state.SubwalletID)
+			require.Equal(t, uint32(100), state.Timeout)
+			require.Equal(t, tt.used, state.UsedQueryIDs)
+			require.Equal(t, HighloadV3QueryIDCapacity-tt.used, state.RemainingQueryIDs)
+		})
+	}
+}
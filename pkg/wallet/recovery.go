@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/wallet"
+)
+
+// MaxRecoverySubWallets limits the number of subwallet IDs scanned by a single recovery.
+const MaxRecoverySubWallets = 32
+
+// RecoveryVersions are wallet versions scanned by a recovery by default.
+var RecoveryVersions = []wallet.Version{
+	wallet.V1R1, wallet.V1R2, wallet.V1R3,
+	wallet.V2R1, wallet.V2R2,
+	wallet.V3R1, wallet.V3R2,
+	wallet.V4R1, wallet.V4R2,
+	wallet.V5Beta, wallet.V5R1,
+}
+
+// RecoveryCandidate is an address a wallet of the given version and subwallet ID would be deployed at.
+type RecoveryCandidate struct {
+	Address ton.AccountID
+	Version wallet.Version
+	// SubWalletID is nil for the default subwallet.
+	SubWalletID *uint32
+}
+
+// ParseRecoveryVersion returns a wallet version by its name if a recovery can scan it.
+func ParseRecoveryVersion(name string) (wallet.Version, error) {
+	for _, version := range RecoveryVersions {
+		if version.ToString() == name {
+			return version, nil
+		}
+	}
+	return 0, fmt.Errorf("wallet %v is not supported", name)
+}
+
+// RecoveryCandidates derives addresses of wallets of the given versions owned by the public key.
+// Wallets v3 and v4 are additionally derived for every given subwallet ID,
+// the other versions don't let to choose a subwallet.
+func RecoveryCandidates(publicKey ed25519.PublicKey, versions []wallet.Version, subWalletIDs []uint32) ([]RecoveryCandidate, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key length: %v", len(publicKey))
+	}
+	if len(subWalletIDs) > MaxRecoverySubWallets {
+		return nil, fmt.Errorf("too many subwallet IDs, max %v", MaxRecoverySubWallets)
+	}
+	if len(versions) == 0 {
+		versions = RecoveryVersions
+	}
+	var candidates []RecoveryCandidate
+	seen := map[ton.AccountID]struct{}{}
+	add := func(version wallet.Version, subWalletID *uint32) error {
+		address, err := wallet.GenerateWalletAddress(publicKey, version, nil, 0, subWalletID)
+		if err != nil {
+			return err
+		}
+		// an explicit subwallet ID can match the default one.
+		if _, ok := seen[address]; ok {
+			return nil
+		}
+		seen[address] = struct{}{}
+		candidates = append(candidates, RecoveryCandidate{Address: address, Version: version, SubWalletID: subWalletID})
+		return nil
+	}
+	for _, version := range versions {
+		if err := add(version, nil); err != nil {
+			return nil, err
+		}
+		switch version {
+		case wallet.V3R1, wallet.V3R2, wallet.V4R1, wallet.V4R2:
+		default:
+			continue
+		}
+		for i := range subWalletIDs {
+			if err := add(version, &subWalletIDs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return candidates, nil
+}
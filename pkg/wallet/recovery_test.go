@@ -0,0 +1,77 @@
+package wallet
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/wallet"
+)
+
+func TestRecoveryCandidates(t *testing.T) {
+	publicKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+	defaultSubWallet := uint32(wallet.DefaultSubWallet)
+	tests := []struct {
+		name         string
+		publicKey    ed25519.PublicKey
+		versions     []wallet.Version
+		subWalletIDs []uint32
+		wantCount    int
+		wantErr      bool
+	}{
+		{
+			name:      "all versions",
+			publicKey: publicKey,
+			wantCount: len(RecoveryVersions),
+		},
+		{
+			name:         "subwallets of v3 and v4 only",
+			publicKey:    publicKey,
+			versions:     []wallet.Version{wallet.V2R2, wallet.V4R2, wallet.V5R1},
+			subWalletIDs: []uint32{1, 2},
+			wantCount:    5,
+		},
+		{
+			name:         "default subwallet is not repeated",
+			publicKey:    publicKey,
+			versions:     []wallet.Version{wallet.V4R2},
+			subWalletIDs: []uint32{defaultSubWallet},
+			wantCount:    1,
+		},
+		{
+			name:      "invalid public key",
+			publicKey: publicKey[:16],
+			wantErr:   true,
+		},
+		{
+			name:         "too many subwallets",
+			publicKey:    publicKey,
+			subWalletIDs: make([]uint32, MaxRecoverySubWallets+1),
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates, err := RecoveryCandidates(tt.publicKey, tt.versions, tt.subWalletIDs)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, candidates, tt.wantCount)
+			for _, candidate := range candidates {
+				address, err := wallet.GenerateWalletAddress(tt.publicKey, candidate.Version, nil, 0, candidate.SubWalletID)
+				require.NoError(t, err)
+				require.Equal(t, address, candidate.Address)
+			}
+		})
+	}
+}
+
+func TestParseRecoveryVersion(t *testing.T) {
+	version, err := ParseRecoveryVersion("v5R1")
+	require.NoError(t, err)
+	require.Equal(t, wallet.V5R1, version)
+	_, err = ParseRecoveryVersion("highload_v2")
+	require.Error(t, err)
+}
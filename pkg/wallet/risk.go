@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/tonkeeper/tongo"
@@ -21,6 +22,78 @@ type Risk struct {
 	// Jettons are not normalized and have to be post-processed with respect to Jetton masters' decimals.
 	Jettons map[tongo.AccountID]big.Int
 	Nfts    []tongo.AccountID
+	// AddedExtensions and RemovedExtensions are wallet v5 extensions installed and removed by a message.
+	// An extension can send any message on behalf of the wallet, so installing one may hand the wallet over.
+	AddedExtensions   []tongo.AccountID
+	RemovedExtensions []tongo.AccountID
+	// SignatureAllowed is set if a message changes whether wallet v5 accepts messages signed with its key.
+	// With signatures disabled, the wallet is controlled by its extensions only.
+	SignatureAllowed *bool
+}
+
+// AccountTakeover returns true if a message may hand control over the wallet to someone else,
+// that is, it installs a wallet v5 extension or disables signatures.
+func (r Risk) AccountTakeover() bool {
+	return len(r.AddedExtensions) > 0 || (r.SignatureAllowed != nil && !*r.SignatureAllowed)
+}
+
+// AddW5AuthChanges compares states of a wallet v5 before and after a message,
+// and records extensions installed or removed by the message and a change of the signature flag.
+// Unlike assets sent by a message, such changes are visible only in the resulting state,
+// because an extension can be installed by a signed message, by another extension or with the initial data.
+func (r *Risk) AddW5AuthChanges(walletAddress tongo.AccountID, before, after tlb.ShardAccount) error {
+	dataBefore, err := w5Data(before)
+	if err != nil {
+		return err
+	}
+	dataAfter, err := w5Data(after)
+	if err != nil {
+		return err
+	}
+	r.addW5DataChanges(walletAddress.Workchain, dataBefore, dataAfter)
+	return nil
+}
+
+// w5Data returns data of a wallet v5, a wallet that is not deployed yet has no extensions and allows signatures.
+func w5Data(state tlb.ShardAccount) (tongoWallet.DataV5R1, error) {
+	if state.Account.Status() != tlb.AccountActive {
+		return tongoWallet.DataV5R1{IsSignatureAllowed: true}, nil
+	}
+	var data tongoWallet.DataV5R1
+	stateInit := state.Account.Account.Storage.State.AccountActive.StateInit
+	if !stateInit.Data.Exists {
+		return data, fmt.Errorf("wallet v5 has no data")
+	}
+	cell := boc.Cell(stateInit.Data.Value.Value)
+	if err := tlb.Unmarshal(&cell, &data); err != nil {
+		return data, err
+	}
+	return data, nil
+}
+
+func (r *Risk) addW5DataChanges(workchain int32, before, after tongoWallet.DataV5R1) {
+	extensions := func(data tongoWallet.DataV5R1) map[tlb.Bits256]struct{} {
+		result := make(map[tlb.Bits256]struct{}, len(data.Extensions.Keys()))
+		for _, key := range data.Extensions.Keys() {
+			result[key] = struct{}{}
+		}
+		return result
+	}
+	extensionsBefore, extensionsAfter := extensions(before), extensions(after)
+	for _, key := range after.Extensions.Keys() {
+		if _, ok := extensionsBefore[key]; !ok {
+			r.AddedExtensions = append(r.AddedExtensions, tongo.AccountID{Workchain: workchain, Address: key})
+		}
+	}
+	for _, key := range before.Extensions.Keys() {
+		if _, ok := extensionsAfter[key]; !ok {
+			r.RemovedExtensions = append(r.RemovedExtensions, tongo.AccountID{Workchain: workchain, Address: key})
+		}
+	}
+	if before.IsSignatureAllowed != after.IsSignatureAllowed {
+		allowed := after.IsSignatureAllowed
+		r.SignatureAllowed = &allowed
+	}
 }
 
 func ExtractRisk(version tongoWallet.Version, msg *boc.Cell) (*Risk, error) {
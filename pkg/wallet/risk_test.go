@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/opentonapi/internal/g"
 	"github.com/tonkeeper/tongo"
 	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/wallet"
 )
 
@@ -73,3 +75,64 @@ func TestExtractRisk(t *testing.T) {
 		})
 	}
 }
+
+func TestRisk_addW5DataChanges(t *testing.T) {
+	extension := tongo.MustParseAccountID("0:96ac9b952d050f79c07a2e5e0b94872a5bc189f8633882ac33ea82f5f9670a38")
+	other := tongo.MustParseAccountID("0:120ecd442f6521f9951e37f15180e3f0baa4d0776a69a669b25f4c58acfe0653")
+	data := func(signatureAllowed bool, extensions ...tongo.AccountID) wallet.DataV5R1 {
+		keys := make([]tlb.Bits256, 0, len(extensions))
+		values := make([]tlb.Uint1, 0, len(extensions))
+		for _, ext := range extensions {
+			keys = append(keys, ext.Address)
+			values = append(values, 1)
+		}
+		return wallet.DataV5R1{IsSignatureAllowed: signatureAllowed, Extensions: tlb.NewHashmapE(keys, values)}
+	}
+	tests := []struct {
+		name         string
+		before       wallet.DataV5R1
+		after        wallet.DataV5R1
+		want         Risk
+		wantTakeover bool
+	}{
+		{
+			name:   "no changes",
+			before: data(true, extension),
+			after:  data(true, extension),
+		},
+		{
+			name:         "extension installed",
+			before:       data(true, other),
+			after:        data(true, other, extension),
+			want:         Risk{AddedExtensions: []tongo.AccountID{extension}},
+			wantTakeover: true,
+		},
+		{
+			name:   "extension removed",
+			before: data(true, extension, other),
+			after:  data(true, other),
+			want:   Risk{RemovedExtensions: []tongo.AccountID{extension}},
+		},
+		{
+			name:         "signature disabled",
+			before:       data(true, extension),
+			after:        data(false, extension),
+			want:         Risk{SignatureAllowed: g.Pointer(false)},
+			wantTakeover: true,
+		},
+		{
+			name:   "signature enabled",
+			before: data(false, extension),
+			after:  data(true, extension),
+			want:   Risk{SignatureAllowed: g.Pointer(true)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var risk Risk
+			risk.addW5DataChanges(0, tt.before, tt.after)
+			require.Equal(t, tt.want, risk)
+			require.Equal(t, tt.wantTakeover, risk.AccountTakeover())
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package wallet
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/wallet"
+)
+
+// SignaturePosition describes where an ed25519 signature of the unsigned body has to be written
+// to get the body of an external message.
+type SignaturePosition string
+
+const (
+	// SignatureBeforeBody means the signature precedes the bits of the body, used by wallets v3 and v4.
+	SignatureBeforeBody SignaturePosition = "before_body"
+	// SignatureAfterBody means the signature is appended to the bits of the body, used by wallet v5.
+	SignatureAfterBody SignaturePosition = "after_body"
+)
+
+// UnsignedBody is a body of an external message to a wallet without a signature.
+// An external signer signs the hash of Body and puts the signature according to SignaturePosition.
+type UnsignedBody struct {
+	Body              *boc.Cell
+	SignaturePosition SignaturePosition
+}
+
+// Sign returns the body of an external message with the given signature of the hash of the unsigned body.
+func (b *UnsignedBody) Sign(signature []byte) (*boc.Cell, error) {
+	if len(signature) != 64 {
+		return nil, fmt.Errorf("signature must be 64 bytes long")
+	}
+	signed := boc.NewCell()
+	if b.SignaturePosition == SignatureBeforeBody {
+		if err := signed.WriteBytes(signature); err != nil {
+			return nil, err
+		}
+	}
+	if err := signed.WriteBitString(b.Body.RawBitString()); err != nil {
+		return nil, err
+	}
+	for _, ref := range b.Body.Refs() {
+		if err := signed.AddRef(ref); err != nil {
+			return nil, err
+		}
+	}
+	if b.SignaturePosition == SignatureAfterBody {
+		if err := signed.WriteBytes(signature); err != nil {
+			return nil, err
+		}
+	}
+	return signed, nil
+}
+
+// TransferParams configures the unsigned body of an external message to a wallet.
+type TransferParams struct {
+	Version    wallet.Version
+	WalletID   uint32
+	Seqno      uint32
+	ValidUntil time.Time
+}
+
+var transferVersions = []wallet.Version{wallet.V3R1, wallet.V3R2, wallet.V4R1, wallet.V4R2, wallet.V5R1}
+
+// ParseTransferVersion returns a wallet version by its name if BuildUnsignedBody supports it.
+func ParseTransferVersion(name string) (wallet.Version, error) {
+	for _, version := range transferVersions {
+		if version.ToString() == name {
+			return version, nil
+		}
+	}
+	return 0, fmt.Errorf("wallet %v is not supported", name)
+}
+
+// ParseWalletData returns a seqno and a wallet ID stored in the data of a wallet.
+func ParseWalletData(version wallet.Version, data []byte) (seqno uint32, walletID uint32, err error) {
+	cells, err := boc.DeserializeBoc(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(cells) != 1 {
+		return 0, 0, fmt.Errorf("wallet data contains multiple root cells")
+	}
+	switch version {
+	case wallet.V3R1, wallet.V3R2:
+		var d wallet.DataV3
+		if err := tlb.Unmarshal(cells[0], &d); err != nil {
+			return 0, 0, err
+		}
+		return d.Seqno, d.SubWalletId, nil
+	case wallet.V4R1, wallet.V4R2:
+		var d wallet.DataV4
+		if err := tlb.Unmarshal(cells[0], &d); err != nil {
+			return 0, 0, err
+		}
+		return d.Seqno, d.SubWalletId, nil
+	case wallet.V5R1:
+		var d wallet.DataV5R1
+		if err := tlb.Unmarshal(cells[0], &d); err != nil {
+			return 0, 0, err
+		}
+		return d.Seqno, d.WalletID, nil
+	}
+	return 0, 0, fmt.Errorf("wallet %v is not supported", version.ToString())
+}
+
+// DefaultWalletID returns a wallet ID a wallet of the given version gets by default in the mainnet.
+func DefaultWalletID(version wallet.Version, workchain int32) (uint32, error) {
+	switch version {
+	case wallet.V3R1, wallet.V3R2, wallet.V4R1, wallet.V4R2:
+		return uint32(wallet.DefaultSubWallet + workchain), nil
+	case wallet.V5R1:
+		// wallet_id of v5 is a network global ID xor-ed with a context of the wallet:
+		// a client flag, a workchain, a wallet version and a subwallet number.
+		context := int64(1)<<31 | int64(uint8(workchain))<<23
+		return uint32(context ^ wallet.MainnetGlobalID), nil
+	}
+	return 0, fmt.Errorf("wallet %v is not supported", version.ToString())
+}
+
+// BuildUnsignedBody constructs the body of an external message to a wallet carrying the given messages.
+func BuildUnsignedBody(params TransferParams, messages ...wallet.Sendable) (*UnsignedBody, error) {
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("no messages to send")
+	}
+	rawMessages := make([]wallet.RawMessage, 0, len(messages))
+	for _, m := range messages {
+		intMsg, mode, err := m.ToInternal()
+		if err != nil {
+			return nil, err
+		}
+		cell := boc.NewCell()
+		if err := tlb.Marshal(cell, intMsg); err != nil {
+			return nil, err
+		}
+		rawMessages = append(rawMessages, wallet.RawMessage{Message: cell, Mode: mode})
+	}
+	validUntil := uint32(params.ValidUntil.Unix())
+	body := boc.NewCell()
+	switch params.Version {
+	case wallet.V3R1, wallet.V3R2:
+		if len(rawMessages) > 4 {
+			return nil, fmt.Errorf("wallet v3 sends up to 4 messages")
+		}
+		msg := wallet.MessageV3{
+			SubWalletId: params.WalletID,
+			ValidUntil:  validUntil,
+			Seqno:       params.Seqno,
+			RawMessages: wallet.PayloadV1toV4(rawMessages),
+		}
+		if err := tlb.Marshal(body, msg); err != nil {
+			return nil, err
+		}
+		return &UnsignedBody{Body: body, SignaturePosition: SignatureBeforeBody}, nil
+	case wallet.V4R1, wallet.V4R2:
+		if len(rawMessages) > 4 {
+			return nil, fmt.Errorf("wallet v4 sends up to 4 messages")
+		}
+		msg := wallet.MessageV4{
+			SubWalletId: params.WalletID,
+			ValidUntil:  validUntil,
+			Seqno:       params.Seqno,
+			Op:          0,
+			RawMessages: wallet.PayloadV1toV4(rawMessages),
+		}
+		if err := tlb.Marshal(body, msg); err != nil {
+			return nil, err
+		}
+		return &UnsignedBody{Body: body, SignaturePosition: SignatureBeforeBody}, nil
+	case wallet.V5R1:
+		if len(rawMessages) > 255 {
+			return nil, fmt.Errorf("wallet v5 sends up to 255 messages")
+		}
+		actions := make(wallet.W5Actions, 0, len(rawMessages))
+		for _, m := range rawMessages {
+			actions = append(actions, wallet.W5SendMessageAction{Msg: m.Message, Mode: m.Mode})
+		}
+		msg := struct {
+			WalletId        uint32
+			ValidUntil      uint32
+			Seqno           uint32
+			Actions         *wallet.W5Actions         `tlb:"maybe^"`
+			ExtendedActions *wallet.W5ExtendedActions `tlb:"maybe"`
+		}{
+			WalletId:   params.WalletID,
+			ValidUntil: validUntil,
+			Seqno:      params.Seqno,
+			Actions:    &actions,
+		}
+		if err := body.WriteUint(uint64(wallet.V5MsgTypeSignedExternal), 32); err != nil {
+			return nil, err
+		}
+		if err := tlb.Marshal(body, msg); err != nil {
+			return nil, err
+		}
+		return &UnsignedBody{Body: body, SignaturePosition: SignatureAfterBody}, nil
+	}
+	return nil, fmt.Errorf("wallet %v is not supported", params.Version.ToString())
+}
+
+// JettonTransfer is a message to a jetton wallet of the sender transferring jettons to Destination.
+type JettonTransfer struct {
+	QueryID             uint64
+	JettonWallet        ton.AccountID
+	Amount              big.Int
+	Destination         ton.AccountID
+	ResponseDestination ton.AccountID
+	AttachedTon         tlb.Grams
+	ForwardTonAmount    tlb.Grams
+	Comment             string
+}
+
+func (t JettonTransfer) ToInternal() (tlb.Message, uint8, error) {
+	body := boc.NewCell()
+	msgBody := abi.JettonTransferMsgBody{
+		QueryId:             t.QueryID,
+		Amount:              tlb.VarUInteger16(t.Amount),
+		Destination:         t.Destination.ToMsgAddress(),
+		ResponseDestination: t.ResponseDestination.ToMsgAddress(),
+		ForwardTonAmount:    tlb.VarUInteger16(*big.NewInt(int64(t.ForwardTonAmount))),
+	}
+	if t.Comment != "" {
+		payload, err := commentCell(t.Comment)
+		if err != nil {
+			return tlb.Message{}, 0, err
+		}
+		msgBody.ForwardPayload.IsRight = true
+		msgBody.ForwardPayload.Value = abi.JettonPayload{SumType: abi.UnknownJettonOp, Value: payload}
+	}
+	if err := body.WriteUint(uint64(abi.JettonTransferMsgOpCode), 32); err != nil {
+		return tlb.Message{}, 0, err
+	}
+	if err := tlb.Marshal(body, msgBody); err != nil {
+		return tlb.Message{}, 0, err
+	}
+	return wallet.Message{
+		Amount:  t.AttachedTon,
+		Address: t.JettonWallet,
+		Body:    body,
+		Bounce:  true,
+		Mode:    wallet.DefaultMessageMode,
+	}.ToInternal()
+}
+
+// NftTransfer is a message to an NFT item transferring it to NewOwner.
+type NftTransfer struct {
+	QueryID             uint64
+	Item                ton.AccountID
+	NewOwner            ton.AccountID
+	ResponseDestination ton.AccountID
+	AttachedTon         tlb.Grams
+	ForwardTonAmount    tlb.Grams
+	Comment             string
+}
+
+func (t NftTransfer) ToInternal() (tlb.Message, uint8, error) {
+	body := boc.NewCell()
+	msgBody := abi.NftTransferMsgBody{
+		QueryId:             t.QueryID,
+		NewOwner:            t.NewOwner.ToMsgAddress(),
+		ResponseDestination: t.ResponseDestination.ToMsgAddress(),
+		ForwardAmount:       tlb.VarUInteger16(*big.NewInt(int64(t.ForwardTonAmount))),
+	}
+	if t.Comment != "" {
+		payload, err := commentCell(t.Comment)
+		if err != nil {
+			return tlb.Message{}, 0, err
+		}
+		msgBody.ForwardPayload.IsRight = true
+		msgBody.ForwardPayload.Value = abi.NFTPayload{SumType: abi.UnknownNFTOp, Value: payload}
+	}
+	if err := body.WriteUint(uint64(abi.NftTransferMsgOpCode), 32); err != nil {
+		return tlb.Message{}, 0, err
+	}
+	if err := tlb.Marshal(body, msgBody); err != nil {
+		return tlb.Message{}, 0, err
+	}
+	return wallet.Message{
+		Amount:  t.AttachedTon,
+		Address: t.Item,
+		Body:    body,
+		Bounce:  true,
+		Mode:    wallet.DefaultMessageMode,
+	}.ToInternal()
+}
+
+func commentCell(comment string) (*boc.Cell, error) {
+	cell := boc.NewCell()
+	if err := tlb.Marshal(cell, wallet.TextComment(comment)); err != nil {
+		return nil, err
+	}
+	return cell, nil
+}
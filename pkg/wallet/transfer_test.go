@@ -0,0 +1,76 @@
+package wallet
+
+import (
+	"crypto/ed25519"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/abi"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/wallet"
+)
+
+func TestBuildUnsignedBody(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	destination := ton.MustParseAccountID("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c")
+	validUntil := time.Unix(1720860269, 0)
+	transfer := wallet.SimpleTransfer{Amount: 1_000_000_000, Address: destination, Comment: "hello"}
+
+	for _, version := range []wallet.Version{wallet.V3R2, wallet.V4R2, wallet.V5R1} {
+		t.Run(version.ToString(), func(t *testing.T) {
+			w, err := wallet.New(key, version, nil)
+			require.Nil(t, err)
+			want, err := w.CreateMessageBody(wallet.MessageConfig{Seqno: 7, ValidUntil: validUntil, V5MsgType: wallet.V5MsgTypeSignedExternal}, transfer)
+			require.Nil(t, err)
+
+			walletID, err := DefaultWalletID(version, 0)
+			require.Nil(t, err)
+			unsigned, err := BuildUnsignedBody(TransferParams{Version: version, WalletID: walletID, Seqno: 7, ValidUntil: validUntil}, transfer)
+			require.Nil(t, err)
+			signature, err := unsigned.Body.Sign(key)
+			require.Nil(t, err)
+			got, err := unsigned.Sign(signature)
+			require.Nil(t, err)
+
+			wantHash, err := want.HashString()
+			require.Nil(t, err)
+			gotHash, err := got.HashString()
+			require.Nil(t, err)
+			require.Equal(t, wantHash, gotHash)
+		})
+	}
+
+	_, err := BuildUnsignedBody(TransferParams{Version: wallet.V1R3}, transfer)
+	require.NotNil(t, err)
+	_, err = BuildUnsignedBody(TransferParams{Version: wallet.V4R2}, transfer, transfer, transfer, transfer, transfer)
+	require.NotNil(t, err)
+}
+
+func TestJettonTransfer(t *testing.T) {
+	jettonWallet := ton.MustParseAccountID("0:2cf3b5b8c891e517c9addbda1c0386a09ccacbb0e3faf630b51cfc8152325acb")
+	destination := ton.MustParseAccountID("0:6dcb8357c6bef52b43f0f681d976f5a46068ae195cb95f7a959d25c71b0cac6c")
+	msg, _, err := JettonTransfer{
+		QueryID:             1,
+		JettonWallet:        jettonWallet,
+		Amount:              *big.NewInt(100),
+		Destination:         destination,
+		ResponseDestination: jettonWallet,
+		AttachedTon:         50_000_000,
+		ForwardTonAmount:    1,
+		Comment:             "deposit",
+	}.ToInternal()
+	require.Nil(t, err)
+	require.Equal(t, tlb.Grams(50_000_000), msg.Info.IntMsgInfo.Value.Grams)
+
+	body := boc.Cell(msg.Body.Value)
+	_, opName, value, err := abi.InternalMessageDecoder(&body, nil)
+	require.Nil(t, err)
+	require.Equal(t, abi.JettonTransferMsgOp, *opName)
+	decoded := value.(abi.JettonTransferMsgBody)
+	require.Equal(t, destination.ToMsgAddress(), decoded.Destination)
+	require.Equal(t, abi.TextCommentJettonOp, decoded.ForwardPayload.Value.SumType)
+}
@@ -0,0 +1,8 @@
+package opentonapi
+
+import _ "embed"
+
+// OpenAPISpec is api/openapi.yml converted to JSON by "go generate".
+//
+//go:embed api/openapi.json
+var OpenAPISpec []byte